@@ -1,8 +1,10 @@
 package main
 
 import (
+	"AtoiTalkAPI/internal/adapter"
 	"AtoiTalkAPI/internal/config"
 	"AtoiTalkAPI/internal/scheduler"
+	"AtoiTalkAPI/internal/websocket"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -30,7 +32,17 @@ func main() {
 		os.Exit(1)
 	}
 
-	srv := scheduler.New(cfg, entClient, s3Client)
+	redisAdapter, err := adapter.NewRedisAdapter(cfg)
+	if err != nil {
+		slog.Error("Failed to initialize Redis adapter", "error", err)
+		os.Exit(1)
+	}
+
+	// Events are published through Redis, so scheduled messages reach clients
+	// connected to any API instance.
+	wsHub := websocket.NewPublisherHub(entClient, redisAdapter)
+
+	srv := scheduler.New(cfg, entClient, s3Client, redisAdapter, wsHub)
 
 	srv.Start()
 
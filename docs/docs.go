@@ -1548,7 +1548,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of user's chats, sorted by last message time. Can be searched.",
+                "description": "Get a paginated list of user's chats, sorted by last message time. Can be searched. Archived chats are only listed with archived=true. With folder_id only the chats of that folder are listed, archived or not, and archived is ignored. Without a search query the first page starts with the user's pinned chats in pin order, in addition to limit; later pages leave them out.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1566,6 +1566,18 @@ const docTemplate = `{
                         "name": "query",
                         "in": "query"
                     },
+                    {
+                        "type": "boolean",
+                        "description": "List archived chats instead of the inbox",
+                        "name": "archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List the chats of a chat folder (UUID)",
+                        "name": "folder_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Pagination cursor",
@@ -1628,14 +1640,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group": {
-            "post": {
+        "/api/chats/archive": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new group chat with multiple members and an optional avatar.",
+                "description": "Get the number of archived chats and their aggregated unread counts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1645,18 +1657,7 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Create Group Chat",
-                "parameters": [
-                    {
-                        "description": "Group creation",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateGroupChatRequest"
-                        }
-                    }
-                ],
+                "summary": "Get Archive Summary",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1669,31 +1670,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.ChatListResponse"
+                                            "$ref": "#/definitions/model.ArchiveSummaryResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -1709,9 +1698,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/invite/{inviteCode}": {
+        "/api/chats/exports/{exportID}": {
             "get": {
-                "description": "Get basic group info using an invite code. Useful for previewing before joining.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the status of a chat export requested by the current user. Completed exports include a download link valid for one hour.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1721,12 +1715,12 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Get Group Preview by Invite Code",
+                "summary": "Get Chat Export",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Invite Code",
-                        "name": "inviteCode",
+                        "description": "Export ID (UUID)",
+                        "name": "exportID",
                         "in": "path",
                         "required": true
                     }
@@ -1743,7 +1737,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GroupPreviewDTO"
+                                            "$ref": "#/definitions/model.ChatExportResponse"
                                         }
                                     }
                                 }
@@ -1756,6 +1750,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1777,14 +1777,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/join/invite": {
-            "post": {
+        "/api/chats/folders": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Join a private or public group using an invite code.",
+                "description": "Get the chat folders of the current user in creation order, each with the unread counts of the chats it holds.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1794,18 +1794,7 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Join Group by Invite Code",
-                "parameters": [
-                    {
-                        "description": "Join Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.JoinGroupByInviteRequest"
-                        }
-                    }
-                ],
+                "summary": "Get Chat Folders",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1818,31 +1807,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.ChatListResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.ChatFolderResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -1856,16 +1836,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/chats/group/public": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Search for public groups by name or description. Supports sorting by name or member count.",
+                "description": "Create a chat folder. A chat belongs to the folder when it is listed in chat_ids, or when the folder has rules and the chat matches all of them. At most 10 folders per user. Other devices receive a chat.folder event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1875,31 +1853,16 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Search Public Groups",
+                "summary": "Create Chat Folder",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pagination cursor",
-                        "name": "cursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of items per page (default 20, max 50)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sort order: 'name' (default, A-Z) or 'member_count' (most members first)",
-                        "name": "sort_by",
-                        "in": "query"
+                        "description": "Chat folder",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ChatFolderRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1908,16 +1871,13 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/model.PublicGroupDTO"
-                                            }
+                                            "$ref": "#/definitions/model.ChatFolderResponse"
                                         }
                                     }
                                 }
@@ -1951,14 +1911,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}": {
+        "/api/chats/folders/{folderID}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update group name, description, avatar, or visibility. Only owners or admins can perform this action.",
+                "description": "Replace the name, rules and chats of a chat folder. Other devices receive a chat.folder event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1968,22 +1928,22 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Update Group Chat Info",
+                "summary": "Update Chat Folder",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group Chat ID (UUID)",
-                        "name": "chatID",
+                        "description": "Folder ID (UUID)",
+                        "name": "folderID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Group update",
+                        "description": "Chat folder",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateGroupChatRequest"
+                            "$ref": "#/definitions/model.ChatFolderRequest"
                         }
                     }
                 ],
@@ -1999,7 +1959,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.ChatListResponse"
+                                            "$ref": "#/definitions/model.ChatFolderResponse"
                                         }
                                     }
                                 }
@@ -2018,12 +1978,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2050,7 +2004,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a group chat. Only owner can perform this action.",
+                "description": "Delete a chat folder. The chats in it are not affected. Other devices receive a chat.folder event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2060,12 +2014,12 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Delete Group",
+                "summary": "Delete Chat Folder",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group Chat ID (UUID)",
-                        "name": "chatID",
+                        "description": "Folder ID (UUID)",
+                        "name": "folderID",
                         "in": "path",
                         "required": true
                     }
@@ -2089,12 +2043,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2116,14 +2064,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}/invite": {
-            "put": {
+        "/api/chats/group": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Reset the invite code for a group. Only admins or owners can perform this action.",
+                "description": "Create a new group chat with multiple members and an optional avatar.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2133,14 +2081,16 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Reset Group Invite Code",
+                "summary": "Create Group Chat",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group Chat ID (UUID)",
-                        "name": "chatID",
-                        "in": "path",
-                        "required": true
+                        "description": "Group creation",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateGroupChatRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2155,7 +2105,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.GroupInviteResponse"
+                                            "$ref": "#/definitions/model.ChatListResponse"
                                         }
                                     }
                                 }
@@ -2180,12 +2130,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -2201,14 +2145,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}/join": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Join a public group chat.",
+        "/api/chats/group/invite/{inviteCode}": {
+            "get": {
+                "description": "Get basic group info using an invite code. Useful for previewing before joining.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2218,12 +2157,12 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Join Public Group",
+                "summary": "Get Group Preview by Invite Code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group Chat ID (UUID)",
-                        "name": "chatID",
+                        "description": "Invite Code",
+                        "name": "inviteCode",
                         "in": "path",
                         "required": true
                     }
@@ -2240,7 +2179,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.ChatListResponse"
+                                            "$ref": "#/definitions/model.GroupPreviewDTO"
                                         }
                                     }
                                 }
@@ -2253,30 +2192,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -2292,14 +2213,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}/leave": {
+        "/api/chats/group/join/invite": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Leave a group chat. Owner cannot leave without transferring ownership first.",
+                "description": "Join a private or public group using an invite code. If the group requires approval, a pending join request is created for the admins to review and returned with status 202.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2309,14 +2230,16 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Leave Group",
+                "summary": "Join Group by Invite Code",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group Chat ID (UUID)",
-                        "name": "chatID",
-                        "in": "path",
-                        "required": true
+                        "description": "Join Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.JoinGroupByInviteRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2331,7 +2254,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.MessageResponse"
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GroupJoinRequestDTO"
                                         }
                                     }
                                 }
@@ -2356,6 +2297,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -2371,14 +2318,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}/members": {
+        "/api/chats/group/public": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Search for members in a group chat by username or full name.",
+                "description": "Search for public groups by name or description. Supports sorting by name or member count.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2388,15 +2335,8 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Search Group Members",
+                "summary": "Search Public Groups",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group Chat ID (UUID)",
-                        "name": "chatID",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Search query",
@@ -2414,6 +2354,12 @@ const docTemplate = `{
                         "description": "Number of items per page (default 20, max 50)",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: 'name' (default, A-Z) or 'member_count' (most members first)",
+                        "name": "sort_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2430,7 +2376,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/model.GroupMemberDTO"
+                                                "$ref": "#/definitions/model.PublicGroupDTO"
                                             }
                                         }
                                     }
@@ -2450,18 +2396,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -2475,14 +2409,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/chats/group/{chatID}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add new members to a group chat. Only owners or admins can perform this action.",
+                "description": "Update group name, description, avatar, visibility, slow mode, or join approval. Only owners or admins can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2492,7 +2428,7 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Add Member to Group",
+                "summary": "Update Group Chat Info",
                 "parameters": [
                     {
                         "type": "string",
@@ -2502,12 +2438,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Add Member Request",
+                        "description": "Group update",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.AddGroupMemberRequest"
+                            "$ref": "#/definitions/model.UpdateGroupChatRequest"
                         }
                     }
                 ],
@@ -2523,10 +2459,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/model.MessageResponse"
-                                            }
+                                            "$ref": "#/definitions/model.ChatListResponse"
                                         }
                                     }
                                 }
@@ -2557,12 +2490,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -2576,16 +2503,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/chats/group/{chatID}/members/{userID}/kick": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Kick a member from a group chat. Only owners or admins can perform this action.",
+                "description": "Soft delete a group chat. Only owner can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2595,7 +2520,7 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Kick Member from Group",
+                "summary": "Delete Group",
                 "parameters": [
                     {
                         "type": "string",
@@ -2603,32 +2528,13 @@ const docTemplate = `{
                         "name": "chatID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Target User ID (UUID)",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/helper.ResponseSuccess"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/model.MessageResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/helper.ResponseSuccess"
                         }
                     },
                     "400": {
@@ -2670,14 +2576,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}/members/{userID}/role": {
+        "/api/chats/group/{chatID}/invite": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Promote or demote a group member. Only owner can perform this action.",
+                "description": "Reset the invite code for a group. Only admins or owners can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2687,7 +2593,7 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Update Member Role",
+                "summary": "Reset Group Invite Code",
                 "parameters": [
                     {
                         "type": "string",
@@ -2695,22 +2601,6 @@ const docTemplate = `{
                         "name": "chatID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Target User ID (UUID)",
-                        "name": "userID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Role Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateGroupMemberRoleRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -2725,7 +2615,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.MessageResponse"
+                                            "$ref": "#/definitions/model.GroupInviteResponse"
                                         }
                                     }
                                 }
@@ -2771,14 +2661,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/group/{chatID}/transfer": {
+        "/api/chats/group/{chatID}/join": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Transfer group ownership to another member. Only owner can perform this action.",
+                "description": "Join a public group chat. If the group requires approval, a pending join request is created for the admins to review and returned with status 202.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2788,7 +2678,7 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Transfer Ownership",
+                "summary": "Join Public Group",
                 "parameters": [
                     {
                         "type": "string",
@@ -2796,15 +2686,6 @@ const docTemplate = `{
                         "name": "chatID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Transfer Ownership Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.TransferGroupOwnershipRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -2819,7 +2700,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.MessageResponse"
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.GroupJoinRequestDTO"
                                         }
                                     }
                                 }
@@ -2850,6 +2749,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -2865,14 +2770,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/private": {
+        "/api/chats/group/{chatID}/leave": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new private chat with another user. If it already exists, returns the existing chat.",
+                "description": "Leave a group chat. Owner cannot leave without transferring ownership first.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2882,16 +2787,14 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Create Private Chat",
+                "summary": "Leave Group",
                 "parameters": [
                     {
-                        "description": "Create Private Chat Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreatePrivateChatRequest"
-                        }
+                        "type": "string",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2906,7 +2809,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.ChatResponse"
+                                            "$ref": "#/definitions/model.MessageResponse"
                                         }
                                     }
                                 }
@@ -2946,14 +2849,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/{chatID}/messages": {
+        "/api/chats/group/{chatID}/members": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of messages from a chat. Supports bidirectional pagination and jumping to a specific message.",
+                "description": "Search for members in a group chat by username or full name.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2961,40 +2864,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "chat"
                 ],
-                "summary": "Get Messages",
+                "summary": "Search Group Members",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Chat ID (UUID)",
+                        "description": "Group Chat ID (UUID)",
                         "name": "chatID",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Pagination cursor (Base64 encoded message ID)",
-                        "name": "cursor",
+                        "description": "Search query",
+                        "name": "query",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Jump to message ID (UUID)",
-                        "name": "around_message_id",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Number of messages to fetch (default 20, max 50)",
+                        "description": "Number of items per page (default 20, max 50)",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pagination direction: 'older' (default) or 'newer'",
-                        "name": "direction",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3011,7 +2908,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/model.MessageResponse"
+                                                "$ref": "#/definitions/model.GroupMemberDTO"
                                             }
                                         }
                                     }
@@ -3037,6 +2934,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -3050,16 +2953,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/chats/{id}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed information about a single chat.",
+                "description": "Add new members to a group chat. Only owners or admins can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3069,14 +2970,23 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Get Chat by ID",
+                "summary": "Add Member to Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Chat ID (UUID)",
-                        "name": "id",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Add Member Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AddGroupMemberRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -3091,7 +3001,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.ChatListResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -3110,12 +3023,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -3131,14 +3056,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/{id}/hide": {
+        "/api/chats/group/{chatID}/members/{userID}/kick": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Hide a private chat from the chat list. It will reappear if a new message is sent or received.",
+                "description": "Kick a member from a group chat. Only owners or admins can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3148,12 +3073,19 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Hide Chat",
+                "summary": "Kick Member from Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Chat ID (UUID)",
-                        "name": "id",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target User ID (UUID)",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
@@ -3162,7 +3094,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helper.ResponseSuccess"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3177,6 +3121,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3198,14 +3148,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/chats/{id}/read": {
-            "post": {
+        "/api/chats/group/{chatID}/members/{userID}/role": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mark all messages in a chat as read for the current user.",
+                "description": "Promote or demote a group member. Only owner can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3215,21 +3165,49 @@ const docTemplate = `{
                 "tags": [
                     "chat"
                 ],
-                "summary": "Mark Chat as Read",
+                "summary": "Update Member Role",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Chat ID (UUID)",
-                        "name": "id",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target User ID (UUID)",
+                        "name": "userID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Update Role Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateGroupMemberRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helper.ResponseSuccess"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3244,6 +3222,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3265,14 +3249,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/media/upload": {
-            "post": {
+        "/api/chats/group/{chatID}/requests": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a pending media row and upload URL for direct storage upload.",
+                "description": "List the pending join requests of a group, oldest first. Only owners or admins can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3280,18 +3264,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "media"
+                    "chat"
                 ],
-                "summary": "Create Media Upload URL",
+                "summary": "List Group Join Requests",
                 "parameters": [
                     {
-                        "description": "Upload request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UploadMediaRequest"
-                        }
+                        "type": "string",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3300,13 +3294,16 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.UploadMediaResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.GroupJoinRequestDTO"
+                                            }
                                         }
                                     }
                                 }
@@ -3325,6 +3322,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -3340,14 +3349,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/media/{mediaID}/complete": {
+        "/api/chats/group/{chatID}/requests/{userID}/approve": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Verify the uploaded object exists and mark pending media as completed.",
+                "description": "Approve the pending join request of a user, adding them to the group. Only owners or admins can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3355,14 +3364,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "media"
+                    "chat"
                 ],
-                "summary": "Complete Media Upload",
+                "summary": "Approve Group Join Request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Media ID (UUID)",
-                        "name": "mediaID",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Requesting User ID (UUID)",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
@@ -3379,7 +3395,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.MediaDTO"
+                                            "$ref": "#/definitions/model.GroupJoinRequestDTO"
                                         }
                                     }
                                 }
@@ -3410,6 +3426,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3419,14 +3447,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/media/{mediaID}/url": {
-            "get": {
+        "/api/chats/group/{chatID}/requests/{userID}/decline": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a new presigned URL for a media file if the previous one has expired.",
+                "description": "Decline the pending join request of a user. The user can request to join again later. Only owners or admins can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3434,14 +3462,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "media"
+                    "chat"
                 ],
-                "summary": "Refresh Media URL",
+                "summary": "Decline Group Join Request",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Media ID (UUID)",
-                        "name": "mediaID",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Requesting User ID (UUID)",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
@@ -3458,7 +3493,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.MediaURLResponse"
+                                            "$ref": "#/definitions/model.GroupJoinRequestDTO"
                                         }
                                     }
                                 }
@@ -3489,6 +3524,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3498,14 +3539,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/messages": {
+        "/api/chats/group/{chatID}/transfer": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send a message to a chat (private or group). Supports text and attachments (via IDs).",
+                "description": "Transfer group ownership to another member. Only owner can perform this action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3513,17 +3554,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "chat"
                 ],
-                "summary": "Send Message",
+                "summary": "Transfer Ownership",
                 "parameters": [
                     {
-                        "description": "Send Message Request",
+                        "type": "string",
+                        "description": "Group Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer Ownership Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.SendMessageRequest"
+                            "$ref": "#/definitions/model.TransferGroupOwnershipRequest"
                         }
                     }
                 ],
@@ -3558,6 +3606,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3579,14 +3633,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/messages/{messageID}": {
+        "/api/chats/pinned": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Edit a message's content and/or attachments.",
+                "description": "Set the order of the current user's pinned chats. The request must list every pinned chat exactly once. Other devices receive a chat.pin event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3594,24 +3648,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "chat"
                 ],
-                "summary": "Edit Message",
+                "summary": "Reorder Pinned Chats",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Message ID (UUID)",
-                        "name": "messageID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Edit Message Request",
+                        "description": "Pinned chats in the new order",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.EditMessageRequest"
+                            "$ref": "#/definitions/model.ReorderPinnedChatsRequest"
                         }
                     }
                 ],
@@ -3627,7 +3674,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.MessageResponse"
+                                            "$ref": "#/definitions/model.PinnedChatsResponse"
                                         }
                                     }
                                 }
@@ -3646,18 +3693,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -3671,14 +3706,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/chats/private": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Soft delete a message. Only the sender can delete their own message.",
+                "description": "Create a new private chat with another user. If it already exists, returns the existing chat. Using your own ID as target opens your Saved Messages chat, created on first use.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3686,23 +3723,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "chat"
                 ],
-                "summary": "Delete Message",
+                "summary": "Create Private Chat",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Message ID (UUID)",
-                        "name": "messageID",
-                        "in": "path",
-                        "required": true
+                        "description": "Create Private Chat Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreatePrivateChatRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helper.ResponseSuccess"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3717,12 +3768,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3744,9 +3789,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/otp/send": {
+        "/api/chats/read": {
             "post": {
-                "description": "Sends an OTP code to the user's email for registration or password reset.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark every chat of the current user as read, including chats marked as unread, in one step. Each chat emits a chat.read event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3754,20 +3804,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "otp"
-                ],
-                "summary": "Send OTP",
-                "parameters": [
-                    {
-                        "description": "Send OTP Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.SendOTPRequest"
-                        }
-                    }
+                    "chat"
                 ],
+                "summary": "Mark All Chats as Read",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3775,20 +3814,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseSuccess"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/helper.ResponseError"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
@@ -3804,18 +3831,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
                     }
                 }
             }
         },
-        "/api/reports": {
-            "post": {
+        "/api/chats/{chatID}/mentions/next": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Report a message, group, or user. Server will automatically snapshot the evidence.",
+                "description": "Get the oldest message in a group chat that mentions the current user (directly or with @all) and comes after their read position. Pass the returned message ID as after_message_id to continue to the following mention, and as around_message_id to load the surrounding messages.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3823,25 +3856,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "report"
+                    "message"
                 ],
-                "summary": "Create Report",
+                "summary": "Get Next Unread Mention",
                 "parameters": [
                     {
-                        "description": "Report Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.CreateReportRequest"
-                        }
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Continue after this message ID (UUID)",
+                        "name": "after_message_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helper.ResponseSuccess"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3856,6 +3905,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -3877,14 +3932,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/current": {
+        "/api/chats/{chatID}/messages": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get the currently logged-in user's profile.",
+                "description": "Get a paginated list of messages from a chat. Supports bidirectional pagination and jumping to a specific message.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3892,34 +3947,82 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "message"
+                ],
+                "summary": "Get Messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor (Base64 encoded message ID)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Jump to message ID (UUID)",
+                        "name": "around_message_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of messages to fetch (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination direction: 'older' (default) or 'newer'",
+                        "name": "direction",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get Current User",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.UserDTO"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -3935,14 +4038,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/profile": {
-            "put": {
+        "/api/chats/{chatID}/messages/search": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update user's full name, bio, and avatar.",
+                "description": "Full-text search inside a single chat. Results are ranked by relevance; use message_id as around_message_id to jump to a hit.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3950,18 +4053,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "message"
                 ],
-                "summary": "Update User Profile",
+                "summary": "Search Chat Messages",
                 "parameters": [
                     {
-                        "description": "Profile update",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateProfileRequest"
-                        }
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "chatID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search query (2-100 characters)",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results to fetch (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3970,13 +4090,16 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.UserDTO"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageSearchResultDTO"
+                                            }
                                         }
                                     }
                                 }
@@ -3995,6 +4118,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -4016,14 +4145,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/users": {
+        "/api/chats/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Search users by name or email with cursor-based pagination.",
+                "description": "Get detailed information about a single chat.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4031,39 +4160,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chat"
                 ],
-                "summary": "Search Users",
+                "summary": "Get Chat by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query (name or email)",
-                        "name": "query",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pagination cursor",
-                        "name": "cursor",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of items per page (default 10, max 50)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Include private chat ID if exists",
-                        "name": "include_chat_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Exclude users who are members of this group chat ID",
-                        "name": "exclude_chat_id",
-                        "in": "query"
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4072,16 +4178,13 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/model.UserDTO"
-                                            }
+                                            "$ref": "#/definitions/model.ChatListResponse"
                                         }
                                     }
                                 }
@@ -4100,6 +4203,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -4115,14 +4224,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/users/blocked": {
-            "get": {
+        "/api/chats/{id}/archive": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of users blocked by the current user.",
+                "description": "Move a chat to the archive of the current user. The chat returns to the inbox when a new message arrives unless keep_archived is set. Unread counts are kept.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4130,27 +4239,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chat"
                 ],
-                "summary": "Get Blocked Users",
+                "summary": "Archive Chat",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query (name or username)",
-                        "name": "query",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pagination cursor",
-                        "name": "cursor",
-                        "in": "query"
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Number of items per page (default 10, max 50)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Archive options",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.ArchiveChatRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -4159,16 +4265,13 @@ const docTemplate = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/model.UserDTO"
-                                            }
+                                            "$ref": "#/definitions/model.ChatListResponse"
                                         }
                                     }
                                 }
@@ -4187,6 +4290,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -4202,14 +4317,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/users/{id}": {
-            "get": {
+        "/api/chats/{id}/exports": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get another user's profile by ID.",
+                "description": "Queue a zip archive of the chat history visible to the current user, with messages.json, an HTML transcript and attachments. Private chat participants can export their chats, groups can only be exported by their owner. A chat.export WebSocket event with a download link is sent when the archive is ready; archives are kept for 7 days.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4217,13 +4332,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chat"
                 ],
-                "summary": "Get User Profile",
+                "summary": "Request Chat Export",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
+                        "description": "Chat ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4241,7 +4356,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/model.UserDTO"
+                                            "$ref": "#/definitions/model.ChatExportResponse"
                                         }
                                     }
                                 }
@@ -4260,12 +4375,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -4281,14 +4408,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/users/{id}/block": {
+        "/api/chats/{id}/hide": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Block a user by their ID.",
+                "description": "Hide a private chat from the chat list. It will reappear if a new message is sent or received. Saved Messages cannot be hidden.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4296,13 +4423,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chat"
                 ],
-                "summary": "Block a User",
+                "summary": "Hide Chat",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID to block (UUID)",
+                        "description": "Chat ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4348,14 +4475,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/users/{id}/unblock": {
-            "post": {
+        "/api/chats/{id}/mute": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Unblock a user by their ID.",
+                "description": "Mute alerts of a chat for the current user, until muted_until or until unmuted. Muted chats still count unread messages; message events carry meta.muted so clients can skip the alert. In group chats, notify_mentions keeps alerting on mentions. Other devices receive a chat.mute event.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4363,23 +4490,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "chat"
                 ],
-                "summary": "Unblock a User",
+                "summary": "Mute Chat",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID to unblock (UUID)",
+                        "description": "Chat ID (UUID)",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Mute settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MuteChatRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/helper.ResponseSuccess"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -4394,6 +4542,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
                     "429": {
                         "description": "Too Many Requests",
                         "schema": {
@@ -4407,25 +4567,56 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/ws": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Upgrade HTTP connection to WebSocket. Requires Bearer token in Authorization header or 'token' query param.",
+                "description": "Restore alerts of a muted chat for the current user. Other devices receive a chat.mute event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "websocket"
+                    "chat"
+                ],
+                "summary": "Unmute Chat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "WebSocket Connection",
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
                         }
                     },
                     "401": {
@@ -4433,86 +4624,4528 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/helper.ResponseError"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "helper.PaginationMeta": {
-            "type": "object",
-            "properties": {
-                "has_next": {
-                    "type": "boolean"
-                },
-                "has_prev": {
-                    "type": "boolean"
-                },
-                "next_cursor": {
-                    "type": "string"
-                },
-                "prev_cursor": {
-                    "type": "string"
-                }
-            }
-        },
-        "helper.ResponseError": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                }
-            }
-        },
-        "helper.ResponseSuccess": {
-            "type": "object",
-            "properties": {
-                "data": {}
-            }
-        },
-        "helper.ResponseWithPagination": {
-            "type": "object",
-            "properties": {
-                "data": {},
-                "meta": {
-                    "$ref": "#/definitions/helper.PaginationMeta"
-                }
-            }
         },
-        "model.AddGroupMemberRequest": {
-            "type": "object",
-            "required": [
-                "user_ids"
-            ],
-            "properties": {
-                "user_ids": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
+        "/api/chats/{id}/pin": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     }
-                }
-            }
-        },
-        "model.AdminUserDetailResponse": {
-            "type": "object",
-            "properties": {
-                "avatar": {
-                    "type": "string"
-                },
-                "ban_reason": {
-                    "type": "string"
-                },
-                "banned_until": {
-                    "type": "string"
-                },
-                "bio": {
+                ],
+                "description": "Pin a chat to the top of the current user's chat list, ahead of the chats pinned before it. At most 5 chats can be pinned. Other devices receive a chat.pin event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Pin Chat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PinnedChatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/pins": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pin a message in a chat. In groups only admins and owners can pin. A chat can have at most 10 pinned messages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Pin Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message to pin",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PinMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/pins/{messageID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unpin a message from a chat. In groups only admins and owners can unpin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Unpin Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark all messages in a chat as read for the current user. Also clears a mark as unread.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Mark Chat as Read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/ttl": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turn disappearing messages on or off for a chat. New messages expire message_ttl seconds after they are sent; 0 turns it off. In groups only admins and owners can change it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Update Message TTL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message TTL",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateMessageTTLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/unarchive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move an archived chat back to the inbox of the current user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Unarchive Chat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/unpin": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a chat from the current user's pinned chats. Other devices receive a chat.pin event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Unpin Chat",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PinnedChatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/chats/{id}/unread": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Flag a chat as unread for the current user until it is read again. Unread counts and read receipts are not changed. Other devices receive a chat.read event with marked_unread set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "chat"
+                ],
+                "summary": "Mark Chat as Unread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Chat ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ChatListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/media/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a pending media row and upload URL for direct storage upload.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Create Media Upload URL",
+                "parameters": [
+                    {
+                        "description": "Upload request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UploadMediaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UploadMediaResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/media/{mediaID}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Verify the uploaded object exists and mark pending media as completed. Voice notes must be Ogg/Opus or M4A audio; their duration and waveform are read from the file.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Complete Media Upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Media ID (UUID)",
+                        "name": "mediaID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MediaDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/media/{mediaID}/url": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a new presigned URL for a media file if the previous one has expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Refresh Media URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Media ID (UUID)",
+                        "name": "mediaID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MediaURLResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send a message to a chat (private or group). Supports text and attachments (via IDs). Sending again with the same client_message_id returns the existing message instead of creating a duplicate. In groups with slow mode, members who sent a message too recently get a 429 with the seconds left.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Send Message",
+                "parameters": [
+                    {
+                        "description": "Send Message Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SendMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/contacts": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Share another AtoiTalk user as a contact card. Users who blocked the sender, or were blocked by them, cannot be shared.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Send Contact",
+                "parameters": [
+                    {
+                        "description": "Send Contact Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SendContactRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/locations": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Share a position in a chat. With live_period the location stays live for that many seconds and the sender can keep moving it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Send Location",
+                "parameters": [
+                    {
+                        "description": "Send Location Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SendLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/polls": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send a poll message to a chat. Quizzes need exactly one correct_option and allow a single answer. The question is also used as the message content.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Send Poll",
+                "parameters": [
+                    {
+                        "description": "Send Poll Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SendPollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/scheduled": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List your scheduled messages that have not been sent yet, ordered by send_at. Includes failed ones with their failure reason.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Get Scheduled Messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only list messages scheduled for this chat (UUID)",
+                        "name": "chat_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.ScheduledMessageResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compose a message now and have it sent at send_at. The payload is checked with the same rules as Send Message, both now and when it is sent. A user can have at most 100 scheduled messages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Schedule Message",
+                "parameters": [
+                    {
+                        "description": "Schedule Message Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ScheduleMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ScheduledMessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/scheduled/{scheduledID}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the content, attachments, reply target and send_at of a scheduled message. Updating a failed message schedules it again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Update Scheduled Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Scheduled Message ID (UUID)",
+                        "name": "scheduledID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Scheduled Message Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateScheduledMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.ScheduledMessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancel a scheduled message before it is sent.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Cancel Scheduled Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Scheduled Message ID (UUID)",
+                        "name": "scheduledID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search across every chat the user participates in. Results are ranked by relevance; use message_id as around_message_id to jump to a hit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Search Messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (2-100 characters)",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of results to fetch (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageSearchResultDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Edit a message's content and/or attachments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Edit Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Edit Message Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.EditMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Soft delete a message. Only the sender can delete their own message.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Delete Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/forward": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Forward a message to one or more chats (max 10). Attachments are shared with the original and each copy records its original sender and chat.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Forward Message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Forward Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ForwardMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/location": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move the position of a live location while its live period lasts. Only the sender can update it. Chat members receive a message.location event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Update Live Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Live Location Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateLiveLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.LocationDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/location/stop": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "End the live period of a live location early. The last position is kept. Chat members receive a message.location event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Stop Live Location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Location message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.LocationDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/poll/close": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop a poll from accepting votes. Allowed for the poll creator and, in groups, for admins and owners.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Close Poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PollDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/poll/votes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of users who voted on a poll, optionally filtered by option. Not available for anonymous polls.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Get Poll Votes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by option ID (UUID)",
+                        "name": "option_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of votes to fetch (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.PollVoteDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the current user's votes on a poll with the given options. Only one option is allowed unless the poll is multiple choice. Quiz answers cannot be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Vote on Poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Poll Vote Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PollVoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PollDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove all of the current user's votes on an open poll. Not available for quizzes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Retract Poll Vote",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.PollDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/reactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of users who reacted to a message, optionally filtered by emoji.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Get Reactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by emoji",
+                        "name": "emoji",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of reactions to fetch (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageReactionDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "React to a message with an emoji. Adding the same emoji twice is a no-op.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Add Reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reaction Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MessageReactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageReactionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove one of your own emoji reactions from a message.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Remove Reaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reaction Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MessageReactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageReactionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/receipts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the group members who have read a message and those whose client only acknowledged receiving it. Only available in group chats; the sender is not listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Get Message Receipts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.MessageReceiptsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the earlier versions of an edited message, oldest first. Each revision holds the content and attachments the message had before an edit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Get Message Revisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageRevisionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/messages/{messageID}/thread": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of replies to a message, oldest first. Deleted replies are excluded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Get Thread",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Thread root message ID (UUID)",
+                        "name": "messageID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of replies to fetch (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.MessageResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/otp/send": {
+            "post": {
+                "description": "Sends an OTP code to the user's email for registration or password reset.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "otp"
+                ],
+                "summary": "Send OTP",
+                "parameters": [
+                    {
+                        "description": "Send OTP Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SendOTPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report a message, group, or user. Server will automatically snapshot the evidence.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "report"
+                ],
+                "summary": "Create Report",
+                "parameters": [
+                    {
+                        "description": "Report Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/current": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the currently logged-in user's profile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get Current User",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UserDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/profile": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update user's full name, bio, and avatar.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update User Profile",
+                "parameters": [
+                    {
+                        "description": "Profile update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UserDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Search users by name or email with cursor-based pagination.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Search Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (name or email)",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include private chat ID if exists",
+                        "name": "include_chat_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exclude users who are members of this group chat ID",
+                        "name": "exclude_chat_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.UserDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/blocked": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of users blocked by the current user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get Blocked Users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (name or username)",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pagination cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items per page (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseWithPagination"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.UserDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get another user's profile by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get User Profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/helper.ResponseSuccess"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/model.UserDTO"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/block": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Block a user by their ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Block a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID to block (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/{id}/unblock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unblock a user by their ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Unblock a User",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID to unblock (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upgrade HTTP connection to WebSocket. Requires Bearer token in Authorization header or 'token' query param. Besides receiving events, clients can send command frames to send, edit and delete messages and to mark chats as read or hidden (see the AsyncAPI document).",
+                "tags": [
+                    "websocket"
+                ],
+                "summary": "WebSocket Connection",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/helper.ResponseError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "helper.PaginationMeta": {
+            "type": "object",
+            "properties": {
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_prev": {
+                    "type": "boolean"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "helper.ResponseError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "helper.ResponseSuccess": {
+            "type": "object",
+            "properties": {
+                "data": {}
+            }
+        },
+        "helper.ResponseWithPagination": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "meta": {
+                    "$ref": "#/definitions/helper.PaginationMeta"
+                }
+            }
+        },
+        "model.AddGroupMemberRequest": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.AdminUserDetailResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "ban_reason": {
+                    "type": "string"
+                },
+                "banned_until": {
+                    "type": "string"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_banned": {
+                    "type": "boolean"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "total_groups": {
+                    "type": "integer"
+                },
+                "total_messages": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AdminUserListResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_banned": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ArchiveChatRequest": {
+            "type": "object",
+            "properties": {
+                "keep_archived": {
+                    "description": "Keep the chat archived when new messages arrive instead of moving it back to the inbox",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ArchiveSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "chat_count": {
+                    "description": "Number of archived chats",
+                    "type": "integer"
+                },
+                "unread_chat_count": {
+                    "description": "Number of archived chats with unread messages",
+                    "type": "integer"
+                },
+                "unread_count": {
+                    "description": "Unread messages across all archived chats",
+                    "type": "integer"
+                },
+                "unread_mention_count": {
+                    "description": "Unread mentions across all archived group chats",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/model.UserDTO"
+                }
+            }
+        },
+        "model.BanUserRequest": {
+            "type": "object",
+            "required": [
+                "reason",
+                "target_user_id"
+            ],
+            "properties": {
+                "duration_hours": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "target_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ChangeEmailRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "email"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "new_password"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8
+                },
+                "old_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ChatExportResponse": {
+            "type": "object",
+            "properties": {
+                "chat_id": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "description": "Short-lived link to the zip archive, only for completed exports that have not expired",
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "Time after which the archive is deleted",
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "file_size": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message_count": {
+                    "description": "Number of messages in the archive, set once completed",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "pending, processing, completed or failed",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ChatFolderRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "archived": {
+                    "description": "Rule: only archived chats when true, only chats in the inbox when false. Omit to match both.",
+                    "type": "boolean"
+                },
+                "chat_ids": {
+                    "description": "Chats always in the folder, regardless of the rules",
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "chat_type": {
+                    "description": "Rule: only private or only group chats. Omit to match both.",
+                    "type": "string",
+                    "enum": [
+                        "private",
+                        "group"
+                    ]
+                },
+                "muted": {
+                    "description": "Rule: only muted chats when true, only unmuted chats when false. Omit to match both.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "unread_only": {
+                    "description": "Rule: only chats with unread messages or marked as unread",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ChatFolderResponse": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "chat_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "chat_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "muted": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "unread_chat_count": {
+                    "description": "Number of chats in the folder with unread messages or marked as unread",
+                    "type": "integer"
+                },
+                "unread_count": {
+                    "description": "Unread messages across all chats in the folder",
+                    "type": "integer"
+                },
+                "unread_mention_count": {
+                    "description": "Unread mentions across all group chats in the folder",
+                    "type": "integer"
+                },
+                "unread_only": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ChatListResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "description": "Avatar URL of the group or the other user",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description of the group",
+                    "type": "string"
+                },
+                "hidden_at": {
+                    "description": "Timestamp when the current user hid the chat",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invite_code": {
+                    "description": "Invite code for the group, available if public or for admins",
+                    "type": "string"
+                },
+                "invite_expires_at": {
+                    "description": "Expiration timestamp for the invite code",
+                    "type": "string"
+                },
+                "is_archived": {
+                    "description": "Indicates if the chat is in the current user's archive",
+                    "type": "boolean"
+                },
+                "is_blocked_by_me": {
+                    "description": "Indicates if the current user has blocked the other user",
+                    "type": "boolean"
+                },
+                "is_blocked_by_other": {
+                    "description": "Indicates if the other user has blocked the current user",
+                    "type": "boolean"
+                },
+                "is_marked_unread": {
+                    "description": "Indicates if the current user marked the chat as unread. Cleared when the chat is read.",
+                    "type": "boolean"
+                },
+                "is_muted": {
+                    "description": "Indicates if the current user muted the chat",
+                    "type": "boolean"
+                },
+                "is_online": {
+                    "description": "Indicates if the other user is currently online",
+                    "type": "boolean"
+                },
+                "is_pinned": {
+                    "description": "Indicates if the current user pinned the chat to the top of the chat list",
+                    "type": "boolean"
+                },
+                "is_public": {
+                    "description": "Indicates if the group is public",
+                    "type": "boolean"
+                },
+                "is_saved_messages": {
+                    "description": "Indicates if the chat is the current user's Saved Messages self-chat, which has no other user",
+                    "type": "boolean"
+                },
+                "keep_archived": {
+                    "description": "Indicates if the chat stays archived when new messages arrive",
+                    "type": "boolean"
+                },
+                "last_message": {
+                    "description": "Preview of the last message in the chat",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.MessageResponse"
+                        }
+                    ]
+                },
+                "last_read_at": {
+                    "description": "Timestamp when the current user last read the chat",
+                    "type": "string"
+                },
+                "member_count": {
+                    "description": "Total number of members in the group",
+                    "type": "integer"
+                },
+                "message_ttl": {
+                    "description": "Lifetime in seconds of new messages, omitted when messages do not disappear",
+                    "type": "integer"
+                },
+                "muted_until": {
+                    "description": "End of the mute, omitted when muted until unmuted",
+                    "type": "string"
+                },
+                "my_role": {
+                    "description": "Role of the current user in the group (owner, admin, member)",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name of the group or the other user in a private chat",
+                    "type": "string"
+                },
+                "notify_mentions": {
+                    "description": "Indicates if mentions still alert while muted, only for group chats",
+                    "type": "boolean"
+                },
+                "other_last_read_at": {
+                    "description": "Timestamp when the other user last read the chat",
+                    "type": "string"
+                },
+                "other_user_id": {
+                    "description": "ID of the other user in a private chat",
+                    "type": "string"
+                },
+                "other_user_is_banned": {
+                    "description": "Indicates if the other user is currently banned",
+                    "type": "boolean"
+                },
+                "other_user_is_deleted": {
+                    "description": "Indicates if the other user's account has been deleted",
+                    "type": "boolean"
+                },
+                "pinned_messages": {
+                    "description": "Currently pinned messages, most recently pinned first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PinnedMessageDTO"
+                    }
+                },
+                "requires_approval": {
+                    "description": "Indicates if joining the group needs the approval of an admin",
+                    "type": "boolean"
+                },
+                "slow_mode_seconds": {
+                    "description": "Seconds members must wait between messages in a group, omitted when slow mode is off",
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unread_count": {
+                    "description": "Number of unread messages for the current user",
+                    "type": "integer"
+                },
+                "unread_mention_count": {
+                    "description": "Number of unread messages mentioning the current user, only for group chats",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ChatResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ContactDTO": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "description": "\"Deleted User\" when the shared user was deleted",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "Omitted when the shared user was deleted",
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateGroupChatRequest": {
+            "type": "object",
+            "required": [
+                "member_ids",
+                "name"
+            ],
+            "properties": {
+                "avatar_media_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "is_public": {
+                    "type": "boolean"
+                },
+                "member_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                }
+            }
+        },
+        "model.CreatePrivateChatRequest": {
+            "type": "object",
+            "required": [
+                "target_user_id"
+            ],
+            "properties": {
+                "target_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateReportRequest": {
+            "type": "object",
+            "required": [
+                "reason",
+                "target_type"
+            ],
+            "properties": {
+                "chat_id": {
+                    "description": "Chat entity UUID of the group to report. Required when target_type is \"group\".\nThis is the ID visible in chat lists, not the internal GroupChat entity ID.",
                     "type": "string"
                 },
-                "created_at": {
+                "description": {
                     "type": "string"
                 },
-                "email": {
+                "message_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "type": "string",
+                    "enum": [
+                        "message",
+                        "group",
+                        "user"
+                    ]
+                },
+                "target_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DashboardStatsResponse": {
+            "type": "object",
+            "properties": {
+                "active_reports": {
+                    "type": "integer"
+                },
+                "total_groups": {
+                    "type": "integer"
+                },
+                "total_messages": {
+                    "type": "integer"
+                },
+                "total_users": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.DeleteAccountRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EditMessageRequest": {
+            "type": "object",
+            "properties": {
+                "attachment_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 4000
+                }
+            }
+        },
+        "model.ForwardMessageRequest": {
+            "type": "object",
+            "required": [
+                "chat_ids"
+            ],
+            "properties": {
+                "chat_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.ForwardedFromDTO": {
+            "type": "object",
+            "properties": {
+                "chat_id": {
+                    "description": "ID of the chat the message was originally sent in",
+                    "type": "string"
+                },
+                "sender_id": {
+                    "description": "ID of the original sender.\nCan be null when the original sender account is deleted.",
+                    "type": "string"
+                },
+                "sender_name": {
+                    "description": "Display name of the original sender.\nCan be \"Deleted User\" when the original sender account is deleted.",
+                    "type": "string"
+                }
+            }
+        },
+        "model.GoogleAuthInitResponse": {
+            "type": "object",
+            "properties": {
+                "auth_url": {
+                    "type": "string"
+                },
+                "expires_in_seconds": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GoogleLoginRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "state"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16
+                }
+            }
+        },
+        "model.GroupInviteResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "invite_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GroupJoinRequestDTO": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "chat_id": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "requested_at": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status of the request: pending, approved or declined",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GroupMemberDTO": {
+            "type": "object",
+            "properties": {
+                "avatar": {
                     "type": "string"
                 },
                 "full_name": {
@@ -4521,565 +9154,672 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "is_banned": {
+                "is_banned": {
+                    "type": "boolean"
+                },
+                "joined_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GroupPreviewDTO": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_public": {
+                    "type": "boolean"
+                },
+                "member_count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "requires_approval": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.JoinGroupByInviteRequest": {
+            "type": "object",
+            "required": [
+                "invite_code"
+            ],
+            "properties": {
+                "invite_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LinkPreviewDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Public URL of the preview image",
+                    "type": "string"
+                },
+                "site_name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LocationDTO": {
+            "type": "object",
+            "properties": {
+                "accuracy": {
+                    "description": "Horizontal accuracy in meters",
+                    "type": "number"
+                },
+                "is_live": {
+                    "description": "Whether the position can still change",
                     "type": "boolean"
                 },
-                "last_seen_at": {
-                    "type": "string"
+                "latitude": {
+                    "type": "number"
                 },
-                "role": {
+                "live_until": {
+                    "description": "End of the live period, only for live locations",
                     "type": "string"
                 },
-                "total_groups": {
-                    "type": "integer"
+                "longitude": {
+                    "type": "number"
                 },
-                "total_messages": {
-                    "type": "integer"
+                "updated_at": {
+                    "description": "Time of the latest position update of a live location",
+                    "type": "string"
                 },
-                "username": {
+                "venue_name": {
                     "type": "string"
                 }
             }
         },
-        "model.AdminUserListResponse": {
+        "model.LoginRequest": {
             "type": "object",
+            "required": [
+                "captcha_token",
+                "email",
+                "password"
+            ],
             "properties": {
-                "created_at": {
+                "captcha_token": {
                     "type": "string"
                 },
                 "email": {
                     "type": "string"
                 },
-                "full_name": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8
+                }
+            }
+        },
+        "model.MediaDTO": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "description": "Length of a voice note in milliseconds",
+                    "type": "integer"
+                },
+                "file_name": {
                     "type": "string"
                 },
+                "file_size": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
-                "is_banned": {
-                    "type": "boolean"
+                "mime_type": {
+                    "type": "string"
                 },
-                "role": {
+                "original_name": {
                     "type": "string"
                 },
-                "username": {
+                "upload_status": {
+                    "type": "string"
+                },
+                "url": {
                     "type": "string"
+                },
+                "waveform": {
+                    "description": "Up to 64 bars between 0 and 255 for drawing a voice note, approximated from packet sizes",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "model.AuthResponse": {
+        "model.MediaURLResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "url": {
                     "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/model.UserDTO"
                 }
             }
         },
-        "model.BanUserRequest": {
+        "model.MentionDTO": {
             "type": "object",
-            "required": [
-                "reason",
-                "target_user_id"
-            ],
             "properties": {
-                "duration_hours": {
-                    "type": "integer",
-                    "minimum": 0
+                "length": {
+                    "type": "integer"
                 },
-                "reason": {
+                "offset": {
+                    "description": "Position of the mention in the content, in UTF-16 code units including the \"@\"",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "user for an @username mention, all for @all",
                     "type": "string"
                 },
-                "target_user_id": {
+                "user_id": {
+                    "description": "Mentioned user, omitted for @all",
                     "type": "string"
                 }
             }
         },
-        "model.ChangeEmailRequest": {
+        "model.MessageReactionDTO": {
             "type": "object",
-            "required": [
-                "code",
-                "email"
-            ],
             "properties": {
-                "code": {
+                "avatar": {
                     "type": "string"
                 },
-                "email": {
+                "created_at": {
+                    "type": "string"
+                },
+                "emoji": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "model.ChangePasswordRequest": {
+        "model.MessageReactionRequest": {
             "type": "object",
             "required": [
-                "confirm_password",
-                "new_password"
+                "emoji"
             ],
             "properties": {
-                "confirm_password": {
+                "emoji": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MessageReactionResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Either \"added\" or \"removed\"",
                     "type": "string"
                 },
-                "new_password": {
-                    "type": "string",
-                    "maxLength": 72,
-                    "minLength": 8
+                "chat_id": {
+                    "type": "string"
                 },
-                "old_password": {
+                "emoji": {
+                    "type": "string"
+                },
+                "message_id": {
+                    "type": "string"
+                },
+                "my_reactions": {
+                    "description": "Emojis the requesting user has reacted with, only in the REST response",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reactions": {
+                    "description": "Aggregated reaction counts after the change",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReactionSummaryDTO"
+                    }
+                },
+                "user_id": {
+                    "description": "User who added or removed the reaction",
                     "type": "string"
                 }
             }
         },
-        "model.ChatListResponse": {
+        "model.MessageReceiptDTO": {
             "type": "object",
             "properties": {
                 "avatar": {
-                    "description": "Avatar URL of the group or the other user",
-                    "type": "string"
-                },
-                "description": {
-                    "description": "Description of the group",
                     "type": "string"
                 },
-                "hidden_at": {
-                    "description": "Timestamp when the current user hid the chat",
+                "full_name": {
                     "type": "string"
                 },
-                "id": {
+                "user_id": {
                     "type": "string"
                 },
-                "invite_code": {
-                    "description": "Invite code for the group, available if public or for admins",
+                "username": {
                     "type": "string"
+                }
+            }
+        },
+        "model.MessageReceiptsResponse": {
+            "type": "object",
+            "properties": {
+                "delivered_to": {
+                    "description": "Members whose client acknowledged the message but who have not read it yet",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MessageReceiptDTO"
+                    }
                 },
-                "invite_expires_at": {
-                    "description": "Expiration timestamp for the invite code",
+                "message_id": {
                     "type": "string"
                 },
-                "is_blocked_by_me": {
-                    "description": "Indicates if the current user has blocked the other user",
-                    "type": "boolean"
+                "read_by": {
+                    "description": "Members whose read position is at or past the message",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MessageReceiptDTO"
+                    }
+                }
+            }
+        },
+        "model.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "action_data": {
+                    "description": "Metadata for system messages (usually empty for regular messages).\n\nCommon payload shapes by message type:\n\n\tsystem_create:\n\t{\n\t  \"initial_name\": \"My Group\"\n\t}\n\n\tsystem_rename:\n\t{\n\t  \"old_name\": \"Old Group Name\",\n\t  \"new_name\": \"New Group Name\"\n\t}\n\n\tsystem_description:\n\t{\n\t  \"old_description\": \"Old Desc\",\n\t  \"new_description\": \"New Desc\"\n\t}\n\n\tsystem_avatar:\n\t{\n\t  \"action\": \"updated\" // or \"removed\"\n\t}\n\n\tsystem_visibility:\n\t{\n\t  \"new_visibility\": \"public\" // or \"private\"\n\t}\n\n\tsystem_add / system_kick:\n\t{\n\t  \"target_id\": \"u1...\",\n\t  \"actor_id\": \"u2...\",\n\t  \"target_name\": \"Alice\", // optional enrichment\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_promote / system_demote:\n\t{\n\t  \"target_id\": \"u1...\",\n\t  \"actor_id\": \"u2...\",\n\t  \"new_role\": \"admin\", // or \"member\", \"owner\"\n\t  \"action\": \"ownership_transferred\", // optional\n\t  \"target_name\": \"Alice\", // optional enrichment\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_pin / system_unpin:\n\t{\n\t  \"message_id\": \"m1...\",\n\t  \"actor_id\": \"u2...\",\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_ttl:\n\t{\n\t  \"message_ttl\": 86400, // seconds, 0 when disappearing messages were turned off\n\t  \"actor_id\": \"u2...\",\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_slow_mode:\n\t{\n\t  \"slow_mode_seconds\": 30, // 0 when slow mode was turned off\n\t  \"actor_id\": \"u2...\",\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_join:\n\t{\n\t  \"actor_id\": \"u2...\", // only when an admin approved a join request\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_join_approval:\n\t{\n\t  \"requires_approval\": true, // false when join approval was turned off\n\t  \"actor_id\": \"u2...\",\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\nNotes:\n- target_name and actor_name are enrichment fields added by service layer.\n- target_id and actor_id can be removed when referenced users are deleted.",
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "is_blocked_by_other": {
-                    "description": "Indicates if the other user has blocked the current user",
-                    "type": "boolean"
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MediaDTO"
+                    }
                 },
-                "is_online": {
-                    "description": "Indicates if the other user is currently online",
-                    "type": "boolean"
+                "chat_id": {
+                    "type": "string"
                 },
-                "is_public": {
-                    "description": "Indicates if the group is public",
-                    "type": "boolean"
+                "client_message_id": {
+                    "description": "ID the sender attached to the send request, used to reconcile optimistic UI entries",
+                    "type": "string"
                 },
-                "last_message": {
-                    "description": "Preview of the last message in the chat",
+                "contact": {
+                    "description": "Shared user, only for contact messages",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/model.MessageResponse"
+                            "$ref": "#/definitions/model.ContactDTO"
                         }
                     ]
                 },
-                "last_read_at": {
-                    "description": "Timestamp when the current user last read the chat",
+                "content": {
                     "type": "string"
                 },
-                "member_count": {
-                    "description": "Total number of members in the group",
-                    "type": "integer"
-                },
-                "my_role": {
-                    "description": "Role of the current user in the group (owner, admin, member)",
+                "created_at": {
                     "type": "string"
                 },
-                "name": {
-                    "description": "Name of the group or the other user in a private chat",
+                "deleted_at": {
                     "type": "string"
                 },
-                "other_last_read_at": {
-                    "description": "Timestamp when the other user last read the chat",
+                "edited_at": {
                     "type": "string"
                 },
-                "other_user_id": {
-                    "description": "ID of the other user in a private chat",
+                "expires_at": {
+                    "description": "Timestamp after which the message disappears, set when the chat has a message TTL",
                     "type": "string"
                 },
-                "other_user_is_banned": {
-                    "description": "Indicates if the other user is currently banned",
-                    "type": "boolean"
-                },
-                "other_user_is_deleted": {
-                    "description": "Indicates if the other user's account has been deleted",
-                    "type": "boolean"
+                "forwarded_from": {
+                    "description": "Origin of a forwarded message, omitted for messages that were not forwarded",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ForwardedFromDTO"
+                        }
+                    ]
                 },
-                "type": {
+                "id": {
                     "type": "string"
                 },
-                "unread_count": {
-                    "description": "Number of unread messages for the current user",
-                    "type": "integer"
-                }
-            }
-        },
-        "model.ChatResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+                "last_reply_at": {
+                    "description": "Timestamp of the latest reply to this message",
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "link_preview": {
+                    "description": "Preview of the first URL in the content.\nFetched in the background, so it arrives in a later message.update event.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.LinkPreviewDTO"
+                        }
+                    ]
                 },
-                "type": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.CreateGroupChatRequest": {
-            "type": "object",
-            "required": [
-                "member_ids",
-                "name"
-            ],
-            "properties": {
-                "avatar_media_id": {
-                    "type": "string"
+                "location": {
+                    "description": "Shared position, only for location messages",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.LocationDTO"
+                        }
+                    ]
                 },
-                "description": {
-                    "type": "string",
-                    "maxLength": 255
+                "member_count": {
+                    "description": "Total number of members in the group, only for group chats",
+                    "type": "integer"
                 },
-                "is_public": {
-                    "type": "boolean"
+                "mentions": {
+                    "description": "Users mentioned in the content, ordered by position",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MentionDTO"
+                    }
                 },
-                "member_ids": {
+                "my_reactions": {
+                    "description": "Emojis the requesting user has reacted with.\nNot included in WebSocket payloads since they are shared by all chat members.",
                     "type": "array",
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
-                }
-            }
-        },
-        "model.CreatePrivateChatRequest": {
-            "type": "object",
-            "required": [
-                "target_user_id"
-            ],
-            "properties": {
-                "target_user_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.CreateReportRequest": {
-            "type": "object",
-            "required": [
-                "reason",
-                "target_type"
-            ],
-            "properties": {
-                "chat_id": {
-                    "description": "Chat entity UUID of the group to report. Required when target_type is \"group\".\nThis is the ID visible in chat lists, not the internal GroupChat entity ID.",
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
+                "poll": {
+                    "description": "Question, options and vote tallies, only for poll messages",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.PollDTO"
+                        }
+                    ]
                 },
-                "message_id": {
-                    "type": "string"
+                "reactions": {
+                    "description": "Aggregated reaction counts, ordered by count descending",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ReactionSummaryDTO"
+                    }
                 },
-                "reason": {
-                    "type": "string"
+                "reply_count": {
+                    "description": "Number of non-deleted replies to this message",
+                    "type": "integer"
                 },
-                "target_type": {
-                    "type": "string",
-                    "enum": [
-                        "message",
-                        "group",
-                        "user"
+                "reply_to": {
+                    "description": "Preview of the message this message is replying to",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ReplyPreviewDTO"
+                        }
                     ]
                 },
-                "target_user_id": {
+                "sender_avatar": {
+                    "description": "Avatar URL of the sender",
                     "type": "string"
-                }
-            }
-        },
-        "model.DashboardStatsResponse": {
-            "type": "object",
-            "properties": {
-                "active_reports": {
-                    "type": "integer"
                 },
-                "total_groups": {
-                    "type": "integer"
+                "sender_id": {
+                    "description": "ID of the sender when available.\nCan be null when sender account is deleted or relation is unavailable.",
+                    "type": "string"
                 },
-                "total_messages": {
-                    "type": "integer"
+                "sender_name": {
+                    "description": "Display name of the sender.\nCan be \"Deleted User\" when sender account is deleted.",
+                    "type": "string"
                 },
-                "total_users": {
-                    "type": "integer"
-                }
-            }
-        },
-        "model.DeleteAccountRequest": {
-            "type": "object",
-            "properties": {
-                "password": {
+                "sender_role": {
+                    "description": "Role of the sender in the group (owner, admin, member)",
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "model.EditMessageRequest": {
+        "model.MessageRevisionResponse": {
             "type": "object",
             "properties": {
-                "attachment_ids": {
+                "attachments": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.MediaDTO"
                     }
                 },
                 "content": {
-                    "type": "string",
-                    "maxLength": 4000
-                }
-            }
-        },
-        "model.GoogleAuthInitResponse": {
-            "type": "object",
-            "properties": {
-                "auth_url": {
                     "type": "string"
                 },
-                "expires_in_seconds": {
-                    "type": "integer"
-                },
-                "state": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.GoogleLoginRequest": {
-            "type": "object",
-            "required": [
-                "code",
-                "state"
-            ],
-            "properties": {
-                "code": {
+                "id": {
                     "type": "string"
                 },
-                "state": {
-                    "type": "string",
-                    "maxLength": 128,
-                    "minLength": 16
-                }
-            }
-        },
-        "model.GroupInviteResponse": {
-            "type": "object",
-            "properties": {
-                "expires_at": {
+                "message_id": {
                     "type": "string"
                 },
-                "invite_code": {
+                "replaced_at": {
+                    "description": "When this version was replaced by an edit",
                     "type": "string"
                 }
             }
         },
-        "model.GroupMemberDTO": {
+        "model.MessageSearchResultDTO": {
             "type": "object",
             "properties": {
-                "avatar": {
+                "chat_id": {
                     "type": "string"
                 },
-                "full_name": {
+                "chat_name": {
+                    "description": "Group name, or the other participant's name for private chats",
                     "type": "string"
                 },
-                "id": {
+                "chat_type": {
                     "type": "string"
                 },
-                "is_banned": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
                 },
-                "joined_at": {
+                "message_id": {
+                    "description": "ID of the matched message, usable as around_message_id when fetching messages",
                     "type": "string"
                 },
-                "role": {
+                "rank": {
+                    "description": "Relevance score, higher is better",
+                    "type": "number"
+                },
+                "sender_id": {
                     "type": "string"
                 },
-                "user_id": {
+                "sender_name": {
                     "type": "string"
                 },
-                "username": {
+                "snippet": {
+                    "description": "HTML-escaped excerpt of the message with matched terms wrapped in \u003cmark\u003e\u003c/mark\u003e",
                     "type": "string"
                 }
             }
         },
-        "model.GroupPreviewDTO": {
+        "model.MuteChatRequest": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
+                "muted_until": {
+                    "description": "End of the mute, must be in the future. Omit to mute until unmuted.",
                     "type": "string"
                 },
-                "is_public": {
+                "notify_mentions": {
+                    "description": "Keep alerting on mentions of the current user while muted, only for group chats",
                     "type": "boolean"
-                },
-                "member_count": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
                 }
             }
         },
-        "model.JoinGroupByInviteRequest": {
+        "model.PinMessageRequest": {
             "type": "object",
             "required": [
-                "invite_code"
+                "message_id"
             ],
             "properties": {
-                "invite_code": {
+                "message_id": {
                     "type": "string"
                 }
             }
         },
-        "model.LoginRequest": {
+        "model.PinnedChatsResponse": {
             "type": "object",
-            "required": [
-                "captcha_token",
-                "email",
-                "password"
-            ],
             "properties": {
-                "captcha_token": {
-                    "type": "string"
-                },
-                "email": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 72,
-                    "minLength": 8
+                "chat_ids": {
+                    "description": "Pinned chats of the current user in display order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "model.MediaDTO": {
+        "model.PinnedMessageDTO": {
             "type": "object",
             "properties": {
-                "category": {
-                    "type": "string"
+                "message": {
+                    "description": "Preview of the pinned message",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ReplyPreviewDTO"
+                        }
+                    ]
                 },
-                "file_name": {
+                "pinned_at": {
                     "type": "string"
                 },
-                "file_size": {
-                    "type": "integer"
-                },
-                "id": {
+                "pinned_by_id": {
+                    "description": "ID of the user who pinned the message.\nCan be null when that user's account is deleted.",
                     "type": "string"
+                }
+            }
+        },
+        "model.PollDTO": {
+            "type": "object",
+            "properties": {
+                "anonymous": {
+                    "type": "boolean"
                 },
-                "mime_type": {
+                "closed_at": {
+                    "description": "Set once the poll was closed by hand or reached closes_at",
                     "type": "string"
                 },
-                "original_name": {
+                "closes_at": {
                     "type": "string"
                 },
-                "upload_status": {
+                "id": {
                     "type": "string"
                 },
-                "url": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.MediaURLResponse": {
-            "type": "object",
-            "properties": {
-                "url": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.MessageResponse": {
-            "type": "object",
-            "properties": {
-                "action_data": {
-                    "description": "Metadata for system messages (usually empty for regular messages).\n\nCommon payload shapes by message type:\n\n\tsystem_create:\n\t{\n\t  \"initial_name\": \"My Group\"\n\t}\n\n\tsystem_rename:\n\t{\n\t  \"old_name\": \"Old Group Name\",\n\t  \"new_name\": \"New Group Name\"\n\t}\n\n\tsystem_description:\n\t{\n\t  \"old_description\": \"Old Desc\",\n\t  \"new_description\": \"New Desc\"\n\t}\n\n\tsystem_avatar:\n\t{\n\t  \"action\": \"updated\" // or \"removed\"\n\t}\n\n\tsystem_visibility:\n\t{\n\t  \"new_visibility\": \"public\" // or \"private\"\n\t}\n\n\tsystem_add / system_kick:\n\t{\n\t  \"target_id\": \"u1...\",\n\t  \"actor_id\": \"u2...\",\n\t  \"target_name\": \"Alice\", // optional enrichment\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\n\tsystem_promote / system_demote:\n\t{\n\t  \"target_id\": \"u1...\",\n\t  \"actor_id\": \"u2...\",\n\t  \"new_role\": \"admin\", // or \"member\", \"owner\"\n\t  \"action\": \"ownership_transferred\", // optional\n\t  \"target_name\": \"Alice\", // optional enrichment\n\t  \"actor_name\": \"Bob\" // optional enrichment\n\t}\n\nNotes:\n- target_name and actor_name are enrichment fields added by service layer.\n- target_id and actor_id can be removed when referenced users are deleted.",
-                    "type": "object",
-                    "additionalProperties": true
+                "is_closed": {
+                    "type": "boolean"
                 },
-                "attachments": {
+                "multiple_choice": {
+                    "type": "boolean"
+                },
+                "my_votes": {
+                    "description": "Options the requesting user voted for.\nNot included in WebSocket payloads since they are shared by all chat members.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.MediaDTO"
+                        "type": "string"
                     }
                 },
-                "chat_id": {
-                    "type": "string"
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.PollOptionDTO"
+                    }
                 },
-                "content": {
+                "question": {
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "string"
+                "quiz": {
+                    "type": "boolean"
                 },
-                "deleted_at": {
+                "total_voters": {
+                    "description": "Number of distinct users who voted",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.PollOptionDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "edited_at": {
-                    "type": "string"
+                "is_correct": {
+                    "description": "Whether this is the answer of a quiz. Only revealed to the quiz creator,\nto users who already answered and to everyone once the quiz is closed.",
+                    "type": "boolean"
                 },
-                "id": {
+                "text": {
                     "type": "string"
                 },
-                "member_count": {
-                    "description": "Total number of members in the group, only for group chats",
+                "vote_count": {
                     "type": "integer"
+                }
+            }
+        },
+        "model.PollVoteDTO": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
                 },
-                "reply_to": {
-                    "description": "Preview of the message this message is replying to",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/model.ReplyPreviewDTO"
-                        }
-                    ]
-                },
-                "sender_avatar": {
-                    "description": "Avatar URL of the sender",
+                "created_at": {
                     "type": "string"
                 },
-                "sender_id": {
-                    "description": "ID of the sender when available.\nCan be null when sender account is deleted or relation is unavailable.",
+                "full_name": {
                     "type": "string"
                 },
-                "sender_name": {
-                    "description": "Display name of the sender.\nCan be \"Deleted User\" when sender account is deleted.",
+                "option_id": {
                     "type": "string"
                 },
-                "sender_role": {
-                    "description": "Role of the sender in the group (owner, admin, member)",
+                "user_id": {
                     "type": "string"
                 },
-                "type": {
+                "username": {
                     "type": "string"
                 }
             }
         },
+        "model.PollVoteRequest": {
+            "type": "object",
+            "required": [
+                "option_ids"
+            ],
+            "properties": {
+                "option_ids": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "model.PublicGroupDTO": {
             "type": "object",
             "properties": {
@@ -5103,6 +9843,20 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "requires_approval": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.ReactionSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "emoji": {
+                    "type": "string"
                 }
             }
         },
@@ -5143,6 +9897,24 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ReorderPinnedChatsRequest": {
+            "type": "object",
+            "required": [
+                "chat_ids"
+            ],
+            "properties": {
+                "chat_ids": {
+                    "description": "Every pinned chat exactly once, in the new order",
+                    "type": "array",
+                    "maxItems": 5,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "model.ReplyPreviewDTO": {
             "type": "object",
             "properties": {
@@ -5151,6 +9923,9 @@ const docTemplate = `{
                     "type": "object",
                     "additionalProperties": true
                 },
+                "contact": {
+                    "$ref": "#/definitions/model.ContactDTO"
+                },
                 "content": {
                     "type": "string"
                 },
@@ -5163,6 +9938,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "location": {
+                    "$ref": "#/definitions/model.LocationDTO"
+                },
                 "sender_id": {
                     "type": "string"
                 },
@@ -5194,6 +9972,13 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "message_revisions": {
+                    "description": "Earlier versions of the reported message, oldest first.\nOnly set for target_type \"message\" while the message still exists.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MessageRevisionResponse"
+                    }
+                },
                 "reason": {
                     "type": "string"
                 },
@@ -5341,6 +10126,143 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ScheduleMessageRequest": {
+            "type": "object",
+            "required": [
+                "chat_id",
+                "send_at"
+            ],
+            "properties": {
+                "attachment_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "chat_id": {
+                    "type": "string"
+                },
+                "client_message_id": {
+                    "description": "Optional ID generated by the client. Retrying a send with the same ID in\nthe same chat returns the message created by the first attempt.\nIgnored for scheduled messages.",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 4000
+                },
+                "reply_to_id": {
+                    "type": "string"
+                },
+                "send_at": {
+                    "description": "Time at which the message is sent, must be in the future",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ScheduledMessageResponse": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MediaDTO"
+                    }
+                },
+                "chat_id": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "failure_reason": {
+                    "description": "Reason the send failed, only set for failed messages",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reply_to_id": {
+                    "type": "string"
+                },
+                "send_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending, or failed when the message could no longer be sent at send_at.\nUpdating a failed message schedules it again.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SendContactRequest": {
+            "type": "object",
+            "required": [
+                "chat_id",
+                "user_id"
+            ],
+            "properties": {
+                "chat_id": {
+                    "type": "string"
+                },
+                "reply_to_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "AtoiTalk user to share",
+                    "type": "string"
+                }
+            }
+        },
+        "model.SendLocationRequest": {
+            "type": "object",
+            "required": [
+                "accuracy",
+                "chat_id",
+                "latitude",
+                "longitude"
+            ],
+            "properties": {
+                "accuracy": {
+                    "description": "Horizontal accuracy in meters",
+                    "type": "number",
+                    "maximum": 100000,
+                    "minimum": 0
+                },
+                "chat_id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "live_period": {
+                    "description": "Share a live location for this many seconds. The sender can move the\nposition until the period ends.",
+                    "type": "integer",
+                    "maximum": 28800,
+                    "minimum": 60
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "reply_to_id": {
+                    "type": "string"
+                },
+                "venue_name": {
+                    "description": "Name of the place, for example a restaurant or a meeting point",
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
         "model.SendMessageRequest": {
             "type": "object",
             "required": [
@@ -5356,6 +10278,11 @@ const docTemplate = `{
                 "chat_id": {
                     "type": "string"
                 },
+                "client_message_id": {
+                    "description": "Optional ID generated by the client. Retrying a send with the same ID in\nthe same chat returns the message created by the first attempt.\nIgnored for scheduled messages.",
+                    "type": "string",
+                    "maxLength": 64
+                },
                 "content": {
                     "type": "string",
                     "maxLength": 4000
@@ -5389,6 +10316,56 @@ const docTemplate = `{
                 }
             }
         },
+        "model.SendPollRequest": {
+            "type": "object",
+            "required": [
+                "chat_id",
+                "options",
+                "question"
+            ],
+            "properties": {
+                "anonymous": {
+                    "description": "Hide who voted for which option",
+                    "type": "boolean"
+                },
+                "chat_id": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "description": "Time at which the poll stops accepting votes, must be in the future",
+                    "type": "string"
+                },
+                "correct_option": {
+                    "description": "Index in options of the correct answer, required for quizzes",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "multiple_choice": {
+                    "description": "Allow voting for more than one option, not available for quizzes",
+                    "type": "boolean"
+                },
+                "options": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 2,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "question": {
+                    "type": "string",
+                    "maxLength": 300
+                },
+                "quiz": {
+                    "description": "Quizzes have a single correct option and votes cannot be changed",
+                    "type": "boolean"
+                },
+                "reply_to_id": {
+                    "type": "string"
+                }
+            }
+        },
         "model.TransferGroupOwnershipRequest": {
             "type": "object",
             "required": [
@@ -5420,6 +10397,16 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 100,
                     "minLength": 3
+                },
+                "requires_approval": {
+                    "description": "When true, joining by invite code or as a public group creates a join request for admins to review.",
+                    "type": "boolean"
+                },
+                "slow_mode_seconds": {
+                    "description": "Seconds members must wait between messages, 0 turns slow mode off. Admins and owners are exempt.",
+                    "type": "integer",
+                    "maximum": 3600,
+                    "minimum": 0
                 }
             }
         },
@@ -5438,6 +10425,43 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UpdateLiveLocationRequest": {
+            "type": "object",
+            "required": [
+                "accuracy",
+                "latitude",
+                "longitude"
+            ],
+            "properties": {
+                "accuracy": {
+                    "description": "Horizontal accuracy in meters",
+                    "type": "number",
+                    "maximum": 100000,
+                    "minimum": 0
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                }
+            }
+        },
+        "model.UpdateMessageTTLRequest": {
+            "type": "object",
+            "properties": {
+                "message_ttl": {
+                    "description": "Lifetime in seconds of new messages (5 seconds to 1 year), 0 turns disappearing messages off",
+                    "type": "integer",
+                    "maximum": 31536000,
+                    "minimum": 5
+                }
+            }
+        },
         "model.UpdateProfileRequest": {
             "type": "object",
             "required": [
@@ -5466,6 +10490,30 @@ const docTemplate = `{
                 }
             }
         },
+        "model.UpdateScheduledMessageRequest": {
+            "type": "object",
+            "required": [
+                "send_at"
+            ],
+            "properties": {
+                "attachment_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 4000
+                },
+                "reply_to_id": {
+                    "type": "string"
+                },
+                "send_at": {
+                    "type": "string"
+                }
+            }
+        },
         "model.UploadMediaRequest": {
             "type": "object",
             "required": [
@@ -5495,7 +10543,8 @@ const docTemplate = `{
                     "enum": [
                         "message_attachment",
                         "user_avatar",
-                        "group_avatar"
+                        "group_avatar",
+                        "voice"
                     ]
                 }
             }
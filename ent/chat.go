@@ -31,6 +31,8 @@ type Chat struct {
 	LastMessageID *uuid.UUID `json:"last_message_id,omitempty"`
 	// LastMessageAt holds the value of the "last_message_at" field.
 	LastMessageAt *time.Time `json:"last_message_at,omitempty"`
+	// MessageTTL holds the value of the "message_ttl" field.
+	MessageTTL *int `json:"message_ttl,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -49,9 +51,19 @@ type ChatEdges struct {
 	GroupChat *GroupChat `json:"group_chat,omitempty"`
 	// LastMessage holds the value of the last_message edge.
 	LastMessage *Message `json:"last_message,omitempty"`
+	// PinnedMessages holds the value of the pinned_messages edge.
+	PinnedMessages []*PinnedMessage `json:"pinned_messages,omitempty"`
+	// ScheduledMessages holds the value of the scheduled_messages edge.
+	ScheduledMessages []*ScheduledMessage `json:"scheduled_messages,omitempty"`
+	// Exports holds the value of the exports edge.
+	Exports []*ChatExport `json:"exports,omitempty"`
+	// PinnedBy holds the value of the pinned_by edge.
+	PinnedBy []*PinnedChat `json:"pinned_by,omitempty"`
+	// Folders holds the value of the folders edge.
+	Folders []*ChatFolderChat `json:"folders,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [9]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -96,6 +108,51 @@ func (e ChatEdges) LastMessageOrErr() (*Message, error) {
 	return nil, &NotLoadedError{edge: "last_message"}
 }
 
+// PinnedMessagesOrErr returns the PinnedMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) PinnedMessagesOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[4] {
+		return e.PinnedMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "pinned_messages"}
+}
+
+// ScheduledMessagesOrErr returns the ScheduledMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) ScheduledMessagesOrErr() ([]*ScheduledMessage, error) {
+	if e.loadedTypes[5] {
+		return e.ScheduledMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_messages"}
+}
+
+// ExportsOrErr returns the Exports value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) ExportsOrErr() ([]*ChatExport, error) {
+	if e.loadedTypes[6] {
+		return e.Exports, nil
+	}
+	return nil, &NotLoadedError{edge: "exports"}
+}
+
+// PinnedByOrErr returns the PinnedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) PinnedByOrErr() ([]*PinnedChat, error) {
+	if e.loadedTypes[7] {
+		return e.PinnedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "pinned_by"}
+}
+
+// FoldersOrErr returns the Folders value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatEdges) FoldersOrErr() ([]*ChatFolderChat, error) {
+	if e.loadedTypes[8] {
+		return e.Folders, nil
+	}
+	return nil, &NotLoadedError{edge: "folders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Chat) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -103,6 +160,8 @@ func (*Chat) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case chat.FieldLastMessageID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case chat.FieldMessageTTL:
+			values[i] = new(sql.NullInt64)
 		case chat.FieldType:
 			values[i] = new(sql.NullString)
 		case chat.FieldCreatedAt, chat.FieldUpdatedAt, chat.FieldLastMessageAt, chat.FieldDeletedAt:
@@ -162,6 +221,13 @@ func (_m *Chat) assignValues(columns []string, values []any) error {
 				_m.LastMessageAt = new(time.Time)
 				*_m.LastMessageAt = value.Time
 			}
+		case chat.FieldMessageTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_ttl", values[i])
+			} else if value.Valid {
+				_m.MessageTTL = new(int)
+				*_m.MessageTTL = int(value.Int64)
+			}
 		case chat.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -202,6 +268,31 @@ func (_m *Chat) QueryLastMessage() *MessageQuery {
 	return NewChatClient(_m.config).QueryLastMessage(_m)
 }
 
+// QueryPinnedMessages queries the "pinned_messages" edge of the Chat entity.
+func (_m *Chat) QueryPinnedMessages() *PinnedMessageQuery {
+	return NewChatClient(_m.config).QueryPinnedMessages(_m)
+}
+
+// QueryScheduledMessages queries the "scheduled_messages" edge of the Chat entity.
+func (_m *Chat) QueryScheduledMessages() *ScheduledMessageQuery {
+	return NewChatClient(_m.config).QueryScheduledMessages(_m)
+}
+
+// QueryExports queries the "exports" edge of the Chat entity.
+func (_m *Chat) QueryExports() *ChatExportQuery {
+	return NewChatClient(_m.config).QueryExports(_m)
+}
+
+// QueryPinnedBy queries the "pinned_by" edge of the Chat entity.
+func (_m *Chat) QueryPinnedBy() *PinnedChatQuery {
+	return NewChatClient(_m.config).QueryPinnedBy(_m)
+}
+
+// QueryFolders queries the "folders" edge of the Chat entity.
+func (_m *Chat) QueryFolders() *ChatFolderChatQuery {
+	return NewChatClient(_m.config).QueryFolders(_m)
+}
+
 // Update returns a builder for updating this Chat.
 // Note that you need to call Chat.Unwrap() before calling this method if this Chat
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -244,6 +335,11 @@ func (_m *Chat) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.MessageTTL; v != nil {
+		builder.WriteString("message_ttl=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
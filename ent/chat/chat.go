@@ -26,6 +26,8 @@ const (
 	FieldLastMessageID = "last_message_id"
 	// FieldLastMessageAt holds the string denoting the last_message_at field in the database.
 	FieldLastMessageAt = "last_message_at"
+	// FieldMessageTTL holds the string denoting the message_ttl field in the database.
+	FieldMessageTTL = "message_ttl"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
@@ -36,6 +38,16 @@ const (
 	EdgeGroupChat = "group_chat"
 	// EdgeLastMessage holds the string denoting the last_message edge name in mutations.
 	EdgeLastMessage = "last_message"
+	// EdgePinnedMessages holds the string denoting the pinned_messages edge name in mutations.
+	EdgePinnedMessages = "pinned_messages"
+	// EdgeScheduledMessages holds the string denoting the scheduled_messages edge name in mutations.
+	EdgeScheduledMessages = "scheduled_messages"
+	// EdgeExports holds the string denoting the exports edge name in mutations.
+	EdgeExports = "exports"
+	// EdgePinnedBy holds the string denoting the pinned_by edge name in mutations.
+	EdgePinnedBy = "pinned_by"
+	// EdgeFolders holds the string denoting the folders edge name in mutations.
+	EdgeFolders = "folders"
 	// Table holds the table name of the chat in the database.
 	Table = "chats"
 	// MessagesTable is the table that holds the messages relation/edge.
@@ -66,6 +78,41 @@ const (
 	LastMessageInverseTable = "messages"
 	// LastMessageColumn is the table column denoting the last_message relation/edge.
 	LastMessageColumn = "last_message_id"
+	// PinnedMessagesTable is the table that holds the pinned_messages relation/edge.
+	PinnedMessagesTable = "pinned_messages"
+	// PinnedMessagesInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinnedMessagesInverseTable = "pinned_messages"
+	// PinnedMessagesColumn is the table column denoting the pinned_messages relation/edge.
+	PinnedMessagesColumn = "chat_id"
+	// ScheduledMessagesTable is the table that holds the scheduled_messages relation/edge.
+	ScheduledMessagesTable = "scheduled_messages"
+	// ScheduledMessagesInverseTable is the table name for the ScheduledMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledmessage" package.
+	ScheduledMessagesInverseTable = "scheduled_messages"
+	// ScheduledMessagesColumn is the table column denoting the scheduled_messages relation/edge.
+	ScheduledMessagesColumn = "chat_id"
+	// ExportsTable is the table that holds the exports relation/edge.
+	ExportsTable = "chat_exports"
+	// ExportsInverseTable is the table name for the ChatExport entity.
+	// It exists in this package in order to avoid circular dependency with the "chatexport" package.
+	ExportsInverseTable = "chat_exports"
+	// ExportsColumn is the table column denoting the exports relation/edge.
+	ExportsColumn = "chat_id"
+	// PinnedByTable is the table that holds the pinned_by relation/edge.
+	PinnedByTable = "pinned_chats"
+	// PinnedByInverseTable is the table name for the PinnedChat entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedchat" package.
+	PinnedByInverseTable = "pinned_chats"
+	// PinnedByColumn is the table column denoting the pinned_by relation/edge.
+	PinnedByColumn = "chat_id"
+	// FoldersTable is the table that holds the folders relation/edge.
+	FoldersTable = "chat_folder_chats"
+	// FoldersInverseTable is the table name for the ChatFolderChat entity.
+	// It exists in this package in order to avoid circular dependency with the "chatfolderchat" package.
+	FoldersInverseTable = "chat_folder_chats"
+	// FoldersColumn is the table column denoting the folders relation/edge.
+	FoldersColumn = "chat_id"
 )
 
 // Columns holds all SQL columns for chat fields.
@@ -76,6 +123,7 @@ var Columns = []string{
 	FieldType,
 	FieldLastMessageID,
 	FieldLastMessageAt,
+	FieldMessageTTL,
 	FieldDeletedAt,
 }
 
@@ -96,6 +144,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// MessageTTLValidator is a validator for the "message_ttl" field. It is called by the builders before save.
+	MessageTTLValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -156,6 +206,11 @@ func ByLastMessageAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastMessageAt, opts...).ToFunc()
 }
 
+// ByMessageTTL orders the results by the message_ttl field.
+func ByMessageTTL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageTTL, opts...).ToFunc()
+}
+
 // ByDeletedAt orders the results by the deleted_at field.
 func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
@@ -195,6 +250,76 @@ func ByLastMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLastMessageStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPinnedMessagesCount orders the results by pinned_messages count.
+func ByPinnedMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinnedMessagesStep(), opts...)
+	}
+}
+
+// ByPinnedMessages orders the results by pinned_messages terms.
+func ByPinnedMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinnedMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledMessagesCount orders the results by scheduled_messages count.
+func ByScheduledMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledMessagesStep(), opts...)
+	}
+}
+
+// ByScheduledMessages orders the results by scheduled_messages terms.
+func ByScheduledMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByExportsCount orders the results by exports count.
+func ByExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExportsStep(), opts...)
+	}
+}
+
+// ByExports orders the results by exports terms.
+func ByExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPinnedByCount orders the results by pinned_by count.
+func ByPinnedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinnedByStep(), opts...)
+	}
+}
+
+// ByPinnedBy orders the results by pinned_by terms.
+func ByPinnedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinnedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFoldersCount orders the results by folders count.
+func ByFoldersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFoldersStep(), opts...)
+	}
+}
+
+// ByFolders orders the results by folders terms.
+func ByFolders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFoldersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMessagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -223,3 +348,38 @@ func newLastMessageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, LastMessageTable, LastMessageColumn),
 	)
 }
+func newPinnedMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinnedMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinnedMessagesTable, PinnedMessagesColumn),
+	)
+}
+func newScheduledMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledMessagesTable, ScheduledMessagesColumn),
+	)
+}
+func newExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExportsTable, ExportsColumn),
+	)
+}
+func newPinnedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinnedByInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinnedByTable, PinnedByColumn),
+	)
+}
+func newFoldersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FoldersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FoldersTable, FoldersColumn),
+	)
+}
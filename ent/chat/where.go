@@ -76,6 +76,11 @@ func LastMessageAt(v time.Time) predicate.Chat {
 	return predicate.Chat(sql.FieldEQ(FieldLastMessageAt, v))
 }
 
+// MessageTTL applies equality check predicate on the "message_ttl" field. It's identical to MessageTTLEQ.
+func MessageTTL(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldMessageTTL, v))
+}
+
 // DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
 func DeletedAt(v time.Time) predicate.Chat {
 	return predicate.Chat(sql.FieldEQ(FieldDeletedAt, v))
@@ -261,6 +266,56 @@ func LastMessageAtNotNil() predicate.Chat {
 	return predicate.Chat(sql.FieldNotNull(FieldLastMessageAt))
 }
 
+// MessageTTLEQ applies the EQ predicate on the "message_ttl" field.
+func MessageTTLEQ(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLNEQ applies the NEQ predicate on the "message_ttl" field.
+func MessageTTLNEQ(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldNEQ(FieldMessageTTL, v))
+}
+
+// MessageTTLIn applies the In predicate on the "message_ttl" field.
+func MessageTTLIn(vs ...int) predicate.Chat {
+	return predicate.Chat(sql.FieldIn(FieldMessageTTL, vs...))
+}
+
+// MessageTTLNotIn applies the NotIn predicate on the "message_ttl" field.
+func MessageTTLNotIn(vs ...int) predicate.Chat {
+	return predicate.Chat(sql.FieldNotIn(FieldMessageTTL, vs...))
+}
+
+// MessageTTLGT applies the GT predicate on the "message_ttl" field.
+func MessageTTLGT(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldGT(FieldMessageTTL, v))
+}
+
+// MessageTTLGTE applies the GTE predicate on the "message_ttl" field.
+func MessageTTLGTE(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldGTE(FieldMessageTTL, v))
+}
+
+// MessageTTLLT applies the LT predicate on the "message_ttl" field.
+func MessageTTLLT(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldLT(FieldMessageTTL, v))
+}
+
+// MessageTTLLTE applies the LTE predicate on the "message_ttl" field.
+func MessageTTLLTE(v int) predicate.Chat {
+	return predicate.Chat(sql.FieldLTE(FieldMessageTTL, v))
+}
+
+// MessageTTLIsNil applies the IsNil predicate on the "message_ttl" field.
+func MessageTTLIsNil() predicate.Chat {
+	return predicate.Chat(sql.FieldIsNull(FieldMessageTTL))
+}
+
+// MessageTTLNotNil applies the NotNil predicate on the "message_ttl" field.
+func MessageTTLNotNil() predicate.Chat {
+	return predicate.Chat(sql.FieldNotNull(FieldMessageTTL))
+}
+
 // DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
 func DeletedAtEQ(v time.Time) predicate.Chat {
 	return predicate.Chat(sql.FieldEQ(FieldDeletedAt, v))
@@ -403,6 +458,121 @@ func HasLastMessageWith(preds ...predicate.Message) predicate.Chat {
 	})
 }
 
+// HasPinnedMessages applies the HasEdge predicate on the "pinned_messages" edge.
+func HasPinnedMessages() predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PinnedMessagesTable, PinnedMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedMessagesWith applies the HasEdge predicate on the "pinned_messages" edge with a given conditions (other predicates).
+func HasPinnedMessagesWith(preds ...predicate.PinnedMessage) predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := newPinnedMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledMessages applies the HasEdge predicate on the "scheduled_messages" edge.
+func HasScheduledMessages() predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledMessagesTable, ScheduledMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledMessagesWith applies the HasEdge predicate on the "scheduled_messages" edge with a given conditions (other predicates).
+func HasScheduledMessagesWith(preds ...predicate.ScheduledMessage) predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := newScheduledMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExports applies the HasEdge predicate on the "exports" edge.
+func HasExports() predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExportsTable, ExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExportsWith applies the HasEdge predicate on the "exports" edge with a given conditions (other predicates).
+func HasExportsWith(preds ...predicate.ChatExport) predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := newExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPinnedBy applies the HasEdge predicate on the "pinned_by" edge.
+func HasPinnedBy() predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PinnedByTable, PinnedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedByWith applies the HasEdge predicate on the "pinned_by" edge with a given conditions (other predicates).
+func HasPinnedByWith(preds ...predicate.PinnedChat) predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := newPinnedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFolders applies the HasEdge predicate on the "folders" edge.
+func HasFolders() predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FoldersTable, FoldersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFoldersWith applies the HasEdge predicate on the "folders" edge with a given conditions (other predicates).
+func HasFoldersWith(preds ...predicate.ChatFolderChat) predicate.Chat {
+	return predicate.Chat(func(s *sql.Selector) {
+		step := newFoldersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Chat) predicate.Chat {
 	return predicate.Chat(sql.AndPredicates(predicates...))
@@ -4,9 +4,14 @@ package ent
 
 import (
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
 	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"context"
 	"errors"
 	"fmt"
@@ -89,6 +94,20 @@ func (_c *ChatCreate) SetNillableLastMessageAt(v *time.Time) *ChatCreate {
 	return _c
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (_c *ChatCreate) SetMessageTTL(v int) *ChatCreate {
+	_c.mutation.SetMessageTTL(v)
+	return _c
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableMessageTTL(v *int) *ChatCreate {
+	if v != nil {
+		_c.SetMessageTTL(*v)
+	}
+	return _c
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_c *ChatCreate) SetDeletedAt(v time.Time) *ChatCreate {
 	_c.mutation.SetDeletedAt(v)
@@ -175,6 +194,81 @@ func (_c *ChatCreate) SetLastMessage(v *Message) *ChatCreate {
 	return _c.SetLastMessageID(v.ID)
 }
 
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (_c *ChatCreate) AddPinnedMessageIDs(ids ...uuid.UUID) *ChatCreate {
+	_c.mutation.AddPinnedMessageIDs(ids...)
+	return _c
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (_c *ChatCreate) AddPinnedMessages(v ...*PinnedMessage) *ChatCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPinnedMessageIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (_c *ChatCreate) AddScheduledMessageIDs(ids ...uuid.UUID) *ChatCreate {
+	_c.mutation.AddScheduledMessageIDs(ids...)
+	return _c
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (_c *ChatCreate) AddScheduledMessages(v ...*ScheduledMessage) *ChatCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduledMessageIDs(ids...)
+}
+
+// AddExportIDs adds the "exports" edge to the ChatExport entity by IDs.
+func (_c *ChatCreate) AddExportIDs(ids ...uuid.UUID) *ChatCreate {
+	_c.mutation.AddExportIDs(ids...)
+	return _c
+}
+
+// AddExports adds the "exports" edges to the ChatExport entity.
+func (_c *ChatCreate) AddExports(v ...*ChatExport) *ChatCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExportIDs(ids...)
+}
+
+// AddPinnedByIDs adds the "pinned_by" edge to the PinnedChat entity by IDs.
+func (_c *ChatCreate) AddPinnedByIDs(ids ...uuid.UUID) *ChatCreate {
+	_c.mutation.AddPinnedByIDs(ids...)
+	return _c
+}
+
+// AddPinnedBy adds the "pinned_by" edges to the PinnedChat entity.
+func (_c *ChatCreate) AddPinnedBy(v ...*PinnedChat) *ChatCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPinnedByIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the ChatFolderChat entity by IDs.
+func (_c *ChatCreate) AddFolderIDs(ids ...uuid.UUID) *ChatCreate {
+	_c.mutation.AddFolderIDs(ids...)
+	return _c
+}
+
+// AddFolders adds the "folders" edges to the ChatFolderChat entity.
+func (_c *ChatCreate) AddFolders(v ...*ChatFolderChat) *ChatCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFolderIDs(ids...)
+}
+
 // Mutation returns the ChatMutation object of the builder.
 func (_c *ChatCreate) Mutation() *ChatMutation {
 	return _c.mutation
@@ -240,6 +334,11 @@ func (_c *ChatCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Chat.type": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.MessageTTL(); ok {
+		if err := chat.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Chat.message_ttl": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -292,6 +391,10 @@ func (_c *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_spec.SetField(chat.FieldLastMessageAt, field.TypeTime, value)
 		_node.LastMessageAt = &value
 	}
+	if value, ok := _c.mutation.MessageTTL(); ok {
+		_spec.SetField(chat.FieldMessageTTL, field.TypeInt, value)
+		_node.MessageTTL = &value
+	}
 	if value, ok := _c.mutation.DeletedAt(); ok {
 		_spec.SetField(chat.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -361,6 +464,86 @@ func (_c *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_node.LastMessageID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -461,6 +644,30 @@ func (u *ChatUpsert) ClearLastMessageAt() *ChatUpsert {
 	return u
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (u *ChatUpsert) SetMessageTTL(v int) *ChatUpsert {
+	u.Set(chat.FieldMessageTTL, v)
+	return u
+}
+
+// UpdateMessageTTL sets the "message_ttl" field to the value that was provided on create.
+func (u *ChatUpsert) UpdateMessageTTL() *ChatUpsert {
+	u.SetExcluded(chat.FieldMessageTTL)
+	return u
+}
+
+// AddMessageTTL adds v to the "message_ttl" field.
+func (u *ChatUpsert) AddMessageTTL(v int) *ChatUpsert {
+	u.Add(chat.FieldMessageTTL, v)
+	return u
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (u *ChatUpsert) ClearMessageTTL() *ChatUpsert {
+	u.SetNull(chat.FieldMessageTTL)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *ChatUpsert) SetDeletedAt(v time.Time) *ChatUpsert {
 	u.Set(chat.FieldDeletedAt, v)
@@ -589,6 +796,34 @@ func (u *ChatUpsertOne) ClearLastMessageAt() *ChatUpsertOne {
 	})
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (u *ChatUpsertOne) SetMessageTTL(v int) *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetMessageTTL(v)
+	})
+}
+
+// AddMessageTTL adds v to the "message_ttl" field.
+func (u *ChatUpsertOne) AddMessageTTL(v int) *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.AddMessageTTL(v)
+	})
+}
+
+// UpdateMessageTTL sets the "message_ttl" field to the value that was provided on create.
+func (u *ChatUpsertOne) UpdateMessageTTL() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateMessageTTL()
+	})
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (u *ChatUpsertOne) ClearMessageTTL() *ChatUpsertOne {
+	return u.Update(func(s *ChatUpsert) {
+		s.ClearMessageTTL()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *ChatUpsertOne) SetDeletedAt(v time.Time) *ChatUpsertOne {
 	return u.Update(func(s *ChatUpsert) {
@@ -887,6 +1122,34 @@ func (u *ChatUpsertBulk) ClearLastMessageAt() *ChatUpsertBulk {
 	})
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (u *ChatUpsertBulk) SetMessageTTL(v int) *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.SetMessageTTL(v)
+	})
+}
+
+// AddMessageTTL adds v to the "message_ttl" field.
+func (u *ChatUpsertBulk) AddMessageTTL(v int) *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.AddMessageTTL(v)
+	})
+}
+
+// UpdateMessageTTL sets the "message_ttl" field to the value that was provided on create.
+func (u *ChatUpsertBulk) UpdateMessageTTL() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.UpdateMessageTTL()
+	})
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (u *ChatUpsertBulk) ClearMessageTTL() *ChatUpsertBulk {
+	return u.Update(func(s *ChatUpsert) {
+		s.ClearMessageTTL()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *ChatUpsertBulk) SetDeletedAt(v time.Time) *ChatUpsertBulk {
 	return u.Update(func(s *ChatUpsert) {
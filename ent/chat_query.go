@@ -4,10 +4,15 @@ package ent
 
 import (
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -24,15 +29,20 @@ import (
 // ChatQuery is the builder for querying Chat entities.
 type ChatQuery struct {
 	config
-	ctx             *QueryContext
-	order           []chat.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Chat
-	withMessages    *MessageQuery
-	withPrivateChat *PrivateChatQuery
-	withGroupChat   *GroupChatQuery
-	withLastMessage *MessageQuery
-	modifiers       []func(*sql.Selector)
+	ctx                   *QueryContext
+	order                 []chat.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Chat
+	withMessages          *MessageQuery
+	withPrivateChat       *PrivateChatQuery
+	withGroupChat         *GroupChatQuery
+	withLastMessage       *MessageQuery
+	withPinnedMessages    *PinnedMessageQuery
+	withScheduledMessages *ScheduledMessageQuery
+	withExports           *ChatExportQuery
+	withPinnedBy          *PinnedChatQuery
+	withFolders           *ChatFolderChatQuery
+	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -157,6 +167,116 @@ func (_q *ChatQuery) QueryLastMessage() *MessageQuery {
 	return query
 }
 
+// QueryPinnedMessages chains the current query on the "pinned_messages" edge.
+func (_q *ChatQuery) QueryPinnedMessages() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.PinnedMessagesTable, chat.PinnedMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages chains the current query on the "scheduled_messages" edge.
+func (_q *ChatQuery) QueryScheduledMessages() *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.ScheduledMessagesTable, chat.ScheduledMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryExports chains the current query on the "exports" edge.
+func (_q *ChatQuery) QueryExports() *ChatExportQuery {
+	query := (&ChatExportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(chatexport.Table, chatexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.ExportsTable, chat.ExportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPinnedBy chains the current query on the "pinned_by" edge.
+func (_q *ChatQuery) QueryPinnedBy() *PinnedChatQuery {
+	query := (&PinnedChatClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(pinnedchat.Table, pinnedchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.PinnedByTable, chat.PinnedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFolders chains the current query on the "folders" edge.
+func (_q *ChatQuery) QueryFolders() *ChatFolderChatQuery {
+	query := (&ChatFolderChatClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, selector),
+			sqlgraph.To(chatfolderchat.Table, chatfolderchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.FoldersTable, chat.FoldersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Chat entity from the query.
 // Returns a *NotFoundError when no Chat was found.
 func (_q *ChatQuery) First(ctx context.Context) (*Chat, error) {
@@ -344,15 +464,20 @@ func (_q *ChatQuery) Clone() *ChatQuery {
 		return nil
 	}
 	return &ChatQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]chat.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.Chat{}, _q.predicates...),
-		withMessages:    _q.withMessages.Clone(),
-		withPrivateChat: _q.withPrivateChat.Clone(),
-		withGroupChat:   _q.withGroupChat.Clone(),
-		withLastMessage: _q.withLastMessage.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]chat.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Chat{}, _q.predicates...),
+		withMessages:          _q.withMessages.Clone(),
+		withPrivateChat:       _q.withPrivateChat.Clone(),
+		withGroupChat:         _q.withGroupChat.Clone(),
+		withLastMessage:       _q.withLastMessage.Clone(),
+		withPinnedMessages:    _q.withPinnedMessages.Clone(),
+		withScheduledMessages: _q.withScheduledMessages.Clone(),
+		withExports:           _q.withExports.Clone(),
+		withPinnedBy:          _q.withPinnedBy.Clone(),
+		withFolders:           _q.withFolders.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -404,6 +529,61 @@ func (_q *ChatQuery) WithLastMessage(opts ...func(*MessageQuery)) *ChatQuery {
 	return _q
 }
 
+// WithPinnedMessages tells the query-builder to eager-load the nodes that are connected to
+// the "pinned_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChatQuery) WithPinnedMessages(opts ...func(*PinnedMessageQuery)) *ChatQuery {
+	query := (&PinnedMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPinnedMessages = query
+	return _q
+}
+
+// WithScheduledMessages tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChatQuery) WithScheduledMessages(opts ...func(*ScheduledMessageQuery)) *ChatQuery {
+	query := (&ScheduledMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledMessages = query
+	return _q
+}
+
+// WithExports tells the query-builder to eager-load the nodes that are connected to
+// the "exports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChatQuery) WithExports(opts ...func(*ChatExportQuery)) *ChatQuery {
+	query := (&ChatExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExports = query
+	return _q
+}
+
+// WithPinnedBy tells the query-builder to eager-load the nodes that are connected to
+// the "pinned_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChatQuery) WithPinnedBy(opts ...func(*PinnedChatQuery)) *ChatQuery {
+	query := (&PinnedChatClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPinnedBy = query
+	return _q
+}
+
+// WithFolders tells the query-builder to eager-load the nodes that are connected to
+// the "folders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ChatQuery) WithFolders(opts ...func(*ChatFolderChatQuery)) *ChatQuery {
+	query := (&ChatFolderChatClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFolders = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -482,11 +662,16 @@ func (_q *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 	var (
 		nodes       = []*Chat{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [9]bool{
 			_q.withMessages != nil,
 			_q.withPrivateChat != nil,
 			_q.withGroupChat != nil,
 			_q.withLastMessage != nil,
+			_q.withPinnedMessages != nil,
+			_q.withScheduledMessages != nil,
+			_q.withExports != nil,
+			_q.withPinnedBy != nil,
+			_q.withFolders != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -535,6 +720,41 @@ func (_q *ChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chat, e
 			return nil, err
 		}
 	}
+	if query := _q.withPinnedMessages; query != nil {
+		if err := _q.loadPinnedMessages(ctx, query, nodes,
+			func(n *Chat) { n.Edges.PinnedMessages = []*PinnedMessage{} },
+			func(n *Chat, e *PinnedMessage) { n.Edges.PinnedMessages = append(n.Edges.PinnedMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScheduledMessages; query != nil {
+		if err := _q.loadScheduledMessages(ctx, query, nodes,
+			func(n *Chat) { n.Edges.ScheduledMessages = []*ScheduledMessage{} },
+			func(n *Chat, e *ScheduledMessage) { n.Edges.ScheduledMessages = append(n.Edges.ScheduledMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withExports; query != nil {
+		if err := _q.loadExports(ctx, query, nodes,
+			func(n *Chat) { n.Edges.Exports = []*ChatExport{} },
+			func(n *Chat, e *ChatExport) { n.Edges.Exports = append(n.Edges.Exports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPinnedBy; query != nil {
+		if err := _q.loadPinnedBy(ctx, query, nodes,
+			func(n *Chat) { n.Edges.PinnedBy = []*PinnedChat{} },
+			func(n *Chat, e *PinnedChat) { n.Edges.PinnedBy = append(n.Edges.PinnedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFolders; query != nil {
+		if err := _q.loadFolders(ctx, query, nodes,
+			func(n *Chat) { n.Edges.Folders = []*ChatFolderChat{} },
+			func(n *Chat, e *ChatFolderChat) { n.Edges.Folders = append(n.Edges.Folders, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -654,6 +874,156 @@ func (_q *ChatQuery) loadLastMessage(ctx context.Context, query *MessageQuery, n
 	}
 	return nil
 }
+func (_q *ChatQuery) loadPinnedMessages(ctx context.Context, query *PinnedMessageQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *PinnedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Chat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldChatID)
+	}
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chat.PinnedMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ChatQuery) loadScheduledMessages(ctx context.Context, query *ScheduledMessageQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *ScheduledMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Chat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledmessage.FieldChatID)
+	}
+	query.Where(predicate.ScheduledMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chat.ScheduledMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ChatQuery) loadExports(ctx context.Context, query *ChatExportQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *ChatExport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Chat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(chatexport.FieldChatID)
+	}
+	query.Where(predicate.ChatExport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chat.ExportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ChatQuery) loadPinnedBy(ctx context.Context, query *PinnedChatQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *PinnedChat)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Chat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedchat.FieldChatID)
+	}
+	query.Where(predicate.PinnedChat(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chat.PinnedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ChatQuery) loadFolders(ctx context.Context, query *ChatFolderChatQuery, nodes []*Chat, init func(*Chat), assign func(*Chat, *ChatFolderChat)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Chat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(chatfolderchat.FieldChatID)
+	}
+	query.Where(predicate.ChatFolderChat(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(chat.FoldersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ChatQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -4,10 +4,15 @@ package ent
 
 import (
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"context"
 	"errors"
 	"fmt"
@@ -79,6 +84,33 @@ func (_u *ChatUpdate) ClearLastMessageAt() *ChatUpdate {
 	return _u
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (_u *ChatUpdate) SetMessageTTL(v int) *ChatUpdate {
+	_u.mutation.ResetMessageTTL()
+	_u.mutation.SetMessageTTL(v)
+	return _u
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableMessageTTL(v *int) *ChatUpdate {
+	if v != nil {
+		_u.SetMessageTTL(*v)
+	}
+	return _u
+}
+
+// AddMessageTTL adds value to the "message_ttl" field.
+func (_u *ChatUpdate) AddMessageTTL(v int) *ChatUpdate {
+	_u.mutation.AddMessageTTL(v)
+	return _u
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (_u *ChatUpdate) ClearMessageTTL() *ChatUpdate {
+	_u.mutation.ClearMessageTTL()
+	return _u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (_u *ChatUpdate) SetDeletedAt(v time.Time) *ChatUpdate {
 	_u.mutation.SetDeletedAt(v)
@@ -157,6 +189,81 @@ func (_u *ChatUpdate) SetLastMessage(v *Message) *ChatUpdate {
 	return _u.SetLastMessageID(v.ID)
 }
 
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (_u *ChatUpdate) AddPinnedMessageIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.AddPinnedMessageIDs(ids...)
+	return _u
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (_u *ChatUpdate) AddPinnedMessages(v ...*PinnedMessage) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedMessageIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (_u *ChatUpdate) AddScheduledMessageIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.AddScheduledMessageIDs(ids...)
+	return _u
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *ChatUpdate) AddScheduledMessages(v ...*ScheduledMessage) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledMessageIDs(ids...)
+}
+
+// AddExportIDs adds the "exports" edge to the ChatExport entity by IDs.
+func (_u *ChatUpdate) AddExportIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.AddExportIDs(ids...)
+	return _u
+}
+
+// AddExports adds the "exports" edges to the ChatExport entity.
+func (_u *ChatUpdate) AddExports(v ...*ChatExport) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportIDs(ids...)
+}
+
+// AddPinnedByIDs adds the "pinned_by" edge to the PinnedChat entity by IDs.
+func (_u *ChatUpdate) AddPinnedByIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.AddPinnedByIDs(ids...)
+	return _u
+}
+
+// AddPinnedBy adds the "pinned_by" edges to the PinnedChat entity.
+func (_u *ChatUpdate) AddPinnedBy(v ...*PinnedChat) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedByIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the ChatFolderChat entity by IDs.
+func (_u *ChatUpdate) AddFolderIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.AddFolderIDs(ids...)
+	return _u
+}
+
+// AddFolders adds the "folders" edges to the ChatFolderChat entity.
+func (_u *ChatUpdate) AddFolders(v ...*ChatFolderChat) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFolderIDs(ids...)
+}
+
 // Mutation returns the ChatMutation object of the builder.
 func (_u *ChatUpdate) Mutation() *ChatMutation {
 	return _u.mutation
@@ -201,6 +308,111 @@ func (_u *ChatUpdate) ClearLastMessage() *ChatUpdate {
 	return _u
 }
 
+// ClearPinnedMessages clears all "pinned_messages" edges to the PinnedMessage entity.
+func (_u *ChatUpdate) ClearPinnedMessages() *ChatUpdate {
+	_u.mutation.ClearPinnedMessages()
+	return _u
+}
+
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to PinnedMessage entities by IDs.
+func (_u *ChatUpdate) RemovePinnedMessageIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.RemovePinnedMessageIDs(ids...)
+	return _u
+}
+
+// RemovePinnedMessages removes "pinned_messages" edges to PinnedMessage entities.
+func (_u *ChatUpdate) RemovePinnedMessages(v ...*PinnedMessage) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedMessageIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *ChatUpdate) ClearScheduledMessages() *ChatUpdate {
+	_u.mutation.ClearScheduledMessages()
+	return _u
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (_u *ChatUpdate) RemoveScheduledMessageIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.RemoveScheduledMessageIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (_u *ChatUpdate) RemoveScheduledMessages(v ...*ScheduledMessage) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearExports clears all "exports" edges to the ChatExport entity.
+func (_u *ChatUpdate) ClearExports() *ChatUpdate {
+	_u.mutation.ClearExports()
+	return _u
+}
+
+// RemoveExportIDs removes the "exports" edge to ChatExport entities by IDs.
+func (_u *ChatUpdate) RemoveExportIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.RemoveExportIDs(ids...)
+	return _u
+}
+
+// RemoveExports removes "exports" edges to ChatExport entities.
+func (_u *ChatUpdate) RemoveExports(v ...*ChatExport) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportIDs(ids...)
+}
+
+// ClearPinnedBy clears all "pinned_by" edges to the PinnedChat entity.
+func (_u *ChatUpdate) ClearPinnedBy() *ChatUpdate {
+	_u.mutation.ClearPinnedBy()
+	return _u
+}
+
+// RemovePinnedByIDs removes the "pinned_by" edge to PinnedChat entities by IDs.
+func (_u *ChatUpdate) RemovePinnedByIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.RemovePinnedByIDs(ids...)
+	return _u
+}
+
+// RemovePinnedBy removes "pinned_by" edges to PinnedChat entities.
+func (_u *ChatUpdate) RemovePinnedBy(v ...*PinnedChat) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedByIDs(ids...)
+}
+
+// ClearFolders clears all "folders" edges to the ChatFolderChat entity.
+func (_u *ChatUpdate) ClearFolders() *ChatUpdate {
+	_u.mutation.ClearFolders()
+	return _u
+}
+
+// RemoveFolderIDs removes the "folders" edge to ChatFolderChat entities by IDs.
+func (_u *ChatUpdate) RemoveFolderIDs(ids ...uuid.UUID) *ChatUpdate {
+	_u.mutation.RemoveFolderIDs(ids...)
+	return _u
+}
+
+// RemoveFolders removes "folders" edges to ChatFolderChat entities.
+func (_u *ChatUpdate) RemoveFolders(v ...*ChatFolderChat) *ChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFolderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ChatUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -237,6 +449,16 @@ func (_u *ChatUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatUpdate) check() error {
+	if v, ok := _u.mutation.MessageTTL(); ok {
+		if err := chat.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Chat.message_ttl": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *ChatUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -244,6 +466,9 @@ func (_u *ChatUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatUpdat
 }
 
 func (_u *ChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(chat.Table, chat.Columns, sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -261,6 +486,15 @@ func (_u *ChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastMessageAtCleared() {
 		_spec.ClearField(chat.FieldLastMessageAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.MessageTTL(); ok {
+		_spec.SetField(chat.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageTTL(); ok {
+		_spec.AddField(chat.FieldMessageTTL, field.TypeInt, value)
+	}
+	if _u.mutation.MessageTTLCleared() {
+		_spec.ClearField(chat.FieldMessageTTL, field.TypeInt)
+	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(chat.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -399,103 +633,355 @@ func (_u *ChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{chat.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.PinnedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// ChatUpdateOne is the builder for updating a single Chat entity.
-type ChatUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *ChatMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *ChatUpdateOne) SetUpdatedAt(v time.Time) *ChatUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetLastMessageID sets the "last_message_id" field.
-func (_u *ChatUpdateOne) SetLastMessageID(v uuid.UUID) *ChatUpdateOne {
-	_u.mutation.SetLastMessageID(v)
-	return _u
-}
-
-// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
-func (_u *ChatUpdateOne) SetNillableLastMessageID(v *uuid.UUID) *ChatUpdateOne {
-	if v != nil {
-		_u.SetLastMessageID(*v)
+	if nodes := _u.mutation.RemovedPinnedMessagesIDs(); len(nodes) > 0 && !_u.mutation.PinnedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearLastMessageID clears the value of the "last_message_id" field.
-func (_u *ChatUpdateOne) ClearLastMessageID() *ChatUpdateOne {
-	_u.mutation.ClearLastMessageID()
-	return _u
-}
-
-// SetLastMessageAt sets the "last_message_at" field.
-func (_u *ChatUpdateOne) SetLastMessageAt(v time.Time) *ChatUpdateOne {
-	_u.mutation.SetLastMessageAt(v)
-	return _u
-}
-
-// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
-func (_u *ChatUpdateOne) SetNillableLastMessageAt(v *time.Time) *ChatUpdateOne {
-	if v != nil {
-		_u.SetLastMessageAt(*v)
+	if nodes := _u.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearLastMessageAt clears the value of the "last_message_at" field.
-func (_u *ChatUpdateOne) ClearLastMessageAt() *ChatUpdateOne {
-	_u.mutation.ClearLastMessageAt()
-	return _u
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *ChatUpdateOne) SetDeletedAt(v time.Time) *ChatUpdateOne {
-	_u.mutation.SetDeletedAt(v)
-	return _u
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *ChatUpdateOne) SetNillableDeletedAt(v *time.Time) *ChatUpdateOne {
-	if v != nil {
-		_u.SetDeletedAt(*v)
+	if _u.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *ChatUpdateOne) ClearDeletedAt() *ChatUpdateOne {
-	_u.mutation.ClearDeletedAt()
-	return _u
-}
-
-// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
-func (_u *ChatUpdateOne) AddMessageIDs(ids ...uuid.UUID) *ChatUpdateOne {
-	_u.mutation.AddMessageIDs(ids...)
-	return _u
-}
-
-// AddMessages adds the "messages" edges to the Message entity.
-func (_u *ChatUpdateOne) AddMessages(v ...*Message) *ChatUpdateOne {
-	ids := make([]uuid.UUID, len(v))
+	if nodes := _u.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !_u.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportsIDs(); len(nodes) > 0 && !_u.mutation.ExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinnedByIDs(); len(nodes) > 0 && !_u.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFoldersIDs(); len(nodes) > 0 && !_u.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatUpdateOne is the builder for updating a single Chat entity.
+type ChatUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatUpdateOne) SetUpdatedAt(v time.Time) *ChatUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_u *ChatUpdateOne) SetLastMessageID(v uuid.UUID) *ChatUpdateOne {
+	_u.mutation.SetLastMessageID(v)
+	return _u
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableLastMessageID(v *uuid.UUID) *ChatUpdateOne {
+	if v != nil {
+		_u.SetLastMessageID(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (_u *ChatUpdateOne) ClearLastMessageID() *ChatUpdateOne {
+	_u.mutation.ClearLastMessageID()
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ChatUpdateOne) SetLastMessageAt(v time.Time) *ChatUpdateOne {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableLastMessageAt(v *time.Time) *ChatUpdateOne {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ChatUpdateOne) ClearLastMessageAt() *ChatUpdateOne {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// SetMessageTTL sets the "message_ttl" field.
+func (_u *ChatUpdateOne) SetMessageTTL(v int) *ChatUpdateOne {
+	_u.mutation.ResetMessageTTL()
+	_u.mutation.SetMessageTTL(v)
+	return _u
+}
+
+// SetNillableMessageTTL sets the "message_ttl" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableMessageTTL(v *int) *ChatUpdateOne {
+	if v != nil {
+		_u.SetMessageTTL(*v)
+	}
+	return _u
+}
+
+// AddMessageTTL adds value to the "message_ttl" field.
+func (_u *ChatUpdateOne) AddMessageTTL(v int) *ChatUpdateOne {
+	_u.mutation.AddMessageTTL(v)
+	return _u
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (_u *ChatUpdateOne) ClearMessageTTL() *ChatUpdateOne {
+	_u.mutation.ClearMessageTTL()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ChatUpdateOne) SetDeletedAt(v time.Time) *ChatUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableDeletedAt(v *time.Time) *ChatUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ChatUpdateOne) ClearDeletedAt() *ChatUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (_u *ChatUpdateOne) AddMessageIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the Message entity.
+func (_u *ChatUpdateOne) AddMessages(v ...*Message) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
@@ -545,6 +1031,81 @@ func (_u *ChatUpdateOne) SetLastMessage(v *Message) *ChatUpdateOne {
 	return _u.SetLastMessageID(v.ID)
 }
 
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (_u *ChatUpdateOne) AddPinnedMessageIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.AddPinnedMessageIDs(ids...)
+	return _u
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (_u *ChatUpdateOne) AddPinnedMessages(v ...*PinnedMessage) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedMessageIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (_u *ChatUpdateOne) AddScheduledMessageIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.AddScheduledMessageIDs(ids...)
+	return _u
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *ChatUpdateOne) AddScheduledMessages(v ...*ScheduledMessage) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledMessageIDs(ids...)
+}
+
+// AddExportIDs adds the "exports" edge to the ChatExport entity by IDs.
+func (_u *ChatUpdateOne) AddExportIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.AddExportIDs(ids...)
+	return _u
+}
+
+// AddExports adds the "exports" edges to the ChatExport entity.
+func (_u *ChatUpdateOne) AddExports(v ...*ChatExport) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExportIDs(ids...)
+}
+
+// AddPinnedByIDs adds the "pinned_by" edge to the PinnedChat entity by IDs.
+func (_u *ChatUpdateOne) AddPinnedByIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.AddPinnedByIDs(ids...)
+	return _u
+}
+
+// AddPinnedBy adds the "pinned_by" edges to the PinnedChat entity.
+func (_u *ChatUpdateOne) AddPinnedBy(v ...*PinnedChat) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedByIDs(ids...)
+}
+
+// AddFolderIDs adds the "folders" edge to the ChatFolderChat entity by IDs.
+func (_u *ChatUpdateOne) AddFolderIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.AddFolderIDs(ids...)
+	return _u
+}
+
+// AddFolders adds the "folders" edges to the ChatFolderChat entity.
+func (_u *ChatUpdateOne) AddFolders(v ...*ChatFolderChat) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFolderIDs(ids...)
+}
+
 // Mutation returns the ChatMutation object of the builder.
 func (_u *ChatUpdateOne) Mutation() *ChatMutation {
 	return _u.mutation
@@ -589,6 +1150,111 @@ func (_u *ChatUpdateOne) ClearLastMessage() *ChatUpdateOne {
 	return _u
 }
 
+// ClearPinnedMessages clears all "pinned_messages" edges to the PinnedMessage entity.
+func (_u *ChatUpdateOne) ClearPinnedMessages() *ChatUpdateOne {
+	_u.mutation.ClearPinnedMessages()
+	return _u
+}
+
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to PinnedMessage entities by IDs.
+func (_u *ChatUpdateOne) RemovePinnedMessageIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.RemovePinnedMessageIDs(ids...)
+	return _u
+}
+
+// RemovePinnedMessages removes "pinned_messages" edges to PinnedMessage entities.
+func (_u *ChatUpdateOne) RemovePinnedMessages(v ...*PinnedMessage) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedMessageIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *ChatUpdateOne) ClearScheduledMessages() *ChatUpdateOne {
+	_u.mutation.ClearScheduledMessages()
+	return _u
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (_u *ChatUpdateOne) RemoveScheduledMessageIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.RemoveScheduledMessageIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (_u *ChatUpdateOne) RemoveScheduledMessages(v ...*ScheduledMessage) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearExports clears all "exports" edges to the ChatExport entity.
+func (_u *ChatUpdateOne) ClearExports() *ChatUpdateOne {
+	_u.mutation.ClearExports()
+	return _u
+}
+
+// RemoveExportIDs removes the "exports" edge to ChatExport entities by IDs.
+func (_u *ChatUpdateOne) RemoveExportIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.RemoveExportIDs(ids...)
+	return _u
+}
+
+// RemoveExports removes "exports" edges to ChatExport entities.
+func (_u *ChatUpdateOne) RemoveExports(v ...*ChatExport) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExportIDs(ids...)
+}
+
+// ClearPinnedBy clears all "pinned_by" edges to the PinnedChat entity.
+func (_u *ChatUpdateOne) ClearPinnedBy() *ChatUpdateOne {
+	_u.mutation.ClearPinnedBy()
+	return _u
+}
+
+// RemovePinnedByIDs removes the "pinned_by" edge to PinnedChat entities by IDs.
+func (_u *ChatUpdateOne) RemovePinnedByIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.RemovePinnedByIDs(ids...)
+	return _u
+}
+
+// RemovePinnedBy removes "pinned_by" edges to PinnedChat entities.
+func (_u *ChatUpdateOne) RemovePinnedBy(v ...*PinnedChat) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedByIDs(ids...)
+}
+
+// ClearFolders clears all "folders" edges to the ChatFolderChat entity.
+func (_u *ChatUpdateOne) ClearFolders() *ChatUpdateOne {
+	_u.mutation.ClearFolders()
+	return _u
+}
+
+// RemoveFolderIDs removes the "folders" edge to ChatFolderChat entities by IDs.
+func (_u *ChatUpdateOne) RemoveFolderIDs(ids ...uuid.UUID) *ChatUpdateOne {
+	_u.mutation.RemoveFolderIDs(ids...)
+	return _u
+}
+
+// RemoveFolders removes "folders" edges to ChatFolderChat entities.
+func (_u *ChatUpdateOne) RemoveFolders(v ...*ChatFolderChat) *ChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFolderIDs(ids...)
+}
+
 // Where appends a list predicates to the ChatUpdate builder.
 func (_u *ChatUpdateOne) Where(ps ...predicate.Chat) *ChatUpdateOne {
 	_u.mutation.Where(ps...)
@@ -638,6 +1304,16 @@ func (_u *ChatUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatUpdateOne) check() error {
+	if v, ok := _u.mutation.MessageTTL(); ok {
+		if err := chat.MessageTTLValidator(v); err != nil {
+			return &ValidationError{Name: "message_ttl", err: fmt.Errorf(`ent: validator failed for field "Chat.message_ttl": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *ChatUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -645,6 +1321,9 @@ func (_u *ChatUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatUp
 }
 
 func (_u *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(chat.Table, chat.Columns, sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -679,6 +1358,15 @@ func (_u *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error) {
 	if _u.mutation.LastMessageAtCleared() {
 		_spec.ClearField(chat.FieldLastMessageAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.MessageTTL(); ok {
+		_spec.SetField(chat.FieldMessageTTL, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageTTL(); ok {
+		_spec.AddField(chat.FieldMessageTTL, field.TypeInt, value)
+	}
+	if _u.mutation.MessageTTLCleared() {
+		_spec.ClearField(chat.FieldMessageTTL, field.TypeInt)
+	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(chat.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -817,6 +1505,231 @@ func (_u *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PinnedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinnedMessagesIDs(); len(nodes) > 0 && !_u.mutation.PinnedMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedMessagesTable,
+			Columns: []string{chat.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !_u.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ScheduledMessagesTable,
+			Columns: []string{chat.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExportsIDs(); len(nodes) > 0 && !_u.mutation.ExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.ExportsTable,
+			Columns: []string{chat.ExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinnedByIDs(); len(nodes) > 0 && !_u.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.PinnedByTable,
+			Columns: []string{chat.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFoldersIDs(); len(nodes) > 0 && !_u.mutation.FoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chat.FoldersTable,
+			Columns: []string{chat.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Chat{config: _u.config}
 	_spec.Assign = _node.assignValues
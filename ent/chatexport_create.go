@@ -0,0 +1,1282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChatExportCreate is the builder for creating a ChatExport entity.
+type ChatExportCreate struct {
+	config
+	mutation *ChatExportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatExportCreate) SetCreatedAt(v time.Time) *ChatExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableCreatedAt(v *time.Time) *ChatExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatExportCreate) SetUpdatedAt(v time.Time) *ChatExportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableUpdatedAt(v *time.Time) *ChatExportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ChatExportCreate) SetChatID(v uuid.UUID) *ChatExportCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ChatExportCreate) SetUserID(v uuid.UUID) *ChatExportCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ChatExportCreate) SetStatus(v chatexport.Status) *ChatExportCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableStatus(v *chatexport.Status) *ChatExportCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *ChatExportCreate) SetFileName(v string) *ChatExportCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableFileName(v *string) *ChatExportCreate {
+	if v != nil {
+		_c.SetFileName(*v)
+	}
+	return _c
+}
+
+// SetFileSize sets the "file_size" field.
+func (_c *ChatExportCreate) SetFileSize(v int64) *ChatExportCreate {
+	_c.mutation.SetFileSize(v)
+	return _c
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableFileSize(v *int64) *ChatExportCreate {
+	if v != nil {
+		_c.SetFileSize(*v)
+	}
+	return _c
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_c *ChatExportCreate) SetMessageCount(v int) *ChatExportCreate {
+	_c.mutation.SetMessageCount(v)
+	return _c
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableMessageCount(v *int) *ChatExportCreate {
+	if v != nil {
+		_c.SetMessageCount(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *ChatExportCreate) SetFailureReason(v string) *ChatExportCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableFailureReason(v *string) *ChatExportCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ChatExportCreate) SetCompletedAt(v time.Time) *ChatExportCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableCompletedAt(v *time.Time) *ChatExportCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ChatExportCreate) SetExpiresAt(v time.Time) *ChatExportCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableExpiresAt(v *time.Time) *ChatExportCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatExportCreate) SetID(v uuid.UUID) *ChatExportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatExportCreate) SetNillableID(v *uuid.UUID) *ChatExportCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_c *ChatExportCreate) SetChat(v *Chat) *ChatExportCreate {
+	return _c.SetChatID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ChatExportCreate) SetUser(v *User) *ChatExportCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ChatExportMutation object of the builder.
+func (_c *ChatExportCreate) Mutation() *ChatExportMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatExport in the database.
+func (_c *ChatExportCreate) Save(ctx context.Context) (*ChatExport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatExportCreate) SaveX(ctx context.Context) *ChatExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatExportCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatexport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := chatexport.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := chatexport.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		v := chatexport.DefaultMessageCount
+		_c.mutation.SetMessageCount(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatexport.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatExportCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatExport.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatExport.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ChatExport.chat_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ChatExport.user_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ChatExport.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := chatexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ChatExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FileName(); ok {
+		if err := chatexport.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ChatExport.file_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "ChatExport.message_count"`)}
+	}
+	if v, ok := _c.mutation.MessageCount(); ok {
+		if err := chatexport.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "ChatExport.message_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FailureReason(); ok {
+		if err := chatexport.FailureReasonValidator(v); err != nil {
+			return &ValidationError{Name: "failure_reason", err: fmt.Errorf(`ent: validator failed for field "ChatExport.failure_reason": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ChatIDs()) == 0 {
+		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "ChatExport.chat"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ChatExport.user"`)}
+	}
+	return nil
+}
+
+func (_c *ChatExportCreate) sqlSave(ctx context.Context) (*ChatExport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatExportCreate) createSpec() (*ChatExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatExport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatexport.Table, sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatexport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(chatexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(chatexport.FieldFileName, field.TypeString, value)
+		_node.FileName = &value
+	}
+	if value, ok := _c.mutation.FileSize(); ok {
+		_spec.SetField(chatexport.FieldFileSize, field.TypeInt64, value)
+		_node.FileSize = &value
+	}
+	if value, ok := _c.mutation.MessageCount(); ok {
+		_spec.SetField(chatexport.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(chatexport.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(chatexport.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(chatexport.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.ChatTable,
+			Columns: []string{chatexport.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChatID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.UserTable,
+			Columns: []string{chatexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatExport.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatExportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatExportCreate) OnConflict(opts ...sql.ConflictOption) *ChatExportUpsertOne {
+	_c.conflict = opts
+	return &ChatExportUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatExportCreate) OnConflictColumns(columns ...string) *ChatExportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatExportUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatExportUpsertOne is the builder for "upsert"-ing
+	//  one ChatExport node.
+	ChatExportUpsertOne struct {
+		create *ChatExportCreate
+	}
+
+	// ChatExportUpsert is the "OnConflict" setter.
+	ChatExportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatExportUpsert) SetUpdatedAt(v time.Time) *ChatExportUpsert {
+	u.Set(chatexport.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateUpdatedAt() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldUpdatedAt)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatExportUpsert) SetChatID(v uuid.UUID) *ChatExportUpsert {
+	u.Set(chatexport.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateChatID() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldChatID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ChatExportUpsert) SetUserID(v uuid.UUID) *ChatExportUpsert {
+	u.Set(chatexport.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateUserID() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldUserID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ChatExportUpsert) SetStatus(v chatexport.Status) *ChatExportUpsert {
+	u.Set(chatexport.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateStatus() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldStatus)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ChatExportUpsert) SetFileName(v string) *ChatExportUpsert {
+	u.Set(chatexport.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateFileName() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldFileName)
+	return u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (u *ChatExportUpsert) ClearFileName() *ChatExportUpsert {
+	u.SetNull(chatexport.FieldFileName)
+	return u
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ChatExportUpsert) SetFileSize(v int64) *ChatExportUpsert {
+	u.Set(chatexport.FieldFileSize, v)
+	return u
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateFileSize() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldFileSize)
+	return u
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ChatExportUpsert) AddFileSize(v int64) *ChatExportUpsert {
+	u.Add(chatexport.FieldFileSize, v)
+	return u
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (u *ChatExportUpsert) ClearFileSize() *ChatExportUpsert {
+	u.SetNull(chatexport.FieldFileSize)
+	return u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *ChatExportUpsert) SetMessageCount(v int) *ChatExportUpsert {
+	u.Set(chatexport.FieldMessageCount, v)
+	return u
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateMessageCount() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldMessageCount)
+	return u
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *ChatExportUpsert) AddMessageCount(v int) *ChatExportUpsert {
+	u.Add(chatexport.FieldMessageCount, v)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *ChatExportUpsert) SetFailureReason(v string) *ChatExportUpsert {
+	u.Set(chatexport.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateFailureReason() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *ChatExportUpsert) ClearFailureReason() *ChatExportUpsert {
+	u.SetNull(chatexport.FieldFailureReason)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ChatExportUpsert) SetCompletedAt(v time.Time) *ChatExportUpsert {
+	u.Set(chatexport.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateCompletedAt() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ChatExportUpsert) ClearCompletedAt() *ChatExportUpsert {
+	u.SetNull(chatexport.FieldCompletedAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ChatExportUpsert) SetExpiresAt(v time.Time) *ChatExportUpsert {
+	u.Set(chatexport.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ChatExportUpsert) UpdateExpiresAt() *ChatExportUpsert {
+	u.SetExcluded(chatexport.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ChatExportUpsert) ClearExpiresAt() *ChatExportUpsert {
+	u.SetNull(chatexport.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatExportUpsertOne) UpdateNewValues() *ChatExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatexport.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(chatexport.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatExport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatExportUpsertOne) Ignore() *ChatExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatExportUpsertOne) DoNothing() *ChatExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatExportCreate.OnConflict
+// documentation for more info.
+func (u *ChatExportUpsertOne) Update(set func(*ChatExportUpsert)) *ChatExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatExportUpsertOne) SetUpdatedAt(v time.Time) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateUpdatedAt() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatExportUpsertOne) SetChatID(v uuid.UUID) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateChatID() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ChatExportUpsertOne) SetUserID(v uuid.UUID) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateUserID() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChatExportUpsertOne) SetStatus(v chatexport.Status) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateStatus() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ChatExportUpsertOne) SetFileName(v string) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateFileName() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (u *ChatExportUpsertOne) ClearFileName() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearFileName()
+	})
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ChatExportUpsertOne) SetFileSize(v int64) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetFileSize(v)
+	})
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ChatExportUpsertOne) AddFileSize(v int64) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.AddFileSize(v)
+	})
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateFileSize() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateFileSize()
+	})
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (u *ChatExportUpsertOne) ClearFileSize() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearFileSize()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *ChatExportUpsertOne) SetMessageCount(v int) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *ChatExportUpsertOne) AddMessageCount(v int) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateMessageCount() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *ChatExportUpsertOne) SetFailureReason(v string) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateFailureReason() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *ChatExportUpsertOne) ClearFailureReason() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ChatExportUpsertOne) SetCompletedAt(v time.Time) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateCompletedAt() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ChatExportUpsertOne) ClearCompletedAt() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ChatExportUpsertOne) SetExpiresAt(v time.Time) *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ChatExportUpsertOne) UpdateExpiresAt() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ChatExportUpsertOne) ClearExpiresAt() *ChatExportUpsertOne {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatExportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatExportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatExportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatExportUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatExportUpsertOne.ID is not supported by MySQL driver. Use ChatExportUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatExportUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatExportCreateBulk is the builder for creating many ChatExport entities in bulk.
+type ChatExportCreateBulk struct {
+	config
+	err      error
+	builders []*ChatExportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatExport entities in the database.
+func (_c *ChatExportCreateBulk) Save(ctx context.Context) ([]*ChatExport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatExport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatExportCreateBulk) SaveX(ctx context.Context) []*ChatExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatExport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatExportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatExportCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatExportUpsertBulk {
+	_c.conflict = opts
+	return &ChatExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatExportCreateBulk) OnConflictColumns(columns ...string) *ChatExportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatExportUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatExport nodes.
+type ChatExportUpsertBulk struct {
+	create *ChatExportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatexport.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatExportUpsertBulk) UpdateNewValues() *ChatExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatexport.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(chatexport.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatExport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatExportUpsertBulk) Ignore() *ChatExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatExportUpsertBulk) DoNothing() *ChatExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatExportCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatExportUpsertBulk) Update(set func(*ChatExportUpsert)) *ChatExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatExportUpsertBulk) SetUpdatedAt(v time.Time) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateUpdatedAt() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatExportUpsertBulk) SetChatID(v uuid.UUID) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateChatID() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ChatExportUpsertBulk) SetUserID(v uuid.UUID) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateUserID() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ChatExportUpsertBulk) SetStatus(v chatexport.Status) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateStatus() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ChatExportUpsertBulk) SetFileName(v string) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateFileName() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (u *ChatExportUpsertBulk) ClearFileName() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearFileName()
+	})
+}
+
+// SetFileSize sets the "file_size" field.
+func (u *ChatExportUpsertBulk) SetFileSize(v int64) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetFileSize(v)
+	})
+}
+
+// AddFileSize adds v to the "file_size" field.
+func (u *ChatExportUpsertBulk) AddFileSize(v int64) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.AddFileSize(v)
+	})
+}
+
+// UpdateFileSize sets the "file_size" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateFileSize() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateFileSize()
+	})
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (u *ChatExportUpsertBulk) ClearFileSize() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearFileSize()
+	})
+}
+
+// SetMessageCount sets the "message_count" field.
+func (u *ChatExportUpsertBulk) SetMessageCount(v int) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetMessageCount(v)
+	})
+}
+
+// AddMessageCount adds v to the "message_count" field.
+func (u *ChatExportUpsertBulk) AddMessageCount(v int) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.AddMessageCount(v)
+	})
+}
+
+// UpdateMessageCount sets the "message_count" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateMessageCount() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateMessageCount()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *ChatExportUpsertBulk) SetFailureReason(v string) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateFailureReason() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *ChatExportUpsertBulk) ClearFailureReason() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ChatExportUpsertBulk) SetCompletedAt(v time.Time) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateCompletedAt() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *ChatExportUpsertBulk) ClearCompletedAt() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ChatExportUpsertBulk) SetExpiresAt(v time.Time) *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ChatExportUpsertBulk) UpdateExpiresAt() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ChatExportUpsertBulk) ClearExpiresAt() *ChatExportUpsertBulk {
+	return u.Update(func(s *ChatExportUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatExportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatExportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatExportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatExportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
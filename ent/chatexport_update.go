@@ -0,0 +1,883 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChatExportUpdate is the builder for updating ChatExport entities.
+type ChatExportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChatExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ChatExportUpdate builder.
+func (_u *ChatExportUpdate) Where(ps ...predicate.ChatExport) *ChatExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatExportUpdate) SetUpdatedAt(v time.Time) *ChatExportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatExportUpdate) SetChatID(v uuid.UUID) *ChatExportUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableChatID(v *uuid.UUID) *ChatExportUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatExportUpdate) SetUserID(v uuid.UUID) *ChatExportUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableUserID(v *uuid.UUID) *ChatExportUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ChatExportUpdate) SetStatus(v chatexport.Status) *ChatExportUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableStatus(v *chatexport.Status) *ChatExportUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *ChatExportUpdate) SetFileName(v string) *ChatExportUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableFileName(v *string) *ChatExportUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *ChatExportUpdate) ClearFileName() *ChatExportUpdate {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetFileSize sets the "file_size" field.
+func (_u *ChatExportUpdate) SetFileSize(v int64) *ChatExportUpdate {
+	_u.mutation.ResetFileSize()
+	_u.mutation.SetFileSize(v)
+	return _u
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableFileSize(v *int64) *ChatExportUpdate {
+	if v != nil {
+		_u.SetFileSize(*v)
+	}
+	return _u
+}
+
+// AddFileSize adds value to the "file_size" field.
+func (_u *ChatExportUpdate) AddFileSize(v int64) *ChatExportUpdate {
+	_u.mutation.AddFileSize(v)
+	return _u
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (_u *ChatExportUpdate) ClearFileSize() *ChatExportUpdate {
+	_u.mutation.ClearFileSize()
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *ChatExportUpdate) SetMessageCount(v int) *ChatExportUpdate {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableMessageCount(v *int) *ChatExportUpdate {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *ChatExportUpdate) AddMessageCount(v int) *ChatExportUpdate {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *ChatExportUpdate) SetFailureReason(v string) *ChatExportUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableFailureReason(v *string) *ChatExportUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *ChatExportUpdate) ClearFailureReason() *ChatExportUpdate {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ChatExportUpdate) SetCompletedAt(v time.Time) *ChatExportUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableCompletedAt(v *time.Time) *ChatExportUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ChatExportUpdate) ClearCompletedAt() *ChatExportUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ChatExportUpdate) SetExpiresAt(v time.Time) *ChatExportUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ChatExportUpdate) SetNillableExpiresAt(v *time.Time) *ChatExportUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ChatExportUpdate) ClearExpiresAt() *ChatExportUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *ChatExportUpdate) SetChat(v *Chat) *ChatExportUpdate {
+	return _u.SetChatID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ChatExportUpdate) SetUser(v *User) *ChatExportUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ChatExportMutation object of the builder.
+func (_u *ChatExportUpdate) Mutation() *ChatExportMutation {
+	return _u.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *ChatExportUpdate) ClearChat() *ChatExportUpdate {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ChatExportUpdate) ClearUser() *ChatExportUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatExportUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatExportUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatExportUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := chatexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ChatExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := chatexport.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ChatExport.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageCount(); ok {
+		if err := chatexport.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "ChatExport.message_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureReason(); ok {
+		if err := chatexport.FailureReasonValidator(v); err != nil {
+			return &ValidationError{Name: "failure_reason", err: fmt.Errorf(`ent: validator failed for field "ChatExport.failure_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatExport.chat"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatExportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatExportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ChatExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatexport.Table, chatexport.Columns, sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(chatexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(chatexport.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(chatexport.FieldFileName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileSize(); ok {
+		_spec.SetField(chatexport.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFileSize(); ok {
+		_spec.AddField(chatexport.FieldFileSize, field.TypeInt64, value)
+	}
+	if _u.mutation.FileSizeCleared() {
+		_spec.ClearField(chatexport.FieldFileSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(chatexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(chatexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(chatexport.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(chatexport.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(chatexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(chatexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(chatexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(chatexport.FieldExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.ChatTable,
+			Columns: []string{chatexport.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.ChatTable,
+			Columns: []string{chatexport.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.UserTable,
+			Columns: []string{chatexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.UserTable,
+			Columns: []string{chatexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatExportUpdateOne is the builder for updating a single ChatExport entity.
+type ChatExportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatExportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatExportUpdateOne) SetUpdatedAt(v time.Time) *ChatExportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatExportUpdateOne) SetChatID(v uuid.UUID) *ChatExportUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableChatID(v *uuid.UUID) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatExportUpdateOne) SetUserID(v uuid.UUID) *ChatExportUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableUserID(v *uuid.UUID) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ChatExportUpdateOne) SetStatus(v chatexport.Status) *ChatExportUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableStatus(v *chatexport.Status) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *ChatExportUpdateOne) SetFileName(v string) *ChatExportUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableFileName(v *string) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// ClearFileName clears the value of the "file_name" field.
+func (_u *ChatExportUpdateOne) ClearFileName() *ChatExportUpdateOne {
+	_u.mutation.ClearFileName()
+	return _u
+}
+
+// SetFileSize sets the "file_size" field.
+func (_u *ChatExportUpdateOne) SetFileSize(v int64) *ChatExportUpdateOne {
+	_u.mutation.ResetFileSize()
+	_u.mutation.SetFileSize(v)
+	return _u
+}
+
+// SetNillableFileSize sets the "file_size" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableFileSize(v *int64) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetFileSize(*v)
+	}
+	return _u
+}
+
+// AddFileSize adds value to the "file_size" field.
+func (_u *ChatExportUpdateOne) AddFileSize(v int64) *ChatExportUpdateOne {
+	_u.mutation.AddFileSize(v)
+	return _u
+}
+
+// ClearFileSize clears the value of the "file_size" field.
+func (_u *ChatExportUpdateOne) ClearFileSize() *ChatExportUpdateOne {
+	_u.mutation.ClearFileSize()
+	return _u
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_u *ChatExportUpdateOne) SetMessageCount(v int) *ChatExportUpdateOne {
+	_u.mutation.ResetMessageCount()
+	_u.mutation.SetMessageCount(v)
+	return _u
+}
+
+// SetNillableMessageCount sets the "message_count" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableMessageCount(v *int) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetMessageCount(*v)
+	}
+	return _u
+}
+
+// AddMessageCount adds value to the "message_count" field.
+func (_u *ChatExportUpdateOne) AddMessageCount(v int) *ChatExportUpdateOne {
+	_u.mutation.AddMessageCount(v)
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *ChatExportUpdateOne) SetFailureReason(v string) *ChatExportUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableFailureReason(v *string) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *ChatExportUpdateOne) ClearFailureReason() *ChatExportUpdateOne {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ChatExportUpdateOne) SetCompletedAt(v time.Time) *ChatExportUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableCompletedAt(v *time.Time) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ChatExportUpdateOne) ClearCompletedAt() *ChatExportUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ChatExportUpdateOne) SetExpiresAt(v time.Time) *ChatExportUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ChatExportUpdateOne) SetNillableExpiresAt(v *time.Time) *ChatExportUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ChatExportUpdateOne) ClearExpiresAt() *ChatExportUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *ChatExportUpdateOne) SetChat(v *Chat) *ChatExportUpdateOne {
+	return _u.SetChatID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ChatExportUpdateOne) SetUser(v *User) *ChatExportUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ChatExportMutation object of the builder.
+func (_u *ChatExportUpdateOne) Mutation() *ChatExportMutation {
+	return _u.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *ChatExportUpdateOne) ClearChat() *ChatExportUpdateOne {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ChatExportUpdateOne) ClearUser() *ChatExportUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ChatExportUpdate builder.
+func (_u *ChatExportUpdateOne) Where(ps ...predicate.ChatExport) *ChatExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatExportUpdateOne) Select(field string, fields ...string) *ChatExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatExport entity.
+func (_u *ChatExportUpdateOne) Save(ctx context.Context) (*ChatExport, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatExportUpdateOne) SaveX(ctx context.Context) *ChatExport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatExportUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatexport.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatExportUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := chatexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ChatExport.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := chatexport.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ChatExport.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageCount(); ok {
+		if err := chatexport.MessageCountValidator(v); err != nil {
+			return &ValidationError{Name: "message_count", err: fmt.Errorf(`ent: validator failed for field "ChatExport.message_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureReason(); ok {
+		if err := chatexport.FailureReasonValidator(v); err != nil {
+			return &ValidationError{Name: "failure_reason", err: fmt.Errorf(`ent: validator failed for field "ChatExport.failure_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatExport.chat"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatExport.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatExportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatExportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ChatExportUpdateOne) sqlSave(ctx context.Context) (_node *ChatExport, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatexport.Table, chatexport.Columns, sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatExport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatexport.FieldID)
+		for _, f := range fields {
+			if !chatexport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatexport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatexport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(chatexport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(chatexport.FieldFileName, field.TypeString, value)
+	}
+	if _u.mutation.FileNameCleared() {
+		_spec.ClearField(chatexport.FieldFileName, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileSize(); ok {
+		_spec.SetField(chatexport.FieldFileSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFileSize(); ok {
+		_spec.AddField(chatexport.FieldFileSize, field.TypeInt64, value)
+	}
+	if _u.mutation.FileSizeCleared() {
+		_spec.ClearField(chatexport.FieldFileSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MessageCount(); ok {
+		_spec.SetField(chatexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageCount(); ok {
+		_spec.AddField(chatexport.FieldMessageCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(chatexport.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(chatexport.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(chatexport.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(chatexport.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(chatexport.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(chatexport.FieldExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.ChatTable,
+			Columns: []string{chatexport.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.ChatTable,
+			Columns: []string{chatexport.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.UserTable,
+			Columns: []string{chatexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatexport.UserTable,
+			Columns: []string{chatexport.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ChatExport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatexport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
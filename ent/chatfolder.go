@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ChatFolder is the model entity for the ChatFolder schema.
+type ChatFolder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ChatType holds the value of the "chat_type" field.
+	ChatType *chatfolder.ChatType `json:"chat_type,omitempty"`
+	// UnreadOnly holds the value of the "unread_only" field.
+	UnreadOnly bool `json:"unread_only,omitempty"`
+	// Muted holds the value of the "muted" field.
+	Muted *bool `json:"muted,omitempty"`
+	// Archived holds the value of the "archived" field.
+	Archived *bool `json:"archived,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChatFolderQuery when eager-loading is set.
+	Edges        ChatFolderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChatFolderEdges holds the relations/edges for other nodes in the graph.
+type ChatFolderEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Chats holds the value of the chats edge.
+	Chats []*ChatFolderChat `json:"chats,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatFolderEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ChatsOrErr returns the Chats value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChatFolderEdges) ChatsOrErr() ([]*ChatFolderChat, error) {
+	if e.loadedTypes[1] {
+		return e.Chats, nil
+	}
+	return nil, &NotLoadedError{edge: "chats"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatFolder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatfolder.FieldUnreadOnly, chatfolder.FieldMuted, chatfolder.FieldArchived:
+			values[i] = new(sql.NullBool)
+		case chatfolder.FieldName, chatfolder.FieldChatType:
+			values[i] = new(sql.NullString)
+		case chatfolder.FieldCreatedAt, chatfolder.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case chatfolder.FieldID, chatfolder.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatFolder fields.
+func (_m *ChatFolder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatfolder.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case chatfolder.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case chatfolder.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case chatfolder.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case chatfolder.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case chatfolder.FieldChatType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_type", values[i])
+			} else if value.Valid {
+				_m.ChatType = new(chatfolder.ChatType)
+				*_m.ChatType = chatfolder.ChatType(value.String)
+			}
+		case chatfolder.FieldUnreadOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field unread_only", values[i])
+			} else if value.Valid {
+				_m.UnreadOnly = value.Bool
+			}
+		case chatfolder.FieldMuted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field muted", values[i])
+			} else if value.Valid {
+				_m.Muted = new(bool)
+				*_m.Muted = value.Bool
+			}
+		case chatfolder.FieldArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field archived", values[i])
+			} else if value.Valid {
+				_m.Archived = new(bool)
+				*_m.Archived = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatFolder.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChatFolder) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ChatFolder entity.
+func (_m *ChatFolder) QueryUser() *UserQuery {
+	return NewChatFolderClient(_m.config).QueryUser(_m)
+}
+
+// QueryChats queries the "chats" edge of the ChatFolder entity.
+func (_m *ChatFolder) QueryChats() *ChatFolderChatQuery {
+	return NewChatFolderClient(_m.config).QueryChats(_m)
+}
+
+// Update returns a builder for updating this ChatFolder.
+// Note that you need to call ChatFolder.Unwrap() before calling this method if this ChatFolder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChatFolder) Update() *ChatFolderUpdateOne {
+	return NewChatFolderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChatFolder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChatFolder) Unwrap() *ChatFolder {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatFolder is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChatFolder) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatFolder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.ChatType; v != nil {
+		builder.WriteString("chat_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unread_only=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnreadOnly))
+	builder.WriteString(", ")
+	if v := _m.Muted; v != nil {
+		builder.WriteString("muted=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Archived; v != nil {
+		builder.WriteString("archived=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatFolders is a parsable slice of ChatFolder.
+type ChatFolders []*ChatFolder
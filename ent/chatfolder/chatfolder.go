@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatfolder
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the chatfolder type in the database.
+	Label = "chat_folder"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldChatType holds the string denoting the chat_type field in the database.
+	FieldChatType = "chat_type"
+	// FieldUnreadOnly holds the string denoting the unread_only field in the database.
+	FieldUnreadOnly = "unread_only"
+	// FieldMuted holds the string denoting the muted field in the database.
+	FieldMuted = "muted"
+	// FieldArchived holds the string denoting the archived field in the database.
+	FieldArchived = "archived"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeChats holds the string denoting the chats edge name in mutations.
+	EdgeChats = "chats"
+	// Table holds the table name of the chatfolder in the database.
+	Table = "chat_folders"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "chat_folders"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// ChatsTable is the table that holds the chats relation/edge.
+	ChatsTable = "chat_folder_chats"
+	// ChatsInverseTable is the table name for the ChatFolderChat entity.
+	// It exists in this package in order to avoid circular dependency with the "chatfolderchat" package.
+	ChatsInverseTable = "chat_folder_chats"
+	// ChatsColumn is the table column denoting the chats relation/edge.
+	ChatsColumn = "folder_id"
+)
+
+// Columns holds all SQL columns for chatfolder fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldUserID,
+	FieldName,
+	FieldChatType,
+	FieldUnreadOnly,
+	FieldMuted,
+	FieldArchived,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultUnreadOnly holds the default value on creation for the "unread_only" field.
+	DefaultUnreadOnly bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// ChatType defines the type for the "chat_type" enum field.
+type ChatType string
+
+// ChatType values.
+const (
+	ChatTypePrivate ChatType = "private"
+	ChatTypeGroup   ChatType = "group"
+)
+
+func (ct ChatType) String() string {
+	return string(ct)
+}
+
+// ChatTypeValidator is a validator for the "chat_type" field enum values. It is called by the builders before save.
+func ChatTypeValidator(ct ChatType) error {
+	switch ct {
+	case ChatTypePrivate, ChatTypeGroup:
+		return nil
+	default:
+		return fmt.Errorf("chatfolder: invalid enum value for chat_type field: %q", ct)
+	}
+}
+
+// OrderOption defines the ordering options for the ChatFolder queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByChatType orders the results by the chat_type field.
+func ByChatType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatType, opts...).ToFunc()
+}
+
+// ByUnreadOnly orders the results by the unread_only field.
+func ByUnreadOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnreadOnly, opts...).ToFunc()
+}
+
+// ByMuted orders the results by the muted field.
+func ByMuted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMuted, opts...).ToFunc()
+}
+
+// ByArchived orders the results by the archived field.
+func ByArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchived, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChatsCount orders the results by chats count.
+func ByChatsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatsStep(), opts...)
+	}
+}
+
+// ByChats orders the results by chats terms.
+func ByChats(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newChatsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatsTable, ChatsColumn),
+	)
+}
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatfolder
+
+import (
+	"AtoiTalkAPI/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldName, v))
+}
+
+// UnreadOnly applies equality check predicate on the "unread_only" field. It's identical to UnreadOnlyEQ.
+func UnreadOnly(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldUnreadOnly, v))
+}
+
+// Muted applies equality check predicate on the "muted" field. It's identical to MutedEQ.
+func Muted(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldMuted, v))
+}
+
+// Archived applies equality check predicate on the "archived" field. It's identical to ArchivedEQ.
+func Archived(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldArchived, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldContainsFold(FieldName, v))
+}
+
+// ChatTypeEQ applies the EQ predicate on the "chat_type" field.
+func ChatTypeEQ(v ChatType) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldChatType, v))
+}
+
+// ChatTypeNEQ applies the NEQ predicate on the "chat_type" field.
+func ChatTypeNEQ(v ChatType) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldChatType, v))
+}
+
+// ChatTypeIn applies the In predicate on the "chat_type" field.
+func ChatTypeIn(vs ...ChatType) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIn(FieldChatType, vs...))
+}
+
+// ChatTypeNotIn applies the NotIn predicate on the "chat_type" field.
+func ChatTypeNotIn(vs ...ChatType) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotIn(FieldChatType, vs...))
+}
+
+// ChatTypeIsNil applies the IsNil predicate on the "chat_type" field.
+func ChatTypeIsNil() predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIsNull(FieldChatType))
+}
+
+// ChatTypeNotNil applies the NotNil predicate on the "chat_type" field.
+func ChatTypeNotNil() predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotNull(FieldChatType))
+}
+
+// UnreadOnlyEQ applies the EQ predicate on the "unread_only" field.
+func UnreadOnlyEQ(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldUnreadOnly, v))
+}
+
+// UnreadOnlyNEQ applies the NEQ predicate on the "unread_only" field.
+func UnreadOnlyNEQ(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldUnreadOnly, v))
+}
+
+// MutedEQ applies the EQ predicate on the "muted" field.
+func MutedEQ(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldMuted, v))
+}
+
+// MutedNEQ applies the NEQ predicate on the "muted" field.
+func MutedNEQ(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldMuted, v))
+}
+
+// MutedIsNil applies the IsNil predicate on the "muted" field.
+func MutedIsNil() predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIsNull(FieldMuted))
+}
+
+// MutedNotNil applies the NotNil predicate on the "muted" field.
+func MutedNotNil() predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotNull(FieldMuted))
+}
+
+// ArchivedEQ applies the EQ predicate on the "archived" field.
+func ArchivedEQ(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldEQ(FieldArchived, v))
+}
+
+// ArchivedNEQ applies the NEQ predicate on the "archived" field.
+func ArchivedNEQ(v bool) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNEQ(FieldArchived, v))
+}
+
+// ArchivedIsNil applies the IsNil predicate on the "archived" field.
+func ArchivedIsNil() predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldIsNull(FieldArchived))
+}
+
+// ArchivedNotNil applies the NotNil predicate on the "archived" field.
+func ArchivedNotNil() predicate.ChatFolder {
+	return predicate.ChatFolder(sql.FieldNotNull(FieldArchived))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ChatFolder {
+	return predicate.ChatFolder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ChatFolder {
+	return predicate.ChatFolder(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChats applies the HasEdge predicate on the "chats" edge.
+func HasChats() predicate.ChatFolder {
+	return predicate.ChatFolder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatsTable, ChatsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatsWith applies the HasEdge predicate on the "chats" edge with a given conditions (other predicates).
+func HasChatsWith(preds ...predicate.ChatFolderChat) predicate.ChatFolder {
+	return predicate.ChatFolder(func(s *sql.Selector) {
+		step := newChatsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChatFolder) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChatFolder) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChatFolder) predicate.ChatFolder {
+	return predicate.ChatFolder(sql.NotPredicates(p))
+}
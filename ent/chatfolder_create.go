@@ -0,0 +1,1021 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChatFolderCreate is the builder for creating a ChatFolder entity.
+type ChatFolderCreate struct {
+	config
+	mutation *ChatFolderMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatFolderCreate) SetCreatedAt(v time.Time) *ChatFolderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableCreatedAt(v *time.Time) *ChatFolderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatFolderCreate) SetUpdatedAt(v time.Time) *ChatFolderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableUpdatedAt(v *time.Time) *ChatFolderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ChatFolderCreate) SetUserID(v uuid.UUID) *ChatFolderCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ChatFolderCreate) SetName(v string) *ChatFolderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetChatType sets the "chat_type" field.
+func (_c *ChatFolderCreate) SetChatType(v chatfolder.ChatType) *ChatFolderCreate {
+	_c.mutation.SetChatType(v)
+	return _c
+}
+
+// SetNillableChatType sets the "chat_type" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableChatType(v *chatfolder.ChatType) *ChatFolderCreate {
+	if v != nil {
+		_c.SetChatType(*v)
+	}
+	return _c
+}
+
+// SetUnreadOnly sets the "unread_only" field.
+func (_c *ChatFolderCreate) SetUnreadOnly(v bool) *ChatFolderCreate {
+	_c.mutation.SetUnreadOnly(v)
+	return _c
+}
+
+// SetNillableUnreadOnly sets the "unread_only" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableUnreadOnly(v *bool) *ChatFolderCreate {
+	if v != nil {
+		_c.SetUnreadOnly(*v)
+	}
+	return _c
+}
+
+// SetMuted sets the "muted" field.
+func (_c *ChatFolderCreate) SetMuted(v bool) *ChatFolderCreate {
+	_c.mutation.SetMuted(v)
+	return _c
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableMuted(v *bool) *ChatFolderCreate {
+	if v != nil {
+		_c.SetMuted(*v)
+	}
+	return _c
+}
+
+// SetArchived sets the "archived" field.
+func (_c *ChatFolderCreate) SetArchived(v bool) *ChatFolderCreate {
+	_c.mutation.SetArchived(v)
+	return _c
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableArchived(v *bool) *ChatFolderCreate {
+	if v != nil {
+		_c.SetArchived(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatFolderCreate) SetID(v uuid.UUID) *ChatFolderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatFolderCreate) SetNillableID(v *uuid.UUID) *ChatFolderCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ChatFolderCreate) SetUser(v *User) *ChatFolderCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddChatIDs adds the "chats" edge to the ChatFolderChat entity by IDs.
+func (_c *ChatFolderCreate) AddChatIDs(ids ...uuid.UUID) *ChatFolderCreate {
+	_c.mutation.AddChatIDs(ids...)
+	return _c
+}
+
+// AddChats adds the "chats" edges to the ChatFolderChat entity.
+func (_c *ChatFolderCreate) AddChats(v ...*ChatFolderChat) *ChatFolderCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChatIDs(ids...)
+}
+
+// Mutation returns the ChatFolderMutation object of the builder.
+func (_c *ChatFolderCreate) Mutation() *ChatFolderMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatFolder in the database.
+func (_c *ChatFolderCreate) Save(ctx context.Context) (*ChatFolder, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatFolderCreate) SaveX(ctx context.Context) *ChatFolder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatFolderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatFolderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatFolderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatfolder.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := chatfolder.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.UnreadOnly(); !ok {
+		v := chatfolder.DefaultUnreadOnly
+		_c.mutation.SetUnreadOnly(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatfolder.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatFolderCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatFolder.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatFolder.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ChatFolder.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ChatFolder.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := chatfolder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ChatFolder.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ChatType(); ok {
+		if err := chatfolder.ChatTypeValidator(v); err != nil {
+			return &ValidationError{Name: "chat_type", err: fmt.Errorf(`ent: validator failed for field "ChatFolder.chat_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UnreadOnly(); !ok {
+		return &ValidationError{Name: "unread_only", err: errors.New(`ent: missing required field "ChatFolder.unread_only"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ChatFolder.user"`)}
+	}
+	return nil
+}
+
+func (_c *ChatFolderCreate) sqlSave(ctx context.Context) (*ChatFolder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatFolderCreate) createSpec() (*ChatFolder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatFolder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatfolder.Table, sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatfolder.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatfolder.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(chatfolder.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.ChatType(); ok {
+		_spec.SetField(chatfolder.FieldChatType, field.TypeEnum, value)
+		_node.ChatType = &value
+	}
+	if value, ok := _c.mutation.UnreadOnly(); ok {
+		_spec.SetField(chatfolder.FieldUnreadOnly, field.TypeBool, value)
+		_node.UnreadOnly = value
+	}
+	if value, ok := _c.mutation.Muted(); ok {
+		_spec.SetField(chatfolder.FieldMuted, field.TypeBool, value)
+		_node.Muted = &value
+	}
+	if value, ok := _c.mutation.Archived(); ok {
+		_spec.SetField(chatfolder.FieldArchived, field.TypeBool, value)
+		_node.Archived = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolder.UserTable,
+			Columns: []string{chatfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatFolder.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatFolderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatFolderCreate) OnConflict(opts ...sql.ConflictOption) *ChatFolderUpsertOne {
+	_c.conflict = opts
+	return &ChatFolderUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatFolder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatFolderCreate) OnConflictColumns(columns ...string) *ChatFolderUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatFolderUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatFolderUpsertOne is the builder for "upsert"-ing
+	//  one ChatFolder node.
+	ChatFolderUpsertOne struct {
+		create *ChatFolderCreate
+	}
+
+	// ChatFolderUpsert is the "OnConflict" setter.
+	ChatFolderUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatFolderUpsert) SetUpdatedAt(v time.Time) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateUpdatedAt() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ChatFolderUpsert) SetUserID(v uuid.UUID) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateUserID() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ChatFolderUpsert) SetName(v string) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateName() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldName)
+	return u
+}
+
+// SetChatType sets the "chat_type" field.
+func (u *ChatFolderUpsert) SetChatType(v chatfolder.ChatType) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldChatType, v)
+	return u
+}
+
+// UpdateChatType sets the "chat_type" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateChatType() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldChatType)
+	return u
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (u *ChatFolderUpsert) ClearChatType() *ChatFolderUpsert {
+	u.SetNull(chatfolder.FieldChatType)
+	return u
+}
+
+// SetUnreadOnly sets the "unread_only" field.
+func (u *ChatFolderUpsert) SetUnreadOnly(v bool) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldUnreadOnly, v)
+	return u
+}
+
+// UpdateUnreadOnly sets the "unread_only" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateUnreadOnly() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldUnreadOnly)
+	return u
+}
+
+// SetMuted sets the "muted" field.
+func (u *ChatFolderUpsert) SetMuted(v bool) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldMuted, v)
+	return u
+}
+
+// UpdateMuted sets the "muted" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateMuted() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldMuted)
+	return u
+}
+
+// ClearMuted clears the value of the "muted" field.
+func (u *ChatFolderUpsert) ClearMuted() *ChatFolderUpsert {
+	u.SetNull(chatfolder.FieldMuted)
+	return u
+}
+
+// SetArchived sets the "archived" field.
+func (u *ChatFolderUpsert) SetArchived(v bool) *ChatFolderUpsert {
+	u.Set(chatfolder.FieldArchived, v)
+	return u
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *ChatFolderUpsert) UpdateArchived() *ChatFolderUpsert {
+	u.SetExcluded(chatfolder.FieldArchived)
+	return u
+}
+
+// ClearArchived clears the value of the "archived" field.
+func (u *ChatFolderUpsert) ClearArchived() *ChatFolderUpsert {
+	u.SetNull(chatfolder.FieldArchived)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatFolder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatfolder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatFolderUpsertOne) UpdateNewValues() *ChatFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatfolder.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(chatfolder.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatFolder.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatFolderUpsertOne) Ignore() *ChatFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatFolderUpsertOne) DoNothing() *ChatFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatFolderCreate.OnConflict
+// documentation for more info.
+func (u *ChatFolderUpsertOne) Update(set func(*ChatFolderUpsert)) *ChatFolderUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatFolderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatFolderUpsertOne) SetUpdatedAt(v time.Time) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateUpdatedAt() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ChatFolderUpsertOne) SetUserID(v uuid.UUID) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateUserID() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ChatFolderUpsertOne) SetName(v string) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateName() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetChatType sets the "chat_type" field.
+func (u *ChatFolderUpsertOne) SetChatType(v chatfolder.ChatType) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetChatType(v)
+	})
+}
+
+// UpdateChatType sets the "chat_type" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateChatType() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateChatType()
+	})
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (u *ChatFolderUpsertOne) ClearChatType() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.ClearChatType()
+	})
+}
+
+// SetUnreadOnly sets the "unread_only" field.
+func (u *ChatFolderUpsertOne) SetUnreadOnly(v bool) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetUnreadOnly(v)
+	})
+}
+
+// UpdateUnreadOnly sets the "unread_only" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateUnreadOnly() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateUnreadOnly()
+	})
+}
+
+// SetMuted sets the "muted" field.
+func (u *ChatFolderUpsertOne) SetMuted(v bool) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetMuted(v)
+	})
+}
+
+// UpdateMuted sets the "muted" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateMuted() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateMuted()
+	})
+}
+
+// ClearMuted clears the value of the "muted" field.
+func (u *ChatFolderUpsertOne) ClearMuted() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.ClearMuted()
+	})
+}
+
+// SetArchived sets the "archived" field.
+func (u *ChatFolderUpsertOne) SetArchived(v bool) *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetArchived(v)
+	})
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *ChatFolderUpsertOne) UpdateArchived() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateArchived()
+	})
+}
+
+// ClearArchived clears the value of the "archived" field.
+func (u *ChatFolderUpsertOne) ClearArchived() *ChatFolderUpsertOne {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.ClearArchived()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatFolderUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatFolderCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatFolderUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatFolderUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatFolderUpsertOne.ID is not supported by MySQL driver. Use ChatFolderUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatFolderUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatFolderCreateBulk is the builder for creating many ChatFolder entities in bulk.
+type ChatFolderCreateBulk struct {
+	config
+	err      error
+	builders []*ChatFolderCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatFolder entities in the database.
+func (_c *ChatFolderCreateBulk) Save(ctx context.Context) ([]*ChatFolder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatFolder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatFolderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatFolderCreateBulk) SaveX(ctx context.Context) []*ChatFolder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatFolderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatFolderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatFolder.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatFolderUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatFolderCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatFolderUpsertBulk {
+	_c.conflict = opts
+	return &ChatFolderUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatFolder.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatFolderCreateBulk) OnConflictColumns(columns ...string) *ChatFolderUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatFolderUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatFolderUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatFolder nodes.
+type ChatFolderUpsertBulk struct {
+	create *ChatFolderCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatFolder.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatfolder.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatFolderUpsertBulk) UpdateNewValues() *ChatFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatfolder.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(chatfolder.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatFolder.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatFolderUpsertBulk) Ignore() *ChatFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatFolderUpsertBulk) DoNothing() *ChatFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatFolderCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatFolderUpsertBulk) Update(set func(*ChatFolderUpsert)) *ChatFolderUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatFolderUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatFolderUpsertBulk) SetUpdatedAt(v time.Time) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateUpdatedAt() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ChatFolderUpsertBulk) SetUserID(v uuid.UUID) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateUserID() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ChatFolderUpsertBulk) SetName(v string) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateName() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetChatType sets the "chat_type" field.
+func (u *ChatFolderUpsertBulk) SetChatType(v chatfolder.ChatType) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetChatType(v)
+	})
+}
+
+// UpdateChatType sets the "chat_type" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateChatType() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateChatType()
+	})
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (u *ChatFolderUpsertBulk) ClearChatType() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.ClearChatType()
+	})
+}
+
+// SetUnreadOnly sets the "unread_only" field.
+func (u *ChatFolderUpsertBulk) SetUnreadOnly(v bool) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetUnreadOnly(v)
+	})
+}
+
+// UpdateUnreadOnly sets the "unread_only" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateUnreadOnly() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateUnreadOnly()
+	})
+}
+
+// SetMuted sets the "muted" field.
+func (u *ChatFolderUpsertBulk) SetMuted(v bool) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetMuted(v)
+	})
+}
+
+// UpdateMuted sets the "muted" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateMuted() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateMuted()
+	})
+}
+
+// ClearMuted clears the value of the "muted" field.
+func (u *ChatFolderUpsertBulk) ClearMuted() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.ClearMuted()
+	})
+}
+
+// SetArchived sets the "archived" field.
+func (u *ChatFolderUpsertBulk) SetArchived(v bool) *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.SetArchived(v)
+	})
+}
+
+// UpdateArchived sets the "archived" field to the value that was provided on create.
+func (u *ChatFolderUpsertBulk) UpdateArchived() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.UpdateArchived()
+	})
+}
+
+// ClearArchived clears the value of the "archived" field.
+func (u *ChatFolderUpsertBulk) ClearArchived() *ChatFolderUpsertBulk {
+	return u.Update(func(s *ChatFolderUpsert) {
+		s.ClearArchived()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatFolderUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatFolderCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatFolderCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatFolderUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
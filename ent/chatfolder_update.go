@@ -0,0 +1,767 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChatFolderUpdate is the builder for updating ChatFolder entities.
+type ChatFolderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChatFolderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ChatFolderUpdate builder.
+func (_u *ChatFolderUpdate) Where(ps ...predicate.ChatFolder) *ChatFolderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatFolderUpdate) SetUpdatedAt(v time.Time) *ChatFolderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatFolderUpdate) SetUserID(v uuid.UUID) *ChatFolderUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatFolderUpdate) SetNillableUserID(v *uuid.UUID) *ChatFolderUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChatFolderUpdate) SetName(v string) *ChatFolderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChatFolderUpdate) SetNillableName(v *string) *ChatFolderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetChatType sets the "chat_type" field.
+func (_u *ChatFolderUpdate) SetChatType(v chatfolder.ChatType) *ChatFolderUpdate {
+	_u.mutation.SetChatType(v)
+	return _u
+}
+
+// SetNillableChatType sets the "chat_type" field if the given value is not nil.
+func (_u *ChatFolderUpdate) SetNillableChatType(v *chatfolder.ChatType) *ChatFolderUpdate {
+	if v != nil {
+		_u.SetChatType(*v)
+	}
+	return _u
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (_u *ChatFolderUpdate) ClearChatType() *ChatFolderUpdate {
+	_u.mutation.ClearChatType()
+	return _u
+}
+
+// SetUnreadOnly sets the "unread_only" field.
+func (_u *ChatFolderUpdate) SetUnreadOnly(v bool) *ChatFolderUpdate {
+	_u.mutation.SetUnreadOnly(v)
+	return _u
+}
+
+// SetNillableUnreadOnly sets the "unread_only" field if the given value is not nil.
+func (_u *ChatFolderUpdate) SetNillableUnreadOnly(v *bool) *ChatFolderUpdate {
+	if v != nil {
+		_u.SetUnreadOnly(*v)
+	}
+	return _u
+}
+
+// SetMuted sets the "muted" field.
+func (_u *ChatFolderUpdate) SetMuted(v bool) *ChatFolderUpdate {
+	_u.mutation.SetMuted(v)
+	return _u
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_u *ChatFolderUpdate) SetNillableMuted(v *bool) *ChatFolderUpdate {
+	if v != nil {
+		_u.SetMuted(*v)
+	}
+	return _u
+}
+
+// ClearMuted clears the value of the "muted" field.
+func (_u *ChatFolderUpdate) ClearMuted() *ChatFolderUpdate {
+	_u.mutation.ClearMuted()
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *ChatFolderUpdate) SetArchived(v bool) *ChatFolderUpdate {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *ChatFolderUpdate) SetNillableArchived(v *bool) *ChatFolderUpdate {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// ClearArchived clears the value of the "archived" field.
+func (_u *ChatFolderUpdate) ClearArchived() *ChatFolderUpdate {
+	_u.mutation.ClearArchived()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ChatFolderUpdate) SetUser(v *User) *ChatFolderUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// AddChatIDs adds the "chats" edge to the ChatFolderChat entity by IDs.
+func (_u *ChatFolderUpdate) AddChatIDs(ids ...uuid.UUID) *ChatFolderUpdate {
+	_u.mutation.AddChatIDs(ids...)
+	return _u
+}
+
+// AddChats adds the "chats" edges to the ChatFolderChat entity.
+func (_u *ChatFolderUpdate) AddChats(v ...*ChatFolderChat) *ChatFolderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatIDs(ids...)
+}
+
+// Mutation returns the ChatFolderMutation object of the builder.
+func (_u *ChatFolderUpdate) Mutation() *ChatFolderMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ChatFolderUpdate) ClearUser() *ChatFolderUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearChats clears all "chats" edges to the ChatFolderChat entity.
+func (_u *ChatFolderUpdate) ClearChats() *ChatFolderUpdate {
+	_u.mutation.ClearChats()
+	return _u
+}
+
+// RemoveChatIDs removes the "chats" edge to ChatFolderChat entities by IDs.
+func (_u *ChatFolderUpdate) RemoveChatIDs(ids ...uuid.UUID) *ChatFolderUpdate {
+	_u.mutation.RemoveChatIDs(ids...)
+	return _u
+}
+
+// RemoveChats removes "chats" edges to ChatFolderChat entities.
+func (_u *ChatFolderUpdate) RemoveChats(v ...*ChatFolderChat) *ChatFolderUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatFolderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatFolderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatFolderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatFolderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatFolderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatfolder.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatFolderUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := chatfolder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ChatFolder.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChatType(); ok {
+		if err := chatfolder.ChatTypeValidator(v); err != nil {
+			return &ValidationError{Name: "chat_type", err: fmt.Errorf(`ent: validator failed for field "ChatFolder.chat_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatFolder.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatFolderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatFolderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ChatFolderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatfolder.Table, chatfolder.Columns, sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatfolder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(chatfolder.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChatType(); ok {
+		_spec.SetField(chatfolder.FieldChatType, field.TypeEnum, value)
+	}
+	if _u.mutation.ChatTypeCleared() {
+		_spec.ClearField(chatfolder.FieldChatType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UnreadOnly(); ok {
+		_spec.SetField(chatfolder.FieldUnreadOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Muted(); ok {
+		_spec.SetField(chatfolder.FieldMuted, field.TypeBool, value)
+	}
+	if _u.mutation.MutedCleared() {
+		_spec.ClearField(chatfolder.FieldMuted, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(chatfolder.FieldArchived, field.TypeBool, value)
+	}
+	if _u.mutation.ArchivedCleared() {
+		_spec.ClearField(chatfolder.FieldArchived, field.TypeBool)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolder.UserTable,
+			Columns: []string{chatfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolder.UserTable,
+			Columns: []string{chatfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatsIDs(); len(nodes) > 0 && !_u.mutation.ChatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatfolder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatFolderUpdateOne is the builder for updating a single ChatFolder entity.
+type ChatFolderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatFolderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatFolderUpdateOne) SetUpdatedAt(v time.Time) *ChatFolderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ChatFolderUpdateOne) SetUserID(v uuid.UUID) *ChatFolderUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ChatFolderUpdateOne) SetNillableUserID(v *uuid.UUID) *ChatFolderUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ChatFolderUpdateOne) SetName(v string) *ChatFolderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ChatFolderUpdateOne) SetNillableName(v *string) *ChatFolderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetChatType sets the "chat_type" field.
+func (_u *ChatFolderUpdateOne) SetChatType(v chatfolder.ChatType) *ChatFolderUpdateOne {
+	_u.mutation.SetChatType(v)
+	return _u
+}
+
+// SetNillableChatType sets the "chat_type" field if the given value is not nil.
+func (_u *ChatFolderUpdateOne) SetNillableChatType(v *chatfolder.ChatType) *ChatFolderUpdateOne {
+	if v != nil {
+		_u.SetChatType(*v)
+	}
+	return _u
+}
+
+// ClearChatType clears the value of the "chat_type" field.
+func (_u *ChatFolderUpdateOne) ClearChatType() *ChatFolderUpdateOne {
+	_u.mutation.ClearChatType()
+	return _u
+}
+
+// SetUnreadOnly sets the "unread_only" field.
+func (_u *ChatFolderUpdateOne) SetUnreadOnly(v bool) *ChatFolderUpdateOne {
+	_u.mutation.SetUnreadOnly(v)
+	return _u
+}
+
+// SetNillableUnreadOnly sets the "unread_only" field if the given value is not nil.
+func (_u *ChatFolderUpdateOne) SetNillableUnreadOnly(v *bool) *ChatFolderUpdateOne {
+	if v != nil {
+		_u.SetUnreadOnly(*v)
+	}
+	return _u
+}
+
+// SetMuted sets the "muted" field.
+func (_u *ChatFolderUpdateOne) SetMuted(v bool) *ChatFolderUpdateOne {
+	_u.mutation.SetMuted(v)
+	return _u
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_u *ChatFolderUpdateOne) SetNillableMuted(v *bool) *ChatFolderUpdateOne {
+	if v != nil {
+		_u.SetMuted(*v)
+	}
+	return _u
+}
+
+// ClearMuted clears the value of the "muted" field.
+func (_u *ChatFolderUpdateOne) ClearMuted() *ChatFolderUpdateOne {
+	_u.mutation.ClearMuted()
+	return _u
+}
+
+// SetArchived sets the "archived" field.
+func (_u *ChatFolderUpdateOne) SetArchived(v bool) *ChatFolderUpdateOne {
+	_u.mutation.SetArchived(v)
+	return _u
+}
+
+// SetNillableArchived sets the "archived" field if the given value is not nil.
+func (_u *ChatFolderUpdateOne) SetNillableArchived(v *bool) *ChatFolderUpdateOne {
+	if v != nil {
+		_u.SetArchived(*v)
+	}
+	return _u
+}
+
+// ClearArchived clears the value of the "archived" field.
+func (_u *ChatFolderUpdateOne) ClearArchived() *ChatFolderUpdateOne {
+	_u.mutation.ClearArchived()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ChatFolderUpdateOne) SetUser(v *User) *ChatFolderUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// AddChatIDs adds the "chats" edge to the ChatFolderChat entity by IDs.
+func (_u *ChatFolderUpdateOne) AddChatIDs(ids ...uuid.UUID) *ChatFolderUpdateOne {
+	_u.mutation.AddChatIDs(ids...)
+	return _u
+}
+
+// AddChats adds the "chats" edges to the ChatFolderChat entity.
+func (_u *ChatFolderUpdateOne) AddChats(v ...*ChatFolderChat) *ChatFolderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatIDs(ids...)
+}
+
+// Mutation returns the ChatFolderMutation object of the builder.
+func (_u *ChatFolderUpdateOne) Mutation() *ChatFolderMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ChatFolderUpdateOne) ClearUser() *ChatFolderUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearChats clears all "chats" edges to the ChatFolderChat entity.
+func (_u *ChatFolderUpdateOne) ClearChats() *ChatFolderUpdateOne {
+	_u.mutation.ClearChats()
+	return _u
+}
+
+// RemoveChatIDs removes the "chats" edge to ChatFolderChat entities by IDs.
+func (_u *ChatFolderUpdateOne) RemoveChatIDs(ids ...uuid.UUID) *ChatFolderUpdateOne {
+	_u.mutation.RemoveChatIDs(ids...)
+	return _u
+}
+
+// RemoveChats removes "chats" edges to ChatFolderChat entities.
+func (_u *ChatFolderUpdateOne) RemoveChats(v ...*ChatFolderChat) *ChatFolderUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatIDs(ids...)
+}
+
+// Where appends a list predicates to the ChatFolderUpdate builder.
+func (_u *ChatFolderUpdateOne) Where(ps ...predicate.ChatFolder) *ChatFolderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatFolderUpdateOne) Select(field string, fields ...string) *ChatFolderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatFolder entity.
+func (_u *ChatFolderUpdateOne) Save(ctx context.Context) (*ChatFolder, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatFolderUpdateOne) SaveX(ctx context.Context) *ChatFolder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatFolderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatFolderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatFolderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatfolder.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatFolderUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := chatfolder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ChatFolder.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChatType(); ok {
+		if err := chatfolder.ChatTypeValidator(v); err != nil {
+			return &ValidationError{Name: "chat_type", err: fmt.Errorf(`ent: validator failed for field "ChatFolder.chat_type": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatFolder.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatFolderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatFolderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ChatFolderUpdateOne) sqlSave(ctx context.Context) (_node *ChatFolder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatfolder.Table, chatfolder.Columns, sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatFolder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatfolder.FieldID)
+		for _, f := range fields {
+			if !chatfolder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatfolder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatfolder.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(chatfolder.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChatType(); ok {
+		_spec.SetField(chatfolder.FieldChatType, field.TypeEnum, value)
+	}
+	if _u.mutation.ChatTypeCleared() {
+		_spec.ClearField(chatfolder.FieldChatType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UnreadOnly(); ok {
+		_spec.SetField(chatfolder.FieldUnreadOnly, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Muted(); ok {
+		_spec.SetField(chatfolder.FieldMuted, field.TypeBool, value)
+	}
+	if _u.mutation.MutedCleared() {
+		_spec.ClearField(chatfolder.FieldMuted, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Archived(); ok {
+		_spec.SetField(chatfolder.FieldArchived, field.TypeBool, value)
+	}
+	if _u.mutation.ArchivedCleared() {
+		_spec.ClearField(chatfolder.FieldArchived, field.TypeBool)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolder.UserTable,
+			Columns: []string{chatfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolder.UserTable,
+			Columns: []string{chatfolder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatsIDs(); len(nodes) > 0 && !_u.mutation.ChatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   chatfolder.ChatsTable,
+			Columns: []string{chatfolder.ChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ChatFolder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatfolder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
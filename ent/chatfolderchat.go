@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ChatFolderChat is the model entity for the ChatFolderChat schema.
+type ChatFolderChat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FolderID holds the value of the "folder_id" field.
+	FolderID uuid.UUID `json:"folder_id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID uuid.UUID `json:"chat_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChatFolderChatQuery when eager-loading is set.
+	Edges        ChatFolderChatEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ChatFolderChatEdges holds the relations/edges for other nodes in the graph.
+type ChatFolderChatEdges struct {
+	// Folder holds the value of the folder edge.
+	Folder *ChatFolder `json:"folder,omitempty"`
+	// Chat holds the value of the chat edge.
+	Chat *Chat `json:"chat,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FolderOrErr returns the Folder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatFolderChatEdges) FolderOrErr() (*ChatFolder, error) {
+	if e.Folder != nil {
+		return e.Folder, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: chatfolder.Label}
+	}
+	return nil, &NotLoadedError{edge: "folder"}
+}
+
+// ChatOrErr returns the Chat value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatFolderChatEdges) ChatOrErr() (*Chat, error) {
+	if e.Chat != nil {
+		return e.Chat, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: chat.Label}
+	}
+	return nil, &NotLoadedError{edge: "chat"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatFolderChat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatfolderchat.FieldCreatedAt, chatfolderchat.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case chatfolderchat.FieldID, chatfolderchat.FieldFolderID, chatfolderchat.FieldChatID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatFolderChat fields.
+func (_m *ChatFolderChat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatfolderchat.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case chatfolderchat.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case chatfolderchat.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case chatfolderchat.FieldFolderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field folder_id", values[i])
+			} else if value != nil {
+				_m.FolderID = *value
+			}
+		case chatfolderchat.FieldChatID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				_m.ChatID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatFolderChat.
+// This includes values selected through modifiers, order, etc.
+func (_m *ChatFolderChat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFolder queries the "folder" edge of the ChatFolderChat entity.
+func (_m *ChatFolderChat) QueryFolder() *ChatFolderQuery {
+	return NewChatFolderChatClient(_m.config).QueryFolder(_m)
+}
+
+// QueryChat queries the "chat" edge of the ChatFolderChat entity.
+func (_m *ChatFolderChat) QueryChat() *ChatQuery {
+	return NewChatFolderChatClient(_m.config).QueryChat(_m)
+}
+
+// Update returns a builder for updating this ChatFolderChat.
+// Note that you need to call ChatFolderChat.Unwrap() before calling this method if this ChatFolderChat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ChatFolderChat) Update() *ChatFolderChatUpdateOne {
+	return NewChatFolderChatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ChatFolderChat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ChatFolderChat) Unwrap() *ChatFolderChat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatFolderChat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ChatFolderChat) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatFolderChat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("folder_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FolderID))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatFolderChats is a parsable slice of ChatFolderChat.
+type ChatFolderChats []*ChatFolderChat
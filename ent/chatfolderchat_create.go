@@ -0,0 +1,702 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChatFolderChatCreate is the builder for creating a ChatFolderChat entity.
+type ChatFolderChatCreate struct {
+	config
+	mutation *ChatFolderChatMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatFolderChatCreate) SetCreatedAt(v time.Time) *ChatFolderChatCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatFolderChatCreate) SetNillableCreatedAt(v *time.Time) *ChatFolderChatCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatFolderChatCreate) SetUpdatedAt(v time.Time) *ChatFolderChatCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatFolderChatCreate) SetNillableUpdatedAt(v *time.Time) *ChatFolderChatCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFolderID sets the "folder_id" field.
+func (_c *ChatFolderChatCreate) SetFolderID(v uuid.UUID) *ChatFolderChatCreate {
+	_c.mutation.SetFolderID(v)
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ChatFolderChatCreate) SetChatID(v uuid.UUID) *ChatFolderChatCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ChatFolderChatCreate) SetID(v uuid.UUID) *ChatFolderChatCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ChatFolderChatCreate) SetNillableID(v *uuid.UUID) *ChatFolderChatCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFolder sets the "folder" edge to the ChatFolder entity.
+func (_c *ChatFolderChatCreate) SetFolder(v *ChatFolder) *ChatFolderChatCreate {
+	return _c.SetFolderID(v.ID)
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_c *ChatFolderChatCreate) SetChat(v *Chat) *ChatFolderChatCreate {
+	return _c.SetChatID(v.ID)
+}
+
+// Mutation returns the ChatFolderChatMutation object of the builder.
+func (_c *ChatFolderChatCreate) Mutation() *ChatFolderChatMutation {
+	return _c.mutation
+}
+
+// Save creates the ChatFolderChat in the database.
+func (_c *ChatFolderChatCreate) Save(ctx context.Context) (*ChatFolderChat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ChatFolderChatCreate) SaveX(ctx context.Context) *ChatFolderChat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatFolderChatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatFolderChatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ChatFolderChatCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := chatfolderchat.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := chatfolderchat.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := chatfolderchat.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ChatFolderChatCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatFolderChat.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChatFolderChat.updated_at"`)}
+	}
+	if _, ok := _c.mutation.FolderID(); !ok {
+		return &ValidationError{Name: "folder_id", err: errors.New(`ent: missing required field "ChatFolderChat.folder_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ChatFolderChat.chat_id"`)}
+	}
+	if len(_c.mutation.FolderIDs()) == 0 {
+		return &ValidationError{Name: "folder", err: errors.New(`ent: missing required edge "ChatFolderChat.folder"`)}
+	}
+	if len(_c.mutation.ChatIDs()) == 0 {
+		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "ChatFolderChat.chat"`)}
+	}
+	return nil
+}
+
+func (_c *ChatFolderChatCreate) sqlSave(ctx context.Context) (*ChatFolderChat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ChatFolderChatCreate) createSpec() (*ChatFolderChat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatFolderChat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(chatfolderchat.Table, sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatfolderchat.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatfolderchat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.FolderTable,
+			Columns: []string{chatfolderchat.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FolderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.ChatTable,
+			Columns: []string{chatfolderchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChatID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatFolderChat.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatFolderChatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatFolderChatCreate) OnConflict(opts ...sql.ConflictOption) *ChatFolderChatUpsertOne {
+	_c.conflict = opts
+	return &ChatFolderChatUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatFolderChat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatFolderChatCreate) OnConflictColumns(columns ...string) *ChatFolderChatUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatFolderChatUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ChatFolderChatUpsertOne is the builder for "upsert"-ing
+	//  one ChatFolderChat node.
+	ChatFolderChatUpsertOne struct {
+		create *ChatFolderChatCreate
+	}
+
+	// ChatFolderChatUpsert is the "OnConflict" setter.
+	ChatFolderChatUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatFolderChatUpsert) SetUpdatedAt(v time.Time) *ChatFolderChatUpsert {
+	u.Set(chatfolderchat.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatFolderChatUpsert) UpdateUpdatedAt() *ChatFolderChatUpsert {
+	u.SetExcluded(chatfolderchat.FieldUpdatedAt)
+	return u
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *ChatFolderChatUpsert) SetFolderID(v uuid.UUID) *ChatFolderChatUpsert {
+	u.Set(chatfolderchat.FieldFolderID, v)
+	return u
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *ChatFolderChatUpsert) UpdateFolderID() *ChatFolderChatUpsert {
+	u.SetExcluded(chatfolderchat.FieldFolderID)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatFolderChatUpsert) SetChatID(v uuid.UUID) *ChatFolderChatUpsert {
+	u.Set(chatfolderchat.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatFolderChatUpsert) UpdateChatID() *ChatFolderChatUpsert {
+	u.SetExcluded(chatfolderchat.FieldChatID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatFolderChat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatfolderchat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatFolderChatUpsertOne) UpdateNewValues() *ChatFolderChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatfolderchat.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(chatfolderchat.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatFolderChat.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatFolderChatUpsertOne) Ignore() *ChatFolderChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatFolderChatUpsertOne) DoNothing() *ChatFolderChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatFolderChatCreate.OnConflict
+// documentation for more info.
+func (u *ChatFolderChatUpsertOne) Update(set func(*ChatFolderChatUpsert)) *ChatFolderChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatFolderChatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatFolderChatUpsertOne) SetUpdatedAt(v time.Time) *ChatFolderChatUpsertOne {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatFolderChatUpsertOne) UpdateUpdatedAt() *ChatFolderChatUpsertOne {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *ChatFolderChatUpsertOne) SetFolderID(v uuid.UUID) *ChatFolderChatUpsertOne {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *ChatFolderChatUpsertOne) UpdateFolderID() *ChatFolderChatUpsertOne {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatFolderChatUpsertOne) SetChatID(v uuid.UUID) *ChatFolderChatUpsertOne {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatFolderChatUpsertOne) UpdateChatID() *ChatFolderChatUpsertOne {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatFolderChatUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatFolderChatCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatFolderChatUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatFolderChatUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatFolderChatUpsertOne.ID is not supported by MySQL driver. Use ChatFolderChatUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatFolderChatUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatFolderChatCreateBulk is the builder for creating many ChatFolderChat entities in bulk.
+type ChatFolderChatCreateBulk struct {
+	config
+	err      error
+	builders []*ChatFolderChatCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatFolderChat entities in the database.
+func (_c *ChatFolderChatCreateBulk) Save(ctx context.Context) ([]*ChatFolderChat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ChatFolderChat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatFolderChatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ChatFolderChatCreateBulk) SaveX(ctx context.Context) []*ChatFolderChat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ChatFolderChatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ChatFolderChatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatFolderChat.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatFolderChatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ChatFolderChatCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatFolderChatUpsertBulk {
+	_c.conflict = opts
+	return &ChatFolderChatUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatFolderChat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ChatFolderChatCreateBulk) OnConflictColumns(columns ...string) *ChatFolderChatUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ChatFolderChatUpsertBulk{
+		create: _c,
+	}
+}
+
+// ChatFolderChatUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatFolderChat nodes.
+type ChatFolderChatUpsertBulk struct {
+	create *ChatFolderChatCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatFolderChat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatfolderchat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatFolderChatUpsertBulk) UpdateNewValues() *ChatFolderChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatfolderchat.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(chatfolderchat.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatFolderChat.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatFolderChatUpsertBulk) Ignore() *ChatFolderChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatFolderChatUpsertBulk) DoNothing() *ChatFolderChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatFolderChatCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatFolderChatUpsertBulk) Update(set func(*ChatFolderChatUpsert)) *ChatFolderChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatFolderChatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChatFolderChatUpsertBulk) SetUpdatedAt(v time.Time) *ChatFolderChatUpsertBulk {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChatFolderChatUpsertBulk) UpdateUpdatedAt() *ChatFolderChatUpsertBulk {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFolderID sets the "folder_id" field.
+func (u *ChatFolderChatUpsertBulk) SetFolderID(v uuid.UUID) *ChatFolderChatUpsertBulk {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.SetFolderID(v)
+	})
+}
+
+// UpdateFolderID sets the "folder_id" field to the value that was provided on create.
+func (u *ChatFolderChatUpsertBulk) UpdateFolderID() *ChatFolderChatUpsertBulk {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.UpdateFolderID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ChatFolderChatUpsertBulk) SetChatID(v uuid.UUID) *ChatFolderChatUpsertBulk {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ChatFolderChatUpsertBulk) UpdateChatID() *ChatFolderChatUpsertBulk {
+	return u.Update(func(s *ChatFolderChatUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatFolderChatUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatFolderChatCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatFolderChatCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatFolderChatUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
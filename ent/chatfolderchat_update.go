@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
+	"AtoiTalkAPI/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ChatFolderChatUpdate is the builder for updating ChatFolderChat entities.
+type ChatFolderChatUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChatFolderChatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ChatFolderChatUpdate builder.
+func (_u *ChatFolderChatUpdate) Where(ps ...predicate.ChatFolderChat) *ChatFolderChatUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatFolderChatUpdate) SetUpdatedAt(v time.Time) *ChatFolderChatUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFolderID sets the "folder_id" field.
+func (_u *ChatFolderChatUpdate) SetFolderID(v uuid.UUID) *ChatFolderChatUpdate {
+	_u.mutation.SetFolderID(v)
+	return _u
+}
+
+// SetNillableFolderID sets the "folder_id" field if the given value is not nil.
+func (_u *ChatFolderChatUpdate) SetNillableFolderID(v *uuid.UUID) *ChatFolderChatUpdate {
+	if v != nil {
+		_u.SetFolderID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatFolderChatUpdate) SetChatID(v uuid.UUID) *ChatFolderChatUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatFolderChatUpdate) SetNillableChatID(v *uuid.UUID) *ChatFolderChatUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetFolder sets the "folder" edge to the ChatFolder entity.
+func (_u *ChatFolderChatUpdate) SetFolder(v *ChatFolder) *ChatFolderChatUpdate {
+	return _u.SetFolderID(v.ID)
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *ChatFolderChatUpdate) SetChat(v *Chat) *ChatFolderChatUpdate {
+	return _u.SetChatID(v.ID)
+}
+
+// Mutation returns the ChatFolderChatMutation object of the builder.
+func (_u *ChatFolderChatUpdate) Mutation() *ChatFolderChatMutation {
+	return _u.mutation
+}
+
+// ClearFolder clears the "folder" edge to the ChatFolder entity.
+func (_u *ChatFolderChatUpdate) ClearFolder() *ChatFolderChatUpdate {
+	_u.mutation.ClearFolder()
+	return _u
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *ChatFolderChatUpdate) ClearChat() *ChatFolderChatUpdate {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ChatFolderChatUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatFolderChatUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ChatFolderChatUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatFolderChatUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatFolderChatUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatfolderchat.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatFolderChatUpdate) check() error {
+	if _u.mutation.FolderCleared() && len(_u.mutation.FolderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatFolderChat.folder"`)
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatFolderChat.chat"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatFolderChatUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatFolderChatUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ChatFolderChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatfolderchat.Table, chatfolderchat.Columns, sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatfolderchat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.FolderTable,
+			Columns: []string{chatfolderchat.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.FolderTable,
+			Columns: []string{chatfolderchat.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.ChatTable,
+			Columns: []string{chatfolderchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.ChatTable,
+			Columns: []string{chatfolderchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatfolderchat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ChatFolderChatUpdateOne is the builder for updating a single ChatFolderChat entity.
+type ChatFolderChatUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChatFolderChatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatFolderChatUpdateOne) SetUpdatedAt(v time.Time) *ChatFolderChatUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFolderID sets the "folder_id" field.
+func (_u *ChatFolderChatUpdateOne) SetFolderID(v uuid.UUID) *ChatFolderChatUpdateOne {
+	_u.mutation.SetFolderID(v)
+	return _u
+}
+
+// SetNillableFolderID sets the "folder_id" field if the given value is not nil.
+func (_u *ChatFolderChatUpdateOne) SetNillableFolderID(v *uuid.UUID) *ChatFolderChatUpdateOne {
+	if v != nil {
+		_u.SetFolderID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ChatFolderChatUpdateOne) SetChatID(v uuid.UUID) *ChatFolderChatUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ChatFolderChatUpdateOne) SetNillableChatID(v *uuid.UUID) *ChatFolderChatUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetFolder sets the "folder" edge to the ChatFolder entity.
+func (_u *ChatFolderChatUpdateOne) SetFolder(v *ChatFolder) *ChatFolderChatUpdateOne {
+	return _u.SetFolderID(v.ID)
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *ChatFolderChatUpdateOne) SetChat(v *Chat) *ChatFolderChatUpdateOne {
+	return _u.SetChatID(v.ID)
+}
+
+// Mutation returns the ChatFolderChatMutation object of the builder.
+func (_u *ChatFolderChatUpdateOne) Mutation() *ChatFolderChatMutation {
+	return _u.mutation
+}
+
+// ClearFolder clears the "folder" edge to the ChatFolder entity.
+func (_u *ChatFolderChatUpdateOne) ClearFolder() *ChatFolderChatUpdateOne {
+	_u.mutation.ClearFolder()
+	return _u
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *ChatFolderChatUpdateOne) ClearChat() *ChatFolderChatUpdateOne {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// Where appends a list predicates to the ChatFolderChatUpdate builder.
+func (_u *ChatFolderChatUpdateOne) Where(ps ...predicate.ChatFolderChat) *ChatFolderChatUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ChatFolderChatUpdateOne) Select(field string, fields ...string) *ChatFolderChatUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ChatFolderChat entity.
+func (_u *ChatFolderChatUpdateOne) Save(ctx context.Context) (*ChatFolderChat, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ChatFolderChatUpdateOne) SaveX(ctx context.Context) *ChatFolderChat {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ChatFolderChatUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ChatFolderChatUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ChatFolderChatUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := chatfolderchat.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ChatFolderChatUpdateOne) check() error {
+	if _u.mutation.FolderCleared() && len(_u.mutation.FolderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatFolderChat.folder"`)
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ChatFolderChat.chat"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ChatFolderChatUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChatFolderChatUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ChatFolderChatUpdateOne) sqlSave(ctx context.Context) (_node *ChatFolderChat, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatfolderchat.Table, chatfolderchat.Columns, sqlgraph.NewFieldSpec(chatfolderchat.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatFolderChat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatfolderchat.FieldID)
+		for _, f := range fields {
+			if !chatfolderchat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatfolderchat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chatfolderchat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.FolderTable,
+			Columns: []string{chatfolderchat.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.FolderTable,
+			Columns: []string{chatfolderchat.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.ChatTable,
+			Columns: []string{chatfolderchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatfolderchat.ChatTable,
+			Columns: []string{chatfolderchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ChatFolderChat{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatfolderchat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
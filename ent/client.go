@@ -12,12 +12,25 @@ import (
 	"AtoiTalkAPI/ent/migrate"
 
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/ent/useridentity"
@@ -36,18 +49,44 @@ type Client struct {
 	Schema *migrate.Schema
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
+	// ChatExport is the client for interacting with the ChatExport builders.
+	ChatExport *ChatExportClient
+	// ChatFolder is the client for interacting with the ChatFolder builders.
+	ChatFolder *ChatFolderClient
+	// ChatFolderChat is the client for interacting with the ChatFolderChat builders.
+	ChatFolderChat *ChatFolderChatClient
 	// GroupChat is the client for interacting with the GroupChat builders.
 	GroupChat *GroupChatClient
+	// GroupJoinRequest is the client for interacting with the GroupJoinRequest builders.
+	GroupJoinRequest *GroupJoinRequestClient
 	// GroupMember is the client for interacting with the GroupMember builders.
 	GroupMember *GroupMemberClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageMention is the client for interacting with the MessageMention builders.
+	MessageMention *MessageMentionClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// PinnedChat is the client for interacting with the PinnedChat builders.
+	PinnedChat *PinnedChatClient
+	// PinnedMessage is the client for interacting with the PinnedMessage builders.
+	PinnedMessage *PinnedMessageClient
+	// Poll is the client for interacting with the Poll builders.
+	Poll *PollClient
+	// PollOption is the client for interacting with the PollOption builders.
+	PollOption *PollOptionClient
+	// PollVote is the client for interacting with the PollVote builders.
+	PollVote *PollVoteClient
 	// PrivateChat is the client for interacting with the PrivateChat builders.
 	PrivateChat *PrivateChatClient
 	// Report is the client for interacting with the Report builders.
 	Report *ReportClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBlock is the client for interacting with the UserBlock builders.
@@ -66,12 +105,25 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Chat = NewChatClient(c.config)
+	c.ChatExport = NewChatExportClient(c.config)
+	c.ChatFolder = NewChatFolderClient(c.config)
+	c.ChatFolderChat = NewChatFolderChatClient(c.config)
 	c.GroupChat = NewGroupChatClient(c.config)
+	c.GroupJoinRequest = NewGroupJoinRequestClient(c.config)
 	c.GroupMember = NewGroupMemberClient(c.config)
 	c.Media = NewMediaClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.MessageMention = NewMessageMentionClient(c.config)
+	c.MessageReaction = NewMessageReactionClient(c.config)
+	c.MessageRevision = NewMessageRevisionClient(c.config)
+	c.PinnedChat = NewPinnedChatClient(c.config)
+	c.PinnedMessage = NewPinnedMessageClient(c.config)
+	c.Poll = NewPollClient(c.config)
+	c.PollOption = NewPollOptionClient(c.config)
+	c.PollVote = NewPollVoteClient(c.config)
 	c.PrivateChat = NewPrivateChatClient(c.config)
 	c.Report = NewReportClient(c.config)
+	c.ScheduledMessage = NewScheduledMessageClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserBlock = NewUserBlockClient(c.config)
 	c.UserIdentity = NewUserIdentityClient(c.config)
@@ -165,18 +217,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Chat:         NewChatClient(cfg),
-		GroupChat:    NewGroupChatClient(cfg),
-		GroupMember:  NewGroupMemberClient(cfg),
-		Media:        NewMediaClient(cfg),
-		Message:      NewMessageClient(cfg),
-		PrivateChat:  NewPrivateChatClient(cfg),
-		Report:       NewReportClient(cfg),
-		User:         NewUserClient(cfg),
-		UserBlock:    NewUserBlockClient(cfg),
-		UserIdentity: NewUserIdentityClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Chat:             NewChatClient(cfg),
+		ChatExport:       NewChatExportClient(cfg),
+		ChatFolder:       NewChatFolderClient(cfg),
+		ChatFolderChat:   NewChatFolderChatClient(cfg),
+		GroupChat:        NewGroupChatClient(cfg),
+		GroupJoinRequest: NewGroupJoinRequestClient(cfg),
+		GroupMember:      NewGroupMemberClient(cfg),
+		Media:            NewMediaClient(cfg),
+		Message:          NewMessageClient(cfg),
+		MessageMention:   NewMessageMentionClient(cfg),
+		MessageReaction:  NewMessageReactionClient(cfg),
+		MessageRevision:  NewMessageRevisionClient(cfg),
+		PinnedChat:       NewPinnedChatClient(cfg),
+		PinnedMessage:    NewPinnedMessageClient(cfg),
+		Poll:             NewPollClient(cfg),
+		PollOption:       NewPollOptionClient(cfg),
+		PollVote:         NewPollVoteClient(cfg),
+		PrivateChat:      NewPrivateChatClient(cfg),
+		Report:           NewReportClient(cfg),
+		ScheduledMessage: NewScheduledMessageClient(cfg),
+		User:             NewUserClient(cfg),
+		UserBlock:        NewUserBlockClient(cfg),
+		UserIdentity:     NewUserIdentityClient(cfg),
 	}, nil
 }
 
@@ -194,18 +259,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Chat:         NewChatClient(cfg),
-		GroupChat:    NewGroupChatClient(cfg),
-		GroupMember:  NewGroupMemberClient(cfg),
-		Media:        NewMediaClient(cfg),
-		Message:      NewMessageClient(cfg),
-		PrivateChat:  NewPrivateChatClient(cfg),
-		Report:       NewReportClient(cfg),
-		User:         NewUserClient(cfg),
-		UserBlock:    NewUserBlockClient(cfg),
-		UserIdentity: NewUserIdentityClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Chat:             NewChatClient(cfg),
+		ChatExport:       NewChatExportClient(cfg),
+		ChatFolder:       NewChatFolderClient(cfg),
+		ChatFolderChat:   NewChatFolderChatClient(cfg),
+		GroupChat:        NewGroupChatClient(cfg),
+		GroupJoinRequest: NewGroupJoinRequestClient(cfg),
+		GroupMember:      NewGroupMemberClient(cfg),
+		Media:            NewMediaClient(cfg),
+		Message:          NewMessageClient(cfg),
+		MessageMention:   NewMessageMentionClient(cfg),
+		MessageReaction:  NewMessageReactionClient(cfg),
+		MessageRevision:  NewMessageRevisionClient(cfg),
+		PinnedChat:       NewPinnedChatClient(cfg),
+		PinnedMessage:    NewPinnedMessageClient(cfg),
+		Poll:             NewPollClient(cfg),
+		PollOption:       NewPollOptionClient(cfg),
+		PollVote:         NewPollVoteClient(cfg),
+		PrivateChat:      NewPrivateChatClient(cfg),
+		Report:           NewReportClient(cfg),
+		ScheduledMessage: NewScheduledMessageClient(cfg),
+		User:             NewUserClient(cfg),
+		UserBlock:        NewUserBlockClient(cfg),
+		UserIdentity:     NewUserIdentityClient(cfg),
 	}, nil
 }
 
@@ -235,8 +313,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Chat, c.GroupChat, c.GroupMember, c.Media, c.Message, c.PrivateChat, c.Report,
-		c.User, c.UserBlock, c.UserIdentity,
+		c.Chat, c.ChatExport, c.ChatFolder, c.ChatFolderChat, c.GroupChat,
+		c.GroupJoinRequest, c.GroupMember, c.Media, c.Message, c.MessageMention,
+		c.MessageReaction, c.MessageRevision, c.PinnedChat, c.PinnedMessage, c.Poll,
+		c.PollOption, c.PollVote, c.PrivateChat, c.Report, c.ScheduledMessage, c.User,
+		c.UserBlock, c.UserIdentity,
 	} {
 		n.Use(hooks...)
 	}
@@ -246,8 +327,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Chat, c.GroupChat, c.GroupMember, c.Media, c.Message, c.PrivateChat, c.Report,
-		c.User, c.UserBlock, c.UserIdentity,
+		c.Chat, c.ChatExport, c.ChatFolder, c.ChatFolderChat, c.GroupChat,
+		c.GroupJoinRequest, c.GroupMember, c.Media, c.Message, c.MessageMention,
+		c.MessageReaction, c.MessageRevision, c.PinnedChat, c.PinnedMessage, c.Poll,
+		c.PollOption, c.PollVote, c.PrivateChat, c.Report, c.ScheduledMessage, c.User,
+		c.UserBlock, c.UserIdentity,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -258,18 +342,44 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ChatMutation:
 		return c.Chat.mutate(ctx, m)
+	case *ChatExportMutation:
+		return c.ChatExport.mutate(ctx, m)
+	case *ChatFolderMutation:
+		return c.ChatFolder.mutate(ctx, m)
+	case *ChatFolderChatMutation:
+		return c.ChatFolderChat.mutate(ctx, m)
 	case *GroupChatMutation:
 		return c.GroupChat.mutate(ctx, m)
+	case *GroupJoinRequestMutation:
+		return c.GroupJoinRequest.mutate(ctx, m)
 	case *GroupMemberMutation:
 		return c.GroupMember.mutate(ctx, m)
 	case *MediaMutation:
 		return c.Media.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *MessageMentionMutation:
+		return c.MessageMention.mutate(ctx, m)
+	case *MessageReactionMutation:
+		return c.MessageReaction.mutate(ctx, m)
+	case *MessageRevisionMutation:
+		return c.MessageRevision.mutate(ctx, m)
+	case *PinnedChatMutation:
+		return c.PinnedChat.mutate(ctx, m)
+	case *PinnedMessageMutation:
+		return c.PinnedMessage.mutate(ctx, m)
+	case *PollMutation:
+		return c.Poll.mutate(ctx, m)
+	case *PollOptionMutation:
+		return c.PollOption.mutate(ctx, m)
+	case *PollVoteMutation:
+		return c.PollVote.mutate(ctx, m)
 	case *PrivateChatMutation:
 		return c.PrivateChat.mutate(ctx, m)
 	case *ReportMutation:
 		return c.Report.mutate(ctx, m)
+	case *ScheduledMessageMutation:
+		return c.ScheduledMessage.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserBlockMutation:
@@ -453,6 +563,86 @@ func (c *ChatClient) QueryLastMessage(_m *Chat) *MessageQuery {
 	return query
 }
 
+// QueryPinnedMessages queries the pinned_messages edge of a Chat.
+func (c *ChatClient) QueryPinnedMessages(_m *Chat) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.PinnedMessagesTable, chat.PinnedMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages queries the scheduled_messages edge of a Chat.
+func (c *ChatClient) QueryScheduledMessages(_m *Chat) *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, id),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.ScheduledMessagesTable, chat.ScheduledMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExports queries the exports edge of a Chat.
+func (c *ChatClient) QueryExports(_m *Chat) *ChatExportQuery {
+	query := (&ChatExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, id),
+			sqlgraph.To(chatexport.Table, chatexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.ExportsTable, chat.ExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPinnedBy queries the pinned_by edge of a Chat.
+func (c *ChatClient) QueryPinnedBy(_m *Chat) *PinnedChatQuery {
+	query := (&PinnedChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, id),
+			sqlgraph.To(pinnedchat.Table, pinnedchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.PinnedByTable, chat.PinnedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolders queries the folders edge of a Chat.
+func (c *ChatClient) QueryFolders(_m *Chat) *ChatFolderChatQuery {
+	query := (&ChatFolderChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chat.Table, chat.FieldID, id),
+			sqlgraph.To(chatfolderchat.Table, chatfolderchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chat.FoldersTable, chat.FoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ChatClient) Hooks() []Hook {
 	return c.hooks.Chat
@@ -478,107 +668,107 @@ func (c *ChatClient) mutate(ctx context.Context, m *ChatMutation) (Value, error)
 	}
 }
 
-// GroupChatClient is a client for the GroupChat schema.
-type GroupChatClient struct {
+// ChatExportClient is a client for the ChatExport schema.
+type ChatExportClient struct {
 	config
 }
 
-// NewGroupChatClient returns a client for the GroupChat from the given config.
-func NewGroupChatClient(c config) *GroupChatClient {
-	return &GroupChatClient{config: c}
+// NewChatExportClient returns a client for the ChatExport from the given config.
+func NewChatExportClient(c config) *ChatExportClient {
+	return &ChatExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `groupchat.Hooks(f(g(h())))`.
-func (c *GroupChatClient) Use(hooks ...Hook) {
-	c.hooks.GroupChat = append(c.hooks.GroupChat, hooks...)
+// A call to `Use(f, g, h)` equals to `chatexport.Hooks(f(g(h())))`.
+func (c *ChatExportClient) Use(hooks ...Hook) {
+	c.hooks.ChatExport = append(c.hooks.ChatExport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `groupchat.Intercept(f(g(h())))`.
-func (c *GroupChatClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GroupChat = append(c.inters.GroupChat, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `chatexport.Intercept(f(g(h())))`.
+func (c *ChatExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatExport = append(c.inters.ChatExport, interceptors...)
 }
 
-// Create returns a builder for creating a GroupChat entity.
-func (c *GroupChatClient) Create() *GroupChatCreate {
-	mutation := newGroupChatMutation(c.config, OpCreate)
-	return &GroupChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ChatExport entity.
+func (c *ChatExportClient) Create() *ChatExportCreate {
+	mutation := newChatExportMutation(c.config, OpCreate)
+	return &ChatExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GroupChat entities.
-func (c *GroupChatClient) CreateBulk(builders ...*GroupChatCreate) *GroupChatCreateBulk {
-	return &GroupChatCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ChatExport entities.
+func (c *ChatExportClient) CreateBulk(builders ...*ChatExportCreate) *ChatExportCreateBulk {
+	return &ChatExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupChatClient) MapCreateBulk(slice any, setFunc func(*GroupChatCreate, int)) *GroupChatCreateBulk {
+func (c *ChatExportClient) MapCreateBulk(slice any, setFunc func(*ChatExportCreate, int)) *ChatExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupChatCreateBulk{err: fmt.Errorf("calling to GroupChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChatExportCreateBulk{err: fmt.Errorf("calling to ChatExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupChatCreate, rv.Len())
+	builders := make([]*ChatExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupChatCreateBulk{config: c.config, builders: builders}
+	return &ChatExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GroupChat.
-func (c *GroupChatClient) Update() *GroupChatUpdate {
-	mutation := newGroupChatMutation(c.config, OpUpdate)
-	return &GroupChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ChatExport.
+func (c *ChatExportClient) Update() *ChatExportUpdate {
+	mutation := newChatExportMutation(c.config, OpUpdate)
+	return &ChatExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupChatClient) UpdateOne(_m *GroupChat) *GroupChatUpdateOne {
-	mutation := newGroupChatMutation(c.config, OpUpdateOne, withGroupChat(_m))
-	return &GroupChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChatExportClient) UpdateOne(_m *ChatExport) *ChatExportUpdateOne {
+	mutation := newChatExportMutation(c.config, OpUpdateOne, withChatExport(_m))
+	return &ChatExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupChatClient) UpdateOneID(id uuid.UUID) *GroupChatUpdateOne {
-	mutation := newGroupChatMutation(c.config, OpUpdateOne, withGroupChatID(id))
-	return &GroupChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChatExportClient) UpdateOneID(id uuid.UUID) *ChatExportUpdateOne {
+	mutation := newChatExportMutation(c.config, OpUpdateOne, withChatExportID(id))
+	return &ChatExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GroupChat.
-func (c *GroupChatClient) Delete() *GroupChatDelete {
-	mutation := newGroupChatMutation(c.config, OpDelete)
-	return &GroupChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ChatExport.
+func (c *ChatExportClient) Delete() *ChatExportDelete {
+	mutation := newChatExportMutation(c.config, OpDelete)
+	return &ChatExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupChatClient) DeleteOne(_m *GroupChat) *GroupChatDeleteOne {
+func (c *ChatExportClient) DeleteOne(_m *ChatExport) *ChatExportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupChatClient) DeleteOneID(id uuid.UUID) *GroupChatDeleteOne {
-	builder := c.Delete().Where(groupchat.ID(id))
+func (c *ChatExportClient) DeleteOneID(id uuid.UUID) *ChatExportDeleteOne {
+	builder := c.Delete().Where(chatexport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupChatDeleteOne{builder}
+	return &ChatExportDeleteOne{builder}
 }
 
-// Query returns a query builder for GroupChat.
-func (c *GroupChatClient) Query() *GroupChatQuery {
-	return &GroupChatQuery{
+// Query returns a query builder for ChatExport.
+func (c *ChatExportClient) Query() *ChatExportQuery {
+	return &ChatExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroupChat},
+		ctx:    &QueryContext{Type: TypeChatExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GroupChat entity by its id.
-func (c *GroupChatClient) Get(ctx context.Context, id uuid.UUID) (*GroupChat, error) {
-	return c.Query().Where(groupchat.ID(id)).Only(ctx)
+// Get returns a ChatExport entity by its id.
+func (c *ChatExportClient) Get(ctx context.Context, id uuid.UUID) (*ChatExport, error) {
+	return c.Query().Where(chatexport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupChatClient) GetX(ctx context.Context, id uuid.UUID) *GroupChat {
+func (c *ChatExportClient) GetX(ctx context.Context, id uuid.UUID) *ChatExport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -586,31 +776,15 @@ func (c *GroupChatClient) GetX(ctx context.Context, id uuid.UUID) *GroupChat {
 	return obj
 }
 
-// QueryAvatar queries the avatar edge of a GroupChat.
-func (c *GroupChatClient) QueryAvatar(_m *GroupChat) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, groupchat.AvatarTable, groupchat.AvatarColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChat queries the chat edge of a GroupChat.
-func (c *GroupChatClient) QueryChat(_m *GroupChat) *ChatQuery {
+// QueryChat queries the chat edge of a ChatExport.
+func (c *ChatExportClient) QueryChat(_m *ChatExport) *ChatQuery {
 	query := (&ChatClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.From(chatexport.Table, chatexport.FieldID, id),
 			sqlgraph.To(chat.Table, chat.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, groupchat.ChatTable, groupchat.ChatColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatexport.ChatTable, chatexport.ChatColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -618,47 +792,15 @@ func (c *GroupChatClient) QueryChat(_m *GroupChat) *ChatQuery {
 	return query
 }
 
-// QueryCreator queries the creator edge of a GroupChat.
-func (c *GroupChatClient) QueryCreator(_m *GroupChat) *UserQuery {
+// QueryUser queries the user edge of a ChatExport.
+func (c *ChatExportClient) QueryUser(_m *ChatExport) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.From(chatexport.Table, chatexport.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, groupchat.CreatorTable, groupchat.CreatorColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMembers queries the members edge of a GroupChat.
-func (c *GroupChatClient) QueryMembers(_m *GroupChat) *GroupMemberQuery {
-	query := (&GroupMemberClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
-			sqlgraph.To(groupmember.Table, groupmember.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, groupchat.MembersTable, groupchat.MembersColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryReports queries the reports edge of a GroupChat.
-func (c *GroupChatClient) QueryReports(_m *GroupChat) *ReportQuery {
-	query := (&ReportClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, groupchat.ReportsTable, groupchat.ReportsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatexport.UserTable, chatexport.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -667,131 +809,131 @@ func (c *GroupChatClient) QueryReports(_m *GroupChat) *ReportQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *GroupChatClient) Hooks() []Hook {
-	return c.hooks.GroupChat
+func (c *ChatExportClient) Hooks() []Hook {
+	return c.hooks.ChatExport
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupChatClient) Interceptors() []Interceptor {
-	return c.inters.GroupChat
+func (c *ChatExportClient) Interceptors() []Interceptor {
+	return c.inters.ChatExport
 }
 
-func (c *GroupChatClient) mutate(ctx context.Context, m *GroupChatMutation) (Value, error) {
+func (c *ChatExportClient) mutate(ctx context.Context, m *ChatExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChatExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChatExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChatExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ChatExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GroupChat mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ChatExport mutation op: %q", m.Op())
 	}
 }
 
-// GroupMemberClient is a client for the GroupMember schema.
-type GroupMemberClient struct {
+// ChatFolderClient is a client for the ChatFolder schema.
+type ChatFolderClient struct {
 	config
 }
 
-// NewGroupMemberClient returns a client for the GroupMember from the given config.
-func NewGroupMemberClient(c config) *GroupMemberClient {
-	return &GroupMemberClient{config: c}
+// NewChatFolderClient returns a client for the ChatFolder from the given config.
+func NewChatFolderClient(c config) *ChatFolderClient {
+	return &ChatFolderClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `groupmember.Hooks(f(g(h())))`.
-func (c *GroupMemberClient) Use(hooks ...Hook) {
-	c.hooks.GroupMember = append(c.hooks.GroupMember, hooks...)
+// A call to `Use(f, g, h)` equals to `chatfolder.Hooks(f(g(h())))`.
+func (c *ChatFolderClient) Use(hooks ...Hook) {
+	c.hooks.ChatFolder = append(c.hooks.ChatFolder, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `groupmember.Intercept(f(g(h())))`.
-func (c *GroupMemberClient) Intercept(interceptors ...Interceptor) {
-	c.inters.GroupMember = append(c.inters.GroupMember, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `chatfolder.Intercept(f(g(h())))`.
+func (c *ChatFolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatFolder = append(c.inters.ChatFolder, interceptors...)
 }
 
-// Create returns a builder for creating a GroupMember entity.
-func (c *GroupMemberClient) Create() *GroupMemberCreate {
-	mutation := newGroupMemberMutation(c.config, OpCreate)
-	return &GroupMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ChatFolder entity.
+func (c *ChatFolderClient) Create() *ChatFolderCreate {
+	mutation := newChatFolderMutation(c.config, OpCreate)
+	return &ChatFolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of GroupMember entities.
-func (c *GroupMemberClient) CreateBulk(builders ...*GroupMemberCreate) *GroupMemberCreateBulk {
-	return &GroupMemberCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ChatFolder entities.
+func (c *ChatFolderClient) CreateBulk(builders ...*ChatFolderCreate) *ChatFolderCreateBulk {
+	return &ChatFolderCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *GroupMemberClient) MapCreateBulk(slice any, setFunc func(*GroupMemberCreate, int)) *GroupMemberCreateBulk {
+func (c *ChatFolderClient) MapCreateBulk(slice any, setFunc func(*ChatFolderCreate, int)) *ChatFolderCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &GroupMemberCreateBulk{err: fmt.Errorf("calling to GroupMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChatFolderCreateBulk{err: fmt.Errorf("calling to ChatFolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*GroupMemberCreate, rv.Len())
+	builders := make([]*ChatFolderCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &GroupMemberCreateBulk{config: c.config, builders: builders}
+	return &ChatFolderCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for GroupMember.
-func (c *GroupMemberClient) Update() *GroupMemberUpdate {
-	mutation := newGroupMemberMutation(c.config, OpUpdate)
-	return &GroupMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ChatFolder.
+func (c *ChatFolderClient) Update() *ChatFolderUpdate {
+	mutation := newChatFolderMutation(c.config, OpUpdate)
+	return &ChatFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *GroupMemberClient) UpdateOne(_m *GroupMember) *GroupMemberUpdateOne {
-	mutation := newGroupMemberMutation(c.config, OpUpdateOne, withGroupMember(_m))
-	return &GroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChatFolderClient) UpdateOne(_m *ChatFolder) *ChatFolderUpdateOne {
+	mutation := newChatFolderMutation(c.config, OpUpdateOne, withChatFolder(_m))
+	return &ChatFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *GroupMemberClient) UpdateOneID(id uuid.UUID) *GroupMemberUpdateOne {
-	mutation := newGroupMemberMutation(c.config, OpUpdateOne, withGroupMemberID(id))
-	return &GroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChatFolderClient) UpdateOneID(id uuid.UUID) *ChatFolderUpdateOne {
+	mutation := newChatFolderMutation(c.config, OpUpdateOne, withChatFolderID(id))
+	return &ChatFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for GroupMember.
-func (c *GroupMemberClient) Delete() *GroupMemberDelete {
-	mutation := newGroupMemberMutation(c.config, OpDelete)
-	return &GroupMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ChatFolder.
+func (c *ChatFolderClient) Delete() *ChatFolderDelete {
+	mutation := newChatFolderMutation(c.config, OpDelete)
+	return &ChatFolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *GroupMemberClient) DeleteOne(_m *GroupMember) *GroupMemberDeleteOne {
+func (c *ChatFolderClient) DeleteOne(_m *ChatFolder) *ChatFolderDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *GroupMemberClient) DeleteOneID(id uuid.UUID) *GroupMemberDeleteOne {
-	builder := c.Delete().Where(groupmember.ID(id))
+func (c *ChatFolderClient) DeleteOneID(id uuid.UUID) *ChatFolderDeleteOne {
+	builder := c.Delete().Where(chatfolder.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &GroupMemberDeleteOne{builder}
+	return &ChatFolderDeleteOne{builder}
 }
 
-// Query returns a query builder for GroupMember.
-func (c *GroupMemberClient) Query() *GroupMemberQuery {
-	return &GroupMemberQuery{
+// Query returns a query builder for ChatFolder.
+func (c *ChatFolderClient) Query() *ChatFolderQuery {
+	return &ChatFolderQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeGroupMember},
+		ctx:    &QueryContext{Type: TypeChatFolder},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a GroupMember entity by its id.
-func (c *GroupMemberClient) Get(ctx context.Context, id uuid.UUID) (*GroupMember, error) {
-	return c.Query().Where(groupmember.ID(id)).Only(ctx)
+// Get returns a ChatFolder entity by its id.
+func (c *ChatFolderClient) Get(ctx context.Context, id uuid.UUID) (*ChatFolder, error) {
+	return c.Query().Where(chatfolder.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *GroupMemberClient) GetX(ctx context.Context, id uuid.UUID) *GroupMember {
+func (c *ChatFolderClient) GetX(ctx context.Context, id uuid.UUID) *ChatFolder {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -799,15 +941,15 @@ func (c *GroupMemberClient) GetX(ctx context.Context, id uuid.UUID) *GroupMember
 	return obj
 }
 
-// QueryGroupChat queries the group_chat edge of a GroupMember.
-func (c *GroupMemberClient) QueryGroupChat(_m *GroupMember) *GroupChatQuery {
-	query := (&GroupChatClient{config: c.config}).Query()
+// QueryUser queries the user edge of a ChatFolder.
+func (c *ChatFolderClient) QueryUser(_m *ChatFolder) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(groupmember.Table, groupmember.FieldID, id),
-			sqlgraph.To(groupchat.Table, groupchat.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, groupmember.GroupChatTable, groupmember.GroupChatColumn),
+			sqlgraph.From(chatfolder.Table, chatfolder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatfolder.UserTable, chatfolder.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -815,15 +957,15 @@ func (c *GroupMemberClient) QueryGroupChat(_m *GroupMember) *GroupChatQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a GroupMember.
-func (c *GroupMemberClient) QueryUser(_m *GroupMember) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryChats queries the chats edge of a ChatFolder.
+func (c *ChatFolderClient) QueryChats(_m *ChatFolder) *ChatFolderChatQuery {
+	query := (&ChatFolderChatClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(groupmember.Table, groupmember.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, groupmember.UserTable, groupmember.UserColumn),
+			sqlgraph.From(chatfolder.Table, chatfolder.FieldID, id),
+			sqlgraph.To(chatfolderchat.Table, chatfolderchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, chatfolder.ChatsTable, chatfolder.ChatsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -832,56 +974,812 @@ func (c *GroupMemberClient) QueryUser(_m *GroupMember) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *GroupMemberClient) Hooks() []Hook {
-	return c.hooks.GroupMember
+func (c *ChatFolderClient) Hooks() []Hook {
+	return c.hooks.ChatFolder
 }
 
 // Interceptors returns the client interceptors.
-func (c *GroupMemberClient) Interceptors() []Interceptor {
-	return c.inters.GroupMember
+func (c *ChatFolderClient) Interceptors() []Interceptor {
+	return c.inters.ChatFolder
 }
 
-func (c *GroupMemberClient) mutate(ctx context.Context, m *GroupMemberMutation) (Value, error) {
+func (c *ChatFolderClient) mutate(ctx context.Context, m *ChatFolderMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&GroupMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChatFolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&GroupMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChatFolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&GroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChatFolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&GroupMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ChatFolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown GroupMember mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ChatFolder mutation op: %q", m.Op())
 	}
 }
 
-// MediaClient is a client for the Media schema.
-type MediaClient struct {
+// ChatFolderChatClient is a client for the ChatFolderChat schema.
+type ChatFolderChatClient struct {
 	config
 }
 
-// NewMediaClient returns a client for the Media from the given config.
-func NewMediaClient(c config) *MediaClient {
-	return &MediaClient{config: c}
+// NewChatFolderChatClient returns a client for the ChatFolderChat from the given config.
+func NewChatFolderChatClient(c config) *ChatFolderChatClient {
+	return &ChatFolderChatClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
-func (c *MediaClient) Use(hooks ...Hook) {
-	c.hooks.Media = append(c.hooks.Media, hooks...)
+// A call to `Use(f, g, h)` equals to `chatfolderchat.Hooks(f(g(h())))`.
+func (c *ChatFolderChatClient) Use(hooks ...Hook) {
+	c.hooks.ChatFolderChat = append(c.hooks.ChatFolderChat, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
-func (c *MediaClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Media = append(c.inters.Media, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `chatfolderchat.Intercept(f(g(h())))`.
+func (c *ChatFolderChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatFolderChat = append(c.inters.ChatFolderChat, interceptors...)
 }
 
-// Create returns a builder for creating a Media entity.
-func (c *MediaClient) Create() *MediaCreate {
-	mutation := newMediaMutation(c.config, OpCreate)
-	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ChatFolderChat entity.
+func (c *ChatFolderChatClient) Create() *ChatFolderChatCreate {
+	mutation := newChatFolderChatMutation(c.config, OpCreate)
+	return &ChatFolderChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatFolderChat entities.
+func (c *ChatFolderChatClient) CreateBulk(builders ...*ChatFolderChatCreate) *ChatFolderChatCreateBulk {
+	return &ChatFolderChatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatFolderChatClient) MapCreateBulk(slice any, setFunc func(*ChatFolderChatCreate, int)) *ChatFolderChatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatFolderChatCreateBulk{err: fmt.Errorf("calling to ChatFolderChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatFolderChatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatFolderChatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatFolderChat.
+func (c *ChatFolderChatClient) Update() *ChatFolderChatUpdate {
+	mutation := newChatFolderChatMutation(c.config, OpUpdate)
+	return &ChatFolderChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatFolderChatClient) UpdateOne(_m *ChatFolderChat) *ChatFolderChatUpdateOne {
+	mutation := newChatFolderChatMutation(c.config, OpUpdateOne, withChatFolderChat(_m))
+	return &ChatFolderChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatFolderChatClient) UpdateOneID(id uuid.UUID) *ChatFolderChatUpdateOne {
+	mutation := newChatFolderChatMutation(c.config, OpUpdateOne, withChatFolderChatID(id))
+	return &ChatFolderChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatFolderChat.
+func (c *ChatFolderChatClient) Delete() *ChatFolderChatDelete {
+	mutation := newChatFolderChatMutation(c.config, OpDelete)
+	return &ChatFolderChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatFolderChatClient) DeleteOne(_m *ChatFolderChat) *ChatFolderChatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatFolderChatClient) DeleteOneID(id uuid.UUID) *ChatFolderChatDeleteOne {
+	builder := c.Delete().Where(chatfolderchat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatFolderChatDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatFolderChat.
+func (c *ChatFolderChatClient) Query() *ChatFolderChatQuery {
+	return &ChatFolderChatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatFolderChat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatFolderChat entity by its id.
+func (c *ChatFolderChatClient) Get(ctx context.Context, id uuid.UUID) (*ChatFolderChat, error) {
+	return c.Query().Where(chatfolderchat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatFolderChatClient) GetX(ctx context.Context, id uuid.UUID) *ChatFolderChat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFolder queries the folder edge of a ChatFolderChat.
+func (c *ChatFolderChatClient) QueryFolder(_m *ChatFolderChat) *ChatFolderQuery {
+	query := (&ChatFolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatfolderchat.Table, chatfolderchat.FieldID, id),
+			sqlgraph.To(chatfolder.Table, chatfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatfolderchat.FolderTable, chatfolderchat.FolderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChat queries the chat edge of a ChatFolderChat.
+func (c *ChatFolderChatClient) QueryChat(_m *ChatFolderChat) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatfolderchat.Table, chatfolderchat.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatfolderchat.ChatTable, chatfolderchat.ChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChatFolderChatClient) Hooks() []Hook {
+	return c.hooks.ChatFolderChat
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatFolderChatClient) Interceptors() []Interceptor {
+	return c.inters.ChatFolderChat
+}
+
+func (c *ChatFolderChatClient) mutate(ctx context.Context, m *ChatFolderChatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatFolderChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatFolderChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatFolderChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatFolderChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatFolderChat mutation op: %q", m.Op())
+	}
+}
+
+// GroupChatClient is a client for the GroupChat schema.
+type GroupChatClient struct {
+	config
+}
+
+// NewGroupChatClient returns a client for the GroupChat from the given config.
+func NewGroupChatClient(c config) *GroupChatClient {
+	return &GroupChatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupchat.Hooks(f(g(h())))`.
+func (c *GroupChatClient) Use(hooks ...Hook) {
+	c.hooks.GroupChat = append(c.hooks.GroupChat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupchat.Intercept(f(g(h())))`.
+func (c *GroupChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupChat = append(c.inters.GroupChat, interceptors...)
+}
+
+// Create returns a builder for creating a GroupChat entity.
+func (c *GroupChatClient) Create() *GroupChatCreate {
+	mutation := newGroupChatMutation(c.config, OpCreate)
+	return &GroupChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupChat entities.
+func (c *GroupChatClient) CreateBulk(builders ...*GroupChatCreate) *GroupChatCreateBulk {
+	return &GroupChatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupChatClient) MapCreateBulk(slice any, setFunc func(*GroupChatCreate, int)) *GroupChatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupChatCreateBulk{err: fmt.Errorf("calling to GroupChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupChatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupChatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupChat.
+func (c *GroupChatClient) Update() *GroupChatUpdate {
+	mutation := newGroupChatMutation(c.config, OpUpdate)
+	return &GroupChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupChatClient) UpdateOne(_m *GroupChat) *GroupChatUpdateOne {
+	mutation := newGroupChatMutation(c.config, OpUpdateOne, withGroupChat(_m))
+	return &GroupChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupChatClient) UpdateOneID(id uuid.UUID) *GroupChatUpdateOne {
+	mutation := newGroupChatMutation(c.config, OpUpdateOne, withGroupChatID(id))
+	return &GroupChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupChat.
+func (c *GroupChatClient) Delete() *GroupChatDelete {
+	mutation := newGroupChatMutation(c.config, OpDelete)
+	return &GroupChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupChatClient) DeleteOne(_m *GroupChat) *GroupChatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupChatClient) DeleteOneID(id uuid.UUID) *GroupChatDeleteOne {
+	builder := c.Delete().Where(groupchat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupChatDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupChat.
+func (c *GroupChatClient) Query() *GroupChatQuery {
+	return &GroupChatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupChat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupChat entity by its id.
+func (c *GroupChatClient) Get(ctx context.Context, id uuid.UUID) (*GroupChat, error) {
+	return c.Query().Where(groupchat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupChatClient) GetX(ctx context.Context, id uuid.UUID) *GroupChat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAvatar queries the avatar edge of a GroupChat.
+func (c *GroupChatClient) QueryAvatar(_m *GroupChat) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, groupchat.AvatarTable, groupchat.AvatarColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChat queries the chat edge of a GroupChat.
+func (c *GroupChatClient) QueryChat(_m *GroupChat) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, groupchat.ChatTable, groupchat.ChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreator queries the creator edge of a GroupChat.
+func (c *GroupChatClient) QueryCreator(_m *GroupChat) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupchat.CreatorTable, groupchat.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a GroupChat.
+func (c *GroupChatClient) QueryMembers(_m *GroupChat) *GroupMemberQuery {
+	query := (&GroupMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.To(groupmember.Table, groupmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, groupchat.MembersTable, groupchat.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJoinRequests queries the join_requests edge of a GroupChat.
+func (c *GroupChatClient) QueryJoinRequests(_m *GroupChat) *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, groupchat.JoinRequestsTable, groupchat.JoinRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReports queries the reports edge of a GroupChat.
+func (c *GroupChatClient) QueryReports(_m *GroupChat) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, groupchat.ReportsTable, groupchat.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupChatClient) Hooks() []Hook {
+	return c.hooks.GroupChat
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupChatClient) Interceptors() []Interceptor {
+	return c.inters.GroupChat
+}
+
+func (c *GroupChatClient) mutate(ctx context.Context, m *GroupChatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupChat mutation op: %q", m.Op())
+	}
+}
+
+// GroupJoinRequestClient is a client for the GroupJoinRequest schema.
+type GroupJoinRequestClient struct {
+	config
+}
+
+// NewGroupJoinRequestClient returns a client for the GroupJoinRequest from the given config.
+func NewGroupJoinRequestClient(c config) *GroupJoinRequestClient {
+	return &GroupJoinRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupjoinrequest.Hooks(f(g(h())))`.
+func (c *GroupJoinRequestClient) Use(hooks ...Hook) {
+	c.hooks.GroupJoinRequest = append(c.hooks.GroupJoinRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupjoinrequest.Intercept(f(g(h())))`.
+func (c *GroupJoinRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupJoinRequest = append(c.inters.GroupJoinRequest, interceptors...)
+}
+
+// Create returns a builder for creating a GroupJoinRequest entity.
+func (c *GroupJoinRequestClient) Create() *GroupJoinRequestCreate {
+	mutation := newGroupJoinRequestMutation(c.config, OpCreate)
+	return &GroupJoinRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupJoinRequest entities.
+func (c *GroupJoinRequestClient) CreateBulk(builders ...*GroupJoinRequestCreate) *GroupJoinRequestCreateBulk {
+	return &GroupJoinRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupJoinRequestClient) MapCreateBulk(slice any, setFunc func(*GroupJoinRequestCreate, int)) *GroupJoinRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupJoinRequestCreateBulk{err: fmt.Errorf("calling to GroupJoinRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupJoinRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupJoinRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Update() *GroupJoinRequestUpdate {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdate)
+	return &GroupJoinRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupJoinRequestClient) UpdateOne(_m *GroupJoinRequest) *GroupJoinRequestUpdateOne {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdateOne, withGroupJoinRequest(_m))
+	return &GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupJoinRequestClient) UpdateOneID(id uuid.UUID) *GroupJoinRequestUpdateOne {
+	mutation := newGroupJoinRequestMutation(c.config, OpUpdateOne, withGroupJoinRequestID(id))
+	return &GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Delete() *GroupJoinRequestDelete {
+	mutation := newGroupJoinRequestMutation(c.config, OpDelete)
+	return &GroupJoinRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupJoinRequestClient) DeleteOne(_m *GroupJoinRequest) *GroupJoinRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupJoinRequestClient) DeleteOneID(id uuid.UUID) *GroupJoinRequestDeleteOne {
+	builder := c.Delete().Where(groupjoinrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupJoinRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupJoinRequest.
+func (c *GroupJoinRequestClient) Query() *GroupJoinRequestQuery {
+	return &GroupJoinRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupJoinRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupJoinRequest entity by its id.
+func (c *GroupJoinRequestClient) Get(ctx context.Context, id uuid.UUID) (*GroupJoinRequest, error) {
+	return c.Query().Where(groupjoinrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupJoinRequestClient) GetX(ctx context.Context, id uuid.UUID) *GroupJoinRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroupChat queries the group_chat edge of a GroupJoinRequest.
+func (c *GroupJoinRequestClient) QueryGroupChat(_m *GroupJoinRequest) *GroupChatQuery {
+	query := (&GroupChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, id),
+			sqlgraph.To(groupchat.Table, groupchat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupjoinrequest.GroupChatTable, groupjoinrequest.GroupChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a GroupJoinRequest.
+func (c *GroupJoinRequestClient) QueryUser(_m *GroupJoinRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupjoinrequest.Table, groupjoinrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupjoinrequest.UserTable, groupjoinrequest.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupJoinRequestClient) Hooks() []Hook {
+	return c.hooks.GroupJoinRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupJoinRequestClient) Interceptors() []Interceptor {
+	return c.inters.GroupJoinRequest
+}
+
+func (c *GroupJoinRequestClient) mutate(ctx context.Context, m *GroupJoinRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupJoinRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupJoinRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupJoinRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupJoinRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupJoinRequest mutation op: %q", m.Op())
+	}
+}
+
+// GroupMemberClient is a client for the GroupMember schema.
+type GroupMemberClient struct {
+	config
+}
+
+// NewGroupMemberClient returns a client for the GroupMember from the given config.
+func NewGroupMemberClient(c config) *GroupMemberClient {
+	return &GroupMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `groupmember.Hooks(f(g(h())))`.
+func (c *GroupMemberClient) Use(hooks ...Hook) {
+	c.hooks.GroupMember = append(c.hooks.GroupMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `groupmember.Intercept(f(g(h())))`.
+func (c *GroupMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GroupMember = append(c.inters.GroupMember, interceptors...)
+}
+
+// Create returns a builder for creating a GroupMember entity.
+func (c *GroupMemberClient) Create() *GroupMemberCreate {
+	mutation := newGroupMemberMutation(c.config, OpCreate)
+	return &GroupMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GroupMember entities.
+func (c *GroupMemberClient) CreateBulk(builders ...*GroupMemberCreate) *GroupMemberCreateBulk {
+	return &GroupMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupMemberClient) MapCreateBulk(slice any, setFunc func(*GroupMemberCreate, int)) *GroupMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupMemberCreateBulk{err: fmt.Errorf("calling to GroupMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GroupMember.
+func (c *GroupMemberClient) Update() *GroupMemberUpdate {
+	mutation := newGroupMemberMutation(c.config, OpUpdate)
+	return &GroupMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupMemberClient) UpdateOne(_m *GroupMember) *GroupMemberUpdateOne {
+	mutation := newGroupMemberMutation(c.config, OpUpdateOne, withGroupMember(_m))
+	return &GroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupMemberClient) UpdateOneID(id uuid.UUID) *GroupMemberUpdateOne {
+	mutation := newGroupMemberMutation(c.config, OpUpdateOne, withGroupMemberID(id))
+	return &GroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GroupMember.
+func (c *GroupMemberClient) Delete() *GroupMemberDelete {
+	mutation := newGroupMemberMutation(c.config, OpDelete)
+	return &GroupMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupMemberClient) DeleteOne(_m *GroupMember) *GroupMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupMemberClient) DeleteOneID(id uuid.UUID) *GroupMemberDeleteOne {
+	builder := c.Delete().Where(groupmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for GroupMember.
+func (c *GroupMemberClient) Query() *GroupMemberQuery {
+	return &GroupMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroupMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GroupMember entity by its id.
+func (c *GroupMemberClient) Get(ctx context.Context, id uuid.UUID) (*GroupMember, error) {
+	return c.Query().Where(groupmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupMemberClient) GetX(ctx context.Context, id uuid.UUID) *GroupMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGroupChat queries the group_chat edge of a GroupMember.
+func (c *GroupMemberClient) QueryGroupChat(_m *GroupMember) *GroupChatQuery {
+	query := (&GroupChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmember.Table, groupmember.FieldID, id),
+			sqlgraph.To(groupchat.Table, groupchat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupmember.GroupChatTable, groupmember.GroupChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a GroupMember.
+func (c *GroupMemberClient) QueryUser(_m *GroupMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmember.Table, groupmember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, groupmember.UserTable, groupmember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLastReadMessage queries the last_read_message edge of a GroupMember.
+func (c *GroupMemberClient) QueryLastReadMessage(_m *GroupMember) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmember.Table, groupmember.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmember.LastReadMessageTable, groupmember.LastReadMessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLastDeliveredMessage queries the last_delivered_message edge of a GroupMember.
+func (c *GroupMemberClient) QueryLastDeliveredMessage(_m *GroupMember) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmember.Table, groupmember.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmember.LastDeliveredMessageTable, groupmember.LastDeliveredMessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupMemberClient) Hooks() []Hook {
+	return c.hooks.GroupMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupMemberClient) Interceptors() []Interceptor {
+	return c.inters.GroupMember
+}
+
+func (c *GroupMemberClient) mutate(ctx context.Context, m *GroupMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GroupMember mutation op: %q", m.Op())
+	}
+}
+
+// MediaClient is a client for the Media schema.
+type MediaClient struct {
+	config
+}
+
+// NewMediaClient returns a client for the Media from the given config.
+func NewMediaClient(c config) *MediaClient {
+	return &MediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
+func (c *MediaClient) Use(hooks ...Hook) {
+	c.hooks.Media = append(c.hooks.Media, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
+func (c *MediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Media = append(c.inters.Media, interceptors...)
+}
+
+// Create returns a builder for creating a Media entity.
+func (c *MediaClient) Create() *MediaCreate {
+	mutation := newMediaMutation(c.config, OpCreate)
+	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // CreateBulk returns a builder for creating a bulk of Media entities.
@@ -891,72 +1789,1515 @@ func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Media.
+func (c *MediaClient) Update() *MediaUpdate {
+	mutation := newMediaMutation(c.config, OpUpdate)
+	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaClient) UpdateOne(_m *Media) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(_m))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaClient) UpdateOneID(id uuid.UUID) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Media.
+func (c *MediaClient) Delete() *MediaDelete {
+	mutation := newMediaMutation(c.config, OpDelete)
+	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaClient) DeleteOne(_m *Media) *MediaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaClient) DeleteOneID(id uuid.UUID) *MediaDeleteOne {
+	builder := c.Delete().Where(media.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaDeleteOne{builder}
+}
+
+// Query returns a query builder for Media.
+func (c *MediaClient) Query() *MediaQuery {
+	return &MediaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMedia},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Media entity by its id.
+func (c *MediaClient) Get(ctx context.Context, id uuid.UUID) (*Media, error) {
+	return c.Query().Where(media.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a Media.
+func (c *MediaClient) QueryMessage(_m *Media) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.MessageTable, media.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledMessage queries the scheduled_message edge of a Media.
+func (c *MediaClient) QueryScheduledMessage(_m *Media) *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.ScheduledMessageTable, media.ScheduledMessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserAvatar queries the user_avatar edge of a Media.
+func (c *MediaClient) QueryUserAvatar(_m *Media) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, media.UserAvatarTable, media.UserAvatarColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupAvatar queries the group_avatar edge of a Media.
+func (c *MediaClient) QueryGroupAvatar(_m *Media) *GroupChatQuery {
+	query := (&GroupChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(groupchat.Table, groupchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, media.GroupAvatarTable, media.GroupAvatarColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploader queries the uploader edge of a Media.
+func (c *MediaClient) QueryUploader(_m *Media) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.UploaderTable, media.UploaderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReports queries the reports edge of a Media.
+func (c *MediaClient) QueryReports(_m *Media) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, media.ReportsTable, media.ReportsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessageRevisions queries the message_revisions edge of a Media.
+func (c *MediaClient) QueryMessageRevisions(_m *Media) *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, media.MessageRevisionsTable, media.MessageRevisionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaClient) Hooks() []Hook {
+	return c.hooks.Media
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaClient) Interceptors() []Interceptor {
+	return c.inters.Media
+}
+
+func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id uuid.UUID) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id uuid.UUID) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id uuid.UUID) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id uuid.UUID) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChat queries the chat edge of a Message.
+func (c *MessageClient) QueryChat(_m *Message) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.ChatTable, message.ChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySender queries the sender edge of a Message.
+func (c *MessageClient) QuerySender(_m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.SenderTable, message.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a Message.
+func (c *MessageClient) QueryReplies(_m *Message) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.RepliesTable, message.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplyTo queries the reply_to edge of a Message.
+func (c *MessageClient) QueryReplyTo(_m *Message) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ReplyToTable, message.ReplyToColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a Message.
+func (c *MessageClient) QueryAttachments(_m *Message) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.AttachmentsTable, message.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReactions queries the reactions edge of a Message.
+func (c *MessageClient) QueryReactions(_m *Message) *MessageReactionQuery {
+	query := (&MessageReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ReactionsTable, message.ReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPins queries the pins edge of a Message.
+func (c *MessageClient) QueryPins(_m *Message) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.PinsTable, message.PinsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Message.
+func (c *MessageClient) QueryRevisions(_m *Message) *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a Message.
+func (c *MessageClient) QueryMentions(_m *Message) *MessageMentionQuery {
+	query := (&MessageMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.MentionsTable, message.MentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPoll queries the poll edge of a Message.
+func (c *MessageClient) QueryPoll(_m *Message) *PollQuery {
+	query := (&PollClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, message.PollTable, message.PollColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryForwardedFromSender queries the forwarded_from_sender edge of a Message.
+func (c *MessageClient) QueryForwardedFromSender(_m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ForwardedFromSenderTable, message.ForwardedFromSenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryForwardedFromChat queries the forwarded_from_chat edge of a Message.
+func (c *MessageClient) QueryForwardedFromChat(_m *Message) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ForwardedFromChatTable, message.ForwardedFromChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContactUser queries the contact_user edge of a Message.
+func (c *MessageClient) QueryContactUser(_m *Message) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ContactUserTable, message.ContactUserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReports queries the reports edge of a Message.
+func (c *MessageClient) QueryReports(_m *Message) *ReportQuery {
+	query := (&ReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(report.Table, report.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.ReportsTable, message.ReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// MessageMentionClient is a client for the MessageMention schema.
+type MessageMentionClient struct {
+	config
+}
+
+// NewMessageMentionClient returns a client for the MessageMention from the given config.
+func NewMessageMentionClient(c config) *MessageMentionClient {
+	return &MessageMentionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagemention.Hooks(f(g(h())))`.
+func (c *MessageMentionClient) Use(hooks ...Hook) {
+	c.hooks.MessageMention = append(c.hooks.MessageMention, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagemention.Intercept(f(g(h())))`.
+func (c *MessageMentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageMention = append(c.inters.MessageMention, interceptors...)
+}
+
+// Create returns a builder for creating a MessageMention entity.
+func (c *MessageMentionClient) Create() *MessageMentionCreate {
+	mutation := newMessageMentionMutation(c.config, OpCreate)
+	return &MessageMentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageMention entities.
+func (c *MessageMentionClient) CreateBulk(builders ...*MessageMentionCreate) *MessageMentionCreateBulk {
+	return &MessageMentionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageMentionClient) MapCreateBulk(slice any, setFunc func(*MessageMentionCreate, int)) *MessageMentionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageMentionCreateBulk{err: fmt.Errorf("calling to MessageMentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageMentionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageMentionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageMention.
+func (c *MessageMentionClient) Update() *MessageMentionUpdate {
+	mutation := newMessageMentionMutation(c.config, OpUpdate)
+	return &MessageMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageMentionClient) UpdateOne(_m *MessageMention) *MessageMentionUpdateOne {
+	mutation := newMessageMentionMutation(c.config, OpUpdateOne, withMessageMention(_m))
+	return &MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageMentionClient) UpdateOneID(id uuid.UUID) *MessageMentionUpdateOne {
+	mutation := newMessageMentionMutation(c.config, OpUpdateOne, withMessageMentionID(id))
+	return &MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageMention.
+func (c *MessageMentionClient) Delete() *MessageMentionDelete {
+	mutation := newMessageMentionMutation(c.config, OpDelete)
+	return &MessageMentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageMentionClient) DeleteOne(_m *MessageMention) *MessageMentionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageMentionClient) DeleteOneID(id uuid.UUID) *MessageMentionDeleteOne {
+	builder := c.Delete().Where(messagemention.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageMentionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageMention.
+func (c *MessageMentionClient) Query() *MessageMentionQuery {
+	return &MessageMentionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageMention},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageMention entity by its id.
+func (c *MessageMentionClient) Get(ctx context.Context, id uuid.UUID) (*MessageMention, error) {
+	return c.Query().Where(messagemention.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageMentionClient) GetX(ctx context.Context, id uuid.UUID) *MessageMention {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageMention.
+func (c *MessageMentionClient) QueryMessage(_m *MessageMention) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagemention.Table, messagemention.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagemention.MessageTable, messagemention.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a MessageMention.
+func (c *MessageMentionClient) QueryUser(_m *MessageMention) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagemention.Table, messagemention.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagemention.UserTable, messagemention.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageMentionClient) Hooks() []Hook {
+	return c.hooks.MessageMention
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageMentionClient) Interceptors() []Interceptor {
+	return c.inters.MessageMention
+}
+
+func (c *MessageMentionClient) mutate(ctx context.Context, m *MessageMentionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageMentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageMentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageMention mutation op: %q", m.Op())
+	}
+}
+
+// MessageReactionClient is a client for the MessageReaction schema.
+type MessageReactionClient struct {
+	config
+}
+
+// NewMessageReactionClient returns a client for the MessageReaction from the given config.
+func NewMessageReactionClient(c config) *MessageReactionClient {
+	return &MessageReactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagereaction.Hooks(f(g(h())))`.
+func (c *MessageReactionClient) Use(hooks ...Hook) {
+	c.hooks.MessageReaction = append(c.hooks.MessageReaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagereaction.Intercept(f(g(h())))`.
+func (c *MessageReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageReaction = append(c.inters.MessageReaction, interceptors...)
+}
+
+// Create returns a builder for creating a MessageReaction entity.
+func (c *MessageReactionClient) Create() *MessageReactionCreate {
+	mutation := newMessageReactionMutation(c.config, OpCreate)
+	return &MessageReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageReaction entities.
+func (c *MessageReactionClient) CreateBulk(builders ...*MessageReactionCreate) *MessageReactionCreateBulk {
+	return &MessageReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageReactionClient) MapCreateBulk(slice any, setFunc func(*MessageReactionCreate, int)) *MessageReactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageReactionCreateBulk{err: fmt.Errorf("calling to MessageReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageReactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageReaction.
+func (c *MessageReactionClient) Update() *MessageReactionUpdate {
+	mutation := newMessageReactionMutation(c.config, OpUpdate)
+	return &MessageReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageReactionClient) UpdateOne(_m *MessageReaction) *MessageReactionUpdateOne {
+	mutation := newMessageReactionMutation(c.config, OpUpdateOne, withMessageReaction(_m))
+	return &MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageReactionClient) UpdateOneID(id uuid.UUID) *MessageReactionUpdateOne {
+	mutation := newMessageReactionMutation(c.config, OpUpdateOne, withMessageReactionID(id))
+	return &MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageReaction.
+func (c *MessageReactionClient) Delete() *MessageReactionDelete {
+	mutation := newMessageReactionMutation(c.config, OpDelete)
+	return &MessageReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageReactionClient) DeleteOne(_m *MessageReaction) *MessageReactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageReactionClient) DeleteOneID(id uuid.UUID) *MessageReactionDeleteOne {
+	builder := c.Delete().Where(messagereaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageReactionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageReaction.
+func (c *MessageReactionClient) Query() *MessageReactionQuery {
+	return &MessageReactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageReaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageReaction entity by its id.
+func (c *MessageReactionClient) Get(ctx context.Context, id uuid.UUID) (*MessageReaction, error) {
+	return c.Query().Where(messagereaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageReactionClient) GetX(ctx context.Context, id uuid.UUID) *MessageReaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageReaction.
+func (c *MessageReactionClient) QueryMessage(_m *MessageReaction) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagereaction.Table, messagereaction.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagereaction.MessageTable, messagereaction.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a MessageReaction.
+func (c *MessageReactionClient) QueryUser(_m *MessageReaction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagereaction.Table, messagereaction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagereaction.UserTable, messagereaction.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageReactionClient) Hooks() []Hook {
+	return c.hooks.MessageReaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageReactionClient) Interceptors() []Interceptor {
+	return c.inters.MessageReaction
+}
+
+func (c *MessageReactionClient) mutate(ctx context.Context, m *MessageReactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageReaction mutation op: %q", m.Op())
+	}
+}
+
+// MessageRevisionClient is a client for the MessageRevision schema.
+type MessageRevisionClient struct {
+	config
+}
+
+// NewMessageRevisionClient returns a client for the MessageRevision from the given config.
+func NewMessageRevisionClient(c config) *MessageRevisionClient {
+	return &MessageRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagerevision.Hooks(f(g(h())))`.
+func (c *MessageRevisionClient) Use(hooks ...Hook) {
+	c.hooks.MessageRevision = append(c.hooks.MessageRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `messagerevision.Intercept(f(g(h())))`.
+func (c *MessageRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageRevision = append(c.inters.MessageRevision, interceptors...)
+}
+
+// Create returns a builder for creating a MessageRevision entity.
+func (c *MessageRevisionClient) Create() *MessageRevisionCreate {
+	mutation := newMessageRevisionMutation(c.config, OpCreate)
+	return &MessageRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageRevision entities.
+func (c *MessageRevisionClient) CreateBulk(builders ...*MessageRevisionCreate) *MessageRevisionCreateBulk {
+	return &MessageRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageRevisionClient) MapCreateBulk(slice any, setFunc func(*MessageRevisionCreate, int)) *MessageRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageRevisionCreateBulk{err: fmt.Errorf("calling to MessageRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageRevision.
+func (c *MessageRevisionClient) Update() *MessageRevisionUpdate {
+	mutation := newMessageRevisionMutation(c.config, OpUpdate)
+	return &MessageRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageRevisionClient) UpdateOne(_m *MessageRevision) *MessageRevisionUpdateOne {
+	mutation := newMessageRevisionMutation(c.config, OpUpdateOne, withMessageRevision(_m))
+	return &MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageRevisionClient) UpdateOneID(id uuid.UUID) *MessageRevisionUpdateOne {
+	mutation := newMessageRevisionMutation(c.config, OpUpdateOne, withMessageRevisionID(id))
+	return &MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageRevision.
+func (c *MessageRevisionClient) Delete() *MessageRevisionDelete {
+	mutation := newMessageRevisionMutation(c.config, OpDelete)
+	return &MessageRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageRevisionClient) DeleteOne(_m *MessageRevision) *MessageRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageRevisionClient) DeleteOneID(id uuid.UUID) *MessageRevisionDeleteOne {
+	builder := c.Delete().Where(messagerevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageRevision.
+func (c *MessageRevisionClient) Query() *MessageRevisionQuery {
+	return &MessageRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageRevision entity by its id.
+func (c *MessageRevisionClient) Get(ctx context.Context, id uuid.UUID) (*MessageRevision, error) {
+	return c.Query().Where(messagerevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageRevisionClient) GetX(ctx context.Context, id uuid.UUID) *MessageRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a MessageRevision.
+func (c *MessageRevisionClient) QueryMessage(_m *MessageRevision) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagerevision.Table, messagerevision.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, messagerevision.MessageTable, messagerevision.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a MessageRevision.
+func (c *MessageRevisionClient) QueryAttachments(_m *MessageRevision) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(messagerevision.Table, messagerevision.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, messagerevision.AttachmentsTable, messagerevision.AttachmentsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageRevisionClient) Hooks() []Hook {
+	return c.hooks.MessageRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageRevisionClient) Interceptors() []Interceptor {
+	return c.inters.MessageRevision
+}
+
+func (c *MessageRevisionClient) mutate(ctx context.Context, m *MessageRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageRevision mutation op: %q", m.Op())
+	}
+}
+
+// PinnedChatClient is a client for the PinnedChat schema.
+type PinnedChatClient struct {
+	config
+}
+
+// NewPinnedChatClient returns a client for the PinnedChat from the given config.
+func NewPinnedChatClient(c config) *PinnedChatClient {
+	return &PinnedChatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pinnedchat.Hooks(f(g(h())))`.
+func (c *PinnedChatClient) Use(hooks ...Hook) {
+	c.hooks.PinnedChat = append(c.hooks.PinnedChat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pinnedchat.Intercept(f(g(h())))`.
+func (c *PinnedChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PinnedChat = append(c.inters.PinnedChat, interceptors...)
+}
+
+// Create returns a builder for creating a PinnedChat entity.
+func (c *PinnedChatClient) Create() *PinnedChatCreate {
+	mutation := newPinnedChatMutation(c.config, OpCreate)
+	return &PinnedChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PinnedChat entities.
+func (c *PinnedChatClient) CreateBulk(builders ...*PinnedChatCreate) *PinnedChatCreateBulk {
+	return &PinnedChatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PinnedChatClient) MapCreateBulk(slice any, setFunc func(*PinnedChatCreate, int)) *PinnedChatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PinnedChatCreateBulk{err: fmt.Errorf("calling to PinnedChatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PinnedChatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PinnedChatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PinnedChat.
+func (c *PinnedChatClient) Update() *PinnedChatUpdate {
+	mutation := newPinnedChatMutation(c.config, OpUpdate)
+	return &PinnedChatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PinnedChatClient) UpdateOne(_m *PinnedChat) *PinnedChatUpdateOne {
+	mutation := newPinnedChatMutation(c.config, OpUpdateOne, withPinnedChat(_m))
+	return &PinnedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PinnedChatClient) UpdateOneID(id uuid.UUID) *PinnedChatUpdateOne {
+	mutation := newPinnedChatMutation(c.config, OpUpdateOne, withPinnedChatID(id))
+	return &PinnedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PinnedChat.
+func (c *PinnedChatClient) Delete() *PinnedChatDelete {
+	mutation := newPinnedChatMutation(c.config, OpDelete)
+	return &PinnedChatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PinnedChatClient) DeleteOne(_m *PinnedChat) *PinnedChatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PinnedChatClient) DeleteOneID(id uuid.UUID) *PinnedChatDeleteOne {
+	builder := c.Delete().Where(pinnedchat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PinnedChatDeleteOne{builder}
+}
+
+// Query returns a query builder for PinnedChat.
+func (c *PinnedChatClient) Query() *PinnedChatQuery {
+	return &PinnedChatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePinnedChat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PinnedChat entity by its id.
+func (c *PinnedChatClient) Get(ctx context.Context, id uuid.UUID) (*PinnedChat, error) {
+	return c.Query().Where(pinnedchat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PinnedChatClient) GetX(ctx context.Context, id uuid.UUID) *PinnedChat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PinnedChat.
+func (c *PinnedChatClient) QueryUser(_m *PinnedChat) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedchat.Table, pinnedchat.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedchat.UserTable, pinnedchat.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChat queries the chat edge of a PinnedChat.
+func (c *PinnedChatClient) QueryChat(_m *PinnedChat) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedchat.Table, pinnedchat.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedchat.ChatTable, pinnedchat.ChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PinnedChatClient) Hooks() []Hook {
+	return c.hooks.PinnedChat
+}
+
+// Interceptors returns the client interceptors.
+func (c *PinnedChatClient) Interceptors() []Interceptor {
+	return c.inters.PinnedChat
+}
+
+func (c *PinnedChatClient) mutate(ctx context.Context, m *PinnedChatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PinnedChatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PinnedChatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PinnedChatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PinnedChatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PinnedChat mutation op: %q", m.Op())
+	}
+}
+
+// PinnedMessageClient is a client for the PinnedMessage schema.
+type PinnedMessageClient struct {
+	config
+}
+
+// NewPinnedMessageClient returns a client for the PinnedMessage from the given config.
+func NewPinnedMessageClient(c config) *PinnedMessageClient {
+	return &PinnedMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pinnedmessage.Hooks(f(g(h())))`.
+func (c *PinnedMessageClient) Use(hooks ...Hook) {
+	c.hooks.PinnedMessage = append(c.hooks.PinnedMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pinnedmessage.Intercept(f(g(h())))`.
+func (c *PinnedMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PinnedMessage = append(c.inters.PinnedMessage, interceptors...)
+}
+
+// Create returns a builder for creating a PinnedMessage entity.
+func (c *PinnedMessageClient) Create() *PinnedMessageCreate {
+	mutation := newPinnedMessageMutation(c.config, OpCreate)
+	return &PinnedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PinnedMessage entities.
+func (c *PinnedMessageClient) CreateBulk(builders ...*PinnedMessageCreate) *PinnedMessageCreateBulk {
+	return &PinnedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PinnedMessageClient) MapCreateBulk(slice any, setFunc func(*PinnedMessageCreate, int)) *PinnedMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PinnedMessageCreateBulk{err: fmt.Errorf("calling to PinnedMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PinnedMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PinnedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PinnedMessage.
+func (c *PinnedMessageClient) Update() *PinnedMessageUpdate {
+	mutation := newPinnedMessageMutation(c.config, OpUpdate)
+	return &PinnedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PinnedMessageClient) UpdateOne(_m *PinnedMessage) *PinnedMessageUpdateOne {
+	mutation := newPinnedMessageMutation(c.config, OpUpdateOne, withPinnedMessage(_m))
+	return &PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PinnedMessageClient) UpdateOneID(id uuid.UUID) *PinnedMessageUpdateOne {
+	mutation := newPinnedMessageMutation(c.config, OpUpdateOne, withPinnedMessageID(id))
+	return &PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PinnedMessage.
+func (c *PinnedMessageClient) Delete() *PinnedMessageDelete {
+	mutation := newPinnedMessageMutation(c.config, OpDelete)
+	return &PinnedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PinnedMessageClient) DeleteOne(_m *PinnedMessage) *PinnedMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PinnedMessageClient) DeleteOneID(id uuid.UUID) *PinnedMessageDeleteOne {
+	builder := c.Delete().Where(pinnedmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PinnedMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for PinnedMessage.
+func (c *PinnedMessageClient) Query() *PinnedMessageQuery {
+	return &PinnedMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePinnedMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PinnedMessage entity by its id.
+func (c *PinnedMessageClient) Get(ctx context.Context, id uuid.UUID) (*PinnedMessage, error) {
+	return c.Query().Where(pinnedmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PinnedMessageClient) GetX(ctx context.Context, id uuid.UUID) *PinnedMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChat queries the chat edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryChat(_m *PinnedMessage) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedmessage.ChatTable, pinnedmessage.ChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMessage queries the message edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryMessage(_m *PinnedMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedmessage.MessageTable, pinnedmessage.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPinnedBy queries the pinned_by edge of a PinnedMessage.
+func (c *PinnedMessageClient) QueryPinnedBy(_m *PinnedMessage) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pinnedmessage.Table, pinnedmessage.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pinnedmessage.PinnedByTable, pinnedmessage.PinnedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PinnedMessageClient) Hooks() []Hook {
+	return c.hooks.PinnedMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *PinnedMessageClient) Interceptors() []Interceptor {
+	return c.inters.PinnedMessage
+}
+
+func (c *PinnedMessageClient) mutate(ctx context.Context, m *PinnedMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PinnedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PinnedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PinnedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PinnedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PinnedMessage mutation op: %q", m.Op())
+	}
+}
+
+// PollClient is a client for the Poll schema.
+type PollClient struct {
+	config
+}
+
+// NewPollClient returns a client for the Poll from the given config.
+func NewPollClient(c config) *PollClient {
+	return &PollClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `poll.Hooks(f(g(h())))`.
+func (c *PollClient) Use(hooks ...Hook) {
+	c.hooks.Poll = append(c.hooks.Poll, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `poll.Intercept(f(g(h())))`.
+func (c *PollClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Poll = append(c.inters.Poll, interceptors...)
+}
+
+// Create returns a builder for creating a Poll entity.
+func (c *PollClient) Create() *PollCreate {
+	mutation := newPollMutation(c.config, OpCreate)
+	return &PollCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Poll entities.
+func (c *PollClient) CreateBulk(builders ...*PollCreate) *PollCreateBulk {
+	return &PollCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PollClient) MapCreateBulk(slice any, setFunc func(*PollCreate, int)) *PollCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PollCreateBulk{err: fmt.Errorf("calling to PollClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MediaCreate, rv.Len())
+	builders := make([]*PollCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MediaCreateBulk{config: c.config, builders: builders}
+	return &PollCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Media.
-func (c *MediaClient) Update() *MediaUpdate {
-	mutation := newMediaMutation(c.config, OpUpdate)
-	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Poll.
+func (c *PollClient) Update() *PollUpdate {
+	mutation := newPollMutation(c.config, OpUpdate)
+	return &PollUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MediaClient) UpdateOne(_m *Media) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(_m))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PollClient) UpdateOne(_m *Poll) *PollUpdateOne {
+	mutation := newPollMutation(c.config, OpUpdateOne, withPoll(_m))
+	return &PollUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MediaClient) UpdateOneID(id uuid.UUID) *MediaUpdateOne {
-	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
-	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PollClient) UpdateOneID(id uuid.UUID) *PollUpdateOne {
+	mutation := newPollMutation(c.config, OpUpdateOne, withPollID(id))
+	return &PollUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Media.
-func (c *MediaClient) Delete() *MediaDelete {
-	mutation := newMediaMutation(c.config, OpDelete)
-	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Poll.
+func (c *PollClient) Delete() *PollDelete {
+	mutation := newPollMutation(c.config, OpDelete)
+	return &PollDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MediaClient) DeleteOne(_m *Media) *MediaDeleteOne {
+func (c *PollClient) DeleteOne(_m *Poll) *PollDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MediaClient) DeleteOneID(id uuid.UUID) *MediaDeleteOne {
-	builder := c.Delete().Where(media.ID(id))
+func (c *PollClient) DeleteOneID(id uuid.UUID) *PollDeleteOne {
+	builder := c.Delete().Where(poll.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MediaDeleteOne{builder}
+	return &PollDeleteOne{builder}
 }
 
-// Query returns a query builder for Media.
-func (c *MediaClient) Query() *MediaQuery {
-	return &MediaQuery{
+// Query returns a query builder for Poll.
+func (c *PollClient) Query() *PollQuery {
+	return &PollQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMedia},
+		ctx:    &QueryContext{Type: TypePoll},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Media entity by its id.
-func (c *MediaClient) Get(ctx context.Context, id uuid.UUID) (*Media, error) {
-	return c.Query().Where(media.ID(id)).Only(ctx)
+// Get returns a Poll entity by its id.
+func (c *PollClient) Get(ctx context.Context, id uuid.UUID) (*Poll, error) {
+	return c.Query().Where(poll.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
+func (c *PollClient) GetX(ctx context.Context, id uuid.UUID) *Poll {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -964,47 +3305,15 @@ func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
 	return obj
 }
 
-// QueryMessage queries the message edge of a Media.
-func (c *MediaClient) QueryMessage(_m *Media) *MessageQuery {
+// QueryMessage queries the message edge of a Poll.
+func (c *PollClient) QueryMessage(_m *Poll) *MessageQuery {
 	query := (&MessageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.From(poll.Table, poll.FieldID, id),
 			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, media.MessageTable, media.MessageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUserAvatar queries the user_avatar edge of a Media.
-func (c *MediaClient) QueryUserAvatar(_m *Media) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, media.UserAvatarTable, media.UserAvatarColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryGroupAvatar queries the group_avatar edge of a Media.
-func (c *MediaClient) QueryGroupAvatar(_m *Media) *GroupChatQuery {
-	query := (&GroupChatClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(groupchat.Table, groupchat.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, media.GroupAvatarTable, media.GroupAvatarColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, poll.MessageTable, poll.MessageColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1012,15 +3321,15 @@ func (c *MediaClient) QueryGroupAvatar(_m *Media) *GroupChatQuery {
 	return query
 }
 
-// QueryUploader queries the uploader edge of a Media.
-func (c *MediaClient) QueryUploader(_m *Media) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryOptions queries the options edge of a Poll.
+func (c *PollClient) QueryOptions(_m *Poll) *PollOptionQuery {
+	query := (&PollOptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, media.UploaderTable, media.UploaderColumn),
+			sqlgraph.From(poll.Table, poll.FieldID, id),
+			sqlgraph.To(polloption.Table, polloption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.OptionsTable, poll.OptionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1028,15 +3337,15 @@ func (c *MediaClient) QueryUploader(_m *Media) *UserQuery {
 	return query
 }
 
-// QueryReports queries the reports edge of a Media.
-func (c *MediaClient) QueryReports(_m *Media) *ReportQuery {
-	query := (&ReportClient{config: c.config}).Query()
+// QueryVotes queries the votes edge of a Poll.
+func (c *PollClient) QueryVotes(_m *Poll) *PollVoteQuery {
+	query := (&PollVoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(media.Table, media.FieldID, id),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, media.ReportsTable, media.ReportsPrimaryKey...),
+			sqlgraph.From(poll.Table, poll.FieldID, id),
+			sqlgraph.To(pollvote.Table, pollvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.VotesTable, poll.VotesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1045,131 +3354,131 @@ func (c *MediaClient) QueryReports(_m *Media) *ReportQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MediaClient) Hooks() []Hook {
-	return c.hooks.Media
+func (c *PollClient) Hooks() []Hook {
+	return c.hooks.Poll
 }
 
 // Interceptors returns the client interceptors.
-func (c *MediaClient) Interceptors() []Interceptor {
-	return c.inters.Media
+func (c *PollClient) Interceptors() []Interceptor {
+	return c.inters.Poll
 }
 
-func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+func (c *PollClient) mutate(ctx context.Context, m *PollMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PollCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PollUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PollUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PollDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Poll mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// PollOptionClient is a client for the PollOption schema.
+type PollOptionClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewPollOptionClient returns a client for the PollOption from the given config.
+func NewPollOptionClient(c config) *PollOptionClient {
+	return &PollOptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `polloption.Hooks(f(g(h())))`.
+func (c *PollOptionClient) Use(hooks ...Hook) {
+	c.hooks.PollOption = append(c.hooks.PollOption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `polloption.Intercept(f(g(h())))`.
+func (c *PollOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PollOption = append(c.inters.PollOption, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a PollOption entity.
+func (c *PollOptionClient) Create() *PollOptionCreate {
+	mutation := newPollOptionMutation(c.config, OpCreate)
+	return &PollOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of PollOption entities.
+func (c *PollOptionClient) CreateBulk(builders ...*PollOptionCreate) *PollOptionCreateBulk {
+	return &PollOptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *PollOptionClient) MapCreateBulk(slice any, setFunc func(*PollOptionCreate, int)) *PollOptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &PollOptionCreateBulk{err: fmt.Errorf("calling to PollOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*PollOptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &PollOptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for PollOption.
+func (c *PollOptionClient) Update() *PollOptionUpdate {
+	mutation := newPollOptionMutation(c.config, OpUpdate)
+	return &PollOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PollOptionClient) UpdateOne(_m *PollOption) *PollOptionUpdateOne {
+	mutation := newPollOptionMutation(c.config, OpUpdateOne, withPollOption(_m))
+	return &PollOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id uuid.UUID) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *PollOptionClient) UpdateOneID(id uuid.UUID) *PollOptionUpdateOne {
+	mutation := newPollOptionMutation(c.config, OpUpdateOne, withPollOptionID(id))
+	return &PollOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for PollOption.
+func (c *PollOptionClient) Delete() *PollOptionDelete {
+	mutation := newPollOptionMutation(c.config, OpDelete)
+	return &PollOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+func (c *PollOptionClient) DeleteOne(_m *PollOption) *PollOptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id uuid.UUID) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *PollOptionClient) DeleteOneID(id uuid.UUID) *PollOptionDeleteOne {
+	builder := c.Delete().Where(polloption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &PollOptionDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for PollOption.
+func (c *PollOptionClient) Query() *PollOptionQuery {
+	return &PollOptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypePollOption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id uuid.UUID) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a PollOption entity by its id.
+func (c *PollOptionClient) Get(ctx context.Context, id uuid.UUID) (*PollOption, error) {
+	return c.Query().Where(polloption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id uuid.UUID) *Message {
+func (c *PollOptionClient) GetX(ctx context.Context, id uuid.UUID) *PollOption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1177,15 +3486,15 @@ func (c *MessageClient) GetX(ctx context.Context, id uuid.UUID) *Message {
 	return obj
 }
 
-// QueryChat queries the chat edge of a Message.
-func (c *MessageClient) QueryChat(_m *Message) *ChatQuery {
-	query := (&ChatClient{config: c.config}).Query()
+// QueryPoll queries the poll edge of a PollOption.
+func (c *PollOptionClient) QueryPoll(_m *PollOption) *PollQuery {
+	query := (&PollClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(chat.Table, chat.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, message.ChatTable, message.ChatColumn),
+			sqlgraph.From(polloption.Table, polloption.FieldID, id),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, polloption.PollTable, polloption.PollColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1193,15 +3502,15 @@ func (c *MessageClient) QueryChat(_m *Message) *ChatQuery {
 	return query
 }
 
-// QuerySender queries the sender edge of a Message.
-func (c *MessageClient) QuerySender(_m *Message) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryVotes queries the votes edge of a PollOption.
+func (c *PollOptionClient) QueryVotes(_m *PollOption) *PollVoteQuery {
+	query := (&PollVoteClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, message.SenderTable, message.SenderColumn),
+			sqlgraph.From(polloption.Table, polloption.FieldID, id),
+			sqlgraph.To(pollvote.Table, pollvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, polloption.VotesTable, polloption.VotesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1209,31 +3518,148 @@ func (c *MessageClient) QuerySender(_m *Message) *UserQuery {
 	return query
 }
 
-// QueryReplies queries the replies edge of a Message.
-func (c *MessageClient) QueryReplies(_m *Message) *MessageQuery {
-	query := (&MessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, message.RepliesTable, message.RepliesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *PollOptionClient) Hooks() []Hook {
+	return c.hooks.PollOption
+}
+
+// Interceptors returns the client interceptors.
+func (c *PollOptionClient) Interceptors() []Interceptor {
+	return c.inters.PollOption
+}
+
+func (c *PollOptionClient) mutate(ctx context.Context, m *PollOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PollOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PollOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PollOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PollOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PollOption mutation op: %q", m.Op())
+	}
+}
+
+// PollVoteClient is a client for the PollVote schema.
+type PollVoteClient struct {
+	config
+}
+
+// NewPollVoteClient returns a client for the PollVote from the given config.
+func NewPollVoteClient(c config) *PollVoteClient {
+	return &PollVoteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pollvote.Hooks(f(g(h())))`.
+func (c *PollVoteClient) Use(hooks ...Hook) {
+	c.hooks.PollVote = append(c.hooks.PollVote, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pollvote.Intercept(f(g(h())))`.
+func (c *PollVoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PollVote = append(c.inters.PollVote, interceptors...)
+}
+
+// Create returns a builder for creating a PollVote entity.
+func (c *PollVoteClient) Create() *PollVoteCreate {
+	mutation := newPollVoteMutation(c.config, OpCreate)
+	return &PollVoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PollVote entities.
+func (c *PollVoteClient) CreateBulk(builders ...*PollVoteCreate) *PollVoteCreateBulk {
+	return &PollVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PollVoteClient) MapCreateBulk(slice any, setFunc func(*PollVoteCreate, int)) *PollVoteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PollVoteCreateBulk{err: fmt.Errorf("calling to PollVoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PollVoteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PollVoteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PollVote.
+func (c *PollVoteClient) Update() *PollVoteUpdate {
+	mutation := newPollVoteMutation(c.config, OpUpdate)
+	return &PollVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PollVoteClient) UpdateOne(_m *PollVote) *PollVoteUpdateOne {
+	mutation := newPollVoteMutation(c.config, OpUpdateOne, withPollVote(_m))
+	return &PollVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PollVoteClient) UpdateOneID(id uuid.UUID) *PollVoteUpdateOne {
+	mutation := newPollVoteMutation(c.config, OpUpdateOne, withPollVoteID(id))
+	return &PollVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PollVote.
+func (c *PollVoteClient) Delete() *PollVoteDelete {
+	mutation := newPollVoteMutation(c.config, OpDelete)
+	return &PollVoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PollVoteClient) DeleteOne(_m *PollVote) *PollVoteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PollVoteClient) DeleteOneID(id uuid.UUID) *PollVoteDeleteOne {
+	builder := c.Delete().Where(pollvote.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PollVoteDeleteOne{builder}
+}
+
+// Query returns a query builder for PollVote.
+func (c *PollVoteClient) Query() *PollVoteQuery {
+	return &PollVoteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePollVote},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PollVote entity by its id.
+func (c *PollVoteClient) Get(ctx context.Context, id uuid.UUID) (*PollVote, error) {
+	return c.Query().Where(pollvote.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PollVoteClient) GetX(ctx context.Context, id uuid.UUID) *PollVote {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryReplyTo queries the reply_to edge of a Message.
-func (c *MessageClient) QueryReplyTo(_m *Message) *MessageQuery {
-	query := (&MessageClient{config: c.config}).Query()
+// QueryPoll queries the poll edge of a PollVote.
+func (c *PollVoteClient) QueryPoll(_m *PollVote) *PollQuery {
+	query := (&PollClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, message.ReplyToTable, message.ReplyToColumn),
+			sqlgraph.From(pollvote.Table, pollvote.FieldID, id),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pollvote.PollTable, pollvote.PollColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1241,15 +3667,15 @@ func (c *MessageClient) QueryReplyTo(_m *Message) *MessageQuery {
 	return query
 }
 
-// QueryAttachments queries the attachments edge of a Message.
-func (c *MessageClient) QueryAttachments(_m *Message) *MediaQuery {
-	query := (&MediaClient{config: c.config}).Query()
+// QueryOption queries the option edge of a PollVote.
+func (c *PollVoteClient) QueryOption(_m *PollVote) *PollOptionQuery {
+	query := (&PollOptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(media.Table, media.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, message.AttachmentsTable, message.AttachmentsColumn),
+			sqlgraph.From(pollvote.Table, pollvote.FieldID, id),
+			sqlgraph.To(polloption.Table, polloption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pollvote.OptionTable, pollvote.OptionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1257,15 +3683,15 @@ func (c *MessageClient) QueryAttachments(_m *Message) *MediaQuery {
 	return query
 }
 
-// QueryReports queries the reports edge of a Message.
-func (c *MessageClient) QueryReports(_m *Message) *ReportQuery {
-	query := (&ReportClient{config: c.config}).Query()
+// QueryUser queries the user edge of a PollVote.
+func (c *PollVoteClient) QueryUser(_m *PollVote) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(report.Table, report.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, message.ReportsTable, message.ReportsColumn),
+			sqlgraph.From(pollvote.Table, pollvote.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pollvote.UserTable, pollvote.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1274,27 +3700,27 @@ func (c *MessageClient) QueryReports(_m *Message) *ReportQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *PollVoteClient) Hooks() []Hook {
+	return c.hooks.PollVote
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *PollVoteClient) Interceptors() []Interceptor {
+	return c.inters.PollVote
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *PollVoteClient) mutate(ctx context.Context, m *PollVoteMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PollVoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PollVoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PollVoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PollVoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PollVote mutation op: %q", m.Op())
 	}
 }
 
@@ -1709,6 +4135,203 @@ func (c *ReportClient) mutate(ctx context.Context, m *ReportMutation) (Value, er
 	}
 }
 
+// ScheduledMessageClient is a client for the ScheduledMessage schema.
+type ScheduledMessageClient struct {
+	config
+}
+
+// NewScheduledMessageClient returns a client for the ScheduledMessage from the given config.
+func NewScheduledMessageClient(c config) *ScheduledMessageClient {
+	return &ScheduledMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledmessage.Hooks(f(g(h())))`.
+func (c *ScheduledMessageClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledMessage = append(c.hooks.ScheduledMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduledmessage.Intercept(f(g(h())))`.
+func (c *ScheduledMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledMessage = append(c.inters.ScheduledMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduledMessage entity.
+func (c *ScheduledMessageClient) Create() *ScheduledMessageCreate {
+	mutation := newScheduledMessageMutation(c.config, OpCreate)
+	return &ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledMessage entities.
+func (c *ScheduledMessageClient) CreateBulk(builders ...*ScheduledMessageCreate) *ScheduledMessageCreateBulk {
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduledMessageClient) MapCreateBulk(slice any, setFunc func(*ScheduledMessageCreate, int)) *ScheduledMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduledMessageCreateBulk{err: fmt.Errorf("calling to ScheduledMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduledMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Update() *ScheduledMessageUpdate {
+	mutation := newScheduledMessageMutation(c.config, OpUpdate)
+	return &ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledMessageClient) UpdateOne(_m *ScheduledMessage) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessage(_m))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledMessageClient) UpdateOneID(id uuid.UUID) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessageID(id))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Delete() *ScheduledMessageDelete {
+	mutation := newScheduledMessageMutation(c.config, OpDelete)
+	return &ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduledMessageClient) DeleteOne(_m *ScheduledMessage) *ScheduledMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduledMessageClient) DeleteOneID(id uuid.UUID) *ScheduledMessageDeleteOne {
+	builder := c.Delete().Where(scheduledmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Query() *ScheduledMessageQuery {
+	return &ScheduledMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduledMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduledMessage entity by its id.
+func (c *ScheduledMessageClient) Get(ctx context.Context, id uuid.UUID) (*ScheduledMessage, error) {
+	return c.Query().Where(scheduledmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduledMessageClient) GetX(ctx context.Context, id uuid.UUID) *ScheduledMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChat queries the chat edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QueryChat(_m *ScheduledMessage) *ChatQuery {
+	query := (&ChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledmessage.ChatTable, scheduledmessage.ChatColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySender queries the sender edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QuerySender(_m *ScheduledMessage) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scheduledmessage.SenderTable, scheduledmessage.SenderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplyTo queries the reply_to edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QueryReplyTo(_m *ScheduledMessage) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, scheduledmessage.ReplyToTable, scheduledmessage.ReplyToColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttachments queries the attachments edge of a ScheduledMessage.
+func (c *ScheduledMessageClient) QueryAttachments(_m *ScheduledMessage) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scheduledmessage.Table, scheduledmessage.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scheduledmessage.AttachmentsTable, scheduledmessage.AttachmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduledMessageClient) Hooks() []Hook {
+	return c.hooks.ScheduledMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduledMessageClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledMessage
+}
+
+func (c *ScheduledMessageClient) mutate(ctx context.Context, m *ScheduledMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScheduledMessage mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1977,6 +4600,150 @@ func (c *UserClient) QueryBlockedByRel(_m *User) *UserBlockQuery {
 	return query
 }
 
+// QueryMessageReactions queries the message_reactions edge of a User.
+func (c *UserClient) QueryMessageReactions(_m *User) *MessageReactionQuery {
+	query := (&MessageReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MessageReactionsTable, user.MessageReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPinnedMessages queries the pinned_messages edge of a User.
+func (c *UserClient) QueryPinnedMessages(_m *User) *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PinnedMessagesTable, user.PinnedMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages queries the scheduled_messages edge of a User.
+func (c *UserClient) QueryScheduledMessages(_m *User) *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ScheduledMessagesTable, user.ScheduledMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentions queries the mentions edge of a User.
+func (c *UserClient) QueryMentions(_m *User) *MessageMentionQuery {
+	query := (&MessageMentionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MentionsTable, user.MentionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPollVotes queries the poll_votes edge of a User.
+func (c *UserClient) QueryPollVotes(_m *User) *PollVoteQuery {
+	query := (&PollVoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(pollvote.Table, pollvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PollVotesTable, user.PollVotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChatExports queries the chat_exports edge of a User.
+func (c *UserClient) QueryChatExports(_m *User) *ChatExportQuery {
+	query := (&ChatExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(chatexport.Table, chatexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChatExportsTable, user.ChatExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPinnedChats queries the pinned_chats edge of a User.
+func (c *UserClient) QueryPinnedChats(_m *User) *PinnedChatQuery {
+	query := (&PinnedChatClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(pinnedchat.Table, pinnedchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PinnedChatsTable, user.PinnedChatsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChatFolders queries the chat_folders edge of a User.
+func (c *UserClient) QueryChatFolders(_m *User) *ChatFolderQuery {
+	query := (&ChatFolderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(chatfolder.Table, chatfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChatFoldersTable, user.ChatFoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroupJoinRequests queries the group_join_requests edge of a User.
+func (c *UserClient) QueryGroupJoinRequests(_m *User) *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupJoinRequestsTable, user.GroupJoinRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryReportsMade queries the reports_made edge of a User.
 func (c *UserClient) QueryReportsMade(_m *User) *ReportQuery {
 	query := (&ReportClient{config: c.config}).Query()
@@ -2351,11 +5118,15 @@ func (c *UserIdentityClient) mutate(ctx context.Context, m *UserIdentityMutation
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Chat, GroupChat, GroupMember, Media, Message, PrivateChat, Report, User,
-		UserBlock, UserIdentity []ent.Hook
+		Chat, ChatExport, ChatFolder, ChatFolderChat, GroupChat, GroupJoinRequest,
+		GroupMember, Media, Message, MessageMention, MessageReaction, MessageRevision,
+		PinnedChat, PinnedMessage, Poll, PollOption, PollVote, PrivateChat, Report,
+		ScheduledMessage, User, UserBlock, UserIdentity []ent.Hook
 	}
 	inters struct {
-		Chat, GroupChat, GroupMember, Media, Message, PrivateChat, Report, User,
-		UserBlock, UserIdentity []ent.Interceptor
+		Chat, ChatExport, ChatFolder, ChatFolderChat, GroupChat, GroupJoinRequest,
+		GroupMember, Media, Message, MessageMention, MessageReaction, MessageRevision,
+		PinnedChat, PinnedMessage, Poll, PollOption, PollVote, PrivateChat, Report,
+		ScheduledMessage, User, UserBlock, UserIdentity []ent.Interceptor
 	}
 )
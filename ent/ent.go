@@ -4,12 +4,25 @@ package ent
 
 import (
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/ent/useridentity"
@@ -82,16 +95,29 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chat.Table:         chat.ValidColumn,
-			groupchat.Table:    groupchat.ValidColumn,
-			groupmember.Table:  groupmember.ValidColumn,
-			media.Table:        media.ValidColumn,
-			message.Table:      message.ValidColumn,
-			privatechat.Table:  privatechat.ValidColumn,
-			report.Table:       report.ValidColumn,
-			user.Table:         user.ValidColumn,
-			userblock.Table:    userblock.ValidColumn,
-			useridentity.Table: useridentity.ValidColumn,
+			chat.Table:             chat.ValidColumn,
+			chatexport.Table:       chatexport.ValidColumn,
+			chatfolder.Table:       chatfolder.ValidColumn,
+			chatfolderchat.Table:   chatfolderchat.ValidColumn,
+			groupchat.Table:        groupchat.ValidColumn,
+			groupjoinrequest.Table: groupjoinrequest.ValidColumn,
+			groupmember.Table:      groupmember.ValidColumn,
+			media.Table:            media.ValidColumn,
+			message.Table:          message.ValidColumn,
+			messagemention.Table:   messagemention.ValidColumn,
+			messagereaction.Table:  messagereaction.ValidColumn,
+			messagerevision.Table:  messagerevision.ValidColumn,
+			pinnedchat.Table:       pinnedchat.ValidColumn,
+			pinnedmessage.Table:    pinnedmessage.ValidColumn,
+			poll.Table:             poll.ValidColumn,
+			polloption.Table:       polloption.ValidColumn,
+			pollvote.Table:         pollvote.ValidColumn,
+			privatechat.Table:      privatechat.ValidColumn,
+			report.Table:           report.ValidColumn,
+			scheduledmessage.Table: scheduledmessage.ValidColumn,
+			user.Table:             user.ValidColumn,
+			userblock.Table:        userblock.ValidColumn,
+			useridentity.Table:     useridentity.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
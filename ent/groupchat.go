@@ -33,6 +33,10 @@ type GroupChat struct {
 	AvatarID *uuid.UUID `json:"avatar_id,omitempty"`
 	// IsPublic holds the value of the "is_public" field.
 	IsPublic bool `json:"is_public,omitempty"`
+	// SlowModeSeconds holds the value of the "slow_mode_seconds" field.
+	SlowModeSeconds int `json:"slow_mode_seconds,omitempty"`
+	// RequiresApproval holds the value of the "requires_approval" field.
+	RequiresApproval bool `json:"requires_approval,omitempty"`
 	// InviteCode holds the value of the "invite_code" field.
 	InviteCode string `json:"invite_code,omitempty"`
 	// InviteExpiresAt holds the value of the "invite_expires_at" field.
@@ -53,11 +57,13 @@ type GroupChatEdges struct {
 	Creator *User `json:"creator,omitempty"`
 	// Members holds the value of the members edge.
 	Members []*GroupMember `json:"members,omitempty"`
+	// JoinRequests holds the value of the join_requests edge.
+	JoinRequests []*GroupJoinRequest `json:"join_requests,omitempty"`
 	// Reports holds the value of the reports edge.
 	Reports []*Report `json:"reports,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // AvatarOrErr returns the Avatar value or an error if the edge
@@ -102,10 +108,19 @@ func (e GroupChatEdges) MembersOrErr() ([]*GroupMember, error) {
 	return nil, &NotLoadedError{edge: "members"}
 }
 
+// JoinRequestsOrErr returns the JoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e GroupChatEdges) JoinRequestsOrErr() ([]*GroupJoinRequest, error) {
+	if e.loadedTypes[4] {
+		return e.JoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "join_requests"}
+}
+
 // ReportsOrErr returns the Reports value or an error if the edge
 // was not loaded in eager-loading.
 func (e GroupChatEdges) ReportsOrErr() ([]*Report, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Reports, nil
 	}
 	return nil, &NotLoadedError{edge: "reports"}
@@ -118,8 +133,10 @@ func (*GroupChat) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case groupchat.FieldCreatedBy, groupchat.FieldAvatarID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case groupchat.FieldIsPublic:
+		case groupchat.FieldIsPublic, groupchat.FieldRequiresApproval:
 			values[i] = new(sql.NullBool)
+		case groupchat.FieldSlowModeSeconds:
+			values[i] = new(sql.NullInt64)
 		case groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldInviteCode:
 			values[i] = new(sql.NullString)
 		case groupchat.FieldInviteExpiresAt:
@@ -186,6 +203,18 @@ func (_m *GroupChat) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case groupchat.FieldSlowModeSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slow_mode_seconds", values[i])
+			} else if value.Valid {
+				_m.SlowModeSeconds = int(value.Int64)
+			}
+		case groupchat.FieldRequiresApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field requires_approval", values[i])
+			} else if value.Valid {
+				_m.RequiresApproval = value.Bool
+			}
 		case groupchat.FieldInviteCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field invite_code", values[i])
@@ -232,6 +261,11 @@ func (_m *GroupChat) QueryMembers() *GroupMemberQuery {
 	return NewGroupChatClient(_m.config).QueryMembers(_m)
 }
 
+// QueryJoinRequests queries the "join_requests" edge of the GroupChat entity.
+func (_m *GroupChat) QueryJoinRequests() *GroupJoinRequestQuery {
+	return NewGroupChatClient(_m.config).QueryJoinRequests(_m)
+}
+
 // QueryReports queries the "reports" edge of the GroupChat entity.
 func (_m *GroupChat) QueryReports() *ReportQuery {
 	return NewGroupChatClient(_m.config).QueryReports(_m)
@@ -284,6 +318,12 @@ func (_m *GroupChat) String() string {
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	builder.WriteString("slow_mode_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SlowModeSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("requires_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiresApproval))
+	builder.WriteString(", ")
 	builder.WriteString("invite_code=")
 	builder.WriteString(_m.InviteCode)
 	builder.WriteString(", ")
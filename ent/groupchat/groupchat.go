@@ -25,6 +25,10 @@ const (
 	FieldAvatarID = "avatar_id"
 	// FieldIsPublic holds the string denoting the is_public field in the database.
 	FieldIsPublic = "is_public"
+	// FieldSlowModeSeconds holds the string denoting the slow_mode_seconds field in the database.
+	FieldSlowModeSeconds = "slow_mode_seconds"
+	// FieldRequiresApproval holds the string denoting the requires_approval field in the database.
+	FieldRequiresApproval = "requires_approval"
 	// FieldInviteCode holds the string denoting the invite_code field in the database.
 	FieldInviteCode = "invite_code"
 	// FieldInviteExpiresAt holds the string denoting the invite_expires_at field in the database.
@@ -37,6 +41,8 @@ const (
 	EdgeCreator = "creator"
 	// EdgeMembers holds the string denoting the members edge name in mutations.
 	EdgeMembers = "members"
+	// EdgeJoinRequests holds the string denoting the join_requests edge name in mutations.
+	EdgeJoinRequests = "join_requests"
 	// EdgeReports holds the string denoting the reports edge name in mutations.
 	EdgeReports = "reports"
 	// Table holds the table name of the groupchat in the database.
@@ -69,6 +75,13 @@ const (
 	MembersInverseTable = "group_members"
 	// MembersColumn is the table column denoting the members relation/edge.
 	MembersColumn = "group_chat_id"
+	// JoinRequestsTable is the table that holds the join_requests relation/edge.
+	JoinRequestsTable = "group_join_requests"
+	// JoinRequestsInverseTable is the table name for the GroupJoinRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "groupjoinrequest" package.
+	JoinRequestsInverseTable = "group_join_requests"
+	// JoinRequestsColumn is the table column denoting the join_requests relation/edge.
+	JoinRequestsColumn = "group_chat_id"
 	// ReportsTable is the table that holds the reports relation/edge.
 	ReportsTable = "reports"
 	// ReportsInverseTable is the table name for the Report entity.
@@ -87,6 +100,8 @@ var Columns = []string{
 	FieldDescription,
 	FieldAvatarID,
 	FieldIsPublic,
+	FieldSlowModeSeconds,
+	FieldRequiresApproval,
 	FieldInviteCode,
 	FieldInviteExpiresAt,
 }
@@ -106,6 +121,12 @@ var (
 	NameValidator func(string) error
 	// DefaultIsPublic holds the default value on creation for the "is_public" field.
 	DefaultIsPublic bool
+	// DefaultSlowModeSeconds holds the default value on creation for the "slow_mode_seconds" field.
+	DefaultSlowModeSeconds int
+	// SlowModeSecondsValidator is a validator for the "slow_mode_seconds" field. It is called by the builders before save.
+	SlowModeSecondsValidator func(int) error
+	// DefaultRequiresApproval holds the default value on creation for the "requires_approval" field.
+	DefaultRequiresApproval bool
 	// InviteCodeValidator is a validator for the "invite_code" field. It is called by the builders before save.
 	InviteCodeValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
@@ -150,6 +171,16 @@ func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
 }
 
+// BySlowModeSeconds orders the results by the slow_mode_seconds field.
+func BySlowModeSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlowModeSeconds, opts...).ToFunc()
+}
+
+// ByRequiresApproval orders the results by the requires_approval field.
+func ByRequiresApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiresApproval, opts...).ToFunc()
+}
+
 // ByInviteCode orders the results by the invite_code field.
 func ByInviteCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInviteCode, opts...).ToFunc()
@@ -195,6 +226,20 @@ func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByJoinRequestsCount orders the results by join_requests count.
+func ByJoinRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJoinRequestsStep(), opts...)
+	}
+}
+
+// ByJoinRequests orders the results by join_requests terms.
+func ByJoinRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJoinRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByReportsCount orders the results by reports count.
 func ByReportsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -236,6 +281,13 @@ func newMembersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
 	)
 }
+func newJoinRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JoinRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+	)
+}
 func newReportsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -86,6 +86,16 @@ func IsPublic(v bool) predicate.GroupChat {
 	return predicate.GroupChat(sql.FieldEQ(FieldIsPublic, v))
 }
 
+// SlowModeSeconds applies equality check predicate on the "slow_mode_seconds" field. It's identical to SlowModeSecondsEQ.
+func SlowModeSeconds(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldEQ(FieldSlowModeSeconds, v))
+}
+
+// RequiresApproval applies equality check predicate on the "requires_approval" field. It's identical to RequiresApprovalEQ.
+func RequiresApproval(v bool) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldEQ(FieldRequiresApproval, v))
+}
+
 // InviteCode applies equality check predicate on the "invite_code" field. It's identical to InviteCodeEQ.
 func InviteCode(v string) predicate.GroupChat {
 	return predicate.GroupChat(sql.FieldEQ(FieldInviteCode, v))
@@ -326,6 +336,56 @@ func IsPublicNEQ(v bool) predicate.GroupChat {
 	return predicate.GroupChat(sql.FieldNEQ(FieldIsPublic, v))
 }
 
+// SlowModeSecondsEQ applies the EQ predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsEQ(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldEQ(FieldSlowModeSeconds, v))
+}
+
+// SlowModeSecondsNEQ applies the NEQ predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsNEQ(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldNEQ(FieldSlowModeSeconds, v))
+}
+
+// SlowModeSecondsIn applies the In predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsIn(vs ...int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldIn(FieldSlowModeSeconds, vs...))
+}
+
+// SlowModeSecondsNotIn applies the NotIn predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsNotIn(vs ...int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldNotIn(FieldSlowModeSeconds, vs...))
+}
+
+// SlowModeSecondsGT applies the GT predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsGT(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldGT(FieldSlowModeSeconds, v))
+}
+
+// SlowModeSecondsGTE applies the GTE predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsGTE(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldGTE(FieldSlowModeSeconds, v))
+}
+
+// SlowModeSecondsLT applies the LT predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsLT(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldLT(FieldSlowModeSeconds, v))
+}
+
+// SlowModeSecondsLTE applies the LTE predicate on the "slow_mode_seconds" field.
+func SlowModeSecondsLTE(v int) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldLTE(FieldSlowModeSeconds, v))
+}
+
+// RequiresApprovalEQ applies the EQ predicate on the "requires_approval" field.
+func RequiresApprovalEQ(v bool) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldEQ(FieldRequiresApproval, v))
+}
+
+// RequiresApprovalNEQ applies the NEQ predicate on the "requires_approval" field.
+func RequiresApprovalNEQ(v bool) predicate.GroupChat {
+	return predicate.GroupChat(sql.FieldNEQ(FieldRequiresApproval, v))
+}
+
 // InviteCodeEQ applies the EQ predicate on the "invite_code" field.
 func InviteCodeEQ(v string) predicate.GroupChat {
 	return predicate.GroupChat(sql.FieldEQ(FieldInviteCode, v))
@@ -533,6 +593,29 @@ func HasMembersWith(preds ...predicate.GroupMember) predicate.GroupChat {
 	})
 }
 
+// HasJoinRequests applies the HasEdge predicate on the "join_requests" edge.
+func HasJoinRequests() predicate.GroupChat {
+	return predicate.GroupChat(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJoinRequestsWith applies the HasEdge predicate on the "join_requests" edge with a given conditions (other predicates).
+func HasJoinRequestsWith(preds ...predicate.GroupJoinRequest) predicate.GroupChat {
+	return predicate.GroupChat(func(s *sql.Selector) {
+		step := newJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasReports applies the HasEdge predicate on the "reports" edge.
 func HasReports() predicate.GroupChat {
 	return predicate.GroupChat(func(s *sql.Selector) {
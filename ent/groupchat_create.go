@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/report"
@@ -97,6 +98,34 @@ func (_c *GroupChatCreate) SetNillableIsPublic(v *bool) *GroupChatCreate {
 	return _c
 }
 
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (_c *GroupChatCreate) SetSlowModeSeconds(v int) *GroupChatCreate {
+	_c.mutation.SetSlowModeSeconds(v)
+	return _c
+}
+
+// SetNillableSlowModeSeconds sets the "slow_mode_seconds" field if the given value is not nil.
+func (_c *GroupChatCreate) SetNillableSlowModeSeconds(v *int) *GroupChatCreate {
+	if v != nil {
+		_c.SetSlowModeSeconds(*v)
+	}
+	return _c
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (_c *GroupChatCreate) SetRequiresApproval(v bool) *GroupChatCreate {
+	_c.mutation.SetRequiresApproval(v)
+	return _c
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_c *GroupChatCreate) SetNillableRequiresApproval(v *bool) *GroupChatCreate {
+	if v != nil {
+		_c.SetRequiresApproval(*v)
+	}
+	return _c
+}
+
 // SetInviteCode sets the "invite_code" field.
 func (_c *GroupChatCreate) SetInviteCode(v string) *GroupChatCreate {
 	_c.mutation.SetInviteCode(v)
@@ -175,6 +204,21 @@ func (_c *GroupChatCreate) AddMembers(v ...*GroupMember) *GroupChatCreate {
 	return _c.AddMemberIDs(ids...)
 }
 
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (_c *GroupChatCreate) AddJoinRequestIDs(ids ...uuid.UUID) *GroupChatCreate {
+	_c.mutation.AddJoinRequestIDs(ids...)
+	return _c
+}
+
+// AddJoinRequests adds the "join_requests" edges to the GroupJoinRequest entity.
+func (_c *GroupChatCreate) AddJoinRequests(v ...*GroupJoinRequest) *GroupChatCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddJoinRequestIDs(ids...)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_c *GroupChatCreate) AddReportIDs(ids ...uuid.UUID) *GroupChatCreate {
 	_c.mutation.AddReportIDs(ids...)
@@ -229,6 +273,14 @@ func (_c *GroupChatCreate) defaults() {
 		v := groupchat.DefaultIsPublic
 		_c.mutation.SetIsPublic(v)
 	}
+	if _, ok := _c.mutation.SlowModeSeconds(); !ok {
+		v := groupchat.DefaultSlowModeSeconds
+		_c.mutation.SetSlowModeSeconds(v)
+	}
+	if _, ok := _c.mutation.RequiresApproval(); !ok {
+		v := groupchat.DefaultRequiresApproval
+		_c.mutation.SetRequiresApproval(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := groupchat.DefaultID()
 		_c.mutation.SetID(v)
@@ -251,6 +303,17 @@ func (_c *GroupChatCreate) check() error {
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "GroupChat.is_public"`)}
 	}
+	if _, ok := _c.mutation.SlowModeSeconds(); !ok {
+		return &ValidationError{Name: "slow_mode_seconds", err: errors.New(`ent: missing required field "GroupChat.slow_mode_seconds"`)}
+	}
+	if v, ok := _c.mutation.SlowModeSeconds(); ok {
+		if err := groupchat.SlowModeSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "slow_mode_seconds", err: fmt.Errorf(`ent: validator failed for field "GroupChat.slow_mode_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequiresApproval(); !ok {
+		return &ValidationError{Name: "requires_approval", err: errors.New(`ent: missing required field "GroupChat.requires_approval"`)}
+	}
 	if _, ok := _c.mutation.InviteCode(); !ok {
 		return &ValidationError{Name: "invite_code", err: errors.New(`ent: missing required field "GroupChat.invite_code"`)}
 	}
@@ -310,6 +373,14 @@ func (_c *GroupChatCreate) createSpec() (*GroupChat, *sqlgraph.CreateSpec) {
 		_spec.SetField(groupchat.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
 	}
+	if value, ok := _c.mutation.SlowModeSeconds(); ok {
+		_spec.SetField(groupchat.FieldSlowModeSeconds, field.TypeInt, value)
+		_node.SlowModeSeconds = value
+	}
+	if value, ok := _c.mutation.RequiresApproval(); ok {
+		_spec.SetField(groupchat.FieldRequiresApproval, field.TypeBool, value)
+		_node.RequiresApproval = value
+	}
 	if value, ok := _c.mutation.InviteCode(); ok {
 		_spec.SetField(groupchat.FieldInviteCode, field.TypeString, value)
 		_node.InviteCode = value
@@ -385,6 +456,22 @@ func (_c *GroupChatCreate) createSpec() (*GroupChat, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -543,6 +630,36 @@ func (u *GroupChatUpsert) UpdateIsPublic() *GroupChatUpsert {
 	return u
 }
 
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (u *GroupChatUpsert) SetSlowModeSeconds(v int) *GroupChatUpsert {
+	u.Set(groupchat.FieldSlowModeSeconds, v)
+	return u
+}
+
+// UpdateSlowModeSeconds sets the "slow_mode_seconds" field to the value that was provided on create.
+func (u *GroupChatUpsert) UpdateSlowModeSeconds() *GroupChatUpsert {
+	u.SetExcluded(groupchat.FieldSlowModeSeconds)
+	return u
+}
+
+// AddSlowModeSeconds adds v to the "slow_mode_seconds" field.
+func (u *GroupChatUpsert) AddSlowModeSeconds(v int) *GroupChatUpsert {
+	u.Add(groupchat.FieldSlowModeSeconds, v)
+	return u
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (u *GroupChatUpsert) SetRequiresApproval(v bool) *GroupChatUpsert {
+	u.Set(groupchat.FieldRequiresApproval, v)
+	return u
+}
+
+// UpdateRequiresApproval sets the "requires_approval" field to the value that was provided on create.
+func (u *GroupChatUpsert) UpdateRequiresApproval() *GroupChatUpsert {
+	u.SetExcluded(groupchat.FieldRequiresApproval)
+	return u
+}
+
 // SetInviteCode sets the "invite_code" field.
 func (u *GroupChatUpsert) SetInviteCode(v string) *GroupChatUpsert {
 	u.Set(groupchat.FieldInviteCode, v)
@@ -726,6 +843,41 @@ func (u *GroupChatUpsertOne) UpdateIsPublic() *GroupChatUpsertOne {
 	})
 }
 
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (u *GroupChatUpsertOne) SetSlowModeSeconds(v int) *GroupChatUpsertOne {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.SetSlowModeSeconds(v)
+	})
+}
+
+// AddSlowModeSeconds adds v to the "slow_mode_seconds" field.
+func (u *GroupChatUpsertOne) AddSlowModeSeconds(v int) *GroupChatUpsertOne {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.AddSlowModeSeconds(v)
+	})
+}
+
+// UpdateSlowModeSeconds sets the "slow_mode_seconds" field to the value that was provided on create.
+func (u *GroupChatUpsertOne) UpdateSlowModeSeconds() *GroupChatUpsertOne {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.UpdateSlowModeSeconds()
+	})
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (u *GroupChatUpsertOne) SetRequiresApproval(v bool) *GroupChatUpsertOne {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.SetRequiresApproval(v)
+	})
+}
+
+// UpdateRequiresApproval sets the "requires_approval" field to the value that was provided on create.
+func (u *GroupChatUpsertOne) UpdateRequiresApproval() *GroupChatUpsertOne {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.UpdateRequiresApproval()
+	})
+}
+
 // SetInviteCode sets the "invite_code" field.
 func (u *GroupChatUpsertOne) SetInviteCode(v string) *GroupChatUpsertOne {
 	return u.Update(func(s *GroupChatUpsert) {
@@ -1081,6 +1233,41 @@ func (u *GroupChatUpsertBulk) UpdateIsPublic() *GroupChatUpsertBulk {
 	})
 }
 
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (u *GroupChatUpsertBulk) SetSlowModeSeconds(v int) *GroupChatUpsertBulk {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.SetSlowModeSeconds(v)
+	})
+}
+
+// AddSlowModeSeconds adds v to the "slow_mode_seconds" field.
+func (u *GroupChatUpsertBulk) AddSlowModeSeconds(v int) *GroupChatUpsertBulk {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.AddSlowModeSeconds(v)
+	})
+}
+
+// UpdateSlowModeSeconds sets the "slow_mode_seconds" field to the value that was provided on create.
+func (u *GroupChatUpsertBulk) UpdateSlowModeSeconds() *GroupChatUpsertBulk {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.UpdateSlowModeSeconds()
+	})
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (u *GroupChatUpsertBulk) SetRequiresApproval(v bool) *GroupChatUpsertBulk {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.SetRequiresApproval(v)
+	})
+}
+
+// UpdateRequiresApproval sets the "requires_approval" field to the value that was provided on create.
+func (u *GroupChatUpsertBulk) UpdateRequiresApproval() *GroupChatUpsertBulk {
+	return u.Update(func(s *GroupChatUpsert) {
+		s.UpdateRequiresApproval()
+	})
+}
+
 // SetInviteCode sets the "invite_code" field.
 func (u *GroupChatUpsertBulk) SetInviteCode(v string) *GroupChatUpsertBulk {
 	return u.Update(func(s *GroupChatUpsert) {
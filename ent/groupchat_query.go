@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/predicate"
@@ -26,16 +27,17 @@ import (
 // GroupChatQuery is the builder for querying GroupChat entities.
 type GroupChatQuery struct {
 	config
-	ctx         *QueryContext
-	order       []groupchat.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.GroupChat
-	withAvatar  *MediaQuery
-	withChat    *ChatQuery
-	withCreator *UserQuery
-	withMembers *GroupMemberQuery
-	withReports *ReportQuery
-	modifiers   []func(*sql.Selector)
+	ctx              *QueryContext
+	order            []groupchat.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.GroupChat
+	withAvatar       *MediaQuery
+	withChat         *ChatQuery
+	withCreator      *UserQuery
+	withMembers      *GroupMemberQuery
+	withJoinRequests *GroupJoinRequestQuery
+	withReports      *ReportQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -160,6 +162,28 @@ func (_q *GroupChatQuery) QueryMembers() *GroupMemberQuery {
 	return query
 }
 
+// QueryJoinRequests chains the current query on the "join_requests" edge.
+func (_q *GroupChatQuery) QueryJoinRequests() *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupchat.Table, groupchat.FieldID, selector),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, groupchat.JoinRequestsTable, groupchat.JoinRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryReports chains the current query on the "reports" edge.
 func (_q *GroupChatQuery) QueryReports() *ReportQuery {
 	query := (&ReportClient{config: _q.config}).Query()
@@ -369,16 +393,17 @@ func (_q *GroupChatQuery) Clone() *GroupChatQuery {
 		return nil
 	}
 	return &GroupChatQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]groupchat.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.GroupChat{}, _q.predicates...),
-		withAvatar:  _q.withAvatar.Clone(),
-		withChat:    _q.withChat.Clone(),
-		withCreator: _q.withCreator.Clone(),
-		withMembers: _q.withMembers.Clone(),
-		withReports: _q.withReports.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]groupchat.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.GroupChat{}, _q.predicates...),
+		withAvatar:       _q.withAvatar.Clone(),
+		withChat:         _q.withChat.Clone(),
+		withCreator:      _q.withCreator.Clone(),
+		withMembers:      _q.withMembers.Clone(),
+		withJoinRequests: _q.withJoinRequests.Clone(),
+		withReports:      _q.withReports.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -430,6 +455,17 @@ func (_q *GroupChatQuery) WithMembers(opts ...func(*GroupMemberQuery)) *GroupCha
 	return _q
 }
 
+// WithJoinRequests tells the query-builder to eager-load the nodes that are connected to
+// the "join_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupChatQuery) WithJoinRequests(opts ...func(*GroupJoinRequestQuery)) *GroupChatQuery {
+	query := (&GroupJoinRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJoinRequests = query
+	return _q
+}
+
 // WithReports tells the query-builder to eager-load the nodes that are connected to
 // the "reports" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *GroupChatQuery) WithReports(opts ...func(*ReportQuery)) *GroupChatQuery {
@@ -519,11 +555,12 @@ func (_q *GroupChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Gr
 	var (
 		nodes       = []*GroupChat{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			_q.withAvatar != nil,
 			_q.withChat != nil,
 			_q.withCreator != nil,
 			_q.withMembers != nil,
+			_q.withJoinRequests != nil,
 			_q.withReports != nil,
 		}
 	)
@@ -573,6 +610,13 @@ func (_q *GroupChatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Gr
 			return nil, err
 		}
 	}
+	if query := _q.withJoinRequests; query != nil {
+		if err := _q.loadJoinRequests(ctx, query, nodes,
+			func(n *GroupChat) { n.Edges.JoinRequests = []*GroupJoinRequest{} },
+			func(n *GroupChat, e *GroupJoinRequest) { n.Edges.JoinRequests = append(n.Edges.JoinRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withReports; query != nil {
 		if err := _q.loadReports(ctx, query, nodes,
 			func(n *GroupChat) { n.Edges.Reports = []*Report{} },
@@ -706,6 +750,36 @@ func (_q *GroupChatQuery) loadMembers(ctx context.Context, query *GroupMemberQue
 	}
 	return nil
 }
+func (_q *GroupChatQuery) loadJoinRequests(ctx context.Context, query *GroupJoinRequestQuery, nodes []*GroupChat, init func(*GroupChat), assign func(*GroupChat, *GroupJoinRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*GroupChat)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupjoinrequest.FieldGroupChatID)
+	}
+	query.Where(predicate.GroupJoinRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(groupchat.JoinRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GroupChatID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "group_chat_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *GroupChatQuery) loadReports(ctx context.Context, query *ReportQuery, nodes []*GroupChat, init func(*GroupChat), assign func(*GroupChat, *Report)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*GroupChat)
@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/predicate"
@@ -137,6 +138,41 @@ func (_u *GroupChatUpdate) SetNillableIsPublic(v *bool) *GroupChatUpdate {
 	return _u
 }
 
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (_u *GroupChatUpdate) SetSlowModeSeconds(v int) *GroupChatUpdate {
+	_u.mutation.ResetSlowModeSeconds()
+	_u.mutation.SetSlowModeSeconds(v)
+	return _u
+}
+
+// SetNillableSlowModeSeconds sets the "slow_mode_seconds" field if the given value is not nil.
+func (_u *GroupChatUpdate) SetNillableSlowModeSeconds(v *int) *GroupChatUpdate {
+	if v != nil {
+		_u.SetSlowModeSeconds(*v)
+	}
+	return _u
+}
+
+// AddSlowModeSeconds adds value to the "slow_mode_seconds" field.
+func (_u *GroupChatUpdate) AddSlowModeSeconds(v int) *GroupChatUpdate {
+	_u.mutation.AddSlowModeSeconds(v)
+	return _u
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (_u *GroupChatUpdate) SetRequiresApproval(v bool) *GroupChatUpdate {
+	_u.mutation.SetRequiresApproval(v)
+	return _u
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_u *GroupChatUpdate) SetNillableRequiresApproval(v *bool) *GroupChatUpdate {
+	if v != nil {
+		_u.SetRequiresApproval(*v)
+	}
+	return _u
+}
+
 // SetInviteCode sets the "invite_code" field.
 func (_u *GroupChatUpdate) SetInviteCode(v string) *GroupChatUpdate {
 	_u.mutation.SetInviteCode(v)
@@ -215,6 +251,21 @@ func (_u *GroupChatUpdate) AddMembers(v ...*GroupMember) *GroupChatUpdate {
 	return _u.AddMemberIDs(ids...)
 }
 
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (_u *GroupChatUpdate) AddJoinRequestIDs(ids ...uuid.UUID) *GroupChatUpdate {
+	_u.mutation.AddJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddJoinRequests adds the "join_requests" edges to the GroupJoinRequest entity.
+func (_u *GroupChatUpdate) AddJoinRequests(v ...*GroupJoinRequest) *GroupChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJoinRequestIDs(ids...)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_u *GroupChatUpdate) AddReportIDs(ids ...uuid.UUID) *GroupChatUpdate {
 	_u.mutation.AddReportIDs(ids...)
@@ -274,6 +325,27 @@ func (_u *GroupChatUpdate) RemoveMembers(v ...*GroupMember) *GroupChatUpdate {
 	return _u.RemoveMemberIDs(ids...)
 }
 
+// ClearJoinRequests clears all "join_requests" edges to the GroupJoinRequest entity.
+func (_u *GroupChatUpdate) ClearJoinRequests() *GroupChatUpdate {
+	_u.mutation.ClearJoinRequests()
+	return _u
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to GroupJoinRequest entities by IDs.
+func (_u *GroupChatUpdate) RemoveJoinRequestIDs(ids ...uuid.UUID) *GroupChatUpdate {
+	_u.mutation.RemoveJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveJoinRequests removes "join_requests" edges to GroupJoinRequest entities.
+func (_u *GroupChatUpdate) RemoveJoinRequests(v ...*GroupJoinRequest) *GroupChatUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJoinRequestIDs(ids...)
+}
+
 // ClearReports clears all "reports" edges to the Report entity.
 func (_u *GroupChatUpdate) ClearReports() *GroupChatUpdate {
 	_u.mutation.ClearReports()
@@ -329,6 +401,11 @@ func (_u *GroupChatUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GroupChat.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SlowModeSeconds(); ok {
+		if err := groupchat.SlowModeSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "slow_mode_seconds", err: fmt.Errorf(`ent: validator failed for field "GroupChat.slow_mode_seconds": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.InviteCode(); ok {
 		if err := groupchat.InviteCodeValidator(v); err != nil {
 			return &ValidationError{Name: "invite_code", err: fmt.Errorf(`ent: validator failed for field "GroupChat.invite_code": %w`, err)}
@@ -370,6 +447,15 @@ func (_u *GroupChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(groupchat.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.SlowModeSeconds(); ok {
+		_spec.SetField(groupchat.FieldSlowModeSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlowModeSeconds(); ok {
+		_spec.AddField(groupchat.FieldSlowModeSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RequiresApproval(); ok {
+		_spec.SetField(groupchat.FieldRequiresApproval, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.InviteCode(); ok {
 		_spec.SetField(groupchat.FieldInviteCode, field.TypeString, value)
 	}
@@ -511,6 +597,51 @@ func (_u *GroupChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -680,6 +811,41 @@ func (_u *GroupChatUpdateOne) SetNillableIsPublic(v *bool) *GroupChatUpdateOne {
 	return _u
 }
 
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (_u *GroupChatUpdateOne) SetSlowModeSeconds(v int) *GroupChatUpdateOne {
+	_u.mutation.ResetSlowModeSeconds()
+	_u.mutation.SetSlowModeSeconds(v)
+	return _u
+}
+
+// SetNillableSlowModeSeconds sets the "slow_mode_seconds" field if the given value is not nil.
+func (_u *GroupChatUpdateOne) SetNillableSlowModeSeconds(v *int) *GroupChatUpdateOne {
+	if v != nil {
+		_u.SetSlowModeSeconds(*v)
+	}
+	return _u
+}
+
+// AddSlowModeSeconds adds value to the "slow_mode_seconds" field.
+func (_u *GroupChatUpdateOne) AddSlowModeSeconds(v int) *GroupChatUpdateOne {
+	_u.mutation.AddSlowModeSeconds(v)
+	return _u
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (_u *GroupChatUpdateOne) SetRequiresApproval(v bool) *GroupChatUpdateOne {
+	_u.mutation.SetRequiresApproval(v)
+	return _u
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_u *GroupChatUpdateOne) SetNillableRequiresApproval(v *bool) *GroupChatUpdateOne {
+	if v != nil {
+		_u.SetRequiresApproval(*v)
+	}
+	return _u
+}
+
 // SetInviteCode sets the "invite_code" field.
 func (_u *GroupChatUpdateOne) SetInviteCode(v string) *GroupChatUpdateOne {
 	_u.mutation.SetInviteCode(v)
@@ -758,6 +924,21 @@ func (_u *GroupChatUpdateOne) AddMembers(v ...*GroupMember) *GroupChatUpdateOne
 	return _u.AddMemberIDs(ids...)
 }
 
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (_u *GroupChatUpdateOne) AddJoinRequestIDs(ids ...uuid.UUID) *GroupChatUpdateOne {
+	_u.mutation.AddJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddJoinRequests adds the "join_requests" edges to the GroupJoinRequest entity.
+func (_u *GroupChatUpdateOne) AddJoinRequests(v ...*GroupJoinRequest) *GroupChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJoinRequestIDs(ids...)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_u *GroupChatUpdateOne) AddReportIDs(ids ...uuid.UUID) *GroupChatUpdateOne {
 	_u.mutation.AddReportIDs(ids...)
@@ -817,6 +998,27 @@ func (_u *GroupChatUpdateOne) RemoveMembers(v ...*GroupMember) *GroupChatUpdateO
 	return _u.RemoveMemberIDs(ids...)
 }
 
+// ClearJoinRequests clears all "join_requests" edges to the GroupJoinRequest entity.
+func (_u *GroupChatUpdateOne) ClearJoinRequests() *GroupChatUpdateOne {
+	_u.mutation.ClearJoinRequests()
+	return _u
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to GroupJoinRequest entities by IDs.
+func (_u *GroupChatUpdateOne) RemoveJoinRequestIDs(ids ...uuid.UUID) *GroupChatUpdateOne {
+	_u.mutation.RemoveJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveJoinRequests removes "join_requests" edges to GroupJoinRequest entities.
+func (_u *GroupChatUpdateOne) RemoveJoinRequests(v ...*GroupJoinRequest) *GroupChatUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJoinRequestIDs(ids...)
+}
+
 // ClearReports clears all "reports" edges to the Report entity.
 func (_u *GroupChatUpdateOne) ClearReports() *GroupChatUpdateOne {
 	_u.mutation.ClearReports()
@@ -885,6 +1087,11 @@ func (_u *GroupChatUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GroupChat.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SlowModeSeconds(); ok {
+		if err := groupchat.SlowModeSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "slow_mode_seconds", err: fmt.Errorf(`ent: validator failed for field "GroupChat.slow_mode_seconds": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.InviteCode(); ok {
 		if err := groupchat.InviteCodeValidator(v); err != nil {
 			return &ValidationError{Name: "invite_code", err: fmt.Errorf(`ent: validator failed for field "GroupChat.invite_code": %w`, err)}
@@ -943,6 +1150,15 @@ func (_u *GroupChatUpdateOne) sqlSave(ctx context.Context) (_node *GroupChat, er
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(groupchat.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.SlowModeSeconds(); ok {
+		_spec.SetField(groupchat.FieldSlowModeSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlowModeSeconds(); ok {
+		_spec.AddField(groupchat.FieldSlowModeSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RequiresApproval(); ok {
+		_spec.SetField(groupchat.FieldRequiresApproval, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.InviteCode(); ok {
 		_spec.SetField(groupchat.FieldInviteCode, field.TypeString, value)
 	}
@@ -1084,6 +1300,51 @@ func (_u *GroupChatUpdateOne) sqlSave(ctx context.Context) (_node *GroupChat, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   groupchat.JoinRequestsTable,
+			Columns: []string{groupchat.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
+	"AtoiTalkAPI/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// GroupJoinRequest is the model entity for the GroupJoinRequest schema.
+type GroupJoinRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// GroupChatID holds the value of the "group_chat_id" field.
+	GroupChatID uuid.UUID `json:"group_chat_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status groupjoinrequest.Status `json:"status,omitempty"`
+	// RequestedAt holds the value of the "requested_at" field.
+	RequestedAt time.Time `json:"requested_at,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GroupJoinRequestQuery when eager-loading is set.
+	Edges        GroupJoinRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GroupJoinRequestEdges holds the relations/edges for other nodes in the graph.
+type GroupJoinRequestEdges struct {
+	// GroupChat holds the value of the group_chat edge.
+	GroupChat *GroupChat `json:"group_chat,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GroupChatOrErr returns the GroupChat value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupJoinRequestEdges) GroupChatOrErr() (*GroupChat, error) {
+	if e.GroupChat != nil {
+		return e.GroupChat, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: groupchat.Label}
+	}
+	return nil, &NotLoadedError{edge: "group_chat"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupJoinRequestEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GroupJoinRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupjoinrequest.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case groupjoinrequest.FieldStatus:
+			values[i] = new(sql.NullString)
+		case groupjoinrequest.FieldCreatedAt, groupjoinrequest.FieldUpdatedAt, groupjoinrequest.FieldRequestedAt, groupjoinrequest.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		case groupjoinrequest.FieldID, groupjoinrequest.FieldGroupChatID, groupjoinrequest.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GroupJoinRequest fields.
+func (_m *GroupJoinRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case groupjoinrequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case groupjoinrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case groupjoinrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case groupjoinrequest.FieldGroupChatID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_chat_id", values[i])
+			} else if value != nil {
+				_m.GroupChatID = *value
+			}
+		case groupjoinrequest.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case groupjoinrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = groupjoinrequest.Status(value.String)
+			}
+		case groupjoinrequest.FieldRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_at", values[i])
+			} else if value.Valid {
+				_m.RequestedAt = value.Time
+			}
+		case groupjoinrequest.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(uuid.UUID)
+				*_m.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case groupjoinrequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GroupJoinRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *GroupJoinRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryGroupChat queries the "group_chat" edge of the GroupJoinRequest entity.
+func (_m *GroupJoinRequest) QueryGroupChat() *GroupChatQuery {
+	return NewGroupJoinRequestClient(_m.config).QueryGroupChat(_m)
+}
+
+// QueryUser queries the "user" edge of the GroupJoinRequest entity.
+func (_m *GroupJoinRequest) QueryUser() *UserQuery {
+	return NewGroupJoinRequestClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this GroupJoinRequest.
+// Note that you need to call GroupJoinRequest.Unwrap() before calling this method if this GroupJoinRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GroupJoinRequest) Update() *GroupJoinRequestUpdateOne {
+	return NewGroupJoinRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GroupJoinRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GroupJoinRequest) Unwrap() *GroupJoinRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupJoinRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GroupJoinRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("GroupJoinRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("group_chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupChatID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("requested_at=")
+	builder.WriteString(_m.RequestedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GroupJoinRequests is a parsable slice of GroupJoinRequest.
+type GroupJoinRequests []*GroupJoinRequest
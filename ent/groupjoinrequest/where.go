@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package groupjoinrequest
+
+import (
+	"AtoiTalkAPI/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GroupChatID applies equality check predicate on the "group_chat_id" field. It's identical to GroupChatIDEQ.
+func GroupChatID(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldGroupChatID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// RequestedAt applies equality check predicate on the "requested_at" field. It's identical to RequestedAtEQ.
+func RequestedAt(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldRequestedAt, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// GroupChatIDEQ applies the EQ predicate on the "group_chat_id" field.
+func GroupChatIDEQ(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldGroupChatID, v))
+}
+
+// GroupChatIDNEQ applies the NEQ predicate on the "group_chat_id" field.
+func GroupChatIDNEQ(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldGroupChatID, v))
+}
+
+// GroupChatIDIn applies the In predicate on the "group_chat_id" field.
+func GroupChatIDIn(vs ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldGroupChatID, vs...))
+}
+
+// GroupChatIDNotIn applies the NotIn predicate on the "group_chat_id" field.
+func GroupChatIDNotIn(vs ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldGroupChatID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RequestedAtEQ applies the EQ predicate on the "requested_at" field.
+func RequestedAtEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldRequestedAt, v))
+}
+
+// RequestedAtNEQ applies the NEQ predicate on the "requested_at" field.
+func RequestedAtNEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldRequestedAt, v))
+}
+
+// RequestedAtIn applies the In predicate on the "requested_at" field.
+func RequestedAtIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldRequestedAt, vs...))
+}
+
+// RequestedAtNotIn applies the NotIn predicate on the "requested_at" field.
+func RequestedAtNotIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldRequestedAt, vs...))
+}
+
+// RequestedAtGT applies the GT predicate on the "requested_at" field.
+func RequestedAtGT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGT(FieldRequestedAt, v))
+}
+
+// RequestedAtGTE applies the GTE predicate on the "requested_at" field.
+func RequestedAtGTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGTE(FieldRequestedAt, v))
+}
+
+// RequestedAtLT applies the LT predicate on the "requested_at" field.
+func RequestedAtLT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLT(FieldRequestedAt, v))
+}
+
+// RequestedAtLTE applies the LTE predicate on the "requested_at" field.
+func RequestedAtLTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLTE(FieldRequestedAt, v))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// HasGroupChat applies the HasEdge predicate on the "group_chat" edge.
+func HasGroupChat() predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupChatTable, GroupChatColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupChatWith applies the HasEdge predicate on the "group_chat" edge with a given conditions (other predicates).
+func HasGroupChatWith(preds ...predicate.GroupChat) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(func(s *sql.Selector) {
+		step := newGroupChatStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GroupJoinRequest) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GroupJoinRequest) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GroupJoinRequest) predicate.GroupJoinRequest {
+	return predicate.GroupJoinRequest(sql.NotPredicates(p))
+}
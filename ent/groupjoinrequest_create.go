@@ -0,0 +1,993 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GroupJoinRequestCreate is the builder for creating a GroupJoinRequest entity.
+type GroupJoinRequestCreate struct {
+	config
+	mutation *GroupJoinRequestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GroupJoinRequestCreate) SetCreatedAt(v time.Time) *GroupJoinRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableCreatedAt(v *time.Time) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GroupJoinRequestCreate) SetUpdatedAt(v time.Time) *GroupJoinRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableUpdatedAt(v *time.Time) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetGroupChatID sets the "group_chat_id" field.
+func (_c *GroupJoinRequestCreate) SetGroupChatID(v uuid.UUID) *GroupJoinRequestCreate {
+	_c.mutation.SetGroupChatID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *GroupJoinRequestCreate) SetUserID(v uuid.UUID) *GroupJoinRequestCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GroupJoinRequestCreate) SetStatus(v groupjoinrequest.Status) *GroupJoinRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableStatus(v *groupjoinrequest.Status) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (_c *GroupJoinRequestCreate) SetRequestedAt(v time.Time) *GroupJoinRequestCreate {
+	_c.mutation.SetRequestedAt(v)
+	return _c
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableRequestedAt(v *time.Time) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetRequestedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *GroupJoinRequestCreate) SetReviewedBy(v uuid.UUID) *GroupJoinRequestCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableReviewedBy(v *uuid.UUID) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *GroupJoinRequestCreate) SetReviewedAt(v time.Time) *GroupJoinRequestCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableReviewedAt(v *time.Time) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GroupJoinRequestCreate) SetID(v uuid.UUID) *GroupJoinRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GroupJoinRequestCreate) SetNillableID(v *uuid.UUID) *GroupJoinRequestCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetGroupChat sets the "group_chat" edge to the GroupChat entity.
+func (_c *GroupJoinRequestCreate) SetGroupChat(v *GroupChat) *GroupJoinRequestCreate {
+	return _c.SetGroupChatID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *GroupJoinRequestCreate) SetUser(v *User) *GroupJoinRequestCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (_c *GroupJoinRequestCreate) Mutation() *GroupJoinRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the GroupJoinRequest in the database.
+func (_c *GroupJoinRequestCreate) Save(ctx context.Context) (*GroupJoinRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GroupJoinRequestCreate) SaveX(ctx context.Context) *GroupJoinRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupJoinRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupJoinRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GroupJoinRequestCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := groupjoinrequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := groupjoinrequest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := groupjoinrequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RequestedAt(); !ok {
+		v := groupjoinrequest.DefaultRequestedAt()
+		_c.mutation.SetRequestedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := groupjoinrequest.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GroupJoinRequestCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GroupJoinRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GroupJoinRequest.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GroupChatID(); !ok {
+		return &ValidationError{Name: "group_chat_id", err: errors.New(`ent: missing required field "GroupJoinRequest.group_chat_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "GroupJoinRequest.user_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "GroupJoinRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := groupjoinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GroupJoinRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestedAt(); !ok {
+		return &ValidationError{Name: "requested_at", err: errors.New(`ent: missing required field "GroupJoinRequest.requested_at"`)}
+	}
+	if len(_c.mutation.GroupChatIDs()) == 0 {
+		return &ValidationError{Name: "group_chat", err: errors.New(`ent: missing required edge "GroupJoinRequest.group_chat"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "GroupJoinRequest.user"`)}
+	}
+	return nil
+}
+
+func (_c *GroupJoinRequestCreate) sqlSave(ctx context.Context) (*GroupJoinRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GroupJoinRequestCreate) createSpec() (*GroupJoinRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GroupJoinRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(groupjoinrequest.Table, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(groupjoinrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RequestedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldRequestedAt, field.TypeTime, value)
+		_node.RequestedAt = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if nodes := _c.mutation.GroupChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupChatTable,
+			Columns: []string{groupjoinrequest.GroupChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupChatID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupJoinRequest.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupJoinRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GroupJoinRequestCreate) OnConflict(opts ...sql.ConflictOption) *GroupJoinRequestUpsertOne {
+	_c.conflict = opts
+	return &GroupJoinRequestUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupJoinRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GroupJoinRequestCreate) OnConflictColumns(columns ...string) *GroupJoinRequestUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GroupJoinRequestUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GroupJoinRequestUpsertOne is the builder for "upsert"-ing
+	//  one GroupJoinRequest node.
+	GroupJoinRequestUpsertOne struct {
+		create *GroupJoinRequestCreate
+	}
+
+	// GroupJoinRequestUpsert is the "OnConflict" setter.
+	GroupJoinRequestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GroupJoinRequestUpsert) SetUpdatedAt(v time.Time) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateUpdatedAt() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldUpdatedAt)
+	return u
+}
+
+// SetGroupChatID sets the "group_chat_id" field.
+func (u *GroupJoinRequestUpsert) SetGroupChatID(v uuid.UUID) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldGroupChatID, v)
+	return u
+}
+
+// UpdateGroupChatID sets the "group_chat_id" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateGroupChatID() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldGroupChatID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupJoinRequestUpsert) SetUserID(v uuid.UUID) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateUserID() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldUserID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *GroupJoinRequestUpsert) SetStatus(v groupjoinrequest.Status) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateStatus() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldStatus)
+	return u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *GroupJoinRequestUpsert) SetRequestedAt(v time.Time) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldRequestedAt, v)
+	return u
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateRequestedAt() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldRequestedAt)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *GroupJoinRequestUpsert) SetReviewedBy(v uuid.UUID) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateReviewedBy() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldReviewedBy)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *GroupJoinRequestUpsert) ClearReviewedBy() *GroupJoinRequestUpsert {
+	u.SetNull(groupjoinrequest.FieldReviewedBy)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *GroupJoinRequestUpsert) SetReviewedAt(v time.Time) *GroupJoinRequestUpsert {
+	u.Set(groupjoinrequest.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsert) UpdateReviewedAt() *GroupJoinRequestUpsert {
+	u.SetExcluded(groupjoinrequest.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *GroupJoinRequestUpsert) ClearReviewedAt() *GroupJoinRequestUpsert {
+	u.SetNull(groupjoinrequest.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GroupJoinRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupjoinrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupJoinRequestUpsertOne) UpdateNewValues() *GroupJoinRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(groupjoinrequest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(groupjoinrequest.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupJoinRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GroupJoinRequestUpsertOne) Ignore() *GroupJoinRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupJoinRequestUpsertOne) DoNothing() *GroupJoinRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupJoinRequestCreate.OnConflict
+// documentation for more info.
+func (u *GroupJoinRequestUpsertOne) Update(set func(*GroupJoinRequestUpsert)) *GroupJoinRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupJoinRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GroupJoinRequestUpsertOne) SetUpdatedAt(v time.Time) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateUpdatedAt() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetGroupChatID sets the "group_chat_id" field.
+func (u *GroupJoinRequestUpsertOne) SetGroupChatID(v uuid.UUID) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetGroupChatID(v)
+	})
+}
+
+// UpdateGroupChatID sets the "group_chat_id" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateGroupChatID() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateGroupChatID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupJoinRequestUpsertOne) SetUserID(v uuid.UUID) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateUserID() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GroupJoinRequestUpsertOne) SetStatus(v groupjoinrequest.Status) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateStatus() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *GroupJoinRequestUpsertOne) SetRequestedAt(v time.Time) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetRequestedAt(v)
+	})
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateRequestedAt() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateRequestedAt()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *GroupJoinRequestUpsertOne) SetReviewedBy(v uuid.UUID) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateReviewedBy() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *GroupJoinRequestUpsertOne) ClearReviewedBy() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *GroupJoinRequestUpsertOne) SetReviewedAt(v time.Time) *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertOne) UpdateReviewedAt() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *GroupJoinRequestUpsertOne) ClearReviewedAt() *GroupJoinRequestUpsertOne {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupJoinRequestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupJoinRequestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupJoinRequestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GroupJoinRequestUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GroupJoinRequestUpsertOne.ID is not supported by MySQL driver. Use GroupJoinRequestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GroupJoinRequestUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GroupJoinRequestCreateBulk is the builder for creating many GroupJoinRequest entities in bulk.
+type GroupJoinRequestCreateBulk struct {
+	config
+	err      error
+	builders []*GroupJoinRequestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GroupJoinRequest entities in the database.
+func (_c *GroupJoinRequestCreateBulk) Save(ctx context.Context) ([]*GroupJoinRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GroupJoinRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupJoinRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GroupJoinRequestCreateBulk) SaveX(ctx context.Context) []*GroupJoinRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GroupJoinRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GroupJoinRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GroupJoinRequest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GroupJoinRequestUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GroupJoinRequestCreateBulk) OnConflict(opts ...sql.ConflictOption) *GroupJoinRequestUpsertBulk {
+	_c.conflict = opts
+	return &GroupJoinRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GroupJoinRequest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GroupJoinRequestCreateBulk) OnConflictColumns(columns ...string) *GroupJoinRequestUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GroupJoinRequestUpsertBulk{
+		create: _c,
+	}
+}
+
+// GroupJoinRequestUpsertBulk is the builder for "upsert"-ing
+// a bulk of GroupJoinRequest nodes.
+type GroupJoinRequestUpsertBulk struct {
+	create *GroupJoinRequestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GroupJoinRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(groupjoinrequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GroupJoinRequestUpsertBulk) UpdateNewValues() *GroupJoinRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(groupjoinrequest.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(groupjoinrequest.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GroupJoinRequest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GroupJoinRequestUpsertBulk) Ignore() *GroupJoinRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GroupJoinRequestUpsertBulk) DoNothing() *GroupJoinRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GroupJoinRequestCreateBulk.OnConflict
+// documentation for more info.
+func (u *GroupJoinRequestUpsertBulk) Update(set func(*GroupJoinRequestUpsert)) *GroupJoinRequestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GroupJoinRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GroupJoinRequestUpsertBulk) SetUpdatedAt(v time.Time) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateUpdatedAt() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetGroupChatID sets the "group_chat_id" field.
+func (u *GroupJoinRequestUpsertBulk) SetGroupChatID(v uuid.UUID) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetGroupChatID(v)
+	})
+}
+
+// UpdateGroupChatID sets the "group_chat_id" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateGroupChatID() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateGroupChatID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *GroupJoinRequestUpsertBulk) SetUserID(v uuid.UUID) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateUserID() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GroupJoinRequestUpsertBulk) SetStatus(v groupjoinrequest.Status) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateStatus() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *GroupJoinRequestUpsertBulk) SetRequestedAt(v time.Time) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetRequestedAt(v)
+	})
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateRequestedAt() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateRequestedAt()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *GroupJoinRequestUpsertBulk) SetReviewedBy(v uuid.UUID) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateReviewedBy() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *GroupJoinRequestUpsertBulk) ClearReviewedBy() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *GroupJoinRequestUpsertBulk) SetReviewedAt(v time.Time) *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *GroupJoinRequestUpsertBulk) UpdateReviewedAt() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *GroupJoinRequestUpsertBulk) ClearReviewedAt() *GroupJoinRequestUpsertBulk {
+	return u.Update(func(s *GroupJoinRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GroupJoinRequestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GroupJoinRequestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GroupJoinRequestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GroupJoinRequestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
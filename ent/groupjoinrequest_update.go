@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GroupJoinRequestUpdate is the builder for updating GroupJoinRequest entities.
+type GroupJoinRequestUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GroupJoinRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GroupJoinRequestUpdate builder.
+func (_u *GroupJoinRequestUpdate) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupJoinRequestUpdate) SetUpdatedAt(v time.Time) *GroupJoinRequestUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupChatID sets the "group_chat_id" field.
+func (_u *GroupJoinRequestUpdate) SetGroupChatID(v uuid.UUID) *GroupJoinRequestUpdate {
+	_u.mutation.SetGroupChatID(v)
+	return _u
+}
+
+// SetNillableGroupChatID sets the "group_chat_id" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdate) SetNillableGroupChatID(v *uuid.UUID) *GroupJoinRequestUpdate {
+	if v != nil {
+		_u.SetGroupChatID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *GroupJoinRequestUpdate) SetUserID(v uuid.UUID) *GroupJoinRequestUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdate) SetNillableUserID(v *uuid.UUID) *GroupJoinRequestUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GroupJoinRequestUpdate) SetStatus(v groupjoinrequest.Status) *GroupJoinRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdate) SetNillableStatus(v *groupjoinrequest.Status) *GroupJoinRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (_u *GroupJoinRequestUpdate) SetRequestedAt(v time.Time) *GroupJoinRequestUpdate {
+	_u.mutation.SetRequestedAt(v)
+	return _u
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdate) SetNillableRequestedAt(v *time.Time) *GroupJoinRequestUpdate {
+	if v != nil {
+		_u.SetRequestedAt(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *GroupJoinRequestUpdate) SetReviewedBy(v uuid.UUID) *GroupJoinRequestUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdate) SetNillableReviewedBy(v *uuid.UUID) *GroupJoinRequestUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *GroupJoinRequestUpdate) ClearReviewedBy() *GroupJoinRequestUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *GroupJoinRequestUpdate) SetReviewedAt(v time.Time) *GroupJoinRequestUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdate) SetNillableReviewedAt(v *time.Time) *GroupJoinRequestUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *GroupJoinRequestUpdate) ClearReviewedAt() *GroupJoinRequestUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetGroupChat sets the "group_chat" edge to the GroupChat entity.
+func (_u *GroupJoinRequestUpdate) SetGroupChat(v *GroupChat) *GroupJoinRequestUpdate {
+	return _u.SetGroupChatID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *GroupJoinRequestUpdate) SetUser(v *User) *GroupJoinRequestUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (_u *GroupJoinRequestUpdate) Mutation() *GroupJoinRequestMutation {
+	return _u.mutation
+}
+
+// ClearGroupChat clears the "group_chat" edge to the GroupChat entity.
+func (_u *GroupJoinRequestUpdate) ClearGroupChat() *GroupJoinRequestUpdate {
+	_u.mutation.ClearGroupChat()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *GroupJoinRequestUpdate) ClearUser() *GroupJoinRequestUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GroupJoinRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupJoinRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GroupJoinRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupJoinRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupJoinRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := groupjoinrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupJoinRequestUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := groupjoinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GroupJoinRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupChatCleared() && len(_u.mutation.GroupChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.group_chat"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GroupJoinRequestUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GroupJoinRequestUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GroupJoinRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(groupjoinrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequestedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldRequestedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(groupjoinrequest.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(groupjoinrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if _u.mutation.GroupChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupChatTable,
+			Columns: []string{groupjoinrequest.GroupChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupChatTable,
+			Columns: []string{groupjoinrequest.GroupChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupjoinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GroupJoinRequestUpdateOne is the builder for updating a single GroupJoinRequest entity.
+type GroupJoinRequestUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GroupJoinRequestMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GroupJoinRequestUpdateOne) SetUpdatedAt(v time.Time) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetGroupChatID sets the "group_chat_id" field.
+func (_u *GroupJoinRequestUpdateOne) SetGroupChatID(v uuid.UUID) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetGroupChatID(v)
+	return _u
+}
+
+// SetNillableGroupChatID sets the "group_chat_id" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdateOne) SetNillableGroupChatID(v *uuid.UUID) *GroupJoinRequestUpdateOne {
+	if v != nil {
+		_u.SetGroupChatID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *GroupJoinRequestUpdateOne) SetUserID(v uuid.UUID) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdateOne) SetNillableUserID(v *uuid.UUID) *GroupJoinRequestUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GroupJoinRequestUpdateOne) SetStatus(v groupjoinrequest.Status) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdateOne) SetNillableStatus(v *groupjoinrequest.Status) *GroupJoinRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (_u *GroupJoinRequestUpdateOne) SetRequestedAt(v time.Time) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetRequestedAt(v)
+	return _u
+}
+
+// SetNillableRequestedAt sets the "requested_at" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdateOne) SetNillableRequestedAt(v *time.Time) *GroupJoinRequestUpdateOne {
+	if v != nil {
+		_u.SetRequestedAt(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *GroupJoinRequestUpdateOne) SetReviewedBy(v uuid.UUID) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdateOne) SetNillableReviewedBy(v *uuid.UUID) *GroupJoinRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *GroupJoinRequestUpdateOne) ClearReviewedBy() *GroupJoinRequestUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *GroupJoinRequestUpdateOne) SetReviewedAt(v time.Time) *GroupJoinRequestUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *GroupJoinRequestUpdateOne) SetNillableReviewedAt(v *time.Time) *GroupJoinRequestUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *GroupJoinRequestUpdateOne) ClearReviewedAt() *GroupJoinRequestUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetGroupChat sets the "group_chat" edge to the GroupChat entity.
+func (_u *GroupJoinRequestUpdateOne) SetGroupChat(v *GroupChat) *GroupJoinRequestUpdateOne {
+	return _u.SetGroupChatID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *GroupJoinRequestUpdateOne) SetUser(v *User) *GroupJoinRequestUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the GroupJoinRequestMutation object of the builder.
+func (_u *GroupJoinRequestUpdateOne) Mutation() *GroupJoinRequestMutation {
+	return _u.mutation
+}
+
+// ClearGroupChat clears the "group_chat" edge to the GroupChat entity.
+func (_u *GroupJoinRequestUpdateOne) ClearGroupChat() *GroupJoinRequestUpdateOne {
+	_u.mutation.ClearGroupChat()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *GroupJoinRequestUpdateOne) ClearUser() *GroupJoinRequestUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the GroupJoinRequestUpdate builder.
+func (_u *GroupJoinRequestUpdateOne) Where(ps ...predicate.GroupJoinRequest) *GroupJoinRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GroupJoinRequestUpdateOne) Select(field string, fields ...string) *GroupJoinRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GroupJoinRequest entity.
+func (_u *GroupJoinRequestUpdateOne) Save(ctx context.Context) (*GroupJoinRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GroupJoinRequestUpdateOne) SaveX(ctx context.Context) *GroupJoinRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GroupJoinRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GroupJoinRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GroupJoinRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := groupjoinrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GroupJoinRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := groupjoinrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "GroupJoinRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.GroupChatCleared() && len(_u.mutation.GroupChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.group_chat"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "GroupJoinRequest.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *GroupJoinRequestUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GroupJoinRequestUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *GroupJoinRequestUpdateOne) sqlSave(ctx context.Context) (_node *GroupJoinRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(groupjoinrequest.Table, groupjoinrequest.Columns, sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GroupJoinRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, groupjoinrequest.FieldID)
+		for _, f := range fields {
+			if !groupjoinrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != groupjoinrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(groupjoinrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequestedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldRequestedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(groupjoinrequest.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(groupjoinrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(groupjoinrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if _u.mutation.GroupChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupChatTable,
+			Columns: []string{groupjoinrequest.GroupChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.GroupChatTable,
+			Columns: []string{groupjoinrequest.GroupChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   groupjoinrequest.UserTable,
+			Columns: []string{groupjoinrequest.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &GroupJoinRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{groupjoinrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
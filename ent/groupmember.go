@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
 	"AtoiTalkAPI/ent/user"
 	"fmt"
 	"strings"
@@ -32,6 +33,24 @@ type GroupMember struct {
 	JoinedAt time.Time `json:"joined_at,omitempty"`
 	// UnreadCount holds the value of the "unread_count" field.
 	UnreadCount int `json:"unread_count,omitempty"`
+	// UnreadMentionCount holds the value of the "unread_mention_count" field.
+	UnreadMentionCount int `json:"unread_mention_count,omitempty"`
+	// MarkedUnread holds the value of the "marked_unread" field.
+	MarkedUnread bool `json:"marked_unread,omitempty"`
+	// LastReadMessageID holds the value of the "last_read_message_id" field.
+	LastReadMessageID *uuid.UUID `json:"last_read_message_id,omitempty"`
+	// LastDeliveredMessageID holds the value of the "last_delivered_message_id" field.
+	LastDeliveredMessageID *uuid.UUID `json:"last_delivered_message_id,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// KeepArchived holds the value of the "keep_archived" field.
+	KeepArchived bool `json:"keep_archived,omitempty"`
+	// Muted holds the value of the "muted" field.
+	Muted bool `json:"muted,omitempty"`
+	// MutedUntil holds the value of the "muted_until" field.
+	MutedUntil *time.Time `json:"muted_until,omitempty"`
+	// NotifyMentions holds the value of the "notify_mentions" field.
+	NotifyMentions bool `json:"notify_mentions,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupMemberQuery when eager-loading is set.
 	Edges        GroupMemberEdges `json:"edges"`
@@ -44,9 +63,13 @@ type GroupMemberEdges struct {
 	GroupChat *GroupChat `json:"group_chat,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// LastReadMessage holds the value of the last_read_message edge.
+	LastReadMessage *Message `json:"last_read_message,omitempty"`
+	// LastDeliveredMessage holds the value of the last_delivered_message edge.
+	LastDeliveredMessage *Message `json:"last_delivered_message,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // GroupChatOrErr returns the GroupChat value or an error if the edge
@@ -71,16 +94,42 @@ func (e GroupMemberEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// LastReadMessageOrErr returns the LastReadMessage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupMemberEdges) LastReadMessageOrErr() (*Message, error) {
+	if e.LastReadMessage != nil {
+		return e.LastReadMessage, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "last_read_message"}
+}
+
+// LastDeliveredMessageOrErr returns the LastDeliveredMessage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupMemberEdges) LastDeliveredMessageOrErr() (*Message, error) {
+	if e.LastDeliveredMessage != nil {
+		return e.LastDeliveredMessage, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "last_delivered_message"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*GroupMember) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case groupmember.FieldUnreadCount:
+		case groupmember.FieldLastReadMessageID, groupmember.FieldLastDeliveredMessageID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case groupmember.FieldMarkedUnread, groupmember.FieldKeepArchived, groupmember.FieldMuted, groupmember.FieldNotifyMentions:
+			values[i] = new(sql.NullBool)
+		case groupmember.FieldUnreadCount, groupmember.FieldUnreadMentionCount:
 			values[i] = new(sql.NullInt64)
 		case groupmember.FieldRole:
 			values[i] = new(sql.NullString)
-		case groupmember.FieldLastReadAt, groupmember.FieldJoinedAt:
+		case groupmember.FieldLastReadAt, groupmember.FieldJoinedAt, groupmember.FieldArchivedAt, groupmember.FieldMutedUntil:
 			values[i] = new(sql.NullTime)
 		case groupmember.FieldID, groupmember.FieldGroupChatID, groupmember.FieldUserID:
 			values[i] = new(uuid.UUID)
@@ -142,6 +191,64 @@ func (_m *GroupMember) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UnreadCount = int(value.Int64)
 			}
+		case groupmember.FieldUnreadMentionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unread_mention_count", values[i])
+			} else if value.Valid {
+				_m.UnreadMentionCount = int(value.Int64)
+			}
+		case groupmember.FieldMarkedUnread:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field marked_unread", values[i])
+			} else if value.Valid {
+				_m.MarkedUnread = value.Bool
+			}
+		case groupmember.FieldLastReadMessageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field last_read_message_id", values[i])
+			} else if value.Valid {
+				_m.LastReadMessageID = new(uuid.UUID)
+				*_m.LastReadMessageID = *value.S.(*uuid.UUID)
+			}
+		case groupmember.FieldLastDeliveredMessageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field last_delivered_message_id", values[i])
+			} else if value.Valid {
+				_m.LastDeliveredMessageID = new(uuid.UUID)
+				*_m.LastDeliveredMessageID = *value.S.(*uuid.UUID)
+			}
+		case groupmember.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
+		case groupmember.FieldKeepArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_archived", values[i])
+			} else if value.Valid {
+				_m.KeepArchived = value.Bool
+			}
+		case groupmember.FieldMuted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field muted", values[i])
+			} else if value.Valid {
+				_m.Muted = value.Bool
+			}
+		case groupmember.FieldMutedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_until", values[i])
+			} else if value.Valid {
+				_m.MutedUntil = new(time.Time)
+				*_m.MutedUntil = value.Time
+			}
+		case groupmember.FieldNotifyMentions:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_mentions", values[i])
+			} else if value.Valid {
+				_m.NotifyMentions = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -165,6 +272,16 @@ func (_m *GroupMember) QueryUser() *UserQuery {
 	return NewGroupMemberClient(_m.config).QueryUser(_m)
 }
 
+// QueryLastReadMessage queries the "last_read_message" edge of the GroupMember entity.
+func (_m *GroupMember) QueryLastReadMessage() *MessageQuery {
+	return NewGroupMemberClient(_m.config).QueryLastReadMessage(_m)
+}
+
+// QueryLastDeliveredMessage queries the "last_delivered_message" edge of the GroupMember entity.
+func (_m *GroupMember) QueryLastDeliveredMessage() *MessageQuery {
+	return NewGroupMemberClient(_m.config).QueryLastDeliveredMessage(_m)
+}
+
 // Update returns a builder for updating this GroupMember.
 // Note that you need to call GroupMember.Unwrap() before calling this method if this GroupMember
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -207,6 +324,41 @@ func (_m *GroupMember) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("unread_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UnreadCount))
+	builder.WriteString(", ")
+	builder.WriteString("unread_mention_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UnreadMentionCount))
+	builder.WriteString(", ")
+	builder.WriteString("marked_unread=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MarkedUnread))
+	builder.WriteString(", ")
+	if v := _m.LastReadMessageID; v != nil {
+		builder.WriteString("last_read_message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastDeliveredMessageID; v != nil {
+		builder.WriteString("last_delivered_message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("keep_archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepArchived))
+	builder.WriteString(", ")
+	builder.WriteString("muted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Muted))
+	builder.WriteString(", ")
+	if v := _m.MutedUntil; v != nil {
+		builder.WriteString("muted_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("notify_mentions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyMentions))
 	builder.WriteByte(')')
 	return builder.String()
 }
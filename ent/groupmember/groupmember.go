@@ -28,10 +28,32 @@ const (
 	FieldJoinedAt = "joined_at"
 	// FieldUnreadCount holds the string denoting the unread_count field in the database.
 	FieldUnreadCount = "unread_count"
+	// FieldUnreadMentionCount holds the string denoting the unread_mention_count field in the database.
+	FieldUnreadMentionCount = "unread_mention_count"
+	// FieldMarkedUnread holds the string denoting the marked_unread field in the database.
+	FieldMarkedUnread = "marked_unread"
+	// FieldLastReadMessageID holds the string denoting the last_read_message_id field in the database.
+	FieldLastReadMessageID = "last_read_message_id"
+	// FieldLastDeliveredMessageID holds the string denoting the last_delivered_message_id field in the database.
+	FieldLastDeliveredMessageID = "last_delivered_message_id"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldKeepArchived holds the string denoting the keep_archived field in the database.
+	FieldKeepArchived = "keep_archived"
+	// FieldMuted holds the string denoting the muted field in the database.
+	FieldMuted = "muted"
+	// FieldMutedUntil holds the string denoting the muted_until field in the database.
+	FieldMutedUntil = "muted_until"
+	// FieldNotifyMentions holds the string denoting the notify_mentions field in the database.
+	FieldNotifyMentions = "notify_mentions"
 	// EdgeGroupChat holds the string denoting the group_chat edge name in mutations.
 	EdgeGroupChat = "group_chat"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeLastReadMessage holds the string denoting the last_read_message edge name in mutations.
+	EdgeLastReadMessage = "last_read_message"
+	// EdgeLastDeliveredMessage holds the string denoting the last_delivered_message edge name in mutations.
+	EdgeLastDeliveredMessage = "last_delivered_message"
 	// Table holds the table name of the groupmember in the database.
 	Table = "group_members"
 	// GroupChatTable is the table that holds the group_chat relation/edge.
@@ -48,6 +70,20 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// LastReadMessageTable is the table that holds the last_read_message relation/edge.
+	LastReadMessageTable = "group_members"
+	// LastReadMessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	LastReadMessageInverseTable = "messages"
+	// LastReadMessageColumn is the table column denoting the last_read_message relation/edge.
+	LastReadMessageColumn = "last_read_message_id"
+	// LastDeliveredMessageTable is the table that holds the last_delivered_message relation/edge.
+	LastDeliveredMessageTable = "group_members"
+	// LastDeliveredMessageInverseTable is the table name for the Message entity.
+	// It exists in this package in order to avoid circular dependency with the "message" package.
+	LastDeliveredMessageInverseTable = "messages"
+	// LastDeliveredMessageColumn is the table column denoting the last_delivered_message relation/edge.
+	LastDeliveredMessageColumn = "last_delivered_message_id"
 )
 
 // Columns holds all SQL columns for groupmember fields.
@@ -59,6 +95,15 @@ var Columns = []string{
 	FieldLastReadAt,
 	FieldJoinedAt,
 	FieldUnreadCount,
+	FieldUnreadMentionCount,
+	FieldMarkedUnread,
+	FieldLastReadMessageID,
+	FieldLastDeliveredMessageID,
+	FieldArchivedAt,
+	FieldKeepArchived,
+	FieldMuted,
+	FieldMutedUntil,
+	FieldNotifyMentions,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -76,6 +121,16 @@ var (
 	DefaultJoinedAt func() time.Time
 	// DefaultUnreadCount holds the default value on creation for the "unread_count" field.
 	DefaultUnreadCount int
+	// DefaultUnreadMentionCount holds the default value on creation for the "unread_mention_count" field.
+	DefaultUnreadMentionCount int
+	// DefaultMarkedUnread holds the default value on creation for the "marked_unread" field.
+	DefaultMarkedUnread bool
+	// DefaultKeepArchived holds the default value on creation for the "keep_archived" field.
+	DefaultKeepArchived bool
+	// DefaultMuted holds the default value on creation for the "muted" field.
+	DefaultMuted bool
+	// DefaultNotifyMentions holds the default value on creation for the "notify_mentions" field.
+	DefaultNotifyMentions bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -145,6 +200,51 @@ func ByUnreadCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUnreadCount, opts...).ToFunc()
 }
 
+// ByUnreadMentionCount orders the results by the unread_mention_count field.
+func ByUnreadMentionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnreadMentionCount, opts...).ToFunc()
+}
+
+// ByMarkedUnread orders the results by the marked_unread field.
+func ByMarkedUnread(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMarkedUnread, opts...).ToFunc()
+}
+
+// ByLastReadMessageID orders the results by the last_read_message_id field.
+func ByLastReadMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastReadMessageID, opts...).ToFunc()
+}
+
+// ByLastDeliveredMessageID orders the results by the last_delivered_message_id field.
+func ByLastDeliveredMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDeliveredMessageID, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByKeepArchived orders the results by the keep_archived field.
+func ByKeepArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepArchived, opts...).ToFunc()
+}
+
+// ByMuted orders the results by the muted field.
+func ByMuted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMuted, opts...).ToFunc()
+}
+
+// ByMutedUntil orders the results by the muted_until field.
+func ByMutedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMutedUntil, opts...).ToFunc()
+}
+
+// ByNotifyMentions orders the results by the notify_mentions field.
+func ByNotifyMentions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyMentions, opts...).ToFunc()
+}
+
 // ByGroupChatField orders the results by group_chat field.
 func ByGroupChatField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -158,6 +258,20 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByLastReadMessageField orders the results by last_read_message field.
+func ByLastReadMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLastReadMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLastDeliveredMessageField orders the results by last_delivered_message field.
+func ByLastDeliveredMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLastDeliveredMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newGroupChatStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -172,3 +286,17 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newLastReadMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LastReadMessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LastReadMessageTable, LastReadMessageColumn),
+	)
+}
+func newLastDeliveredMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LastDeliveredMessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LastDeliveredMessageTable, LastDeliveredMessageColumn),
+	)
+}
@@ -81,6 +81,51 @@ func UnreadCount(v int) predicate.GroupMember {
 	return predicate.GroupMember(sql.FieldEQ(FieldUnreadCount, v))
 }
 
+// UnreadMentionCount applies equality check predicate on the "unread_mention_count" field. It's identical to UnreadMentionCountEQ.
+func UnreadMentionCount(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldUnreadMentionCount, v))
+}
+
+// MarkedUnread applies equality check predicate on the "marked_unread" field. It's identical to MarkedUnreadEQ.
+func MarkedUnread(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldMarkedUnread, v))
+}
+
+// LastReadMessageID applies equality check predicate on the "last_read_message_id" field. It's identical to LastReadMessageIDEQ.
+func LastReadMessageID(v uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// LastDeliveredMessageID applies equality check predicate on the "last_delivered_message_id" field. It's identical to LastDeliveredMessageIDEQ.
+func LastDeliveredMessageID(v uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldLastDeliveredMessageID, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// KeepArchived applies equality check predicate on the "keep_archived" field. It's identical to KeepArchivedEQ.
+func KeepArchived(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldKeepArchived, v))
+}
+
+// Muted applies equality check predicate on the "muted" field. It's identical to MutedEQ.
+func Muted(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldMuted, v))
+}
+
+// MutedUntil applies equality check predicate on the "muted_until" field. It's identical to MutedUntilEQ.
+func MutedUntil(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// NotifyMentions applies equality check predicate on the "notify_mentions" field. It's identical to NotifyMentionsEQ.
+func NotifyMentions(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldNotifyMentions, v))
+}
+
 // GroupChatIDEQ applies the EQ predicate on the "group_chat_id" field.
 func GroupChatIDEQ(v uuid.UUID) predicate.GroupMember {
 	return predicate.GroupMember(sql.FieldEQ(FieldGroupChatID, v))
@@ -271,6 +316,246 @@ func UnreadCountLTE(v int) predicate.GroupMember {
 	return predicate.GroupMember(sql.FieldLTE(FieldUnreadCount, v))
 }
 
+// UnreadMentionCountEQ applies the EQ predicate on the "unread_mention_count" field.
+func UnreadMentionCountEQ(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldUnreadMentionCount, v))
+}
+
+// UnreadMentionCountNEQ applies the NEQ predicate on the "unread_mention_count" field.
+func UnreadMentionCountNEQ(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldUnreadMentionCount, v))
+}
+
+// UnreadMentionCountIn applies the In predicate on the "unread_mention_count" field.
+func UnreadMentionCountIn(vs ...int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIn(FieldUnreadMentionCount, vs...))
+}
+
+// UnreadMentionCountNotIn applies the NotIn predicate on the "unread_mention_count" field.
+func UnreadMentionCountNotIn(vs ...int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotIn(FieldUnreadMentionCount, vs...))
+}
+
+// UnreadMentionCountGT applies the GT predicate on the "unread_mention_count" field.
+func UnreadMentionCountGT(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldGT(FieldUnreadMentionCount, v))
+}
+
+// UnreadMentionCountGTE applies the GTE predicate on the "unread_mention_count" field.
+func UnreadMentionCountGTE(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldGTE(FieldUnreadMentionCount, v))
+}
+
+// UnreadMentionCountLT applies the LT predicate on the "unread_mention_count" field.
+func UnreadMentionCountLT(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldLT(FieldUnreadMentionCount, v))
+}
+
+// UnreadMentionCountLTE applies the LTE predicate on the "unread_mention_count" field.
+func UnreadMentionCountLTE(v int) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldLTE(FieldUnreadMentionCount, v))
+}
+
+// MarkedUnreadEQ applies the EQ predicate on the "marked_unread" field.
+func MarkedUnreadEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldMarkedUnread, v))
+}
+
+// MarkedUnreadNEQ applies the NEQ predicate on the "marked_unread" field.
+func MarkedUnreadNEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldMarkedUnread, v))
+}
+
+// LastReadMessageIDEQ applies the EQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDEQ(v uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDNEQ applies the NEQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDNEQ(v uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDIn applies the In predicate on the "last_read_message_id" field.
+func LastReadMessageIDIn(vs ...uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDNotIn applies the NotIn predicate on the "last_read_message_id" field.
+func LastReadMessageIDNotIn(vs ...uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDIsNil applies the IsNil predicate on the "last_read_message_id" field.
+func LastReadMessageIDIsNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIsNull(FieldLastReadMessageID))
+}
+
+// LastReadMessageIDNotNil applies the NotNil predicate on the "last_read_message_id" field.
+func LastReadMessageIDNotNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotNull(FieldLastReadMessageID))
+}
+
+// LastDeliveredMessageIDEQ applies the EQ predicate on the "last_delivered_message_id" field.
+func LastDeliveredMessageIDEQ(v uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldLastDeliveredMessageID, v))
+}
+
+// LastDeliveredMessageIDNEQ applies the NEQ predicate on the "last_delivered_message_id" field.
+func LastDeliveredMessageIDNEQ(v uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldLastDeliveredMessageID, v))
+}
+
+// LastDeliveredMessageIDIn applies the In predicate on the "last_delivered_message_id" field.
+func LastDeliveredMessageIDIn(vs ...uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIn(FieldLastDeliveredMessageID, vs...))
+}
+
+// LastDeliveredMessageIDNotIn applies the NotIn predicate on the "last_delivered_message_id" field.
+func LastDeliveredMessageIDNotIn(vs ...uuid.UUID) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotIn(FieldLastDeliveredMessageID, vs...))
+}
+
+// LastDeliveredMessageIDIsNil applies the IsNil predicate on the "last_delivered_message_id" field.
+func LastDeliveredMessageIDIsNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIsNull(FieldLastDeliveredMessageID))
+}
+
+// LastDeliveredMessageIDNotNil applies the NotNil predicate on the "last_delivered_message_id" field.
+func LastDeliveredMessageIDNotNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotNull(FieldLastDeliveredMessageID))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// KeepArchivedEQ applies the EQ predicate on the "keep_archived" field.
+func KeepArchivedEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldKeepArchived, v))
+}
+
+// KeepArchivedNEQ applies the NEQ predicate on the "keep_archived" field.
+func KeepArchivedNEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldKeepArchived, v))
+}
+
+// MutedEQ applies the EQ predicate on the "muted" field.
+func MutedEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldMuted, v))
+}
+
+// MutedNEQ applies the NEQ predicate on the "muted" field.
+func MutedNEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldMuted, v))
+}
+
+// MutedUntilEQ applies the EQ predicate on the "muted_until" field.
+func MutedUntilEQ(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilNEQ applies the NEQ predicate on the "muted_until" field.
+func MutedUntilNEQ(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilIn applies the In predicate on the "muted_until" field.
+func MutedUntilIn(vs ...time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilNotIn applies the NotIn predicate on the "muted_until" field.
+func MutedUntilNotIn(vs ...time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilGT applies the GT predicate on the "muted_until" field.
+func MutedUntilGT(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldGT(FieldMutedUntil, v))
+}
+
+// MutedUntilGTE applies the GTE predicate on the "muted_until" field.
+func MutedUntilGTE(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldGTE(FieldMutedUntil, v))
+}
+
+// MutedUntilLT applies the LT predicate on the "muted_until" field.
+func MutedUntilLT(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldLT(FieldMutedUntil, v))
+}
+
+// MutedUntilLTE applies the LTE predicate on the "muted_until" field.
+func MutedUntilLTE(v time.Time) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldLTE(FieldMutedUntil, v))
+}
+
+// MutedUntilIsNil applies the IsNil predicate on the "muted_until" field.
+func MutedUntilIsNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldIsNull(FieldMutedUntil))
+}
+
+// MutedUntilNotNil applies the NotNil predicate on the "muted_until" field.
+func MutedUntilNotNil() predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNotNull(FieldMutedUntil))
+}
+
+// NotifyMentionsEQ applies the EQ predicate on the "notify_mentions" field.
+func NotifyMentionsEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldEQ(FieldNotifyMentions, v))
+}
+
+// NotifyMentionsNEQ applies the NEQ predicate on the "notify_mentions" field.
+func NotifyMentionsNEQ(v bool) predicate.GroupMember {
+	return predicate.GroupMember(sql.FieldNEQ(FieldNotifyMentions, v))
+}
+
 // HasGroupChat applies the HasEdge predicate on the "group_chat" edge.
 func HasGroupChat() predicate.GroupMember {
 	return predicate.GroupMember(func(s *sql.Selector) {
@@ -317,6 +602,52 @@ func HasUserWith(preds ...predicate.User) predicate.GroupMember {
 	})
 }
 
+// HasLastReadMessage applies the HasEdge predicate on the "last_read_message" edge.
+func HasLastReadMessage() predicate.GroupMember {
+	return predicate.GroupMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LastReadMessageTable, LastReadMessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLastReadMessageWith applies the HasEdge predicate on the "last_read_message" edge with a given conditions (other predicates).
+func HasLastReadMessageWith(preds ...predicate.Message) predicate.GroupMember {
+	return predicate.GroupMember(func(s *sql.Selector) {
+		step := newLastReadMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLastDeliveredMessage applies the HasEdge predicate on the "last_delivered_message" edge.
+func HasLastDeliveredMessage() predicate.GroupMember {
+	return predicate.GroupMember(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LastDeliveredMessageTable, LastDeliveredMessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLastDeliveredMessageWith applies the HasEdge predicate on the "last_delivered_message" edge with a given conditions (other predicates).
+func HasLastDeliveredMessageWith(preds ...predicate.Message) predicate.GroupMember {
+	return predicate.GroupMember(func(s *sql.Selector) {
+		step := newLastDeliveredMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.GroupMember) predicate.GroupMember {
 	return predicate.GroupMember(sql.AndPredicates(predicates...))
@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"errors"
@@ -94,6 +95,132 @@ func (_c *GroupMemberCreate) SetNillableUnreadCount(v *int) *GroupMemberCreate {
 	return _c
 }
 
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (_c *GroupMemberCreate) SetUnreadMentionCount(v int) *GroupMemberCreate {
+	_c.mutation.SetUnreadMentionCount(v)
+	return _c
+}
+
+// SetNillableUnreadMentionCount sets the "unread_mention_count" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableUnreadMentionCount(v *int) *GroupMemberCreate {
+	if v != nil {
+		_c.SetUnreadMentionCount(*v)
+	}
+	return _c
+}
+
+// SetMarkedUnread sets the "marked_unread" field.
+func (_c *GroupMemberCreate) SetMarkedUnread(v bool) *GroupMemberCreate {
+	_c.mutation.SetMarkedUnread(v)
+	return _c
+}
+
+// SetNillableMarkedUnread sets the "marked_unread" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableMarkedUnread(v *bool) *GroupMemberCreate {
+	if v != nil {
+		_c.SetMarkedUnread(*v)
+	}
+	return _c
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_c *GroupMemberCreate) SetLastReadMessageID(v uuid.UUID) *GroupMemberCreate {
+	_c.mutation.SetLastReadMessageID(v)
+	return _c
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableLastReadMessageID(v *uuid.UUID) *GroupMemberCreate {
+	if v != nil {
+		_c.SetLastReadMessageID(*v)
+	}
+	return _c
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (_c *GroupMemberCreate) SetLastDeliveredMessageID(v uuid.UUID) *GroupMemberCreate {
+	_c.mutation.SetLastDeliveredMessageID(v)
+	return _c
+}
+
+// SetNillableLastDeliveredMessageID sets the "last_delivered_message_id" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableLastDeliveredMessageID(v *uuid.UUID) *GroupMemberCreate {
+	if v != nil {
+		_c.SetLastDeliveredMessageID(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *GroupMemberCreate) SetArchivedAt(v time.Time) *GroupMemberCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableArchivedAt(v *time.Time) *GroupMemberCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetKeepArchived sets the "keep_archived" field.
+func (_c *GroupMemberCreate) SetKeepArchived(v bool) *GroupMemberCreate {
+	_c.mutation.SetKeepArchived(v)
+	return _c
+}
+
+// SetNillableKeepArchived sets the "keep_archived" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableKeepArchived(v *bool) *GroupMemberCreate {
+	if v != nil {
+		_c.SetKeepArchived(*v)
+	}
+	return _c
+}
+
+// SetMuted sets the "muted" field.
+func (_c *GroupMemberCreate) SetMuted(v bool) *GroupMemberCreate {
+	_c.mutation.SetMuted(v)
+	return _c
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableMuted(v *bool) *GroupMemberCreate {
+	if v != nil {
+		_c.SetMuted(*v)
+	}
+	return _c
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (_c *GroupMemberCreate) SetMutedUntil(v time.Time) *GroupMemberCreate {
+	_c.mutation.SetMutedUntil(v)
+	return _c
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableMutedUntil(v *time.Time) *GroupMemberCreate {
+	if v != nil {
+		_c.SetMutedUntil(*v)
+	}
+	return _c
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (_c *GroupMemberCreate) SetNotifyMentions(v bool) *GroupMemberCreate {
+	_c.mutation.SetNotifyMentions(v)
+	return _c
+}
+
+// SetNillableNotifyMentions sets the "notify_mentions" field if the given value is not nil.
+func (_c *GroupMemberCreate) SetNillableNotifyMentions(v *bool) *GroupMemberCreate {
+	if v != nil {
+		_c.SetNotifyMentions(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *GroupMemberCreate) SetID(v uuid.UUID) *GroupMemberCreate {
 	_c.mutation.SetID(v)
@@ -118,6 +245,16 @@ func (_c *GroupMemberCreate) SetUser(v *User) *GroupMemberCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetLastReadMessage sets the "last_read_message" edge to the Message entity.
+func (_c *GroupMemberCreate) SetLastReadMessage(v *Message) *GroupMemberCreate {
+	return _c.SetLastReadMessageID(v.ID)
+}
+
+// SetLastDeliveredMessage sets the "last_delivered_message" edge to the Message entity.
+func (_c *GroupMemberCreate) SetLastDeliveredMessage(v *Message) *GroupMemberCreate {
+	return _c.SetLastDeliveredMessageID(v.ID)
+}
+
 // Mutation returns the GroupMemberMutation object of the builder.
 func (_c *GroupMemberCreate) Mutation() *GroupMemberMutation {
 	return _c.mutation
@@ -165,6 +302,26 @@ func (_c *GroupMemberCreate) defaults() {
 		v := groupmember.DefaultUnreadCount
 		_c.mutation.SetUnreadCount(v)
 	}
+	if _, ok := _c.mutation.UnreadMentionCount(); !ok {
+		v := groupmember.DefaultUnreadMentionCount
+		_c.mutation.SetUnreadMentionCount(v)
+	}
+	if _, ok := _c.mutation.MarkedUnread(); !ok {
+		v := groupmember.DefaultMarkedUnread
+		_c.mutation.SetMarkedUnread(v)
+	}
+	if _, ok := _c.mutation.KeepArchived(); !ok {
+		v := groupmember.DefaultKeepArchived
+		_c.mutation.SetKeepArchived(v)
+	}
+	if _, ok := _c.mutation.Muted(); !ok {
+		v := groupmember.DefaultMuted
+		_c.mutation.SetMuted(v)
+	}
+	if _, ok := _c.mutation.NotifyMentions(); !ok {
+		v := groupmember.DefaultNotifyMentions
+		_c.mutation.SetNotifyMentions(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := groupmember.DefaultID()
 		_c.mutation.SetID(v)
@@ -193,6 +350,21 @@ func (_c *GroupMemberCreate) check() error {
 	if _, ok := _c.mutation.UnreadCount(); !ok {
 		return &ValidationError{Name: "unread_count", err: errors.New(`ent: missing required field "GroupMember.unread_count"`)}
 	}
+	if _, ok := _c.mutation.UnreadMentionCount(); !ok {
+		return &ValidationError{Name: "unread_mention_count", err: errors.New(`ent: missing required field "GroupMember.unread_mention_count"`)}
+	}
+	if _, ok := _c.mutation.MarkedUnread(); !ok {
+		return &ValidationError{Name: "marked_unread", err: errors.New(`ent: missing required field "GroupMember.marked_unread"`)}
+	}
+	if _, ok := _c.mutation.KeepArchived(); !ok {
+		return &ValidationError{Name: "keep_archived", err: errors.New(`ent: missing required field "GroupMember.keep_archived"`)}
+	}
+	if _, ok := _c.mutation.Muted(); !ok {
+		return &ValidationError{Name: "muted", err: errors.New(`ent: missing required field "GroupMember.muted"`)}
+	}
+	if _, ok := _c.mutation.NotifyMentions(); !ok {
+		return &ValidationError{Name: "notify_mentions", err: errors.New(`ent: missing required field "GroupMember.notify_mentions"`)}
+	}
 	if len(_c.mutation.GroupChatIDs()) == 0 {
 		return &ValidationError{Name: "group_chat", err: errors.New(`ent: missing required edge "GroupMember.group_chat"`)}
 	}
@@ -251,6 +423,34 @@ func (_c *GroupMemberCreate) createSpec() (*GroupMember, *sqlgraph.CreateSpec) {
 		_spec.SetField(groupmember.FieldUnreadCount, field.TypeInt, value)
 		_node.UnreadCount = value
 	}
+	if value, ok := _c.mutation.UnreadMentionCount(); ok {
+		_spec.SetField(groupmember.FieldUnreadMentionCount, field.TypeInt, value)
+		_node.UnreadMentionCount = value
+	}
+	if value, ok := _c.mutation.MarkedUnread(); ok {
+		_spec.SetField(groupmember.FieldMarkedUnread, field.TypeBool, value)
+		_node.MarkedUnread = value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(groupmember.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.KeepArchived(); ok {
+		_spec.SetField(groupmember.FieldKeepArchived, field.TypeBool, value)
+		_node.KeepArchived = value
+	}
+	if value, ok := _c.mutation.Muted(); ok {
+		_spec.SetField(groupmember.FieldMuted, field.TypeBool, value)
+		_node.Muted = value
+	}
+	if value, ok := _c.mutation.MutedUntil(); ok {
+		_spec.SetField(groupmember.FieldMutedUntil, field.TypeTime, value)
+		_node.MutedUntil = &value
+	}
+	if value, ok := _c.mutation.NotifyMentions(); ok {
+		_spec.SetField(groupmember.FieldNotifyMentions, field.TypeBool, value)
+		_node.NotifyMentions = value
+	}
 	if nodes := _c.mutation.GroupChatIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -285,6 +485,40 @@ func (_c *GroupMemberCreate) createSpec() (*GroupMember, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LastReadMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastReadMessageTable,
+			Columns: []string{groupmember.LastReadMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LastReadMessageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LastDeliveredMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastDeliveredMessageTable,
+			Columns: []string{groupmember.LastDeliveredMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LastDeliveredMessageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -409,6 +643,144 @@ func (u *GroupMemberUpsert) AddUnreadCount(v int) *GroupMemberUpsert {
 	return u
 }
 
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (u *GroupMemberUpsert) SetUnreadMentionCount(v int) *GroupMemberUpsert {
+	u.Set(groupmember.FieldUnreadMentionCount, v)
+	return u
+}
+
+// UpdateUnreadMentionCount sets the "unread_mention_count" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateUnreadMentionCount() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldUnreadMentionCount)
+	return u
+}
+
+// AddUnreadMentionCount adds v to the "unread_mention_count" field.
+func (u *GroupMemberUpsert) AddUnreadMentionCount(v int) *GroupMemberUpsert {
+	u.Add(groupmember.FieldUnreadMentionCount, v)
+	return u
+}
+
+// SetMarkedUnread sets the "marked_unread" field.
+func (u *GroupMemberUpsert) SetMarkedUnread(v bool) *GroupMemberUpsert {
+	u.Set(groupmember.FieldMarkedUnread, v)
+	return u
+}
+
+// UpdateMarkedUnread sets the "marked_unread" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateMarkedUnread() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldMarkedUnread)
+	return u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (u *GroupMemberUpsert) SetLastReadMessageID(v uuid.UUID) *GroupMemberUpsert {
+	u.Set(groupmember.FieldLastReadMessageID, v)
+	return u
+}
+
+// UpdateLastReadMessageID sets the "last_read_message_id" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateLastReadMessageID() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldLastReadMessageID)
+	return u
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (u *GroupMemberUpsert) ClearLastReadMessageID() *GroupMemberUpsert {
+	u.SetNull(groupmember.FieldLastReadMessageID)
+	return u
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (u *GroupMemberUpsert) SetLastDeliveredMessageID(v uuid.UUID) *GroupMemberUpsert {
+	u.Set(groupmember.FieldLastDeliveredMessageID, v)
+	return u
+}
+
+// UpdateLastDeliveredMessageID sets the "last_delivered_message_id" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateLastDeliveredMessageID() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldLastDeliveredMessageID)
+	return u
+}
+
+// ClearLastDeliveredMessageID clears the value of the "last_delivered_message_id" field.
+func (u *GroupMemberUpsert) ClearLastDeliveredMessageID() *GroupMemberUpsert {
+	u.SetNull(groupmember.FieldLastDeliveredMessageID)
+	return u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *GroupMemberUpsert) SetArchivedAt(v time.Time) *GroupMemberUpsert {
+	u.Set(groupmember.FieldArchivedAt, v)
+	return u
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateArchivedAt() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldArchivedAt)
+	return u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *GroupMemberUpsert) ClearArchivedAt() *GroupMemberUpsert {
+	u.SetNull(groupmember.FieldArchivedAt)
+	return u
+}
+
+// SetKeepArchived sets the "keep_archived" field.
+func (u *GroupMemberUpsert) SetKeepArchived(v bool) *GroupMemberUpsert {
+	u.Set(groupmember.FieldKeepArchived, v)
+	return u
+}
+
+// UpdateKeepArchived sets the "keep_archived" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateKeepArchived() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldKeepArchived)
+	return u
+}
+
+// SetMuted sets the "muted" field.
+func (u *GroupMemberUpsert) SetMuted(v bool) *GroupMemberUpsert {
+	u.Set(groupmember.FieldMuted, v)
+	return u
+}
+
+// UpdateMuted sets the "muted" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateMuted() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldMuted)
+	return u
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (u *GroupMemberUpsert) SetMutedUntil(v time.Time) *GroupMemberUpsert {
+	u.Set(groupmember.FieldMutedUntil, v)
+	return u
+}
+
+// UpdateMutedUntil sets the "muted_until" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateMutedUntil() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldMutedUntil)
+	return u
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (u *GroupMemberUpsert) ClearMutedUntil() *GroupMemberUpsert {
+	u.SetNull(groupmember.FieldMutedUntil)
+	return u
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (u *GroupMemberUpsert) SetNotifyMentions(v bool) *GroupMemberUpsert {
+	u.Set(groupmember.FieldNotifyMentions, v)
+	return u
+}
+
+// UpdateNotifyMentions sets the "notify_mentions" field to the value that was provided on create.
+func (u *GroupMemberUpsert) UpdateNotifyMentions() *GroupMemberUpsert {
+	u.SetExcluded(groupmember.FieldNotifyMentions)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -544,6 +916,167 @@ func (u *GroupMemberUpsertOne) UpdateUnreadCount() *GroupMemberUpsertOne {
 	})
 }
 
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (u *GroupMemberUpsertOne) SetUnreadMentionCount(v int) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetUnreadMentionCount(v)
+	})
+}
+
+// AddUnreadMentionCount adds v to the "unread_mention_count" field.
+func (u *GroupMemberUpsertOne) AddUnreadMentionCount(v int) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.AddUnreadMentionCount(v)
+	})
+}
+
+// UpdateUnreadMentionCount sets the "unread_mention_count" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateUnreadMentionCount() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateUnreadMentionCount()
+	})
+}
+
+// SetMarkedUnread sets the "marked_unread" field.
+func (u *GroupMemberUpsertOne) SetMarkedUnread(v bool) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetMarkedUnread(v)
+	})
+}
+
+// UpdateMarkedUnread sets the "marked_unread" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateMarkedUnread() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateMarkedUnread()
+	})
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (u *GroupMemberUpsertOne) SetLastReadMessageID(v uuid.UUID) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetLastReadMessageID(v)
+	})
+}
+
+// UpdateLastReadMessageID sets the "last_read_message_id" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateLastReadMessageID() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateLastReadMessageID()
+	})
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (u *GroupMemberUpsertOne) ClearLastReadMessageID() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearLastReadMessageID()
+	})
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (u *GroupMemberUpsertOne) SetLastDeliveredMessageID(v uuid.UUID) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetLastDeliveredMessageID(v)
+	})
+}
+
+// UpdateLastDeliveredMessageID sets the "last_delivered_message_id" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateLastDeliveredMessageID() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateLastDeliveredMessageID()
+	})
+}
+
+// ClearLastDeliveredMessageID clears the value of the "last_delivered_message_id" field.
+func (u *GroupMemberUpsertOne) ClearLastDeliveredMessageID() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearLastDeliveredMessageID()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *GroupMemberUpsertOne) SetArchivedAt(v time.Time) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateArchivedAt() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *GroupMemberUpsertOne) ClearArchivedAt() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetKeepArchived sets the "keep_archived" field.
+func (u *GroupMemberUpsertOne) SetKeepArchived(v bool) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetKeepArchived(v)
+	})
+}
+
+// UpdateKeepArchived sets the "keep_archived" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateKeepArchived() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateKeepArchived()
+	})
+}
+
+// SetMuted sets the "muted" field.
+func (u *GroupMemberUpsertOne) SetMuted(v bool) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetMuted(v)
+	})
+}
+
+// UpdateMuted sets the "muted" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateMuted() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateMuted()
+	})
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (u *GroupMemberUpsertOne) SetMutedUntil(v time.Time) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetMutedUntil(v)
+	})
+}
+
+// UpdateMutedUntil sets the "muted_until" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateMutedUntil() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateMutedUntil()
+	})
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (u *GroupMemberUpsertOne) ClearMutedUntil() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearMutedUntil()
+	})
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (u *GroupMemberUpsertOne) SetNotifyMentions(v bool) *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetNotifyMentions(v)
+	})
+}
+
+// UpdateNotifyMentions sets the "notify_mentions" field to the value that was provided on create.
+func (u *GroupMemberUpsertOne) UpdateNotifyMentions() *GroupMemberUpsertOne {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateNotifyMentions()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupMemberUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -846,6 +1379,167 @@ func (u *GroupMemberUpsertBulk) UpdateUnreadCount() *GroupMemberUpsertBulk {
 	})
 }
 
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (u *GroupMemberUpsertBulk) SetUnreadMentionCount(v int) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetUnreadMentionCount(v)
+	})
+}
+
+// AddUnreadMentionCount adds v to the "unread_mention_count" field.
+func (u *GroupMemberUpsertBulk) AddUnreadMentionCount(v int) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.AddUnreadMentionCount(v)
+	})
+}
+
+// UpdateUnreadMentionCount sets the "unread_mention_count" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateUnreadMentionCount() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateUnreadMentionCount()
+	})
+}
+
+// SetMarkedUnread sets the "marked_unread" field.
+func (u *GroupMemberUpsertBulk) SetMarkedUnread(v bool) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetMarkedUnread(v)
+	})
+}
+
+// UpdateMarkedUnread sets the "marked_unread" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateMarkedUnread() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateMarkedUnread()
+	})
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (u *GroupMemberUpsertBulk) SetLastReadMessageID(v uuid.UUID) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetLastReadMessageID(v)
+	})
+}
+
+// UpdateLastReadMessageID sets the "last_read_message_id" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateLastReadMessageID() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateLastReadMessageID()
+	})
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (u *GroupMemberUpsertBulk) ClearLastReadMessageID() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearLastReadMessageID()
+	})
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (u *GroupMemberUpsertBulk) SetLastDeliveredMessageID(v uuid.UUID) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetLastDeliveredMessageID(v)
+	})
+}
+
+// UpdateLastDeliveredMessageID sets the "last_delivered_message_id" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateLastDeliveredMessageID() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateLastDeliveredMessageID()
+	})
+}
+
+// ClearLastDeliveredMessageID clears the value of the "last_delivered_message_id" field.
+func (u *GroupMemberUpsertBulk) ClearLastDeliveredMessageID() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearLastDeliveredMessageID()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *GroupMemberUpsertBulk) SetArchivedAt(v time.Time) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateArchivedAt() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *GroupMemberUpsertBulk) ClearArchivedAt() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetKeepArchived sets the "keep_archived" field.
+func (u *GroupMemberUpsertBulk) SetKeepArchived(v bool) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetKeepArchived(v)
+	})
+}
+
+// UpdateKeepArchived sets the "keep_archived" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateKeepArchived() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateKeepArchived()
+	})
+}
+
+// SetMuted sets the "muted" field.
+func (u *GroupMemberUpsertBulk) SetMuted(v bool) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetMuted(v)
+	})
+}
+
+// UpdateMuted sets the "muted" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateMuted() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateMuted()
+	})
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (u *GroupMemberUpsertBulk) SetMutedUntil(v time.Time) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetMutedUntil(v)
+	})
+}
+
+// UpdateMutedUntil sets the "muted_until" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateMutedUntil() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateMutedUntil()
+	})
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (u *GroupMemberUpsertBulk) ClearMutedUntil() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.ClearMutedUntil()
+	})
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (u *GroupMemberUpsertBulk) SetNotifyMentions(v bool) *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.SetNotifyMentions(v)
+	})
+}
+
+// UpdateNotifyMentions sets the "notify_mentions" field to the value that was provided on create.
+func (u *GroupMemberUpsertBulk) UpdateNotifyMentions() *GroupMemberUpsertBulk {
+	return u.Update(func(s *GroupMemberUpsert) {
+		s.UpdateNotifyMentions()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupMemberUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
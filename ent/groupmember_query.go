@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/user"
 	"context"
@@ -22,13 +23,15 @@ import (
 // GroupMemberQuery is the builder for querying GroupMember entities.
 type GroupMemberQuery struct {
 	config
-	ctx           *QueryContext
-	order         []groupmember.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.GroupMember
-	withGroupChat *GroupChatQuery
-	withUser      *UserQuery
-	modifiers     []func(*sql.Selector)
+	ctx                      *QueryContext
+	order                    []groupmember.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.GroupMember
+	withGroupChat            *GroupChatQuery
+	withUser                 *UserQuery
+	withLastReadMessage      *MessageQuery
+	withLastDeliveredMessage *MessageQuery
+	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +112,50 @@ func (_q *GroupMemberQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryLastReadMessage chains the current query on the "last_read_message" edge.
+func (_q *GroupMemberQuery) QueryLastReadMessage() *MessageQuery {
+	query := (&MessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmember.Table, groupmember.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmember.LastReadMessageTable, groupmember.LastReadMessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLastDeliveredMessage chains the current query on the "last_delivered_message" edge.
+func (_q *GroupMemberQuery) QueryLastDeliveredMessage() *MessageQuery {
+	query := (&MessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(groupmember.Table, groupmember.FieldID, selector),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, groupmember.LastDeliveredMessageTable, groupmember.LastDeliveredMessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first GroupMember entity from the query.
 // Returns a *NotFoundError when no GroupMember was found.
 func (_q *GroupMemberQuery) First(ctx context.Context) (*GroupMember, error) {
@@ -296,13 +343,15 @@ func (_q *GroupMemberQuery) Clone() *GroupMemberQuery {
 		return nil
 	}
 	return &GroupMemberQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]groupmember.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.GroupMember{}, _q.predicates...),
-		withGroupChat: _q.withGroupChat.Clone(),
-		withUser:      _q.withUser.Clone(),
+		config:                   _q.config,
+		ctx:                      _q.ctx.Clone(),
+		order:                    append([]groupmember.OrderOption{}, _q.order...),
+		inters:                   append([]Interceptor{}, _q.inters...),
+		predicates:               append([]predicate.GroupMember{}, _q.predicates...),
+		withGroupChat:            _q.withGroupChat.Clone(),
+		withUser:                 _q.withUser.Clone(),
+		withLastReadMessage:      _q.withLastReadMessage.Clone(),
+		withLastDeliveredMessage: _q.withLastDeliveredMessage.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -332,6 +381,28 @@ func (_q *GroupMemberQuery) WithUser(opts ...func(*UserQuery)) *GroupMemberQuery
 	return _q
 }
 
+// WithLastReadMessage tells the query-builder to eager-load the nodes that are connected to
+// the "last_read_message" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupMemberQuery) WithLastReadMessage(opts ...func(*MessageQuery)) *GroupMemberQuery {
+	query := (&MessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLastReadMessage = query
+	return _q
+}
+
+// WithLastDeliveredMessage tells the query-builder to eager-load the nodes that are connected to
+// the "last_delivered_message" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *GroupMemberQuery) WithLastDeliveredMessage(opts ...func(*MessageQuery)) *GroupMemberQuery {
+	query := (&MessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLastDeliveredMessage = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +481,11 @@ func (_q *GroupMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*GroupMember{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withGroupChat != nil,
 			_q.withUser != nil,
+			_q.withLastReadMessage != nil,
+			_q.withLastDeliveredMessage != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -448,6 +521,18 @@ func (_q *GroupMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withLastReadMessage; query != nil {
+		if err := _q.loadLastReadMessage(ctx, query, nodes, nil,
+			func(n *GroupMember, e *Message) { n.Edges.LastReadMessage = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLastDeliveredMessage; query != nil {
+		if err := _q.loadLastDeliveredMessage(ctx, query, nodes, nil,
+			func(n *GroupMember, e *Message) { n.Edges.LastDeliveredMessage = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -509,6 +594,70 @@ func (_q *GroupMemberQuery) loadUser(ctx context.Context, query *UserQuery, node
 	}
 	return nil
 }
+func (_q *GroupMemberQuery) loadLastReadMessage(ctx context.Context, query *MessageQuery, nodes []*GroupMember, init func(*GroupMember), assign func(*GroupMember, *Message)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*GroupMember)
+	for i := range nodes {
+		if nodes[i].LastReadMessageID == nil {
+			continue
+		}
+		fk := *nodes[i].LastReadMessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "last_read_message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *GroupMemberQuery) loadLastDeliveredMessage(ctx context.Context, query *MessageQuery, nodes []*GroupMember, init func(*GroupMember), assign func(*GroupMember, *Message)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*GroupMember)
+	for i := range nodes {
+		if nodes[i].LastDeliveredMessageID == nil {
+			continue
+		}
+		fk := *nodes[i].LastDeliveredMessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(message.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "last_delivered_message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *GroupMemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -544,6 +693,12 @@ func (_q *GroupMemberQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withUser != nil {
 			_spec.Node.AddColumnOnce(groupmember.FieldUserID)
 		}
+		if _q.withLastReadMessage != nil {
+			_spec.Node.AddColumnOnce(groupmember.FieldLastReadMessageID)
+		}
+		if _q.withLastDeliveredMessage != nil {
+			_spec.Node.AddColumnOnce(groupmember.FieldLastDeliveredMessageID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -5,6 +5,7 @@ package ent
 import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/user"
 	"context"
@@ -115,6 +116,163 @@ func (_u *GroupMemberUpdate) AddUnreadCount(v int) *GroupMemberUpdate {
 	return _u
 }
 
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (_u *GroupMemberUpdate) SetUnreadMentionCount(v int) *GroupMemberUpdate {
+	_u.mutation.ResetUnreadMentionCount()
+	_u.mutation.SetUnreadMentionCount(v)
+	return _u
+}
+
+// SetNillableUnreadMentionCount sets the "unread_mention_count" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableUnreadMentionCount(v *int) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetUnreadMentionCount(*v)
+	}
+	return _u
+}
+
+// AddUnreadMentionCount adds value to the "unread_mention_count" field.
+func (_u *GroupMemberUpdate) AddUnreadMentionCount(v int) *GroupMemberUpdate {
+	_u.mutation.AddUnreadMentionCount(v)
+	return _u
+}
+
+// SetMarkedUnread sets the "marked_unread" field.
+func (_u *GroupMemberUpdate) SetMarkedUnread(v bool) *GroupMemberUpdate {
+	_u.mutation.SetMarkedUnread(v)
+	return _u
+}
+
+// SetNillableMarkedUnread sets the "marked_unread" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableMarkedUnread(v *bool) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetMarkedUnread(*v)
+	}
+	return _u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_u *GroupMemberUpdate) SetLastReadMessageID(v uuid.UUID) *GroupMemberUpdate {
+	_u.mutation.SetLastReadMessageID(v)
+	return _u
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableLastReadMessageID(v *uuid.UUID) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetLastReadMessageID(*v)
+	}
+	return _u
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (_u *GroupMemberUpdate) ClearLastReadMessageID() *GroupMemberUpdate {
+	_u.mutation.ClearLastReadMessageID()
+	return _u
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (_u *GroupMemberUpdate) SetLastDeliveredMessageID(v uuid.UUID) *GroupMemberUpdate {
+	_u.mutation.SetLastDeliveredMessageID(v)
+	return _u
+}
+
+// SetNillableLastDeliveredMessageID sets the "last_delivered_message_id" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableLastDeliveredMessageID(v *uuid.UUID) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetLastDeliveredMessageID(*v)
+	}
+	return _u
+}
+
+// ClearLastDeliveredMessageID clears the value of the "last_delivered_message_id" field.
+func (_u *GroupMemberUpdate) ClearLastDeliveredMessageID() *GroupMemberUpdate {
+	_u.mutation.ClearLastDeliveredMessageID()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *GroupMemberUpdate) SetArchivedAt(v time.Time) *GroupMemberUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableArchivedAt(v *time.Time) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *GroupMemberUpdate) ClearArchivedAt() *GroupMemberUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetKeepArchived sets the "keep_archived" field.
+func (_u *GroupMemberUpdate) SetKeepArchived(v bool) *GroupMemberUpdate {
+	_u.mutation.SetKeepArchived(v)
+	return _u
+}
+
+// SetNillableKeepArchived sets the "keep_archived" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableKeepArchived(v *bool) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetKeepArchived(*v)
+	}
+	return _u
+}
+
+// SetMuted sets the "muted" field.
+func (_u *GroupMemberUpdate) SetMuted(v bool) *GroupMemberUpdate {
+	_u.mutation.SetMuted(v)
+	return _u
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableMuted(v *bool) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetMuted(*v)
+	}
+	return _u
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (_u *GroupMemberUpdate) SetMutedUntil(v time.Time) *GroupMemberUpdate {
+	_u.mutation.SetMutedUntil(v)
+	return _u
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableMutedUntil(v *time.Time) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetMutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (_u *GroupMemberUpdate) ClearMutedUntil() *GroupMemberUpdate {
+	_u.mutation.ClearMutedUntil()
+	return _u
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (_u *GroupMemberUpdate) SetNotifyMentions(v bool) *GroupMemberUpdate {
+	_u.mutation.SetNotifyMentions(v)
+	return _u
+}
+
+// SetNillableNotifyMentions sets the "notify_mentions" field if the given value is not nil.
+func (_u *GroupMemberUpdate) SetNillableNotifyMentions(v *bool) *GroupMemberUpdate {
+	if v != nil {
+		_u.SetNotifyMentions(*v)
+	}
+	return _u
+}
+
 // SetGroupChat sets the "group_chat" edge to the GroupChat entity.
 func (_u *GroupMemberUpdate) SetGroupChat(v *GroupChat) *GroupMemberUpdate {
 	return _u.SetGroupChatID(v.ID)
@@ -125,6 +283,16 @@ func (_u *GroupMemberUpdate) SetUser(v *User) *GroupMemberUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetLastReadMessage sets the "last_read_message" edge to the Message entity.
+func (_u *GroupMemberUpdate) SetLastReadMessage(v *Message) *GroupMemberUpdate {
+	return _u.SetLastReadMessageID(v.ID)
+}
+
+// SetLastDeliveredMessage sets the "last_delivered_message" edge to the Message entity.
+func (_u *GroupMemberUpdate) SetLastDeliveredMessage(v *Message) *GroupMemberUpdate {
+	return _u.SetLastDeliveredMessageID(v.ID)
+}
+
 // Mutation returns the GroupMemberMutation object of the builder.
 func (_u *GroupMemberUpdate) Mutation() *GroupMemberMutation {
 	return _u.mutation
@@ -142,6 +310,18 @@ func (_u *GroupMemberUpdate) ClearUser() *GroupMemberUpdate {
 	return _u
 }
 
+// ClearLastReadMessage clears the "last_read_message" edge to the Message entity.
+func (_u *GroupMemberUpdate) ClearLastReadMessage() *GroupMemberUpdate {
+	_u.mutation.ClearLastReadMessage()
+	return _u
+}
+
+// ClearLastDeliveredMessage clears the "last_delivered_message" edge to the Message entity.
+func (_u *GroupMemberUpdate) ClearLastDeliveredMessage() *GroupMemberUpdate {
+	_u.mutation.ClearLastDeliveredMessage()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *GroupMemberUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -218,6 +398,36 @@ func (_u *GroupMemberUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedUnreadCount(); ok {
 		_spec.AddField(groupmember.FieldUnreadCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.UnreadMentionCount(); ok {
+		_spec.SetField(groupmember.FieldUnreadMentionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUnreadMentionCount(); ok {
+		_spec.AddField(groupmember.FieldUnreadMentionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MarkedUnread(); ok {
+		_spec.SetField(groupmember.FieldMarkedUnread, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(groupmember.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(groupmember.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.KeepArchived(); ok {
+		_spec.SetField(groupmember.FieldKeepArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Muted(); ok {
+		_spec.SetField(groupmember.FieldMuted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MutedUntil(); ok {
+		_spec.SetField(groupmember.FieldMutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.MutedUntilCleared() {
+		_spec.ClearField(groupmember.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NotifyMentions(); ok {
+		_spec.SetField(groupmember.FieldNotifyMentions, field.TypeBool, value)
+	}
 	if _u.mutation.GroupChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -276,6 +486,64 @@ func (_u *GroupMemberUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LastReadMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastReadMessageTable,
+			Columns: []string{groupmember.LastReadMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LastReadMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastReadMessageTable,
+			Columns: []string{groupmember.LastReadMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LastDeliveredMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastDeliveredMessageTable,
+			Columns: []string{groupmember.LastDeliveredMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LastDeliveredMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastDeliveredMessageTable,
+			Columns: []string{groupmember.LastDeliveredMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -381,6 +649,163 @@ func (_u *GroupMemberUpdateOne) AddUnreadCount(v int) *GroupMemberUpdateOne {
 	return _u
 }
 
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (_u *GroupMemberUpdateOne) SetUnreadMentionCount(v int) *GroupMemberUpdateOne {
+	_u.mutation.ResetUnreadMentionCount()
+	_u.mutation.SetUnreadMentionCount(v)
+	return _u
+}
+
+// SetNillableUnreadMentionCount sets the "unread_mention_count" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableUnreadMentionCount(v *int) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetUnreadMentionCount(*v)
+	}
+	return _u
+}
+
+// AddUnreadMentionCount adds value to the "unread_mention_count" field.
+func (_u *GroupMemberUpdateOne) AddUnreadMentionCount(v int) *GroupMemberUpdateOne {
+	_u.mutation.AddUnreadMentionCount(v)
+	return _u
+}
+
+// SetMarkedUnread sets the "marked_unread" field.
+func (_u *GroupMemberUpdateOne) SetMarkedUnread(v bool) *GroupMemberUpdateOne {
+	_u.mutation.SetMarkedUnread(v)
+	return _u
+}
+
+// SetNillableMarkedUnread sets the "marked_unread" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableMarkedUnread(v *bool) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetMarkedUnread(*v)
+	}
+	return _u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_u *GroupMemberUpdateOne) SetLastReadMessageID(v uuid.UUID) *GroupMemberUpdateOne {
+	_u.mutation.SetLastReadMessageID(v)
+	return _u
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableLastReadMessageID(v *uuid.UUID) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetLastReadMessageID(*v)
+	}
+	return _u
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (_u *GroupMemberUpdateOne) ClearLastReadMessageID() *GroupMemberUpdateOne {
+	_u.mutation.ClearLastReadMessageID()
+	return _u
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (_u *GroupMemberUpdateOne) SetLastDeliveredMessageID(v uuid.UUID) *GroupMemberUpdateOne {
+	_u.mutation.SetLastDeliveredMessageID(v)
+	return _u
+}
+
+// SetNillableLastDeliveredMessageID sets the "last_delivered_message_id" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableLastDeliveredMessageID(v *uuid.UUID) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetLastDeliveredMessageID(*v)
+	}
+	return _u
+}
+
+// ClearLastDeliveredMessageID clears the value of the "last_delivered_message_id" field.
+func (_u *GroupMemberUpdateOne) ClearLastDeliveredMessageID() *GroupMemberUpdateOne {
+	_u.mutation.ClearLastDeliveredMessageID()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *GroupMemberUpdateOne) SetArchivedAt(v time.Time) *GroupMemberUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableArchivedAt(v *time.Time) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *GroupMemberUpdateOne) ClearArchivedAt() *GroupMemberUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetKeepArchived sets the "keep_archived" field.
+func (_u *GroupMemberUpdateOne) SetKeepArchived(v bool) *GroupMemberUpdateOne {
+	_u.mutation.SetKeepArchived(v)
+	return _u
+}
+
+// SetNillableKeepArchived sets the "keep_archived" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableKeepArchived(v *bool) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetKeepArchived(*v)
+	}
+	return _u
+}
+
+// SetMuted sets the "muted" field.
+func (_u *GroupMemberUpdateOne) SetMuted(v bool) *GroupMemberUpdateOne {
+	_u.mutation.SetMuted(v)
+	return _u
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableMuted(v *bool) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetMuted(*v)
+	}
+	return _u
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (_u *GroupMemberUpdateOne) SetMutedUntil(v time.Time) *GroupMemberUpdateOne {
+	_u.mutation.SetMutedUntil(v)
+	return _u
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableMutedUntil(v *time.Time) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetMutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (_u *GroupMemberUpdateOne) ClearMutedUntil() *GroupMemberUpdateOne {
+	_u.mutation.ClearMutedUntil()
+	return _u
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (_u *GroupMemberUpdateOne) SetNotifyMentions(v bool) *GroupMemberUpdateOne {
+	_u.mutation.SetNotifyMentions(v)
+	return _u
+}
+
+// SetNillableNotifyMentions sets the "notify_mentions" field if the given value is not nil.
+func (_u *GroupMemberUpdateOne) SetNillableNotifyMentions(v *bool) *GroupMemberUpdateOne {
+	if v != nil {
+		_u.SetNotifyMentions(*v)
+	}
+	return _u
+}
+
 // SetGroupChat sets the "group_chat" edge to the GroupChat entity.
 func (_u *GroupMemberUpdateOne) SetGroupChat(v *GroupChat) *GroupMemberUpdateOne {
 	return _u.SetGroupChatID(v.ID)
@@ -391,6 +816,16 @@ func (_u *GroupMemberUpdateOne) SetUser(v *User) *GroupMemberUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetLastReadMessage sets the "last_read_message" edge to the Message entity.
+func (_u *GroupMemberUpdateOne) SetLastReadMessage(v *Message) *GroupMemberUpdateOne {
+	return _u.SetLastReadMessageID(v.ID)
+}
+
+// SetLastDeliveredMessage sets the "last_delivered_message" edge to the Message entity.
+func (_u *GroupMemberUpdateOne) SetLastDeliveredMessage(v *Message) *GroupMemberUpdateOne {
+	return _u.SetLastDeliveredMessageID(v.ID)
+}
+
 // Mutation returns the GroupMemberMutation object of the builder.
 func (_u *GroupMemberUpdateOne) Mutation() *GroupMemberMutation {
 	return _u.mutation
@@ -408,6 +843,18 @@ func (_u *GroupMemberUpdateOne) ClearUser() *GroupMemberUpdateOne {
 	return _u
 }
 
+// ClearLastReadMessage clears the "last_read_message" edge to the Message entity.
+func (_u *GroupMemberUpdateOne) ClearLastReadMessage() *GroupMemberUpdateOne {
+	_u.mutation.ClearLastReadMessage()
+	return _u
+}
+
+// ClearLastDeliveredMessage clears the "last_delivered_message" edge to the Message entity.
+func (_u *GroupMemberUpdateOne) ClearLastDeliveredMessage() *GroupMemberUpdateOne {
+	_u.mutation.ClearLastDeliveredMessage()
+	return _u
+}
+
 // Where appends a list predicates to the GroupMemberUpdate builder.
 func (_u *GroupMemberUpdateOne) Where(ps ...predicate.GroupMember) *GroupMemberUpdateOne {
 	_u.mutation.Where(ps...)
@@ -514,6 +961,36 @@ func (_u *GroupMemberUpdateOne) sqlSave(ctx context.Context) (_node *GroupMember
 	if value, ok := _u.mutation.AddedUnreadCount(); ok {
 		_spec.AddField(groupmember.FieldUnreadCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.UnreadMentionCount(); ok {
+		_spec.SetField(groupmember.FieldUnreadMentionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUnreadMentionCount(); ok {
+		_spec.AddField(groupmember.FieldUnreadMentionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MarkedUnread(); ok {
+		_spec.SetField(groupmember.FieldMarkedUnread, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(groupmember.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(groupmember.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.KeepArchived(); ok {
+		_spec.SetField(groupmember.FieldKeepArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Muted(); ok {
+		_spec.SetField(groupmember.FieldMuted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MutedUntil(); ok {
+		_spec.SetField(groupmember.FieldMutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.MutedUntilCleared() {
+		_spec.ClearField(groupmember.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NotifyMentions(); ok {
+		_spec.SetField(groupmember.FieldNotifyMentions, field.TypeBool, value)
+	}
 	if _u.mutation.GroupChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -572,6 +1049,64 @@ func (_u *GroupMemberUpdateOne) sqlSave(ctx context.Context) (_node *GroupMember
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LastReadMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastReadMessageTable,
+			Columns: []string{groupmember.LastReadMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LastReadMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastReadMessageTable,
+			Columns: []string{groupmember.LastReadMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LastDeliveredMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastDeliveredMessageTable,
+			Columns: []string{groupmember.LastDeliveredMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LastDeliveredMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   groupmember.LastDeliveredMessageTable,
+			Columns: []string{groupmember.LastDeliveredMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &GroupMember{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -20,6 +20,42 @@ func (f ChatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatMutation", m)
 }
 
+// The ChatExportFunc type is an adapter to allow the use of ordinary
+// function as ChatExport mutator.
+type ChatExportFunc func(context.Context, *ent.ChatExportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatExportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatExportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatExportMutation", m)
+}
+
+// The ChatFolderFunc type is an adapter to allow the use of ordinary
+// function as ChatFolder mutator.
+type ChatFolderFunc func(context.Context, *ent.ChatFolderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatFolderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatFolderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatFolderMutation", m)
+}
+
+// The ChatFolderChatFunc type is an adapter to allow the use of ordinary
+// function as ChatFolderChat mutator.
+type ChatFolderChatFunc func(context.Context, *ent.ChatFolderChatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChatFolderChatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ChatFolderChatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatFolderChatMutation", m)
+}
+
 // The GroupChatFunc type is an adapter to allow the use of ordinary
 // function as GroupChat mutator.
 type GroupChatFunc func(context.Context, *ent.GroupChatMutation) (ent.Value, error)
@@ -32,6 +68,18 @@ func (f GroupChatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupChatMutation", m)
 }
 
+// The GroupJoinRequestFunc type is an adapter to allow the use of ordinary
+// function as GroupJoinRequest mutator.
+type GroupJoinRequestFunc func(context.Context, *ent.GroupJoinRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupJoinRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupJoinRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupJoinRequestMutation", m)
+}
+
 // The GroupMemberFunc type is an adapter to allow the use of ordinary
 // function as GroupMember mutator.
 type GroupMemberFunc func(context.Context, *ent.GroupMemberMutation) (ent.Value, error)
@@ -68,6 +116,102 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The MessageMentionFunc type is an adapter to allow the use of ordinary
+// function as MessageMention mutator.
+type MessageMentionFunc func(context.Context, *ent.MessageMentionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageMentionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageMentionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMentionMutation", m)
+}
+
+// The MessageReactionFunc type is an adapter to allow the use of ordinary
+// function as MessageReaction mutator.
+type MessageReactionFunc func(context.Context, *ent.MessageReactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageReactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageReactionMutation", m)
+}
+
+// The MessageRevisionFunc type is an adapter to allow the use of ordinary
+// function as MessageRevision mutator.
+type MessageRevisionFunc func(context.Context, *ent.MessageRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MessageRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageRevisionMutation", m)
+}
+
+// The PinnedChatFunc type is an adapter to allow the use of ordinary
+// function as PinnedChat mutator.
+type PinnedChatFunc func(context.Context, *ent.PinnedChatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PinnedChatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PinnedChatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PinnedChatMutation", m)
+}
+
+// The PinnedMessageFunc type is an adapter to allow the use of ordinary
+// function as PinnedMessage mutator.
+type PinnedMessageFunc func(context.Context, *ent.PinnedMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PinnedMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PinnedMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PinnedMessageMutation", m)
+}
+
+// The PollFunc type is an adapter to allow the use of ordinary
+// function as Poll mutator.
+type PollFunc func(context.Context, *ent.PollMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PollFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PollMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PollMutation", m)
+}
+
+// The PollOptionFunc type is an adapter to allow the use of ordinary
+// function as PollOption mutator.
+type PollOptionFunc func(context.Context, *ent.PollOptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PollOptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PollOptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PollOptionMutation", m)
+}
+
+// The PollVoteFunc type is an adapter to allow the use of ordinary
+// function as PollVote mutator.
+type PollVoteFunc func(context.Context, *ent.PollVoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PollVoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PollVoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PollVoteMutation", m)
+}
+
 // The PrivateChatFunc type is an adapter to allow the use of ordinary
 // function as PrivateChat mutator.
 type PrivateChatFunc func(context.Context, *ent.PrivateChatMutation) (ent.Value, error)
@@ -92,6 +236,18 @@ func (f ReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReportMutation", m)
 }
 
+// The ScheduledMessageFunc type is an adapter to allow the use of ordinary
+// function as ScheduledMessage mutator.
+type ScheduledMessageFunc func(context.Context, *ent.ScheduledMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScheduledMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScheduledMessageMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
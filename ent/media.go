@@ -6,7 +6,9 @@ import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -41,10 +43,16 @@ type Media struct {
 	UploadExpiresAt *time.Time `json:"upload_expires_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs *int `json:"duration_ms,omitempty"`
+	// Waveform holds the value of the "waveform" field.
+	Waveform []int `json:"waveform,omitempty"`
 	// MessageID holds the value of the "message_id" field.
 	MessageID *uuid.UUID `json:"message_id,omitempty"`
 	// UploadedByID holds the value of the "uploaded_by_id" field.
 	UploadedByID *uuid.UUID `json:"uploaded_by_id,omitempty"`
+	// ScheduledMessageID holds the value of the "scheduled_message_id" field.
+	ScheduledMessageID *uuid.UUID `json:"scheduled_message_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MediaQuery when eager-loading is set.
 	Edges        MediaEdges `json:"edges"`
@@ -55,6 +63,8 @@ type Media struct {
 type MediaEdges struct {
 	// Message holds the value of the message edge.
 	Message *Message `json:"message,omitempty"`
+	// ScheduledMessage holds the value of the scheduled_message edge.
+	ScheduledMessage *ScheduledMessage `json:"scheduled_message,omitempty"`
 	// UserAvatar holds the value of the user_avatar edge.
 	UserAvatar *User `json:"user_avatar,omitempty"`
 	// GroupAvatar holds the value of the group_avatar edge.
@@ -63,9 +73,11 @@ type MediaEdges struct {
 	Uploader *User `json:"uploader,omitempty"`
 	// Reports holds the value of the reports edge.
 	Reports []*Report `json:"reports,omitempty"`
+	// MessageRevisions holds the value of the message_revisions edge.
+	MessageRevisions []*MessageRevision `json:"message_revisions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // MessageOrErr returns the Message value or an error if the edge
@@ -79,12 +91,23 @@ func (e MediaEdges) MessageOrErr() (*Message, error) {
 	return nil, &NotLoadedError{edge: "message"}
 }
 
+// ScheduledMessageOrErr returns the ScheduledMessage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaEdges) ScheduledMessageOrErr() (*ScheduledMessage, error) {
+	if e.ScheduledMessage != nil {
+		return e.ScheduledMessage, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: scheduledmessage.Label}
+	}
+	return nil, &NotLoadedError{edge: "scheduled_message"}
+}
+
 // UserAvatarOrErr returns the UserAvatar value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e MediaEdges) UserAvatarOrErr() (*User, error) {
 	if e.UserAvatar != nil {
 		return e.UserAvatar, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user_avatar"}
@@ -95,7 +118,7 @@ func (e MediaEdges) UserAvatarOrErr() (*User, error) {
 func (e MediaEdges) GroupAvatarOrErr() (*GroupChat, error) {
 	if e.GroupAvatar != nil {
 		return e.GroupAvatar, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: groupchat.Label}
 	}
 	return nil, &NotLoadedError{edge: "group_avatar"}
@@ -106,7 +129,7 @@ func (e MediaEdges) GroupAvatarOrErr() (*GroupChat, error) {
 func (e MediaEdges) UploaderOrErr() (*User, error) {
 	if e.Uploader != nil {
 		return e.Uploader, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "uploader"}
@@ -115,20 +138,31 @@ func (e MediaEdges) UploaderOrErr() (*User, error) {
 // ReportsOrErr returns the Reports value or an error if the edge
 // was not loaded in eager-loading.
 func (e MediaEdges) ReportsOrErr() ([]*Report, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Reports, nil
 	}
 	return nil, &NotLoadedError{edge: "reports"}
 }
 
+// MessageRevisionsOrErr returns the MessageRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) MessageRevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[6] {
+		return e.MessageRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "message_revisions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Media) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case media.FieldMessageID, media.FieldUploadedByID:
+		case media.FieldMessageID, media.FieldUploadedByID, media.FieldScheduledMessageID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case media.FieldFileSize:
+		case media.FieldWaveform:
+			values[i] = new([]byte)
+		case media.FieldFileSize, media.FieldDurationMs:
 			values[i] = new(sql.NullInt64)
 		case media.FieldFileName, media.FieldOriginalName, media.FieldMimeType, media.FieldCategory, media.FieldUploadStatus:
 			values[i] = new(sql.NullString)
@@ -219,6 +253,21 @@ func (_m *Media) assignValues(columns []string, values []any) error {
 				_m.CompletedAt = new(time.Time)
 				*_m.CompletedAt = value.Time
 			}
+		case media.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = new(int)
+				*_m.DurationMs = int(value.Int64)
+			}
+		case media.FieldWaveform:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field waveform", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Waveform); err != nil {
+					return fmt.Errorf("unmarshal field waveform: %w", err)
+				}
+			}
 		case media.FieldMessageID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field message_id", values[i])
@@ -233,6 +282,13 @@ func (_m *Media) assignValues(columns []string, values []any) error {
 				_m.UploadedByID = new(uuid.UUID)
 				*_m.UploadedByID = *value.S.(*uuid.UUID)
 			}
+		case media.FieldScheduledMessageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_message_id", values[i])
+			} else if value.Valid {
+				_m.ScheduledMessageID = new(uuid.UUID)
+				*_m.ScheduledMessageID = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -251,6 +307,11 @@ func (_m *Media) QueryMessage() *MessageQuery {
 	return NewMediaClient(_m.config).QueryMessage(_m)
 }
 
+// QueryScheduledMessage queries the "scheduled_message" edge of the Media entity.
+func (_m *Media) QueryScheduledMessage() *ScheduledMessageQuery {
+	return NewMediaClient(_m.config).QueryScheduledMessage(_m)
+}
+
 // QueryUserAvatar queries the "user_avatar" edge of the Media entity.
 func (_m *Media) QueryUserAvatar() *UserQuery {
 	return NewMediaClient(_m.config).QueryUserAvatar(_m)
@@ -271,6 +332,11 @@ func (_m *Media) QueryReports() *ReportQuery {
 	return NewMediaClient(_m.config).QueryReports(_m)
 }
 
+// QueryMessageRevisions queries the "message_revisions" edge of the Media entity.
+func (_m *Media) QueryMessageRevisions() *MessageRevisionQuery {
+	return NewMediaClient(_m.config).QueryMessageRevisions(_m)
+}
+
 // Update returns a builder for updating this Media.
 // Note that you need to call Media.Unwrap() before calling this method if this Media
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -328,6 +394,14 @@ func (_m *Media) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.DurationMs; v != nil {
+		builder.WriteString("duration_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("waveform=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Waveform))
+	builder.WriteString(", ")
 	if v := _m.MessageID; v != nil {
 		builder.WriteString("message_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -337,6 +411,11 @@ func (_m *Media) String() string {
 		builder.WriteString("uploaded_by_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.ScheduledMessageID; v != nil {
+		builder.WriteString("scheduled_message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
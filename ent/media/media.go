@@ -36,12 +36,20 @@ const (
 	FieldUploadExpiresAt = "upload_expires_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
 	FieldCompletedAt = "completed_at"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldWaveform holds the string denoting the waveform field in the database.
+	FieldWaveform = "waveform"
 	// FieldMessageID holds the string denoting the message_id field in the database.
 	FieldMessageID = "message_id"
 	// FieldUploadedByID holds the string denoting the uploaded_by_id field in the database.
 	FieldUploadedByID = "uploaded_by_id"
+	// FieldScheduledMessageID holds the string denoting the scheduled_message_id field in the database.
+	FieldScheduledMessageID = "scheduled_message_id"
 	// EdgeMessage holds the string denoting the message edge name in mutations.
 	EdgeMessage = "message"
+	// EdgeScheduledMessage holds the string denoting the scheduled_message edge name in mutations.
+	EdgeScheduledMessage = "scheduled_message"
 	// EdgeUserAvatar holds the string denoting the user_avatar edge name in mutations.
 	EdgeUserAvatar = "user_avatar"
 	// EdgeGroupAvatar holds the string denoting the group_avatar edge name in mutations.
@@ -50,6 +58,8 @@ const (
 	EdgeUploader = "uploader"
 	// EdgeReports holds the string denoting the reports edge name in mutations.
 	EdgeReports = "reports"
+	// EdgeMessageRevisions holds the string denoting the message_revisions edge name in mutations.
+	EdgeMessageRevisions = "message_revisions"
 	// Table holds the table name of the media in the database.
 	Table = "media"
 	// MessageTable is the table that holds the message relation/edge.
@@ -59,6 +69,13 @@ const (
 	MessageInverseTable = "messages"
 	// MessageColumn is the table column denoting the message relation/edge.
 	MessageColumn = "message_id"
+	// ScheduledMessageTable is the table that holds the scheduled_message relation/edge.
+	ScheduledMessageTable = "media"
+	// ScheduledMessageInverseTable is the table name for the ScheduledMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledmessage" package.
+	ScheduledMessageInverseTable = "scheduled_messages"
+	// ScheduledMessageColumn is the table column denoting the scheduled_message relation/edge.
+	ScheduledMessageColumn = "scheduled_message_id"
 	// UserAvatarTable is the table that holds the user_avatar relation/edge.
 	UserAvatarTable = "users"
 	// UserAvatarInverseTable is the table name for the User entity.
@@ -85,6 +102,11 @@ const (
 	// ReportsInverseTable is the table name for the Report entity.
 	// It exists in this package in order to avoid circular dependency with the "report" package.
 	ReportsInverseTable = "reports"
+	// MessageRevisionsTable is the table that holds the message_revisions relation/edge. The primary key declared below.
+	MessageRevisionsTable = "message_revision_attachments"
+	// MessageRevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	MessageRevisionsInverseTable = "message_revisions"
 )
 
 // Columns holds all SQL columns for media fields.
@@ -100,14 +122,20 @@ var Columns = []string{
 	FieldUploadStatus,
 	FieldUploadExpiresAt,
 	FieldCompletedAt,
+	FieldDurationMs,
+	FieldWaveform,
 	FieldMessageID,
 	FieldUploadedByID,
+	FieldScheduledMessageID,
 }
 
 var (
 	// ReportsPrimaryKey and ReportsColumn2 are the table columns denoting the
 	// primary key for the reports relation (M2M).
 	ReportsPrimaryKey = []string{"report_id", "media_id"}
+	// MessageRevisionsPrimaryKey and MessageRevisionsColumn2 are the table columns denoting the
+	// primary key for the message_revisions relation (M2M).
+	MessageRevisionsPrimaryKey = []string{"message_revision_id", "media_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -135,6 +163,8 @@ var (
 	FileSizeValidator func(int64) error
 	// MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
 	MimeTypeValidator func(string) error
+	// DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	DurationMsValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -150,6 +180,7 @@ const (
 	CategoryUserAvatar        Category = "user_avatar"
 	CategoryGroupAvatar       Category = "group_avatar"
 	CategoryMessageAttachment Category = "message_attachment"
+	CategoryVoice             Category = "voice"
 )
 
 func (c Category) String() string {
@@ -159,7 +190,7 @@ func (c Category) String() string {
 // CategoryValidator is a validator for the "category" field enum values. It is called by the builders before save.
 func CategoryValidator(c Category) error {
 	switch c {
-	case CategoryUserAvatar, CategoryGroupAvatar, CategoryMessageAttachment:
+	case CategoryUserAvatar, CategoryGroupAvatar, CategoryMessageAttachment, CategoryVoice:
 		return nil
 	default:
 		return fmt.Errorf("media: invalid enum value for category field: %q", c)
@@ -250,6 +281,11 @@ func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
 }
 
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
 // ByMessageID orders the results by the message_id field.
 func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
@@ -260,6 +296,11 @@ func ByUploadedByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUploadedByID, opts...).ToFunc()
 }
 
+// ByScheduledMessageID orders the results by the scheduled_message_id field.
+func ByScheduledMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledMessageID, opts...).ToFunc()
+}
+
 // ByMessageField orders the results by message field.
 func ByMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -267,6 +308,13 @@ func ByMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByScheduledMessageField orders the results by scheduled_message field.
+func ByScheduledMessageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledMessageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByUserAvatarField orders the results by user_avatar field.
 func ByUserAvatarField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -301,6 +349,20 @@ func ByReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMessageRevisionsCount orders the results by message_revisions count.
+func ByMessageRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageRevisionsStep(), opts...)
+	}
+}
+
+// ByMessageRevisions orders the results by message_revisions terms.
+func ByMessageRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMessageStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -308,6 +370,13 @@ func newMessageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
 	)
 }
+func newScheduledMessageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledMessageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ScheduledMessageTable, ScheduledMessageColumn),
+	)
+}
 func newUserAvatarStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -336,3 +405,10 @@ func newReportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, ReportsTable, ReportsPrimaryKey...),
 	)
 }
+func newMessageRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MessageRevisionsTable, MessageRevisionsPrimaryKey...),
+	)
+}
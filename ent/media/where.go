@@ -96,6 +96,11 @@ func CompletedAt(v time.Time) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldDurationMs, v))
+}
+
 // MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
 func MessageID(v uuid.UUID) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldMessageID, v))
@@ -106,6 +111,11 @@ func UploadedByID(v uuid.UUID) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldUploadedByID, v))
 }
 
+// ScheduledMessageID applies equality check predicate on the "scheduled_message_id" field. It's identical to ScheduledMessageIDEQ.
+func ScheduledMessageID(v uuid.UUID) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldScheduledMessageID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldCreatedAt, v))
@@ -561,6 +571,66 @@ func CompletedAtNotNil() predicate.Media {
 	return predicate.Media(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldDurationMs))
+}
+
+// WaveformIsNil applies the IsNil predicate on the "waveform" field.
+func WaveformIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldWaveform))
+}
+
+// WaveformNotNil applies the NotNil predicate on the "waveform" field.
+func WaveformNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldWaveform))
+}
+
 // MessageIDEQ applies the EQ predicate on the "message_id" field.
 func MessageIDEQ(v uuid.UUID) predicate.Media {
 	return predicate.Media(sql.FieldEQ(FieldMessageID, v))
@@ -621,6 +691,36 @@ func UploadedByIDNotNil() predicate.Media {
 	return predicate.Media(sql.FieldNotNull(FieldUploadedByID))
 }
 
+// ScheduledMessageIDEQ applies the EQ predicate on the "scheduled_message_id" field.
+func ScheduledMessageIDEQ(v uuid.UUID) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldScheduledMessageID, v))
+}
+
+// ScheduledMessageIDNEQ applies the NEQ predicate on the "scheduled_message_id" field.
+func ScheduledMessageIDNEQ(v uuid.UUID) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldScheduledMessageID, v))
+}
+
+// ScheduledMessageIDIn applies the In predicate on the "scheduled_message_id" field.
+func ScheduledMessageIDIn(vs ...uuid.UUID) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldScheduledMessageID, vs...))
+}
+
+// ScheduledMessageIDNotIn applies the NotIn predicate on the "scheduled_message_id" field.
+func ScheduledMessageIDNotIn(vs ...uuid.UUID) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldScheduledMessageID, vs...))
+}
+
+// ScheduledMessageIDIsNil applies the IsNil predicate on the "scheduled_message_id" field.
+func ScheduledMessageIDIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldScheduledMessageID))
+}
+
+// ScheduledMessageIDNotNil applies the NotNil predicate on the "scheduled_message_id" field.
+func ScheduledMessageIDNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldScheduledMessageID))
+}
+
 // HasMessage applies the HasEdge predicate on the "message" edge.
 func HasMessage() predicate.Media {
 	return predicate.Media(func(s *sql.Selector) {
@@ -644,6 +744,29 @@ func HasMessageWith(preds ...predicate.Message) predicate.Media {
 	})
 }
 
+// HasScheduledMessage applies the HasEdge predicate on the "scheduled_message" edge.
+func HasScheduledMessage() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScheduledMessageTable, ScheduledMessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledMessageWith applies the HasEdge predicate on the "scheduled_message" edge with a given conditions (other predicates).
+func HasScheduledMessageWith(preds ...predicate.ScheduledMessage) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newScheduledMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasUserAvatar applies the HasEdge predicate on the "user_avatar" edge.
 func HasUserAvatar() predicate.Media {
 	return predicate.Media(func(s *sql.Selector) {
@@ -736,6 +859,29 @@ func HasReportsWith(preds ...predicate.Report) predicate.Media {
 	})
 }
 
+// HasMessageRevisions applies the HasEdge predicate on the "message_revisions" edge.
+func HasMessageRevisions() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MessageRevisionsTable, MessageRevisionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageRevisionsWith applies the HasEdge predicate on the "message_revisions" edge with a given conditions (other predicates).
+func HasMessageRevisionsWith(preds ...predicate.MessageRevision) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newMessageRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Media) predicate.Media {
 	return predicate.Media(sql.AndPredicates(predicates...))
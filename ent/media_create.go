@@ -6,7 +6,9 @@ import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagerevision"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"errors"
@@ -136,6 +138,26 @@ func (_c *MediaCreate) SetNillableCompletedAt(v *time.Time) *MediaCreate {
 	return _c
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (_c *MediaCreate) SetDurationMs(v int) *MediaCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *MediaCreate) SetNillableDurationMs(v *int) *MediaCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetWaveform sets the "waveform" field.
+func (_c *MediaCreate) SetWaveform(v []int) *MediaCreate {
+	_c.mutation.SetWaveform(v)
+	return _c
+}
+
 // SetMessageID sets the "message_id" field.
 func (_c *MediaCreate) SetMessageID(v uuid.UUID) *MediaCreate {
 	_c.mutation.SetMessageID(v)
@@ -164,6 +186,20 @@ func (_c *MediaCreate) SetNillableUploadedByID(v *uuid.UUID) *MediaCreate {
 	return _c
 }
 
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (_c *MediaCreate) SetScheduledMessageID(v uuid.UUID) *MediaCreate {
+	_c.mutation.SetScheduledMessageID(v)
+	return _c
+}
+
+// SetNillableScheduledMessageID sets the "scheduled_message_id" field if the given value is not nil.
+func (_c *MediaCreate) SetNillableScheduledMessageID(v *uuid.UUID) *MediaCreate {
+	if v != nil {
+		_c.SetScheduledMessageID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MediaCreate) SetID(v uuid.UUID) *MediaCreate {
 	_c.mutation.SetID(v)
@@ -183,6 +219,11 @@ func (_c *MediaCreate) SetMessage(v *Message) *MediaCreate {
 	return _c.SetMessageID(v.ID)
 }
 
+// SetScheduledMessage sets the "scheduled_message" edge to the ScheduledMessage entity.
+func (_c *MediaCreate) SetScheduledMessage(v *ScheduledMessage) *MediaCreate {
+	return _c.SetScheduledMessageID(v.ID)
+}
+
 // SetUserAvatarID sets the "user_avatar" edge to the User entity by ID.
 func (_c *MediaCreate) SetUserAvatarID(id uuid.UUID) *MediaCreate {
 	_c.mutation.SetUserAvatarID(id)
@@ -255,6 +296,21 @@ func (_c *MediaCreate) AddReports(v ...*Report) *MediaCreate {
 	return _c.AddReportIDs(ids...)
 }
 
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (_c *MediaCreate) AddMessageRevisionIDs(ids ...uuid.UUID) *MediaCreate {
+	_c.mutation.AddMessageRevisionIDs(ids...)
+	return _c
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (_c *MediaCreate) AddMessageRevisions(v ...*MessageRevision) *MediaCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMessageRevisionIDs(ids...)
+}
+
 // Mutation returns the MediaMutation object of the builder.
 func (_c *MediaCreate) Mutation() *MediaMutation {
 	return _c.mutation
@@ -368,6 +424,11 @@ func (_c *MediaCreate) check() error {
 			return &ValidationError{Name: "upload_status", err: fmt.Errorf(`ent: validator failed for field "Media.upload_status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.DurationMs(); ok {
+		if err := media.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Media.duration_ms": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -444,6 +505,14 @@ func (_c *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		_spec.SetField(media.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = &value
 	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(media.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = &value
+	}
+	if value, ok := _c.mutation.Waveform(); ok {
+		_spec.SetField(media.FieldWaveform, field.TypeJSON, value)
+		_node.Waveform = value
+	}
 	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -461,6 +530,23 @@ func (_c *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		_node.MessageID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ScheduledMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.ScheduledMessageTable,
+			Columns: []string{media.ScheduledMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduledMessageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.UserAvatarIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -526,6 +612,22 @@ func (_c *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -704,6 +806,48 @@ func (u *MediaUpsert) ClearCompletedAt() *MediaUpsert {
 	return u
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (u *MediaUpsert) SetDurationMs(v int) *MediaUpsert {
+	u.Set(media.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *MediaUpsert) UpdateDurationMs() *MediaUpsert {
+	u.SetExcluded(media.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *MediaUpsert) AddDurationMs(v int) *MediaUpsert {
+	u.Add(media.FieldDurationMs, v)
+	return u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *MediaUpsert) ClearDurationMs() *MediaUpsert {
+	u.SetNull(media.FieldDurationMs)
+	return u
+}
+
+// SetWaveform sets the "waveform" field.
+func (u *MediaUpsert) SetWaveform(v []int) *MediaUpsert {
+	u.Set(media.FieldWaveform, v)
+	return u
+}
+
+// UpdateWaveform sets the "waveform" field to the value that was provided on create.
+func (u *MediaUpsert) UpdateWaveform() *MediaUpsert {
+	u.SetExcluded(media.FieldWaveform)
+	return u
+}
+
+// ClearWaveform clears the value of the "waveform" field.
+func (u *MediaUpsert) ClearWaveform() *MediaUpsert {
+	u.SetNull(media.FieldWaveform)
+	return u
+}
+
 // SetMessageID sets the "message_id" field.
 func (u *MediaUpsert) SetMessageID(v uuid.UUID) *MediaUpsert {
 	u.Set(media.FieldMessageID, v)
@@ -740,6 +884,24 @@ func (u *MediaUpsert) ClearUploadedByID() *MediaUpsert {
 	return u
 }
 
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (u *MediaUpsert) SetScheduledMessageID(v uuid.UUID) *MediaUpsert {
+	u.Set(media.FieldScheduledMessageID, v)
+	return u
+}
+
+// UpdateScheduledMessageID sets the "scheduled_message_id" field to the value that was provided on create.
+func (u *MediaUpsert) UpdateScheduledMessageID() *MediaUpsert {
+	u.SetExcluded(media.FieldScheduledMessageID)
+	return u
+}
+
+// ClearScheduledMessageID clears the value of the "scheduled_message_id" field.
+func (u *MediaUpsert) ClearScheduledMessageID() *MediaUpsert {
+	u.SetNull(media.FieldScheduledMessageID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -938,6 +1100,55 @@ func (u *MediaUpsertOne) ClearCompletedAt() *MediaUpsertOne {
 	})
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (u *MediaUpsertOne) SetDurationMs(v int) *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *MediaUpsertOne) AddDurationMs(v int) *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *MediaUpsertOne) UpdateDurationMs() *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *MediaUpsertOne) ClearDurationMs() *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetWaveform sets the "waveform" field.
+func (u *MediaUpsertOne) SetWaveform(v []int) *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.SetWaveform(v)
+	})
+}
+
+// UpdateWaveform sets the "waveform" field to the value that was provided on create.
+func (u *MediaUpsertOne) UpdateWaveform() *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.UpdateWaveform()
+	})
+}
+
+// ClearWaveform clears the value of the "waveform" field.
+func (u *MediaUpsertOne) ClearWaveform() *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.ClearWaveform()
+	})
+}
+
 // SetMessageID sets the "message_id" field.
 func (u *MediaUpsertOne) SetMessageID(v uuid.UUID) *MediaUpsertOne {
 	return u.Update(func(s *MediaUpsert) {
@@ -980,6 +1191,27 @@ func (u *MediaUpsertOne) ClearUploadedByID() *MediaUpsertOne {
 	})
 }
 
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (u *MediaUpsertOne) SetScheduledMessageID(v uuid.UUID) *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.SetScheduledMessageID(v)
+	})
+}
+
+// UpdateScheduledMessageID sets the "scheduled_message_id" field to the value that was provided on create.
+func (u *MediaUpsertOne) UpdateScheduledMessageID() *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.UpdateScheduledMessageID()
+	})
+}
+
+// ClearScheduledMessageID clears the value of the "scheduled_message_id" field.
+func (u *MediaUpsertOne) ClearScheduledMessageID() *MediaUpsertOne {
+	return u.Update(func(s *MediaUpsert) {
+		s.ClearScheduledMessageID()
+	})
+}
+
 // Exec executes the query.
 func (u *MediaUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1345,6 +1577,55 @@ func (u *MediaUpsertBulk) ClearCompletedAt() *MediaUpsertBulk {
 	})
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (u *MediaUpsertBulk) SetDurationMs(v int) *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *MediaUpsertBulk) AddDurationMs(v int) *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *MediaUpsertBulk) UpdateDurationMs() *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *MediaUpsertBulk) ClearDurationMs() *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetWaveform sets the "waveform" field.
+func (u *MediaUpsertBulk) SetWaveform(v []int) *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.SetWaveform(v)
+	})
+}
+
+// UpdateWaveform sets the "waveform" field to the value that was provided on create.
+func (u *MediaUpsertBulk) UpdateWaveform() *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.UpdateWaveform()
+	})
+}
+
+// ClearWaveform clears the value of the "waveform" field.
+func (u *MediaUpsertBulk) ClearWaveform() *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.ClearWaveform()
+	})
+}
+
 // SetMessageID sets the "message_id" field.
 func (u *MediaUpsertBulk) SetMessageID(v uuid.UUID) *MediaUpsertBulk {
 	return u.Update(func(s *MediaUpsert) {
@@ -1387,6 +1668,27 @@ func (u *MediaUpsertBulk) ClearUploadedByID() *MediaUpsertBulk {
 	})
 }
 
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (u *MediaUpsertBulk) SetScheduledMessageID(v uuid.UUID) *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.SetScheduledMessageID(v)
+	})
+}
+
+// UpdateScheduledMessageID sets the "scheduled_message_id" field to the value that was provided on create.
+func (u *MediaUpsertBulk) UpdateScheduledMessageID() *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.UpdateScheduledMessageID()
+	})
+}
+
+// ClearScheduledMessageID clears the value of the "scheduled_message_id" field.
+func (u *MediaUpsertBulk) ClearScheduledMessageID() *MediaUpsertBulk {
+	return u.Update(func(s *MediaUpsert) {
+		s.ClearScheduledMessageID()
+	})
+}
+
 // Exec executes the query.
 func (u *MediaUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
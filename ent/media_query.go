@@ -6,8 +6,10 @@ import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagerevision"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"database/sql/driver"
@@ -25,16 +27,18 @@ import (
 // MediaQuery is the builder for querying Media entities.
 type MediaQuery struct {
 	config
-	ctx             *QueryContext
-	order           []media.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Media
-	withMessage     *MessageQuery
-	withUserAvatar  *UserQuery
-	withGroupAvatar *GroupChatQuery
-	withUploader    *UserQuery
-	withReports     *ReportQuery
-	modifiers       []func(*sql.Selector)
+	ctx                  *QueryContext
+	order                []media.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Media
+	withMessage          *MessageQuery
+	withScheduledMessage *ScheduledMessageQuery
+	withUserAvatar       *UserQuery
+	withGroupAvatar      *GroupChatQuery
+	withUploader         *UserQuery
+	withReports          *ReportQuery
+	withMessageRevisions *MessageRevisionQuery
+	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -93,6 +97,28 @@ func (_q *MediaQuery) QueryMessage() *MessageQuery {
 	return query
 }
 
+// QueryScheduledMessage chains the current query on the "scheduled_message" edge.
+func (_q *MediaQuery) QueryScheduledMessage() *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, media.ScheduledMessageTable, media.ScheduledMessageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUserAvatar chains the current query on the "user_avatar" edge.
 func (_q *MediaQuery) QueryUserAvatar() *UserQuery {
 	query := (&UserClient{config: _q.config}).Query()
@@ -181,6 +207,28 @@ func (_q *MediaQuery) QueryReports() *ReportQuery {
 	return query
 }
 
+// QueryMessageRevisions chains the current query on the "message_revisions" edge.
+func (_q *MediaQuery) QueryMessageRevisions() *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, selector),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, media.MessageRevisionsTable, media.MessageRevisionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Media entity from the query.
 // Returns a *NotFoundError when no Media was found.
 func (_q *MediaQuery) First(ctx context.Context) (*Media, error) {
@@ -368,16 +416,18 @@ func (_q *MediaQuery) Clone() *MediaQuery {
 		return nil
 	}
 	return &MediaQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]media.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.Media{}, _q.predicates...),
-		withMessage:     _q.withMessage.Clone(),
-		withUserAvatar:  _q.withUserAvatar.Clone(),
-		withGroupAvatar: _q.withGroupAvatar.Clone(),
-		withUploader:    _q.withUploader.Clone(),
-		withReports:     _q.withReports.Clone(),
+		config:               _q.config,
+		ctx:                  _q.ctx.Clone(),
+		order:                append([]media.OrderOption{}, _q.order...),
+		inters:               append([]Interceptor{}, _q.inters...),
+		predicates:           append([]predicate.Media{}, _q.predicates...),
+		withMessage:          _q.withMessage.Clone(),
+		withScheduledMessage: _q.withScheduledMessage.Clone(),
+		withUserAvatar:       _q.withUserAvatar.Clone(),
+		withGroupAvatar:      _q.withGroupAvatar.Clone(),
+		withUploader:         _q.withUploader.Clone(),
+		withReports:          _q.withReports.Clone(),
+		withMessageRevisions: _q.withMessageRevisions.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -396,6 +446,17 @@ func (_q *MediaQuery) WithMessage(opts ...func(*MessageQuery)) *MediaQuery {
 	return _q
 }
 
+// WithScheduledMessage tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_message" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MediaQuery) WithScheduledMessage(opts ...func(*ScheduledMessageQuery)) *MediaQuery {
+	query := (&ScheduledMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledMessage = query
+	return _q
+}
+
 // WithUserAvatar tells the query-builder to eager-load the nodes that are connected to
 // the "user_avatar" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *MediaQuery) WithUserAvatar(opts ...func(*UserQuery)) *MediaQuery {
@@ -440,6 +501,17 @@ func (_q *MediaQuery) WithReports(opts ...func(*ReportQuery)) *MediaQuery {
 	return _q
 }
 
+// WithMessageRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "message_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MediaQuery) WithMessageRevisions(opts ...func(*MessageRevisionQuery)) *MediaQuery {
+	query := (&MessageRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMessageRevisions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +590,14 @@ func (_q *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 	var (
 		nodes       = []*Media{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			_q.withMessage != nil,
+			_q.withScheduledMessage != nil,
 			_q.withUserAvatar != nil,
 			_q.withGroupAvatar != nil,
 			_q.withUploader != nil,
 			_q.withReports != nil,
+			_q.withMessageRevisions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -553,6 +627,12 @@ func (_q *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 			return nil, err
 		}
 	}
+	if query := _q.withScheduledMessage; query != nil {
+		if err := _q.loadScheduledMessage(ctx, query, nodes, nil,
+			func(n *Media, e *ScheduledMessage) { n.Edges.ScheduledMessage = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withUserAvatar; query != nil {
 		if err := _q.loadUserAvatar(ctx, query, nodes, nil,
 			func(n *Media, e *User) { n.Edges.UserAvatar = e }); err != nil {
@@ -578,6 +658,13 @@ func (_q *MediaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Media,
 			return nil, err
 		}
 	}
+	if query := _q.withMessageRevisions; query != nil {
+		if err := _q.loadMessageRevisions(ctx, query, nodes,
+			func(n *Media) { n.Edges.MessageRevisions = []*MessageRevision{} },
+			func(n *Media, e *MessageRevision) { n.Edges.MessageRevisions = append(n.Edges.MessageRevisions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -613,6 +700,38 @@ func (_q *MediaQuery) loadMessage(ctx context.Context, query *MessageQuery, node
 	}
 	return nil
 }
+func (_q *MediaQuery) loadScheduledMessage(ctx context.Context, query *ScheduledMessageQuery, nodes []*Media, init func(*Media), assign func(*Media, *ScheduledMessage)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Media)
+	for i := range nodes {
+		if nodes[i].ScheduledMessageID == nil {
+			continue
+		}
+		fk := *nodes[i].ScheduledMessageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(scheduledmessage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "scheduled_message_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *MediaQuery) loadUserAvatar(ctx context.Context, query *UserQuery, nodes []*Media, init func(*Media), assign func(*Media, *User)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Media)
@@ -766,6 +885,67 @@ func (_q *MediaQuery) loadReports(ctx context.Context, query *ReportQuery, nodes
 	}
 	return nil
 }
+func (_q *MediaQuery) loadMessageRevisions(ctx context.Context, query *MessageRevisionQuery, nodes []*Media, init func(*Media), assign func(*Media, *MessageRevision)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Media)
+	nids := make(map[uuid.UUID]map[*Media]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(media.MessageRevisionsTable)
+		s.Join(joinT).On(s.C(messagerevision.FieldID), joinT.C(media.MessageRevisionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(media.MessageRevisionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(media.MessageRevisionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Media]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*MessageRevision](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "message_revisions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *MediaQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -798,6 +978,9 @@ func (_q *MediaQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withMessage != nil {
 			_spec.Node.AddColumnOnce(media.FieldMessageID)
 		}
+		if _q.withScheduledMessage != nil {
+			_spec.Node.AddColumnOnce(media.FieldScheduledMessageID)
+		}
 		if _q.withUploader != nil {
 			_spec.Node.AddColumnOnce(media.FieldUploadedByID)
 		}
@@ -6,8 +6,10 @@ import (
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagerevision"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"errors"
@@ -16,6 +18,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -171,6 +174,51 @@ func (_u *MediaUpdate) ClearCompletedAt() *MediaUpdate {
 	return _u
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (_u *MediaUpdate) SetDurationMs(v int) *MediaUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableDurationMs(v *int) *MediaUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *MediaUpdate) AddDurationMs(v int) *MediaUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *MediaUpdate) ClearDurationMs() *MediaUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetWaveform sets the "waveform" field.
+func (_u *MediaUpdate) SetWaveform(v []int) *MediaUpdate {
+	_u.mutation.SetWaveform(v)
+	return _u
+}
+
+// AppendWaveform appends value to the "waveform" field.
+func (_u *MediaUpdate) AppendWaveform(v []int) *MediaUpdate {
+	_u.mutation.AppendWaveform(v)
+	return _u
+}
+
+// ClearWaveform clears the value of the "waveform" field.
+func (_u *MediaUpdate) ClearWaveform() *MediaUpdate {
+	_u.mutation.ClearWaveform()
+	return _u
+}
+
 // SetMessageID sets the "message_id" field.
 func (_u *MediaUpdate) SetMessageID(v uuid.UUID) *MediaUpdate {
 	_u.mutation.SetMessageID(v)
@@ -211,11 +259,36 @@ func (_u *MediaUpdate) ClearUploadedByID() *MediaUpdate {
 	return _u
 }
 
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (_u *MediaUpdate) SetScheduledMessageID(v uuid.UUID) *MediaUpdate {
+	_u.mutation.SetScheduledMessageID(v)
+	return _u
+}
+
+// SetNillableScheduledMessageID sets the "scheduled_message_id" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableScheduledMessageID(v *uuid.UUID) *MediaUpdate {
+	if v != nil {
+		_u.SetScheduledMessageID(*v)
+	}
+	return _u
+}
+
+// ClearScheduledMessageID clears the value of the "scheduled_message_id" field.
+func (_u *MediaUpdate) ClearScheduledMessageID() *MediaUpdate {
+	_u.mutation.ClearScheduledMessageID()
+	return _u
+}
+
 // SetMessage sets the "message" edge to the Message entity.
 func (_u *MediaUpdate) SetMessage(v *Message) *MediaUpdate {
 	return _u.SetMessageID(v.ID)
 }
 
+// SetScheduledMessage sets the "scheduled_message" edge to the ScheduledMessage entity.
+func (_u *MediaUpdate) SetScheduledMessage(v *ScheduledMessage) *MediaUpdate {
+	return _u.SetScheduledMessageID(v.ID)
+}
+
 // SetUserAvatarID sets the "user_avatar" edge to the User entity by ID.
 func (_u *MediaUpdate) SetUserAvatarID(id uuid.UUID) *MediaUpdate {
 	_u.mutation.SetUserAvatarID(id)
@@ -288,6 +361,21 @@ func (_u *MediaUpdate) AddReports(v ...*Report) *MediaUpdate {
 	return _u.AddReportIDs(ids...)
 }
 
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (_u *MediaUpdate) AddMessageRevisionIDs(ids ...uuid.UUID) *MediaUpdate {
+	_u.mutation.AddMessageRevisionIDs(ids...)
+	return _u
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (_u *MediaUpdate) AddMessageRevisions(v ...*MessageRevision) *MediaUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageRevisionIDs(ids...)
+}
+
 // Mutation returns the MediaMutation object of the builder.
 func (_u *MediaUpdate) Mutation() *MediaMutation {
 	return _u.mutation
@@ -299,6 +387,12 @@ func (_u *MediaUpdate) ClearMessage() *MediaUpdate {
 	return _u
 }
 
+// ClearScheduledMessage clears the "scheduled_message" edge to the ScheduledMessage entity.
+func (_u *MediaUpdate) ClearScheduledMessage() *MediaUpdate {
+	_u.mutation.ClearScheduledMessage()
+	return _u
+}
+
 // ClearUserAvatar clears the "user_avatar" edge to the User entity.
 func (_u *MediaUpdate) ClearUserAvatar() *MediaUpdate {
 	_u.mutation.ClearUserAvatar()
@@ -338,6 +432,27 @@ func (_u *MediaUpdate) RemoveReports(v ...*Report) *MediaUpdate {
 	return _u.RemoveReportIDs(ids...)
 }
 
+// ClearMessageRevisions clears all "message_revisions" edges to the MessageRevision entity.
+func (_u *MediaUpdate) ClearMessageRevisions() *MediaUpdate {
+	_u.mutation.ClearMessageRevisions()
+	return _u
+}
+
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to MessageRevision entities by IDs.
+func (_u *MediaUpdate) RemoveMessageRevisionIDs(ids ...uuid.UUID) *MediaUpdate {
+	_u.mutation.RemoveMessageRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveMessageRevisions removes "message_revisions" edges to MessageRevision entities.
+func (_u *MediaUpdate) RemoveMessageRevisions(v ...*MessageRevision) *MediaUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *MediaUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -406,6 +521,11 @@ func (_u *MediaUpdate) check() error {
 			return &ValidationError{Name: "upload_status", err: fmt.Errorf(`ent: validator failed for field "Media.upload_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := media.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Media.duration_ms": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -463,6 +583,26 @@ func (_u *MediaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(media.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(media.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(media.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(media.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Waveform(); ok {
+		_spec.SetField(media.FieldWaveform, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWaveform(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, media.FieldWaveform, value)
+		})
+	}
+	if _u.mutation.WaveformCleared() {
+		_spec.ClearField(media.FieldWaveform, field.TypeJSON)
+	}
 	if _u.mutation.MessageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -492,6 +632,35 @@ func (_u *MediaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduledMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.ScheduledMessageTable,
+			Columns: []string{media.ScheduledMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.ScheduledMessageTable,
+			Columns: []string{media.ScheduledMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.UserAvatarCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -624,6 +793,51 @@ func (_u *MediaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessageRevisionsIDs(); len(nodes) > 0 && !_u.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -783,6 +997,51 @@ func (_u *MediaUpdateOne) ClearCompletedAt() *MediaUpdateOne {
 	return _u
 }
 
+// SetDurationMs sets the "duration_ms" field.
+func (_u *MediaUpdateOne) SetDurationMs(v int) *MediaUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableDurationMs(v *int) *MediaUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *MediaUpdateOne) AddDurationMs(v int) *MediaUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *MediaUpdateOne) ClearDurationMs() *MediaUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetWaveform sets the "waveform" field.
+func (_u *MediaUpdateOne) SetWaveform(v []int) *MediaUpdateOne {
+	_u.mutation.SetWaveform(v)
+	return _u
+}
+
+// AppendWaveform appends value to the "waveform" field.
+func (_u *MediaUpdateOne) AppendWaveform(v []int) *MediaUpdateOne {
+	_u.mutation.AppendWaveform(v)
+	return _u
+}
+
+// ClearWaveform clears the value of the "waveform" field.
+func (_u *MediaUpdateOne) ClearWaveform() *MediaUpdateOne {
+	_u.mutation.ClearWaveform()
+	return _u
+}
+
 // SetMessageID sets the "message_id" field.
 func (_u *MediaUpdateOne) SetMessageID(v uuid.UUID) *MediaUpdateOne {
 	_u.mutation.SetMessageID(v)
@@ -823,11 +1082,36 @@ func (_u *MediaUpdateOne) ClearUploadedByID() *MediaUpdateOne {
 	return _u
 }
 
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (_u *MediaUpdateOne) SetScheduledMessageID(v uuid.UUID) *MediaUpdateOne {
+	_u.mutation.SetScheduledMessageID(v)
+	return _u
+}
+
+// SetNillableScheduledMessageID sets the "scheduled_message_id" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableScheduledMessageID(v *uuid.UUID) *MediaUpdateOne {
+	if v != nil {
+		_u.SetScheduledMessageID(*v)
+	}
+	return _u
+}
+
+// ClearScheduledMessageID clears the value of the "scheduled_message_id" field.
+func (_u *MediaUpdateOne) ClearScheduledMessageID() *MediaUpdateOne {
+	_u.mutation.ClearScheduledMessageID()
+	return _u
+}
+
 // SetMessage sets the "message" edge to the Message entity.
 func (_u *MediaUpdateOne) SetMessage(v *Message) *MediaUpdateOne {
 	return _u.SetMessageID(v.ID)
 }
 
+// SetScheduledMessage sets the "scheduled_message" edge to the ScheduledMessage entity.
+func (_u *MediaUpdateOne) SetScheduledMessage(v *ScheduledMessage) *MediaUpdateOne {
+	return _u.SetScheduledMessageID(v.ID)
+}
+
 // SetUserAvatarID sets the "user_avatar" edge to the User entity by ID.
 func (_u *MediaUpdateOne) SetUserAvatarID(id uuid.UUID) *MediaUpdateOne {
 	_u.mutation.SetUserAvatarID(id)
@@ -900,6 +1184,21 @@ func (_u *MediaUpdateOne) AddReports(v ...*Report) *MediaUpdateOne {
 	return _u.AddReportIDs(ids...)
 }
 
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by IDs.
+func (_u *MediaUpdateOne) AddMessageRevisionIDs(ids ...uuid.UUID) *MediaUpdateOne {
+	_u.mutation.AddMessageRevisionIDs(ids...)
+	return _u
+}
+
+// AddMessageRevisions adds the "message_revisions" edges to the MessageRevision entity.
+func (_u *MediaUpdateOne) AddMessageRevisions(v ...*MessageRevision) *MediaUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageRevisionIDs(ids...)
+}
+
 // Mutation returns the MediaMutation object of the builder.
 func (_u *MediaUpdateOne) Mutation() *MediaMutation {
 	return _u.mutation
@@ -911,6 +1210,12 @@ func (_u *MediaUpdateOne) ClearMessage() *MediaUpdateOne {
 	return _u
 }
 
+// ClearScheduledMessage clears the "scheduled_message" edge to the ScheduledMessage entity.
+func (_u *MediaUpdateOne) ClearScheduledMessage() *MediaUpdateOne {
+	_u.mutation.ClearScheduledMessage()
+	return _u
+}
+
 // ClearUserAvatar clears the "user_avatar" edge to the User entity.
 func (_u *MediaUpdateOne) ClearUserAvatar() *MediaUpdateOne {
 	_u.mutation.ClearUserAvatar()
@@ -950,6 +1255,27 @@ func (_u *MediaUpdateOne) RemoveReports(v ...*Report) *MediaUpdateOne {
 	return _u.RemoveReportIDs(ids...)
 }
 
+// ClearMessageRevisions clears all "message_revisions" edges to the MessageRevision entity.
+func (_u *MediaUpdateOne) ClearMessageRevisions() *MediaUpdateOne {
+	_u.mutation.ClearMessageRevisions()
+	return _u
+}
+
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to MessageRevision entities by IDs.
+func (_u *MediaUpdateOne) RemoveMessageRevisionIDs(ids ...uuid.UUID) *MediaUpdateOne {
+	_u.mutation.RemoveMessageRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveMessageRevisions removes "message_revisions" edges to MessageRevision entities.
+func (_u *MediaUpdateOne) RemoveMessageRevisions(v ...*MessageRevision) *MediaUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageRevisionIDs(ids...)
+}
+
 // Where appends a list predicates to the MediaUpdate builder.
 func (_u *MediaUpdateOne) Where(ps ...predicate.Media) *MediaUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1031,6 +1357,11 @@ func (_u *MediaUpdateOne) check() error {
 			return &ValidationError{Name: "upload_status", err: fmt.Errorf(`ent: validator failed for field "Media.upload_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DurationMs(); ok {
+		if err := media.DurationMsValidator(v); err != nil {
+			return &ValidationError{Name: "duration_ms", err: fmt.Errorf(`ent: validator failed for field "Media.duration_ms": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1105,6 +1436,26 @@ func (_u *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error)
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(media.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(media.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(media.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(media.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Waveform(); ok {
+		_spec.SetField(media.FieldWaveform, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWaveform(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, media.FieldWaveform, value)
+		})
+	}
+	if _u.mutation.WaveformCleared() {
+		_spec.ClearField(media.FieldWaveform, field.TypeJSON)
+	}
 	if _u.mutation.MessageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1134,6 +1485,35 @@ func (_u *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScheduledMessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.ScheduledMessageTable,
+			Columns: []string{media.ScheduledMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledMessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   media.ScheduledMessageTable,
+			Columns: []string{media.ScheduledMessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.UserAvatarCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -1266,6 +1646,51 @@ func (_u *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessageRevisionsIDs(); len(nodes) > 0 && !_u.mutation.MessageRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.MessageRevisionsTable,
+			Columns: media.MessageRevisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Media{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -5,6 +5,8 @@ package ent
 import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/schema"
 	"AtoiTalkAPI/ent/user"
 	"encoding/json"
 	"fmt"
@@ -41,6 +43,24 @@ type Message struct {
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// EditedAt holds the value of the "edited_at" field.
 	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// ForwardedFromSenderID holds the value of the "forwarded_from_sender_id" field.
+	ForwardedFromSenderID *uuid.UUID `json:"forwarded_from_sender_id,omitempty"`
+	// ForwardedFromChatID holds the value of the "forwarded_from_chat_id" field.
+	ForwardedFromChatID *uuid.UUID `json:"forwarded_from_chat_id,omitempty"`
+	// ReplyCount holds the value of the "reply_count" field.
+	ReplyCount int `json:"reply_count,omitempty"`
+	// LastReplyAt holds the value of the "last_reply_at" field.
+	LastReplyAt *time.Time `json:"last_reply_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// LinkPreview holds the value of the "link_preview" field.
+	LinkPreview *schema.LinkPreview `json:"link_preview,omitempty"`
+	// ClientMessageID holds the value of the "client_message_id" field.
+	ClientMessageID *string `json:"client_message_id,omitempty"`
+	// Location holds the value of the "location" field.
+	Location *schema.Location `json:"location,omitempty"`
+	// ContactUserID holds the value of the "contact_user_id" field.
+	ContactUserID *uuid.UUID `json:"contact_user_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MessageQuery when eager-loading is set.
 	Edges        MessageEdges `json:"edges"`
@@ -59,11 +79,27 @@ type MessageEdges struct {
 	ReplyTo *Message `json:"reply_to,omitempty"`
 	// Attachments holds the value of the attachments edge.
 	Attachments []*Media `json:"attachments,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*MessageReaction `json:"reactions,omitempty"`
+	// Pins holds the value of the pins edge.
+	Pins []*PinnedMessage `json:"pins,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MessageRevision `json:"revisions,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*MessageMention `json:"mentions,omitempty"`
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// ForwardedFromSender holds the value of the forwarded_from_sender edge.
+	ForwardedFromSender *User `json:"forwarded_from_sender,omitempty"`
+	// ForwardedFromChat holds the value of the forwarded_from_chat edge.
+	ForwardedFromChat *Chat `json:"forwarded_from_chat,omitempty"`
+	// ContactUser holds the value of the contact_user edge.
+	ContactUser *User `json:"contact_user,omitempty"`
 	// Reports holds the value of the reports edge.
 	Reports []*Report `json:"reports,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [14]bool
 }
 
 // ChatOrErr returns the Chat value or an error if the edge
@@ -117,10 +153,90 @@ func (e MessageEdges) AttachmentsOrErr() ([]*Media, error) {
 	return nil, &NotLoadedError{edge: "attachments"}
 }
 
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) ReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[5] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// PinsOrErr returns the Pins value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) PinsOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[6] {
+		return e.Pins, nil
+	}
+	return nil, &NotLoadedError{edge: "pins"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[7] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) MentionsOrErr() ([]*MessageMention, error) {
+	if e.loadedTypes[8] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// ForwardedFromSenderOrErr returns the ForwardedFromSender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ForwardedFromSenderOrErr() (*User, error) {
+	if e.ForwardedFromSender != nil {
+		return e.ForwardedFromSender, nil
+	} else if e.loadedTypes[10] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "forwarded_from_sender"}
+}
+
+// ForwardedFromChatOrErr returns the ForwardedFromChat value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ForwardedFromChatOrErr() (*Chat, error) {
+	if e.ForwardedFromChat != nil {
+		return e.ForwardedFromChat, nil
+	} else if e.loadedTypes[11] {
+		return nil, &NotFoundError{label: chat.Label}
+	}
+	return nil, &NotLoadedError{edge: "forwarded_from_chat"}
+}
+
+// ContactUserOrErr returns the ContactUser value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MessageEdges) ContactUserOrErr() (*User, error) {
+	if e.ContactUser != nil {
+		return e.ContactUser, nil
+	} else if e.loadedTypes[12] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "contact_user"}
+}
+
 // ReportsOrErr returns the Reports value or an error if the edge
 // was not loaded in eager-loading.
 func (e MessageEdges) ReportsOrErr() ([]*Report, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[13] {
 		return e.Reports, nil
 	}
 	return nil, &NotLoadedError{edge: "reports"}
@@ -131,13 +247,15 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldSenderID, message.FieldReplyToID:
+		case message.FieldSenderID, message.FieldReplyToID, message.FieldForwardedFromSenderID, message.FieldForwardedFromChatID, message.FieldContactUserID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case message.FieldActionData:
+		case message.FieldActionData, message.FieldLinkPreview, message.FieldLocation:
 			values[i] = new([]byte)
-		case message.FieldType, message.FieldContent:
+		case message.FieldReplyCount:
+			values[i] = new(sql.NullInt64)
+		case message.FieldType, message.FieldContent, message.FieldClientMessageID:
 			values[i] = new(sql.NullString)
-		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldDeletedAt, message.FieldEditedAt:
+		case message.FieldCreatedAt, message.FieldUpdatedAt, message.FieldDeletedAt, message.FieldEditedAt, message.FieldLastReplyAt, message.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case message.FieldID, message.FieldChatID:
 			values[i] = new(uuid.UUID)
@@ -229,6 +347,70 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 				_m.EditedAt = new(time.Time)
 				*_m.EditedAt = value.Time
 			}
+		case message.FieldForwardedFromSenderID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field forwarded_from_sender_id", values[i])
+			} else if value.Valid {
+				_m.ForwardedFromSenderID = new(uuid.UUID)
+				*_m.ForwardedFromSenderID = *value.S.(*uuid.UUID)
+			}
+		case message.FieldForwardedFromChatID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field forwarded_from_chat_id", values[i])
+			} else if value.Valid {
+				_m.ForwardedFromChatID = new(uuid.UUID)
+				*_m.ForwardedFromChatID = *value.S.(*uuid.UUID)
+			}
+		case message.FieldReplyCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_count", values[i])
+			} else if value.Valid {
+				_m.ReplyCount = int(value.Int64)
+			}
+		case message.FieldLastReplyAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reply_at", values[i])
+			} else if value.Valid {
+				_m.LastReplyAt = new(time.Time)
+				*_m.LastReplyAt = value.Time
+			}
+		case message.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case message.FieldLinkPreview:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field link_preview", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LinkPreview); err != nil {
+					return fmt.Errorf("unmarshal field link_preview: %w", err)
+				}
+			}
+		case message.FieldClientMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_message_id", values[i])
+			} else if value.Valid {
+				_m.ClientMessageID = new(string)
+				*_m.ClientMessageID = value.String
+			}
+		case message.FieldLocation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Location); err != nil {
+					return fmt.Errorf("unmarshal field location: %w", err)
+				}
+			}
+		case message.FieldContactUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field contact_user_id", values[i])
+			} else if value.Valid {
+				_m.ContactUserID = new(uuid.UUID)
+				*_m.ContactUserID = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -267,6 +449,46 @@ func (_m *Message) QueryAttachments() *MediaQuery {
 	return NewMessageClient(_m.config).QueryAttachments(_m)
 }
 
+// QueryReactions queries the "reactions" edge of the Message entity.
+func (_m *Message) QueryReactions() *MessageReactionQuery {
+	return NewMessageClient(_m.config).QueryReactions(_m)
+}
+
+// QueryPins queries the "pins" edge of the Message entity.
+func (_m *Message) QueryPins() *PinnedMessageQuery {
+	return NewMessageClient(_m.config).QueryPins(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Message entity.
+func (_m *Message) QueryRevisions() *MessageRevisionQuery {
+	return NewMessageClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryMentions queries the "mentions" edge of the Message entity.
+func (_m *Message) QueryMentions() *MessageMentionQuery {
+	return NewMessageClient(_m.config).QueryMentions(_m)
+}
+
+// QueryPoll queries the "poll" edge of the Message entity.
+func (_m *Message) QueryPoll() *PollQuery {
+	return NewMessageClient(_m.config).QueryPoll(_m)
+}
+
+// QueryForwardedFromSender queries the "forwarded_from_sender" edge of the Message entity.
+func (_m *Message) QueryForwardedFromSender() *UserQuery {
+	return NewMessageClient(_m.config).QueryForwardedFromSender(_m)
+}
+
+// QueryForwardedFromChat queries the "forwarded_from_chat" edge of the Message entity.
+func (_m *Message) QueryForwardedFromChat() *ChatQuery {
+	return NewMessageClient(_m.config).QueryForwardedFromChat(_m)
+}
+
+// QueryContactUser queries the "contact_user" edge of the Message entity.
+func (_m *Message) QueryContactUser() *UserQuery {
+	return NewMessageClient(_m.config).QueryContactUser(_m)
+}
+
 // QueryReports queries the "reports" edge of the Message entity.
 func (_m *Message) QueryReports() *ReportQuery {
 	return NewMessageClient(_m.config).QueryReports(_m)
@@ -334,6 +556,45 @@ func (_m *Message) String() string {
 		builder.WriteString("edited_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.ForwardedFromSenderID; v != nil {
+		builder.WriteString("forwarded_from_sender_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ForwardedFromChatID; v != nil {
+		builder.WriteString("forwarded_from_chat_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reply_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReplyCount))
+	builder.WriteString(", ")
+	if v := _m.LastReplyAt; v != nil {
+		builder.WriteString("last_reply_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("link_preview=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LinkPreview))
+	builder.WriteString(", ")
+	if v := _m.ClientMessageID; v != nil {
+		builder.WriteString("client_message_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Location))
+	builder.WriteString(", ")
+	if v := _m.ContactUserID; v != nil {
+		builder.WriteString("contact_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -36,6 +36,24 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldEditedAt holds the string denoting the edited_at field in the database.
 	FieldEditedAt = "edited_at"
+	// FieldForwardedFromSenderID holds the string denoting the forwarded_from_sender_id field in the database.
+	FieldForwardedFromSenderID = "forwarded_from_sender_id"
+	// FieldForwardedFromChatID holds the string denoting the forwarded_from_chat_id field in the database.
+	FieldForwardedFromChatID = "forwarded_from_chat_id"
+	// FieldReplyCount holds the string denoting the reply_count field in the database.
+	FieldReplyCount = "reply_count"
+	// FieldLastReplyAt holds the string denoting the last_reply_at field in the database.
+	FieldLastReplyAt = "last_reply_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldLinkPreview holds the string denoting the link_preview field in the database.
+	FieldLinkPreview = "link_preview"
+	// FieldClientMessageID holds the string denoting the client_message_id field in the database.
+	FieldClientMessageID = "client_message_id"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldContactUserID holds the string denoting the contact_user_id field in the database.
+	FieldContactUserID = "contact_user_id"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
 	EdgeChat = "chat"
 	// EdgeSender holds the string denoting the sender edge name in mutations.
@@ -46,6 +64,22 @@ const (
 	EdgeReplyTo = "reply_to"
 	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
 	EdgeAttachments = "attachments"
+	// EdgeReactions holds the string denoting the reactions edge name in mutations.
+	EdgeReactions = "reactions"
+	// EdgePins holds the string denoting the pins edge name in mutations.
+	EdgePins = "pins"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeMentions holds the string denoting the mentions edge name in mutations.
+	EdgeMentions = "mentions"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
+	// EdgeForwardedFromSender holds the string denoting the forwarded_from_sender edge name in mutations.
+	EdgeForwardedFromSender = "forwarded_from_sender"
+	// EdgeForwardedFromChat holds the string denoting the forwarded_from_chat edge name in mutations.
+	EdgeForwardedFromChat = "forwarded_from_chat"
+	// EdgeContactUser holds the string denoting the contact_user edge name in mutations.
+	EdgeContactUser = "contact_user"
 	// EdgeReports holds the string denoting the reports edge name in mutations.
 	EdgeReports = "reports"
 	// Table holds the table name of the message in the database.
@@ -79,6 +113,62 @@ const (
 	AttachmentsInverseTable = "media"
 	// AttachmentsColumn is the table column denoting the attachments relation/edge.
 	AttachmentsColumn = "message_id"
+	// ReactionsTable is the table that holds the reactions relation/edge.
+	ReactionsTable = "message_reactions"
+	// ReactionsInverseTable is the table name for the MessageReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereaction" package.
+	ReactionsInverseTable = "message_reactions"
+	// ReactionsColumn is the table column denoting the reactions relation/edge.
+	ReactionsColumn = "message_id"
+	// PinsTable is the table that holds the pins relation/edge.
+	PinsTable = "pinned_messages"
+	// PinsInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinsInverseTable = "pinned_messages"
+	// PinsColumn is the table column denoting the pins relation/edge.
+	PinsColumn = "message_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "message_revisions"
+	// RevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	RevisionsInverseTable = "message_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "message_id"
+	// MentionsTable is the table that holds the mentions relation/edge.
+	MentionsTable = "message_mentions"
+	// MentionsInverseTable is the table name for the MessageMention entity.
+	// It exists in this package in order to avoid circular dependency with the "messagemention" package.
+	MentionsInverseTable = "message_mentions"
+	// MentionsColumn is the table column denoting the mentions relation/edge.
+	MentionsColumn = "message_id"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "polls"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "message_id"
+	// ForwardedFromSenderTable is the table that holds the forwarded_from_sender relation/edge.
+	ForwardedFromSenderTable = "messages"
+	// ForwardedFromSenderInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ForwardedFromSenderInverseTable = "users"
+	// ForwardedFromSenderColumn is the table column denoting the forwarded_from_sender relation/edge.
+	ForwardedFromSenderColumn = "forwarded_from_sender_id"
+	// ForwardedFromChatTable is the table that holds the forwarded_from_chat relation/edge.
+	ForwardedFromChatTable = "messages"
+	// ForwardedFromChatInverseTable is the table name for the Chat entity.
+	// It exists in this package in order to avoid circular dependency with the "chat" package.
+	ForwardedFromChatInverseTable = "chats"
+	// ForwardedFromChatColumn is the table column denoting the forwarded_from_chat relation/edge.
+	ForwardedFromChatColumn = "forwarded_from_chat_id"
+	// ContactUserTable is the table that holds the contact_user relation/edge.
+	ContactUserTable = "messages"
+	// ContactUserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	ContactUserInverseTable = "users"
+	// ContactUserColumn is the table column denoting the contact_user relation/edge.
+	ContactUserColumn = "contact_user_id"
 	// ReportsTable is the table that holds the reports relation/edge.
 	ReportsTable = "reports"
 	// ReportsInverseTable is the table name for the Report entity.
@@ -101,6 +191,15 @@ var Columns = []string{
 	FieldActionData,
 	FieldDeletedAt,
 	FieldEditedAt,
+	FieldForwardedFromSenderID,
+	FieldForwardedFromChatID,
+	FieldReplyCount,
+	FieldLastReplyAt,
+	FieldExpiresAt,
+	FieldLinkPreview,
+	FieldClientMessageID,
+	FieldLocation,
+	FieldContactUserID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -120,6 +219,12 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultReplyCount holds the default value on creation for the "reply_count" field.
+	DefaultReplyCount int
+	// ReplyCountValidator is a validator for the "reply_count" field. It is called by the builders before save.
+	ReplyCountValidator func(int) error
+	// ClientMessageIDValidator is a validator for the "client_message_id" field. It is called by the builders before save.
+	ClientMessageIDValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -132,18 +237,26 @@ const DefaultType = TypeRegular
 
 // Type values.
 const (
-	TypeRegular           Type = "regular"
-	TypeSystemCreate      Type = "system_create"
-	TypeSystemRename      Type = "system_rename"
-	TypeSystemDescription Type = "system_description"
-	TypeSystemAvatar      Type = "system_avatar"
-	TypeSystemJoin        Type = "system_join"
-	TypeSystemAdd         Type = "system_add"
-	TypeSystemLeave       Type = "system_leave"
-	TypeSystemKick        Type = "system_kick"
-	TypeSystemPromote     Type = "system_promote"
-	TypeSystemDemote      Type = "system_demote"
-	TypeSystemVisibility  Type = "system_visibility"
+	TypeRegular            Type = "regular"
+	TypeSystemCreate       Type = "system_create"
+	TypeSystemRename       Type = "system_rename"
+	TypeSystemDescription  Type = "system_description"
+	TypeSystemAvatar       Type = "system_avatar"
+	TypeSystemJoin         Type = "system_join"
+	TypeSystemAdd          Type = "system_add"
+	TypeSystemLeave        Type = "system_leave"
+	TypeSystemKick         Type = "system_kick"
+	TypeSystemPromote      Type = "system_promote"
+	TypeSystemDemote       Type = "system_demote"
+	TypeSystemVisibility   Type = "system_visibility"
+	TypeSystemPin          Type = "system_pin"
+	TypeSystemUnpin        Type = "system_unpin"
+	TypeSystemTTL          Type = "system_ttl"
+	TypeSystemSlowMode     Type = "system_slow_mode"
+	TypeSystemJoinApproval Type = "system_join_approval"
+	TypePoll               Type = "poll"
+	TypeLocation           Type = "location"
+	TypeContact            Type = "contact"
 )
 
 func (_type Type) String() string {
@@ -153,7 +266,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeRegular, TypeSystemCreate, TypeSystemRename, TypeSystemDescription, TypeSystemAvatar, TypeSystemJoin, TypeSystemAdd, TypeSystemLeave, TypeSystemKick, TypeSystemPromote, TypeSystemDemote, TypeSystemVisibility:
+	case TypeRegular, TypeSystemCreate, TypeSystemRename, TypeSystemDescription, TypeSystemAvatar, TypeSystemJoin, TypeSystemAdd, TypeSystemLeave, TypeSystemKick, TypeSystemPromote, TypeSystemDemote, TypeSystemVisibility, TypeSystemPin, TypeSystemUnpin, TypeSystemTTL, TypeSystemSlowMode, TypeSystemJoinApproval, TypePoll, TypeLocation, TypeContact:
 		return nil
 	default:
 		return fmt.Errorf("message: invalid enum value for type field: %q", _type)
@@ -213,6 +326,41 @@ func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
 }
 
+// ByForwardedFromSenderID orders the results by the forwarded_from_sender_id field.
+func ByForwardedFromSenderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForwardedFromSenderID, opts...).ToFunc()
+}
+
+// ByForwardedFromChatID orders the results by the forwarded_from_chat_id field.
+func ByForwardedFromChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForwardedFromChatID, opts...).ToFunc()
+}
+
+// ByReplyCount orders the results by the reply_count field.
+func ByReplyCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyCount, opts...).ToFunc()
+}
+
+// ByLastReplyAt orders the results by the last_reply_at field.
+func ByLastReplyAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastReplyAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByClientMessageID orders the results by the client_message_id field.
+func ByClientMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientMessageID, opts...).ToFunc()
+}
+
+// ByContactUserID orders the results by the contact_user_id field.
+func ByContactUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContactUserID, opts...).ToFunc()
+}
+
 // ByChatField orders the results by chat field.
 func ByChatField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -262,6 +410,90 @@ func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByReactionsCount orders the results by reactions count.
+func ByReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReactionsStep(), opts...)
+	}
+}
+
+// ByReactions orders the results by reactions terms.
+func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPinsCount orders the results by pins count.
+func ByPinsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinsStep(), opts...)
+	}
+}
+
+// ByPins orders the results by pins terms.
+func ByPins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionsCount orders the results by mentions count.
+func ByMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionsStep(), opts...)
+	}
+}
+
+// ByMentions orders the results by mentions terms.
+func ByMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByForwardedFromSenderField orders the results by forwarded_from_sender field.
+func ByForwardedFromSenderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newForwardedFromSenderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByForwardedFromChatField orders the results by forwarded_from_chat field.
+func ByForwardedFromChatField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newForwardedFromChatStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByContactUserField orders the results by contact_user field.
+func ByContactUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContactUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByReportsCount orders the results by reports count.
 func ByReportsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -310,6 +542,62 @@ func newAttachmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
 	)
 }
+func newReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+	)
+}
+func newPinsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinsTable, PinsColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+	)
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PollTable, PollColumn),
+	)
+}
+func newForwardedFromSenderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ForwardedFromSenderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ForwardedFromSenderTable, ForwardedFromSenderColumn),
+	)
+}
+func newForwardedFromChatStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ForwardedFromChatInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ForwardedFromChatTable, ForwardedFromChatColumn),
+	)
+}
+func newContactUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContactUserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ContactUserTable, ContactUserColumn),
+	)
+}
 func newReportsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -96,6 +96,41 @@ func EditedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldEditedAt, v))
 }
 
+// ForwardedFromSenderID applies equality check predicate on the "forwarded_from_sender_id" field. It's identical to ForwardedFromSenderIDEQ.
+func ForwardedFromSenderID(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromSenderID, v))
+}
+
+// ForwardedFromChatID applies equality check predicate on the "forwarded_from_chat_id" field. It's identical to ForwardedFromChatIDEQ.
+func ForwardedFromChatID(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromChatID, v))
+}
+
+// ReplyCount applies equality check predicate on the "reply_count" field. It's identical to ReplyCountEQ.
+func ReplyCount(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyCount, v))
+}
+
+// LastReplyAt applies equality check predicate on the "last_reply_at" field. It's identical to LastReplyAtEQ.
+func LastReplyAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldLastReplyAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ClientMessageID applies equality check predicate on the "client_message_id" field. It's identical to ClientMessageIDEQ.
+func ClientMessageID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldClientMessageID, v))
+}
+
+// ContactUserID applies equality check predicate on the "contact_user_id" field. It's identical to ContactUserIDEQ.
+func ContactUserID(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldContactUserID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -461,6 +496,331 @@ func EditedAtNotNil() predicate.Message {
 	return predicate.Message(sql.FieldNotNull(FieldEditedAt))
 }
 
+// ForwardedFromSenderIDEQ applies the EQ predicate on the "forwarded_from_sender_id" field.
+func ForwardedFromSenderIDEQ(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromSenderID, v))
+}
+
+// ForwardedFromSenderIDNEQ applies the NEQ predicate on the "forwarded_from_sender_id" field.
+func ForwardedFromSenderIDNEQ(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldForwardedFromSenderID, v))
+}
+
+// ForwardedFromSenderIDIn applies the In predicate on the "forwarded_from_sender_id" field.
+func ForwardedFromSenderIDIn(vs ...uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldForwardedFromSenderID, vs...))
+}
+
+// ForwardedFromSenderIDNotIn applies the NotIn predicate on the "forwarded_from_sender_id" field.
+func ForwardedFromSenderIDNotIn(vs ...uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldForwardedFromSenderID, vs...))
+}
+
+// ForwardedFromSenderIDIsNil applies the IsNil predicate on the "forwarded_from_sender_id" field.
+func ForwardedFromSenderIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldForwardedFromSenderID))
+}
+
+// ForwardedFromSenderIDNotNil applies the NotNil predicate on the "forwarded_from_sender_id" field.
+func ForwardedFromSenderIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldForwardedFromSenderID))
+}
+
+// ForwardedFromChatIDEQ applies the EQ predicate on the "forwarded_from_chat_id" field.
+func ForwardedFromChatIDEQ(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldForwardedFromChatID, v))
+}
+
+// ForwardedFromChatIDNEQ applies the NEQ predicate on the "forwarded_from_chat_id" field.
+func ForwardedFromChatIDNEQ(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldForwardedFromChatID, v))
+}
+
+// ForwardedFromChatIDIn applies the In predicate on the "forwarded_from_chat_id" field.
+func ForwardedFromChatIDIn(vs ...uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldForwardedFromChatID, vs...))
+}
+
+// ForwardedFromChatIDNotIn applies the NotIn predicate on the "forwarded_from_chat_id" field.
+func ForwardedFromChatIDNotIn(vs ...uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldForwardedFromChatID, vs...))
+}
+
+// ForwardedFromChatIDIsNil applies the IsNil predicate on the "forwarded_from_chat_id" field.
+func ForwardedFromChatIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldForwardedFromChatID))
+}
+
+// ForwardedFromChatIDNotNil applies the NotNil predicate on the "forwarded_from_chat_id" field.
+func ForwardedFromChatIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldForwardedFromChatID))
+}
+
+// ReplyCountEQ applies the EQ predicate on the "reply_count" field.
+func ReplyCountEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReplyCount, v))
+}
+
+// ReplyCountNEQ applies the NEQ predicate on the "reply_count" field.
+func ReplyCountNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReplyCount, v))
+}
+
+// ReplyCountIn applies the In predicate on the "reply_count" field.
+func ReplyCountIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReplyCount, vs...))
+}
+
+// ReplyCountNotIn applies the NotIn predicate on the "reply_count" field.
+func ReplyCountNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReplyCount, vs...))
+}
+
+// ReplyCountGT applies the GT predicate on the "reply_count" field.
+func ReplyCountGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReplyCount, v))
+}
+
+// ReplyCountGTE applies the GTE predicate on the "reply_count" field.
+func ReplyCountGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReplyCount, v))
+}
+
+// ReplyCountLT applies the LT predicate on the "reply_count" field.
+func ReplyCountLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReplyCount, v))
+}
+
+// ReplyCountLTE applies the LTE predicate on the "reply_count" field.
+func ReplyCountLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReplyCount, v))
+}
+
+// LastReplyAtEQ applies the EQ predicate on the "last_reply_at" field.
+func LastReplyAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldLastReplyAt, v))
+}
+
+// LastReplyAtNEQ applies the NEQ predicate on the "last_reply_at" field.
+func LastReplyAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldLastReplyAt, v))
+}
+
+// LastReplyAtIn applies the In predicate on the "last_reply_at" field.
+func LastReplyAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldLastReplyAt, vs...))
+}
+
+// LastReplyAtNotIn applies the NotIn predicate on the "last_reply_at" field.
+func LastReplyAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldLastReplyAt, vs...))
+}
+
+// LastReplyAtGT applies the GT predicate on the "last_reply_at" field.
+func LastReplyAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldLastReplyAt, v))
+}
+
+// LastReplyAtGTE applies the GTE predicate on the "last_reply_at" field.
+func LastReplyAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldLastReplyAt, v))
+}
+
+// LastReplyAtLT applies the LT predicate on the "last_reply_at" field.
+func LastReplyAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldLastReplyAt, v))
+}
+
+// LastReplyAtLTE applies the LTE predicate on the "last_reply_at" field.
+func LastReplyAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldLastReplyAt, v))
+}
+
+// LastReplyAtIsNil applies the IsNil predicate on the "last_reply_at" field.
+func LastReplyAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldLastReplyAt))
+}
+
+// LastReplyAtNotNil applies the NotNil predicate on the "last_reply_at" field.
+func LastReplyAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldLastReplyAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// LinkPreviewIsNil applies the IsNil predicate on the "link_preview" field.
+func LinkPreviewIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldLinkPreview))
+}
+
+// LinkPreviewNotNil applies the NotNil predicate on the "link_preview" field.
+func LinkPreviewNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldLinkPreview))
+}
+
+// ClientMessageIDEQ applies the EQ predicate on the "client_message_id" field.
+func ClientMessageIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldClientMessageID, v))
+}
+
+// ClientMessageIDNEQ applies the NEQ predicate on the "client_message_id" field.
+func ClientMessageIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldClientMessageID, v))
+}
+
+// ClientMessageIDIn applies the In predicate on the "client_message_id" field.
+func ClientMessageIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldClientMessageID, vs...))
+}
+
+// ClientMessageIDNotIn applies the NotIn predicate on the "client_message_id" field.
+func ClientMessageIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldClientMessageID, vs...))
+}
+
+// ClientMessageIDGT applies the GT predicate on the "client_message_id" field.
+func ClientMessageIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldClientMessageID, v))
+}
+
+// ClientMessageIDGTE applies the GTE predicate on the "client_message_id" field.
+func ClientMessageIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldClientMessageID, v))
+}
+
+// ClientMessageIDLT applies the LT predicate on the "client_message_id" field.
+func ClientMessageIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldClientMessageID, v))
+}
+
+// ClientMessageIDLTE applies the LTE predicate on the "client_message_id" field.
+func ClientMessageIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldClientMessageID, v))
+}
+
+// ClientMessageIDContains applies the Contains predicate on the "client_message_id" field.
+func ClientMessageIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldClientMessageID, v))
+}
+
+// ClientMessageIDHasPrefix applies the HasPrefix predicate on the "client_message_id" field.
+func ClientMessageIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldClientMessageID, v))
+}
+
+// ClientMessageIDHasSuffix applies the HasSuffix predicate on the "client_message_id" field.
+func ClientMessageIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldClientMessageID, v))
+}
+
+// ClientMessageIDIsNil applies the IsNil predicate on the "client_message_id" field.
+func ClientMessageIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldClientMessageID))
+}
+
+// ClientMessageIDNotNil applies the NotNil predicate on the "client_message_id" field.
+func ClientMessageIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldClientMessageID))
+}
+
+// ClientMessageIDEqualFold applies the EqualFold predicate on the "client_message_id" field.
+func ClientMessageIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldClientMessageID, v))
+}
+
+// ClientMessageIDContainsFold applies the ContainsFold predicate on the "client_message_id" field.
+func ClientMessageIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldClientMessageID, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldLocation))
+}
+
+// ContactUserIDEQ applies the EQ predicate on the "contact_user_id" field.
+func ContactUserIDEQ(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldContactUserID, v))
+}
+
+// ContactUserIDNEQ applies the NEQ predicate on the "contact_user_id" field.
+func ContactUserIDNEQ(v uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldContactUserID, v))
+}
+
+// ContactUserIDIn applies the In predicate on the "contact_user_id" field.
+func ContactUserIDIn(vs ...uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldContactUserID, vs...))
+}
+
+// ContactUserIDNotIn applies the NotIn predicate on the "contact_user_id" field.
+func ContactUserIDNotIn(vs ...uuid.UUID) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldContactUserID, vs...))
+}
+
+// ContactUserIDIsNil applies the IsNil predicate on the "contact_user_id" field.
+func ContactUserIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldContactUserID))
+}
+
+// ContactUserIDNotNil applies the NotNil predicate on the "contact_user_id" field.
+func ContactUserIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldContactUserID))
+}
+
 // HasChat applies the HasEdge predicate on the "chat" edge.
 func HasChat() predicate.Message {
 	return predicate.Message(func(s *sql.Selector) {
@@ -576,6 +936,190 @@ func HasAttachmentsWith(preds ...predicate.Media) predicate.Message {
 	})
 }
 
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.MessageReaction) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPins applies the HasEdge predicate on the "pins" edge.
+func HasPins() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PinsTable, PinsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinsWith applies the HasEdge predicate on the "pins" edge with a given conditions (other predicates).
+func HasPinsWith(preds ...predicate.PinnedMessage) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newPinsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.MessageRevision) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentions applies the HasEdge predicate on the "mentions" edge.
+func HasMentions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
+func HasMentionsWith(preds ...predicate.MessageMention) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasForwardedFromSender applies the HasEdge predicate on the "forwarded_from_sender" edge.
+func HasForwardedFromSender() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ForwardedFromSenderTable, ForwardedFromSenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasForwardedFromSenderWith applies the HasEdge predicate on the "forwarded_from_sender" edge with a given conditions (other predicates).
+func HasForwardedFromSenderWith(preds ...predicate.User) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newForwardedFromSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasForwardedFromChat applies the HasEdge predicate on the "forwarded_from_chat" edge.
+func HasForwardedFromChat() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ForwardedFromChatTable, ForwardedFromChatColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasForwardedFromChatWith applies the HasEdge predicate on the "forwarded_from_chat" edge with a given conditions (other predicates).
+func HasForwardedFromChatWith(preds ...predicate.Chat) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newForwardedFromChatStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContactUser applies the HasEdge predicate on the "contact_user" edge.
+func HasContactUser() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ContactUserTable, ContactUserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContactUserWith applies the HasEdge predicate on the "contact_user" edge with a given conditions (other predicates).
+func HasContactUserWith(preds ...predicate.User) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newContactUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasReports applies the HasEdge predicate on the "reports" edge.
 func HasReports() predicate.Message {
 	return predicate.Message(func(s *sql.Selector) {
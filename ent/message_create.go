@@ -6,7 +6,13 @@ import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/schema"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"errors"
@@ -152,6 +158,116 @@ func (_c *MessageCreate) SetNillableEditedAt(v *time.Time) *MessageCreate {
 	return _c
 }
 
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (_c *MessageCreate) SetForwardedFromSenderID(v uuid.UUID) *MessageCreate {
+	_c.mutation.SetForwardedFromSenderID(v)
+	return _c
+}
+
+// SetNillableForwardedFromSenderID sets the "forwarded_from_sender_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableForwardedFromSenderID(v *uuid.UUID) *MessageCreate {
+	if v != nil {
+		_c.SetForwardedFromSenderID(*v)
+	}
+	return _c
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (_c *MessageCreate) SetForwardedFromChatID(v uuid.UUID) *MessageCreate {
+	_c.mutation.SetForwardedFromChatID(v)
+	return _c
+}
+
+// SetNillableForwardedFromChatID sets the "forwarded_from_chat_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableForwardedFromChatID(v *uuid.UUID) *MessageCreate {
+	if v != nil {
+		_c.SetForwardedFromChatID(*v)
+	}
+	return _c
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (_c *MessageCreate) SetReplyCount(v int) *MessageCreate {
+	_c.mutation.SetReplyCount(v)
+	return _c
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableReplyCount(v *int) *MessageCreate {
+	if v != nil {
+		_c.SetReplyCount(*v)
+	}
+	return _c
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (_c *MessageCreate) SetLastReplyAt(v time.Time) *MessageCreate {
+	_c.mutation.SetLastReplyAt(v)
+	return _c
+}
+
+// SetNillableLastReplyAt sets the "last_reply_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableLastReplyAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetLastReplyAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *MessageCreate) SetExpiresAt(v time.Time) *MessageCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableExpiresAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (_c *MessageCreate) SetLinkPreview(v *schema.LinkPreview) *MessageCreate {
+	_c.mutation.SetLinkPreview(v)
+	return _c
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (_c *MessageCreate) SetClientMessageID(v string) *MessageCreate {
+	_c.mutation.SetClientMessageID(v)
+	return _c
+}
+
+// SetNillableClientMessageID sets the "client_message_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableClientMessageID(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetClientMessageID(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *MessageCreate) SetLocation(v *schema.Location) *MessageCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (_c *MessageCreate) SetContactUserID(v uuid.UUID) *MessageCreate {
+	_c.mutation.SetContactUserID(v)
+	return _c
+}
+
+// SetNillableContactUserID sets the "contact_user_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableContactUserID(v *uuid.UUID) *MessageCreate {
+	if v != nil {
+		_c.SetContactUserID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MessageCreate) SetID(v uuid.UUID) *MessageCreate {
 	_c.mutation.SetID(v)
@@ -211,6 +327,100 @@ func (_c *MessageCreate) AddAttachments(v ...*Media) *MessageCreate {
 	return _c.AddAttachmentIDs(ids...)
 }
 
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (_c *MessageCreate) AddReactionIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddReactionIDs(ids...)
+	return _c
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (_c *MessageCreate) AddReactions(v ...*MessageReaction) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReactionIDs(ids...)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (_c *MessageCreate) AddPinIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddPinIDs(ids...)
+	return _c
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (_c *MessageCreate) AddPins(v ...*PinnedMessage) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPinIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (_c *MessageCreate) AddRevisionIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (_c *MessageCreate) AddRevisions(v ...*MessageRevision) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (_c *MessageCreate) AddMentionIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddMentionIDs(ids...)
+	return _c
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (_c *MessageCreate) AddMentions(v ...*MessageMention) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionIDs(ids...)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (_c *MessageCreate) SetPollID(id uuid.UUID) *MessageCreate {
+	_c.mutation.SetPollID(id)
+	return _c
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (_c *MessageCreate) SetNillablePollID(id *uuid.UUID) *MessageCreate {
+	if id != nil {
+		_c = _c.SetPollID(*id)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *MessageCreate) SetPoll(v *Poll) *MessageCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// SetForwardedFromSender sets the "forwarded_from_sender" edge to the User entity.
+func (_c *MessageCreate) SetForwardedFromSender(v *User) *MessageCreate {
+	return _c.SetForwardedFromSenderID(v.ID)
+}
+
+// SetForwardedFromChat sets the "forwarded_from_chat" edge to the Chat entity.
+func (_c *MessageCreate) SetForwardedFromChat(v *Chat) *MessageCreate {
+	return _c.SetForwardedFromChatID(v.ID)
+}
+
+// SetContactUser sets the "contact_user" edge to the User entity.
+func (_c *MessageCreate) SetContactUser(v *User) *MessageCreate {
+	return _c.SetContactUserID(v.ID)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_c *MessageCreate) AddReportIDs(ids ...uuid.UUID) *MessageCreate {
 	_c.mutation.AddReportIDs(ids...)
@@ -273,6 +483,10 @@ func (_c *MessageCreate) defaults() {
 		v := message.DefaultType
 		_c.mutation.SetType(v)
 	}
+	if _, ok := _c.mutation.ReplyCount(); !ok {
+		v := message.DefaultReplyCount
+		_c.mutation.SetReplyCount(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := message.DefaultID()
 		_c.mutation.SetID(v)
@@ -298,6 +512,19 @@ func (_c *MessageCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ReplyCount(); !ok {
+		return &ValidationError{Name: "reply_count", err: errors.New(`ent: missing required field "Message.reply_count"`)}
+	}
+	if v, ok := _c.mutation.ReplyCount(); ok {
+		if err := message.ReplyCountValidator(v); err != nil {
+			return &ValidationError{Name: "reply_count", err: fmt.Errorf(`ent: validator failed for field "Message.reply_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientMessageID(); ok {
+		if err := message.ClientMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_message_id", err: fmt.Errorf(`ent: validator failed for field "Message.client_message_id": %w`, err)}
+		}
+	}
 	if len(_c.mutation.ChatIDs()) == 0 {
 		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "Message.chat"`)}
 	}
@@ -365,6 +592,30 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
 		_node.EditedAt = &value
 	}
+	if value, ok := _c.mutation.ReplyCount(); ok {
+		_spec.SetField(message.FieldReplyCount, field.TypeInt, value)
+		_node.ReplyCount = value
+	}
+	if value, ok := _c.mutation.LastReplyAt(); ok {
+		_spec.SetField(message.FieldLastReplyAt, field.TypeTime, value)
+		_node.LastReplyAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(message.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.LinkPreview(); ok {
+		_spec.SetField(message.FieldLinkPreview, field.TypeJSON, value)
+		_node.LinkPreview = value
+	}
+	if value, ok := _c.mutation.ClientMessageID(); ok {
+		_spec.SetField(message.FieldClientMessageID, field.TypeString, value)
+		_node.ClientMessageID = &value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(message.FieldLocation, field.TypeJSON, value)
+		_node.Location = value
+	}
 	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -448,6 +699,137 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ForwardedFromSenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromSenderTable,
+			Columns: []string{message.ForwardedFromSenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ForwardedFromSenderID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ForwardedFromChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromChatTable,
+			Columns: []string{message.ForwardedFromChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ForwardedFromChatID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContactUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ContactUserTable,
+			Columns: []string{message.ContactUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ContactUserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ReportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -660,6 +1042,150 @@ func (u *MessageUpsert) ClearEditedAt() *MessageUpsert {
 	return u
 }
 
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (u *MessageUpsert) SetForwardedFromSenderID(v uuid.UUID) *MessageUpsert {
+	u.Set(message.FieldForwardedFromSenderID, v)
+	return u
+}
+
+// UpdateForwardedFromSenderID sets the "forwarded_from_sender_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateForwardedFromSenderID() *MessageUpsert {
+	u.SetExcluded(message.FieldForwardedFromSenderID)
+	return u
+}
+
+// ClearForwardedFromSenderID clears the value of the "forwarded_from_sender_id" field.
+func (u *MessageUpsert) ClearForwardedFromSenderID() *MessageUpsert {
+	u.SetNull(message.FieldForwardedFromSenderID)
+	return u
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (u *MessageUpsert) SetForwardedFromChatID(v uuid.UUID) *MessageUpsert {
+	u.Set(message.FieldForwardedFromChatID, v)
+	return u
+}
+
+// UpdateForwardedFromChatID sets the "forwarded_from_chat_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateForwardedFromChatID() *MessageUpsert {
+	u.SetExcluded(message.FieldForwardedFromChatID)
+	return u
+}
+
+// ClearForwardedFromChatID clears the value of the "forwarded_from_chat_id" field.
+func (u *MessageUpsert) ClearForwardedFromChatID() *MessageUpsert {
+	u.SetNull(message.FieldForwardedFromChatID)
+	return u
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (u *MessageUpsert) SetReplyCount(v int) *MessageUpsert {
+	u.Set(message.FieldReplyCount, v)
+	return u
+}
+
+// UpdateReplyCount sets the "reply_count" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateReplyCount() *MessageUpsert {
+	u.SetExcluded(message.FieldReplyCount)
+	return u
+}
+
+// AddReplyCount adds v to the "reply_count" field.
+func (u *MessageUpsert) AddReplyCount(v int) *MessageUpsert {
+	u.Add(message.FieldReplyCount, v)
+	return u
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (u *MessageUpsert) SetLastReplyAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldLastReplyAt, v)
+	return u
+}
+
+// UpdateLastReplyAt sets the "last_reply_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateLastReplyAt() *MessageUpsert {
+	u.SetExcluded(message.FieldLastReplyAt)
+	return u
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (u *MessageUpsert) ClearLastReplyAt() *MessageUpsert {
+	u.SetNull(message.FieldLastReplyAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *MessageUpsert) SetExpiresAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateExpiresAt() *MessageUpsert {
+	u.SetExcluded(message.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *MessageUpsert) ClearExpiresAt() *MessageUpsert {
+	u.SetNull(message.FieldExpiresAt)
+	return u
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (u *MessageUpsert) SetLinkPreview(v *schema.LinkPreview) *MessageUpsert {
+	u.Set(message.FieldLinkPreview, v)
+	return u
+}
+
+// UpdateLinkPreview sets the "link_preview" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateLinkPreview() *MessageUpsert {
+	u.SetExcluded(message.FieldLinkPreview)
+	return u
+}
+
+// ClearLinkPreview clears the value of the "link_preview" field.
+func (u *MessageUpsert) ClearLinkPreview() *MessageUpsert {
+	u.SetNull(message.FieldLinkPreview)
+	return u
+}
+
+// SetLocation sets the "location" field.
+func (u *MessageUpsert) SetLocation(v *schema.Location) *MessageUpsert {
+	u.Set(message.FieldLocation, v)
+	return u
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateLocation() *MessageUpsert {
+	u.SetExcluded(message.FieldLocation)
+	return u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *MessageUpsert) ClearLocation() *MessageUpsert {
+	u.SetNull(message.FieldLocation)
+	return u
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (u *MessageUpsert) SetContactUserID(v uuid.UUID) *MessageUpsert {
+	u.Set(message.FieldContactUserID, v)
+	return u
+}
+
+// UpdateContactUserID sets the "contact_user_id" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateContactUserID() *MessageUpsert {
+	u.SetExcluded(message.FieldContactUserID)
+	return u
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (u *MessageUpsert) ClearContactUserID() *MessageUpsert {
+	u.SetNull(message.FieldContactUserID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -680,6 +1206,9 @@ func (u *MessageUpsertOne) UpdateNewValues() *MessageUpsertOne {
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(message.FieldCreatedAt)
 		}
+		if _, exists := u.create.mutation.ClientMessageID(); exists {
+			s.SetIgnore(message.FieldClientMessageID)
+		}
 	}))
 	return u
 }
@@ -879,6 +1408,174 @@ func (u *MessageUpsertOne) ClearEditedAt() *MessageUpsertOne {
 	})
 }
 
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (u *MessageUpsertOne) SetForwardedFromSenderID(v uuid.UUID) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetForwardedFromSenderID(v)
+	})
+}
+
+// UpdateForwardedFromSenderID sets the "forwarded_from_sender_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateForwardedFromSenderID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateForwardedFromSenderID()
+	})
+}
+
+// ClearForwardedFromSenderID clears the value of the "forwarded_from_sender_id" field.
+func (u *MessageUpsertOne) ClearForwardedFromSenderID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearForwardedFromSenderID()
+	})
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (u *MessageUpsertOne) SetForwardedFromChatID(v uuid.UUID) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetForwardedFromChatID(v)
+	})
+}
+
+// UpdateForwardedFromChatID sets the "forwarded_from_chat_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateForwardedFromChatID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateForwardedFromChatID()
+	})
+}
+
+// ClearForwardedFromChatID clears the value of the "forwarded_from_chat_id" field.
+func (u *MessageUpsertOne) ClearForwardedFromChatID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearForwardedFromChatID()
+	})
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (u *MessageUpsertOne) SetReplyCount(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReplyCount(v)
+	})
+}
+
+// AddReplyCount adds v to the "reply_count" field.
+func (u *MessageUpsertOne) AddReplyCount(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddReplyCount(v)
+	})
+}
+
+// UpdateReplyCount sets the "reply_count" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateReplyCount() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReplyCount()
+	})
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (u *MessageUpsertOne) SetLastReplyAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetLastReplyAt(v)
+	})
+}
+
+// UpdateLastReplyAt sets the "last_reply_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateLastReplyAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateLastReplyAt()
+	})
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (u *MessageUpsertOne) ClearLastReplyAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearLastReplyAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *MessageUpsertOne) SetExpiresAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateExpiresAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *MessageUpsertOne) ClearExpiresAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (u *MessageUpsertOne) SetLinkPreview(v *schema.LinkPreview) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetLinkPreview(v)
+	})
+}
+
+// UpdateLinkPreview sets the "link_preview" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateLinkPreview() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateLinkPreview()
+	})
+}
+
+// ClearLinkPreview clears the value of the "link_preview" field.
+func (u *MessageUpsertOne) ClearLinkPreview() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearLinkPreview()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *MessageUpsertOne) SetLocation(v *schema.Location) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateLocation() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *MessageUpsertOne) ClearLocation() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearLocation()
+	})
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (u *MessageUpsertOne) SetContactUserID(v uuid.UUID) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetContactUserID(v)
+	})
+}
+
+// UpdateContactUserID sets the "contact_user_id" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateContactUserID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateContactUserID()
+	})
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (u *MessageUpsertOne) ClearContactUserID() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearContactUserID()
+	})
+}
+
 // Exec executes the query.
 func (u *MessageUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1065,6 +1762,9 @@ func (u *MessageUpsertBulk) UpdateNewValues() *MessageUpsertBulk {
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(message.FieldCreatedAt)
 			}
+			if _, exists := b.mutation.ClientMessageID(); exists {
+				s.SetIgnore(message.FieldClientMessageID)
+			}
 		}
 	}))
 	return u
@@ -1265,6 +1965,174 @@ func (u *MessageUpsertBulk) ClearEditedAt() *MessageUpsertBulk {
 	})
 }
 
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (u *MessageUpsertBulk) SetForwardedFromSenderID(v uuid.UUID) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetForwardedFromSenderID(v)
+	})
+}
+
+// UpdateForwardedFromSenderID sets the "forwarded_from_sender_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateForwardedFromSenderID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateForwardedFromSenderID()
+	})
+}
+
+// ClearForwardedFromSenderID clears the value of the "forwarded_from_sender_id" field.
+func (u *MessageUpsertBulk) ClearForwardedFromSenderID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearForwardedFromSenderID()
+	})
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (u *MessageUpsertBulk) SetForwardedFromChatID(v uuid.UUID) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetForwardedFromChatID(v)
+	})
+}
+
+// UpdateForwardedFromChatID sets the "forwarded_from_chat_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateForwardedFromChatID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateForwardedFromChatID()
+	})
+}
+
+// ClearForwardedFromChatID clears the value of the "forwarded_from_chat_id" field.
+func (u *MessageUpsertBulk) ClearForwardedFromChatID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearForwardedFromChatID()
+	})
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (u *MessageUpsertBulk) SetReplyCount(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReplyCount(v)
+	})
+}
+
+// AddReplyCount adds v to the "reply_count" field.
+func (u *MessageUpsertBulk) AddReplyCount(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddReplyCount(v)
+	})
+}
+
+// UpdateReplyCount sets the "reply_count" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateReplyCount() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReplyCount()
+	})
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (u *MessageUpsertBulk) SetLastReplyAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetLastReplyAt(v)
+	})
+}
+
+// UpdateLastReplyAt sets the "last_reply_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateLastReplyAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateLastReplyAt()
+	})
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (u *MessageUpsertBulk) ClearLastReplyAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearLastReplyAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *MessageUpsertBulk) SetExpiresAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateExpiresAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *MessageUpsertBulk) ClearExpiresAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (u *MessageUpsertBulk) SetLinkPreview(v *schema.LinkPreview) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetLinkPreview(v)
+	})
+}
+
+// UpdateLinkPreview sets the "link_preview" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateLinkPreview() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateLinkPreview()
+	})
+}
+
+// ClearLinkPreview clears the value of the "link_preview" field.
+func (u *MessageUpsertBulk) ClearLinkPreview() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearLinkPreview()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *MessageUpsertBulk) SetLocation(v *schema.Location) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateLocation() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// ClearLocation clears the value of the "location" field.
+func (u *MessageUpsertBulk) ClearLocation() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearLocation()
+	})
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (u *MessageUpsertBulk) SetContactUserID(v uuid.UUID) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetContactUserID(v)
+	})
+}
+
+// UpdateContactUserID sets the "contact_user_id" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateContactUserID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateContactUserID()
+	})
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (u *MessageUpsertBulk) ClearContactUserID() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearContactUserID()
+	})
+}
+
 // Exec executes the query.
 func (u *MessageUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -6,6 +6,11 @@ import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/report"
 	"AtoiTalkAPI/ent/user"
@@ -25,17 +30,25 @@ import (
 // MessageQuery is the builder for querying Message entities.
 type MessageQuery struct {
 	config
-	ctx             *QueryContext
-	order           []message.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.Message
-	withChat        *ChatQuery
-	withSender      *UserQuery
-	withReplies     *MessageQuery
-	withReplyTo     *MessageQuery
-	withAttachments *MediaQuery
-	withReports     *ReportQuery
-	modifiers       []func(*sql.Selector)
+	ctx                     *QueryContext
+	order                   []message.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Message
+	withChat                *ChatQuery
+	withSender              *UserQuery
+	withReplies             *MessageQuery
+	withReplyTo             *MessageQuery
+	withAttachments         *MediaQuery
+	withReactions           *MessageReactionQuery
+	withPins                *PinnedMessageQuery
+	withRevisions           *MessageRevisionQuery
+	withMentions            *MessageMentionQuery
+	withPoll                *PollQuery
+	withForwardedFromSender *UserQuery
+	withForwardedFromChat   *ChatQuery
+	withContactUser         *UserQuery
+	withReports             *ReportQuery
+	modifiers               []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -182,6 +195,182 @@ func (_q *MessageQuery) QueryAttachments() *MediaQuery {
 	return query
 }
 
+// QueryReactions chains the current query on the "reactions" edge.
+func (_q *MessageQuery) QueryReactions() *MessageReactionQuery {
+	query := (&MessageReactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.ReactionsTable, message.ReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPins chains the current query on the "pins" edge.
+func (_q *MessageQuery) QueryPins() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.PinsTable, message.PinsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *MessageQuery) QueryRevisions() *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentions chains the current query on the "mentions" edge.
+func (_q *MessageQuery) QueryMentions() *MessageMentionQuery {
+	query := (&MessageMentionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.MentionsTable, message.MentionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPoll chains the current query on the "poll" edge.
+func (_q *MessageQuery) QueryPoll() *PollQuery {
+	query := (&PollClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(poll.Table, poll.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, message.PollTable, message.PollColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryForwardedFromSender chains the current query on the "forwarded_from_sender" edge.
+func (_q *MessageQuery) QueryForwardedFromSender() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ForwardedFromSenderTable, message.ForwardedFromSenderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryForwardedFromChat chains the current query on the "forwarded_from_chat" edge.
+func (_q *MessageQuery) QueryForwardedFromChat() *ChatQuery {
+	query := (&ChatClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(chat.Table, chat.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ForwardedFromChatTable, message.ForwardedFromChatColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContactUser chains the current query on the "contact_user" edge.
+func (_q *MessageQuery) QueryContactUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, message.ContactUserTable, message.ContactUserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryReports chains the current query on the "reports" edge.
 func (_q *MessageQuery) QueryReports() *ReportQuery {
 	query := (&ReportClient{config: _q.config}).Query()
@@ -391,17 +580,25 @@ func (_q *MessageQuery) Clone() *MessageQuery {
 		return nil
 	}
 	return &MessageQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]message.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.Message{}, _q.predicates...),
-		withChat:        _q.withChat.Clone(),
-		withSender:      _q.withSender.Clone(),
-		withReplies:     _q.withReplies.Clone(),
-		withReplyTo:     _q.withReplyTo.Clone(),
-		withAttachments: _q.withAttachments.Clone(),
-		withReports:     _q.withReports.Clone(),
+		config:                  _q.config,
+		ctx:                     _q.ctx.Clone(),
+		order:                   append([]message.OrderOption{}, _q.order...),
+		inters:                  append([]Interceptor{}, _q.inters...),
+		predicates:              append([]predicate.Message{}, _q.predicates...),
+		withChat:                _q.withChat.Clone(),
+		withSender:              _q.withSender.Clone(),
+		withReplies:             _q.withReplies.Clone(),
+		withReplyTo:             _q.withReplyTo.Clone(),
+		withAttachments:         _q.withAttachments.Clone(),
+		withReactions:           _q.withReactions.Clone(),
+		withPins:                _q.withPins.Clone(),
+		withRevisions:           _q.withRevisions.Clone(),
+		withMentions:            _q.withMentions.Clone(),
+		withPoll:                _q.withPoll.Clone(),
+		withForwardedFromSender: _q.withForwardedFromSender.Clone(),
+		withForwardedFromChat:   _q.withForwardedFromChat.Clone(),
+		withContactUser:         _q.withContactUser.Clone(),
+		withReports:             _q.withReports.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -464,6 +661,94 @@ func (_q *MessageQuery) WithAttachments(opts ...func(*MediaQuery)) *MessageQuery
 	return _q
 }
 
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithReactions(opts ...func(*MessageReactionQuery)) *MessageQuery {
+	query := (&MessageReactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReactions = query
+	return _q
+}
+
+// WithPins tells the query-builder to eager-load the nodes that are connected to
+// the "pins" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithPins(opts ...func(*PinnedMessageQuery)) *MessageQuery {
+	query := (&PinnedMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPins = query
+	return _q
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithRevisions(opts ...func(*MessageRevisionQuery)) *MessageQuery {
+	query := (&MessageRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithMentions tells the query-builder to eager-load the nodes that are connected to
+// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithMentions(opts ...func(*MessageMentionQuery)) *MessageQuery {
+	query := (&MessageMentionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentions = query
+	return _q
+}
+
+// WithPoll tells the query-builder to eager-load the nodes that are connected to
+// the "poll" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithPoll(opts ...func(*PollQuery)) *MessageQuery {
+	query := (&PollClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPoll = query
+	return _q
+}
+
+// WithForwardedFromSender tells the query-builder to eager-load the nodes that are connected to
+// the "forwarded_from_sender" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithForwardedFromSender(opts ...func(*UserQuery)) *MessageQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withForwardedFromSender = query
+	return _q
+}
+
+// WithForwardedFromChat tells the query-builder to eager-load the nodes that are connected to
+// the "forwarded_from_chat" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithForwardedFromChat(opts ...func(*ChatQuery)) *MessageQuery {
+	query := (&ChatClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withForwardedFromChat = query
+	return _q
+}
+
+// WithContactUser tells the query-builder to eager-load the nodes that are connected to
+// the "contact_user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithContactUser(opts ...func(*UserQuery)) *MessageQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContactUser = query
+	return _q
+}
+
 // WithReports tells the query-builder to eager-load the nodes that are connected to
 // the "reports" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *MessageQuery) WithReports(opts ...func(*ReportQuery)) *MessageQuery {
@@ -553,12 +838,20 @@ func (_q *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 	var (
 		nodes       = []*Message{}
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [14]bool{
 			_q.withChat != nil,
 			_q.withSender != nil,
 			_q.withReplies != nil,
 			_q.withReplyTo != nil,
 			_q.withAttachments != nil,
+			_q.withReactions != nil,
+			_q.withPins != nil,
+			_q.withRevisions != nil,
+			_q.withMentions != nil,
+			_q.withPoll != nil,
+			_q.withForwardedFromSender != nil,
+			_q.withForwardedFromChat != nil,
+			_q.withContactUser != nil,
 			_q.withReports != nil,
 		}
 	)
@@ -615,6 +908,58 @@ func (_q *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 			return nil, err
 		}
 	}
+	if query := _q.withReactions; query != nil {
+		if err := _q.loadReactions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Reactions = []*MessageReaction{} },
+			func(n *Message, e *MessageReaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPins; query != nil {
+		if err := _q.loadPins(ctx, query, nodes,
+			func(n *Message) { n.Edges.Pins = []*PinnedMessage{} },
+			func(n *Message, e *PinnedMessage) { n.Edges.Pins = append(n.Edges.Pins, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Revisions = []*MessageRevision{} },
+			func(n *Message, e *MessageRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMentions; query != nil {
+		if err := _q.loadMentions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Mentions = []*MessageMention{} },
+			func(n *Message, e *MessageMention) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPoll; query != nil {
+		if err := _q.loadPoll(ctx, query, nodes, nil,
+			func(n *Message, e *Poll) { n.Edges.Poll = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withForwardedFromSender; query != nil {
+		if err := _q.loadForwardedFromSender(ctx, query, nodes, nil,
+			func(n *Message, e *User) { n.Edges.ForwardedFromSender = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withForwardedFromChat; query != nil {
+		if err := _q.loadForwardedFromChat(ctx, query, nodes, nil,
+			func(n *Message, e *Chat) { n.Edges.ForwardedFromChat = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContactUser; query != nil {
+		if err := _q.loadContactUser(ctx, query, nodes, nil,
+			func(n *Message, e *User) { n.Edges.ContactUser = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withReports; query != nil {
 		if err := _q.loadReports(ctx, query, nodes,
 			func(n *Message) { n.Edges.Reports = []*Report{} },
@@ -784,6 +1129,249 @@ func (_q *MessageQuery) loadAttachments(ctx context.Context, query *MediaQuery,
 	}
 	return nil
 }
+func (_q *MessageQuery) loadReactions(ctx context.Context, query *MessageReactionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagereaction.FieldMessageID)
+	}
+	query.Where(predicate.MessageReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadPins(ctx context.Context, query *PinnedMessageQuery, nodes []*Message, init func(*Message), assign func(*Message, *PinnedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldMessageID)
+	}
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.PinsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadRevisions(ctx context.Context, query *MessageRevisionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagerevision.FieldMessageID)
+	}
+	query.Where(predicate.MessageRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadMentions(ctx context.Context, query *MessageMentionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageMention)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagemention.FieldMessageID)
+	}
+	query.Where(predicate.MessageMention(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.MentionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadPoll(ctx context.Context, query *PollQuery, nodes []*Message, init func(*Message), assign func(*Message, *Poll)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(poll.FieldMessageID)
+	}
+	query.Where(predicate.Poll(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.PollColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadForwardedFromSender(ctx context.Context, query *UserQuery, nodes []*Message, init func(*Message), assign func(*Message, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Message)
+	for i := range nodes {
+		if nodes[i].ForwardedFromSenderID == nil {
+			continue
+		}
+		fk := *nodes[i].ForwardedFromSenderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "forwarded_from_sender_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *MessageQuery) loadForwardedFromChat(ctx context.Context, query *ChatQuery, nodes []*Message, init func(*Message), assign func(*Message, *Chat)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Message)
+	for i := range nodes {
+		if nodes[i].ForwardedFromChatID == nil {
+			continue
+		}
+		fk := *nodes[i].ForwardedFromChatID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(chat.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "forwarded_from_chat_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *MessageQuery) loadContactUser(ctx context.Context, query *UserQuery, nodes []*Message, init func(*Message), assign func(*Message, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Message)
+	for i := range nodes {
+		if nodes[i].ContactUserID == nil {
+			continue
+		}
+		fk := *nodes[i].ContactUserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "contact_user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *MessageQuery) loadReports(ctx context.Context, query *ReportQuery, nodes []*Message, init func(*Message), assign func(*Message, *Report)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Message)
@@ -856,6 +1444,15 @@ func (_q *MessageQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withReplyTo != nil {
 			_spec.Node.AddColumnOnce(message.FieldReplyToID)
 		}
+		if _q.withForwardedFromSender != nil {
+			_spec.Node.AddColumnOnce(message.FieldForwardedFromSenderID)
+		}
+		if _q.withForwardedFromChat != nil {
+			_spec.Node.AddColumnOnce(message.FieldForwardedFromChatID)
+		}
+		if _q.withContactUser != nil {
+			_spec.Node.AddColumnOnce(message.FieldContactUserID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,8 +6,14 @@ import (
 	"AtoiTalkAPI/ent/chat"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/schema"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"errors"
@@ -180,6 +186,151 @@ func (_u *MessageUpdate) ClearEditedAt() *MessageUpdate {
 	return _u
 }
 
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (_u *MessageUpdate) SetForwardedFromSenderID(v uuid.UUID) *MessageUpdate {
+	_u.mutation.SetForwardedFromSenderID(v)
+	return _u
+}
+
+// SetNillableForwardedFromSenderID sets the "forwarded_from_sender_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableForwardedFromSenderID(v *uuid.UUID) *MessageUpdate {
+	if v != nil {
+		_u.SetForwardedFromSenderID(*v)
+	}
+	return _u
+}
+
+// ClearForwardedFromSenderID clears the value of the "forwarded_from_sender_id" field.
+func (_u *MessageUpdate) ClearForwardedFromSenderID() *MessageUpdate {
+	_u.mutation.ClearForwardedFromSenderID()
+	return _u
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (_u *MessageUpdate) SetForwardedFromChatID(v uuid.UUID) *MessageUpdate {
+	_u.mutation.SetForwardedFromChatID(v)
+	return _u
+}
+
+// SetNillableForwardedFromChatID sets the "forwarded_from_chat_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableForwardedFromChatID(v *uuid.UUID) *MessageUpdate {
+	if v != nil {
+		_u.SetForwardedFromChatID(*v)
+	}
+	return _u
+}
+
+// ClearForwardedFromChatID clears the value of the "forwarded_from_chat_id" field.
+func (_u *MessageUpdate) ClearForwardedFromChatID() *MessageUpdate {
+	_u.mutation.ClearForwardedFromChatID()
+	return _u
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (_u *MessageUpdate) SetReplyCount(v int) *MessageUpdate {
+	_u.mutation.ResetReplyCount()
+	_u.mutation.SetReplyCount(v)
+	return _u
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableReplyCount(v *int) *MessageUpdate {
+	if v != nil {
+		_u.SetReplyCount(*v)
+	}
+	return _u
+}
+
+// AddReplyCount adds value to the "reply_count" field.
+func (_u *MessageUpdate) AddReplyCount(v int) *MessageUpdate {
+	_u.mutation.AddReplyCount(v)
+	return _u
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (_u *MessageUpdate) SetLastReplyAt(v time.Time) *MessageUpdate {
+	_u.mutation.SetLastReplyAt(v)
+	return _u
+}
+
+// SetNillableLastReplyAt sets the "last_reply_at" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableLastReplyAt(v *time.Time) *MessageUpdate {
+	if v != nil {
+		_u.SetLastReplyAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (_u *MessageUpdate) ClearLastReplyAt() *MessageUpdate {
+	_u.mutation.ClearLastReplyAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MessageUpdate) SetExpiresAt(v time.Time) *MessageUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableExpiresAt(v *time.Time) *MessageUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *MessageUpdate) ClearExpiresAt() *MessageUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (_u *MessageUpdate) SetLinkPreview(v *schema.LinkPreview) *MessageUpdate {
+	_u.mutation.SetLinkPreview(v)
+	return _u
+}
+
+// ClearLinkPreview clears the value of the "link_preview" field.
+func (_u *MessageUpdate) ClearLinkPreview() *MessageUpdate {
+	_u.mutation.ClearLinkPreview()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *MessageUpdate) SetLocation(v *schema.Location) *MessageUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *MessageUpdate) ClearLocation() *MessageUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (_u *MessageUpdate) SetContactUserID(v uuid.UUID) *MessageUpdate {
+	_u.mutation.SetContactUserID(v)
+	return _u
+}
+
+// SetNillableContactUserID sets the "contact_user_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableContactUserID(v *uuid.UUID) *MessageUpdate {
+	if v != nil {
+		_u.SetContactUserID(*v)
+	}
+	return _u
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (_u *MessageUpdate) ClearContactUserID() *MessageUpdate {
+	_u.mutation.ClearContactUserID()
+	return _u
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (_u *MessageUpdate) SetChat(v *Chat) *MessageUpdate {
 	return _u.SetChatID(v.ID)
@@ -225,6 +376,100 @@ func (_u *MessageUpdate) AddAttachments(v ...*Media) *MessageUpdate {
 	return _u.AddAttachmentIDs(ids...)
 }
 
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (_u *MessageUpdate) AddReactionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddReactionIDs(ids...)
+	return _u
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (_u *MessageUpdate) AddReactions(v ...*MessageReaction) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactionIDs(ids...)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (_u *MessageUpdate) AddPinIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddPinIDs(ids...)
+	return _u
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (_u *MessageUpdate) AddPins(v ...*PinnedMessage) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (_u *MessageUpdate) AddRevisionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdate) AddRevisions(v ...*MessageRevision) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (_u *MessageUpdate) AddMentionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (_u *MessageUpdate) AddMentions(v ...*MessageMention) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (_u *MessageUpdate) SetPollID(id uuid.UUID) *MessageUpdate {
+	_u.mutation.SetPollID(id)
+	return _u
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (_u *MessageUpdate) SetNillablePollID(id *uuid.UUID) *MessageUpdate {
+	if id != nil {
+		_u = _u.SetPollID(*id)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *MessageUpdate) SetPoll(v *Poll) *MessageUpdate {
+	return _u.SetPollID(v.ID)
+}
+
+// SetForwardedFromSender sets the "forwarded_from_sender" edge to the User entity.
+func (_u *MessageUpdate) SetForwardedFromSender(v *User) *MessageUpdate {
+	return _u.SetForwardedFromSenderID(v.ID)
+}
+
+// SetForwardedFromChat sets the "forwarded_from_chat" edge to the Chat entity.
+func (_u *MessageUpdate) SetForwardedFromChat(v *Chat) *MessageUpdate {
+	return _u.SetForwardedFromChatID(v.ID)
+}
+
+// SetContactUser sets the "contact_user" edge to the User entity.
+func (_u *MessageUpdate) SetContactUser(v *User) *MessageUpdate {
+	return _u.SetContactUserID(v.ID)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_u *MessageUpdate) AddReportIDs(ids ...uuid.UUID) *MessageUpdate {
 	_u.mutation.AddReportIDs(ids...)
@@ -305,6 +550,114 @@ func (_u *MessageUpdate) RemoveAttachments(v ...*Media) *MessageUpdate {
 	return _u.RemoveAttachmentIDs(ids...)
 }
 
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (_u *MessageUpdate) ClearReactions() *MessageUpdate {
+	_u.mutation.ClearReactions()
+	return _u
+}
+
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (_u *MessageUpdate) RemoveReactionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemoveReactionIDs(ids...)
+	return _u
+}
+
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (_u *MessageUpdate) RemoveReactions(v ...*MessageReaction) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReactionIDs(ids...)
+}
+
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (_u *MessageUpdate) ClearPins() *MessageUpdate {
+	_u.mutation.ClearPins()
+	return _u
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (_u *MessageUpdate) RemovePinIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemovePinIDs(ids...)
+	return _u
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (_u *MessageUpdate) RemovePins(v ...*PinnedMessage) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdate) ClearRevisions() *MessageUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (_u *MessageUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (_u *MessageUpdate) RemoveRevisions(v ...*MessageRevision) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (_u *MessageUpdate) ClearMentions() *MessageUpdate {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (_u *MessageUpdate) RemoveMentionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (_u *MessageUpdate) RemoveMentions(v ...*MessageMention) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *MessageUpdate) ClearPoll() *MessageUpdate {
+	_u.mutation.ClearPoll()
+	return _u
+}
+
+// ClearForwardedFromSender clears the "forwarded_from_sender" edge to the User entity.
+func (_u *MessageUpdate) ClearForwardedFromSender() *MessageUpdate {
+	_u.mutation.ClearForwardedFromSender()
+	return _u
+}
+
+// ClearForwardedFromChat clears the "forwarded_from_chat" edge to the Chat entity.
+func (_u *MessageUpdate) ClearForwardedFromChat() *MessageUpdate {
+	_u.mutation.ClearForwardedFromChat()
+	return _u
+}
+
+// ClearContactUser clears the "contact_user" edge to the User entity.
+func (_u *MessageUpdate) ClearContactUser() *MessageUpdate {
+	_u.mutation.ClearContactUser()
+	return _u
+}
+
 // ClearReports clears all "reports" edges to the Report entity.
 func (_u *MessageUpdate) ClearReports() *MessageUpdate {
 	_u.mutation.ClearReports()
@@ -369,6 +722,11 @@ func (_u *MessageUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReplyCount(); ok {
+		if err := message.ReplyCountValidator(v); err != nil {
+			return &ValidationError{Name: "reply_count", err: fmt.Errorf(`ent: validator failed for field "Message.reply_count": %w`, err)}
+		}
+	}
 	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Message.chat"`)
 	}
@@ -423,6 +781,39 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EditedAtCleared() {
 		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ReplyCount(); ok {
+		_spec.SetField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplyCount(); ok {
+		_spec.AddField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastReplyAt(); ok {
+		_spec.SetField(message.FieldLastReplyAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReplyAtCleared() {
+		_spec.ClearField(message.FieldLastReplyAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(message.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(message.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LinkPreview(); ok {
+		_spec.SetField(message.FieldLinkPreview, field.TypeJSON, value)
+	}
+	if _u.mutation.LinkPreviewCleared() {
+		_spec.ClearField(message.FieldLinkPreview, field.TypeJSON)
+	}
+	if _u.mutation.ClientMessageIDCleared() {
+		_spec.ClearField(message.FieldClientMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(message.FieldLocation, field.TypeJSON, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(message.FieldLocation, field.TypeJSON)
+	}
 	if _u.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -600,28 +991,28 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsCleared() {
+	if _u.mutation.ReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   message.ReportsTable,
-			Columns: []string{message.ReportsColumn},
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
+	if nodes := _u.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !_u.mutation.ReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   message.ReportsTable,
-			Columns: []string{message.ReportsColumn},
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -629,15 +1020,15 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   message.ReportsTable,
-			Columns: []string{message.ReportsColumn},
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -645,37 +1036,333 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{message.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// MessageUpdateOne is the builder for updating a single Message entity.
-type MessageUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *MessageMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *MessageUpdateOne) SetUpdatedAt(v time.Time) *MessageUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetChatID sets the "chat_id" field.
-func (_u *MessageUpdateOne) SetChatID(v uuid.UUID) *MessageUpdateOne {
-	_u.mutation.SetChatID(v)
+	if nodes := _u.mutation.RemovedPinsIDs(); len(nodes) > 0 && !_u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ForwardedFromSenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromSenderTable,
+			Columns: []string{message.ForwardedFromSenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ForwardedFromSenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromSenderTable,
+			Columns: []string{message.ForwardedFromSenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ForwardedFromChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromChatTable,
+			Columns: []string{message.ForwardedFromChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ForwardedFromChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromChatTable,
+			Columns: []string{message.ForwardedFromChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContactUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ContactUserTable,
+			Columns: []string{message.ContactUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContactUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ContactUserTable,
+			Columns: []string{message.ContactUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReportsTable,
+			Columns: []string{message.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportsIDs(); len(nodes) > 0 && !_u.mutation.ReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReportsTable,
+			Columns: []string{message.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.ReportsTable,
+			Columns: []string{message.ReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{message.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageUpdateOne is the builder for updating a single Message entity.
+type MessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageUpdateOne) SetUpdatedAt(v time.Time) *MessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *MessageUpdateOne) SetChatID(v uuid.UUID) *MessageUpdateOne {
+	_u.mutation.SetChatID(v)
 	return _u
 }
 
@@ -813,6 +1500,151 @@ func (_u *MessageUpdateOne) ClearEditedAt() *MessageUpdateOne {
 	return _u
 }
 
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (_u *MessageUpdateOne) SetForwardedFromSenderID(v uuid.UUID) *MessageUpdateOne {
+	_u.mutation.SetForwardedFromSenderID(v)
+	return _u
+}
+
+// SetNillableForwardedFromSenderID sets the "forwarded_from_sender_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableForwardedFromSenderID(v *uuid.UUID) *MessageUpdateOne {
+	if v != nil {
+		_u.SetForwardedFromSenderID(*v)
+	}
+	return _u
+}
+
+// ClearForwardedFromSenderID clears the value of the "forwarded_from_sender_id" field.
+func (_u *MessageUpdateOne) ClearForwardedFromSenderID() *MessageUpdateOne {
+	_u.mutation.ClearForwardedFromSenderID()
+	return _u
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (_u *MessageUpdateOne) SetForwardedFromChatID(v uuid.UUID) *MessageUpdateOne {
+	_u.mutation.SetForwardedFromChatID(v)
+	return _u
+}
+
+// SetNillableForwardedFromChatID sets the "forwarded_from_chat_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableForwardedFromChatID(v *uuid.UUID) *MessageUpdateOne {
+	if v != nil {
+		_u.SetForwardedFromChatID(*v)
+	}
+	return _u
+}
+
+// ClearForwardedFromChatID clears the value of the "forwarded_from_chat_id" field.
+func (_u *MessageUpdateOne) ClearForwardedFromChatID() *MessageUpdateOne {
+	_u.mutation.ClearForwardedFromChatID()
+	return _u
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (_u *MessageUpdateOne) SetReplyCount(v int) *MessageUpdateOne {
+	_u.mutation.ResetReplyCount()
+	_u.mutation.SetReplyCount(v)
+	return _u
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableReplyCount(v *int) *MessageUpdateOne {
+	if v != nil {
+		_u.SetReplyCount(*v)
+	}
+	return _u
+}
+
+// AddReplyCount adds value to the "reply_count" field.
+func (_u *MessageUpdateOne) AddReplyCount(v int) *MessageUpdateOne {
+	_u.mutation.AddReplyCount(v)
+	return _u
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (_u *MessageUpdateOne) SetLastReplyAt(v time.Time) *MessageUpdateOne {
+	_u.mutation.SetLastReplyAt(v)
+	return _u
+}
+
+// SetNillableLastReplyAt sets the "last_reply_at" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableLastReplyAt(v *time.Time) *MessageUpdateOne {
+	if v != nil {
+		_u.SetLastReplyAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (_u *MessageUpdateOne) ClearLastReplyAt() *MessageUpdateOne {
+	_u.mutation.ClearLastReplyAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *MessageUpdateOne) SetExpiresAt(v time.Time) *MessageUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableExpiresAt(v *time.Time) *MessageUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *MessageUpdateOne) ClearExpiresAt() *MessageUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (_u *MessageUpdateOne) SetLinkPreview(v *schema.LinkPreview) *MessageUpdateOne {
+	_u.mutation.SetLinkPreview(v)
+	return _u
+}
+
+// ClearLinkPreview clears the value of the "link_preview" field.
+func (_u *MessageUpdateOne) ClearLinkPreview() *MessageUpdateOne {
+	_u.mutation.ClearLinkPreview()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *MessageUpdateOne) SetLocation(v *schema.Location) *MessageUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *MessageUpdateOne) ClearLocation() *MessageUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (_u *MessageUpdateOne) SetContactUserID(v uuid.UUID) *MessageUpdateOne {
+	_u.mutation.SetContactUserID(v)
+	return _u
+}
+
+// SetNillableContactUserID sets the "contact_user_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableContactUserID(v *uuid.UUID) *MessageUpdateOne {
+	if v != nil {
+		_u.SetContactUserID(*v)
+	}
+	return _u
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (_u *MessageUpdateOne) ClearContactUserID() *MessageUpdateOne {
+	_u.mutation.ClearContactUserID()
+	return _u
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (_u *MessageUpdateOne) SetChat(v *Chat) *MessageUpdateOne {
 	return _u.SetChatID(v.ID)
@@ -858,6 +1690,100 @@ func (_u *MessageUpdateOne) AddAttachments(v ...*Media) *MessageUpdateOne {
 	return _u.AddAttachmentIDs(ids...)
 }
 
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by IDs.
+func (_u *MessageUpdateOne) AddReactionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddReactionIDs(ids...)
+	return _u
+}
+
+// AddReactions adds the "reactions" edges to the MessageReaction entity.
+func (_u *MessageUpdateOne) AddReactions(v ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactionIDs(ids...)
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by IDs.
+func (_u *MessageUpdateOne) AddPinIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddPinIDs(ids...)
+	return _u
+}
+
+// AddPins adds the "pins" edges to the PinnedMessage entity.
+func (_u *MessageUpdateOne) AddPins(v ...*PinnedMessage) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (_u *MessageUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdateOne) AddRevisions(v ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (_u *MessageUpdateOne) AddMentionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (_u *MessageUpdateOne) AddMentions(v ...*MessageMention) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by ID.
+func (_u *MessageUpdateOne) SetPollID(id uuid.UUID) *MessageUpdateOne {
+	_u.mutation.SetPollID(id)
+	return _u
+}
+
+// SetNillablePollID sets the "poll" edge to the Poll entity by ID if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillablePollID(id *uuid.UUID) *MessageUpdateOne {
+	if id != nil {
+		_u = _u.SetPollID(*id)
+	}
+	return _u
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_u *MessageUpdateOne) SetPoll(v *Poll) *MessageUpdateOne {
+	return _u.SetPollID(v.ID)
+}
+
+// SetForwardedFromSender sets the "forwarded_from_sender" edge to the User entity.
+func (_u *MessageUpdateOne) SetForwardedFromSender(v *User) *MessageUpdateOne {
+	return _u.SetForwardedFromSenderID(v.ID)
+}
+
+// SetForwardedFromChat sets the "forwarded_from_chat" edge to the Chat entity.
+func (_u *MessageUpdateOne) SetForwardedFromChat(v *Chat) *MessageUpdateOne {
+	return _u.SetForwardedFromChatID(v.ID)
+}
+
+// SetContactUser sets the "contact_user" edge to the User entity.
+func (_u *MessageUpdateOne) SetContactUser(v *User) *MessageUpdateOne {
+	return _u.SetContactUserID(v.ID)
+}
+
 // AddReportIDs adds the "reports" edge to the Report entity by IDs.
 func (_u *MessageUpdateOne) AddReportIDs(ids ...uuid.UUID) *MessageUpdateOne {
 	_u.mutation.AddReportIDs(ids...)
@@ -896,46 +1822,154 @@ func (_u *MessageUpdateOne) ClearReplies() *MessageUpdateOne {
 	return _u
 }
 
-// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
-func (_u *MessageUpdateOne) RemoveReplyIDs(ids ...uuid.UUID) *MessageUpdateOne {
-	_u.mutation.RemoveReplyIDs(ids...)
+// RemoveReplyIDs removes the "replies" edge to Message entities by IDs.
+func (_u *MessageUpdateOne) RemoveReplyIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to Message entities.
+func (_u *MessageUpdateOne) RemoveReplies(v ...*Message) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (_u *MessageUpdateOne) ClearReplyTo() *MessageUpdateOne {
+	_u.mutation.ClearReplyTo()
+	return _u
+}
+
+// ClearAttachments clears all "attachments" edges to the Media entity.
+func (_u *MessageUpdateOne) ClearAttachments() *MessageUpdateOne {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Media entities by IDs.
+func (_u *MessageUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to Media entities.
+func (_u *MessageUpdateOne) RemoveAttachments(v ...*Media) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the MessageReaction entity.
+func (_u *MessageUpdateOne) ClearReactions() *MessageUpdateOne {
+	_u.mutation.ClearReactions()
+	return _u
+}
+
+// RemoveReactionIDs removes the "reactions" edge to MessageReaction entities by IDs.
+func (_u *MessageUpdateOne) RemoveReactionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveReactionIDs(ids...)
+	return _u
+}
+
+// RemoveReactions removes "reactions" edges to MessageReaction entities.
+func (_u *MessageUpdateOne) RemoveReactions(v ...*MessageReaction) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReactionIDs(ids...)
+}
+
+// ClearPins clears all "pins" edges to the PinnedMessage entity.
+func (_u *MessageUpdateOne) ClearPins() *MessageUpdateOne {
+	_u.mutation.ClearPins()
+	return _u
+}
+
+// RemovePinIDs removes the "pins" edge to PinnedMessage entities by IDs.
+func (_u *MessageUpdateOne) RemovePinIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemovePinIDs(ids...)
+	return _u
+}
+
+// RemovePins removes "pins" edges to PinnedMessage entities.
+func (_u *MessageUpdateOne) RemovePins(v ...*PinnedMessage) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdateOne) ClearRevisions() *MessageUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (_u *MessageUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (_u *MessageUpdateOne) RemoveRevisions(v ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (_u *MessageUpdateOne) ClearMentions() *MessageUpdateOne {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (_u *MessageUpdateOne) RemoveMentionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveMentionIDs(ids...)
 	return _u
 }
 
-// RemoveReplies removes "replies" edges to Message entities.
-func (_u *MessageUpdateOne) RemoveReplies(v ...*Message) *MessageUpdateOne {
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (_u *MessageUpdateOne) RemoveMentions(v ...*MessageMention) *MessageUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReplyIDs(ids...)
+	return _u.RemoveMentionIDs(ids...)
 }
 
-// ClearReplyTo clears the "reply_to" edge to the Message entity.
-func (_u *MessageUpdateOne) ClearReplyTo() *MessageUpdateOne {
-	_u.mutation.ClearReplyTo()
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (_u *MessageUpdateOne) ClearPoll() *MessageUpdateOne {
+	_u.mutation.ClearPoll()
 	return _u
 }
 
-// ClearAttachments clears all "attachments" edges to the Media entity.
-func (_u *MessageUpdateOne) ClearAttachments() *MessageUpdateOne {
-	_u.mutation.ClearAttachments()
+// ClearForwardedFromSender clears the "forwarded_from_sender" edge to the User entity.
+func (_u *MessageUpdateOne) ClearForwardedFromSender() *MessageUpdateOne {
+	_u.mutation.ClearForwardedFromSender()
 	return _u
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to Media entities by IDs.
-func (_u *MessageUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *MessageUpdateOne {
-	_u.mutation.RemoveAttachmentIDs(ids...)
+// ClearForwardedFromChat clears the "forwarded_from_chat" edge to the Chat entity.
+func (_u *MessageUpdateOne) ClearForwardedFromChat() *MessageUpdateOne {
+	_u.mutation.ClearForwardedFromChat()
 	return _u
 }
 
-// RemoveAttachments removes "attachments" edges to Media entities.
-func (_u *MessageUpdateOne) RemoveAttachments(v ...*Media) *MessageUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemoveAttachmentIDs(ids...)
+// ClearContactUser clears the "contact_user" edge to the User entity.
+func (_u *MessageUpdateOne) ClearContactUser() *MessageUpdateOne {
+	_u.mutation.ClearContactUser()
+	return _u
 }
 
 // ClearReports clears all "reports" edges to the Report entity.
@@ -1015,6 +2049,11 @@ func (_u *MessageUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Message.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReplyCount(); ok {
+		if err := message.ReplyCountValidator(v); err != nil {
+			return &ValidationError{Name: "reply_count", err: fmt.Errorf(`ent: validator failed for field "Message.reply_count": %w`, err)}
+		}
+	}
 	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Message.chat"`)
 	}
@@ -1086,6 +2125,39 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 	if _u.mutation.EditedAtCleared() {
 		_spec.ClearField(message.FieldEditedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ReplyCount(); ok {
+		_spec.SetField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplyCount(); ok {
+		_spec.AddField(message.FieldReplyCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastReplyAt(); ok {
+		_spec.SetField(message.FieldLastReplyAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReplyAtCleared() {
+		_spec.ClearField(message.FieldLastReplyAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(message.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(message.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LinkPreview(); ok {
+		_spec.SetField(message.FieldLinkPreview, field.TypeJSON, value)
+	}
+	if _u.mutation.LinkPreviewCleared() {
+		_spec.ClearField(message.FieldLinkPreview, field.TypeJSON)
+	}
+	if _u.mutation.ClientMessageIDCleared() {
+		_spec.ClearField(message.FieldClientMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(message.FieldLocation, field.TypeJSON, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(message.FieldLocation, field.TypeJSON)
+	}
 	if _u.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1263,6 +2335,302 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !_u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.ReactionsTable,
+			Columns: []string{message.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinsIDs(); len(nodes) > 0 && !_u.mutation.PinsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.PinsTable,
+			Columns: []string{message.PinsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   message.MentionsTable,
+			Columns: []string{message.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   message.PollTable,
+			Columns: []string{message.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ForwardedFromSenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromSenderTable,
+			Columns: []string{message.ForwardedFromSenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ForwardedFromSenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromSenderTable,
+			Columns: []string{message.ForwardedFromSenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ForwardedFromChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromChatTable,
+			Columns: []string{message.ForwardedFromChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ForwardedFromChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ForwardedFromChatTable,
+			Columns: []string{message.ForwardedFromChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContactUserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ContactUserTable,
+			Columns: []string{message.ContactUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContactUserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   message.ContactUserTable,
+			Columns: []string{message.ContactUserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ReportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
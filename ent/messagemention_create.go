@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageMentionCreate is the builder for creating a MessageMention entity.
+type MessageMentionCreate struct {
+	config
+	mutation *MessageMentionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageMentionCreate) SetCreatedAt(v time.Time) *MessageMentionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageMentionCreate) SetNillableCreatedAt(v *time.Time) *MessageMentionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MessageMentionCreate) SetUpdatedAt(v time.Time) *MessageMentionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MessageMentionCreate) SetNillableUpdatedAt(v *time.Time) *MessageMentionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *MessageMentionCreate) SetMessageID(v uuid.UUID) *MessageMentionCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MessageMentionCreate) SetUserID(v uuid.UUID) *MessageMentionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *MessageMentionCreate) SetNillableUserID(v *uuid.UUID) *MessageMentionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *MessageMentionCreate) SetType(v messagemention.Type) *MessageMentionCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetOffset sets the "offset" field.
+func (_c *MessageMentionCreate) SetOffset(v int) *MessageMentionCreate {
+	_c.mutation.SetOffset(v)
+	return _c
+}
+
+// SetLength sets the "length" field.
+func (_c *MessageMentionCreate) SetLength(v int) *MessageMentionCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MessageMentionCreate) SetID(v uuid.UUID) *MessageMentionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MessageMentionCreate) SetNillableID(v *uuid.UUID) *MessageMentionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *MessageMentionCreate) SetMessage(v *Message) *MessageMentionCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *MessageMentionCreate) SetUser(v *User) *MessageMentionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (_c *MessageMentionCreate) Mutation() *MessageMentionMutation {
+	return _c.mutation
+}
+
+// Save creates the MessageMention in the database.
+func (_c *MessageMentionCreate) Save(ctx context.Context) (*MessageMention, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageMentionCreate) SaveX(ctx context.Context) *MessageMention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageMentionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageMentionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageMentionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := messagemention.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := messagemention.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := messagemention.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageMentionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageMention.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageMention.updated_at"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageMention.message_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "MessageMention.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := messagemention.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MessageMention.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "MessageMention.offset"`)}
+	}
+	if v, ok := _c.mutation.Offset(); ok {
+		if err := messagemention.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "MessageMention.offset": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "MessageMention.length"`)}
+	}
+	if v, ok := _c.mutation.Length(); ok {
+		if err := messagemention.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "MessageMention.length": %w`, err)}
+		}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageMention.message"`)}
+	}
+	return nil
+}
+
+func (_c *MessageMentionCreate) sqlSave(ctx context.Context) (*MessageMention, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageMentionCreate) createSpec() (*MessageMention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageMention{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(messagemention.Table, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(messagemention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagemention.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(messagemention.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+		_node.Offset = value
+	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+		_node.Length = value
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageMention.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageMentionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageMentionCreate) OnConflict(opts ...sql.ConflictOption) *MessageMentionUpsertOne {
+	_c.conflict = opts
+	return &MessageMentionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageMention.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageMentionCreate) OnConflictColumns(columns ...string) *MessageMentionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageMentionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MessageMentionUpsertOne is the builder for "upsert"-ing
+	//  one MessageMention node.
+	MessageMentionUpsertOne struct {
+		create *MessageMentionCreate
+	}
+
+	// MessageMentionUpsert is the "OnConflict" setter.
+	MessageMentionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageMentionUpsert) SetUpdatedAt(v time.Time) *MessageMentionUpsert {
+	u.Set(messagemention.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageMentionUpsert) UpdateUpdatedAt() *MessageMentionUpsert {
+	u.SetExcluded(messagemention.FieldUpdatedAt)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageMentionUpsert) SetMessageID(v uuid.UUID) *MessageMentionUpsert {
+	u.Set(messagemention.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageMentionUpsert) UpdateMessageID() *MessageMentionUpsert {
+	u.SetExcluded(messagemention.FieldMessageID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MessageMentionUpsert) SetUserID(v uuid.UUID) *MessageMentionUpsert {
+	u.Set(messagemention.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MessageMentionUpsert) UpdateUserID() *MessageMentionUpsert {
+	u.SetExcluded(messagemention.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *MessageMentionUpsert) ClearUserID() *MessageMentionUpsert {
+	u.SetNull(messagemention.FieldUserID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *MessageMentionUpsert) SetType(v messagemention.Type) *MessageMentionUpsert {
+	u.Set(messagemention.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MessageMentionUpsert) UpdateType() *MessageMentionUpsert {
+	u.SetExcluded(messagemention.FieldType)
+	return u
+}
+
+// SetOffset sets the "offset" field.
+func (u *MessageMentionUpsert) SetOffset(v int) *MessageMentionUpsert {
+	u.Set(messagemention.FieldOffset, v)
+	return u
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *MessageMentionUpsert) UpdateOffset() *MessageMentionUpsert {
+	u.SetExcluded(messagemention.FieldOffset)
+	return u
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *MessageMentionUpsert) AddOffset(v int) *MessageMentionUpsert {
+	u.Add(messagemention.FieldOffset, v)
+	return u
+}
+
+// SetLength sets the "length" field.
+func (u *MessageMentionUpsert) SetLength(v int) *MessageMentionUpsert {
+	u.Set(messagemention.FieldLength, v)
+	return u
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *MessageMentionUpsert) UpdateLength() *MessageMentionUpsert {
+	u.SetExcluded(messagemention.FieldLength)
+	return u
+}
+
+// AddLength adds v to the "length" field.
+func (u *MessageMentionUpsert) AddLength(v int) *MessageMentionUpsert {
+	u.Add(messagemention.FieldLength, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageMention.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagemention.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageMentionUpsertOne) UpdateNewValues() *MessageMentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagemention.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagemention.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageMention.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageMentionUpsertOne) Ignore() *MessageMentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageMentionUpsertOne) DoNothing() *MessageMentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageMentionCreate.OnConflict
+// documentation for more info.
+func (u *MessageMentionUpsertOne) Update(set func(*MessageMentionUpsert)) *MessageMentionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageMentionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageMentionUpsertOne) SetUpdatedAt(v time.Time) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageMentionUpsertOne) UpdateUpdatedAt() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageMentionUpsertOne) SetMessageID(v uuid.UUID) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageMentionUpsertOne) UpdateMessageID() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MessageMentionUpsertOne) SetUserID(v uuid.UUID) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MessageMentionUpsertOne) UpdateUserID() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *MessageMentionUpsertOne) ClearUserID() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *MessageMentionUpsertOne) SetType(v messagemention.Type) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MessageMentionUpsertOne) UpdateType() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetOffset sets the "offset" field.
+func (u *MessageMentionUpsertOne) SetOffset(v int) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetOffset(v)
+	})
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *MessageMentionUpsertOne) AddOffset(v int) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.AddOffset(v)
+	})
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *MessageMentionUpsertOne) UpdateOffset() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateOffset()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *MessageMentionUpsertOne) SetLength(v int) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *MessageMentionUpsertOne) AddLength(v int) *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *MessageMentionUpsertOne) UpdateLength() *MessageMentionUpsertOne {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageMentionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageMentionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageMentionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageMentionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MessageMentionUpsertOne.ID is not supported by MySQL driver. Use MessageMentionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageMentionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageMentionCreateBulk is the builder for creating many MessageMention entities in bulk.
+type MessageMentionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageMentionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageMention entities in the database.
+func (_c *MessageMentionCreateBulk) Save(ctx context.Context) ([]*MessageMention, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MessageMention, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageMentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageMentionCreateBulk) SaveX(ctx context.Context) []*MessageMention {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageMentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageMentionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageMention.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageMentionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageMentionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageMentionUpsertBulk {
+	_c.conflict = opts
+	return &MessageMentionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageMention.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageMentionCreateBulk) OnConflictColumns(columns ...string) *MessageMentionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageMentionUpsertBulk{
+		create: _c,
+	}
+}
+
+// MessageMentionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageMention nodes.
+type MessageMentionUpsertBulk struct {
+	create *MessageMentionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageMention.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagemention.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageMentionUpsertBulk) UpdateNewValues() *MessageMentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagemention.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagemention.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageMention.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageMentionUpsertBulk) Ignore() *MessageMentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageMentionUpsertBulk) DoNothing() *MessageMentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageMentionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageMentionUpsertBulk) Update(set func(*MessageMentionUpsert)) *MessageMentionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageMentionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageMentionUpsertBulk) SetUpdatedAt(v time.Time) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageMentionUpsertBulk) UpdateUpdatedAt() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageMentionUpsertBulk) SetMessageID(v uuid.UUID) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageMentionUpsertBulk) UpdateMessageID() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MessageMentionUpsertBulk) SetUserID(v uuid.UUID) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MessageMentionUpsertBulk) UpdateUserID() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *MessageMentionUpsertBulk) ClearUserID() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *MessageMentionUpsertBulk) SetType(v messagemention.Type) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *MessageMentionUpsertBulk) UpdateType() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetOffset sets the "offset" field.
+func (u *MessageMentionUpsertBulk) SetOffset(v int) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetOffset(v)
+	})
+}
+
+// AddOffset adds v to the "offset" field.
+func (u *MessageMentionUpsertBulk) AddOffset(v int) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.AddOffset(v)
+	})
+}
+
+// UpdateOffset sets the "offset" field to the value that was provided on create.
+func (u *MessageMentionUpsertBulk) UpdateOffset() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateOffset()
+	})
+}
+
+// SetLength sets the "length" field.
+func (u *MessageMentionUpsertBulk) SetLength(v int) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.SetLength(v)
+	})
+}
+
+// AddLength adds v to the "length" field.
+func (u *MessageMentionUpsertBulk) AddLength(v int) *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.AddLength(v)
+	})
+}
+
+// UpdateLength sets the "length" field to the value that was provided on create.
+func (u *MessageMentionUpsertBulk) UpdateLength() *MessageMentionUpsertBulk {
+	return u.Update(func(s *MessageMentionUpsert) {
+		s.UpdateLength()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageMentionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageMentionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageMentionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageMentionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
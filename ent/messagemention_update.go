@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageMentionUpdate is the builder for updating MessageMention entities.
+type MessageMentionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MessageMentionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MessageMentionUpdate builder.
+func (_u *MessageMentionUpdate) Where(ps ...predicate.MessageMention) *MessageMentionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageMentionUpdate) SetUpdatedAt(v time.Time) *MessageMentionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *MessageMentionUpdate) SetMessageID(v uuid.UUID) *MessageMentionUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *MessageMentionUpdate) SetNillableMessageID(v *uuid.UUID) *MessageMentionUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MessageMentionUpdate) SetUserID(v uuid.UUID) *MessageMentionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MessageMentionUpdate) SetNillableUserID(v *uuid.UUID) *MessageMentionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *MessageMentionUpdate) ClearUserID() *MessageMentionUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *MessageMentionUpdate) SetType(v messagemention.Type) *MessageMentionUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *MessageMentionUpdate) SetNillableType(v *messagemention.Type) *MessageMentionUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *MessageMentionUpdate) SetOffset(v int) *MessageMentionUpdate {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *MessageMentionUpdate) SetNillableOffset(v *int) *MessageMentionUpdate {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *MessageMentionUpdate) AddOffset(v int) *MessageMentionUpdate {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *MessageMentionUpdate) SetLength(v int) *MessageMentionUpdate {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *MessageMentionUpdate) SetNillableLength(v *int) *MessageMentionUpdate {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *MessageMentionUpdate) AddLength(v int) *MessageMentionUpdate {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *MessageMentionUpdate) SetMessage(v *Message) *MessageMentionUpdate {
+	return _u.SetMessageID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *MessageMentionUpdate) SetUser(v *User) *MessageMentionUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (_u *MessageMentionUpdate) Mutation() *MessageMentionMutation {
+	return _u.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *MessageMentionUpdate) ClearMessage() *MessageMentionUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *MessageMentionUpdate) ClearUser() *MessageMentionUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MessageMentionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageMentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MessageMentionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageMentionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MessageMentionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := messagemention.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageMentionUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := messagemention.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MessageMention.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Offset(); ok {
+		if err := messagemention.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "MessageMention.offset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Length(); ok {
+		if err := messagemention.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "MessageMention.length": %w`, err)}
+		}
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageMention.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MessageMentionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageMentionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MessageMentionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagemention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(messagemention.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageMentionUpdateOne is the builder for updating a single MessageMention entity.
+type MessageMentionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageMentionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageMentionUpdateOne) SetUpdatedAt(v time.Time) *MessageMentionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *MessageMentionUpdateOne) SetMessageID(v uuid.UUID) *MessageMentionUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *MessageMentionUpdateOne) SetNillableMessageID(v *uuid.UUID) *MessageMentionUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *MessageMentionUpdateOne) SetUserID(v uuid.UUID) *MessageMentionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *MessageMentionUpdateOne) SetNillableUserID(v *uuid.UUID) *MessageMentionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *MessageMentionUpdateOne) ClearUserID() *MessageMentionUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *MessageMentionUpdateOne) SetType(v messagemention.Type) *MessageMentionUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *MessageMentionUpdateOne) SetNillableType(v *messagemention.Type) *MessageMentionUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOffset sets the "offset" field.
+func (_u *MessageMentionUpdateOne) SetOffset(v int) *MessageMentionUpdateOne {
+	_u.mutation.ResetOffset()
+	_u.mutation.SetOffset(v)
+	return _u
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (_u *MessageMentionUpdateOne) SetNillableOffset(v *int) *MessageMentionUpdateOne {
+	if v != nil {
+		_u.SetOffset(*v)
+	}
+	return _u
+}
+
+// AddOffset adds value to the "offset" field.
+func (_u *MessageMentionUpdateOne) AddOffset(v int) *MessageMentionUpdateOne {
+	_u.mutation.AddOffset(v)
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *MessageMentionUpdateOne) SetLength(v int) *MessageMentionUpdateOne {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *MessageMentionUpdateOne) SetNillableLength(v *int) *MessageMentionUpdateOne {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *MessageMentionUpdateOne) AddLength(v int) *MessageMentionUpdateOne {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *MessageMentionUpdateOne) SetMessage(v *Message) *MessageMentionUpdateOne {
+	return _u.SetMessageID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *MessageMentionUpdateOne) SetUser(v *User) *MessageMentionUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the MessageMentionMutation object of the builder.
+func (_u *MessageMentionUpdateOne) Mutation() *MessageMentionMutation {
+	return _u.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *MessageMentionUpdateOne) ClearMessage() *MessageMentionUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *MessageMentionUpdateOne) ClearUser() *MessageMentionUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the MessageMentionUpdate builder.
+func (_u *MessageMentionUpdateOne) Where(ps ...predicate.MessageMention) *MessageMentionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MessageMentionUpdateOne) Select(field string, fields ...string) *MessageMentionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MessageMention entity.
+func (_u *MessageMentionUpdateOne) Save(ctx context.Context) (*MessageMention, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageMentionUpdateOne) SaveX(ctx context.Context) *MessageMention {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MessageMentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageMentionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MessageMentionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := messagemention.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageMentionUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := messagemention.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "MessageMention.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Offset(); ok {
+		if err := messagemention.OffsetValidator(v); err != nil {
+			return &ValidationError{Name: "offset", err: fmt.Errorf(`ent: validator failed for field "MessageMention.offset": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Length(); ok {
+		if err := messagemention.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "MessageMention.length": %w`, err)}
+		}
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MessageMention.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MessageMentionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageMentionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MessageMentionUpdateOne) sqlSave(ctx context.Context) (_node *MessageMention, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagemention.Table, messagemention.Columns, sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageMention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagemention.FieldID)
+		for _, f := range fields {
+			if !messagemention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagemention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagemention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(messagemention.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Offset(); ok {
+		_spec.SetField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOffset(); ok {
+		_spec.AddField(messagemention.FieldOffset, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(messagemention.FieldLength, field.TypeInt, value)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.MessageTable,
+			Columns: []string{messagemention.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagemention.UserTable,
+			Columns: []string{messagemention.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MessageMention{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagemention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,760 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageReactionCreate is the builder for creating a MessageReaction entity.
+type MessageReactionCreate struct {
+	config
+	mutation *MessageReactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageReactionCreate) SetCreatedAt(v time.Time) *MessageReactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageReactionCreate) SetNillableCreatedAt(v *time.Time) *MessageReactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MessageReactionCreate) SetUpdatedAt(v time.Time) *MessageReactionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MessageReactionCreate) SetNillableUpdatedAt(v *time.Time) *MessageReactionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *MessageReactionCreate) SetMessageID(v uuid.UUID) *MessageReactionCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MessageReactionCreate) SetUserID(v uuid.UUID) *MessageReactionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEmoji sets the "emoji" field.
+func (_c *MessageReactionCreate) SetEmoji(v string) *MessageReactionCreate {
+	_c.mutation.SetEmoji(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MessageReactionCreate) SetID(v uuid.UUID) *MessageReactionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MessageReactionCreate) SetNillableID(v *uuid.UUID) *MessageReactionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *MessageReactionCreate) SetMessage(v *Message) *MessageReactionCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *MessageReactionCreate) SetUser(v *User) *MessageReactionCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the MessageReactionMutation object of the builder.
+func (_c *MessageReactionCreate) Mutation() *MessageReactionMutation {
+	return _c.mutation
+}
+
+// Save creates the MessageReaction in the database.
+func (_c *MessageReactionCreate) Save(ctx context.Context) (*MessageReaction, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageReactionCreate) SaveX(ctx context.Context) *MessageReaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageReactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageReactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageReactionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := messagereaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := messagereaction.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := messagereaction.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageReactionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageReaction.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageReaction.updated_at"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageReaction.message_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MessageReaction.user_id"`)}
+	}
+	if _, ok := _c.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "MessageReaction.emoji"`)}
+	}
+	if v, ok := _c.mutation.Emoji(); ok {
+		if err := messagereaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "MessageReaction.emoji": %w`, err)}
+		}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageReaction.message"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MessageReaction.user"`)}
+	}
+	return nil
+}
+
+func (_c *MessageReactionCreate) sqlSave(ctx context.Context) (*MessageReaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageReactionCreate) createSpec() (*MessageReaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageReaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(messagereaction.Table, sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(messagereaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagereaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Emoji(); ok {
+		_spec.SetField(messagereaction.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagereaction.MessageTable,
+			Columns: []string{messagereaction.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagereaction.UserTable,
+			Columns: []string{messagereaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageReaction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageReactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageReactionCreate) OnConflict(opts ...sql.ConflictOption) *MessageReactionUpsertOne {
+	_c.conflict = opts
+	return &MessageReactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageReactionCreate) OnConflictColumns(columns ...string) *MessageReactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageReactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MessageReactionUpsertOne is the builder for "upsert"-ing
+	//  one MessageReaction node.
+	MessageReactionUpsertOne struct {
+		create *MessageReactionCreate
+	}
+
+	// MessageReactionUpsert is the "OnConflict" setter.
+	MessageReactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageReactionUpsert) SetUpdatedAt(v time.Time) *MessageReactionUpsert {
+	u.Set(messagereaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageReactionUpsert) UpdateUpdatedAt() *MessageReactionUpsert {
+	u.SetExcluded(messagereaction.FieldUpdatedAt)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageReactionUpsert) SetMessageID(v uuid.UUID) *MessageReactionUpsert {
+	u.Set(messagereaction.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageReactionUpsert) UpdateMessageID() *MessageReactionUpsert {
+	u.SetExcluded(messagereaction.FieldMessageID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MessageReactionUpsert) SetUserID(v uuid.UUID) *MessageReactionUpsert {
+	u.Set(messagereaction.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MessageReactionUpsert) UpdateUserID() *MessageReactionUpsert {
+	u.SetExcluded(messagereaction.FieldUserID)
+	return u
+}
+
+// SetEmoji sets the "emoji" field.
+func (u *MessageReactionUpsert) SetEmoji(v string) *MessageReactionUpsert {
+	u.Set(messagereaction.FieldEmoji, v)
+	return u
+}
+
+// UpdateEmoji sets the "emoji" field to the value that was provided on create.
+func (u *MessageReactionUpsert) UpdateEmoji() *MessageReactionUpsert {
+	u.SetExcluded(messagereaction.FieldEmoji)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagereaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageReactionUpsertOne) UpdateNewValues() *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagereaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagereaction.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageReactionUpsertOne) Ignore() *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageReactionUpsertOne) DoNothing() *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageReactionCreate.OnConflict
+// documentation for more info.
+func (u *MessageReactionUpsertOne) Update(set func(*MessageReactionUpsert)) *MessageReactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageReactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageReactionUpsertOne) SetUpdatedAt(v time.Time) *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageReactionUpsertOne) UpdateUpdatedAt() *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageReactionUpsertOne) SetMessageID(v uuid.UUID) *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageReactionUpsertOne) UpdateMessageID() *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MessageReactionUpsertOne) SetUserID(v uuid.UUID) *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MessageReactionUpsertOne) UpdateUserID() *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmoji sets the "emoji" field.
+func (u *MessageReactionUpsertOne) SetEmoji(v string) *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetEmoji(v)
+	})
+}
+
+// UpdateEmoji sets the "emoji" field to the value that was provided on create.
+func (u *MessageReactionUpsertOne) UpdateEmoji() *MessageReactionUpsertOne {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateEmoji()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageReactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageReactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageReactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageReactionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MessageReactionUpsertOne.ID is not supported by MySQL driver. Use MessageReactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageReactionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageReactionCreateBulk is the builder for creating many MessageReaction entities in bulk.
+type MessageReactionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageReactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageReaction entities in the database.
+func (_c *MessageReactionCreateBulk) Save(ctx context.Context) ([]*MessageReaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MessageReaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageReactionCreateBulk) SaveX(ctx context.Context) []*MessageReaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageReaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageReactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageReactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageReactionUpsertBulk {
+	_c.conflict = opts
+	return &MessageReactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageReactionCreateBulk) OnConflictColumns(columns ...string) *MessageReactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageReactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// MessageReactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageReaction nodes.
+type MessageReactionUpsertBulk struct {
+	create *MessageReactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagereaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageReactionUpsertBulk) UpdateNewValues() *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagereaction.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagereaction.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageReaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageReactionUpsertBulk) Ignore() *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageReactionUpsertBulk) DoNothing() *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageReactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageReactionUpsertBulk) Update(set func(*MessageReactionUpsert)) *MessageReactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageReactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageReactionUpsertBulk) SetUpdatedAt(v time.Time) *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageReactionUpsertBulk) UpdateUpdatedAt() *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageReactionUpsertBulk) SetMessageID(v uuid.UUID) *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageReactionUpsertBulk) UpdateMessageID() *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *MessageReactionUpsertBulk) SetUserID(v uuid.UUID) *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *MessageReactionUpsertBulk) UpdateUserID() *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmoji sets the "emoji" field.
+func (u *MessageReactionUpsertBulk) SetEmoji(v string) *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.SetEmoji(v)
+	})
+}
+
+// UpdateEmoji sets the "emoji" field to the value that was provided on create.
+func (u *MessageReactionUpsertBulk) UpdateEmoji() *MessageReactionUpsertBulk {
+	return u.Update(func(s *MessageReactionUpsert) {
+		s.UpdateEmoji()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageReactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageReactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageReactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageReactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
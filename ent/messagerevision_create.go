@@ -0,0 +1,737 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagerevision"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageRevisionCreate is the builder for creating a MessageRevision entity.
+type MessageRevisionCreate struct {
+	config
+	mutation *MessageRevisionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageRevisionCreate) SetCreatedAt(v time.Time) *MessageRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageRevisionCreate) SetNillableCreatedAt(v *time.Time) *MessageRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MessageRevisionCreate) SetUpdatedAt(v time.Time) *MessageRevisionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MessageRevisionCreate) SetNillableUpdatedAt(v *time.Time) *MessageRevisionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *MessageRevisionCreate) SetMessageID(v uuid.UUID) *MessageRevisionCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *MessageRevisionCreate) SetContent(v string) *MessageRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *MessageRevisionCreate) SetNillableContent(v *string) *MessageRevisionCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MessageRevisionCreate) SetID(v uuid.UUID) *MessageRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MessageRevisionCreate) SetNillableID(v *uuid.UUID) *MessageRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *MessageRevisionCreate) SetMessage(v *Message) *MessageRevisionCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by IDs.
+func (_c *MessageRevisionCreate) AddAttachmentIDs(ids ...uuid.UUID) *MessageRevisionCreate {
+	_c.mutation.AddAttachmentIDs(ids...)
+	return _c
+}
+
+// AddAttachments adds the "attachments" edges to the Media entity.
+func (_c *MessageRevisionCreate) AddAttachments(v ...*Media) *MessageRevisionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the MessageRevisionMutation object of the builder.
+func (_c *MessageRevisionCreate) Mutation() *MessageRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the MessageRevision in the database.
+func (_c *MessageRevisionCreate) Save(ctx context.Context) (*MessageRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageRevisionCreate) SaveX(ctx context.Context) *MessageRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := messagerevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := messagerevision.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := messagerevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MessageRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MessageRevision.updated_at"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "MessageRevision.message_id"`)}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "MessageRevision.message"`)}
+	}
+	return nil
+}
+
+func (_c *MessageRevisionCreate) sqlSave(ctx context.Context) (*MessageRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageRevisionCreate) createSpec() (*MessageRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(messagerevision.Table, sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(messagerevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagerevision.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(messagerevision.FieldContent, field.TypeString, value)
+		_node.Content = &value
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   messagerevision.MessageTable,
+			Columns: []string{messagerevision.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   messagerevision.AttachmentsTable,
+			Columns: messagerevision.AttachmentsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageRevision.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageRevisionCreate) OnConflict(opts ...sql.ConflictOption) *MessageRevisionUpsertOne {
+	_c.conflict = opts
+	return &MessageRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageRevisionCreate) OnConflictColumns(columns ...string) *MessageRevisionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageRevisionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MessageRevisionUpsertOne is the builder for "upsert"-ing
+	//  one MessageRevision node.
+	MessageRevisionUpsertOne struct {
+		create *MessageRevisionCreate
+	}
+
+	// MessageRevisionUpsert is the "OnConflict" setter.
+	MessageRevisionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageRevisionUpsert) SetUpdatedAt(v time.Time) *MessageRevisionUpsert {
+	u.Set(messagerevision.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageRevisionUpsert) UpdateUpdatedAt() *MessageRevisionUpsert {
+	u.SetExcluded(messagerevision.FieldUpdatedAt)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageRevisionUpsert) SetMessageID(v uuid.UUID) *MessageRevisionUpsert {
+	u.Set(messagerevision.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageRevisionUpsert) UpdateMessageID() *MessageRevisionUpsert {
+	u.SetExcluded(messagerevision.FieldMessageID)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *MessageRevisionUpsert) SetContent(v string) *MessageRevisionUpsert {
+	u.Set(messagerevision.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *MessageRevisionUpsert) UpdateContent() *MessageRevisionUpsert {
+	u.SetExcluded(messagerevision.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *MessageRevisionUpsert) ClearContent() *MessageRevisionUpsert {
+	u.SetNull(messagerevision.FieldContent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagerevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageRevisionUpsertOne) UpdateNewValues() *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagerevision.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagerevision.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageRevisionUpsertOne) Ignore() *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageRevisionUpsertOne) DoNothing() *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageRevisionCreate.OnConflict
+// documentation for more info.
+func (u *MessageRevisionUpsertOne) Update(set func(*MessageRevisionUpsert)) *MessageRevisionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageRevisionUpsertOne) SetUpdatedAt(v time.Time) *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageRevisionUpsertOne) UpdateUpdatedAt() *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageRevisionUpsertOne) SetMessageID(v uuid.UUID) *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageRevisionUpsertOne) UpdateMessageID() *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *MessageRevisionUpsertOne) SetContent(v string) *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *MessageRevisionUpsertOne) UpdateContent() *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *MessageRevisionUpsertOne) ClearContent() *MessageRevisionUpsertOne {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageRevisionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageRevisionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageRevisionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageRevisionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: MessageRevisionUpsertOne.ID is not supported by MySQL driver. Use MessageRevisionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageRevisionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageRevisionCreateBulk is the builder for creating many MessageRevision entities in bulk.
+type MessageRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*MessageRevisionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageRevision entities in the database.
+func (_c *MessageRevisionCreateBulk) Save(ctx context.Context) ([]*MessageRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MessageRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageRevisionCreateBulk) SaveX(ctx context.Context) []*MessageRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageRevision.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageRevisionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageRevisionCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageRevisionUpsertBulk {
+	_c.conflict = opts
+	return &MessageRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageRevisionCreateBulk) OnConflictColumns(columns ...string) *MessageRevisionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageRevisionUpsertBulk{
+		create: _c,
+	}
+}
+
+// MessageRevisionUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageRevision nodes.
+type MessageRevisionUpsertBulk struct {
+	create *MessageRevisionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagerevision.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageRevisionUpsertBulk) UpdateNewValues() *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagerevision.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagerevision.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageRevision.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageRevisionUpsertBulk) Ignore() *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageRevisionUpsertBulk) DoNothing() *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageRevisionCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageRevisionUpsertBulk) Update(set func(*MessageRevisionUpsert)) *MessageRevisionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageRevisionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageRevisionUpsertBulk) SetUpdatedAt(v time.Time) *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageRevisionUpsertBulk) UpdateUpdatedAt() *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *MessageRevisionUpsertBulk) SetMessageID(v uuid.UUID) *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *MessageRevisionUpsertBulk) UpdateMessageID() *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *MessageRevisionUpsertBulk) SetContent(v string) *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *MessageRevisionUpsertBulk) UpdateContent() *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *MessageRevisionUpsertBulk) ClearContent() *MessageRevisionUpsertBulk {
+	return u.Update(func(s *MessageRevisionUpsert) {
+		s.ClearContent()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageRevisionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageRevisionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageRevisionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageRevisionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -16,6 +16,7 @@ var (
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"private", "group"}},
 		{Name: "last_message_at", Type: field.TypeTime, Nullable: true},
+		{Name: "message_ttl", Type: field.TypeInt, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_message_id", Type: field.TypeUUID, Nullable: true},
 	}
@@ -27,7 +28,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "chats_messages_last_message",
-				Columns:    []*schema.Column{ChatsColumns[6]},
+				Columns:    []*schema.Column{ChatsColumns[7]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -45,12 +46,142 @@ var (
 			},
 		},
 	}
+	// ChatExportsColumns holds the columns for the "chat_exports" table.
+	ChatExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed"}, Default: "pending"},
+		{Name: "file_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "file_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "message_count", Type: field.TypeInt, Default: 0},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ChatExportsTable holds the schema information for the "chat_exports" table.
+	ChatExportsTable = &schema.Table{
+		Name:       "chat_exports",
+		Columns:    ChatExportsColumns,
+		PrimaryKey: []*schema.Column{ChatExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_exports_chats_exports",
+				Columns:    []*schema.Column{ChatExportsColumns[10]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "chat_exports_users_chat_exports",
+				Columns:    []*schema.Column{ChatExportsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatexport_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatExportsColumns[3], ChatExportsColumns[2]},
+			},
+			{
+				Name:    "chatexport_user_id_chat_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatExportsColumns[11], ChatExportsColumns[10]},
+			},
+			{
+				Name:    "chatexport_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ChatExportsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "expires_at IS NOT NULL",
+				},
+			},
+		},
+	}
+	// ChatFoldersColumns holds the columns for the "chat_folders" table.
+	ChatFoldersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "name", Type: field.TypeString, Size: 32},
+		{Name: "chat_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"private", "group"}},
+		{Name: "unread_only", Type: field.TypeBool, Default: false},
+		{Name: "muted", Type: field.TypeBool, Nullable: true},
+		{Name: "archived", Type: field.TypeBool, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ChatFoldersTable holds the schema information for the "chat_folders" table.
+	ChatFoldersTable = &schema.Table{
+		Name:       "chat_folders",
+		Columns:    ChatFoldersColumns,
+		PrimaryKey: []*schema.Column{ChatFoldersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_folders_users_chat_folders",
+				Columns:    []*schema.Column{ChatFoldersColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatfolder_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatFoldersColumns[8]},
+			},
+		},
+	}
+	// ChatFolderChatsColumns holds the columns for the "chat_folder_chats" table.
+	ChatFolderChatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "folder_id", Type: field.TypeUUID},
+	}
+	// ChatFolderChatsTable holds the schema information for the "chat_folder_chats" table.
+	ChatFolderChatsTable = &schema.Table{
+		Name:       "chat_folder_chats",
+		Columns:    ChatFolderChatsColumns,
+		PrimaryKey: []*schema.Column{ChatFolderChatsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_folder_chats_chats_folders",
+				Columns:    []*schema.Column{ChatFolderChatsColumns[3]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "chat_folder_chats_chat_folders_chats",
+				Columns:    []*schema.Column{ChatFolderChatsColumns[4]},
+				RefColumns: []*schema.Column{ChatFoldersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatfolderchat_folder_id_chat_id",
+				Unique:  true,
+				Columns: []*schema.Column{ChatFolderChatsColumns[4], ChatFolderChatsColumns[3]},
+			},
+			{
+				Name:    "chatfolderchat_chat_id",
+				Unique:  false,
+				Columns: []*schema.Column{ChatFolderChatsColumns[3]},
+			},
+		},
+	}
 	// GroupChatsColumns holds the columns for the "group_chats" table.
 	GroupChatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "slow_mode_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "requires_approval", Type: field.TypeBool, Default: false},
 		{Name: "invite_code", Type: field.TypeString, Unique: true, Size: 50},
 		{Name: "invite_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "chat_id", Type: field.TypeUUID, Unique: true},
@@ -65,24 +196,68 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "group_chats_chats_group_chat",
-				Columns:    []*schema.Column{GroupChatsColumns[6]},
+				Columns:    []*schema.Column{GroupChatsColumns[8]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "group_chats_media_group_avatar",
-				Columns:    []*schema.Column{GroupChatsColumns[7]},
+				Columns:    []*schema.Column{GroupChatsColumns[9]},
 				RefColumns: []*schema.Column{MediaColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "group_chats_users_created_groups",
-				Columns:    []*schema.Column{GroupChatsColumns[8]},
+				Columns:    []*schema.Column{GroupChatsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// GroupJoinRequestsColumns holds the columns for the "group_join_requests" table.
+	GroupJoinRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "declined"}, Default: "pending"},
+		{Name: "requested_at", Type: field.TypeTime},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "group_chat_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// GroupJoinRequestsTable holds the schema information for the "group_join_requests" table.
+	GroupJoinRequestsTable = &schema.Table{
+		Name:       "group_join_requests",
+		Columns:    GroupJoinRequestsColumns,
+		PrimaryKey: []*schema.Column{GroupJoinRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_join_requests_group_chats_join_requests",
+				Columns:    []*schema.Column{GroupJoinRequestsColumns[7]},
+				RefColumns: []*schema.Column{GroupChatsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_join_requests_users_group_join_requests",
+				Columns:    []*schema.Column{GroupJoinRequestsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "groupjoinrequest_group_chat_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{GroupJoinRequestsColumns[7], GroupJoinRequestsColumns[8]},
+			},
+			{
+				Name:    "groupjoinrequest_group_chat_id_status_requested_at",
+				Unique:  false,
+				Columns: []*schema.Column{GroupJoinRequestsColumns[7], GroupJoinRequestsColumns[3], GroupJoinRequestsColumns[4]},
+			},
+		},
+	}
 	// GroupMembersColumns holds the columns for the "group_members" table.
 	GroupMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -90,7 +265,16 @@ var (
 		{Name: "last_read_at", Type: field.TypeTime, Nullable: true},
 		{Name: "joined_at", Type: field.TypeTime},
 		{Name: "unread_count", Type: field.TypeInt, Default: 0},
+		{Name: "unread_mention_count", Type: field.TypeInt, Default: 0},
+		{Name: "marked_unread", Type: field.TypeBool, Default: false},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "keep_archived", Type: field.TypeBool, Default: false},
+		{Name: "muted", Type: field.TypeBool, Default: false},
+		{Name: "muted_until", Type: field.TypeTime, Nullable: true},
+		{Name: "notify_mentions", Type: field.TypeBool, Default: false},
 		{Name: "group_chat_id", Type: field.TypeUUID},
+		{Name: "last_read_message_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "last_delivered_message_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// GroupMembersTable holds the schema information for the "group_members" table.
@@ -101,13 +285,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "group_members_group_chats_members",
-				Columns:    []*schema.Column{GroupMembersColumns[5]},
+				Columns:    []*schema.Column{GroupMembersColumns[12]},
 				RefColumns: []*schema.Column{GroupChatsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "group_members_messages_last_read_message",
+				Columns:    []*schema.Column{GroupMembersColumns[13]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "group_members_messages_last_delivered_message",
+				Columns:    []*schema.Column{GroupMembersColumns[14]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "group_members_users_group_memberships",
-				Columns:    []*schema.Column{GroupMembersColumns[6]},
+				Columns:    []*schema.Column{GroupMembersColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -116,12 +312,12 @@ var (
 			{
 				Name:    "pk_group_member",
 				Unique:  true,
-				Columns: []*schema.Column{GroupMembersColumns[5], GroupMembersColumns[6]},
+				Columns: []*schema.Column{GroupMembersColumns[12], GroupMembersColumns[15]},
 			},
 			{
 				Name:    "groupmember_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{GroupMembersColumns[6]},
+				Columns: []*schema.Column{GroupMembersColumns[15]},
 			},
 		},
 	}
@@ -130,15 +326,18 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
-		{Name: "file_name", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "file_name", Type: field.TypeString, Size: 255},
 		{Name: "original_name", Type: field.TypeString, Size: 255},
 		{Name: "file_size", Type: field.TypeInt64},
 		{Name: "mime_type", Type: field.TypeString, Size: 100},
-		{Name: "category", Type: field.TypeEnum, Enums: []string{"user_avatar", "group_avatar", "message_attachment"}, Default: "message_attachment"},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"user_avatar", "group_avatar", "message_attachment", "voice"}, Default: "message_attachment"},
 		{Name: "upload_status", Type: field.TypeEnum, Enums: []string{"pending", "completed"}, Default: "completed"},
 		{Name: "upload_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "waveform", Type: field.TypeJSON, Nullable: true},
 		{Name: "message_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "scheduled_message_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "uploaded_by_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// MediaTable holds the schema information for the "media" table.
@@ -149,30 +348,52 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "media_messages_attachments",
-				Columns:    []*schema.Column{MediaColumns[11]},
+				Columns:    []*schema.Column{MediaColumns[13]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "media_scheduled_messages_attachments",
+				Columns:    []*schema.Column{MediaColumns[14]},
+				RefColumns: []*schema.Column{ScheduledMessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "media_users_uploaded_media",
-				Columns:    []*schema.Column{MediaColumns[12]},
+				Columns:    []*schema.Column{MediaColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "media_file_name",
+				Unique:  false,
+				Columns: []*schema.Column{MediaColumns[3]},
+			},
+		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
 		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"regular", "system_create", "system_rename", "system_description", "system_avatar", "system_join", "system_add", "system_leave", "system_kick", "system_promote", "system_demote", "system_visibility"}, Default: "regular"},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"regular", "system_create", "system_rename", "system_description", "system_avatar", "system_join", "system_add", "system_leave", "system_kick", "system_promote", "system_demote", "system_visibility", "system_pin", "system_unpin", "system_ttl", "system_slow_mode", "system_join_approval", "poll", "location", "contact"}, Default: "regular"},
 		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "action_data", Type: field.TypeJSON, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reply_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_reply_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "link_preview", Type: field.TypeJSON, Nullable: true},
+		{Name: "client_message_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "location", Type: field.TypeJSON, Nullable: true},
 		{Name: "chat_id", Type: field.TypeUUID},
 		{Name: "reply_to_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "forwarded_from_sender_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "forwarded_from_chat_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "contact_user_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "sender_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// MessagesTable holds the schema information for the "messages" table.
@@ -183,19 +404,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_chats_messages",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[14]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "messages_messages_reply_to",
-				Columns:    []*schema.Column{MessagesColumns[9]},
+				Columns:    []*schema.Column{MessagesColumns[15]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "messages_users_forwarded_from_sender",
+				Columns:    []*schema.Column{MessagesColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "messages_chats_forwarded_from_chat",
+				Columns:    []*schema.Column{MessagesColumns[17]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "messages_users_contact_user",
+				Columns:    []*schema.Column{MessagesColumns[18]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "messages_users_sent_messages",
-				Columns:    []*schema.Column{MessagesColumns[10]},
+				Columns:    []*schema.Column{MessagesColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -204,7 +443,7 @@ var (
 			{
 				Name:    "idx_messages_chat_active",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[8], MessagesColumns[1]},
+				Columns: []*schema.Column{MessagesColumns[14], MessagesColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Desc:  true,
 					Where: "deleted_at IS NULL",
@@ -213,11 +452,338 @@ var (
 			{
 				Name:    "message_reply_to_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[9]},
+				Columns: []*schema.Column{MessagesColumns[15]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "reply_to_id IS NOT NULL AND deleted_at IS NULL",
 				},
 			},
+			{
+				Name:    "message_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[10]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "expires_at IS NOT NULL",
+				},
+			},
+			{
+				Name:    "message_sender_id_chat_id_client_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[19], MessagesColumns[14], MessagesColumns[12]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "client_message_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// MessageMentionsColumns holds the columns for the "message_mentions" table.
+	MessageMentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"user", "all"}},
+		{Name: "offset", Type: field.TypeInt},
+		{Name: "length", Type: field.TypeInt},
+		{Name: "message_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// MessageMentionsTable holds the schema information for the "message_mentions" table.
+	MessageMentionsTable = &schema.Table{
+		Name:       "message_mentions",
+		Columns:    MessageMentionsColumns,
+		PrimaryKey: []*schema.Column{MessageMentionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_mentions_messages_mentions",
+				Columns:    []*schema.Column{MessageMentionsColumns[6]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "message_mentions_users_mentions",
+				Columns:    []*schema.Column{MessageMentionsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagemention_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[6]},
+			},
+			{
+				Name:    "messagemention_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageMentionsColumns[7]},
+			},
+		},
+	}
+	// MessageReactionsColumns holds the columns for the "message_reactions" table.
+	MessageReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "emoji", Type: field.TypeString, Size: 32},
+		{Name: "message_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MessageReactionsTable holds the schema information for the "message_reactions" table.
+	MessageReactionsTable = &schema.Table{
+		Name:       "message_reactions",
+		Columns:    MessageReactionsColumns,
+		PrimaryKey: []*schema.Column{MessageReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_reactions_messages_reactions",
+				Columns:    []*schema.Column{MessageReactionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "message_reactions_users_message_reactions",
+				Columns:    []*schema.Column{MessageReactionsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagereaction_message_id_user_id_emoji",
+				Unique:  true,
+				Columns: []*schema.Column{MessageReactionsColumns[4], MessageReactionsColumns[5], MessageReactionsColumns[3]},
+			},
+			{
+				Name:    "messagereaction_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessageReactionsColumns[5]},
+			},
+		},
+	}
+	// MessageRevisionsColumns holds the columns for the "message_revisions" table.
+	MessageRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "message_id", Type: field.TypeUUID},
+	}
+	// MessageRevisionsTable holds the schema information for the "message_revisions" table.
+	MessageRevisionsTable = &schema.Table{
+		Name:       "message_revisions",
+		Columns:    MessageRevisionsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revisions_messages_revisions",
+				Columns:    []*schema.Column{MessageRevisionsColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagerevision_message_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[4], MessageRevisionsColumns[1]},
+			},
+		},
+	}
+	// PinnedChatsColumns holds the columns for the "pinned_chats" table.
+	PinnedChatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// PinnedChatsTable holds the schema information for the "pinned_chats" table.
+	PinnedChatsTable = &schema.Table{
+		Name:       "pinned_chats",
+		Columns:    PinnedChatsColumns,
+		PrimaryKey: []*schema.Column{PinnedChatsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pinned_chats_chats_pinned_by",
+				Columns:    []*schema.Column{PinnedChatsColumns[4]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "pinned_chats_users_pinned_chats",
+				Columns:    []*schema.Column{PinnedChatsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pinnedchat_user_id_chat_id",
+				Unique:  true,
+				Columns: []*schema.Column{PinnedChatsColumns[5], PinnedChatsColumns[4]},
+			},
+			{
+				Name:    "pinnedchat_chat_id",
+				Unique:  false,
+				Columns: []*schema.Column{PinnedChatsColumns[4]},
+			},
+		},
+	}
+	// PinnedMessagesColumns holds the columns for the "pinned_messages" table.
+	PinnedMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "message_id", Type: field.TypeUUID},
+		{Name: "pinned_by_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PinnedMessagesTable holds the schema information for the "pinned_messages" table.
+	PinnedMessagesTable = &schema.Table{
+		Name:       "pinned_messages",
+		Columns:    PinnedMessagesColumns,
+		PrimaryKey: []*schema.Column{PinnedMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pinned_messages_chats_pinned_messages",
+				Columns:    []*schema.Column{PinnedMessagesColumns[3]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "pinned_messages_messages_pins",
+				Columns:    []*schema.Column{PinnedMessagesColumns[4]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "pinned_messages_users_pinned_messages",
+				Columns:    []*schema.Column{PinnedMessagesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pinnedmessage_chat_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{PinnedMessagesColumns[3], PinnedMessagesColumns[4]},
+			},
+			{
+				Name:    "pinnedmessage_message_id",
+				Unique:  false,
+				Columns: []*schema.Column{PinnedMessagesColumns[4]},
+			},
+		},
+	}
+	// PollsColumns holds the columns for the "polls" table.
+	PollsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "question", Type: field.TypeString, Size: 300},
+		{Name: "multiple_choice", Type: field.TypeBool, Default: false},
+		{Name: "anonymous", Type: field.TypeBool, Default: false},
+		{Name: "quiz", Type: field.TypeBool, Default: false},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "message_id", Type: field.TypeUUID, Unique: true},
+	}
+	// PollsTable holds the schema information for the "polls" table.
+	PollsTable = &schema.Table{
+		Name:       "polls",
+		Columns:    PollsColumns,
+		PrimaryKey: []*schema.Column{PollsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "polls_messages_poll",
+				Columns:    []*schema.Column{PollsColumns[9]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PollOptionsColumns holds the columns for the "poll_options" table.
+	PollOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "text", Type: field.TypeString, Size: 100},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "is_correct", Type: field.TypeBool, Default: false},
+		{Name: "poll_id", Type: field.TypeUUID},
+	}
+	// PollOptionsTable holds the schema information for the "poll_options" table.
+	PollOptionsTable = &schema.Table{
+		Name:       "poll_options",
+		Columns:    PollOptionsColumns,
+		PrimaryKey: []*schema.Column{PollOptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_options_polls_options",
+				Columns:    []*schema.Column{PollOptionsColumns[6]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "polloption_poll_id_position",
+				Unique:  true,
+				Columns: []*schema.Column{PollOptionsColumns[6], PollOptionsColumns[4]},
+			},
+		},
+	}
+	// PollVotesColumns holds the columns for the "poll_votes" table.
+	PollVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "poll_id", Type: field.TypeUUID},
+		{Name: "option_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// PollVotesTable holds the schema information for the "poll_votes" table.
+	PollVotesTable = &schema.Table{
+		Name:       "poll_votes",
+		Columns:    PollVotesColumns,
+		PrimaryKey: []*schema.Column{PollVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_votes_polls_votes",
+				Columns:    []*schema.Column{PollVotesColumns[3]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_votes_poll_options_votes",
+				Columns:    []*schema.Column{PollVotesColumns[4]},
+				RefColumns: []*schema.Column{PollOptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_votes_users_poll_votes",
+				Columns:    []*schema.Column{PollVotesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollvote_option_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PollVotesColumns[4], PollVotesColumns[5]},
+			},
+			{
+				Name:    "pollvote_poll_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollVotesColumns[3], PollVotesColumns[5]},
+			},
+			{
+				Name:    "pollvote_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollVotesColumns[5]},
+			},
 		},
 	}
 	// PrivateChatsColumns holds the columns for the "private_chats" table.
@@ -227,8 +793,18 @@ var (
 		{Name: "user2_last_read_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user1_hidden_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user2_hidden_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user1_archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user2_archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user1_keep_archived", Type: field.TypeBool, Default: false},
+		{Name: "user2_keep_archived", Type: field.TypeBool, Default: false},
+		{Name: "user1_muted", Type: field.TypeBool, Default: false},
+		{Name: "user2_muted", Type: field.TypeBool, Default: false},
+		{Name: "user1_muted_until", Type: field.TypeTime, Nullable: true},
+		{Name: "user2_muted_until", Type: field.TypeTime, Nullable: true},
 		{Name: "user1_unread_count", Type: field.TypeInt, Default: 0},
 		{Name: "user2_unread_count", Type: field.TypeInt, Default: 0},
+		{Name: "user1_marked_unread", Type: field.TypeBool, Default: false},
+		{Name: "user2_marked_unread", Type: field.TypeBool, Default: false},
 		{Name: "chat_id", Type: field.TypeUUID, Unique: true},
 		{Name: "user1_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user2_id", Type: field.TypeUUID, Nullable: true},
@@ -241,19 +817,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "private_chats_chats_private_chat",
-				Columns:    []*schema.Column{PrivateChatsColumns[7]},
+				Columns:    []*schema.Column{PrivateChatsColumns[17]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "private_chats_users_private_chats_as_user1",
-				Columns:    []*schema.Column{PrivateChatsColumns[8]},
+				Columns:    []*schema.Column{PrivateChatsColumns[18]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "private_chats_users_private_chats_as_user2",
-				Columns:    []*schema.Column{PrivateChatsColumns[9]},
+				Columns:    []*schema.Column{PrivateChatsColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -262,12 +838,12 @@ var (
 			{
 				Name:    "unique_user_pair",
 				Unique:  true,
-				Columns: []*schema.Column{PrivateChatsColumns[8], PrivateChatsColumns[9]},
+				Columns: []*schema.Column{PrivateChatsColumns[18], PrivateChatsColumns[19]},
 			},
 			{
 				Name:    "privatechat_user2_id",
 				Unique:  false,
-				Columns: []*schema.Column{PrivateChatsColumns[9]},
+				Columns: []*schema.Column{PrivateChatsColumns[19]},
 			},
 		},
 	}
@@ -351,6 +927,57 @@ var (
 			},
 		},
 	}
+	// ScheduledMessagesColumns holds the columns for the "scheduled_messages" table.
+	ScheduledMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "send_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "failed"}, Default: "pending"},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "reply_to_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "sender_id", Type: field.TypeUUID},
+	}
+	// ScheduledMessagesTable holds the schema information for the "scheduled_messages" table.
+	ScheduledMessagesTable = &schema.Table{
+		Name:       "scheduled_messages",
+		Columns:    ScheduledMessagesColumns,
+		PrimaryKey: []*schema.Column{ScheduledMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_messages_chats_scheduled_messages",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[7]},
+				RefColumns: []*schema.Column{ChatsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "scheduled_messages_messages_reply_to",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[8]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "scheduled_messages_users_scheduled_messages",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledmessage_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[5], ScheduledMessagesColumns[4]},
+			},
+			{
+				Name:    "scheduledmessage_sender_id_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[9], ScheduledMessagesColumns[4]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -461,6 +1088,31 @@ var (
 			},
 		},
 	}
+	// MessageRevisionAttachmentsColumns holds the columns for the "message_revision_attachments" table.
+	MessageRevisionAttachmentsColumns = []*schema.Column{
+		{Name: "message_revision_id", Type: field.TypeUUID},
+		{Name: "media_id", Type: field.TypeUUID},
+	}
+	// MessageRevisionAttachmentsTable holds the schema information for the "message_revision_attachments" table.
+	MessageRevisionAttachmentsTable = &schema.Table{
+		Name:       "message_revision_attachments",
+		Columns:    MessageRevisionAttachmentsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionAttachmentsColumns[0], MessageRevisionAttachmentsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revision_attachments_message_revision_id",
+				Columns:    []*schema.Column{MessageRevisionAttachmentsColumns[0]},
+				RefColumns: []*schema.Column{MessageRevisionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "message_revision_attachments_media_id",
+				Columns:    []*schema.Column{MessageRevisionAttachmentsColumns[1]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// ReportEvidenceMediaColumns holds the columns for the "report_evidence_media" table.
 	ReportEvidenceMediaColumns = []*schema.Column{
 		{Name: "report_id", Type: field.TypeUUID},
@@ -489,31 +1141,73 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChatsTable,
+		ChatExportsTable,
+		ChatFoldersTable,
+		ChatFolderChatsTable,
 		GroupChatsTable,
+		GroupJoinRequestsTable,
 		GroupMembersTable,
 		MediaTable,
 		MessagesTable,
+		MessageMentionsTable,
+		MessageReactionsTable,
+		MessageRevisionsTable,
+		PinnedChatsTable,
+		PinnedMessagesTable,
+		PollsTable,
+		PollOptionsTable,
+		PollVotesTable,
 		PrivateChatsTable,
 		ReportsTable,
+		ScheduledMessagesTable,
 		UsersTable,
 		UserBlocksTable,
 		UserIdentitiesTable,
+		MessageRevisionAttachmentsTable,
 		ReportEvidenceMediaTable,
 	}
 )
 
 func init() {
 	ChatsTable.ForeignKeys[0].RefTable = MessagesTable
+	ChatExportsTable.ForeignKeys[0].RefTable = ChatsTable
+	ChatExportsTable.ForeignKeys[1].RefTable = UsersTable
+	ChatFoldersTable.ForeignKeys[0].RefTable = UsersTable
+	ChatFolderChatsTable.ForeignKeys[0].RefTable = ChatsTable
+	ChatFolderChatsTable.ForeignKeys[1].RefTable = ChatFoldersTable
 	GroupChatsTable.ForeignKeys[0].RefTable = ChatsTable
 	GroupChatsTable.ForeignKeys[1].RefTable = MediaTable
 	GroupChatsTable.ForeignKeys[2].RefTable = UsersTable
+	GroupJoinRequestsTable.ForeignKeys[0].RefTable = GroupChatsTable
+	GroupJoinRequestsTable.ForeignKeys[1].RefTable = UsersTable
 	GroupMembersTable.ForeignKeys[0].RefTable = GroupChatsTable
-	GroupMembersTable.ForeignKeys[1].RefTable = UsersTable
+	GroupMembersTable.ForeignKeys[1].RefTable = MessagesTable
+	GroupMembersTable.ForeignKeys[2].RefTable = MessagesTable
+	GroupMembersTable.ForeignKeys[3].RefTable = UsersTable
 	MediaTable.ForeignKeys[0].RefTable = MessagesTable
-	MediaTable.ForeignKeys[1].RefTable = UsersTable
+	MediaTable.ForeignKeys[1].RefTable = ScheduledMessagesTable
+	MediaTable.ForeignKeys[2].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = ChatsTable
 	MessagesTable.ForeignKeys[1].RefTable = MessagesTable
 	MessagesTable.ForeignKeys[2].RefTable = UsersTable
+	MessagesTable.ForeignKeys[3].RefTable = ChatsTable
+	MessagesTable.ForeignKeys[4].RefTable = UsersTable
+	MessagesTable.ForeignKeys[5].RefTable = UsersTable
+	MessageMentionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageMentionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageReactionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageReactionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageRevisionsTable.ForeignKeys[0].RefTable = MessagesTable
+	PinnedChatsTable.ForeignKeys[0].RefTable = ChatsTable
+	PinnedChatsTable.ForeignKeys[1].RefTable = UsersTable
+	PinnedMessagesTable.ForeignKeys[0].RefTable = ChatsTable
+	PinnedMessagesTable.ForeignKeys[1].RefTable = MessagesTable
+	PinnedMessagesTable.ForeignKeys[2].RefTable = UsersTable
+	PollsTable.ForeignKeys[0].RefTable = MessagesTable
+	PollOptionsTable.ForeignKeys[0].RefTable = PollsTable
+	PollVotesTable.ForeignKeys[0].RefTable = PollsTable
+	PollVotesTable.ForeignKeys[1].RefTable = PollOptionsTable
+	PollVotesTable.ForeignKeys[2].RefTable = UsersTable
 	PrivateChatsTable.ForeignKeys[0].RefTable = ChatsTable
 	PrivateChatsTable.ForeignKeys[1].RefTable = UsersTable
 	PrivateChatsTable.ForeignKeys[2].RefTable = UsersTable
@@ -523,10 +1217,15 @@ func init() {
 	ReportsTable.ForeignKeys[3].RefTable = UsersTable
 	ReportsTable.ForeignKeys[4].RefTable = UsersTable
 	ReportsTable.ForeignKeys[5].RefTable = UsersTable
+	ScheduledMessagesTable.ForeignKeys[0].RefTable = ChatsTable
+	ScheduledMessagesTable.ForeignKeys[1].RefTable = MessagesTable
+	ScheduledMessagesTable.ForeignKeys[2].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = MediaTable
 	UserBlocksTable.ForeignKeys[0].RefTable = UsersTable
 	UserBlocksTable.ForeignKeys[1].RefTable = UsersTable
 	UserIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	MessageRevisionAttachmentsTable.ForeignKeys[0].RefTable = MessageRevisionsTable
+	MessageRevisionAttachmentsTable.ForeignKeys[1].RefTable = MediaTable
 	ReportEvidenceMediaTable.ForeignKeys[0].RefTable = ReportsTable
 	ReportEvidenceMediaTable.ForeignKeys[1].RefTable = MediaTable
 }
@@ -4,13 +4,27 @@ package ent
 
 import (
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/ent/schema"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/ent/useridentity"
@@ -34,42 +48,72 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChat         = "Chat"
-	TypeGroupChat    = "GroupChat"
-	TypeGroupMember  = "GroupMember"
-	TypeMedia        = "Media"
-	TypeMessage      = "Message"
-	TypePrivateChat  = "PrivateChat"
-	TypeReport       = "Report"
-	TypeUser         = "User"
-	TypeUserBlock    = "UserBlock"
-	TypeUserIdentity = "UserIdentity"
+	TypeChat             = "Chat"
+	TypeChatExport       = "ChatExport"
+	TypeChatFolder       = "ChatFolder"
+	TypeChatFolderChat   = "ChatFolderChat"
+	TypeGroupChat        = "GroupChat"
+	TypeGroupJoinRequest = "GroupJoinRequest"
+	TypeGroupMember      = "GroupMember"
+	TypeMedia            = "Media"
+	TypeMessage          = "Message"
+	TypeMessageMention   = "MessageMention"
+	TypeMessageReaction  = "MessageReaction"
+	TypeMessageRevision  = "MessageRevision"
+	TypePinnedChat       = "PinnedChat"
+	TypePinnedMessage    = "PinnedMessage"
+	TypePoll             = "Poll"
+	TypePollOption       = "PollOption"
+	TypePollVote         = "PollVote"
+	TypePrivateChat      = "PrivateChat"
+	TypeReport           = "Report"
+	TypeScheduledMessage = "ScheduledMessage"
+	TypeUser             = "User"
+	TypeUserBlock        = "UserBlock"
+	TypeUserIdentity     = "UserIdentity"
 )
 
 // ChatMutation represents an operation that mutates the Chat nodes in the graph.
 type ChatMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	created_at          *time.Time
-	updated_at          *time.Time
-	_type               *chat.Type
-	last_message_at     *time.Time
-	deleted_at          *time.Time
-	clearedFields       map[string]struct{}
-	messages            map[uuid.UUID]struct{}
-	removedmessages     map[uuid.UUID]struct{}
-	clearedmessages     bool
-	private_chat        *uuid.UUID
-	clearedprivate_chat bool
-	group_chat          *uuid.UUID
-	clearedgroup_chat   bool
-	last_message        *uuid.UUID
-	clearedlast_message bool
-	done                bool
-	oldValue            func(context.Context) (*Chat, error)
-	predicates          []predicate.Chat
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	created_at                *time.Time
+	updated_at                *time.Time
+	_type                     *chat.Type
+	last_message_at           *time.Time
+	message_ttl               *int
+	addmessage_ttl            *int
+	deleted_at                *time.Time
+	clearedFields             map[string]struct{}
+	messages                  map[uuid.UUID]struct{}
+	removedmessages           map[uuid.UUID]struct{}
+	clearedmessages           bool
+	private_chat              *uuid.UUID
+	clearedprivate_chat       bool
+	group_chat                *uuid.UUID
+	clearedgroup_chat         bool
+	last_message              *uuid.UUID
+	clearedlast_message       bool
+	pinned_messages           map[uuid.UUID]struct{}
+	removedpinned_messages    map[uuid.UUID]struct{}
+	clearedpinned_messages    bool
+	scheduled_messages        map[uuid.UUID]struct{}
+	removedscheduled_messages map[uuid.UUID]struct{}
+	clearedscheduled_messages bool
+	exports                   map[uuid.UUID]struct{}
+	removedexports            map[uuid.UUID]struct{}
+	clearedexports            bool
+	pinned_by                 map[uuid.UUID]struct{}
+	removedpinned_by          map[uuid.UUID]struct{}
+	clearedpinned_by          bool
+	folders                   map[uuid.UUID]struct{}
+	removedfolders            map[uuid.UUID]struct{}
+	clearedfolders            bool
+	done                      bool
+	oldValue                  func(context.Context) (*Chat, error)
+	predicates                []predicate.Chat
 }
 
 var _ ent.Mutation = (*ChatMutation)(nil)
@@ -382,6 +426,76 @@ func (m *ChatMutation) ResetLastMessageAt() {
 	delete(m.clearedFields, chat.FieldLastMessageAt)
 }
 
+// SetMessageTTL sets the "message_ttl" field.
+func (m *ChatMutation) SetMessageTTL(i int) {
+	m.message_ttl = &i
+	m.addmessage_ttl = nil
+}
+
+// MessageTTL returns the value of the "message_ttl" field in the mutation.
+func (m *ChatMutation) MessageTTL() (r int, exists bool) {
+	v := m.message_ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageTTL returns the old "message_ttl" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatMutation) OldMessageTTL(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageTTL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageTTL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageTTL: %w", err)
+	}
+	return oldValue.MessageTTL, nil
+}
+
+// AddMessageTTL adds i to the "message_ttl" field.
+func (m *ChatMutation) AddMessageTTL(i int) {
+	if m.addmessage_ttl != nil {
+		*m.addmessage_ttl += i
+	} else {
+		m.addmessage_ttl = &i
+	}
+}
+
+// AddedMessageTTL returns the value that was added to the "message_ttl" field in this mutation.
+func (m *ChatMutation) AddedMessageTTL() (r int, exists bool) {
+	v := m.addmessage_ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMessageTTL clears the value of the "message_ttl" field.
+func (m *ChatMutation) ClearMessageTTL() {
+	m.message_ttl = nil
+	m.addmessage_ttl = nil
+	m.clearedFields[chat.FieldMessageTTL] = struct{}{}
+}
+
+// MessageTTLCleared returns if the "message_ttl" field was cleared in this mutation.
+func (m *ChatMutation) MessageTTLCleared() bool {
+	_, ok := m.clearedFields[chat.FieldMessageTTL]
+	return ok
+}
+
+// ResetMessageTTL resets all changes to the "message_ttl" field.
+func (m *ChatMutation) ResetMessageTTL() {
+	m.message_ttl = nil
+	m.addmessage_ttl = nil
+	delete(m.clearedFields, chat.FieldMessageTTL)
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (m *ChatMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
@@ -590,6 +704,276 @@ func (m *ChatMutation) ResetLastMessage() {
 	m.clearedlast_message = false
 }
 
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by ids.
+func (m *ChatMutation) AddPinnedMessageIDs(ids ...uuid.UUID) {
+	if m.pinned_messages == nil {
+		m.pinned_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.pinned_messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPinnedMessages clears the "pinned_messages" edge to the PinnedMessage entity.
+func (m *ChatMutation) ClearPinnedMessages() {
+	m.clearedpinned_messages = true
+}
+
+// PinnedMessagesCleared reports if the "pinned_messages" edge to the PinnedMessage entity was cleared.
+func (m *ChatMutation) PinnedMessagesCleared() bool {
+	return m.clearedpinned_messages
+}
+
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (m *ChatMutation) RemovePinnedMessageIDs(ids ...uuid.UUID) {
+	if m.removedpinned_messages == nil {
+		m.removedpinned_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.pinned_messages, ids[i])
+		m.removedpinned_messages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPinnedMessages returns the removed IDs of the "pinned_messages" edge to the PinnedMessage entity.
+func (m *ChatMutation) RemovedPinnedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedpinned_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PinnedMessagesIDs returns the "pinned_messages" edge IDs in the mutation.
+func (m *ChatMutation) PinnedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.pinned_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPinnedMessages resets all changes to the "pinned_messages" edge.
+func (m *ChatMutation) ResetPinnedMessages() {
+	m.pinned_messages = nil
+	m.clearedpinned_messages = false
+	m.removedpinned_messages = nil
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by ids.
+func (m *ChatMutation) AddScheduledMessageIDs(ids ...uuid.UUID) {
+	if m.scheduled_messages == nil {
+		m.scheduled_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.scheduled_messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearScheduledMessages clears the "scheduled_messages" edge to the ScheduledMessage entity.
+func (m *ChatMutation) ClearScheduledMessages() {
+	m.clearedscheduled_messages = true
+}
+
+// ScheduledMessagesCleared reports if the "scheduled_messages" edge to the ScheduledMessage entity was cleared.
+func (m *ChatMutation) ScheduledMessagesCleared() bool {
+	return m.clearedscheduled_messages
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (m *ChatMutation) RemoveScheduledMessageIDs(ids ...uuid.UUID) {
+	if m.removedscheduled_messages == nil {
+		m.removedscheduled_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.scheduled_messages, ids[i])
+		m.removedscheduled_messages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedScheduledMessages returns the removed IDs of the "scheduled_messages" edge to the ScheduledMessage entity.
+func (m *ChatMutation) RemovedScheduledMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedscheduled_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ScheduledMessagesIDs returns the "scheduled_messages" edge IDs in the mutation.
+func (m *ChatMutation) ScheduledMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.scheduled_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetScheduledMessages resets all changes to the "scheduled_messages" edge.
+func (m *ChatMutation) ResetScheduledMessages() {
+	m.scheduled_messages = nil
+	m.clearedscheduled_messages = false
+	m.removedscheduled_messages = nil
+}
+
+// AddExportIDs adds the "exports" edge to the ChatExport entity by ids.
+func (m *ChatMutation) AddExportIDs(ids ...uuid.UUID) {
+	if m.exports == nil {
+		m.exports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.exports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExports clears the "exports" edge to the ChatExport entity.
+func (m *ChatMutation) ClearExports() {
+	m.clearedexports = true
+}
+
+// ExportsCleared reports if the "exports" edge to the ChatExport entity was cleared.
+func (m *ChatMutation) ExportsCleared() bool {
+	return m.clearedexports
+}
+
+// RemoveExportIDs removes the "exports" edge to the ChatExport entity by IDs.
+func (m *ChatMutation) RemoveExportIDs(ids ...uuid.UUID) {
+	if m.removedexports == nil {
+		m.removedexports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.exports, ids[i])
+		m.removedexports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExports returns the removed IDs of the "exports" edge to the ChatExport entity.
+func (m *ChatMutation) RemovedExportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedexports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExportsIDs returns the "exports" edge IDs in the mutation.
+func (m *ChatMutation) ExportsIDs() (ids []uuid.UUID) {
+	for id := range m.exports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExports resets all changes to the "exports" edge.
+func (m *ChatMutation) ResetExports() {
+	m.exports = nil
+	m.clearedexports = false
+	m.removedexports = nil
+}
+
+// AddPinnedByIDs adds the "pinned_by" edge to the PinnedChat entity by ids.
+func (m *ChatMutation) AddPinnedByIDs(ids ...uuid.UUID) {
+	if m.pinned_by == nil {
+		m.pinned_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.pinned_by[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the PinnedChat entity.
+func (m *ChatMutation) ClearPinnedBy() {
+	m.clearedpinned_by = true
+}
+
+// PinnedByCleared reports if the "pinned_by" edge to the PinnedChat entity was cleared.
+func (m *ChatMutation) PinnedByCleared() bool {
+	return m.clearedpinned_by
+}
+
+// RemovePinnedByIDs removes the "pinned_by" edge to the PinnedChat entity by IDs.
+func (m *ChatMutation) RemovePinnedByIDs(ids ...uuid.UUID) {
+	if m.removedpinned_by == nil {
+		m.removedpinned_by = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.pinned_by, ids[i])
+		m.removedpinned_by[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPinnedBy returns the removed IDs of the "pinned_by" edge to the PinnedChat entity.
+func (m *ChatMutation) RemovedPinnedByIDs() (ids []uuid.UUID) {
+	for id := range m.removedpinned_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PinnedByIDs returns the "pinned_by" edge IDs in the mutation.
+func (m *ChatMutation) PinnedByIDs() (ids []uuid.UUID) {
+	for id := range m.pinned_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPinnedBy resets all changes to the "pinned_by" edge.
+func (m *ChatMutation) ResetPinnedBy() {
+	m.pinned_by = nil
+	m.clearedpinned_by = false
+	m.removedpinned_by = nil
+}
+
+// AddFolderIDs adds the "folders" edge to the ChatFolderChat entity by ids.
+func (m *ChatMutation) AddFolderIDs(ids ...uuid.UUID) {
+	if m.folders == nil {
+		m.folders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.folders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFolders clears the "folders" edge to the ChatFolderChat entity.
+func (m *ChatMutation) ClearFolders() {
+	m.clearedfolders = true
+}
+
+// FoldersCleared reports if the "folders" edge to the ChatFolderChat entity was cleared.
+func (m *ChatMutation) FoldersCleared() bool {
+	return m.clearedfolders
+}
+
+// RemoveFolderIDs removes the "folders" edge to the ChatFolderChat entity by IDs.
+func (m *ChatMutation) RemoveFolderIDs(ids ...uuid.UUID) {
+	if m.removedfolders == nil {
+		m.removedfolders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.folders, ids[i])
+		m.removedfolders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFolders returns the removed IDs of the "folders" edge to the ChatFolderChat entity.
+func (m *ChatMutation) RemovedFoldersIDs() (ids []uuid.UUID) {
+	for id := range m.removedfolders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FoldersIDs returns the "folders" edge IDs in the mutation.
+func (m *ChatMutation) FoldersIDs() (ids []uuid.UUID) {
+	for id := range m.folders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFolders resets all changes to the "folders" edge.
+func (m *ChatMutation) ResetFolders() {
+	m.folders = nil
+	m.clearedfolders = false
+	m.removedfolders = nil
+}
+
 // Where appends a list predicates to the ChatMutation builder.
 func (m *ChatMutation) Where(ps ...predicate.Chat) {
 	m.predicates = append(m.predicates, ps...)
@@ -624,7 +1008,7 @@ func (m *ChatMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChatMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
 		fields = append(fields, chat.FieldCreatedAt)
 	}
@@ -640,6 +1024,9 @@ func (m *ChatMutation) Fields() []string {
 	if m.last_message_at != nil {
 		fields = append(fields, chat.FieldLastMessageAt)
 	}
+	if m.message_ttl != nil {
+		fields = append(fields, chat.FieldMessageTTL)
+	}
 	if m.deleted_at != nil {
 		fields = append(fields, chat.FieldDeletedAt)
 	}
@@ -661,6 +1048,8 @@ func (m *ChatMutation) Field(name string) (ent.Value, bool) {
 		return m.LastMessageID()
 	case chat.FieldLastMessageAt:
 		return m.LastMessageAt()
+	case chat.FieldMessageTTL:
+		return m.MessageTTL()
 	case chat.FieldDeletedAt:
 		return m.DeletedAt()
 	}
@@ -682,6 +1071,8 @@ func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldLastMessageID(ctx)
 	case chat.FieldLastMessageAt:
 		return m.OldLastMessageAt(ctx)
+	case chat.FieldMessageTTL:
+		return m.OldMessageTTL(ctx)
 	case chat.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	}
@@ -728,6 +1119,13 @@ func (m *ChatMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastMessageAt(v)
 		return nil
+	case chat.FieldMessageTTL:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageTTL(v)
+		return nil
 	case chat.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -742,13 +1140,21 @@ func (m *ChatMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ChatMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmessage_ttl != nil {
+		fields = append(fields, chat.FieldMessageTTL)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chat.FieldMessageTTL:
+		return m.AddedMessageTTL()
+	}
 	return nil, false
 }
 
@@ -757,6 +1163,13 @@ func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ChatMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case chat.FieldMessageTTL:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageTTL(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Chat numeric field %s", name)
 }
@@ -771,6 +1184,9 @@ func (m *ChatMutation) ClearedFields() []string {
 	if m.FieldCleared(chat.FieldLastMessageAt) {
 		fields = append(fields, chat.FieldLastMessageAt)
 	}
+	if m.FieldCleared(chat.FieldMessageTTL) {
+		fields = append(fields, chat.FieldMessageTTL)
+	}
 	if m.FieldCleared(chat.FieldDeletedAt) {
 		fields = append(fields, chat.FieldDeletedAt)
 	}
@@ -794,6 +1210,9 @@ func (m *ChatMutation) ClearField(name string) error {
 	case chat.FieldLastMessageAt:
 		m.ClearLastMessageAt()
 		return nil
+	case chat.FieldMessageTTL:
+		m.ClearMessageTTL()
+		return nil
 	case chat.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
@@ -820,6 +1239,9 @@ func (m *ChatMutation) ResetField(name string) error {
 	case chat.FieldLastMessageAt:
 		m.ResetLastMessageAt()
 		return nil
+	case chat.FieldMessageTTL:
+		m.ResetMessageTTL()
+		return nil
 	case chat.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
@@ -829,7 +1251,7 @@ func (m *ChatMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ChatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 9)
 	if m.messages != nil {
 		edges = append(edges, chat.EdgeMessages)
 	}
@@ -842,6 +1264,21 @@ func (m *ChatMutation) AddedEdges() []string {
 	if m.last_message != nil {
 		edges = append(edges, chat.EdgeLastMessage)
 	}
+	if m.pinned_messages != nil {
+		edges = append(edges, chat.EdgePinnedMessages)
+	}
+	if m.scheduled_messages != nil {
+		edges = append(edges, chat.EdgeScheduledMessages)
+	}
+	if m.exports != nil {
+		edges = append(edges, chat.EdgeExports)
+	}
+	if m.pinned_by != nil {
+		edges = append(edges, chat.EdgePinnedBy)
+	}
+	if m.folders != nil {
+		edges = append(edges, chat.EdgeFolders)
+	}
 	return edges
 }
 
@@ -867,16 +1304,61 @@ func (m *ChatMutation) AddedIDs(name string) []ent.Value {
 		if id := m.last_message; id != nil {
 			return []ent.Value{*id}
 		}
+	case chat.EdgePinnedMessages:
+		ids := make([]ent.Value, 0, len(m.pinned_messages))
+		for id := range m.pinned_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeScheduledMessages:
+		ids := make([]ent.Value, 0, len(m.scheduled_messages))
+		for id := range m.scheduled_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeExports:
+		ids := make([]ent.Value, 0, len(m.exports))
+		for id := range m.exports {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgePinnedBy:
+		ids := make([]ent.Value, 0, len(m.pinned_by))
+		for id := range m.pinned_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.folders))
+		for id := range m.folders {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ChatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 9)
 	if m.removedmessages != nil {
 		edges = append(edges, chat.EdgeMessages)
 	}
+	if m.removedpinned_messages != nil {
+		edges = append(edges, chat.EdgePinnedMessages)
+	}
+	if m.removedscheduled_messages != nil {
+		edges = append(edges, chat.EdgeScheduledMessages)
+	}
+	if m.removedexports != nil {
+		edges = append(edges, chat.EdgeExports)
+	}
+	if m.removedpinned_by != nil {
+		edges = append(edges, chat.EdgePinnedBy)
+	}
+	if m.removedfolders != nil {
+		edges = append(edges, chat.EdgeFolders)
+	}
 	return edges
 }
 
@@ -890,13 +1372,43 @@ func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case chat.EdgePinnedMessages:
+		ids := make([]ent.Value, 0, len(m.removedpinned_messages))
+		for id := range m.removedpinned_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeScheduledMessages:
+		ids := make([]ent.Value, 0, len(m.removedscheduled_messages))
+		for id := range m.removedscheduled_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeExports:
+		ids := make([]ent.Value, 0, len(m.removedexports))
+		for id := range m.removedexports {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgePinnedBy:
+		ids := make([]ent.Value, 0, len(m.removedpinned_by))
+		for id := range m.removedpinned_by {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.removedfolders))
+		for id := range m.removedfolders {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ChatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+	edges := make([]string, 0, 9)
 	if m.clearedmessages {
 		edges = append(edges, chat.EdgeMessages)
 	}
@@ -909,6 +1421,21 @@ func (m *ChatMutation) ClearedEdges() []string {
 	if m.clearedlast_message {
 		edges = append(edges, chat.EdgeLastMessage)
 	}
+	if m.clearedpinned_messages {
+		edges = append(edges, chat.EdgePinnedMessages)
+	}
+	if m.clearedscheduled_messages {
+		edges = append(edges, chat.EdgeScheduledMessages)
+	}
+	if m.clearedexports {
+		edges = append(edges, chat.EdgeExports)
+	}
+	if m.clearedpinned_by {
+		edges = append(edges, chat.EdgePinnedBy)
+	}
+	if m.clearedfolders {
+		edges = append(edges, chat.EdgeFolders)
+	}
 	return edges
 }
 
@@ -924,6 +1451,16 @@ func (m *ChatMutation) EdgeCleared(name string) bool {
 		return m.clearedgroup_chat
 	case chat.EdgeLastMessage:
 		return m.clearedlast_message
+	case chat.EdgePinnedMessages:
+		return m.clearedpinned_messages
+	case chat.EdgeScheduledMessages:
+		return m.clearedscheduled_messages
+	case chat.EdgeExports:
+		return m.clearedexports
+	case chat.EdgePinnedBy:
+		return m.clearedpinned_by
+	case chat.EdgeFolders:
+		return m.clearedfolders
 	}
 	return false
 }
@@ -961,72 +1498,85 @@ func (m *ChatMutation) ResetEdge(name string) error {
 	case chat.EdgeLastMessage:
 		m.ResetLastMessage()
 		return nil
+	case chat.EdgePinnedMessages:
+		m.ResetPinnedMessages()
+		return nil
+	case chat.EdgeScheduledMessages:
+		m.ResetScheduledMessages()
+		return nil
+	case chat.EdgeExports:
+		m.ResetExports()
+		return nil
+	case chat.EdgePinnedBy:
+		m.ResetPinnedBy()
+		return nil
+	case chat.EdgeFolders:
+		m.ResetFolders()
+		return nil
 	}
 	return fmt.Errorf("unknown Chat edge %s", name)
 }
 
-// GroupChatMutation represents an operation that mutates the GroupChat nodes in the graph.
-type GroupChatMutation struct {
+// ChatExportMutation represents an operation that mutates the ChatExport nodes in the graph.
+type ChatExportMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	name              *string
-	description       *string
-	is_public         *bool
-	invite_code       *string
-	invite_expires_at *time.Time
-	clearedFields     map[string]struct{}
-	avatar            *uuid.UUID
-	clearedavatar     bool
-	chat              *uuid.UUID
-	clearedchat       bool
-	creator           *uuid.UUID
-	clearedcreator    bool
-	members           map[uuid.UUID]struct{}
-	removedmembers    map[uuid.UUID]struct{}
-	clearedmembers    bool
-	reports           map[uuid.UUID]struct{}
-	removedreports    map[uuid.UUID]struct{}
-	clearedreports    bool
-	done              bool
-	oldValue          func(context.Context) (*GroupChat, error)
-	predicates        []predicate.GroupChat
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	status           *chatexport.Status
+	file_name        *string
+	file_size        *int64
+	addfile_size     *int64
+	message_count    *int
+	addmessage_count *int
+	failure_reason   *string
+	completed_at     *time.Time
+	expires_at       *time.Time
+	clearedFields    map[string]struct{}
+	chat             *uuid.UUID
+	clearedchat      bool
+	user             *uuid.UUID
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*ChatExport, error)
+	predicates       []predicate.ChatExport
+}
+
+var _ ent.Mutation = (*ChatExportMutation)(nil)
+
+// chatexportOption allows management of the mutation configuration using functional options.
+type chatexportOption func(*ChatExportMutation)
+
+// newChatExportMutation creates new mutation for the ChatExport entity.
+func newChatExportMutation(c config, op Op, opts ...chatexportOption) *ChatExportMutation {
+	m := &ChatExportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeChatExport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-var _ ent.Mutation = (*GroupChatMutation)(nil)
-
-// groupchatOption allows management of the mutation configuration using functional options.
-type groupchatOption func(*GroupChatMutation)
-
-// newGroupChatMutation creates new mutation for the GroupChat entity.
-func newGroupChatMutation(c config, op Op, opts ...groupchatOption) *GroupChatMutation {
-	m := &GroupChatMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeGroupChat,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withGroupChatID sets the ID field of the mutation.
-func withGroupChatID(id uuid.UUID) groupchatOption {
-	return func(m *GroupChatMutation) {
+// withChatExportID sets the ID field of the mutation.
+func withChatExportID(id uuid.UUID) chatexportOption {
+	return func(m *ChatExportMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GroupChat
+			value *ChatExport
 		)
-		m.oldValue = func(ctx context.Context) (*GroupChat, error) {
+		m.oldValue = func(ctx context.Context) (*ChatExport, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GroupChat.Get(ctx, id)
+					value, err = m.Client().ChatExport.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1035,10 +1585,10 @@ func withGroupChatID(id uuid.UUID) groupchatOption {
 	}
 }
 
-// withGroupChat sets the old GroupChat of the mutation.
-func withGroupChat(node *GroupChat) groupchatOption {
-	return func(m *GroupChatMutation) {
-		m.oldValue = func(context.Context) (*GroupChat, error) {
+// withChatExport sets the old ChatExport of the mutation.
+func withChatExport(node *ChatExport) chatexportOption {
+	return func(m *ChatExportMutation) {
+		m.oldValue = func(context.Context) (*ChatExport, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1047,7 +1597,7 @@ func withGroupChat(node *GroupChat) groupchatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupChatMutation) Client() *Client {
+func (m ChatExportMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1055,7 +1605,7 @@ func (m GroupChatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupChatMutation) Tx() (*Tx, error) {
+func (m ChatExportMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1065,14 +1615,14 @@ func (m GroupChatMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GroupChat entities.
-func (m *GroupChatMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ChatExport entities.
+func (m *ChatExportMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupChatMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ChatExportMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1083,7 +1633,7 @@ func (m *GroupChatMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ChatExportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1092,563 +1642,577 @@ func (m *GroupChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GroupChat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChatExport.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *GroupChatMutation) SetChatID(u uuid.UUID) {
-	m.chat = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatExportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *GroupChatMutation) ChatID() (r uuid.UUID, exists bool) {
-	v := m.chat
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatExportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ChatExportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *GroupChatMutation) ResetChatID() {
-	m.chat = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatExportMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *GroupChatMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatExportMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *GroupChatMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatExportMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *ChatExportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedBy, nil
-}
-
-// ClearCreatedBy clears the value of the "created_by" field.
-func (m *GroupChatMutation) ClearCreatedBy() {
-	m.creator = nil
-	m.clearedFields[groupchat.FieldCreatedBy] = struct{}{}
-}
-
-// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
-func (m *GroupChatMutation) CreatedByCleared() bool {
-	_, ok := m.clearedFields[groupchat.FieldCreatedBy]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *GroupChatMutation) ResetCreatedBy() {
-	m.creator = nil
-	delete(m.clearedFields, groupchat.FieldCreatedBy)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatExportMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *GroupChatMutation) SetName(s string) {
-	m.name = &s
+// SetChatID sets the "chat_id" field.
+func (m *ChatExportMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GroupChatMutation) Name() (r string, exists bool) {
-	v := m.name
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ChatExportMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ChatExportMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GroupChatMutation) ResetName() {
-	m.name = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ChatExportMutation) ResetChatID() {
+	m.chat = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *GroupChatMutation) SetDescription(s string) {
-	m.description = &s
+// SetUserID sets the "user_id" field.
+func (m *ChatExportMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *GroupChatMutation) Description() (r string, exists bool) {
-	v := m.description
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ChatExportMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *ChatExportMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *GroupChatMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[groupchat.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *GroupChatMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[groupchat.FieldDescription]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *GroupChatMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, groupchat.FieldDescription)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ChatExportMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetAvatarID sets the "avatar_id" field.
-func (m *GroupChatMutation) SetAvatarID(u uuid.UUID) {
-	m.avatar = &u
+// SetStatus sets the "status" field.
+func (m *ChatExportMutation) SetStatus(c chatexport.Status) {
+	m.status = &c
 }
 
-// AvatarID returns the value of the "avatar_id" field in the mutation.
-func (m *GroupChatMutation) AvatarID() (r uuid.UUID, exists bool) {
-	v := m.avatar
+// Status returns the value of the "status" field in the mutation.
+func (m *ChatExportMutation) Status() (r chatexport.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatarID returns the old "avatar_id" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldAvatarID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *ChatExportMutation) OldStatus(ctx context.Context) (v chatexport.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatarID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.AvatarID, nil
-}
-
-// ClearAvatarID clears the value of the "avatar_id" field.
-func (m *GroupChatMutation) ClearAvatarID() {
-	m.avatar = nil
-	m.clearedFields[groupchat.FieldAvatarID] = struct{}{}
-}
-
-// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
-func (m *GroupChatMutation) AvatarIDCleared() bool {
-	_, ok := m.clearedFields[groupchat.FieldAvatarID]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetAvatarID resets all changes to the "avatar_id" field.
-func (m *GroupChatMutation) ResetAvatarID() {
-	m.avatar = nil
-	delete(m.clearedFields, groupchat.FieldAvatarID)
+// ResetStatus resets all changes to the "status" field.
+func (m *ChatExportMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetIsPublic sets the "is_public" field.
-func (m *GroupChatMutation) SetIsPublic(b bool) {
-	m.is_public = &b
+// SetFileName sets the "file_name" field.
+func (m *ChatExportMutation) SetFileName(s string) {
+	m.file_name = &s
 }
 
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *GroupChatMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *ChatExportMutation) FileName() (r string, exists bool) {
+	v := m.file_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublic returns the old "is_public" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldFileName returns the old "file_name" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+func (m *ChatExportMutation) OldFileName(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+		return v, errors.New("OldFileName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
 	}
-	return oldValue.IsPublic, nil
+	return oldValue.FileName, nil
 }
 
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *GroupChatMutation) ResetIsPublic() {
-	m.is_public = nil
+// ClearFileName clears the value of the "file_name" field.
+func (m *ChatExportMutation) ClearFileName() {
+	m.file_name = nil
+	m.clearedFields[chatexport.FieldFileName] = struct{}{}
 }
 
-// SetInviteCode sets the "invite_code" field.
-func (m *GroupChatMutation) SetInviteCode(s string) {
-	m.invite_code = &s
+// FileNameCleared returns if the "file_name" field was cleared in this mutation.
+func (m *ChatExportMutation) FileNameCleared() bool {
+	_, ok := m.clearedFields[chatexport.FieldFileName]
+	return ok
 }
 
-// InviteCode returns the value of the "invite_code" field in the mutation.
-func (m *GroupChatMutation) InviteCode() (r string, exists bool) {
-	v := m.invite_code
+// ResetFileName resets all changes to the "file_name" field.
+func (m *ChatExportMutation) ResetFileName() {
+	m.file_name = nil
+	delete(m.clearedFields, chatexport.FieldFileName)
+}
+
+// SetFileSize sets the "file_size" field.
+func (m *ChatExportMutation) SetFileSize(i int64) {
+	m.file_size = &i
+	m.addfile_size = nil
+}
+
+// FileSize returns the value of the "file_size" field in the mutation.
+func (m *ChatExportMutation) FileSize() (r int64, exists bool) {
+	v := m.file_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInviteCode returns the old "invite_code" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldFileSize returns the old "file_size" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldInviteCode(ctx context.Context) (v string, err error) {
+func (m *ChatExportMutation) OldFileSize(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInviteCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInviteCode requires an ID field in the mutation")
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInviteCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
 	}
-	return oldValue.InviteCode, nil
+	return oldValue.FileSize, nil
 }
 
-// ResetInviteCode resets all changes to the "invite_code" field.
-func (m *GroupChatMutation) ResetInviteCode() {
-	m.invite_code = nil
+// AddFileSize adds i to the "file_size" field.
+func (m *ChatExportMutation) AddFileSize(i int64) {
+	if m.addfile_size != nil {
+		*m.addfile_size += i
+	} else {
+		m.addfile_size = &i
+	}
 }
 
-// SetInviteExpiresAt sets the "invite_expires_at" field.
-func (m *GroupChatMutation) SetInviteExpiresAt(t time.Time) {
-	m.invite_expires_at = &t
+// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
+func (m *ChatExportMutation) AddedFileSize() (r int64, exists bool) {
+	v := m.addfile_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// InviteExpiresAt returns the value of the "invite_expires_at" field in the mutation.
-func (m *GroupChatMutation) InviteExpiresAt() (r time.Time, exists bool) {
-	v := m.invite_expires_at
+// ClearFileSize clears the value of the "file_size" field.
+func (m *ChatExportMutation) ClearFileSize() {
+	m.file_size = nil
+	m.addfile_size = nil
+	m.clearedFields[chatexport.FieldFileSize] = struct{}{}
+}
+
+// FileSizeCleared returns if the "file_size" field was cleared in this mutation.
+func (m *ChatExportMutation) FileSizeCleared() bool {
+	_, ok := m.clearedFields[chatexport.FieldFileSize]
+	return ok
+}
+
+// ResetFileSize resets all changes to the "file_size" field.
+func (m *ChatExportMutation) ResetFileSize() {
+	m.file_size = nil
+	m.addfile_size = nil
+	delete(m.clearedFields, chatexport.FieldFileSize)
+}
+
+// SetMessageCount sets the "message_count" field.
+func (m *ChatExportMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
+}
+
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *ChatExportMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInviteExpiresAt returns the old "invite_expires_at" field's value of the GroupChat entity.
-// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageCount returns the old "message_count" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupChatMutation) OldInviteExpiresAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ChatExportMutation) OldMessageCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInviteExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInviteExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInviteExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
 	}
-	return oldValue.InviteExpiresAt, nil
+	return oldValue.MessageCount, nil
 }
 
-// ClearInviteExpiresAt clears the value of the "invite_expires_at" field.
-func (m *GroupChatMutation) ClearInviteExpiresAt() {
-	m.invite_expires_at = nil
-	m.clearedFields[groupchat.FieldInviteExpiresAt] = struct{}{}
+// AddMessageCount adds i to the "message_count" field.
+func (m *ChatExportMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
 }
 
-// InviteExpiresAtCleared returns if the "invite_expires_at" field was cleared in this mutation.
-func (m *GroupChatMutation) InviteExpiresAtCleared() bool {
-	_, ok := m.clearedFields[groupchat.FieldInviteExpiresAt]
-	return ok
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *ChatExportMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetInviteExpiresAt resets all changes to the "invite_expires_at" field.
-func (m *GroupChatMutation) ResetInviteExpiresAt() {
-	m.invite_expires_at = nil
-	delete(m.clearedFields, groupchat.FieldInviteExpiresAt)
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *ChatExportMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
 }
 
-// ClearAvatar clears the "avatar" edge to the Media entity.
-func (m *GroupChatMutation) ClearAvatar() {
-	m.clearedavatar = true
-	m.clearedFields[groupchat.FieldAvatarID] = struct{}{}
+// SetFailureReason sets the "failure_reason" field.
+func (m *ChatExportMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
 }
 
-// AvatarCleared reports if the "avatar" edge to the Media entity was cleared.
-func (m *GroupChatMutation) AvatarCleared() bool {
-	return m.AvatarIDCleared() || m.clearedavatar
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *ChatExportMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AvatarIDs returns the "avatar" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AvatarID instead. It exists only for internal usage by the builders.
-func (m *GroupChatMutation) AvatarIDs() (ids []uuid.UUID) {
-	if id := m.avatar; id != nil {
-		ids = append(ids, *id)
+// OldFailureReason returns the old "failure_reason" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatExportMutation) OldFailureReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
 }
 
-// ResetAvatar resets all changes to the "avatar" edge.
-func (m *GroupChatMutation) ResetAvatar() {
-	m.avatar = nil
-	m.clearedavatar = false
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (m *ChatExportMutation) ClearFailureReason() {
+	m.failure_reason = nil
+	m.clearedFields[chatexport.FieldFailureReason] = struct{}{}
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *GroupChatMutation) ClearChat() {
-	m.clearedchat = true
-	m.clearedFields[groupchat.FieldChatID] = struct{}{}
+// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
+func (m *ChatExportMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[chatexport.FieldFailureReason]
+	return ok
 }
 
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *GroupChatMutation) ChatCleared() bool {
-	return m.clearedchat
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *ChatExportMutation) ResetFailureReason() {
+	m.failure_reason = nil
+	delete(m.clearedFields, chatexport.FieldFailureReason)
 }
 
-// ChatIDs returns the "chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *GroupChatMutation) ChatIDs() (ids []uuid.UUID) {
-	if id := m.chat; id != nil {
-		ids = append(ids, *id)
+// SetCompletedAt sets the "completed_at" field.
+func (m *ChatExportMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ChatExportMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetChat resets all changes to the "chat" edge.
-func (m *GroupChatMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
+// OldCompletedAt returns the old "completed_at" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatExportMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *GroupChatMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ChatExportMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[chatexport.FieldCompletedAt] = struct{}{}
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *GroupChatMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[groupchat.FieldCreatedBy] = struct{}{}
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ChatExportMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[chatexport.FieldCompletedAt]
+	return ok
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *GroupChatMutation) CreatorCleared() bool {
-	return m.CreatedByCleared() || m.clearedcreator
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ChatExportMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, chatexport.FieldCompletedAt)
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *GroupChatMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// SetExpiresAt sets the "expires_at" field.
+func (m *ChatExportMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ChatExportMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *GroupChatMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// OldExpiresAt returns the old "expires_at" field's value of the ChatExport entity.
+// If the ChatExport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatExportMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *GroupChatMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ChatExportMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[chatexport.FieldExpiresAt] = struct{}{}
 }
 
-// AddMemberIDs adds the "members" edge to the GroupMember entity by ids.
-func (m *GroupChatMutation) AddMemberIDs(ids ...uuid.UUID) {
-	if m.members == nil {
-		m.members = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.members[ids[i]] = struct{}{}
-	}
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ChatExportMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[chatexport.FieldExpiresAt]
+	return ok
 }
 
-// ClearMembers clears the "members" edge to the GroupMember entity.
-func (m *GroupChatMutation) ClearMembers() {
-	m.clearedmembers = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ChatExportMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, chatexport.FieldExpiresAt)
 }
 
-// MembersCleared reports if the "members" edge to the GroupMember entity was cleared.
-func (m *GroupChatMutation) MembersCleared() bool {
-	return m.clearedmembers
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *ChatExportMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[chatexport.FieldChatID] = struct{}{}
 }
 
-// RemoveMemberIDs removes the "members" edge to the GroupMember entity by IDs.
-func (m *GroupChatMutation) RemoveMemberIDs(ids ...uuid.UUID) {
-	if m.removedmembers == nil {
-		m.removedmembers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.members, ids[i])
-		m.removedmembers[ids[i]] = struct{}{}
-	}
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *ChatExportMutation) ChatCleared() bool {
+	return m.clearedchat
 }
 
-// RemovedMembers returns the removed IDs of the "members" edge to the GroupMember entity.
-func (m *GroupChatMutation) RemovedMembersIDs() (ids []uuid.UUID) {
-	for id := range m.removedmembers {
-		ids = append(ids, id)
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *ChatExportMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// MembersIDs returns the "members" edge IDs in the mutation.
-func (m *GroupChatMutation) MembersIDs() (ids []uuid.UUID) {
-	for id := range m.members {
-		ids = append(ids, id)
-	}
-	return
+// ResetChat resets all changes to the "chat" edge.
+func (m *ChatExportMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
 }
 
-// ResetMembers resets all changes to the "members" edge.
-func (m *GroupChatMutation) ResetMembers() {
-	m.members = nil
-	m.clearedmembers = false
-	m.removedmembers = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *ChatExportMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[chatexport.FieldUserID] = struct{}{}
 }
 
-// AddReportIDs adds the "reports" edge to the Report entity by ids.
-func (m *GroupChatMutation) AddReportIDs(ids ...uuid.UUID) {
-	if m.reports == nil {
-		m.reports = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reports[ids[i]] = struct{}{}
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ChatExportMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ChatExportMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearReports clears the "reports" edge to the Report entity.
-func (m *GroupChatMutation) ClearReports() {
-	m.clearedreports = true
+// ResetUser resets all changes to the "user" edge.
+func (m *ChatExportMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
-func (m *GroupChatMutation) ReportsCleared() bool {
-	return m.clearedreports
+// Where appends a list predicates to the ChatExportMutation builder.
+func (m *ChatExportMutation) Where(ps ...predicate.ChatExport) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
-func (m *GroupChatMutation) RemoveReportIDs(ids ...uuid.UUID) {
-	if m.removedreports == nil {
-		m.removedreports = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reports, ids[i])
-		m.removedreports[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
-func (m *GroupChatMutation) RemovedReportsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreports {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ReportsIDs returns the "reports" edge IDs in the mutation.
-func (m *GroupChatMutation) ReportsIDs() (ids []uuid.UUID) {
-	for id := range m.reports {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetReports resets all changes to the "reports" edge.
-func (m *GroupChatMutation) ResetReports() {
-	m.reports = nil
-	m.clearedreports = false
-	m.removedreports = nil
-}
-
-// Where appends a list predicates to the GroupChatMutation builder.
-func (m *GroupChatMutation) Where(ps ...predicate.GroupChat) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the GroupChatMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChatExportMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupChatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GroupChat, len(ps))
+func (m *ChatExportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatExport, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1656,48 +2220,57 @@ func (m *GroupChatMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupChatMutation) Op() Op {
+func (m *ChatExportMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupChatMutation) SetOp(op Op) {
+func (m *ChatExportMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GroupChat).
-func (m *GroupChatMutation) Type() string {
+// Type returns the node type of this mutation (ChatExport).
+func (m *ChatExportMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupChatMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *ChatExportMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, chatexport.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatexport.FieldUpdatedAt)
+	}
 	if m.chat != nil {
-		fields = append(fields, groupchat.FieldChatID)
+		fields = append(fields, chatexport.FieldChatID)
 	}
-	if m.creator != nil {
-		fields = append(fields, groupchat.FieldCreatedBy)
+	if m.user != nil {
+		fields = append(fields, chatexport.FieldUserID)
 	}
-	if m.name != nil {
-		fields = append(fields, groupchat.FieldName)
+	if m.status != nil {
+		fields = append(fields, chatexport.FieldStatus)
 	}
-	if m.description != nil {
-		fields = append(fields, groupchat.FieldDescription)
+	if m.file_name != nil {
+		fields = append(fields, chatexport.FieldFileName)
 	}
-	if m.avatar != nil {
-		fields = append(fields, groupchat.FieldAvatarID)
+	if m.file_size != nil {
+		fields = append(fields, chatexport.FieldFileSize)
 	}
-	if m.is_public != nil {
-		fields = append(fields, groupchat.FieldIsPublic)
+	if m.message_count != nil {
+		fields = append(fields, chatexport.FieldMessageCount)
 	}
-	if m.invite_code != nil {
-		fields = append(fields, groupchat.FieldInviteCode)
+	if m.failure_reason != nil {
+		fields = append(fields, chatexport.FieldFailureReason)
 	}
-	if m.invite_expires_at != nil {
-		fields = append(fields, groupchat.FieldInviteExpiresAt)
+	if m.completed_at != nil {
+		fields = append(fields, chatexport.FieldCompletedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, chatexport.FieldExpiresAt)
 	}
 	return fields
 }
@@ -1705,24 +2278,30 @@ func (m *GroupChatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupChatMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatExportMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case groupchat.FieldChatID:
+	case chatexport.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatexport.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case chatexport.FieldChatID:
 		return m.ChatID()
-	case groupchat.FieldCreatedBy:
-		return m.CreatedBy()
-	case groupchat.FieldName:
-		return m.Name()
-	case groupchat.FieldDescription:
-		return m.Description()
-	case groupchat.FieldAvatarID:
-		return m.AvatarID()
-	case groupchat.FieldIsPublic:
-		return m.IsPublic()
-	case groupchat.FieldInviteCode:
-		return m.InviteCode()
-	case groupchat.FieldInviteExpiresAt:
-		return m.InviteExpiresAt()
+	case chatexport.FieldUserID:
+		return m.UserID()
+	case chatexport.FieldStatus:
+		return m.Status()
+	case chatexport.FieldFileName:
+		return m.FileName()
+	case chatexport.FieldFileSize:
+		return m.FileSize()
+	case chatexport.FieldMessageCount:
+		return m.MessageCount()
+	case chatexport.FieldFailureReason:
+		return m.FailureReason()
+	case chatexport.FieldCompletedAt:
+		return m.CompletedAt()
+	case chatexport.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -1730,389 +2309,389 @@ func (m *GroupChatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatExportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case groupchat.FieldChatID:
+	case chatexport.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatexport.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case chatexport.FieldChatID:
 		return m.OldChatID(ctx)
-	case groupchat.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case groupchat.FieldName:
-		return m.OldName(ctx)
-	case groupchat.FieldDescription:
-		return m.OldDescription(ctx)
-	case groupchat.FieldAvatarID:
-		return m.OldAvatarID(ctx)
-	case groupchat.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case groupchat.FieldInviteCode:
-		return m.OldInviteCode(ctx)
-	case groupchat.FieldInviteExpiresAt:
-		return m.OldInviteExpiresAt(ctx)
+	case chatexport.FieldUserID:
+		return m.OldUserID(ctx)
+	case chatexport.FieldStatus:
+		return m.OldStatus(ctx)
+	case chatexport.FieldFileName:
+		return m.OldFileName(ctx)
+	case chatexport.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case chatexport.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case chatexport.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case chatexport.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case chatexport.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown GroupChat field %s", name)
+	return nil, fmt.Errorf("unknown ChatExport field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupChatMutation) SetField(name string, value ent.Value) error {
+func (m *ChatExportMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case groupchat.FieldChatID:
+	case chatexport.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chatexport.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case chatexport.FieldChatID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case groupchat.FieldCreatedBy:
+	case chatexport.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetUserID(v)
 		return nil
-	case groupchat.FieldName:
-		v, ok := value.(string)
+	case chatexport.FieldStatus:
+		v, ok := value.(chatexport.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStatus(v)
 		return nil
-	case groupchat.FieldDescription:
+	case chatexport.FieldFileName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetFileName(v)
 		return nil
-	case groupchat.FieldAvatarID:
-		v, ok := value.(uuid.UUID)
+	case chatexport.FieldFileSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatarID(v)
+		m.SetFileSize(v)
 		return nil
-	case groupchat.FieldIsPublic:
-		v, ok := value.(bool)
+	case chatexport.FieldMessageCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublic(v)
+		m.SetMessageCount(v)
 		return nil
-	case groupchat.FieldInviteCode:
+	case chatexport.FieldFailureReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInviteCode(v)
+		m.SetFailureReason(v)
 		return nil
-	case groupchat.FieldInviteExpiresAt:
+	case chatexport.FieldCompletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInviteExpiresAt(v)
+		m.SetCompletedAt(v)
+		return nil
+	case chatexport.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GroupChat field %s", name)
+	return fmt.Errorf("unknown ChatExport field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupChatMutation) AddedFields() []string {
-	return nil
+func (m *ChatExportMutation) AddedFields() []string {
+	var fields []string
+	if m.addfile_size != nil {
+		fields = append(fields, chatexport.FieldFileSize)
+	}
+	if m.addmessage_count != nil {
+		fields = append(fields, chatexport.FieldMessageCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupChatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatExportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case chatexport.FieldFileSize:
+		return m.AddedFileSize()
+	case chatexport.FieldMessageCount:
+		return m.AddedMessageCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupChatMutation) AddField(name string, value ent.Value) error {
+func (m *ChatExportMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case chatexport.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileSize(v)
+		return nil
+	case chatexport.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown GroupChat numeric field %s", name)
+	return fmt.Errorf("unknown ChatExport numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupChatMutation) ClearedFields() []string {
+func (m *ChatExportMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(groupchat.FieldCreatedBy) {
-		fields = append(fields, groupchat.FieldCreatedBy)
+	if m.FieldCleared(chatexport.FieldFileName) {
+		fields = append(fields, chatexport.FieldFileName)
 	}
-	if m.FieldCleared(groupchat.FieldDescription) {
-		fields = append(fields, groupchat.FieldDescription)
+	if m.FieldCleared(chatexport.FieldFileSize) {
+		fields = append(fields, chatexport.FieldFileSize)
 	}
-	if m.FieldCleared(groupchat.FieldAvatarID) {
-		fields = append(fields, groupchat.FieldAvatarID)
+	if m.FieldCleared(chatexport.FieldFailureReason) {
+		fields = append(fields, chatexport.FieldFailureReason)
 	}
-	if m.FieldCleared(groupchat.FieldInviteExpiresAt) {
-		fields = append(fields, groupchat.FieldInviteExpiresAt)
+	if m.FieldCleared(chatexport.FieldCompletedAt) {
+		fields = append(fields, chatexport.FieldCompletedAt)
+	}
+	if m.FieldCleared(chatexport.FieldExpiresAt) {
+		fields = append(fields, chatexport.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupChatMutation) FieldCleared(name string) bool {
+func (m *ChatExportMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupChatMutation) ClearField(name string) error {
+func (m *ChatExportMutation) ClearField(name string) error {
 	switch name {
-	case groupchat.FieldCreatedBy:
-		m.ClearCreatedBy()
+	case chatexport.FieldFileName:
+		m.ClearFileName()
 		return nil
-	case groupchat.FieldDescription:
-		m.ClearDescription()
+	case chatexport.FieldFileSize:
+		m.ClearFileSize()
 		return nil
-	case groupchat.FieldAvatarID:
-		m.ClearAvatarID()
+	case chatexport.FieldFailureReason:
+		m.ClearFailureReason()
 		return nil
-	case groupchat.FieldInviteExpiresAt:
-		m.ClearInviteExpiresAt()
+	case chatexport.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case chatexport.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupChat nullable field %s", name)
+	return fmt.Errorf("unknown ChatExport nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupChatMutation) ResetField(name string) error {
+func (m *ChatExportMutation) ResetField(name string) error {
 	switch name {
-	case groupchat.FieldChatID:
+	case chatexport.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chatexport.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case chatexport.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case groupchat.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case chatexport.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case groupchat.FieldName:
-		m.ResetName()
+	case chatexport.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case groupchat.FieldDescription:
-		m.ResetDescription()
+	case chatexport.FieldFileName:
+		m.ResetFileName()
 		return nil
-	case groupchat.FieldAvatarID:
-		m.ResetAvatarID()
+	case chatexport.FieldFileSize:
+		m.ResetFileSize()
 		return nil
-	case groupchat.FieldIsPublic:
-		m.ResetIsPublic()
+	case chatexport.FieldMessageCount:
+		m.ResetMessageCount()
 		return nil
-	case groupchat.FieldInviteCode:
-		m.ResetInviteCode()
+	case chatexport.FieldFailureReason:
+		m.ResetFailureReason()
 		return nil
-	case groupchat.FieldInviteExpiresAt:
-		m.ResetInviteExpiresAt()
+	case chatexport.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case chatexport.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupChat field %s", name)
+	return fmt.Errorf("unknown ChatExport field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupChatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.avatar != nil {
-		edges = append(edges, groupchat.EdgeAvatar)
-	}
+func (m *ChatExportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.chat != nil {
-		edges = append(edges, groupchat.EdgeChat)
-	}
-	if m.creator != nil {
-		edges = append(edges, groupchat.EdgeCreator)
-	}
-	if m.members != nil {
-		edges = append(edges, groupchat.EdgeMembers)
+		edges = append(edges, chatexport.EdgeChat)
 	}
-	if m.reports != nil {
-		edges = append(edges, groupchat.EdgeReports)
+	if m.user != nil {
+		edges = append(edges, chatexport.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupChatMutation) AddedIDs(name string) []ent.Value {
+func (m *ChatExportMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case groupchat.EdgeAvatar:
-		if id := m.avatar; id != nil {
+	case chatexport.EdgeChat:
+		if id := m.chat; id != nil {
 			return []ent.Value{*id}
 		}
-	case groupchat.EdgeChat:
-		if id := m.chat; id != nil {
-			return []ent.Value{*id}
-		}
-	case groupchat.EdgeCreator:
-		if id := m.creator; id != nil {
+	case chatexport.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case groupchat.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.members))
-		for id := range m.members {
-			ids = append(ids, id)
-		}
-		return ids
-	case groupchat.EdgeReports:
-		ids := make([]ent.Value, 0, len(m.reports))
-		for id := range m.reports {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupChatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedmembers != nil {
-		edges = append(edges, groupchat.EdgeMembers)
-	}
-	if m.removedreports != nil {
-		edges = append(edges, groupchat.EdgeReports)
-	}
+func (m *ChatExportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupChatMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case groupchat.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.removedmembers))
-		for id := range m.removedmembers {
-			ids = append(ids, id)
-		}
-		return ids
-	case groupchat.EdgeReports:
-		ids := make([]ent.Value, 0, len(m.removedreports))
-		for id := range m.removedreports {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ChatExportMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupChatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedavatar {
-		edges = append(edges, groupchat.EdgeAvatar)
-	}
+func (m *ChatExportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedchat {
-		edges = append(edges, groupchat.EdgeChat)
-	}
-	if m.clearedcreator {
-		edges = append(edges, groupchat.EdgeCreator)
-	}
-	if m.clearedmembers {
-		edges = append(edges, groupchat.EdgeMembers)
+		edges = append(edges, chatexport.EdgeChat)
 	}
-	if m.clearedreports {
-		edges = append(edges, groupchat.EdgeReports)
+	if m.cleareduser {
+		edges = append(edges, chatexport.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupChatMutation) EdgeCleared(name string) bool {
+func (m *ChatExportMutation) EdgeCleared(name string) bool {
 	switch name {
-	case groupchat.EdgeAvatar:
-		return m.clearedavatar
-	case groupchat.EdgeChat:
+	case chatexport.EdgeChat:
 		return m.clearedchat
-	case groupchat.EdgeCreator:
-		return m.clearedcreator
-	case groupchat.EdgeMembers:
-		return m.clearedmembers
-	case groupchat.EdgeReports:
-		return m.clearedreports
+	case chatexport.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupChatMutation) ClearEdge(name string) error {
+func (m *ChatExportMutation) ClearEdge(name string) error {
 	switch name {
-	case groupchat.EdgeAvatar:
-		m.ClearAvatar()
-		return nil
-	case groupchat.EdgeChat:
+	case chatexport.EdgeChat:
 		m.ClearChat()
 		return nil
-	case groupchat.EdgeCreator:
-		m.ClearCreator()
+	case chatexport.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupChat unique edge %s", name)
+	return fmt.Errorf("unknown ChatExport unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupChatMutation) ResetEdge(name string) error {
+func (m *ChatExportMutation) ResetEdge(name string) error {
 	switch name {
-	case groupchat.EdgeAvatar:
-		m.ResetAvatar()
-		return nil
-	case groupchat.EdgeChat:
+	case chatexport.EdgeChat:
 		m.ResetChat()
 		return nil
-	case groupchat.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case groupchat.EdgeMembers:
-		m.ResetMembers()
-		return nil
-	case groupchat.EdgeReports:
-		m.ResetReports()
+	case chatexport.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupChat edge %s", name)
+	return fmt.Errorf("unknown ChatExport edge %s", name)
 }
 
-// GroupMemberMutation represents an operation that mutates the GroupMember nodes in the graph.
-type GroupMemberMutation struct {
+// ChatFolderMutation represents an operation that mutates the ChatFolder nodes in the graph.
+type ChatFolderMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	role              *groupmember.Role
-	last_read_at      *time.Time
-	joined_at         *time.Time
-	unread_count      *int
-	addunread_count   *int
-	clearedFields     map[string]struct{}
-	group_chat        *uuid.UUID
-	clearedgroup_chat bool
-	user              *uuid.UUID
-	cleareduser       bool
-	done              bool
-	oldValue          func(context.Context) (*GroupMember, error)
-	predicates        []predicate.GroupMember
-}
-
-var _ ent.Mutation = (*GroupMemberMutation)(nil)
-
-// groupmemberOption allows management of the mutation configuration using functional options.
-type groupmemberOption func(*GroupMemberMutation)
-
-// newGroupMemberMutation creates new mutation for the GroupMember entity.
-func newGroupMemberMutation(c config, op Op, opts ...groupmemberOption) *GroupMemberMutation {
-	m := &GroupMemberMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	chat_type     *chatfolder.ChatType
+	unread_only   *bool
+	muted         *bool
+	archived      *bool
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	chats         map[uuid.UUID]struct{}
+	removedchats  map[uuid.UUID]struct{}
+	clearedchats  bool
+	done          bool
+	oldValue      func(context.Context) (*ChatFolder, error)
+	predicates    []predicate.ChatFolder
+}
+
+var _ ent.Mutation = (*ChatFolderMutation)(nil)
+
+// chatfolderOption allows management of the mutation configuration using functional options.
+type chatfolderOption func(*ChatFolderMutation)
+
+// newChatFolderMutation creates new mutation for the ChatFolder entity.
+func newChatFolderMutation(c config, op Op, opts ...chatfolderOption) *ChatFolderMutation {
+	m := &ChatFolderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGroupMember,
+		typ:           TypeChatFolder,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2121,20 +2700,20 @@ func newGroupMemberMutation(c config, op Op, opts ...groupmemberOption) *GroupMe
 	return m
 }
 
-// withGroupMemberID sets the ID field of the mutation.
-func withGroupMemberID(id uuid.UUID) groupmemberOption {
-	return func(m *GroupMemberMutation) {
+// withChatFolderID sets the ID field of the mutation.
+func withChatFolderID(id uuid.UUID) chatfolderOption {
+	return func(m *ChatFolderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *GroupMember
+			value *ChatFolder
 		)
-		m.oldValue = func(ctx context.Context) (*GroupMember, error) {
+		m.oldValue = func(ctx context.Context) (*ChatFolder, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().GroupMember.Get(ctx, id)
+					value, err = m.Client().ChatFolder.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2143,10 +2722,10 @@ func withGroupMemberID(id uuid.UUID) groupmemberOption {
 	}
 }
 
-// withGroupMember sets the old GroupMember of the mutation.
-func withGroupMember(node *GroupMember) groupmemberOption {
-	return func(m *GroupMemberMutation) {
-		m.oldValue = func(context.Context) (*GroupMember, error) {
+// withChatFolder sets the old ChatFolder of the mutation.
+func withChatFolder(node *ChatFolder) chatfolderOption {
+	return func(m *ChatFolderMutation) {
+		m.oldValue = func(context.Context) (*ChatFolder, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2155,7 +2734,7 @@ func withGroupMember(node *GroupMember) groupmemberOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GroupMemberMutation) Client() *Client {
+func (m ChatFolderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2163,7 +2742,7 @@ func (m GroupMemberMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GroupMemberMutation) Tx() (*Tx, error) {
+func (m ChatFolderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2173,14 +2752,14 @@ func (m GroupMemberMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of GroupMember entities.
-func (m *GroupMemberMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ChatFolder entities.
+func (m *ChatFolderMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GroupMemberMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ChatFolderMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2191,7 +2770,7 @@ func (m *GroupMemberMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GroupMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ChatFolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2200,55 +2779,91 @@ func (m *GroupMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().GroupMember.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChatFolder.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetGroupChatID sets the "group_chat_id" field.
-func (m *GroupMemberMutation) SetGroupChatID(u uuid.UUID) {
-	m.group_chat = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatFolderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// GroupChatID returns the value of the "group_chat_id" field in the mutation.
-func (m *GroupMemberMutation) GroupChatID() (r uuid.UUID, exists bool) {
-	v := m.group_chat
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatFolderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupChatID returns the old "group_chat_id" field's value of the GroupMember entity.
-// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMemberMutation) OldGroupChatID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ChatFolderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupChatID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.GroupChatID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetGroupChatID resets all changes to the "group_chat_id" field.
-func (m *GroupMemberMutation) ResetGroupChatID() {
-	m.group_chat = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatFolderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ChatFolderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ChatFolderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatFolderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ChatFolderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // SetUserID sets the "user_id" field.
-func (m *GroupMemberMutation) SetUserID(u uuid.UUID) {
+func (m *ChatFolderMutation) SetUserID(u uuid.UUID) {
 	m.user = &u
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *GroupMemberMutation) UserID() (r uuid.UUID, exists bool) {
+func (m *ChatFolderMutation) UserID() (r uuid.UUID, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -2256,10 +2871,10 @@ func (m *GroupMemberMutation) UserID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the GroupMember entity.
-// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ChatFolderMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -2274,229 +2889,244 @@ func (m *GroupMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err e
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *GroupMemberMutation) ResetUserID() {
+func (m *ChatFolderMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetRole sets the "role" field.
-func (m *GroupMemberMutation) SetRole(gr groupmember.Role) {
-	m.role = &gr
+// SetName sets the "name" field.
+func (m *ChatFolderMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *GroupMemberMutation) Role() (r groupmember.Role, exists bool) {
-	v := m.role
+// Name returns the value of the "name" field in the mutation.
+func (m *ChatFolderMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the GroupMember entity.
-// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMemberMutation) OldRole(ctx context.Context) (v groupmember.Role, err error) {
+func (m *ChatFolderMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Name, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *GroupMemberMutation) ResetRole() {
-	m.role = nil
+// ResetName resets all changes to the "name" field.
+func (m *ChatFolderMutation) ResetName() {
+	m.name = nil
 }
 
-// SetLastReadAt sets the "last_read_at" field.
-func (m *GroupMemberMutation) SetLastReadAt(t time.Time) {
-	m.last_read_at = &t
+// SetChatType sets the "chat_type" field.
+func (m *ChatFolderMutation) SetChatType(ct chatfolder.ChatType) {
+	m.chat_type = &ct
 }
 
-// LastReadAt returns the value of the "last_read_at" field in the mutation.
-func (m *GroupMemberMutation) LastReadAt() (r time.Time, exists bool) {
-	v := m.last_read_at
+// ChatType returns the value of the "chat_type" field in the mutation.
+func (m *ChatFolderMutation) ChatType() (r chatfolder.ChatType, exists bool) {
+	v := m.chat_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastReadAt returns the old "last_read_at" field's value of the GroupMember entity.
-// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// OldChatType returns the old "chat_type" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMemberMutation) OldLastReadAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ChatFolderMutation) OldChatType(ctx context.Context) (v *chatfolder.ChatType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastReadAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastReadAt requires an ID field in the mutation")
+		return v, errors.New("OldChatType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastReadAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatType: %w", err)
 	}
-	return oldValue.LastReadAt, nil
+	return oldValue.ChatType, nil
 }
 
-// ClearLastReadAt clears the value of the "last_read_at" field.
-func (m *GroupMemberMutation) ClearLastReadAt() {
-	m.last_read_at = nil
-	m.clearedFields[groupmember.FieldLastReadAt] = struct{}{}
+// ClearChatType clears the value of the "chat_type" field.
+func (m *ChatFolderMutation) ClearChatType() {
+	m.chat_type = nil
+	m.clearedFields[chatfolder.FieldChatType] = struct{}{}
 }
 
-// LastReadAtCleared returns if the "last_read_at" field was cleared in this mutation.
-func (m *GroupMemberMutation) LastReadAtCleared() bool {
-	_, ok := m.clearedFields[groupmember.FieldLastReadAt]
+// ChatTypeCleared returns if the "chat_type" field was cleared in this mutation.
+func (m *ChatFolderMutation) ChatTypeCleared() bool {
+	_, ok := m.clearedFields[chatfolder.FieldChatType]
 	return ok
 }
 
-// ResetLastReadAt resets all changes to the "last_read_at" field.
-func (m *GroupMemberMutation) ResetLastReadAt() {
-	m.last_read_at = nil
-	delete(m.clearedFields, groupmember.FieldLastReadAt)
+// ResetChatType resets all changes to the "chat_type" field.
+func (m *ChatFolderMutation) ResetChatType() {
+	m.chat_type = nil
+	delete(m.clearedFields, chatfolder.FieldChatType)
 }
 
-// SetJoinedAt sets the "joined_at" field.
-func (m *GroupMemberMutation) SetJoinedAt(t time.Time) {
-	m.joined_at = &t
+// SetUnreadOnly sets the "unread_only" field.
+func (m *ChatFolderMutation) SetUnreadOnly(b bool) {
+	m.unread_only = &b
 }
 
-// JoinedAt returns the value of the "joined_at" field in the mutation.
-func (m *GroupMemberMutation) JoinedAt() (r time.Time, exists bool) {
-	v := m.joined_at
+// UnreadOnly returns the value of the "unread_only" field in the mutation.
+func (m *ChatFolderMutation) UnreadOnly() (r bool, exists bool) {
+	v := m.unread_only
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJoinedAt returns the old "joined_at" field's value of the GroupMember entity.
-// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// OldUnreadOnly returns the old "unread_only" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatFolderMutation) OldUnreadOnly(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnreadOnly is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+		return v, errors.New("OldUnreadOnly requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnreadOnly: %w", err)
 	}
-	return oldValue.JoinedAt, nil
+	return oldValue.UnreadOnly, nil
 }
 
-// ResetJoinedAt resets all changes to the "joined_at" field.
-func (m *GroupMemberMutation) ResetJoinedAt() {
-	m.joined_at = nil
+// ResetUnreadOnly resets all changes to the "unread_only" field.
+func (m *ChatFolderMutation) ResetUnreadOnly() {
+	m.unread_only = nil
 }
 
-// SetUnreadCount sets the "unread_count" field.
-func (m *GroupMemberMutation) SetUnreadCount(i int) {
-	m.unread_count = &i
-	m.addunread_count = nil
+// SetMuted sets the "muted" field.
+func (m *ChatFolderMutation) SetMuted(b bool) {
+	m.muted = &b
 }
 
-// UnreadCount returns the value of the "unread_count" field in the mutation.
-func (m *GroupMemberMutation) UnreadCount() (r int, exists bool) {
-	v := m.unread_count
+// Muted returns the value of the "muted" field in the mutation.
+func (m *ChatFolderMutation) Muted() (r bool, exists bool) {
+	v := m.muted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUnreadCount returns the old "unread_count" field's value of the GroupMember entity.
-// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// OldMuted returns the old "muted" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GroupMemberMutation) OldUnreadCount(ctx context.Context) (v int, err error) {
+func (m *ChatFolderMutation) OldMuted(ctx context.Context) (v *bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUnreadCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldMuted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUnreadCount requires an ID field in the mutation")
+		return v, errors.New("OldMuted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnreadCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldMuted: %w", err)
 	}
-	return oldValue.UnreadCount, nil
+	return oldValue.Muted, nil
 }
 
-// AddUnreadCount adds i to the "unread_count" field.
-func (m *GroupMemberMutation) AddUnreadCount(i int) {
-	if m.addunread_count != nil {
-		*m.addunread_count += i
-	} else {
-		m.addunread_count = &i
-	}
+// ClearMuted clears the value of the "muted" field.
+func (m *ChatFolderMutation) ClearMuted() {
+	m.muted = nil
+	m.clearedFields[chatfolder.FieldMuted] = struct{}{}
 }
 
-// AddedUnreadCount returns the value that was added to the "unread_count" field in this mutation.
-func (m *GroupMemberMutation) AddedUnreadCount() (r int, exists bool) {
-	v := m.addunread_count
+// MutedCleared returns if the "muted" field was cleared in this mutation.
+func (m *ChatFolderMutation) MutedCleared() bool {
+	_, ok := m.clearedFields[chatfolder.FieldMuted]
+	return ok
+}
+
+// ResetMuted resets all changes to the "muted" field.
+func (m *ChatFolderMutation) ResetMuted() {
+	m.muted = nil
+	delete(m.clearedFields, chatfolder.FieldMuted)
+}
+
+// SetArchived sets the "archived" field.
+func (m *ChatFolderMutation) SetArchived(b bool) {
+	m.archived = &b
+}
+
+// Archived returns the value of the "archived" field in the mutation.
+func (m *ChatFolderMutation) Archived() (r bool, exists bool) {
+	v := m.archived
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUnreadCount resets all changes to the "unread_count" field.
-func (m *GroupMemberMutation) ResetUnreadCount() {
-	m.unread_count = nil
-	m.addunread_count = nil
-}
-
-// ClearGroupChat clears the "group_chat" edge to the GroupChat entity.
-func (m *GroupMemberMutation) ClearGroupChat() {
-	m.clearedgroup_chat = true
-	m.clearedFields[groupmember.FieldGroupChatID] = struct{}{}
+// OldArchived returns the old "archived" field's value of the ChatFolder entity.
+// If the ChatFolder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChatFolderMutation) OldArchived(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchived: %w", err)
+	}
+	return oldValue.Archived, nil
 }
 
-// GroupChatCleared reports if the "group_chat" edge to the GroupChat entity was cleared.
-func (m *GroupMemberMutation) GroupChatCleared() bool {
-	return m.clearedgroup_chat
+// ClearArchived clears the value of the "archived" field.
+func (m *ChatFolderMutation) ClearArchived() {
+	m.archived = nil
+	m.clearedFields[chatfolder.FieldArchived] = struct{}{}
 }
 
-// GroupChatIDs returns the "group_chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupChatID instead. It exists only for internal usage by the builders.
-func (m *GroupMemberMutation) GroupChatIDs() (ids []uuid.UUID) {
-	if id := m.group_chat; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ArchivedCleared returns if the "archived" field was cleared in this mutation.
+func (m *ChatFolderMutation) ArchivedCleared() bool {
+	_, ok := m.clearedFields[chatfolder.FieldArchived]
+	return ok
 }
 
-// ResetGroupChat resets all changes to the "group_chat" edge.
-func (m *GroupMemberMutation) ResetGroupChat() {
-	m.group_chat = nil
-	m.clearedgroup_chat = false
+// ResetArchived resets all changes to the "archived" field.
+func (m *ChatFolderMutation) ResetArchived() {
+	m.archived = nil
+	delete(m.clearedFields, chatfolder.FieldArchived)
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *GroupMemberMutation) ClearUser() {
+func (m *ChatFolderMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[groupmember.FieldUserID] = struct{}{}
+	m.clearedFields[chatfolder.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *GroupMemberMutation) UserCleared() bool {
+func (m *ChatFolderMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *GroupMemberMutation) UserIDs() (ids []uuid.UUID) {
+func (m *ChatFolderMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2504,20 +3134,74 @@ func (m *GroupMemberMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *GroupMemberMutation) ResetUser() {
+func (m *ChatFolderMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the GroupMemberMutation builder.
-func (m *GroupMemberMutation) Where(ps ...predicate.GroupMember) {
+// AddChatIDs adds the "chats" edge to the ChatFolderChat entity by ids.
+func (m *ChatFolderMutation) AddChatIDs(ids ...uuid.UUID) {
+	if m.chats == nil {
+		m.chats = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.chats[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChats clears the "chats" edge to the ChatFolderChat entity.
+func (m *ChatFolderMutation) ClearChats() {
+	m.clearedchats = true
+}
+
+// ChatsCleared reports if the "chats" edge to the ChatFolderChat entity was cleared.
+func (m *ChatFolderMutation) ChatsCleared() bool {
+	return m.clearedchats
+}
+
+// RemoveChatIDs removes the "chats" edge to the ChatFolderChat entity by IDs.
+func (m *ChatFolderMutation) RemoveChatIDs(ids ...uuid.UUID) {
+	if m.removedchats == nil {
+		m.removedchats = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.chats, ids[i])
+		m.removedchats[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChats returns the removed IDs of the "chats" edge to the ChatFolderChat entity.
+func (m *ChatFolderMutation) RemovedChatsIDs() (ids []uuid.UUID) {
+	for id := range m.removedchats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChatsIDs returns the "chats" edge IDs in the mutation.
+func (m *ChatFolderMutation) ChatsIDs() (ids []uuid.UUID) {
+	for id := range m.chats {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChats resets all changes to the "chats" edge.
+func (m *ChatFolderMutation) ResetChats() {
+	m.chats = nil
+	m.clearedchats = false
+	m.removedchats = nil
+}
+
+// Where appends a list predicates to the ChatFolderMutation builder.
+func (m *ChatFolderMutation) Where(ps ...predicate.ChatFolder) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GroupMemberMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChatFolderMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GroupMemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.GroupMember, len(ps))
+func (m *ChatFolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatFolder, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2525,42 +3209,48 @@ func (m *GroupMemberMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GroupMemberMutation) Op() Op {
+func (m *ChatFolderMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GroupMemberMutation) SetOp(op Op) {
+func (m *ChatFolderMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (GroupMember).
-func (m *GroupMemberMutation) Type() string {
+// Type returns the node type of this mutation (ChatFolder).
+func (m *ChatFolderMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GroupMemberMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.group_chat != nil {
-		fields = append(fields, groupmember.FieldGroupChatID)
+func (m *ChatFolderMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, chatfolder.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, chatfolder.FieldUpdatedAt)
 	}
 	if m.user != nil {
-		fields = append(fields, groupmember.FieldUserID)
+		fields = append(fields, chatfolder.FieldUserID)
 	}
-	if m.role != nil {
-		fields = append(fields, groupmember.FieldRole)
+	if m.name != nil {
+		fields = append(fields, chatfolder.FieldName)
 	}
-	if m.last_read_at != nil {
-		fields = append(fields, groupmember.FieldLastReadAt)
+	if m.chat_type != nil {
+		fields = append(fields, chatfolder.FieldChatType)
 	}
-	if m.joined_at != nil {
-		fields = append(fields, groupmember.FieldJoinedAt)
+	if m.unread_only != nil {
+		fields = append(fields, chatfolder.FieldUnreadOnly)
 	}
-	if m.unread_count != nil {
-		fields = append(fields, groupmember.FieldUnreadCount)
+	if m.muted != nil {
+		fields = append(fields, chatfolder.FieldMuted)
+	}
+	if m.archived != nil {
+		fields = append(fields, chatfolder.FieldArchived)
 	}
 	return fields
 }
@@ -2568,20 +3258,24 @@ func (m *GroupMemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GroupMemberMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatFolderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case groupmember.FieldGroupChatID:
-		return m.GroupChatID()
-	case groupmember.FieldUserID:
+	case chatfolder.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatfolder.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case chatfolder.FieldUserID:
 		return m.UserID()
-	case groupmember.FieldRole:
-		return m.Role()
-	case groupmember.FieldLastReadAt:
-		return m.LastReadAt()
-	case groupmember.FieldJoinedAt:
-		return m.JoinedAt()
-	case groupmember.FieldUnreadCount:
-		return m.UnreadCount()
+	case chatfolder.FieldName:
+		return m.Name()
+	case chatfolder.FieldChatType:
+		return m.ChatType()
+	case chatfolder.FieldUnreadOnly:
+		return m.UnreadOnly()
+	case chatfolder.FieldMuted:
+		return m.Muted()
+	case chatfolder.FieldArchived:
+		return m.Archived()
 	}
 	return nil, false
 }
@@ -2589,303 +3283,318 @@ func (m *GroupMemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GroupMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatFolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case groupmember.FieldGroupChatID:
-		return m.OldGroupChatID(ctx)
-	case groupmember.FieldUserID:
+	case chatfolder.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatfolder.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case chatfolder.FieldUserID:
 		return m.OldUserID(ctx)
-	case groupmember.FieldRole:
-		return m.OldRole(ctx)
-	case groupmember.FieldLastReadAt:
-		return m.OldLastReadAt(ctx)
-	case groupmember.FieldJoinedAt:
-		return m.OldJoinedAt(ctx)
-	case groupmember.FieldUnreadCount:
-		return m.OldUnreadCount(ctx)
+	case chatfolder.FieldName:
+		return m.OldName(ctx)
+	case chatfolder.FieldChatType:
+		return m.OldChatType(ctx)
+	case chatfolder.FieldUnreadOnly:
+		return m.OldUnreadOnly(ctx)
+	case chatfolder.FieldMuted:
+		return m.OldMuted(ctx)
+	case chatfolder.FieldArchived:
+		return m.OldArchived(ctx)
 	}
-	return nil, fmt.Errorf("unknown GroupMember field %s", name)
+	return nil, fmt.Errorf("unknown ChatFolder field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMemberMutation) SetField(name string, value ent.Value) error {
+func (m *ChatFolderMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case groupmember.FieldGroupChatID:
-		v, ok := value.(uuid.UUID)
+	case chatfolder.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroupChatID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case groupmember.FieldUserID:
+	case chatfolder.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case chatfolder.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case groupmember.FieldRole:
-		v, ok := value.(groupmember.Role)
+	case chatfolder.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetName(v)
 		return nil
-	case groupmember.FieldLastReadAt:
-		v, ok := value.(time.Time)
+	case chatfolder.FieldChatType:
+		v, ok := value.(chatfolder.ChatType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastReadAt(v)
+		m.SetChatType(v)
 		return nil
-	case groupmember.FieldJoinedAt:
-		v, ok := value.(time.Time)
+	case chatfolder.FieldUnreadOnly:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJoinedAt(v)
+		m.SetUnreadOnly(v)
 		return nil
-	case groupmember.FieldUnreadCount:
-		v, ok := value.(int)
+	case chatfolder.FieldMuted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUnreadCount(v)
+		m.SetMuted(v)
+		return nil
+	case chatfolder.FieldArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchived(v)
 		return nil
 	}
-	return fmt.Errorf("unknown GroupMember field %s", name)
+	return fmt.Errorf("unknown ChatFolder field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GroupMemberMutation) AddedFields() []string {
-	var fields []string
-	if m.addunread_count != nil {
-		fields = append(fields, groupmember.FieldUnreadCount)
-	}
-	return fields
+func (m *ChatFolderMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GroupMemberMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case groupmember.FieldUnreadCount:
-		return m.AddedUnreadCount()
-	}
+func (m *ChatFolderMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GroupMemberMutation) AddField(name string, value ent.Value) error {
+func (m *ChatFolderMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case groupmember.FieldUnreadCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUnreadCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown GroupMember numeric field %s", name)
+	return fmt.Errorf("unknown ChatFolder numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GroupMemberMutation) ClearedFields() []string {
+func (m *ChatFolderMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(groupmember.FieldLastReadAt) {
-		fields = append(fields, groupmember.FieldLastReadAt)
+	if m.FieldCleared(chatfolder.FieldChatType) {
+		fields = append(fields, chatfolder.FieldChatType)
+	}
+	if m.FieldCleared(chatfolder.FieldMuted) {
+		fields = append(fields, chatfolder.FieldMuted)
+	}
+	if m.FieldCleared(chatfolder.FieldArchived) {
+		fields = append(fields, chatfolder.FieldArchived)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GroupMemberMutation) FieldCleared(name string) bool {
+func (m *ChatFolderMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GroupMemberMutation) ClearField(name string) error {
+func (m *ChatFolderMutation) ClearField(name string) error {
 	switch name {
-	case groupmember.FieldLastReadAt:
-		m.ClearLastReadAt()
+	case chatfolder.FieldChatType:
+		m.ClearChatType()
+		return nil
+	case chatfolder.FieldMuted:
+		m.ClearMuted()
+		return nil
+	case chatfolder.FieldArchived:
+		m.ClearArchived()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupMember nullable field %s", name)
+	return fmt.Errorf("unknown ChatFolder nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GroupMemberMutation) ResetField(name string) error {
+func (m *ChatFolderMutation) ResetField(name string) error {
 	switch name {
-	case groupmember.FieldGroupChatID:
-		m.ResetGroupChatID()
+	case chatfolder.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case groupmember.FieldUserID:
+	case chatfolder.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case chatfolder.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case groupmember.FieldRole:
-		m.ResetRole()
+	case chatfolder.FieldName:
+		m.ResetName()
 		return nil
-	case groupmember.FieldLastReadAt:
-		m.ResetLastReadAt()
+	case chatfolder.FieldChatType:
+		m.ResetChatType()
 		return nil
-	case groupmember.FieldJoinedAt:
-		m.ResetJoinedAt()
+	case chatfolder.FieldUnreadOnly:
+		m.ResetUnreadOnly()
 		return nil
-	case groupmember.FieldUnreadCount:
-		m.ResetUnreadCount()
+	case chatfolder.FieldMuted:
+		m.ResetMuted()
+		return nil
+	case chatfolder.FieldArchived:
+		m.ResetArchived()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupMember field %s", name)
+	return fmt.Errorf("unknown ChatFolder field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GroupMemberMutation) AddedEdges() []string {
+func (m *ChatFolderMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.group_chat != nil {
-		edges = append(edges, groupmember.EdgeGroupChat)
-	}
 	if m.user != nil {
-		edges = append(edges, groupmember.EdgeUser)
+		edges = append(edges, chatfolder.EdgeUser)
+	}
+	if m.chats != nil {
+		edges = append(edges, chatfolder.EdgeChats)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GroupMemberMutation) AddedIDs(name string) []ent.Value {
+func (m *ChatFolderMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case groupmember.EdgeGroupChat:
-		if id := m.group_chat; id != nil {
-			return []ent.Value{*id}
-		}
-	case groupmember.EdgeUser:
+	case chatfolder.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	}
+	case chatfolder.EdgeChats:
+		ids := make([]ent.Value, 0, len(m.chats))
+		for id := range m.chats {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GroupMemberMutation) RemovedEdges() []string {
+func (m *ChatFolderMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedchats != nil {
+		edges = append(edges, chatfolder.EdgeChats)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GroupMemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChatFolderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case chatfolder.EdgeChats:
+		ids := make([]ent.Value, 0, len(m.removedchats))
+		for id := range m.removedchats {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GroupMemberMutation) ClearedEdges() []string {
+func (m *ChatFolderMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedgroup_chat {
-		edges = append(edges, groupmember.EdgeGroupChat)
-	}
 	if m.cleareduser {
-		edges = append(edges, groupmember.EdgeUser)
+		edges = append(edges, chatfolder.EdgeUser)
+	}
+	if m.clearedchats {
+		edges = append(edges, chatfolder.EdgeChats)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GroupMemberMutation) EdgeCleared(name string) bool {
+func (m *ChatFolderMutation) EdgeCleared(name string) bool {
 	switch name {
-	case groupmember.EdgeGroupChat:
-		return m.clearedgroup_chat
-	case groupmember.EdgeUser:
+	case chatfolder.EdgeUser:
 		return m.cleareduser
+	case chatfolder.EdgeChats:
+		return m.clearedchats
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GroupMemberMutation) ClearEdge(name string) error {
+func (m *ChatFolderMutation) ClearEdge(name string) error {
 	switch name {
-	case groupmember.EdgeGroupChat:
-		m.ClearGroupChat()
-		return nil
-	case groupmember.EdgeUser:
+	case chatfolder.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown GroupMember unique edge %s", name)
+	return fmt.Errorf("unknown ChatFolder unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GroupMemberMutation) ResetEdge(name string) error {
+func (m *ChatFolderMutation) ResetEdge(name string) error {
 	switch name {
-	case groupmember.EdgeGroupChat:
-		m.ResetGroupChat()
-		return nil
-	case groupmember.EdgeUser:
+	case chatfolder.EdgeUser:
 		m.ResetUser()
 		return nil
+	case chatfolder.EdgeChats:
+		m.ResetChats()
+		return nil
 	}
-	return fmt.Errorf("unknown GroupMember edge %s", name)
+	return fmt.Errorf("unknown ChatFolder edge %s", name)
 }
 
-// MediaMutation represents an operation that mutates the Media nodes in the graph.
-type MediaMutation struct {
+// ChatFolderChatMutation represents an operation that mutates the ChatFolderChat nodes in the graph.
+type ChatFolderChatMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	created_at          *time.Time
-	updated_at          *time.Time
-	file_name           *string
-	original_name       *string
-	file_size           *int64
-	addfile_size        *int64
-	mime_type           *string
-	category            *media.Category
-	upload_status       *media.UploadStatus
-	upload_expires_at   *time.Time
-	completed_at        *time.Time
-	clearedFields       map[string]struct{}
-	message             *uuid.UUID
-	clearedmessage      bool
-	user_avatar         *uuid.UUID
-	cleareduser_avatar  bool
-	group_avatar        *uuid.UUID
-	clearedgroup_avatar bool
-	uploader            *uuid.UUID
-	cleareduploader     bool
-	reports             map[uuid.UUID]struct{}
-	removedreports      map[uuid.UUID]struct{}
-	clearedreports      bool
-	done                bool
-	oldValue            func(context.Context) (*Media, error)
-	predicates          []predicate.Media
-}
-
-var _ ent.Mutation = (*MediaMutation)(nil)
-
-// mediaOption allows management of the mutation configuration using functional options.
-type mediaOption func(*MediaMutation)
-
-// newMediaMutation creates new mutation for the Media entity.
-func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
-	m := &MediaMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	folder        *uuid.UUID
+	clearedfolder bool
+	chat          *uuid.UUID
+	clearedchat   bool
+	done          bool
+	oldValue      func(context.Context) (*ChatFolderChat, error)
+	predicates    []predicate.ChatFolderChat
+}
+
+var _ ent.Mutation = (*ChatFolderChatMutation)(nil)
+
+// chatfolderchatOption allows management of the mutation configuration using functional options.
+type chatfolderchatOption func(*ChatFolderChatMutation)
+
+// newChatFolderChatMutation creates new mutation for the ChatFolderChat entity.
+func newChatFolderChatMutation(c config, op Op, opts ...chatfolderchatOption) *ChatFolderChatMutation {
+	m := &ChatFolderChatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMedia,
+		typ:           TypeChatFolderChat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2894,20 +3603,20 @@ func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
 	return m
 }
 
-// withMediaID sets the ID field of the mutation.
-func withMediaID(id uuid.UUID) mediaOption {
-	return func(m *MediaMutation) {
+// withChatFolderChatID sets the ID field of the mutation.
+func withChatFolderChatID(id uuid.UUID) chatfolderchatOption {
+	return func(m *ChatFolderChatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Media
+			value *ChatFolderChat
 		)
-		m.oldValue = func(ctx context.Context) (*Media, error) {
+		m.oldValue = func(ctx context.Context) (*ChatFolderChat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Media.Get(ctx, id)
+					value, err = m.Client().ChatFolderChat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2916,10 +3625,10 @@ func withMediaID(id uuid.UUID) mediaOption {
 	}
 }
 
-// withMedia sets the old Media of the mutation.
-func withMedia(node *Media) mediaOption {
-	return func(m *MediaMutation) {
-		m.oldValue = func(context.Context) (*Media, error) {
+// withChatFolderChat sets the old ChatFolderChat of the mutation.
+func withChatFolderChat(node *ChatFolderChat) chatfolderchatOption {
+	return func(m *ChatFolderChatMutation) {
+		m.oldValue = func(context.Context) (*ChatFolderChat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2928,7 +3637,7 @@ func withMedia(node *Media) mediaOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MediaMutation) Client() *Client {
+func (m ChatFolderChatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2936,7 +3645,7 @@ func (m MediaMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MediaMutation) Tx() (*Tx, error) {
+func (m ChatFolderChatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2946,14 +3655,14 @@ func (m MediaMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Media entities.
-func (m *MediaMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ChatFolderChat entities.
+func (m *ChatFolderChatMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MediaMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ChatFolderChatMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2964,7 +3673,7 @@ func (m *MediaMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MediaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ChatFolderChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2973,19 +3682,19 @@ func (m *MediaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ChatFolderChat.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MediaMutation) SetCreatedAt(t time.Time) {
+func (m *ChatFolderChatMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ChatFolderChatMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2993,10 +3702,10 @@ func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ChatFolderChat entity.
+// If the ChatFolderChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatFolderChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3011,17 +3720,17 @@ func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MediaMutation) ResetCreatedAt() {
+func (m *ChatFolderChatMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MediaMutation) SetUpdatedAt(t time.Time) {
+func (m *ChatFolderChatMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MediaMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ChatFolderChatMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3029,10 +3738,10 @@ func (m *MediaMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ChatFolderChat entity.
+// If the ChatFolderChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatFolderChatMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3047,1985 +3756,2256 @@ func (m *MediaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MediaMutation) ResetUpdatedAt() {
+func (m *ChatFolderChatMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetFileName sets the "file_name" field.
-func (m *MediaMutation) SetFileName(s string) {
-	m.file_name = &s
+// SetFolderID sets the "folder_id" field.
+func (m *ChatFolderChatMutation) SetFolderID(u uuid.UUID) {
+	m.folder = &u
 }
 
-// FileName returns the value of the "file_name" field in the mutation.
-func (m *MediaMutation) FileName() (r string, exists bool) {
-	v := m.file_name
+// FolderID returns the value of the "folder_id" field in the mutation.
+func (m *ChatFolderChatMutation) FolderID() (r uuid.UUID, exists bool) {
+	v := m.folder
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFileName returns the old "file_name" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldFolderID returns the old "folder_id" field's value of the ChatFolderChat entity.
+// If the ChatFolderChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldFileName(ctx context.Context) (v string, err error) {
+func (m *ChatFolderChatMutation) OldFolderID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+		return v, errors.New("OldFolderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileName requires an ID field in the mutation")
+		return v, errors.New("OldFolderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+		return v, fmt.Errorf("querying old value for OldFolderID: %w", err)
 	}
-	return oldValue.FileName, nil
+	return oldValue.FolderID, nil
 }
 
-// ResetFileName resets all changes to the "file_name" field.
-func (m *MediaMutation) ResetFileName() {
-	m.file_name = nil
+// ResetFolderID resets all changes to the "folder_id" field.
+func (m *ChatFolderChatMutation) ResetFolderID() {
+	m.folder = nil
 }
 
-// SetOriginalName sets the "original_name" field.
-func (m *MediaMutation) SetOriginalName(s string) {
-	m.original_name = &s
+// SetChatID sets the "chat_id" field.
+func (m *ChatFolderChatMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
 }
 
-// OriginalName returns the value of the "original_name" field in the mutation.
-func (m *MediaMutation) OriginalName() (r string, exists bool) {
-	v := m.original_name
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ChatFolderChatMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOriginalName returns the old "original_name" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the ChatFolderChat entity.
+// If the ChatFolderChat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldOriginalName(ctx context.Context) (v string, err error) {
+func (m *ChatFolderChatMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOriginalName is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOriginalName requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOriginalName: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.OriginalName, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetOriginalName resets all changes to the "original_name" field.
-func (m *MediaMutation) ResetOriginalName() {
-	m.original_name = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ChatFolderChatMutation) ResetChatID() {
+	m.chat = nil
 }
 
-// SetFileSize sets the "file_size" field.
-func (m *MediaMutation) SetFileSize(i int64) {
-	m.file_size = &i
-	m.addfile_size = nil
+// ClearFolder clears the "folder" edge to the ChatFolder entity.
+func (m *ChatFolderChatMutation) ClearFolder() {
+	m.clearedfolder = true
+	m.clearedFields[chatfolderchat.FieldFolderID] = struct{}{}
 }
 
-// FileSize returns the value of the "file_size" field in the mutation.
-func (m *MediaMutation) FileSize() (r int64, exists bool) {
-	v := m.file_size
-	if v == nil {
-		return
-	}
-	return *v, true
+// FolderCleared reports if the "folder" edge to the ChatFolder entity was cleared.
+func (m *ChatFolderChatMutation) FolderCleared() bool {
+	return m.clearedfolder
 }
 
-// OldFileSize returns the old "file_size" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldFileSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFileSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+// FolderIDs returns the "folder" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *ChatFolderChatMutation) FolderIDs() (ids []uuid.UUID) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.FileSize, nil
+	return
 }
 
-// AddFileSize adds i to the "file_size" field.
-func (m *MediaMutation) AddFileSize(i int64) {
-	if m.addfile_size != nil {
-		*m.addfile_size += i
-	} else {
-		m.addfile_size = &i
-	}
+// ResetFolder resets all changes to the "folder" edge.
+func (m *ChatFolderChatMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
 }
 
-// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
-func (m *MediaMutation) AddedFileSize() (r int64, exists bool) {
-	v := m.addfile_size
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *ChatFolderChatMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[chatfolderchat.FieldChatID] = struct{}{}
 }
 
-// ResetFileSize resets all changes to the "file_size" field.
-func (m *MediaMutation) ResetFileSize() {
-	m.file_size = nil
-	m.addfile_size = nil
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *ChatFolderChatMutation) ChatCleared() bool {
+	return m.clearedchat
 }
 
-// SetMimeType sets the "mime_type" field.
-func (m *MediaMutation) SetMimeType(s string) {
-	m.mime_type = &s
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *ChatFolderChatMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// MimeType returns the value of the "mime_type" field in the mutation.
-func (m *MediaMutation) MimeType() (r string, exists bool) {
-	v := m.mime_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetChat resets all changes to the "chat" edge.
+func (m *ChatFolderChatMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
 }
 
-// OldMimeType returns the old "mime_type" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldMimeType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMimeType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+// Where appends a list predicates to the ChatFolderChatMutation builder.
+func (m *ChatFolderChatMutation) Where(ps ...predicate.ChatFolderChat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatFolderChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatFolderChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ChatFolderChat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.MimeType, nil
+	m.Where(p...)
 }
 
-// ResetMimeType resets all changes to the "mime_type" field.
-func (m *MediaMutation) ResetMimeType() {
-	m.mime_type = nil
+// Op returns the operation name.
+func (m *ChatFolderChatMutation) Op() Op {
+	return m.op
 }
 
-// SetCategory sets the "category" field.
-func (m *MediaMutation) SetCategory(value media.Category) {
-	m.category = &value
+// SetOp allows setting the mutation operation.
+func (m *ChatFolderChatMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *MediaMutation) Category() (r media.Category, exists bool) {
-	v := m.category
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (ChatFolderChat).
+func (m *ChatFolderChatMutation) Type() string {
+	return m.typ
 }
 
-// OldCategory returns the old "category" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldCategory(ctx context.Context) (v media.Category, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ChatFolderChatMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, chatfolderchat.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, chatfolderchat.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	if m.folder != nil {
+		fields = append(fields, chatfolderchat.FieldFolderID)
 	}
-	return oldValue.Category, nil
-}
-
-// ResetCategory resets all changes to the "category" field.
-func (m *MediaMutation) ResetCategory() {
-	m.category = nil
+	if m.chat != nil {
+		fields = append(fields, chatfolderchat.FieldChatID)
+	}
+	return fields
 }
 
-// SetUploadStatus sets the "upload_status" field.
-func (m *MediaMutation) SetUploadStatus(ms media.UploadStatus) {
-	m.upload_status = &ms
-}
-
-// UploadStatus returns the value of the "upload_status" field in the mutation.
-func (m *MediaMutation) UploadStatus() (r media.UploadStatus, exists bool) {
-	v := m.upload_status
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ChatFolderChatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case chatfolderchat.FieldCreatedAt:
+		return m.CreatedAt()
+	case chatfolderchat.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case chatfolderchat.FieldFolderID:
+		return m.FolderID()
+	case chatfolderchat.FieldChatID:
+		return m.ChatID()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldUploadStatus returns the old "upload_status" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldUploadStatus(ctx context.Context) (v media.UploadStatus, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadStatus: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ChatFolderChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case chatfolderchat.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case chatfolderchat.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case chatfolderchat.FieldFolderID:
+		return m.OldFolderID(ctx)
+	case chatfolderchat.FieldChatID:
+		return m.OldChatID(ctx)
 	}
-	return oldValue.UploadStatus, nil
+	return nil, fmt.Errorf("unknown ChatFolderChat field %s", name)
 }
 
-// ResetUploadStatus resets all changes to the "upload_status" field.
-func (m *MediaMutation) ResetUploadStatus() {
-	m.upload_status = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatFolderChatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case chatfolderchat.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case chatfolderchat.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case chatfolderchat.FieldFolderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFolderID(v)
+		return nil
+	case chatfolderchat.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ChatFolderChat field %s", name)
 }
 
-// SetUploadExpiresAt sets the "upload_expires_at" field.
-func (m *MediaMutation) SetUploadExpiresAt(t time.Time) {
-	m.upload_expires_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ChatFolderChatMutation) AddedFields() []string {
+	return nil
 }
 
-// UploadExpiresAt returns the value of the "upload_expires_at" field in the mutation.
-func (m *MediaMutation) UploadExpiresAt() (r time.Time, exists bool) {
-	v := m.upload_expires_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ChatFolderChatMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldUploadExpiresAt returns the old "upload_expires_at" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldUploadExpiresAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadExpiresAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadExpiresAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadExpiresAt: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ChatFolderChatMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.UploadExpiresAt, nil
+	return fmt.Errorf("unknown ChatFolderChat numeric field %s", name)
 }
 
-// ClearUploadExpiresAt clears the value of the "upload_expires_at" field.
-func (m *MediaMutation) ClearUploadExpiresAt() {
-	m.upload_expires_at = nil
-	m.clearedFields[media.FieldUploadExpiresAt] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ChatFolderChatMutation) ClearedFields() []string {
+	return nil
 }
 
-// UploadExpiresAtCleared returns if the "upload_expires_at" field was cleared in this mutation.
-func (m *MediaMutation) UploadExpiresAtCleared() bool {
-	_, ok := m.clearedFields[media.FieldUploadExpiresAt]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ChatFolderChatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetUploadExpiresAt resets all changes to the "upload_expires_at" field.
-func (m *MediaMutation) ResetUploadExpiresAt() {
-	m.upload_expires_at = nil
-	delete(m.clearedFields, media.FieldUploadExpiresAt)
-}
-
-// SetCompletedAt sets the "completed_at" field.
-func (m *MediaMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ChatFolderChatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ChatFolderChat nullable field %s", name)
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *MediaMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ChatFolderChatMutation) ResetField(name string) error {
+	switch name {
+	case chatfolderchat.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case chatfolderchat.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case chatfolderchat.FieldFolderID:
+		m.ResetFolderID()
+		return nil
+	case chatfolderchat.FieldChatID:
+		m.ResetChatID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ChatFolderChat field %s", name)
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ChatFolderChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.folder != nil {
+		edges = append(edges, chatfolderchat.EdgeFolder)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	if m.chat != nil {
+		edges = append(edges, chatfolderchat.EdgeChat)
 	}
-	return oldValue.CompletedAt, nil
+	return edges
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *MediaMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[media.FieldCompletedAt] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ChatFolderChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chatfolderchat.EdgeFolder:
+		if id := m.folder; id != nil {
+			return []ent.Value{*id}
+		}
+	case chatfolderchat.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *MediaMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[media.FieldCompletedAt]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ChatFolderChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *MediaMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, media.FieldCompletedAt)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ChatFolderChatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *MediaMutation) SetMessageID(u uuid.UUID) {
-	m.message = &u
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatFolderChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfolder {
+		edges = append(edges, chatfolderchat.EdgeFolder)
+	}
+	if m.clearedchat {
+		edges = append(edges, chatfolderchat.EdgeChat)
+	}
+	return edges
 }
 
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *MediaMutation) MessageID() (r uuid.UUID, exists bool) {
-	v := m.message
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ChatFolderChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chatfolderchat.EdgeFolder:
+		return m.clearedfolder
+	case chatfolderchat.EdgeChat:
+		return m.clearedchat
 	}
-	return *v, true
+	return false
 }
 
-// OldMessageID returns the old "message_id" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldMessageID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ChatFolderChatMutation) ClearEdge(name string) error {
+	switch name {
+	case chatfolderchat.EdgeFolder:
+		m.ClearFolder()
+		return nil
+	case chatfolderchat.EdgeChat:
+		m.ClearChat()
+		return nil
 	}
-	return oldValue.MessageID, nil
+	return fmt.Errorf("unknown ChatFolderChat unique edge %s", name)
 }
 
-// ClearMessageID clears the value of the "message_id" field.
-func (m *MediaMutation) ClearMessageID() {
-	m.message = nil
-	m.clearedFields[media.FieldMessageID] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ChatFolderChatMutation) ResetEdge(name string) error {
+	switch name {
+	case chatfolderchat.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	case chatfolderchat.EdgeChat:
+		m.ResetChat()
+		return nil
+	}
+	return fmt.Errorf("unknown ChatFolderChat edge %s", name)
 }
 
-// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
-func (m *MediaMutation) MessageIDCleared() bool {
-	_, ok := m.clearedFields[media.FieldMessageID]
-	return ok
+// GroupChatMutation represents an operation that mutates the GroupChat nodes in the graph.
+type GroupChatMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	name                 *string
+	description          *string
+	is_public            *bool
+	slow_mode_seconds    *int
+	addslow_mode_seconds *int
+	requires_approval    *bool
+	invite_code          *string
+	invite_expires_at    *time.Time
+	clearedFields        map[string]struct{}
+	avatar               *uuid.UUID
+	clearedavatar        bool
+	chat                 *uuid.UUID
+	clearedchat          bool
+	creator              *uuid.UUID
+	clearedcreator       bool
+	members              map[uuid.UUID]struct{}
+	removedmembers       map[uuid.UUID]struct{}
+	clearedmembers       bool
+	join_requests        map[uuid.UUID]struct{}
+	removedjoin_requests map[uuid.UUID]struct{}
+	clearedjoin_requests bool
+	reports              map[uuid.UUID]struct{}
+	removedreports       map[uuid.UUID]struct{}
+	clearedreports       bool
+	done                 bool
+	oldValue             func(context.Context) (*GroupChat, error)
+	predicates           []predicate.GroupChat
 }
 
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *MediaMutation) ResetMessageID() {
-	m.message = nil
-	delete(m.clearedFields, media.FieldMessageID)
-}
+var _ ent.Mutation = (*GroupChatMutation)(nil)
 
-// SetUploadedByID sets the "uploaded_by_id" field.
-func (m *MediaMutation) SetUploadedByID(u uuid.UUID) {
-	m.uploader = &u
-}
+// groupchatOption allows management of the mutation configuration using functional options.
+type groupchatOption func(*GroupChatMutation)
 
-// UploadedByID returns the value of the "uploaded_by_id" field in the mutation.
-func (m *MediaMutation) UploadedByID() (r uuid.UUID, exists bool) {
-	v := m.uploader
-	if v == nil {
-		return
+// newGroupChatMutation creates new mutation for the GroupChat entity.
+func newGroupChatMutation(c config, op Op, opts ...groupchatOption) *GroupChatMutation {
+	m := &GroupChatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupChat,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldUploadedByID returns the old "uploaded_by_id" field's value of the Media entity.
-// If the Media object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MediaMutation) OldUploadedByID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadedByID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadedByID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadedByID: %w", err)
+// withGroupChatID sets the ID field of the mutation.
+func withGroupChatID(id uuid.UUID) groupchatOption {
+	return func(m *GroupChatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupChat
+		)
+		m.oldValue = func(ctx context.Context) (*GroupChat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupChat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.UploadedByID, nil
 }
 
-// ClearUploadedByID clears the value of the "uploaded_by_id" field.
-func (m *MediaMutation) ClearUploadedByID() {
-	m.uploader = nil
-	m.clearedFields[media.FieldUploadedByID] = struct{}{}
+// withGroupChat sets the old GroupChat of the mutation.
+func withGroupChat(node *GroupChat) groupchatOption {
+	return func(m *GroupChatMutation) {
+		m.oldValue = func(context.Context) (*GroupChat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// UploadedByIDCleared returns if the "uploaded_by_id" field was cleared in this mutation.
-func (m *MediaMutation) UploadedByIDCleared() bool {
-	_, ok := m.clearedFields[media.FieldUploadedByID]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupChatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetUploadedByID resets all changes to the "uploaded_by_id" field.
-func (m *MediaMutation) ResetUploadedByID() {
-	m.uploader = nil
-	delete(m.clearedFields, media.FieldUploadedByID)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupChatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MediaMutation) ClearMessage() {
-	m.clearedmessage = true
-	m.clearedFields[media.FieldMessageID] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupChat entities.
+func (m *GroupChatMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MediaMutation) MessageCleared() bool {
-	return m.MessageIDCleared() || m.clearedmessage
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupChatMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MediaMutation) MessageIDs() (ids []uuid.UUID) {
-	if id := m.message; id != nil {
-		ids = append(ids, *id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupChat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MediaMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// SetChatID sets the "chat_id" field.
+func (m *GroupChatMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
 }
 
-// SetUserAvatarID sets the "user_avatar" edge to the User entity by id.
-func (m *MediaMutation) SetUserAvatarID(id uuid.UUID) {
-	m.user_avatar = &id
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *GroupChatMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUserAvatar clears the "user_avatar" edge to the User entity.
-func (m *MediaMutation) ClearUserAvatar() {
-	m.cleareduser_avatar = true
+// OldChatID returns the old "chat_id" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
 }
 
-// UserAvatarCleared reports if the "user_avatar" edge to the User entity was cleared.
-func (m *MediaMutation) UserAvatarCleared() bool {
-	return m.cleareduser_avatar
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *GroupChatMutation) ResetChatID() {
+	m.chat = nil
 }
 
-// UserAvatarID returns the "user_avatar" edge ID in the mutation.
-func (m *MediaMutation) UserAvatarID() (id uuid.UUID, exists bool) {
-	if m.user_avatar != nil {
-		return *m.user_avatar, true
-	}
-	return
+// SetCreatedBy sets the "created_by" field.
+func (m *GroupChatMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
 }
 
-// UserAvatarIDs returns the "user_avatar" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserAvatarID instead. It exists only for internal usage by the builders.
-func (m *MediaMutation) UserAvatarIDs() (ids []uuid.UUID) {
-	if id := m.user_avatar; id != nil {
-		ids = append(ids, *id)
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *GroupChatMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUserAvatar resets all changes to the "user_avatar" edge.
-func (m *MediaMutation) ResetUserAvatar() {
-	m.user_avatar = nil
-	m.cleareduser_avatar = false
+// OldCreatedBy returns the old "created_by" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// SetGroupAvatarID sets the "group_avatar" edge to the GroupChat entity by id.
-func (m *MediaMutation) SetGroupAvatarID(id uuid.UUID) {
-	m.group_avatar = &id
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *GroupChatMutation) ClearCreatedBy() {
+	m.creator = nil
+	m.clearedFields[groupchat.FieldCreatedBy] = struct{}{}
 }
 
-// ClearGroupAvatar clears the "group_avatar" edge to the GroupChat entity.
-func (m *MediaMutation) ClearGroupAvatar() {
-	m.clearedgroup_avatar = true
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *GroupChatMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[groupchat.FieldCreatedBy]
+	return ok
 }
 
-// GroupAvatarCleared reports if the "group_avatar" edge to the GroupChat entity was cleared.
-func (m *MediaMutation) GroupAvatarCleared() bool {
-	return m.clearedgroup_avatar
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *GroupChatMutation) ResetCreatedBy() {
+	m.creator = nil
+	delete(m.clearedFields, groupchat.FieldCreatedBy)
 }
 
-// GroupAvatarID returns the "group_avatar" edge ID in the mutation.
-func (m *MediaMutation) GroupAvatarID() (id uuid.UUID, exists bool) {
-	if m.group_avatar != nil {
-		return *m.group_avatar, true
-	}
-	return
+// SetName sets the "name" field.
+func (m *GroupChatMutation) SetName(s string) {
+	m.name = &s
 }
 
-// GroupAvatarIDs returns the "group_avatar" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupAvatarID instead. It exists only for internal usage by the builders.
-func (m *MediaMutation) GroupAvatarIDs() (ids []uuid.UUID) {
-	if id := m.group_avatar; id != nil {
-		ids = append(ids, *id)
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupChatMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetGroupAvatar resets all changes to the "group_avatar" edge.
-func (m *MediaMutation) ResetGroupAvatar() {
-	m.group_avatar = nil
-	m.clearedgroup_avatar = false
+// OldName returns the old "name" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// SetUploaderID sets the "uploader" edge to the User entity by id.
-func (m *MediaMutation) SetUploaderID(id uuid.UUID) {
-	m.uploader = &id
+// ResetName resets all changes to the "name" field.
+func (m *GroupChatMutation) ResetName() {
+	m.name = nil
 }
 
-// ClearUploader clears the "uploader" edge to the User entity.
-func (m *MediaMutation) ClearUploader() {
-	m.cleareduploader = true
-	m.clearedFields[media.FieldUploadedByID] = struct{}{}
+// SetDescription sets the "description" field.
+func (m *GroupChatMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
-func (m *MediaMutation) UploaderCleared() bool {
-	return m.UploadedByIDCleared() || m.cleareduploader
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupChatMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UploaderID returns the "uploader" edge ID in the mutation.
-func (m *MediaMutation) UploaderID() (id uuid.UUID, exists bool) {
-	if m.uploader != nil {
-		return *m.uploader, true
+// OldDescription returns the old "description" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// UploaderIDs returns the "uploader" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploaderID instead. It exists only for internal usage by the builders.
-func (m *MediaMutation) UploaderIDs() (ids []uuid.UUID) {
-	if id := m.uploader; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearDescription clears the value of the "description" field.
+func (m *GroupChatMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[groupchat.FieldDescription] = struct{}{}
 }
 
-// ResetUploader resets all changes to the "uploader" edge.
-func (m *MediaMutation) ResetUploader() {
-	m.uploader = nil
-	m.cleareduploader = false
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupChatMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[groupchat.FieldDescription]
+	return ok
 }
 
-// AddReportIDs adds the "reports" edge to the Report entity by ids.
-func (m *MediaMutation) AddReportIDs(ids ...uuid.UUID) {
-	if m.reports == nil {
-		m.reports = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reports[ids[i]] = struct{}{}
-	}
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupChatMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, groupchat.FieldDescription)
 }
 
-// ClearReports clears the "reports" edge to the Report entity.
-func (m *MediaMutation) ClearReports() {
-	m.clearedreports = true
+// SetAvatarID sets the "avatar_id" field.
+func (m *GroupChatMutation) SetAvatarID(u uuid.UUID) {
+	m.avatar = &u
 }
 
-// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
-func (m *MediaMutation) ReportsCleared() bool {
-	return m.clearedreports
+// AvatarID returns the value of the "avatar_id" field in the mutation.
+func (m *GroupChatMutation) AvatarID() (r uuid.UUID, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
-func (m *MediaMutation) RemoveReportIDs(ids ...uuid.UUID) {
-	if m.removedreports == nil {
-		m.removedreports = make(map[uuid.UUID]struct{})
+// OldAvatarID returns the old "avatar_id" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldAvatarID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.reports, ids[i])
-		m.removedreports[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
 	}
+	return oldValue.AvatarID, nil
 }
 
-// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
-func (m *MediaMutation) RemovedReportsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreports {
-		ids = append(ids, id)
-	}
-	return
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (m *GroupChatMutation) ClearAvatarID() {
+	m.avatar = nil
+	m.clearedFields[groupchat.FieldAvatarID] = struct{}{}
 }
 
-// ReportsIDs returns the "reports" edge IDs in the mutation.
-func (m *MediaMutation) ReportsIDs() (ids []uuid.UUID) {
-	for id := range m.reports {
-		ids = append(ids, id)
-	}
-	return
+// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
+func (m *GroupChatMutation) AvatarIDCleared() bool {
+	_, ok := m.clearedFields[groupchat.FieldAvatarID]
+	return ok
 }
 
-// ResetReports resets all changes to the "reports" edge.
-func (m *MediaMutation) ResetReports() {
-	m.reports = nil
-	m.clearedreports = false
-	m.removedreports = nil
+// ResetAvatarID resets all changes to the "avatar_id" field.
+func (m *GroupChatMutation) ResetAvatarID() {
+	m.avatar = nil
+	delete(m.clearedFields, groupchat.FieldAvatarID)
 }
 
-// Where appends a list predicates to the MediaMutation builder.
-func (m *MediaMutation) Where(ps ...predicate.Media) {
-	m.predicates = append(m.predicates, ps...)
+// SetIsPublic sets the "is_public" field.
+func (m *GroupChatMutation) SetIsPublic(b bool) {
+	m.is_public = &b
 }
 
-// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Media, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *GroupChatMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *MediaMutation) Op() Op {
-	return m.op
+// OldIsPublic returns the old "is_public" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *MediaMutation) SetOp(op Op) {
-	m.op = op
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *GroupChatMutation) ResetIsPublic() {
+	m.is_public = nil
 }
 
-// Type returns the node type of this mutation (Media).
-func (m *MediaMutation) Type() string {
-	return m.typ
+// SetSlowModeSeconds sets the "slow_mode_seconds" field.
+func (m *GroupChatMutation) SetSlowModeSeconds(i int) {
+	m.slow_mode_seconds = &i
+	m.addslow_mode_seconds = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MediaMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.created_at != nil {
-		fields = append(fields, media.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, media.FieldUpdatedAt)
-	}
-	if m.file_name != nil {
-		fields = append(fields, media.FieldFileName)
-	}
-	if m.original_name != nil {
-		fields = append(fields, media.FieldOriginalName)
-	}
-	if m.file_size != nil {
-		fields = append(fields, media.FieldFileSize)
-	}
-	if m.mime_type != nil {
-		fields = append(fields, media.FieldMimeType)
-	}
-	if m.category != nil {
-		fields = append(fields, media.FieldCategory)
+// SlowModeSeconds returns the value of the "slow_mode_seconds" field in the mutation.
+func (m *GroupChatMutation) SlowModeSeconds() (r int, exists bool) {
+	v := m.slow_mode_seconds
+	if v == nil {
+		return
 	}
-	if m.upload_status != nil {
-		fields = append(fields, media.FieldUploadStatus)
+	return *v, true
+}
+
+// OldSlowModeSeconds returns the old "slow_mode_seconds" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldSlowModeSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlowModeSeconds is only allowed on UpdateOne operations")
 	}
-	if m.upload_expires_at != nil {
-		fields = append(fields, media.FieldUploadExpiresAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlowModeSeconds requires an ID field in the mutation")
 	}
-	if m.completed_at != nil {
-		fields = append(fields, media.FieldCompletedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlowModeSeconds: %w", err)
 	}
-	if m.message != nil {
-		fields = append(fields, media.FieldMessageID)
+	return oldValue.SlowModeSeconds, nil
+}
+
+// AddSlowModeSeconds adds i to the "slow_mode_seconds" field.
+func (m *GroupChatMutation) AddSlowModeSeconds(i int) {
+	if m.addslow_mode_seconds != nil {
+		*m.addslow_mode_seconds += i
+	} else {
+		m.addslow_mode_seconds = &i
 	}
-	if m.uploader != nil {
-		fields = append(fields, media.FieldUploadedByID)
+}
+
+// AddedSlowModeSeconds returns the value that was added to the "slow_mode_seconds" field in this mutation.
+func (m *GroupChatMutation) AddedSlowModeSeconds() (r int, exists bool) {
+	v := m.addslow_mode_seconds
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *MediaMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case media.FieldCreatedAt:
-		return m.CreatedAt()
-	case media.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case media.FieldFileName:
-		return m.FileName()
-	case media.FieldOriginalName:
-		return m.OriginalName()
-	case media.FieldFileSize:
-		return m.FileSize()
-	case media.FieldMimeType:
-		return m.MimeType()
-	case media.FieldCategory:
-		return m.Category()
-	case media.FieldUploadStatus:
-		return m.UploadStatus()
-	case media.FieldUploadExpiresAt:
-		return m.UploadExpiresAt()
-	case media.FieldCompletedAt:
-		return m.CompletedAt()
-	case media.FieldMessageID:
-		return m.MessageID()
-	case media.FieldUploadedByID:
-		return m.UploadedByID()
-	}
-	return nil, false
+// ResetSlowModeSeconds resets all changes to the "slow_mode_seconds" field.
+func (m *GroupChatMutation) ResetSlowModeSeconds() {
+	m.slow_mode_seconds = nil
+	m.addslow_mode_seconds = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case media.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case media.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case media.FieldFileName:
-		return m.OldFileName(ctx)
-	case media.FieldOriginalName:
-		return m.OldOriginalName(ctx)
-	case media.FieldFileSize:
-		return m.OldFileSize(ctx)
-	case media.FieldMimeType:
-		return m.OldMimeType(ctx)
-	case media.FieldCategory:
-		return m.OldCategory(ctx)
-	case media.FieldUploadStatus:
-		return m.OldUploadStatus(ctx)
-	case media.FieldUploadExpiresAt:
-		return m.OldUploadExpiresAt(ctx)
-	case media.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
-	case media.FieldMessageID:
-		return m.OldMessageID(ctx)
-	case media.FieldUploadedByID:
-		return m.OldUploadedByID(ctx)
-	}
-	return nil, fmt.Errorf("unknown Media field %s", name)
+// SetRequiresApproval sets the "requires_approval" field.
+func (m *GroupChatMutation) SetRequiresApproval(b bool) {
+	m.requires_approval = &b
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MediaMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case media.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case media.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case media.FieldFileName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileName(v)
-		return nil
-	case media.FieldOriginalName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOriginalName(v)
-		return nil
-	case media.FieldFileSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFileSize(v)
-		return nil
-	case media.FieldMimeType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMimeType(v)
-		return nil
-	case media.FieldCategory:
-		v, ok := value.(media.Category)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case media.FieldUploadStatus:
-		v, ok := value.(media.UploadStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUploadStatus(v)
-		return nil
-	case media.FieldUploadExpiresAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUploadExpiresAt(v)
-		return nil
-	case media.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
-		return nil
-	case media.FieldMessageID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessageID(v)
-		return nil
-	case media.FieldUploadedByID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUploadedByID(v)
-		return nil
+// RequiresApproval returns the value of the "requires_approval" field in the mutation.
+func (m *GroupChatMutation) RequiresApproval() (r bool, exists bool) {
+	v := m.requires_approval
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Media field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *MediaMutation) AddedFields() []string {
-	var fields []string
-	if m.addfile_size != nil {
-		fields = append(fields, media.FieldFileSize)
+// OldRequiresApproval returns the old "requires_approval" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldRequiresApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiresApproval is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiresApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiresApproval: %w", err)
+	}
+	return oldValue.RequiresApproval, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case media.FieldFileSize:
-		return m.AddedFileSize()
-	}
-	return nil, false
+// ResetRequiresApproval resets all changes to the "requires_approval" field.
+func (m *GroupChatMutation) ResetRequiresApproval() {
+	m.requires_approval = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MediaMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case media.FieldFileSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFileSize(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Media numeric field %s", name)
+// SetInviteCode sets the "invite_code" field.
+func (m *GroupChatMutation) SetInviteCode(s string) {
+	m.invite_code = &s
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *MediaMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(media.FieldUploadExpiresAt) {
-		fields = append(fields, media.FieldUploadExpiresAt)
+// InviteCode returns the value of the "invite_code" field in the mutation.
+func (m *GroupChatMutation) InviteCode() (r string, exists bool) {
+	v := m.invite_code
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(media.FieldCompletedAt) {
-		fields = append(fields, media.FieldCompletedAt)
+	return *v, true
+}
+
+// OldInviteCode returns the old "invite_code" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldInviteCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInviteCode is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(media.FieldMessageID) {
-		fields = append(fields, media.FieldMessageID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInviteCode requires an ID field in the mutation")
 	}
-	if m.FieldCleared(media.FieldUploadedByID) {
-		fields = append(fields, media.FieldUploadedByID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInviteCode: %w", err)
 	}
-	return fields
+	return oldValue.InviteCode, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *MediaMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetInviteCode resets all changes to the "invite_code" field.
+func (m *GroupChatMutation) ResetInviteCode() {
+	m.invite_code = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *MediaMutation) ClearField(name string) error {
-	switch name {
-	case media.FieldUploadExpiresAt:
-		m.ClearUploadExpiresAt()
-		return nil
-	case media.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	case media.FieldMessageID:
-		m.ClearMessageID()
-		return nil
-	case media.FieldUploadedByID:
-		m.ClearUploadedByID()
-		return nil
-	}
-	return fmt.Errorf("unknown Media nullable field %s", name)
-}
+// SetInviteExpiresAt sets the "invite_expires_at" field.
+func (m *GroupChatMutation) SetInviteExpiresAt(t time.Time) {
+	m.invite_expires_at = &t
+}
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *MediaMutation) ResetField(name string) error {
-	switch name {
-	case media.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case media.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case media.FieldFileName:
-		m.ResetFileName()
-		return nil
-	case media.FieldOriginalName:
-		m.ResetOriginalName()
-		return nil
-	case media.FieldFileSize:
-		m.ResetFileSize()
-		return nil
-	case media.FieldMimeType:
-		m.ResetMimeType()
-		return nil
-	case media.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case media.FieldUploadStatus:
-		m.ResetUploadStatus()
-		return nil
-	case media.FieldUploadExpiresAt:
-		m.ResetUploadExpiresAt()
-		return nil
-	case media.FieldCompletedAt:
-		m.ResetCompletedAt()
-		return nil
-	case media.FieldMessageID:
-		m.ResetMessageID()
-		return nil
-	case media.FieldUploadedByID:
-		m.ResetUploadedByID()
-		return nil
+// InviteExpiresAt returns the value of the "invite_expires_at" field in the mutation.
+func (m *GroupChatMutation) InviteExpiresAt() (r time.Time, exists bool) {
+	v := m.invite_expires_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Media field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MediaMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.message != nil {
-		edges = append(edges, media.EdgeMessage)
-	}
-	if m.user_avatar != nil {
-		edges = append(edges, media.EdgeUserAvatar)
-	}
-	if m.group_avatar != nil {
-		edges = append(edges, media.EdgeGroupAvatar)
+// OldInviteExpiresAt returns the old "invite_expires_at" field's value of the GroupChat entity.
+// If the GroupChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupChatMutation) OldInviteExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInviteExpiresAt is only allowed on UpdateOne operations")
 	}
-	if m.uploader != nil {
-		edges = append(edges, media.EdgeUploader)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInviteExpiresAt requires an ID field in the mutation")
 	}
-	if m.reports != nil {
-		edges = append(edges, media.EdgeReports)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInviteExpiresAt: %w", err)
 	}
-	return edges
+	return oldValue.InviteExpiresAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *MediaMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case media.EdgeMessage:
-		if id := m.message; id != nil {
-			return []ent.Value{*id}
-		}
-	case media.EdgeUserAvatar:
-		if id := m.user_avatar; id != nil {
-			return []ent.Value{*id}
-		}
-	case media.EdgeGroupAvatar:
-		if id := m.group_avatar; id != nil {
-			return []ent.Value{*id}
-		}
-	case media.EdgeUploader:
-		if id := m.uploader; id != nil {
-			return []ent.Value{*id}
-		}
-	case media.EdgeReports:
-		ids := make([]ent.Value, 0, len(m.reports))
-		for id := range m.reports {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearInviteExpiresAt clears the value of the "invite_expires_at" field.
+func (m *GroupChatMutation) ClearInviteExpiresAt() {
+	m.invite_expires_at = nil
+	m.clearedFields[groupchat.FieldInviteExpiresAt] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MediaMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedreports != nil {
-		edges = append(edges, media.EdgeReports)
-	}
-	return edges
+// InviteExpiresAtCleared returns if the "invite_expires_at" field was cleared in this mutation.
+func (m *GroupChatMutation) InviteExpiresAtCleared() bool {
+	_, ok := m.clearedFields[groupchat.FieldInviteExpiresAt]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case media.EdgeReports:
-		ids := make([]ent.Value, 0, len(m.removedreports))
-		for id := range m.removedreports {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetInviteExpiresAt resets all changes to the "invite_expires_at" field.
+func (m *GroupChatMutation) ResetInviteExpiresAt() {
+	m.invite_expires_at = nil
+	delete(m.clearedFields, groupchat.FieldInviteExpiresAt)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MediaMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedmessage {
-		edges = append(edges, media.EdgeMessage)
-	}
-	if m.cleareduser_avatar {
-		edges = append(edges, media.EdgeUserAvatar)
-	}
-	if m.clearedgroup_avatar {
-		edges = append(edges, media.EdgeGroupAvatar)
-	}
-	if m.cleareduploader {
-		edges = append(edges, media.EdgeUploader)
-	}
-	if m.clearedreports {
-		edges = append(edges, media.EdgeReports)
-	}
-	return edges
+// ClearAvatar clears the "avatar" edge to the Media entity.
+func (m *GroupChatMutation) ClearAvatar() {
+	m.clearedavatar = true
+	m.clearedFields[groupchat.FieldAvatarID] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *MediaMutation) EdgeCleared(name string) bool {
-	switch name {
-	case media.EdgeMessage:
-		return m.clearedmessage
-	case media.EdgeUserAvatar:
-		return m.cleareduser_avatar
-	case media.EdgeGroupAvatar:
-		return m.clearedgroup_avatar
-	case media.EdgeUploader:
-		return m.cleareduploader
-	case media.EdgeReports:
-		return m.clearedreports
-	}
-	return false
+// AvatarCleared reports if the "avatar" edge to the Media entity was cleared.
+func (m *GroupChatMutation) AvatarCleared() bool {
+	return m.AvatarIDCleared() || m.clearedavatar
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *MediaMutation) ClearEdge(name string) error {
-	switch name {
-	case media.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	case media.EdgeUserAvatar:
-		m.ClearUserAvatar()
-		return nil
-	case media.EdgeGroupAvatar:
-		m.ClearGroupAvatar()
-		return nil
-	case media.EdgeUploader:
-		m.ClearUploader()
-		return nil
+// AvatarIDs returns the "avatar" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AvatarID instead. It exists only for internal usage by the builders.
+func (m *GroupChatMutation) AvatarIDs() (ids []uuid.UUID) {
+	if id := m.avatar; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Media unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MediaMutation) ResetEdge(name string) error {
-	switch name {
-	case media.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	case media.EdgeUserAvatar:
-		m.ResetUserAvatar()
-		return nil
-	case media.EdgeGroupAvatar:
-		m.ResetGroupAvatar()
-		return nil
-	case media.EdgeUploader:
-		m.ResetUploader()
-		return nil
-	case media.EdgeReports:
-		m.ResetReports()
-		return nil
-	}
-	return fmt.Errorf("unknown Media edge %s", name)
+// ResetAvatar resets all changes to the "avatar" edge.
+func (m *GroupChatMutation) ResetAvatar() {
+	m.avatar = nil
+	m.clearedavatar = false
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	_type              *message.Type
-	content            *string
-	action_data        *map[string]interface{}
-	deleted_at         *time.Time
-	edited_at          *time.Time
-	clearedFields      map[string]struct{}
-	chat               *uuid.UUID
-	clearedchat        bool
-	sender             *uuid.UUID
-	clearedsender      bool
-	replies            map[uuid.UUID]struct{}
-	removedreplies     map[uuid.UUID]struct{}
-	clearedreplies     bool
-	reply_to           *uuid.UUID
-	clearedreply_to    bool
-	attachments        map[uuid.UUID]struct{}
-	removedattachments map[uuid.UUID]struct{}
-	clearedattachments bool
-	reports            map[uuid.UUID]struct{}
-	removedreports     map[uuid.UUID]struct{}
-	clearedreports     bool
-	done               bool
-	oldValue           func(context.Context) (*Message, error)
-	predicates         []predicate.Message
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *GroupChatMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[groupchat.FieldChatID] = struct{}{}
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *GroupChatMutation) ChatCleared() bool {
+	return m.clearedchat
+}
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMessage,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *GroupChatMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
 	}
-	return m
+	return
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Message
-		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Message.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetChat resets all changes to the "chat" edge.
+func (m *GroupChatMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *GroupChatMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *GroupChatMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[groupchat.FieldCreatedBy] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *GroupChatMutation) CreatorCleared() bool {
+	return m.CreatedByCleared() || m.clearedcreator
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *GroupChatMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *GroupChatMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ResetCreator resets all changes to the "creator" edge.
+func (m *GroupChatMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// AddMemberIDs adds the "members" edge to the GroupMember entity by ids.
+func (m *GroupChatMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearMembers clears the "members" edge to the GroupMember entity.
+func (m *GroupChatMutation) ClearMembers() {
+	m.clearedmembers = true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// MembersCleared reports if the "members" edge to the GroupMember entity was cleared.
+func (m *GroupChatMutation) MembersCleared() bool {
+	return m.clearedmembers
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// RemoveMemberIDs removes the "members" edge to the GroupMember entity by IDs.
+func (m *GroupChatMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedMembers returns the removed IDs of the "members" edge to the GroupMember entity.
+func (m *GroupChatMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *MessageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *GroupChatMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetMembers resets all changes to the "members" edge.
+func (m *GroupChatMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// AddJoinRequestIDs adds the "join_requests" edge to the GroupJoinRequest entity by ids.
+func (m *GroupChatMutation) AddJoinRequestIDs(ids ...uuid.UUID) {
+	if m.join_requests == nil {
+		m.join_requests = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for i := range ids {
+		m.join_requests[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MessageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearJoinRequests clears the "join_requests" edge to the GroupJoinRequest entity.
+func (m *GroupChatMutation) ClearJoinRequests() {
+	m.clearedjoin_requests = true
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *MessageMutation) SetChatID(u uuid.UUID) {
-	m.chat = &u
+// JoinRequestsCleared reports if the "join_requests" edge to the GroupJoinRequest entity was cleared.
+func (m *GroupChatMutation) JoinRequestsCleared() bool {
+	return m.clearedjoin_requests
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *MessageMutation) ChatID() (r uuid.UUID, exists bool) {
-	v := m.chat
-	if v == nil {
-		return
+// RemoveJoinRequestIDs removes the "join_requests" edge to the GroupJoinRequest entity by IDs.
+func (m *GroupChatMutation) RemoveJoinRequestIDs(ids ...uuid.UUID) {
+	if m.removedjoin_requests == nil {
+		m.removedjoin_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.join_requests, ids[i])
+		m.removedjoin_requests[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+// RemovedJoinRequests returns the removed IDs of the "join_requests" edge to the GroupJoinRequest entity.
+func (m *GroupChatMutation) RemovedJoinRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjoin_requests {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	return
+}
+
+// JoinRequestsIDs returns the "join_requests" edge IDs in the mutation.
+func (m *GroupChatMutation) JoinRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.join_requests {
+		ids = append(ids, id)
 	}
-	return oldValue.ChatID, nil
+	return
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *MessageMutation) ResetChatID() {
-	m.chat = nil
+// ResetJoinRequests resets all changes to the "join_requests" edge.
+func (m *GroupChatMutation) ResetJoinRequests() {
+	m.join_requests = nil
+	m.clearedjoin_requests = false
+	m.removedjoin_requests = nil
 }
 
-// SetSenderID sets the "sender_id" field.
-func (m *MessageMutation) SetSenderID(u uuid.UUID) {
-	m.sender = &u
+// AddReportIDs adds the "reports" edge to the Report entity by ids.
+func (m *GroupChatMutation) AddReportIDs(ids ...uuid.UUID) {
+	if m.reports == nil {
+		m.reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports[ids[i]] = struct{}{}
+	}
 }
 
-// SenderID returns the value of the "sender_id" field in the mutation.
-func (m *MessageMutation) SenderID() (r uuid.UUID, exists bool) {
-	v := m.sender
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearReports clears the "reports" edge to the Report entity.
+func (m *GroupChatMutation) ClearReports() {
+	m.clearedreports = true
 }
 
-// OldSenderID returns the old "sender_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSenderID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
-	}
-	return oldValue.SenderID, nil
+// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
+func (m *GroupChatMutation) ReportsCleared() bool {
+	return m.clearedreports
 }
 
-// ClearSenderID clears the value of the "sender_id" field.
-func (m *MessageMutation) ClearSenderID() {
-	m.sender = nil
-	m.clearedFields[message.FieldSenderID] = struct{}{}
+// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
+func (m *GroupChatMutation) RemoveReportIDs(ids ...uuid.UUID) {
+	if m.removedreports == nil {
+		m.removedreports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports, ids[i])
+		m.removedreports[ids[i]] = struct{}{}
+	}
 }
 
-// SenderIDCleared returns if the "sender_id" field was cleared in this mutation.
-func (m *MessageMutation) SenderIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldSenderID]
-	return ok
+// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
+func (m *GroupChatMutation) RemovedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetSenderID resets all changes to the "sender_id" field.
-func (m *MessageMutation) ResetSenderID() {
-	m.sender = nil
-	delete(m.clearedFields, message.FieldSenderID)
+// ReportsIDs returns the "reports" edge IDs in the mutation.
+func (m *GroupChatMutation) ReportsIDs() (ids []uuid.UUID) {
+	for id := range m.reports {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetReplyToID sets the "reply_to_id" field.
-func (m *MessageMutation) SetReplyToID(u uuid.UUID) {
-	m.reply_to = &u
+// ResetReports resets all changes to the "reports" edge.
+func (m *GroupChatMutation) ResetReports() {
+	m.reports = nil
+	m.clearedreports = false
+	m.removedreports = nil
 }
 
-// ReplyToID returns the value of the "reply_to_id" field in the mutation.
-func (m *MessageMutation) ReplyToID() (r uuid.UUID, exists bool) {
-	v := m.reply_to
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the GroupChatMutation builder.
+func (m *GroupChatMutation) Where(ps ...predicate.GroupChat) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldReplyToID returns the old "reply_to_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldReplyToID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReplyToID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+// WhereP appends storage-level predicates to the GroupChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupChat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.ReplyToID, nil
-}
-
-// ClearReplyToID clears the value of the "reply_to_id" field.
-func (m *MessageMutation) ClearReplyToID() {
-	m.reply_to = nil
-	m.clearedFields[message.FieldReplyToID] = struct{}{}
+	m.Where(p...)
 }
 
-// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
-func (m *MessageMutation) ReplyToIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldReplyToID]
-	return ok
+// Op returns the operation name.
+func (m *GroupChatMutation) Op() Op {
+	return m.op
 }
 
-// ResetReplyToID resets all changes to the "reply_to_id" field.
-func (m *MessageMutation) ResetReplyToID() {
-	m.reply_to = nil
-	delete(m.clearedFields, message.FieldReplyToID)
+// SetOp allows setting the mutation operation.
+func (m *GroupChatMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetType sets the "type" field.
-func (m *MessageMutation) SetType(value message.Type) {
-	m._type = &value
+// Type returns the node type of this mutation (GroupChat).
+func (m *GroupChatMutation) Type() string {
+	return m.typ
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *MessageMutation) GetType() (r message.Type, exists bool) {
-	v := m._type
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupChatMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.chat != nil {
+		fields = append(fields, groupchat.FieldChatID)
 	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldType(ctx context.Context) (v message.Type, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	if m.creator != nil {
+		fields = append(fields, groupchat.FieldCreatedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+	if m.name != nil {
+		fields = append(fields, groupchat.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	if m.description != nil {
+		fields = append(fields, groupchat.FieldDescription)
 	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *MessageMutation) ResetType() {
-	m._type = nil
-}
-
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
-}
-
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+	if m.avatar != nil {
+		fields = append(fields, groupchat.FieldAvatarID)
 	}
-	return *v, true
-}
-
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	if m.is_public != nil {
+		fields = append(fields, groupchat.FieldIsPublic)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+	if m.slow_mode_seconds != nil {
+		fields = append(fields, groupchat.FieldSlowModeSeconds)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	if m.requires_approval != nil {
+		fields = append(fields, groupchat.FieldRequiresApproval)
 	}
-	return oldValue.Content, nil
+	if m.invite_code != nil {
+		fields = append(fields, groupchat.FieldInviteCode)
+	}
+	if m.invite_expires_at != nil {
+		fields = append(fields, groupchat.FieldInviteExpiresAt)
+	}
+	return fields
 }
 
-// ClearContent clears the value of the "content" field.
-func (m *MessageMutation) ClearContent() {
-	m.content = nil
-	m.clearedFields[message.FieldContent] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupChatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case groupchat.FieldChatID:
+		return m.ChatID()
+	case groupchat.FieldCreatedBy:
+		return m.CreatedBy()
+	case groupchat.FieldName:
+		return m.Name()
+	case groupchat.FieldDescription:
+		return m.Description()
+	case groupchat.FieldAvatarID:
+		return m.AvatarID()
+	case groupchat.FieldIsPublic:
+		return m.IsPublic()
+	case groupchat.FieldSlowModeSeconds:
+		return m.SlowModeSeconds()
+	case groupchat.FieldRequiresApproval:
+		return m.RequiresApproval()
+	case groupchat.FieldInviteCode:
+		return m.InviteCode()
+	case groupchat.FieldInviteExpiresAt:
+		return m.InviteExpiresAt()
+	}
+	return nil, false
 }
 
-// ContentCleared returns if the "content" field was cleared in this mutation.
-func (m *MessageMutation) ContentCleared() bool {
-	_, ok := m.clearedFields[message.FieldContent]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case groupchat.FieldChatID:
+		return m.OldChatID(ctx)
+	case groupchat.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case groupchat.FieldName:
+		return m.OldName(ctx)
+	case groupchat.FieldDescription:
+		return m.OldDescription(ctx)
+	case groupchat.FieldAvatarID:
+		return m.OldAvatarID(ctx)
+	case groupchat.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case groupchat.FieldSlowModeSeconds:
+		return m.OldSlowModeSeconds(ctx)
+	case groupchat.FieldRequiresApproval:
+		return m.OldRequiresApproval(ctx)
+	case groupchat.FieldInviteCode:
+		return m.OldInviteCode(ctx)
+	case groupchat.FieldInviteExpiresAt:
+		return m.OldInviteExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GroupChat field %s", name)
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
-	delete(m.clearedFields, message.FieldContent)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupChatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case groupchat.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case groupchat.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case groupchat.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case groupchat.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case groupchat.FieldAvatarID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarID(v)
+		return nil
+	case groupchat.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case groupchat.FieldSlowModeSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlowModeSeconds(v)
+		return nil
+	case groupchat.FieldRequiresApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiresApproval(v)
+		return nil
+	case groupchat.FieldInviteCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInviteCode(v)
+		return nil
+	case groupchat.FieldInviteExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInviteExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GroupChat field %s", name)
 }
 
-// SetActionData sets the "action_data" field.
-func (m *MessageMutation) SetActionData(value map[string]interface{}) {
-	m.action_data = &value
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupChatMutation) AddedFields() []string {
+	var fields []string
+	if m.addslow_mode_seconds != nil {
+		fields = append(fields, groupchat.FieldSlowModeSeconds)
+	}
+	return fields
 }
 
-// ActionData returns the value of the "action_data" field in the mutation.
-func (m *MessageMutation) ActionData() (r map[string]interface{}, exists bool) {
-	v := m.action_data
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupChatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case groupchat.FieldSlowModeSeconds:
+		return m.AddedSlowModeSeconds()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldActionData returns the old "action_data" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldActionData(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActionData is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActionData requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActionData: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupChatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case groupchat.FieldSlowModeSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSlowModeSeconds(v)
+		return nil
 	}
-	return oldValue.ActionData, nil
+	return fmt.Errorf("unknown GroupChat numeric field %s", name)
 }
 
-// ClearActionData clears the value of the "action_data" field.
-func (m *MessageMutation) ClearActionData() {
-	m.action_data = nil
-	m.clearedFields[message.FieldActionData] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupChatMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(groupchat.FieldCreatedBy) {
+		fields = append(fields, groupchat.FieldCreatedBy)
+	}
+	if m.FieldCleared(groupchat.FieldDescription) {
+		fields = append(fields, groupchat.FieldDescription)
+	}
+	if m.FieldCleared(groupchat.FieldAvatarID) {
+		fields = append(fields, groupchat.FieldAvatarID)
+	}
+	if m.FieldCleared(groupchat.FieldInviteExpiresAt) {
+		fields = append(fields, groupchat.FieldInviteExpiresAt)
+	}
+	return fields
 }
 
-// ActionDataCleared returns if the "action_data" field was cleared in this mutation.
-func (m *MessageMutation) ActionDataCleared() bool {
-	_, ok := m.clearedFields[message.FieldActionData]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupChatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetActionData resets all changes to the "action_data" field.
-func (m *MessageMutation) ResetActionData() {
-	m.action_data = nil
-	delete(m.clearedFields, message.FieldActionData)
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (m *MessageMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupChatMutation) ClearField(name string) error {
+	switch name {
+	case groupchat.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case groupchat.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case groupchat.FieldAvatarID:
+		m.ClearAvatarID()
+		return nil
+	case groupchat.FieldInviteExpiresAt:
+		m.ClearInviteExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupChat nullable field %s", name)
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupChatMutation) ResetField(name string) error {
+	switch name {
+	case groupchat.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case groupchat.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case groupchat.FieldName:
+		m.ResetName()
+		return nil
+	case groupchat.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case groupchat.FieldAvatarID:
+		m.ResetAvatarID()
+		return nil
+	case groupchat.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case groupchat.FieldSlowModeSeconds:
+		m.ResetSlowModeSeconds()
+		return nil
+	case groupchat.FieldRequiresApproval:
+		m.ResetRequiresApproval()
+		return nil
+	case groupchat.FieldInviteCode:
+		m.ResetInviteCode()
+		return nil
+	case groupchat.FieldInviteExpiresAt:
+		m.ResetInviteExpiresAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown GroupChat field %s", name)
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.avatar != nil {
+		edges = append(edges, groupchat.EdgeAvatar)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	if m.chat != nil {
+		edges = append(edges, groupchat.EdgeChat)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	if m.creator != nil {
+		edges = append(edges, groupchat.EdgeCreator)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MessageMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[message.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MessageMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldDeletedAt]
-	return ok
+	if m.members != nil {
+		edges = append(edges, groupchat.EdgeMembers)
+	}
+	if m.join_requests != nil {
+		edges = append(edges, groupchat.EdgeJoinRequests)
+	}
+	if m.reports != nil {
+		edges = append(edges, groupchat.EdgeReports)
+	}
+	return edges
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MessageMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, message.FieldDeletedAt)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case groupchat.EdgeAvatar:
+		if id := m.avatar; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupchat.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupchat.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupchat.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case groupchat.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.join_requests))
+		for id := range m.join_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case groupchat.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.reports))
+		for id := range m.reports {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetEditedAt sets the "edited_at" field.
-func (m *MessageMutation) SetEditedAt(t time.Time) {
-	m.edited_at = &t
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedmembers != nil {
+		edges = append(edges, groupchat.EdgeMembers)
+	}
+	if m.removedjoin_requests != nil {
+		edges = append(edges, groupchat.EdgeJoinRequests)
+	}
+	if m.removedreports != nil {
+		edges = append(edges, groupchat.EdgeReports)
+	}
+	return edges
 }
 
-// EditedAt returns the value of the "edited_at" field in the mutation.
-func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
-	v := m.edited_at
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupChatMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case groupchat.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case groupchat.EdgeJoinRequests:
+		ids := make([]ent.Value, 0, len(m.removedjoin_requests))
+		for id := range m.removedjoin_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case groupchat.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.removedreports))
+		for id := range m.removedreports {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldEditedAt returns the old "edited_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedavatar {
+		edges = append(edges, groupchat.EdgeAvatar)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	if m.clearedchat {
+		edges = append(edges, groupchat.EdgeChat)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	if m.clearedcreator {
+		edges = append(edges, groupchat.EdgeCreator)
 	}
-	return oldValue.EditedAt, nil
+	if m.clearedmembers {
+		edges = append(edges, groupchat.EdgeMembers)
+	}
+	if m.clearedjoin_requests {
+		edges = append(edges, groupchat.EdgeJoinRequests)
+	}
+	if m.clearedreports {
+		edges = append(edges, groupchat.EdgeReports)
+	}
+	return edges
 }
 
-// ClearEditedAt clears the value of the "edited_at" field.
-func (m *MessageMutation) ClearEditedAt() {
-	m.edited_at = nil
-	m.clearedFields[message.FieldEditedAt] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case groupchat.EdgeAvatar:
+		return m.clearedavatar
+	case groupchat.EdgeChat:
+		return m.clearedchat
+	case groupchat.EdgeCreator:
+		return m.clearedcreator
+	case groupchat.EdgeMembers:
+		return m.clearedmembers
+	case groupchat.EdgeJoinRequests:
+		return m.clearedjoin_requests
+	case groupchat.EdgeReports:
+		return m.clearedreports
+	}
+	return false
 }
 
-// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
-func (m *MessageMutation) EditedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldEditedAt]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupChatMutation) ClearEdge(name string) error {
+	switch name {
+	case groupchat.EdgeAvatar:
+		m.ClearAvatar()
+		return nil
+	case groupchat.EdgeChat:
+		m.ClearChat()
+		return nil
+	case groupchat.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupChat unique edge %s", name)
 }
 
-// ResetEditedAt resets all changes to the "edited_at" field.
-func (m *MessageMutation) ResetEditedAt() {
-	m.edited_at = nil
-	delete(m.clearedFields, message.FieldEditedAt)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupChatMutation) ResetEdge(name string) error {
+	switch name {
+	case groupchat.EdgeAvatar:
+		m.ResetAvatar()
+		return nil
+	case groupchat.EdgeChat:
+		m.ResetChat()
+		return nil
+	case groupchat.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case groupchat.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case groupchat.EdgeJoinRequests:
+		m.ResetJoinRequests()
+		return nil
+	case groupchat.EdgeReports:
+		m.ResetReports()
+		return nil
+	}
+	return fmt.Errorf("unknown GroupChat edge %s", name)
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *MessageMutation) ClearChat() {
-	m.clearedchat = true
-	m.clearedFields[message.FieldChatID] = struct{}{}
+// GroupJoinRequestMutation represents an operation that mutates the GroupJoinRequest nodes in the graph.
+type GroupJoinRequestMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	status            *groupjoinrequest.Status
+	requested_at      *time.Time
+	reviewed_by       *uuid.UUID
+	reviewed_at       *time.Time
+	clearedFields     map[string]struct{}
+	group_chat        *uuid.UUID
+	clearedgroup_chat bool
+	user              *uuid.UUID
+	cleareduser       bool
+	done              bool
+	oldValue          func(context.Context) (*GroupJoinRequest, error)
+	predicates        []predicate.GroupJoinRequest
 }
 
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *MessageMutation) ChatCleared() bool {
-	return m.clearedchat
-}
+var _ ent.Mutation = (*GroupJoinRequestMutation)(nil)
 
-// ChatIDs returns the "chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ChatIDs() (ids []uuid.UUID) {
-	if id := m.chat; id != nil {
-		ids = append(ids, *id)
+// groupjoinrequestOption allows management of the mutation configuration using functional options.
+type groupjoinrequestOption func(*GroupJoinRequestMutation)
+
+// newGroupJoinRequestMutation creates new mutation for the GroupJoinRequest entity.
+func newGroupJoinRequestMutation(c config, op Op, opts ...groupjoinrequestOption) *GroupJoinRequestMutation {
+	m := &GroupJoinRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroupJoinRequest,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetChat resets all changes to the "chat" edge.
-func (m *MessageMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
+// withGroupJoinRequestID sets the ID field of the mutation.
+func withGroupJoinRequestID(id uuid.UUID) groupjoinrequestOption {
+	return func(m *GroupJoinRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GroupJoinRequest
+		)
+		m.oldValue = func(ctx context.Context) (*GroupJoinRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GroupJoinRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ClearSender clears the "sender" edge to the User entity.
-func (m *MessageMutation) ClearSender() {
-	m.clearedsender = true
-	m.clearedFields[message.FieldSenderID] = struct{}{}
+// withGroupJoinRequest sets the old GroupJoinRequest of the mutation.
+func withGroupJoinRequest(node *GroupJoinRequest) groupjoinrequestOption {
+	return func(m *GroupJoinRequestMutation) {
+		m.oldValue = func(context.Context) (*GroupJoinRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SenderCleared reports if the "sender" edge to the User entity was cleared.
-func (m *MessageMutation) SenderCleared() bool {
-	return m.SenderIDCleared() || m.clearedsender
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupJoinRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SenderIDs returns the "sender" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SenderID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) SenderIDs() (ids []uuid.UUID) {
-	if id := m.sender; id != nil {
-		ids = append(ids, *id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupJoinRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetSender resets all changes to the "sender" edge.
-func (m *MessageMutation) ResetSender() {
-	m.sender = nil
-	m.clearedsender = false
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of GroupJoinRequest entities.
+func (m *GroupJoinRequestMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddReplyIDs adds the "replies" edge to the Message entity by ids.
-func (m *MessageMutation) AddReplyIDs(ids ...uuid.UUID) {
-	if m.replies == nil {
-		m.replies = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.replies[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupJoinRequestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// ClearReplies clears the "replies" edge to the Message entity.
-func (m *MessageMutation) ClearReplies() {
-	m.clearedreplies = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupJoinRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GroupJoinRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
-func (m *MessageMutation) RepliesCleared() bool {
-	return m.clearedreplies
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupJoinRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
-func (m *MessageMutation) RemoveReplyIDs(ids ...uuid.UUID) {
-	if m.removedreplies == nil {
-		m.removedreplies = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.replies, ids[i])
-		m.removedreplies[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupJoinRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
-func (m *MessageMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
-	for id := range m.removedreplies {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// RepliesIDs returns the "replies" edge IDs in the mutation.
-func (m *MessageMutation) RepliesIDs() (ids []uuid.UUID) {
-	for id := range m.replies {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetReplies resets all changes to the "replies" edge.
-func (m *MessageMutation) ResetReplies() {
-	m.replies = nil
-	m.clearedreplies = false
-	m.removedreplies = nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearReplyTo clears the "reply_to" edge to the Message entity.
-func (m *MessageMutation) ClearReplyTo() {
-	m.clearedreply_to = true
-	m.clearedFields[message.FieldReplyToID] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupJoinRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
-func (m *MessageMutation) ReplyToCleared() bool {
-	return m.ReplyToIDCleared() || m.clearedreply_to
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupJoinRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReplyToID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ReplyToIDs() (ids []uuid.UUID) {
-	if id := m.reply_to; id != nil {
-		ids = append(ids, *id)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupJoinRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetReplyTo resets all changes to the "reply_to" edge.
-func (m *MessageMutation) ResetReplyTo() {
-	m.reply_to = nil
-	m.clearedreply_to = false
+	return *v, true
 }
 
-// AddAttachmentIDs adds the "attachments" edge to the Media entity by ids.
-func (m *MessageMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachments == nil {
-		m.attachments = make(map[uuid.UUID]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearAttachments clears the "attachments" edge to the Media entity.
-func (m *MessageMutation) ClearAttachments() {
-	m.clearedattachments = true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupJoinRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AttachmentsCleared reports if the "attachments" edge to the Media entity was cleared.
-func (m *MessageMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
+// SetGroupChatID sets the "group_chat_id" field.
+func (m *GroupJoinRequestMutation) SetGroupChatID(u uuid.UUID) {
+	m.group_chat = &u
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to the Media entity by IDs.
-func (m *MessageMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
+// GroupChatID returns the value of the "group_chat_id" field in the mutation.
+func (m *GroupJoinRequestMutation) GroupChatID() (r uuid.UUID, exists bool) {
+	v := m.group_chat
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Media entity.
-func (m *MessageMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
+// OldGroupChatID returns the old "group_chat_id" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldGroupChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupChatID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupChatID: %w", err)
+	}
+	return oldValue.GroupChatID, nil
 }
 
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *MessageMutation) AttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
-	}
-	return
+// ResetGroupChatID resets all changes to the "group_chat_id" field.
+func (m *GroupJoinRequestMutation) ResetGroupChatID() {
+	m.group_chat = nil
 }
 
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *MessageMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
+// SetUserID sets the "user_id" field.
+func (m *GroupJoinRequestMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// AddReportIDs adds the "reports" edge to the Report entity by ids.
-func (m *MessageMutation) AddReportIDs(ids ...uuid.UUID) {
-	if m.reports == nil {
-		m.reports = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reports[ids[i]] = struct{}{}
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GroupJoinRequestMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearReports clears the "reports" edge to the Report entity.
-func (m *MessageMutation) ClearReports() {
-	m.clearedreports = true
+// OldUserID returns the old "user_id" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
-func (m *MessageMutation) ReportsCleared() bool {
-	return m.clearedreports
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GroupJoinRequestMutation) ResetUserID() {
+	m.user = nil
 }
 
-// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
-func (m *MessageMutation) RemoveReportIDs(ids ...uuid.UUID) {
-	if m.removedreports == nil {
-		m.removedreports = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reports, ids[i])
-		m.removedreports[ids[i]] = struct{}{}
-	}
+// SetStatus sets the "status" field.
+func (m *GroupJoinRequestMutation) SetStatus(gr groupjoinrequest.Status) {
+	m.status = &gr
 }
 
-// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
-func (m *MessageMutation) RemovedReportsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreports {
-		ids = append(ids, id)
+// Status returns the value of the "status" field in the mutation.
+func (m *GroupJoinRequestMutation) Status() (r groupjoinrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ReportsIDs returns the "reports" edge IDs in the mutation.
-func (m *MessageMutation) ReportsIDs() (ids []uuid.UUID) {
-	for id := range m.reports {
-		ids = append(ids, id)
+// OldStatus returns the old "status" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldStatus(ctx context.Context) (v groupjoinrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// ResetReports resets all changes to the "reports" edge.
-func (m *MessageMutation) ResetReports() {
-	m.reports = nil
-	m.clearedreports = false
-	m.removedreports = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *GroupJoinRequestMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
-	m.predicates = append(m.predicates, ps...)
+// SetRequestedAt sets the "requested_at" field.
+func (m *GroupJoinRequestMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *GroupJoinRequestMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *GroupJoinRequestMutation) ResetRequestedAt() {
+	m.requested_at = nil
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *GroupJoinRequestMutation) SetReviewedBy(u uuid.UUID) {
+	m.reviewed_by = &u
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *GroupJoinRequestMutation) ReviewedBy() (r uuid.UUID, exists bool) {
+	v := m.reviewed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedBy returns the old "reviewed_by" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldReviewedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
+	}
+	return oldValue.ReviewedBy, nil
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (m *GroupJoinRequestMutation) ClearReviewedBy() {
+	m.reviewed_by = nil
+	m.clearedFields[groupjoinrequest.FieldReviewedBy] = struct{}{}
+}
+
+// ReviewedByCleared returns if the "reviewed_by" field was cleared in this mutation.
+func (m *GroupJoinRequestMutation) ReviewedByCleared() bool {
+	_, ok := m.clearedFields[groupjoinrequest.FieldReviewedBy]
+	return ok
+}
+
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *GroupJoinRequestMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+	delete(m.clearedFields, groupjoinrequest.FieldReviewedBy)
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *GroupJoinRequestMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *GroupJoinRequestMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the GroupJoinRequest entity.
+// If the GroupJoinRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupJoinRequestMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *GroupJoinRequestMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[groupjoinrequest.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *GroupJoinRequestMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[groupjoinrequest.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *GroupJoinRequestMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, groupjoinrequest.FieldReviewedAt)
+}
+
+// ClearGroupChat clears the "group_chat" edge to the GroupChat entity.
+func (m *GroupJoinRequestMutation) ClearGroupChat() {
+	m.clearedgroup_chat = true
+	m.clearedFields[groupjoinrequest.FieldGroupChatID] = struct{}{}
+}
+
+// GroupChatCleared reports if the "group_chat" edge to the GroupChat entity was cleared.
+func (m *GroupJoinRequestMutation) GroupChatCleared() bool {
+	return m.clearedgroup_chat
+}
+
+// GroupChatIDs returns the "group_chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupChatID instead. It exists only for internal usage by the builders.
+func (m *GroupJoinRequestMutation) GroupChatIDs() (ids []uuid.UUID) {
+	if id := m.group_chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroupChat resets all changes to the "group_chat" edge.
+func (m *GroupJoinRequestMutation) ResetGroupChat() {
+	m.group_chat = nil
+	m.clearedgroup_chat = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GroupJoinRequestMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[groupjoinrequest.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GroupJoinRequestMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GroupJoinRequestMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GroupJoinRequestMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the GroupJoinRequestMutation builder.
+func (m *GroupJoinRequestMutation) Where(ps ...predicate.GroupJoinRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupJoinRequestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *GroupJoinRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupJoinRequest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5033,54 +6013,48 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *GroupJoinRequestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *GroupJoinRequestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (GroupJoinRequest).
+func (m *GroupJoinRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *GroupJoinRequestMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+		fields = append(fields, groupjoinrequest.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, message.FieldUpdatedAt)
-	}
-	if m.chat != nil {
-		fields = append(fields, message.FieldChatID)
-	}
-	if m.sender != nil {
-		fields = append(fields, message.FieldSenderID)
+		fields = append(fields, groupjoinrequest.FieldUpdatedAt)
 	}
-	if m.reply_to != nil {
-		fields = append(fields, message.FieldReplyToID)
+	if m.group_chat != nil {
+		fields = append(fields, groupjoinrequest.FieldGroupChatID)
 	}
-	if m._type != nil {
-		fields = append(fields, message.FieldType)
+	if m.user != nil {
+		fields = append(fields, groupjoinrequest.FieldUserID)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.status != nil {
+		fields = append(fields, groupjoinrequest.FieldStatus)
 	}
-	if m.action_data != nil {
-		fields = append(fields, message.FieldActionData)
+	if m.requested_at != nil {
+		fields = append(fields, groupjoinrequest.FieldRequestedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.reviewed_by != nil {
+		fields = append(fields, groupjoinrequest.FieldReviewedBy)
 	}
-	if m.edited_at != nil {
-		fields = append(fields, message.FieldEditedAt)
+	if m.reviewed_at != nil {
+		fields = append(fields, groupjoinrequest.FieldReviewedAt)
 	}
 	return fields
 }
@@ -5088,28 +6062,24 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupJoinRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldCreatedAt:
+	case groupjoinrequest.FieldCreatedAt:
 		return m.CreatedAt()
-	case message.FieldUpdatedAt:
+	case groupjoinrequest.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case message.FieldChatID:
-		return m.ChatID()
-	case message.FieldSenderID:
-		return m.SenderID()
-	case message.FieldReplyToID:
-		return m.ReplyToID()
-	case message.FieldType:
-		return m.GetType()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldActionData:
-		return m.ActionData()
-	case message.FieldDeletedAt:
-		return m.DeletedAt()
-	case message.FieldEditedAt:
-		return m.EditedAt()
+	case groupjoinrequest.FieldGroupChatID:
+		return m.GroupChatID()
+	case groupjoinrequest.FieldUserID:
+		return m.UserID()
+	case groupjoinrequest.FieldStatus:
+		return m.Status()
+	case groupjoinrequest.FieldRequestedAt:
+		return m.RequestedAt()
+	case groupjoinrequest.FieldReviewedBy:
+		return m.ReviewedBy()
+	case groupjoinrequest.FieldReviewedAt:
+		return m.ReviewedAt()
 	}
 	return nil, false
 }
@@ -5117,456 +6087,317 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupJoinRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldCreatedAt:
+	case groupjoinrequest.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case message.FieldUpdatedAt:
+	case groupjoinrequest.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case message.FieldChatID:
-		return m.OldChatID(ctx)
-	case message.FieldSenderID:
-		return m.OldSenderID(ctx)
-	case message.FieldReplyToID:
-		return m.OldReplyToID(ctx)
-	case message.FieldType:
-		return m.OldType(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldActionData:
-		return m.OldActionData(ctx)
-	case message.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case message.FieldEditedAt:
-		return m.OldEditedAt(ctx)
+	case groupjoinrequest.FieldGroupChatID:
+		return m.OldGroupChatID(ctx)
+	case groupjoinrequest.FieldUserID:
+		return m.OldUserID(ctx)
+	case groupjoinrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case groupjoinrequest.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case groupjoinrequest.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case groupjoinrequest.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown GroupJoinRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *GroupJoinRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldCreatedAt:
+	case groupjoinrequest.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case message.FieldUpdatedAt:
+	case groupjoinrequest.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case message.FieldChatID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChatID(v)
-		return nil
-	case message.FieldSenderID:
+	case groupjoinrequest.FieldGroupChatID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSenderID(v)
+		m.SetGroupChatID(v)
 		return nil
-	case message.FieldReplyToID:
+	case groupjoinrequest.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReplyToID(v)
-		return nil
-	case message.FieldType:
-		v, ok := value.(message.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
+		m.SetUserID(v)
 		return nil
-	case message.FieldContent:
-		v, ok := value.(string)
+	case groupjoinrequest.FieldStatus:
+		v, ok := value.(groupjoinrequest.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetStatus(v)
 		return nil
-	case message.FieldActionData:
-		v, ok := value.(map[string]interface{})
+	case groupjoinrequest.FieldRequestedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActionData(v)
+		m.SetRequestedAt(v)
 		return nil
-	case message.FieldDeletedAt:
-		v, ok := value.(time.Time)
+	case groupjoinrequest.FieldReviewedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetReviewedBy(v)
 		return nil
-	case message.FieldEditedAt:
+	case groupjoinrequest.FieldReviewedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEditedAt(v)
+		m.SetReviewedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown GroupJoinRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *GroupJoinRequestMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupJoinRequestMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *GroupJoinRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown GroupJoinRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *GroupJoinRequestMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldSenderID) {
-		fields = append(fields, message.FieldSenderID)
-	}
-	if m.FieldCleared(message.FieldReplyToID) {
-		fields = append(fields, message.FieldReplyToID)
-	}
-	if m.FieldCleared(message.FieldContent) {
-		fields = append(fields, message.FieldContent)
-	}
-	if m.FieldCleared(message.FieldActionData) {
-		fields = append(fields, message.FieldActionData)
-	}
-	if m.FieldCleared(message.FieldDeletedAt) {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.FieldCleared(groupjoinrequest.FieldReviewedBy) {
+		fields = append(fields, groupjoinrequest.FieldReviewedBy)
 	}
-	if m.FieldCleared(message.FieldEditedAt) {
-		fields = append(fields, message.FieldEditedAt)
+	if m.FieldCleared(groupjoinrequest.FieldReviewedAt) {
+		fields = append(fields, groupjoinrequest.FieldReviewedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *GroupJoinRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *GroupJoinRequestMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldSenderID:
-		m.ClearSenderID()
+	case groupjoinrequest.FieldReviewedBy:
+		m.ClearReviewedBy()
 		return nil
-	case message.FieldReplyToID:
-		m.ClearReplyToID()
-		return nil
-	case message.FieldContent:
-		m.ClearContent()
-		return nil
-	case message.FieldActionData:
-		m.ClearActionData()
-		return nil
-	case message.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case message.FieldEditedAt:
-		m.ClearEditedAt()
+	case groupjoinrequest.FieldReviewedAt:
+		m.ClearReviewedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown GroupJoinRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *GroupJoinRequestMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldCreatedAt:
+	case groupjoinrequest.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case message.FieldUpdatedAt:
+	case groupjoinrequest.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case message.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case message.FieldSenderID:
-		m.ResetSenderID()
-		return nil
-	case message.FieldReplyToID:
-		m.ResetReplyToID()
+	case groupjoinrequest.FieldGroupChatID:
+		m.ResetGroupChatID()
 		return nil
-	case message.FieldType:
-		m.ResetType()
+	case groupjoinrequest.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case groupjoinrequest.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case message.FieldActionData:
-		m.ResetActionData()
+	case groupjoinrequest.FieldRequestedAt:
+		m.ResetRequestedAt()
 		return nil
-	case message.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case groupjoinrequest.FieldReviewedBy:
+		m.ResetReviewedBy()
 		return nil
-	case message.FieldEditedAt:
-		m.ResetEditedAt()
+	case groupjoinrequest.FieldReviewedAt:
+		m.ResetReviewedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown GroupJoinRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.chat != nil {
-		edges = append(edges, message.EdgeChat)
-	}
-	if m.sender != nil {
-		edges = append(edges, message.EdgeSender)
-	}
-	if m.replies != nil {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.reply_to != nil {
-		edges = append(edges, message.EdgeReplyTo)
-	}
-	if m.attachments != nil {
-		edges = append(edges, message.EdgeAttachments)
+func (m *GroupJoinRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.group_chat != nil {
+		edges = append(edges, groupjoinrequest.EdgeGroupChat)
 	}
-	if m.reports != nil {
-		edges = append(edges, message.EdgeReports)
+	if m.user != nil {
+		edges = append(edges, groupjoinrequest.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupJoinRequestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeChat:
-		if id := m.chat; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeSender:
-		if id := m.sender; id != nil {
+	case groupjoinrequest.EdgeGroupChat:
+		if id := m.group_chat; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.replies))
-		for id := range m.replies {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeReplyTo:
-		if id := m.reply_to; id != nil {
+	case groupjoinrequest.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeReports:
-		ids := make([]ent.Value, 0, len(m.reports))
-		for id := range m.reports {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.removedreplies != nil {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.removedattachments != nil {
-		edges = append(edges, message.EdgeAttachments)
-	}
-	if m.removedreports != nil {
-		edges = append(edges, message.EdgeReports)
-	}
+func (m *GroupJoinRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.removedreplies))
-		for id := range m.removedreplies {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeReports:
-		ids := make([]ent.Value, 0, len(m.removedreports))
-		for id := range m.removedreports {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *GroupJoinRequestMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.clearedchat {
-		edges = append(edges, message.EdgeChat)
-	}
-	if m.clearedsender {
-		edges = append(edges, message.EdgeSender)
-	}
-	if m.clearedreplies {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.clearedreply_to {
-		edges = append(edges, message.EdgeReplyTo)
-	}
-	if m.clearedattachments {
-		edges = append(edges, message.EdgeAttachments)
+func (m *GroupJoinRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgroup_chat {
+		edges = append(edges, groupjoinrequest.EdgeGroupChat)
 	}
-	if m.clearedreports {
-		edges = append(edges, message.EdgeReports)
+	if m.cleareduser {
+		edges = append(edges, groupjoinrequest.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *GroupJoinRequestMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeChat:
-		return m.clearedchat
-	case message.EdgeSender:
-		return m.clearedsender
-	case message.EdgeReplies:
-		return m.clearedreplies
-	case message.EdgeReplyTo:
-		return m.clearedreply_to
-	case message.EdgeAttachments:
-		return m.clearedattachments
-	case message.EdgeReports:
-		return m.clearedreports
+	case groupjoinrequest.EdgeGroupChat:
+		return m.clearedgroup_chat
+	case groupjoinrequest.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *GroupJoinRequestMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
-		m.ClearChat()
-		return nil
-	case message.EdgeSender:
-		m.ClearSender()
+	case groupjoinrequest.EdgeGroupChat:
+		m.ClearGroupChat()
 		return nil
-	case message.EdgeReplyTo:
-		m.ClearReplyTo()
+	case groupjoinrequest.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown GroupJoinRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *GroupJoinRequestMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
-		m.ResetChat()
-		return nil
-	case message.EdgeSender:
-		m.ResetSender()
-		return nil
-	case message.EdgeReplies:
-		m.ResetReplies()
-		return nil
-	case message.EdgeReplyTo:
-		m.ResetReplyTo()
-		return nil
-	case message.EdgeAttachments:
-		m.ResetAttachments()
+	case groupjoinrequest.EdgeGroupChat:
+		m.ResetGroupChat()
 		return nil
-	case message.EdgeReports:
-		m.ResetReports()
+	case groupjoinrequest.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown GroupJoinRequest edge %s", name)
 }
 
-// PrivateChatMutation represents an operation that mutates the PrivateChat nodes in the graph.
-type PrivateChatMutation struct {
+// GroupMemberMutation represents an operation that mutates the GroupMember nodes in the graph.
+type GroupMemberMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	user1_last_read_at    *time.Time
-	user2_last_read_at    *time.Time
-	user1_hidden_at       *time.Time
-	user2_hidden_at       *time.Time
-	user1_unread_count    *int
-	adduser1_unread_count *int
-	user2_unread_count    *int
-	adduser2_unread_count *int
-	clearedFields         map[string]struct{}
-	chat                  *uuid.UUID
-	clearedchat           bool
-	user1                 *uuid.UUID
-	cleareduser1          bool
-	user2                 *uuid.UUID
-	cleareduser2          bool
-	done                  bool
-	oldValue              func(context.Context) (*PrivateChat, error)
-	predicates            []predicate.PrivateChat
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	role                          *groupmember.Role
+	last_read_at                  *time.Time
+	joined_at                     *time.Time
+	unread_count                  *int
+	addunread_count               *int
+	unread_mention_count          *int
+	addunread_mention_count       *int
+	marked_unread                 *bool
+	archived_at                   *time.Time
+	keep_archived                 *bool
+	muted                         *bool
+	muted_until                   *time.Time
+	notify_mentions               *bool
+	clearedFields                 map[string]struct{}
+	group_chat                    *uuid.UUID
+	clearedgroup_chat             bool
+	user                          *uuid.UUID
+	cleareduser                   bool
+	last_read_message             *uuid.UUID
+	clearedlast_read_message      bool
+	last_delivered_message        *uuid.UUID
+	clearedlast_delivered_message bool
+	done                          bool
+	oldValue                      func(context.Context) (*GroupMember, error)
+	predicates                    []predicate.GroupMember
 }
 
-var _ ent.Mutation = (*PrivateChatMutation)(nil)
+var _ ent.Mutation = (*GroupMemberMutation)(nil)
 
-// privatechatOption allows management of the mutation configuration using functional options.
-type privatechatOption func(*PrivateChatMutation)
+// groupmemberOption allows management of the mutation configuration using functional options.
+type groupmemberOption func(*GroupMemberMutation)
 
-// newPrivateChatMutation creates new mutation for the PrivateChat entity.
-func newPrivateChatMutation(c config, op Op, opts ...privatechatOption) *PrivateChatMutation {
-	m := &PrivateChatMutation{
+// newGroupMemberMutation creates new mutation for the GroupMember entity.
+func newGroupMemberMutation(c config, op Op, opts ...groupmemberOption) *GroupMemberMutation {
+	m := &GroupMemberMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePrivateChat,
+		typ:           TypeGroupMember,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5575,20 +6406,20 @@ func newPrivateChatMutation(c config, op Op, opts ...privatechatOption) *Private
 	return m
 }
 
-// withPrivateChatID sets the ID field of the mutation.
-func withPrivateChatID(id uuid.UUID) privatechatOption {
-	return func(m *PrivateChatMutation) {
+// withGroupMemberID sets the ID field of the mutation.
+func withGroupMemberID(id uuid.UUID) groupmemberOption {
+	return func(m *GroupMemberMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PrivateChat
+			value *GroupMember
 		)
-		m.oldValue = func(ctx context.Context) (*PrivateChat, error) {
+		m.oldValue = func(ctx context.Context) (*GroupMember, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PrivateChat.Get(ctx, id)
+					value, err = m.Client().GroupMember.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5597,10 +6428,10 @@ func withPrivateChatID(id uuid.UUID) privatechatOption {
 	}
 }
 
-// withPrivateChat sets the old PrivateChat of the mutation.
-func withPrivateChat(node *PrivateChat) privatechatOption {
-	return func(m *PrivateChatMutation) {
-		m.oldValue = func(context.Context) (*PrivateChat, error) {
+// withGroupMember sets the old GroupMember of the mutation.
+func withGroupMember(node *GroupMember) groupmemberOption {
+	return func(m *GroupMemberMutation) {
+		m.oldValue = func(context.Context) (*GroupMember, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5609,7 +6440,7 @@ func withPrivateChat(node *PrivateChat) privatechatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PrivateChatMutation) Client() *Client {
+func (m GroupMemberMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5617,7 +6448,7 @@ func (m PrivateChatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PrivateChatMutation) Tx() (*Tx, error) {
+func (m GroupMemberMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5627,14 +6458,14 @@ func (m PrivateChatMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PrivateChat entities.
-func (m *PrivateChatMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of GroupMember entities.
+func (m *GroupMemberMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PrivateChatMutation) ID() (id uuid.UUID, exists bool) {
+func (m *GroupMemberMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5645,7 +6476,7 @@ func (m *PrivateChatMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PrivateChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *GroupMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5654,596 +6485,844 @@ func (m *PrivateChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PrivateChat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GroupMember.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *PrivateChatMutation) SetChatID(u uuid.UUID) {
-	m.chat = &u
+// SetGroupChatID sets the "group_chat_id" field.
+func (m *GroupMemberMutation) SetGroupChatID(u uuid.UUID) {
+	m.group_chat = &u
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *PrivateChatMutation) ChatID() (r uuid.UUID, exists bool) {
-	v := m.chat
+// GroupChatID returns the value of the "group_chat_id" field in the mutation.
+func (m *GroupMemberMutation) GroupChatID() (r uuid.UUID, exists bool) {
+	v := m.group_chat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupChatID returns the old "group_chat_id" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *GroupMemberMutation) OldGroupChatID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldGroupChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupChatID: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.GroupChatID, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *PrivateChatMutation) ResetChatID() {
-	m.chat = nil
+// ResetGroupChatID resets all changes to the "group_chat_id" field.
+func (m *GroupMemberMutation) ResetGroupChatID() {
+	m.group_chat = nil
 }
 
-// SetUser1ID sets the "user1_id" field.
-func (m *PrivateChatMutation) SetUser1ID(u uuid.UUID) {
-	m.user1 = &u
+// SetUserID sets the "user_id" field.
+func (m *GroupMemberMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// User1ID returns the value of the "user1_id" field in the mutation.
-func (m *PrivateChatMutation) User1ID() (r uuid.UUID, exists bool) {
-	v := m.user1
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GroupMemberMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser1ID returns the old "user1_id" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser1ID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *GroupMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser1ID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser1ID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser1ID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.User1ID, nil
-}
-
-// ClearUser1ID clears the value of the "user1_id" field.
-func (m *PrivateChatMutation) ClearUser1ID() {
-	m.user1 = nil
-	m.clearedFields[privatechat.FieldUser1ID] = struct{}{}
-}
-
-// User1IDCleared returns if the "user1_id" field was cleared in this mutation.
-func (m *PrivateChatMutation) User1IDCleared() bool {
-	_, ok := m.clearedFields[privatechat.FieldUser1ID]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetUser1ID resets all changes to the "user1_id" field.
-func (m *PrivateChatMutation) ResetUser1ID() {
-	m.user1 = nil
-	delete(m.clearedFields, privatechat.FieldUser1ID)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GroupMemberMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetUser2ID sets the "user2_id" field.
-func (m *PrivateChatMutation) SetUser2ID(u uuid.UUID) {
-	m.user2 = &u
+// SetRole sets the "role" field.
+func (m *GroupMemberMutation) SetRole(gr groupmember.Role) {
+	m.role = &gr
 }
 
-// User2ID returns the value of the "user2_id" field in the mutation.
-func (m *PrivateChatMutation) User2ID() (r uuid.UUID, exists bool) {
-	v := m.user2
+// Role returns the value of the "role" field in the mutation.
+func (m *GroupMemberMutation) Role() (r groupmember.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser2ID returns the old "user2_id" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser2ID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *GroupMemberMutation) OldRole(ctx context.Context) (v groupmember.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser2ID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser2ID requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser2ID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.User2ID, nil
-}
-
-// ClearUser2ID clears the value of the "user2_id" field.
-func (m *PrivateChatMutation) ClearUser2ID() {
-	m.user2 = nil
-	m.clearedFields[privatechat.FieldUser2ID] = struct{}{}
-}
-
-// User2IDCleared returns if the "user2_id" field was cleared in this mutation.
-func (m *PrivateChatMutation) User2IDCleared() bool {
-	_, ok := m.clearedFields[privatechat.FieldUser2ID]
-	return ok
+	return oldValue.Role, nil
 }
 
-// ResetUser2ID resets all changes to the "user2_id" field.
-func (m *PrivateChatMutation) ResetUser2ID() {
-	m.user2 = nil
-	delete(m.clearedFields, privatechat.FieldUser2ID)
+// ResetRole resets all changes to the "role" field.
+func (m *GroupMemberMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetUser1LastReadAt sets the "user1_last_read_at" field.
-func (m *PrivateChatMutation) SetUser1LastReadAt(t time.Time) {
-	m.user1_last_read_at = &t
+// SetLastReadAt sets the "last_read_at" field.
+func (m *GroupMemberMutation) SetLastReadAt(t time.Time) {
+	m.last_read_at = &t
 }
 
-// User1LastReadAt returns the value of the "user1_last_read_at" field in the mutation.
-func (m *PrivateChatMutation) User1LastReadAt() (r time.Time, exists bool) {
-	v := m.user1_last_read_at
+// LastReadAt returns the value of the "last_read_at" field in the mutation.
+func (m *GroupMemberMutation) LastReadAt() (r time.Time, exists bool) {
+	v := m.last_read_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser1LastReadAt returns the old "user1_last_read_at" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldLastReadAt returns the old "last_read_at" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser1LastReadAt(ctx context.Context) (v *time.Time, err error) {
+func (m *GroupMemberMutation) OldLastReadAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser1LastReadAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastReadAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser1LastReadAt requires an ID field in the mutation")
+		return v, errors.New("OldLastReadAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser1LastReadAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastReadAt: %w", err)
 	}
-	return oldValue.User1LastReadAt, nil
+	return oldValue.LastReadAt, nil
 }
 
-// ClearUser1LastReadAt clears the value of the "user1_last_read_at" field.
-func (m *PrivateChatMutation) ClearUser1LastReadAt() {
-	m.user1_last_read_at = nil
-	m.clearedFields[privatechat.FieldUser1LastReadAt] = struct{}{}
+// ClearLastReadAt clears the value of the "last_read_at" field.
+func (m *GroupMemberMutation) ClearLastReadAt() {
+	m.last_read_at = nil
+	m.clearedFields[groupmember.FieldLastReadAt] = struct{}{}
 }
 
-// User1LastReadAtCleared returns if the "user1_last_read_at" field was cleared in this mutation.
-func (m *PrivateChatMutation) User1LastReadAtCleared() bool {
-	_, ok := m.clearedFields[privatechat.FieldUser1LastReadAt]
+// LastReadAtCleared returns if the "last_read_at" field was cleared in this mutation.
+func (m *GroupMemberMutation) LastReadAtCleared() bool {
+	_, ok := m.clearedFields[groupmember.FieldLastReadAt]
 	return ok
 }
 
-// ResetUser1LastReadAt resets all changes to the "user1_last_read_at" field.
-func (m *PrivateChatMutation) ResetUser1LastReadAt() {
-	m.user1_last_read_at = nil
-	delete(m.clearedFields, privatechat.FieldUser1LastReadAt)
+// ResetLastReadAt resets all changes to the "last_read_at" field.
+func (m *GroupMemberMutation) ResetLastReadAt() {
+	m.last_read_at = nil
+	delete(m.clearedFields, groupmember.FieldLastReadAt)
 }
 
-// SetUser2LastReadAt sets the "user2_last_read_at" field.
-func (m *PrivateChatMutation) SetUser2LastReadAt(t time.Time) {
-	m.user2_last_read_at = &t
+// SetJoinedAt sets the "joined_at" field.
+func (m *GroupMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
 }
 
-// User2LastReadAt returns the value of the "user2_last_read_at" field in the mutation.
-func (m *PrivateChatMutation) User2LastReadAt() (r time.Time, exists bool) {
-	v := m.user2_last_read_at
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *GroupMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser2LastReadAt returns the old "user2_last_read_at" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldJoinedAt returns the old "joined_at" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser2LastReadAt(ctx context.Context) (v *time.Time, err error) {
+func (m *GroupMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser2LastReadAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser2LastReadAt requires an ID field in the mutation")
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser2LastReadAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
 	}
-	return oldValue.User2LastReadAt, nil
-}
-
-// ClearUser2LastReadAt clears the value of the "user2_last_read_at" field.
-func (m *PrivateChatMutation) ClearUser2LastReadAt() {
-	m.user2_last_read_at = nil
-	m.clearedFields[privatechat.FieldUser2LastReadAt] = struct{}{}
-}
-
-// User2LastReadAtCleared returns if the "user2_last_read_at" field was cleared in this mutation.
-func (m *PrivateChatMutation) User2LastReadAtCleared() bool {
-	_, ok := m.clearedFields[privatechat.FieldUser2LastReadAt]
-	return ok
+	return oldValue.JoinedAt, nil
 }
 
-// ResetUser2LastReadAt resets all changes to the "user2_last_read_at" field.
-func (m *PrivateChatMutation) ResetUser2LastReadAt() {
-	m.user2_last_read_at = nil
-	delete(m.clearedFields, privatechat.FieldUser2LastReadAt)
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *GroupMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
 }
 
-// SetUser1HiddenAt sets the "user1_hidden_at" field.
-func (m *PrivateChatMutation) SetUser1HiddenAt(t time.Time) {
-	m.user1_hidden_at = &t
+// SetUnreadCount sets the "unread_count" field.
+func (m *GroupMemberMutation) SetUnreadCount(i int) {
+	m.unread_count = &i
+	m.addunread_count = nil
 }
 
-// User1HiddenAt returns the value of the "user1_hidden_at" field in the mutation.
-func (m *PrivateChatMutation) User1HiddenAt() (r time.Time, exists bool) {
-	v := m.user1_hidden_at
+// UnreadCount returns the value of the "unread_count" field in the mutation.
+func (m *GroupMemberMutation) UnreadCount() (r int, exists bool) {
+	v := m.unread_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser1HiddenAt returns the old "user1_hidden_at" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldUnreadCount returns the old "unread_count" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser1HiddenAt(ctx context.Context) (v *time.Time, err error) {
+func (m *GroupMemberMutation) OldUnreadCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser1HiddenAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnreadCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser1HiddenAt requires an ID field in the mutation")
+		return v, errors.New("OldUnreadCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser1HiddenAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnreadCount: %w", err)
 	}
-	return oldValue.User1HiddenAt, nil
+	return oldValue.UnreadCount, nil
 }
 
-// ClearUser1HiddenAt clears the value of the "user1_hidden_at" field.
-func (m *PrivateChatMutation) ClearUser1HiddenAt() {
-	m.user1_hidden_at = nil
-	m.clearedFields[privatechat.FieldUser1HiddenAt] = struct{}{}
+// AddUnreadCount adds i to the "unread_count" field.
+func (m *GroupMemberMutation) AddUnreadCount(i int) {
+	if m.addunread_count != nil {
+		*m.addunread_count += i
+	} else {
+		m.addunread_count = &i
+	}
 }
 
-// User1HiddenAtCleared returns if the "user1_hidden_at" field was cleared in this mutation.
-func (m *PrivateChatMutation) User1HiddenAtCleared() bool {
-	_, ok := m.clearedFields[privatechat.FieldUser1HiddenAt]
-	return ok
+// AddedUnreadCount returns the value that was added to the "unread_count" field in this mutation.
+func (m *GroupMemberMutation) AddedUnreadCount() (r int, exists bool) {
+	v := m.addunread_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetUser1HiddenAt resets all changes to the "user1_hidden_at" field.
-func (m *PrivateChatMutation) ResetUser1HiddenAt() {
-	m.user1_hidden_at = nil
-	delete(m.clearedFields, privatechat.FieldUser1HiddenAt)
+// ResetUnreadCount resets all changes to the "unread_count" field.
+func (m *GroupMemberMutation) ResetUnreadCount() {
+	m.unread_count = nil
+	m.addunread_count = nil
 }
 
-// SetUser2HiddenAt sets the "user2_hidden_at" field.
-func (m *PrivateChatMutation) SetUser2HiddenAt(t time.Time) {
-	m.user2_hidden_at = &t
+// SetUnreadMentionCount sets the "unread_mention_count" field.
+func (m *GroupMemberMutation) SetUnreadMentionCount(i int) {
+	m.unread_mention_count = &i
+	m.addunread_mention_count = nil
 }
 
-// User2HiddenAt returns the value of the "user2_hidden_at" field in the mutation.
-func (m *PrivateChatMutation) User2HiddenAt() (r time.Time, exists bool) {
-	v := m.user2_hidden_at
+// UnreadMentionCount returns the value of the "unread_mention_count" field in the mutation.
+func (m *GroupMemberMutation) UnreadMentionCount() (r int, exists bool) {
+	v := m.unread_mention_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser2HiddenAt returns the old "user2_hidden_at" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldUnreadMentionCount returns the old "unread_mention_count" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser2HiddenAt(ctx context.Context) (v *time.Time, err error) {
+func (m *GroupMemberMutation) OldUnreadMentionCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser2HiddenAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnreadMentionCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser2HiddenAt requires an ID field in the mutation")
+		return v, errors.New("OldUnreadMentionCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser2HiddenAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnreadMentionCount: %w", err)
 	}
-	return oldValue.User2HiddenAt, nil
+	return oldValue.UnreadMentionCount, nil
 }
 
-// ClearUser2HiddenAt clears the value of the "user2_hidden_at" field.
-func (m *PrivateChatMutation) ClearUser2HiddenAt() {
-	m.user2_hidden_at = nil
-	m.clearedFields[privatechat.FieldUser2HiddenAt] = struct{}{}
+// AddUnreadMentionCount adds i to the "unread_mention_count" field.
+func (m *GroupMemberMutation) AddUnreadMentionCount(i int) {
+	if m.addunread_mention_count != nil {
+		*m.addunread_mention_count += i
+	} else {
+		m.addunread_mention_count = &i
+	}
 }
 
-// User2HiddenAtCleared returns if the "user2_hidden_at" field was cleared in this mutation.
-func (m *PrivateChatMutation) User2HiddenAtCleared() bool {
-	_, ok := m.clearedFields[privatechat.FieldUser2HiddenAt]
-	return ok
+// AddedUnreadMentionCount returns the value that was added to the "unread_mention_count" field in this mutation.
+func (m *GroupMemberMutation) AddedUnreadMentionCount() (r int, exists bool) {
+	v := m.addunread_mention_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetUser2HiddenAt resets all changes to the "user2_hidden_at" field.
-func (m *PrivateChatMutation) ResetUser2HiddenAt() {
-	m.user2_hidden_at = nil
-	delete(m.clearedFields, privatechat.FieldUser2HiddenAt)
+// ResetUnreadMentionCount resets all changes to the "unread_mention_count" field.
+func (m *GroupMemberMutation) ResetUnreadMentionCount() {
+	m.unread_mention_count = nil
+	m.addunread_mention_count = nil
 }
 
-// SetUser1UnreadCount sets the "user1_unread_count" field.
-func (m *PrivateChatMutation) SetUser1UnreadCount(i int) {
-	m.user1_unread_count = &i
-	m.adduser1_unread_count = nil
+// SetMarkedUnread sets the "marked_unread" field.
+func (m *GroupMemberMutation) SetMarkedUnread(b bool) {
+	m.marked_unread = &b
 }
 
-// User1UnreadCount returns the value of the "user1_unread_count" field in the mutation.
-func (m *PrivateChatMutation) User1UnreadCount() (r int, exists bool) {
-	v := m.user1_unread_count
+// MarkedUnread returns the value of the "marked_unread" field in the mutation.
+func (m *GroupMemberMutation) MarkedUnread() (r bool, exists bool) {
+	v := m.marked_unread
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser1UnreadCount returns the old "user1_unread_count" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldMarkedUnread returns the old "marked_unread" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser1UnreadCount(ctx context.Context) (v int, err error) {
+func (m *GroupMemberMutation) OldMarkedUnread(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser1UnreadCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldMarkedUnread is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser1UnreadCount requires an ID field in the mutation")
+		return v, errors.New("OldMarkedUnread requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser1UnreadCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldMarkedUnread: %w", err)
 	}
-	return oldValue.User1UnreadCount, nil
+	return oldValue.MarkedUnread, nil
 }
 
-// AddUser1UnreadCount adds i to the "user1_unread_count" field.
-func (m *PrivateChatMutation) AddUser1UnreadCount(i int) {
-	if m.adduser1_unread_count != nil {
-		*m.adduser1_unread_count += i
-	} else {
-		m.adduser1_unread_count = &i
-	}
+// ResetMarkedUnread resets all changes to the "marked_unread" field.
+func (m *GroupMemberMutation) ResetMarkedUnread() {
+	m.marked_unread = nil
 }
 
-// AddedUser1UnreadCount returns the value that was added to the "user1_unread_count" field in this mutation.
-func (m *PrivateChatMutation) AddedUser1UnreadCount() (r int, exists bool) {
-	v := m.adduser1_unread_count
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (m *GroupMemberMutation) SetLastReadMessageID(u uuid.UUID) {
+	m.last_read_message = &u
+}
+
+// LastReadMessageID returns the value of the "last_read_message_id" field in the mutation.
+func (m *GroupMemberMutation) LastReadMessageID() (r uuid.UUID, exists bool) {
+	v := m.last_read_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUser1UnreadCount resets all changes to the "user1_unread_count" field.
-func (m *PrivateChatMutation) ResetUser1UnreadCount() {
-	m.user1_unread_count = nil
-	m.adduser1_unread_count = nil
+// OldLastReadMessageID returns the old "last_read_message_id" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMemberMutation) OldLastReadMessageID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastReadMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastReadMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastReadMessageID: %w", err)
+	}
+	return oldValue.LastReadMessageID, nil
 }
 
-// SetUser2UnreadCount sets the "user2_unread_count" field.
-func (m *PrivateChatMutation) SetUser2UnreadCount(i int) {
-	m.user2_unread_count = &i
-	m.adduser2_unread_count = nil
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (m *GroupMemberMutation) ClearLastReadMessageID() {
+	m.last_read_message = nil
+	m.clearedFields[groupmember.FieldLastReadMessageID] = struct{}{}
 }
 
-// User2UnreadCount returns the value of the "user2_unread_count" field in the mutation.
-func (m *PrivateChatMutation) User2UnreadCount() (r int, exists bool) {
-	v := m.user2_unread_count
+// LastReadMessageIDCleared returns if the "last_read_message_id" field was cleared in this mutation.
+func (m *GroupMemberMutation) LastReadMessageIDCleared() bool {
+	_, ok := m.clearedFields[groupmember.FieldLastReadMessageID]
+	return ok
+}
+
+// ResetLastReadMessageID resets all changes to the "last_read_message_id" field.
+func (m *GroupMemberMutation) ResetLastReadMessageID() {
+	m.last_read_message = nil
+	delete(m.clearedFields, groupmember.FieldLastReadMessageID)
+}
+
+// SetLastDeliveredMessageID sets the "last_delivered_message_id" field.
+func (m *GroupMemberMutation) SetLastDeliveredMessageID(u uuid.UUID) {
+	m.last_delivered_message = &u
+}
+
+// LastDeliveredMessageID returns the value of the "last_delivered_message_id" field in the mutation.
+func (m *GroupMemberMutation) LastDeliveredMessageID() (r uuid.UUID, exists bool) {
+	v := m.last_delivered_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUser2UnreadCount returns the old "user2_unread_count" field's value of the PrivateChat entity.
-// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// OldLastDeliveredMessageID returns the old "last_delivered_message_id" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateChatMutation) OldUser2UnreadCount(ctx context.Context) (v int, err error) {
+func (m *GroupMemberMutation) OldLastDeliveredMessageID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUser2UnreadCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastDeliveredMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUser2UnreadCount requires an ID field in the mutation")
+		return v, errors.New("OldLastDeliveredMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUser2UnreadCount: %w", err)
-	}
-	return oldValue.User2UnreadCount, nil
-}
-
-// AddUser2UnreadCount adds i to the "user2_unread_count" field.
-func (m *PrivateChatMutation) AddUser2UnreadCount(i int) {
-	if m.adduser2_unread_count != nil {
-		*m.adduser2_unread_count += i
-	} else {
-		m.adduser2_unread_count = &i
+		return v, fmt.Errorf("querying old value for OldLastDeliveredMessageID: %w", err)
 	}
+	return oldValue.LastDeliveredMessageID, nil
 }
 
-// AddedUser2UnreadCount returns the value that was added to the "user2_unread_count" field in this mutation.
-func (m *PrivateChatMutation) AddedUser2UnreadCount() (r int, exists bool) {
-	v := m.adduser2_unread_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearLastDeliveredMessageID clears the value of the "last_delivered_message_id" field.
+func (m *GroupMemberMutation) ClearLastDeliveredMessageID() {
+	m.last_delivered_message = nil
+	m.clearedFields[groupmember.FieldLastDeliveredMessageID] = struct{}{}
 }
 
-// ResetUser2UnreadCount resets all changes to the "user2_unread_count" field.
-func (m *PrivateChatMutation) ResetUser2UnreadCount() {
-	m.user2_unread_count = nil
-	m.adduser2_unread_count = nil
+// LastDeliveredMessageIDCleared returns if the "last_delivered_message_id" field was cleared in this mutation.
+func (m *GroupMemberMutation) LastDeliveredMessageIDCleared() bool {
+	_, ok := m.clearedFields[groupmember.FieldLastDeliveredMessageID]
+	return ok
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *PrivateChatMutation) ClearChat() {
-	m.clearedchat = true
-	m.clearedFields[privatechat.FieldChatID] = struct{}{}
+// ResetLastDeliveredMessageID resets all changes to the "last_delivered_message_id" field.
+func (m *GroupMemberMutation) ResetLastDeliveredMessageID() {
+	m.last_delivered_message = nil
+	delete(m.clearedFields, groupmember.FieldLastDeliveredMessageID)
 }
 
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *PrivateChatMutation) ChatCleared() bool {
-	return m.clearedchat
+// SetArchivedAt sets the "archived_at" field.
+func (m *GroupMemberMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
 }
 
-// ChatIDs returns the "chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *PrivateChatMutation) ChatIDs() (ids []uuid.UUID) {
-	if id := m.chat; id != nil {
-		ids = append(ids, *id)
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *GroupMemberMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetChat resets all changes to the "chat" edge.
-func (m *PrivateChatMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
+	return *v, true
 }
 
-// ClearUser1 clears the "user1" edge to the User entity.
-func (m *PrivateChatMutation) ClearUser1() {
-	m.cleareduser1 = true
-	m.clearedFields[privatechat.FieldUser1ID] = struct{}{}
+// OldArchivedAt returns the old "archived_at" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMemberMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
 }
 
-// User1Cleared reports if the "user1" edge to the User entity was cleared.
-func (m *PrivateChatMutation) User1Cleared() bool {
-	return m.User1IDCleared() || m.cleareduser1
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *GroupMemberMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[groupmember.FieldArchivedAt] = struct{}{}
 }
 
-// User1IDs returns the "user1" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// User1ID instead. It exists only for internal usage by the builders.
-func (m *PrivateChatMutation) User1IDs() (ids []uuid.UUID) {
-	if id := m.user1; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *GroupMemberMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[groupmember.FieldArchivedAt]
+	return ok
 }
 
-// ResetUser1 resets all changes to the "user1" edge.
-func (m *PrivateChatMutation) ResetUser1() {
-	m.user1 = nil
-	m.cleareduser1 = false
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *GroupMemberMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, groupmember.FieldArchivedAt)
 }
 
-// ClearUser2 clears the "user2" edge to the User entity.
-func (m *PrivateChatMutation) ClearUser2() {
-	m.cleareduser2 = true
-	m.clearedFields[privatechat.FieldUser2ID] = struct{}{}
+// SetKeepArchived sets the "keep_archived" field.
+func (m *GroupMemberMutation) SetKeepArchived(b bool) {
+	m.keep_archived = &b
 }
 
-// User2Cleared reports if the "user2" edge to the User entity was cleared.
-func (m *PrivateChatMutation) User2Cleared() bool {
-	return m.User2IDCleared() || m.cleareduser2
+// KeepArchived returns the value of the "keep_archived" field in the mutation.
+func (m *GroupMemberMutation) KeepArchived() (r bool, exists bool) {
+	v := m.keep_archived
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// User2IDs returns the "user2" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// User2ID instead. It exists only for internal usage by the builders.
-func (m *PrivateChatMutation) User2IDs() (ids []uuid.UUID) {
-	if id := m.user2; id != nil {
-		ids = append(ids, *id)
+// OldKeepArchived returns the old "keep_archived" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMemberMutation) OldKeepArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepArchived is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepArchived: %w", err)
+	}
+	return oldValue.KeepArchived, nil
 }
 
-// ResetUser2 resets all changes to the "user2" edge.
-func (m *PrivateChatMutation) ResetUser2() {
-	m.user2 = nil
-	m.cleareduser2 = false
+// ResetKeepArchived resets all changes to the "keep_archived" field.
+func (m *GroupMemberMutation) ResetKeepArchived() {
+	m.keep_archived = nil
 }
 
-// Where appends a list predicates to the PrivateChatMutation builder.
-func (m *PrivateChatMutation) Where(ps ...predicate.PrivateChat) {
-	m.predicates = append(m.predicates, ps...)
+// SetMuted sets the "muted" field.
+func (m *GroupMemberMutation) SetMuted(b bool) {
+	m.muted = &b
 }
 
-// WhereP appends storage-level predicates to the PrivateChatMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PrivateChatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PrivateChat, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Muted returns the value of the "muted" field in the mutation.
+func (m *GroupMemberMutation) Muted() (r bool, exists bool) {
+	v := m.muted
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PrivateChatMutation) Op() Op {
-	return m.op
+// OldMuted returns the old "muted" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMemberMutation) OldMuted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMuted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMuted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMuted: %w", err)
+	}
+	return oldValue.Muted, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PrivateChatMutation) SetOp(op Op) {
-	m.op = op
+// ResetMuted resets all changes to the "muted" field.
+func (m *GroupMemberMutation) ResetMuted() {
+	m.muted = nil
 }
 
-// Type returns the node type of this mutation (PrivateChat).
-func (m *PrivateChatMutation) Type() string {
-	return m.typ
+// SetMutedUntil sets the "muted_until" field.
+func (m *GroupMemberMutation) SetMutedUntil(t time.Time) {
+	m.muted_until = &t
+}
+
+// MutedUntil returns the value of the "muted_until" field in the mutation.
+func (m *GroupMemberMutation) MutedUntil() (r time.Time, exists bool) {
+	v := m.muted_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMutedUntil returns the old "muted_until" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMemberMutation) OldMutedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMutedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMutedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMutedUntil: %w", err)
+	}
+	return oldValue.MutedUntil, nil
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (m *GroupMemberMutation) ClearMutedUntil() {
+	m.muted_until = nil
+	m.clearedFields[groupmember.FieldMutedUntil] = struct{}{}
+}
+
+// MutedUntilCleared returns if the "muted_until" field was cleared in this mutation.
+func (m *GroupMemberMutation) MutedUntilCleared() bool {
+	_, ok := m.clearedFields[groupmember.FieldMutedUntil]
+	return ok
+}
+
+// ResetMutedUntil resets all changes to the "muted_until" field.
+func (m *GroupMemberMutation) ResetMutedUntil() {
+	m.muted_until = nil
+	delete(m.clearedFields, groupmember.FieldMutedUntil)
+}
+
+// SetNotifyMentions sets the "notify_mentions" field.
+func (m *GroupMemberMutation) SetNotifyMentions(b bool) {
+	m.notify_mentions = &b
+}
+
+// NotifyMentions returns the value of the "notify_mentions" field in the mutation.
+func (m *GroupMemberMutation) NotifyMentions() (r bool, exists bool) {
+	v := m.notify_mentions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotifyMentions returns the old "notify_mentions" field's value of the GroupMember entity.
+// If the GroupMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMemberMutation) OldNotifyMentions(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifyMentions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifyMentions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifyMentions: %w", err)
+	}
+	return oldValue.NotifyMentions, nil
+}
+
+// ResetNotifyMentions resets all changes to the "notify_mentions" field.
+func (m *GroupMemberMutation) ResetNotifyMentions() {
+	m.notify_mentions = nil
+}
+
+// ClearGroupChat clears the "group_chat" edge to the GroupChat entity.
+func (m *GroupMemberMutation) ClearGroupChat() {
+	m.clearedgroup_chat = true
+	m.clearedFields[groupmember.FieldGroupChatID] = struct{}{}
+}
+
+// GroupChatCleared reports if the "group_chat" edge to the GroupChat entity was cleared.
+func (m *GroupMemberMutation) GroupChatCleared() bool {
+	return m.clearedgroup_chat
+}
+
+// GroupChatIDs returns the "group_chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupChatID instead. It exists only for internal usage by the builders.
+func (m *GroupMemberMutation) GroupChatIDs() (ids []uuid.UUID) {
+	if id := m.group_chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroupChat resets all changes to the "group_chat" edge.
+func (m *GroupMemberMutation) ResetGroupChat() {
+	m.group_chat = nil
+	m.clearedgroup_chat = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GroupMemberMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[groupmember.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GroupMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GroupMemberMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GroupMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearLastReadMessage clears the "last_read_message" edge to the Message entity.
+func (m *GroupMemberMutation) ClearLastReadMessage() {
+	m.clearedlast_read_message = true
+	m.clearedFields[groupmember.FieldLastReadMessageID] = struct{}{}
+}
+
+// LastReadMessageCleared reports if the "last_read_message" edge to the Message entity was cleared.
+func (m *GroupMemberMutation) LastReadMessageCleared() bool {
+	return m.LastReadMessageIDCleared() || m.clearedlast_read_message
+}
+
+// LastReadMessageIDs returns the "last_read_message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LastReadMessageID instead. It exists only for internal usage by the builders.
+func (m *GroupMemberMutation) LastReadMessageIDs() (ids []uuid.UUID) {
+	if id := m.last_read_message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLastReadMessage resets all changes to the "last_read_message" edge.
+func (m *GroupMemberMutation) ResetLastReadMessage() {
+	m.last_read_message = nil
+	m.clearedlast_read_message = false
+}
+
+// ClearLastDeliveredMessage clears the "last_delivered_message" edge to the Message entity.
+func (m *GroupMemberMutation) ClearLastDeliveredMessage() {
+	m.clearedlast_delivered_message = true
+	m.clearedFields[groupmember.FieldLastDeliveredMessageID] = struct{}{}
+}
+
+// LastDeliveredMessageCleared reports if the "last_delivered_message" edge to the Message entity was cleared.
+func (m *GroupMemberMutation) LastDeliveredMessageCleared() bool {
+	return m.LastDeliveredMessageIDCleared() || m.clearedlast_delivered_message
+}
+
+// LastDeliveredMessageIDs returns the "last_delivered_message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LastDeliveredMessageID instead. It exists only for internal usage by the builders.
+func (m *GroupMemberMutation) LastDeliveredMessageIDs() (ids []uuid.UUID) {
+	if id := m.last_delivered_message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLastDeliveredMessage resets all changes to the "last_delivered_message" edge.
+func (m *GroupMemberMutation) ResetLastDeliveredMessage() {
+	m.last_delivered_message = nil
+	m.clearedlast_delivered_message = false
+}
+
+// Where appends a list predicates to the GroupMemberMutation builder.
+func (m *GroupMemberMutation) Where(ps ...predicate.GroupMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GroupMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GroupMember).
+func (m *GroupMemberMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PrivateChatMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.chat != nil {
-		fields = append(fields, privatechat.FieldChatID)
+func (m *GroupMemberMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.group_chat != nil {
+		fields = append(fields, groupmember.FieldGroupChatID)
 	}
-	if m.user1 != nil {
-		fields = append(fields, privatechat.FieldUser1ID)
+	if m.user != nil {
+		fields = append(fields, groupmember.FieldUserID)
 	}
-	if m.user2 != nil {
-		fields = append(fields, privatechat.FieldUser2ID)
+	if m.role != nil {
+		fields = append(fields, groupmember.FieldRole)
 	}
-	if m.user1_last_read_at != nil {
-		fields = append(fields, privatechat.FieldUser1LastReadAt)
+	if m.last_read_at != nil {
+		fields = append(fields, groupmember.FieldLastReadAt)
 	}
-	if m.user2_last_read_at != nil {
-		fields = append(fields, privatechat.FieldUser2LastReadAt)
+	if m.joined_at != nil {
+		fields = append(fields, groupmember.FieldJoinedAt)
 	}
-	if m.user1_hidden_at != nil {
-		fields = append(fields, privatechat.FieldUser1HiddenAt)
+	if m.unread_count != nil {
+		fields = append(fields, groupmember.FieldUnreadCount)
 	}
-	if m.user2_hidden_at != nil {
-		fields = append(fields, privatechat.FieldUser2HiddenAt)
+	if m.unread_mention_count != nil {
+		fields = append(fields, groupmember.FieldUnreadMentionCount)
 	}
-	if m.user1_unread_count != nil {
-		fields = append(fields, privatechat.FieldUser1UnreadCount)
+	if m.marked_unread != nil {
+		fields = append(fields, groupmember.FieldMarkedUnread)
 	}
-	if m.user2_unread_count != nil {
-		fields = append(fields, privatechat.FieldUser2UnreadCount)
+	if m.last_read_message != nil {
+		fields = append(fields, groupmember.FieldLastReadMessageID)
+	}
+	if m.last_delivered_message != nil {
+		fields = append(fields, groupmember.FieldLastDeliveredMessageID)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, groupmember.FieldArchivedAt)
+	}
+	if m.keep_archived != nil {
+		fields = append(fields, groupmember.FieldKeepArchived)
+	}
+	if m.muted != nil {
+		fields = append(fields, groupmember.FieldMuted)
+	}
+	if m.muted_until != nil {
+		fields = append(fields, groupmember.FieldMutedUntil)
+	}
+	if m.notify_mentions != nil {
+		fields = append(fields, groupmember.FieldNotifyMentions)
 	}
 	return fields
 }
@@ -6251,26 +7330,38 @@ func (m *PrivateChatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PrivateChatMutation) Field(name string) (ent.Value, bool) {
+func (m *GroupMemberMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case privatechat.FieldChatID:
-		return m.ChatID()
-	case privatechat.FieldUser1ID:
-		return m.User1ID()
-	case privatechat.FieldUser2ID:
-		return m.User2ID()
-	case privatechat.FieldUser1LastReadAt:
-		return m.User1LastReadAt()
-	case privatechat.FieldUser2LastReadAt:
-		return m.User2LastReadAt()
-	case privatechat.FieldUser1HiddenAt:
-		return m.User1HiddenAt()
-	case privatechat.FieldUser2HiddenAt:
-		return m.User2HiddenAt()
-	case privatechat.FieldUser1UnreadCount:
-		return m.User1UnreadCount()
-	case privatechat.FieldUser2UnreadCount:
-		return m.User2UnreadCount()
+	case groupmember.FieldGroupChatID:
+		return m.GroupChatID()
+	case groupmember.FieldUserID:
+		return m.UserID()
+	case groupmember.FieldRole:
+		return m.Role()
+	case groupmember.FieldLastReadAt:
+		return m.LastReadAt()
+	case groupmember.FieldJoinedAt:
+		return m.JoinedAt()
+	case groupmember.FieldUnreadCount:
+		return m.UnreadCount()
+	case groupmember.FieldUnreadMentionCount:
+		return m.UnreadMentionCount()
+	case groupmember.FieldMarkedUnread:
+		return m.MarkedUnread()
+	case groupmember.FieldLastReadMessageID:
+		return m.LastReadMessageID()
+	case groupmember.FieldLastDeliveredMessageID:
+		return m.LastDeliveredMessageID()
+	case groupmember.FieldArchivedAt:
+		return m.ArchivedAt()
+	case groupmember.FieldKeepArchived:
+		return m.KeepArchived()
+	case groupmember.FieldMuted:
+		return m.Muted()
+	case groupmember.FieldMutedUntil:
+		return m.MutedUntil()
+	case groupmember.FieldNotifyMentions:
+		return m.NotifyMentions()
 	}
 	return nil, false
 }
@@ -6278,111 +7369,165 @@ func (m *PrivateChatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PrivateChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GroupMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case privatechat.FieldChatID:
-		return m.OldChatID(ctx)
-	case privatechat.FieldUser1ID:
-		return m.OldUser1ID(ctx)
-	case privatechat.FieldUser2ID:
-		return m.OldUser2ID(ctx)
-	case privatechat.FieldUser1LastReadAt:
-		return m.OldUser1LastReadAt(ctx)
-	case privatechat.FieldUser2LastReadAt:
-		return m.OldUser2LastReadAt(ctx)
-	case privatechat.FieldUser1HiddenAt:
-		return m.OldUser1HiddenAt(ctx)
-	case privatechat.FieldUser2HiddenAt:
-		return m.OldUser2HiddenAt(ctx)
-	case privatechat.FieldUser1UnreadCount:
-		return m.OldUser1UnreadCount(ctx)
-	case privatechat.FieldUser2UnreadCount:
-		return m.OldUser2UnreadCount(ctx)
+	case groupmember.FieldGroupChatID:
+		return m.OldGroupChatID(ctx)
+	case groupmember.FieldUserID:
+		return m.OldUserID(ctx)
+	case groupmember.FieldRole:
+		return m.OldRole(ctx)
+	case groupmember.FieldLastReadAt:
+		return m.OldLastReadAt(ctx)
+	case groupmember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case groupmember.FieldUnreadCount:
+		return m.OldUnreadCount(ctx)
+	case groupmember.FieldUnreadMentionCount:
+		return m.OldUnreadMentionCount(ctx)
+	case groupmember.FieldMarkedUnread:
+		return m.OldMarkedUnread(ctx)
+	case groupmember.FieldLastReadMessageID:
+		return m.OldLastReadMessageID(ctx)
+	case groupmember.FieldLastDeliveredMessageID:
+		return m.OldLastDeliveredMessageID(ctx)
+	case groupmember.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case groupmember.FieldKeepArchived:
+		return m.OldKeepArchived(ctx)
+	case groupmember.FieldMuted:
+		return m.OldMuted(ctx)
+	case groupmember.FieldMutedUntil:
+		return m.OldMutedUntil(ctx)
+	case groupmember.FieldNotifyMentions:
+		return m.OldNotifyMentions(ctx)
 	}
-	return nil, fmt.Errorf("unknown PrivateChat field %s", name)
+	return nil, fmt.Errorf("unknown GroupMember field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrivateChatMutation) SetField(name string, value ent.Value) error {
+func (m *GroupMemberMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case privatechat.FieldChatID:
+	case groupmember.FieldGroupChatID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChatID(v)
+		m.SetGroupChatID(v)
 		return nil
-	case privatechat.FieldUser1ID:
+	case groupmember.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser1ID(v)
+		m.SetUserID(v)
 		return nil
-	case privatechat.FieldUser2ID:
-		v, ok := value.(uuid.UUID)
+	case groupmember.FieldRole:
+		v, ok := value.(groupmember.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser2ID(v)
+		m.SetRole(v)
 		return nil
-	case privatechat.FieldUser1LastReadAt:
+	case groupmember.FieldLastReadAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser1LastReadAt(v)
+		m.SetLastReadAt(v)
 		return nil
-	case privatechat.FieldUser2LastReadAt:
+	case groupmember.FieldJoinedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser2LastReadAt(v)
+		m.SetJoinedAt(v)
 		return nil
-	case privatechat.FieldUser1HiddenAt:
-		v, ok := value.(time.Time)
+	case groupmember.FieldUnreadCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser1HiddenAt(v)
+		m.SetUnreadCount(v)
 		return nil
-	case privatechat.FieldUser2HiddenAt:
+	case groupmember.FieldUnreadMentionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnreadMentionCount(v)
+		return nil
+	case groupmember.FieldMarkedUnread:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMarkedUnread(v)
+		return nil
+	case groupmember.FieldLastReadMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReadMessageID(v)
+		return nil
+	case groupmember.FieldLastDeliveredMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDeliveredMessageID(v)
+		return nil
+	case groupmember.FieldArchivedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser2HiddenAt(v)
+		m.SetArchivedAt(v)
 		return nil
-	case privatechat.FieldUser1UnreadCount:
-		v, ok := value.(int)
+	case groupmember.FieldKeepArchived:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser1UnreadCount(v)
+		m.SetKeepArchived(v)
 		return nil
-	case privatechat.FieldUser2UnreadCount:
-		v, ok := value.(int)
+	case groupmember.FieldMuted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUser2UnreadCount(v)
+		m.SetMuted(v)
+		return nil
+	case groupmember.FieldMutedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMutedUntil(v)
+		return nil
+	case groupmember.FieldNotifyMentions:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifyMentions(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateChat field %s", name)
+	return fmt.Errorf("unknown GroupMember field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PrivateChatMutation) AddedFields() []string {
+func (m *GroupMemberMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser1_unread_count != nil {
-		fields = append(fields, privatechat.FieldUser1UnreadCount)
+	if m.addunread_count != nil {
+		fields = append(fields, groupmember.FieldUnreadCount)
 	}
-	if m.adduser2_unread_count != nil {
-		fields = append(fields, privatechat.FieldUser2UnreadCount)
+	if m.addunread_mention_count != nil {
+		fields = append(fields, groupmember.FieldUnreadMentionCount)
 	}
 	return fields
 }
@@ -6390,12 +7535,12 @@ func (m *PrivateChatMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PrivateChatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GroupMemberMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case privatechat.FieldUser1UnreadCount:
-		return m.AddedUser1UnreadCount()
-	case privatechat.FieldUser2UnreadCount:
-		return m.AddedUser2UnreadCount()
+	case groupmember.FieldUnreadCount:
+		return m.AddedUnreadCount()
+	case groupmember.FieldUnreadMentionCount:
+		return m.AddedUnreadMentionCount()
 	}
 	return nil, false
 }
@@ -6403,148 +7548,167 @@ func (m *PrivateChatMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrivateChatMutation) AddField(name string, value ent.Value) error {
+func (m *GroupMemberMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case privatechat.FieldUser1UnreadCount:
+	case groupmember.FieldUnreadCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUser1UnreadCount(v)
+		m.AddUnreadCount(v)
 		return nil
-	case privatechat.FieldUser2UnreadCount:
+	case groupmember.FieldUnreadMentionCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUser2UnreadCount(v)
+		m.AddUnreadMentionCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateChat numeric field %s", name)
+	return fmt.Errorf("unknown GroupMember numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PrivateChatMutation) ClearedFields() []string {
+func (m *GroupMemberMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(privatechat.FieldUser1ID) {
-		fields = append(fields, privatechat.FieldUser1ID)
-	}
-	if m.FieldCleared(privatechat.FieldUser2ID) {
-		fields = append(fields, privatechat.FieldUser2ID)
+	if m.FieldCleared(groupmember.FieldLastReadAt) {
+		fields = append(fields, groupmember.FieldLastReadAt)
 	}
-	if m.FieldCleared(privatechat.FieldUser1LastReadAt) {
-		fields = append(fields, privatechat.FieldUser1LastReadAt)
+	if m.FieldCleared(groupmember.FieldLastReadMessageID) {
+		fields = append(fields, groupmember.FieldLastReadMessageID)
 	}
-	if m.FieldCleared(privatechat.FieldUser2LastReadAt) {
-		fields = append(fields, privatechat.FieldUser2LastReadAt)
+	if m.FieldCleared(groupmember.FieldLastDeliveredMessageID) {
+		fields = append(fields, groupmember.FieldLastDeliveredMessageID)
 	}
-	if m.FieldCleared(privatechat.FieldUser1HiddenAt) {
-		fields = append(fields, privatechat.FieldUser1HiddenAt)
+	if m.FieldCleared(groupmember.FieldArchivedAt) {
+		fields = append(fields, groupmember.FieldArchivedAt)
 	}
-	if m.FieldCleared(privatechat.FieldUser2HiddenAt) {
-		fields = append(fields, privatechat.FieldUser2HiddenAt)
+	if m.FieldCleared(groupmember.FieldMutedUntil) {
+		fields = append(fields, groupmember.FieldMutedUntil)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PrivateChatMutation) FieldCleared(name string) bool {
+func (m *GroupMemberMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PrivateChatMutation) ClearField(name string) error {
+func (m *GroupMemberMutation) ClearField(name string) error {
 	switch name {
-	case privatechat.FieldUser1ID:
-		m.ClearUser1ID()
-		return nil
-	case privatechat.FieldUser2ID:
-		m.ClearUser2ID()
+	case groupmember.FieldLastReadAt:
+		m.ClearLastReadAt()
 		return nil
-	case privatechat.FieldUser1LastReadAt:
-		m.ClearUser1LastReadAt()
+	case groupmember.FieldLastReadMessageID:
+		m.ClearLastReadMessageID()
 		return nil
-	case privatechat.FieldUser2LastReadAt:
-		m.ClearUser2LastReadAt()
+	case groupmember.FieldLastDeliveredMessageID:
+		m.ClearLastDeliveredMessageID()
 		return nil
-	case privatechat.FieldUser1HiddenAt:
-		m.ClearUser1HiddenAt()
+	case groupmember.FieldArchivedAt:
+		m.ClearArchivedAt()
 		return nil
-	case privatechat.FieldUser2HiddenAt:
-		m.ClearUser2HiddenAt()
+	case groupmember.FieldMutedUntil:
+		m.ClearMutedUntil()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateChat nullable field %s", name)
+	return fmt.Errorf("unknown GroupMember nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PrivateChatMutation) ResetField(name string) error {
+func (m *GroupMemberMutation) ResetField(name string) error {
 	switch name {
-	case privatechat.FieldChatID:
-		m.ResetChatID()
+	case groupmember.FieldGroupChatID:
+		m.ResetGroupChatID()
 		return nil
-	case privatechat.FieldUser1ID:
-		m.ResetUser1ID()
+	case groupmember.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case privatechat.FieldUser2ID:
-		m.ResetUser2ID()
+	case groupmember.FieldRole:
+		m.ResetRole()
 		return nil
-	case privatechat.FieldUser1LastReadAt:
-		m.ResetUser1LastReadAt()
+	case groupmember.FieldLastReadAt:
+		m.ResetLastReadAt()
 		return nil
-	case privatechat.FieldUser2LastReadAt:
-		m.ResetUser2LastReadAt()
+	case groupmember.FieldJoinedAt:
+		m.ResetJoinedAt()
 		return nil
-	case privatechat.FieldUser1HiddenAt:
-		m.ResetUser1HiddenAt()
+	case groupmember.FieldUnreadCount:
+		m.ResetUnreadCount()
 		return nil
-	case privatechat.FieldUser2HiddenAt:
-		m.ResetUser2HiddenAt()
+	case groupmember.FieldUnreadMentionCount:
+		m.ResetUnreadMentionCount()
 		return nil
-	case privatechat.FieldUser1UnreadCount:
-		m.ResetUser1UnreadCount()
+	case groupmember.FieldMarkedUnread:
+		m.ResetMarkedUnread()
 		return nil
-	case privatechat.FieldUser2UnreadCount:
-		m.ResetUser2UnreadCount()
+	case groupmember.FieldLastReadMessageID:
+		m.ResetLastReadMessageID()
+		return nil
+	case groupmember.FieldLastDeliveredMessageID:
+		m.ResetLastDeliveredMessageID()
+		return nil
+	case groupmember.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case groupmember.FieldKeepArchived:
+		m.ResetKeepArchived()
+		return nil
+	case groupmember.FieldMuted:
+		m.ResetMuted()
+		return nil
+	case groupmember.FieldMutedUntil:
+		m.ResetMutedUntil()
+		return nil
+	case groupmember.FieldNotifyMentions:
+		m.ResetNotifyMentions()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateChat field %s", name)
+	return fmt.Errorf("unknown GroupMember field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PrivateChatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.chat != nil {
-		edges = append(edges, privatechat.EdgeChat)
+func (m *GroupMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.group_chat != nil {
+		edges = append(edges, groupmember.EdgeGroupChat)
 	}
-	if m.user1 != nil {
-		edges = append(edges, privatechat.EdgeUser1)
+	if m.user != nil {
+		edges = append(edges, groupmember.EdgeUser)
 	}
-	if m.user2 != nil {
-		edges = append(edges, privatechat.EdgeUser2)
+	if m.last_read_message != nil {
+		edges = append(edges, groupmember.EdgeLastReadMessage)
+	}
+	if m.last_delivered_message != nil {
+		edges = append(edges, groupmember.EdgeLastDeliveredMessage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PrivateChatMutation) AddedIDs(name string) []ent.Value {
+func (m *GroupMemberMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case privatechat.EdgeChat:
-		if id := m.chat; id != nil {
+	case groupmember.EdgeGroupChat:
+		if id := m.group_chat; id != nil {
 			return []ent.Value{*id}
 		}
-	case privatechat.EdgeUser1:
-		if id := m.user1; id != nil {
+	case groupmember.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case privatechat.EdgeUser2:
-		if id := m.user2; id != nil {
+	case groupmember.EdgeLastReadMessage:
+		if id := m.last_read_message; id != nil {
+			return []ent.Value{*id}
+		}
+	case groupmember.EdgeLastDeliveredMessage:
+		if id := m.last_delivered_message; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -6552,125 +7716,145 @@ func (m *PrivateChatMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PrivateChatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *GroupMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PrivateChatMutation) RemovedIDs(name string) []ent.Value {
+func (m *GroupMemberMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PrivateChatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedchat {
-		edges = append(edges, privatechat.EdgeChat)
+func (m *GroupMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedgroup_chat {
+		edges = append(edges, groupmember.EdgeGroupChat)
 	}
-	if m.cleareduser1 {
-		edges = append(edges, privatechat.EdgeUser1)
+	if m.cleareduser {
+		edges = append(edges, groupmember.EdgeUser)
 	}
-	if m.cleareduser2 {
-		edges = append(edges, privatechat.EdgeUser2)
+	if m.clearedlast_read_message {
+		edges = append(edges, groupmember.EdgeLastReadMessage)
+	}
+	if m.clearedlast_delivered_message {
+		edges = append(edges, groupmember.EdgeLastDeliveredMessage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PrivateChatMutation) EdgeCleared(name string) bool {
+func (m *GroupMemberMutation) EdgeCleared(name string) bool {
 	switch name {
-	case privatechat.EdgeChat:
-		return m.clearedchat
-	case privatechat.EdgeUser1:
-		return m.cleareduser1
-	case privatechat.EdgeUser2:
-		return m.cleareduser2
+	case groupmember.EdgeGroupChat:
+		return m.clearedgroup_chat
+	case groupmember.EdgeUser:
+		return m.cleareduser
+	case groupmember.EdgeLastReadMessage:
+		return m.clearedlast_read_message
+	case groupmember.EdgeLastDeliveredMessage:
+		return m.clearedlast_delivered_message
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PrivateChatMutation) ClearEdge(name string) error {
+func (m *GroupMemberMutation) ClearEdge(name string) error {
 	switch name {
-	case privatechat.EdgeChat:
-		m.ClearChat()
+	case groupmember.EdgeGroupChat:
+		m.ClearGroupChat()
 		return nil
-	case privatechat.EdgeUser1:
-		m.ClearUser1()
+	case groupmember.EdgeUser:
+		m.ClearUser()
 		return nil
-	case privatechat.EdgeUser2:
-		m.ClearUser2()
+	case groupmember.EdgeLastReadMessage:
+		m.ClearLastReadMessage()
+		return nil
+	case groupmember.EdgeLastDeliveredMessage:
+		m.ClearLastDeliveredMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateChat unique edge %s", name)
+	return fmt.Errorf("unknown GroupMember unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PrivateChatMutation) ResetEdge(name string) error {
+func (m *GroupMemberMutation) ResetEdge(name string) error {
 	switch name {
-	case privatechat.EdgeChat:
-		m.ResetChat()
+	case groupmember.EdgeGroupChat:
+		m.ResetGroupChat()
 		return nil
-	case privatechat.EdgeUser1:
-		m.ResetUser1()
+	case groupmember.EdgeUser:
+		m.ResetUser()
 		return nil
-	case privatechat.EdgeUser2:
-		m.ResetUser2()
+	case groupmember.EdgeLastReadMessage:
+		m.ResetLastReadMessage()
+		return nil
+	case groupmember.EdgeLastDeliveredMessage:
+		m.ResetLastDeliveredMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateChat edge %s", name)
+	return fmt.Errorf("unknown GroupMember edge %s", name)
 }
 
-// ReportMutation represents an operation that mutates the Report nodes in the graph.
-type ReportMutation struct {
+// MediaMutation represents an operation that mutates the Media nodes in the graph.
+type MediaMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	target_type           *report.TargetType
-	reason                *string
-	description           *string
-	evidence_snapshot     *map[string]interface{}
-	status                *report.Status
-	resolution_notes      *string
-	resolved_at           *time.Time
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	reporter              *uuid.UUID
-	clearedreporter       bool
-	message               *uuid.UUID
-	clearedmessage        bool
-	group                 *uuid.UUID
-	clearedgroup          bool
-	target_user           *uuid.UUID
-	clearedtarget_user    bool
-	evidence_media        map[uuid.UUID]struct{}
-	removedevidence_media map[uuid.UUID]struct{}
-	clearedevidence_media bool
-	resolved_by           *uuid.UUID
-	clearedresolved_by    bool
-	done                  bool
-	oldValue              func(context.Context) (*Report, error)
-	predicates            []predicate.Report
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	created_at               *time.Time
+	updated_at               *time.Time
+	file_name                *string
+	original_name            *string
+	file_size                *int64
+	addfile_size             *int64
+	mime_type                *string
+	category                 *media.Category
+	upload_status            *media.UploadStatus
+	upload_expires_at        *time.Time
+	completed_at             *time.Time
+	duration_ms              *int
+	addduration_ms           *int
+	waveform                 *[]int
+	appendwaveform           []int
+	clearedFields            map[string]struct{}
+	message                  *uuid.UUID
+	clearedmessage           bool
+	scheduled_message        *uuid.UUID
+	clearedscheduled_message bool
+	user_avatar              *uuid.UUID
+	cleareduser_avatar       bool
+	group_avatar             *uuid.UUID
+	clearedgroup_avatar      bool
+	uploader                 *uuid.UUID
+	cleareduploader          bool
+	reports                  map[uuid.UUID]struct{}
+	removedreports           map[uuid.UUID]struct{}
+	clearedreports           bool
+	message_revisions        map[uuid.UUID]struct{}
+	removedmessage_revisions map[uuid.UUID]struct{}
+	clearedmessage_revisions bool
+	done                     bool
+	oldValue                 func(context.Context) (*Media, error)
+	predicates               []predicate.Media
 }
 
-var _ ent.Mutation = (*ReportMutation)(nil)
+var _ ent.Mutation = (*MediaMutation)(nil)
 
-// reportOption allows management of the mutation configuration using functional options.
-type reportOption func(*ReportMutation)
+// mediaOption allows management of the mutation configuration using functional options.
+type mediaOption func(*MediaMutation)
 
-// newReportMutation creates new mutation for the Report entity.
-func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
-	m := &ReportMutation{
+// newMediaMutation creates new mutation for the Media entity.
+func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
+	m := &MediaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReport,
+		typ:           TypeMedia,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6679,20 +7863,20 @@ func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
 	return m
 }
 
-// withReportID sets the ID field of the mutation.
-func withReportID(id uuid.UUID) reportOption {
-	return func(m *ReportMutation) {
+// withMediaID sets the ID field of the mutation.
+func withMediaID(id uuid.UUID) mediaOption {
+	return func(m *MediaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Report
+			value *Media
 		)
-		m.oldValue = func(ctx context.Context) (*Report, error) {
+		m.oldValue = func(ctx context.Context) (*Media, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Report.Get(ctx, id)
+					value, err = m.Client().Media.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6701,10 +7885,10 @@ func withReportID(id uuid.UUID) reportOption {
 	}
 }
 
-// withReport sets the old Report of the mutation.
-func withReport(node *Report) reportOption {
-	return func(m *ReportMutation) {
-		m.oldValue = func(context.Context) (*Report, error) {
+// withMedia sets the old Media of the mutation.
+func withMedia(node *Media) mediaOption {
+	return func(m *MediaMutation) {
+		m.oldValue = func(context.Context) (*Media, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6713,7 +7897,7 @@ func withReport(node *Report) reportOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReportMutation) Client() *Client {
+func (m MediaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6721,7 +7905,7 @@ func (m ReportMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReportMutation) Tx() (*Tx, error) {
+func (m MediaMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6731,14 +7915,14 @@ func (m ReportMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Report entities.
-func (m *ReportMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Media entities.
+func (m *MediaMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReportMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MediaMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6749,7 +7933,7 @@ func (m *ReportMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MediaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6758,675 +7942,715 @@ func (m *ReportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTargetType sets the "target_type" field.
-func (m *ReportMutation) SetTargetType(rt report.TargetType) {
-	m.target_type = &rt
+// SetCreatedAt sets the "created_at" field.
+func (m *MediaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// TargetType returns the value of the "target_type" field in the mutation.
-func (m *ReportMutation) TargetType() (r report.TargetType, exists bool) {
-	v := m.target_type
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetType returns the old "target_type" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldTargetType(ctx context.Context) (v report.TargetType, err error) {
+func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetType requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.TargetType, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTargetType resets all changes to the "target_type" field.
-func (m *ReportMutation) ResetTargetType() {
-	m.target_type = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MediaMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetReason sets the "reason" field.
-func (m *ReportMutation) SetReason(s string) {
-	m.reason = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MediaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *ReportMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MediaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *MediaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Reason, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *ReportMutation) ResetReason() {
-	m.reason = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MediaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ReportMutation) SetDescription(s string) {
-	m.description = &s
+// SetFileName sets the "file_name" field.
+func (m *MediaMutation) SetFileName(s string) {
+	m.file_name = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ReportMutation) Description() (r string, exists bool) {
-	v := m.description
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *MediaMutation) FileName() (r string, exists bool) {
+	v := m.file_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldFileName returns the old "file_name" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *MediaMutation) OldFileName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldFileName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ReportMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[report.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ReportMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[report.FieldDescription]
-	return ok
+	return oldValue.FileName, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ReportMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, report.FieldDescription)
+// ResetFileName resets all changes to the "file_name" field.
+func (m *MediaMutation) ResetFileName() {
+	m.file_name = nil
 }
 
-// SetEvidenceSnapshot sets the "evidence_snapshot" field.
-func (m *ReportMutation) SetEvidenceSnapshot(value map[string]interface{}) {
-	m.evidence_snapshot = &value
+// SetOriginalName sets the "original_name" field.
+func (m *MediaMutation) SetOriginalName(s string) {
+	m.original_name = &s
 }
 
-// EvidenceSnapshot returns the value of the "evidence_snapshot" field in the mutation.
-func (m *ReportMutation) EvidenceSnapshot() (r map[string]interface{}, exists bool) {
-	v := m.evidence_snapshot
+// OriginalName returns the value of the "original_name" field in the mutation.
+func (m *MediaMutation) OriginalName() (r string, exists bool) {
+	v := m.original_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvidenceSnapshot returns the old "evidence_snapshot" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldOriginalName returns the old "original_name" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldEvidenceSnapshot(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *MediaMutation) OldOriginalName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEvidenceSnapshot is only allowed on UpdateOne operations")
+		return v, errors.New("OldOriginalName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEvidenceSnapshot requires an ID field in the mutation")
+		return v, errors.New("OldOriginalName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvidenceSnapshot: %w", err)
+		return v, fmt.Errorf("querying old value for OldOriginalName: %w", err)
 	}
-	return oldValue.EvidenceSnapshot, nil
+	return oldValue.OriginalName, nil
 }
 
-// ClearEvidenceSnapshot clears the value of the "evidence_snapshot" field.
-func (m *ReportMutation) ClearEvidenceSnapshot() {
-	m.evidence_snapshot = nil
-	m.clearedFields[report.FieldEvidenceSnapshot] = struct{}{}
+// ResetOriginalName resets all changes to the "original_name" field.
+func (m *MediaMutation) ResetOriginalName() {
+	m.original_name = nil
 }
 
-// EvidenceSnapshotCleared returns if the "evidence_snapshot" field was cleared in this mutation.
-func (m *ReportMutation) EvidenceSnapshotCleared() bool {
-	_, ok := m.clearedFields[report.FieldEvidenceSnapshot]
-	return ok
+// SetFileSize sets the "file_size" field.
+func (m *MediaMutation) SetFileSize(i int64) {
+	m.file_size = &i
+	m.addfile_size = nil
 }
 
-// ResetEvidenceSnapshot resets all changes to the "evidence_snapshot" field.
-func (m *ReportMutation) ResetEvidenceSnapshot() {
-	m.evidence_snapshot = nil
-	delete(m.clearedFields, report.FieldEvidenceSnapshot)
+// FileSize returns the value of the "file_size" field in the mutation.
+func (m *MediaMutation) FileSize() (r int64, exists bool) {
+	v := m.file_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetStatus sets the "status" field.
-func (m *ReportMutation) SetStatus(r report.Status) {
-	m.status = &r
+// OldFileSize returns the old "file_size" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldFileSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileSize: %w", err)
+	}
+	return oldValue.FileSize, nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ReportMutation) Status() (r report.Status, exists bool) {
-	v := m.status
+// AddFileSize adds i to the "file_size" field.
+func (m *MediaMutation) AddFileSize(i int64) {
+	if m.addfile_size != nil {
+		*m.addfile_size += i
+	} else {
+		m.addfile_size = &i
+	}
+}
+
+// AddedFileSize returns the value that was added to the "file_size" field in this mutation.
+func (m *MediaMutation) AddedFileSize() (r int64, exists bool) {
+	v := m.addfile_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// ResetFileSize resets all changes to the "file_size" field.
+func (m *MediaMutation) ResetFileSize() {
+	m.file_size = nil
+	m.addfile_size = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *MediaMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *MediaMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldStatus(ctx context.Context) (v report.Status, err error) {
+func (m *MediaMutation) OldMimeType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.MimeType, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ReportMutation) ResetStatus() {
-	m.status = nil
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *MediaMutation) ResetMimeType() {
+	m.mime_type = nil
 }
 
-// SetReporterID sets the "reporter_id" field.
-func (m *ReportMutation) SetReporterID(u uuid.UUID) {
-	m.reporter = &u
+// SetCategory sets the "category" field.
+func (m *MediaMutation) SetCategory(value media.Category) {
+	m.category = &value
 }
 
-// ReporterID returns the value of the "reporter_id" field in the mutation.
-func (m *ReportMutation) ReporterID() (r uuid.UUID, exists bool) {
-	v := m.reporter
+// Category returns the value of the "category" field in the mutation.
+func (m *MediaMutation) Category() (r media.Category, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReporterID returns the old "reporter_id" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldReporterID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *MediaMutation) OldCategory(ctx context.Context) (v media.Category, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReporterID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReporterID requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReporterID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.ReporterID, nil
+	return oldValue.Category, nil
 }
 
-// ClearReporterID clears the value of the "reporter_id" field.
-func (m *ReportMutation) ClearReporterID() {
-	m.reporter = nil
-	m.clearedFields[report.FieldReporterID] = struct{}{}
+// ResetCategory resets all changes to the "category" field.
+func (m *MediaMutation) ResetCategory() {
+	m.category = nil
 }
 
-// ReporterIDCleared returns if the "reporter_id" field was cleared in this mutation.
-func (m *ReportMutation) ReporterIDCleared() bool {
-	_, ok := m.clearedFields[report.FieldReporterID]
-	return ok
+// SetUploadStatus sets the "upload_status" field.
+func (m *MediaMutation) SetUploadStatus(ms media.UploadStatus) {
+	m.upload_status = &ms
 }
 
-// ResetReporterID resets all changes to the "reporter_id" field.
-func (m *ReportMutation) ResetReporterID() {
-	m.reporter = nil
-	delete(m.clearedFields, report.FieldReporterID)
+// UploadStatus returns the value of the "upload_status" field in the mutation.
+func (m *MediaMutation) UploadStatus() (r media.UploadStatus, exists bool) {
+	v := m.upload_status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *ReportMutation) SetMessageID(u uuid.UUID) {
-	m.message = &u
+// OldUploadStatus returns the old "upload_status" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldUploadStatus(ctx context.Context) (v media.UploadStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadStatus: %w", err)
+	}
+	return oldValue.UploadStatus, nil
 }
 
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *ReportMutation) MessageID() (r uuid.UUID, exists bool) {
-	v := m.message
+// ResetUploadStatus resets all changes to the "upload_status" field.
+func (m *MediaMutation) ResetUploadStatus() {
+	m.upload_status = nil
+}
+
+// SetUploadExpiresAt sets the "upload_expires_at" field.
+func (m *MediaMutation) SetUploadExpiresAt(t time.Time) {
+	m.upload_expires_at = &t
+}
+
+// UploadExpiresAt returns the value of the "upload_expires_at" field in the mutation.
+func (m *MediaMutation) UploadExpiresAt() (r time.Time, exists bool) {
+	v := m.upload_expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessageID returns the old "message_id" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldUploadExpiresAt returns the old "upload_expires_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldMessageID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *MediaMutation) OldUploadExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploadExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
+		return v, errors.New("OldUploadExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploadExpiresAt: %w", err)
 	}
-	return oldValue.MessageID, nil
+	return oldValue.UploadExpiresAt, nil
 }
 
-// ClearMessageID clears the value of the "message_id" field.
-func (m *ReportMutation) ClearMessageID() {
-	m.message = nil
-	m.clearedFields[report.FieldMessageID] = struct{}{}
+// ClearUploadExpiresAt clears the value of the "upload_expires_at" field.
+func (m *MediaMutation) ClearUploadExpiresAt() {
+	m.upload_expires_at = nil
+	m.clearedFields[media.FieldUploadExpiresAt] = struct{}{}
 }
 
-// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
-func (m *ReportMutation) MessageIDCleared() bool {
-	_, ok := m.clearedFields[report.FieldMessageID]
+// UploadExpiresAtCleared returns if the "upload_expires_at" field was cleared in this mutation.
+func (m *MediaMutation) UploadExpiresAtCleared() bool {
+	_, ok := m.clearedFields[media.FieldUploadExpiresAt]
 	return ok
 }
 
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *ReportMutation) ResetMessageID() {
-	m.message = nil
-	delete(m.clearedFields, report.FieldMessageID)
+// ResetUploadExpiresAt resets all changes to the "upload_expires_at" field.
+func (m *MediaMutation) ResetUploadExpiresAt() {
+	m.upload_expires_at = nil
+	delete(m.clearedFields, media.FieldUploadExpiresAt)
 }
 
-// SetGroupID sets the "group_id" field.
-func (m *ReportMutation) SetGroupID(u uuid.UUID) {
-	m.group = &u
+// SetCompletedAt sets the "completed_at" field.
+func (m *MediaMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// GroupID returns the value of the "group_id" field in the mutation.
-func (m *ReportMutation) GroupID() (r uuid.UUID, exists bool) {
-	v := m.group
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *MediaMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroupID returns the old "group_id" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletedAt returns the old "completed_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldGroupID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *MediaMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroupID requires an ID field in the mutation")
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
-	return oldValue.GroupID, nil
+	return oldValue.CompletedAt, nil
 }
 
-// ClearGroupID clears the value of the "group_id" field.
-func (m *ReportMutation) ClearGroupID() {
-	m.group = nil
-	m.clearedFields[report.FieldGroupID] = struct{}{}
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *MediaMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[media.FieldCompletedAt] = struct{}{}
 }
 
-// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
-func (m *ReportMutation) GroupIDCleared() bool {
-	_, ok := m.clearedFields[report.FieldGroupID]
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *MediaMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[media.FieldCompletedAt]
 	return ok
 }
 
-// ResetGroupID resets all changes to the "group_id" field.
-func (m *ReportMutation) ResetGroupID() {
-	m.group = nil
-	delete(m.clearedFields, report.FieldGroupID)
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *MediaMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, media.FieldCompletedAt)
 }
 
-// SetTargetUserID sets the "target_user_id" field.
-func (m *ReportMutation) SetTargetUserID(u uuid.UUID) {
-	m.target_user = &u
+// SetDurationMs sets the "duration_ms" field.
+func (m *MediaMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
 }
 
-// TargetUserID returns the value of the "target_user_id" field in the mutation.
-func (m *ReportMutation) TargetUserID() (r uuid.UUID, exists bool) {
-	v := m.target_user
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *MediaMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetUserID returns the old "target_user_id" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMs returns the old "duration_ms" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldTargetUserID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *MediaMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
 	}
-	return oldValue.TargetUserID, nil
+	return oldValue.DurationMs, nil
 }
 
-// ClearTargetUserID clears the value of the "target_user_id" field.
-func (m *ReportMutation) ClearTargetUserID() {
-	m.target_user = nil
-	m.clearedFields[report.FieldTargetUserID] = struct{}{}
-}
-
-// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
-func (m *ReportMutation) TargetUserIDCleared() bool {
-	_, ok := m.clearedFields[report.FieldTargetUserID]
-	return ok
-}
-
-// ResetTargetUserID resets all changes to the "target_user_id" field.
-func (m *ReportMutation) ResetTargetUserID() {
-	m.target_user = nil
-	delete(m.clearedFields, report.FieldTargetUserID)
-}
-
-// SetResolutionNotes sets the "resolution_notes" field.
-func (m *ReportMutation) SetResolutionNotes(s string) {
-	m.resolution_notes = &s
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *MediaMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
 }
 
-// ResolutionNotes returns the value of the "resolution_notes" field in the mutation.
-func (m *ReportMutation) ResolutionNotes() (r string, exists bool) {
-	v := m.resolution_notes
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *MediaMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolutionNotes returns the old "resolution_notes" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldResolutionNotes(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolutionNotes is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolutionNotes requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolutionNotes: %w", err)
-	}
-	return oldValue.ResolutionNotes, nil
-}
-
-// ClearResolutionNotes clears the value of the "resolution_notes" field.
-func (m *ReportMutation) ClearResolutionNotes() {
-	m.resolution_notes = nil
-	m.clearedFields[report.FieldResolutionNotes] = struct{}{}
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *MediaMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[media.FieldDurationMs] = struct{}{}
 }
 
-// ResolutionNotesCleared returns if the "resolution_notes" field was cleared in this mutation.
-func (m *ReportMutation) ResolutionNotesCleared() bool {
-	_, ok := m.clearedFields[report.FieldResolutionNotes]
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *MediaMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[media.FieldDurationMs]
 	return ok
 }
 
-// ResetResolutionNotes resets all changes to the "resolution_notes" field.
-func (m *ReportMutation) ResetResolutionNotes() {
-	m.resolution_notes = nil
-	delete(m.clearedFields, report.FieldResolutionNotes)
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *MediaMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, media.FieldDurationMs)
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *ReportMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
+// SetWaveform sets the "waveform" field.
+func (m *MediaMutation) SetWaveform(i []int) {
+	m.waveform = &i
+	m.appendwaveform = nil
 }
 
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *ReportMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
+// Waveform returns the value of the "waveform" field in the mutation.
+func (m *MediaMutation) Waveform() (r []int, exists bool) {
+	v := m.waveform
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedAt returns the old "resolved_at" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldWaveform returns the old "waveform" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *MediaMutation) OldWaveform(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWaveform is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+		return v, errors.New("OldWaveform requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWaveform: %w", err)
 	}
-	return oldValue.ResolvedAt, nil
+	return oldValue.Waveform, nil
 }
 
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *ReportMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[report.FieldResolvedAt] = struct{}{}
+// AppendWaveform adds i to the "waveform" field.
+func (m *MediaMutation) AppendWaveform(i []int) {
+	m.appendwaveform = append(m.appendwaveform, i...)
 }
 
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *ReportMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[report.FieldResolvedAt]
+// AppendedWaveform returns the list of values that were appended to the "waveform" field in this mutation.
+func (m *MediaMutation) AppendedWaveform() ([]int, bool) {
+	if len(m.appendwaveform) == 0 {
+		return nil, false
+	}
+	return m.appendwaveform, true
+}
+
+// ClearWaveform clears the value of the "waveform" field.
+func (m *MediaMutation) ClearWaveform() {
+	m.waveform = nil
+	m.appendwaveform = nil
+	m.clearedFields[media.FieldWaveform] = struct{}{}
+}
+
+// WaveformCleared returns if the "waveform" field was cleared in this mutation.
+func (m *MediaMutation) WaveformCleared() bool {
+	_, ok := m.clearedFields[media.FieldWaveform]
 	return ok
 }
 
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *ReportMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, report.FieldResolvedAt)
+// ResetWaveform resets all changes to the "waveform" field.
+func (m *MediaMutation) ResetWaveform() {
+	m.waveform = nil
+	m.appendwaveform = nil
+	delete(m.clearedFields, media.FieldWaveform)
 }
 
-// SetResolvedByID sets the "resolved_by_id" field.
-func (m *ReportMutation) SetResolvedByID(u uuid.UUID) {
-	m.resolved_by = &u
+// SetMessageID sets the "message_id" field.
+func (m *MediaMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
 }
 
-// ResolvedByID returns the value of the "resolved_by_id" field in the mutation.
-func (m *ReportMutation) ResolvedByID() (r uuid.UUID, exists bool) {
-	v := m.resolved_by
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MediaMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedByID returns the old "resolved_by_id" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageID returns the old "message_id" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldResolvedByID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *MediaMutation) OldMessageID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedByID is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedByID requires an ID field in the mutation")
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedByID: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
 	}
-	return oldValue.ResolvedByID, nil
+	return oldValue.MessageID, nil
 }
 
-// ClearResolvedByID clears the value of the "resolved_by_id" field.
-func (m *ReportMutation) ClearResolvedByID() {
-	m.resolved_by = nil
-	m.clearedFields[report.FieldResolvedByID] = struct{}{}
+// ClearMessageID clears the value of the "message_id" field.
+func (m *MediaMutation) ClearMessageID() {
+	m.message = nil
+	m.clearedFields[media.FieldMessageID] = struct{}{}
 }
 
-// ResolvedByIDCleared returns if the "resolved_by_id" field was cleared in this mutation.
-func (m *ReportMutation) ResolvedByIDCleared() bool {
-	_, ok := m.clearedFields[report.FieldResolvedByID]
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *MediaMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[media.FieldMessageID]
 	return ok
 }
 
-// ResetResolvedByID resets all changes to the "resolved_by_id" field.
-func (m *ReportMutation) ResetResolvedByID() {
-	m.resolved_by = nil
-	delete(m.clearedFields, report.FieldResolvedByID)
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MediaMutation) ResetMessageID() {
+	m.message = nil
+	delete(m.clearedFields, media.FieldMessageID)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ReportMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUploadedByID sets the "uploaded_by_id" field.
+func (m *MediaMutation) SetUploadedByID(u uuid.UUID) {
+	m.uploader = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReportMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UploadedByID returns the value of the "uploaded_by_id" field in the mutation.
+func (m *MediaMutation) UploadedByID() (r uuid.UUID, exists bool) {
+	v := m.uploader
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldUploadedByID returns the old "uploaded_by_id" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MediaMutation) OldUploadedByID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUploadedByID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUploadedByID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUploadedByID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UploadedByID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReportMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearUploadedByID clears the value of the "uploaded_by_id" field.
+func (m *MediaMutation) ClearUploadedByID() {
+	m.uploader = nil
+	m.clearedFields[media.FieldUploadedByID] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ReportMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// UploadedByIDCleared returns if the "uploaded_by_id" field was cleared in this mutation.
+func (m *MediaMutation) UploadedByIDCleared() bool {
+	_, ok := m.clearedFields[media.FieldUploadedByID]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ReportMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetUploadedByID resets all changes to the "uploaded_by_id" field.
+func (m *MediaMutation) ResetUploadedByID() {
+	m.uploader = nil
+	delete(m.clearedFields, media.FieldUploadedByID)
+}
+
+// SetScheduledMessageID sets the "scheduled_message_id" field.
+func (m *MediaMutation) SetScheduledMessageID(u uuid.UUID) {
+	m.scheduled_message = &u
+}
+
+// ScheduledMessageID returns the value of the "scheduled_message_id" field in the mutation.
+func (m *MediaMutation) ScheduledMessageID() (r uuid.UUID, exists bool) {
+	v := m.scheduled_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Report entity.
-// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduledMessageID returns the old "scheduled_message_id" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MediaMutation) OldScheduledMessageID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduledMessageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScheduledMessageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduledMessageID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ReportMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearReporter clears the "reporter" edge to the User entity.
-func (m *ReportMutation) ClearReporter() {
-	m.clearedreporter = true
-	m.clearedFields[report.FieldReporterID] = struct{}{}
+	return oldValue.ScheduledMessageID, nil
 }
 
-// ReporterCleared reports if the "reporter" edge to the User entity was cleared.
-func (m *ReportMutation) ReporterCleared() bool {
-	return m.ReporterIDCleared() || m.clearedreporter
+// ClearScheduledMessageID clears the value of the "scheduled_message_id" field.
+func (m *MediaMutation) ClearScheduledMessageID() {
+	m.scheduled_message = nil
+	m.clearedFields[media.FieldScheduledMessageID] = struct{}{}
 }
 
-// ReporterIDs returns the "reporter" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ReporterID instead. It exists only for internal usage by the builders.
-func (m *ReportMutation) ReporterIDs() (ids []uuid.UUID) {
-	if id := m.reporter; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ScheduledMessageIDCleared returns if the "scheduled_message_id" field was cleared in this mutation.
+func (m *MediaMutation) ScheduledMessageIDCleared() bool {
+	_, ok := m.clearedFields[media.FieldScheduledMessageID]
+	return ok
 }
 
-// ResetReporter resets all changes to the "reporter" edge.
-func (m *ReportMutation) ResetReporter() {
-	m.reporter = nil
-	m.clearedreporter = false
+// ResetScheduledMessageID resets all changes to the "scheduled_message_id" field.
+func (m *MediaMutation) ResetScheduledMessageID() {
+	m.scheduled_message = nil
+	delete(m.clearedFields, media.FieldScheduledMessageID)
 }
 
 // ClearMessage clears the "message" edge to the Message entity.
-func (m *ReportMutation) ClearMessage() {
+func (m *MediaMutation) ClearMessage() {
 	m.clearedmessage = true
-	m.clearedFields[report.FieldMessageID] = struct{}{}
+	m.clearedFields[media.FieldMessageID] = struct{}{}
 }
 
 // MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *ReportMutation) MessageCleared() bool {
+func (m *MediaMutation) MessageCleared() bool {
 	return m.MessageIDCleared() || m.clearedmessage
 }
 
 // MessageIDs returns the "message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // MessageID instead. It exists only for internal usage by the builders.
-func (m *ReportMutation) MessageIDs() (ids []uuid.UUID) {
+func (m *MediaMutation) MessageIDs() (ids []uuid.UUID) {
 	if id := m.message; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7434,155 +8658,273 @@ func (m *ReportMutation) MessageIDs() (ids []uuid.UUID) {
 }
 
 // ResetMessage resets all changes to the "message" edge.
-func (m *ReportMutation) ResetMessage() {
+func (m *MediaMutation) ResetMessage() {
 	m.message = nil
 	m.clearedmessage = false
 }
 
-// ClearGroup clears the "group" edge to the GroupChat entity.
-func (m *ReportMutation) ClearGroup() {
-	m.clearedgroup = true
-	m.clearedFields[report.FieldGroupID] = struct{}{}
+// ClearScheduledMessage clears the "scheduled_message" edge to the ScheduledMessage entity.
+func (m *MediaMutation) ClearScheduledMessage() {
+	m.clearedscheduled_message = true
+	m.clearedFields[media.FieldScheduledMessageID] = struct{}{}
 }
 
-// GroupCleared reports if the "group" edge to the GroupChat entity was cleared.
-func (m *ReportMutation) GroupCleared() bool {
-	return m.GroupIDCleared() || m.clearedgroup
+// ScheduledMessageCleared reports if the "scheduled_message" edge to the ScheduledMessage entity was cleared.
+func (m *MediaMutation) ScheduledMessageCleared() bool {
+	return m.ScheduledMessageIDCleared() || m.clearedscheduled_message
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
+// ScheduledMessageIDs returns the "scheduled_message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *ReportMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
+// ScheduledMessageID instead. It exists only for internal usage by the builders.
+func (m *MediaMutation) ScheduledMessageIDs() (ids []uuid.UUID) {
+	if id := m.scheduled_message; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *ReportMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// ResetScheduledMessage resets all changes to the "scheduled_message" edge.
+func (m *MediaMutation) ResetScheduledMessage() {
+	m.scheduled_message = nil
+	m.clearedscheduled_message = false
 }
 
-// ClearTargetUser clears the "target_user" edge to the User entity.
-func (m *ReportMutation) ClearTargetUser() {
-	m.clearedtarget_user = true
-	m.clearedFields[report.FieldTargetUserID] = struct{}{}
+// SetUserAvatarID sets the "user_avatar" edge to the User entity by id.
+func (m *MediaMutation) SetUserAvatarID(id uuid.UUID) {
+	m.user_avatar = &id
 }
 
-// TargetUserCleared reports if the "target_user" edge to the User entity was cleared.
-func (m *ReportMutation) TargetUserCleared() bool {
-	return m.TargetUserIDCleared() || m.clearedtarget_user
+// ClearUserAvatar clears the "user_avatar" edge to the User entity.
+func (m *MediaMutation) ClearUserAvatar() {
+	m.cleareduser_avatar = true
 }
 
-// TargetUserIDs returns the "target_user" edge IDs in the mutation.
+// UserAvatarCleared reports if the "user_avatar" edge to the User entity was cleared.
+func (m *MediaMutation) UserAvatarCleared() bool {
+	return m.cleareduser_avatar
+}
+
+// UserAvatarID returns the "user_avatar" edge ID in the mutation.
+func (m *MediaMutation) UserAvatarID() (id uuid.UUID, exists bool) {
+	if m.user_avatar != nil {
+		return *m.user_avatar, true
+	}
+	return
+}
+
+// UserAvatarIDs returns the "user_avatar" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetUserID instead. It exists only for internal usage by the builders.
-func (m *ReportMutation) TargetUserIDs() (ids []uuid.UUID) {
-	if id := m.target_user; id != nil {
+// UserAvatarID instead. It exists only for internal usage by the builders.
+func (m *MediaMutation) UserAvatarIDs() (ids []uuid.UUID) {
+	if id := m.user_avatar; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTargetUser resets all changes to the "target_user" edge.
-func (m *ReportMutation) ResetTargetUser() {
-	m.target_user = nil
-	m.clearedtarget_user = false
+// ResetUserAvatar resets all changes to the "user_avatar" edge.
+func (m *MediaMutation) ResetUserAvatar() {
+	m.user_avatar = nil
+	m.cleareduser_avatar = false
 }
 
-// AddEvidenceMediumIDs adds the "evidence_media" edge to the Media entity by ids.
-func (m *ReportMutation) AddEvidenceMediumIDs(ids ...uuid.UUID) {
-	if m.evidence_media == nil {
-		m.evidence_media = make(map[uuid.UUID]struct{})
+// SetGroupAvatarID sets the "group_avatar" edge to the GroupChat entity by id.
+func (m *MediaMutation) SetGroupAvatarID(id uuid.UUID) {
+	m.group_avatar = &id
+}
+
+// ClearGroupAvatar clears the "group_avatar" edge to the GroupChat entity.
+func (m *MediaMutation) ClearGroupAvatar() {
+	m.clearedgroup_avatar = true
+}
+
+// GroupAvatarCleared reports if the "group_avatar" edge to the GroupChat entity was cleared.
+func (m *MediaMutation) GroupAvatarCleared() bool {
+	return m.clearedgroup_avatar
+}
+
+// GroupAvatarID returns the "group_avatar" edge ID in the mutation.
+func (m *MediaMutation) GroupAvatarID() (id uuid.UUID, exists bool) {
+	if m.group_avatar != nil {
+		return *m.group_avatar, true
+	}
+	return
+}
+
+// GroupAvatarIDs returns the "group_avatar" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupAvatarID instead. It exists only for internal usage by the builders.
+func (m *MediaMutation) GroupAvatarIDs() (ids []uuid.UUID) {
+	if id := m.group_avatar; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroupAvatar resets all changes to the "group_avatar" edge.
+func (m *MediaMutation) ResetGroupAvatar() {
+	m.group_avatar = nil
+	m.clearedgroup_avatar = false
+}
+
+// SetUploaderID sets the "uploader" edge to the User entity by id.
+func (m *MediaMutation) SetUploaderID(id uuid.UUID) {
+	m.uploader = &id
+}
+
+// ClearUploader clears the "uploader" edge to the User entity.
+func (m *MediaMutation) ClearUploader() {
+	m.cleareduploader = true
+	m.clearedFields[media.FieldUploadedByID] = struct{}{}
+}
+
+// UploaderCleared reports if the "uploader" edge to the User entity was cleared.
+func (m *MediaMutation) UploaderCleared() bool {
+	return m.UploadedByIDCleared() || m.cleareduploader
+}
+
+// UploaderID returns the "uploader" edge ID in the mutation.
+func (m *MediaMutation) UploaderID() (id uuid.UUID, exists bool) {
+	if m.uploader != nil {
+		return *m.uploader, true
+	}
+	return
+}
+
+// UploaderIDs returns the "uploader" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploaderID instead. It exists only for internal usage by the builders.
+func (m *MediaMutation) UploaderIDs() (ids []uuid.UUID) {
+	if id := m.uploader; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUploader resets all changes to the "uploader" edge.
+func (m *MediaMutation) ResetUploader() {
+	m.uploader = nil
+	m.cleareduploader = false
+}
+
+// AddReportIDs adds the "reports" edge to the Report entity by ids.
+func (m *MediaMutation) AddReportIDs(ids ...uuid.UUID) {
+	if m.reports == nil {
+		m.reports = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.evidence_media[ids[i]] = struct{}{}
+		m.reports[ids[i]] = struct{}{}
 	}
 }
 
-// ClearEvidenceMedia clears the "evidence_media" edge to the Media entity.
-func (m *ReportMutation) ClearEvidenceMedia() {
-	m.clearedevidence_media = true
+// ClearReports clears the "reports" edge to the Report entity.
+func (m *MediaMutation) ClearReports() {
+	m.clearedreports = true
 }
 
-// EvidenceMediaCleared reports if the "evidence_media" edge to the Media entity was cleared.
-func (m *ReportMutation) EvidenceMediaCleared() bool {
-	return m.clearedevidence_media
+// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
+func (m *MediaMutation) ReportsCleared() bool {
+	return m.clearedreports
 }
 
-// RemoveEvidenceMediumIDs removes the "evidence_media" edge to the Media entity by IDs.
-func (m *ReportMutation) RemoveEvidenceMediumIDs(ids ...uuid.UUID) {
-	if m.removedevidence_media == nil {
-		m.removedevidence_media = make(map[uuid.UUID]struct{})
+// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
+func (m *MediaMutation) RemoveReportIDs(ids ...uuid.UUID) {
+	if m.removedreports == nil {
+		m.removedreports = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.evidence_media, ids[i])
-		m.removedevidence_media[ids[i]] = struct{}{}
+		delete(m.reports, ids[i])
+		m.removedreports[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedEvidenceMedia returns the removed IDs of the "evidence_media" edge to the Media entity.
-func (m *ReportMutation) RemovedEvidenceMediaIDs() (ids []uuid.UUID) {
-	for id := range m.removedevidence_media {
+// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
+func (m *MediaMutation) RemovedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// EvidenceMediaIDs returns the "evidence_media" edge IDs in the mutation.
-func (m *ReportMutation) EvidenceMediaIDs() (ids []uuid.UUID) {
-	for id := range m.evidence_media {
+// ReportsIDs returns the "reports" edge IDs in the mutation.
+func (m *MediaMutation) ReportsIDs() (ids []uuid.UUID) {
+	for id := range m.reports {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEvidenceMedia resets all changes to the "evidence_media" edge.
-func (m *ReportMutation) ResetEvidenceMedia() {
-	m.evidence_media = nil
-	m.clearedevidence_media = false
-	m.removedevidence_media = nil
+// ResetReports resets all changes to the "reports" edge.
+func (m *MediaMutation) ResetReports() {
+	m.reports = nil
+	m.clearedreports = false
+	m.removedreports = nil
 }
 
-// ClearResolvedBy clears the "resolved_by" edge to the User entity.
-func (m *ReportMutation) ClearResolvedBy() {
-	m.clearedresolved_by = true
-	m.clearedFields[report.FieldResolvedByID] = struct{}{}
+// AddMessageRevisionIDs adds the "message_revisions" edge to the MessageRevision entity by ids.
+func (m *MediaMutation) AddMessageRevisionIDs(ids ...uuid.UUID) {
+	if m.message_revisions == nil {
+		m.message_revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.message_revisions[ids[i]] = struct{}{}
+	}
 }
 
-// ResolvedByCleared reports if the "resolved_by" edge to the User entity was cleared.
-func (m *ReportMutation) ResolvedByCleared() bool {
-	return m.ResolvedByIDCleared() || m.clearedresolved_by
+// ClearMessageRevisions clears the "message_revisions" edge to the MessageRevision entity.
+func (m *MediaMutation) ClearMessageRevisions() {
+	m.clearedmessage_revisions = true
 }
 
-// ResolvedByIDs returns the "resolved_by" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ResolvedByID instead. It exists only for internal usage by the builders.
-func (m *ReportMutation) ResolvedByIDs() (ids []uuid.UUID) {
-	if id := m.resolved_by; id != nil {
-		ids = append(ids, *id)
+// MessageRevisionsCleared reports if the "message_revisions" edge to the MessageRevision entity was cleared.
+func (m *MediaMutation) MessageRevisionsCleared() bool {
+	return m.clearedmessage_revisions
+}
+
+// RemoveMessageRevisionIDs removes the "message_revisions" edge to the MessageRevision entity by IDs.
+func (m *MediaMutation) RemoveMessageRevisionIDs(ids ...uuid.UUID) {
+	if m.removedmessage_revisions == nil {
+		m.removedmessage_revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.message_revisions, ids[i])
+		m.removedmessage_revisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessageRevisions returns the removed IDs of the "message_revisions" edge to the MessageRevision entity.
+func (m *MediaMutation) RemovedMessageRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessage_revisions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetResolvedBy resets all changes to the "resolved_by" edge.
-func (m *ReportMutation) ResetResolvedBy() {
-	m.resolved_by = nil
-	m.clearedresolved_by = false
+// MessageRevisionsIDs returns the "message_revisions" edge IDs in the mutation.
+func (m *MediaMutation) MessageRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.message_revisions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the ReportMutation builder.
-func (m *ReportMutation) Where(ps ...predicate.Report) {
+// ResetMessageRevisions resets all changes to the "message_revisions" edge.
+func (m *MediaMutation) ResetMessageRevisions() {
+	m.message_revisions = nil
+	m.clearedmessage_revisions = false
+	m.removedmessage_revisions = nil
+}
+
+// Where appends a list predicates to the MediaMutation builder.
+func (m *MediaMutation) Where(ps ...predicate.Media) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Report, len(ps))
+func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Media, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7590,66 +8932,69 @@ func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReportMutation) Op() Op {
+func (m *MediaMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReportMutation) SetOp(op Op) {
+func (m *MediaMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Report).
-func (m *ReportMutation) Type() string {
+// Type returns the node type of this mutation (Media).
+func (m *MediaMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReportMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.target_type != nil {
-		fields = append(fields, report.FieldTargetType)
+func (m *MediaMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.created_at != nil {
+		fields = append(fields, media.FieldCreatedAt)
 	}
-	if m.reason != nil {
-		fields = append(fields, report.FieldReason)
+	if m.updated_at != nil {
+		fields = append(fields, media.FieldUpdatedAt)
 	}
-	if m.description != nil {
-		fields = append(fields, report.FieldDescription)
+	if m.file_name != nil {
+		fields = append(fields, media.FieldFileName)
 	}
-	if m.evidence_snapshot != nil {
-		fields = append(fields, report.FieldEvidenceSnapshot)
+	if m.original_name != nil {
+		fields = append(fields, media.FieldOriginalName)
 	}
-	if m.status != nil {
-		fields = append(fields, report.FieldStatus)
+	if m.file_size != nil {
+		fields = append(fields, media.FieldFileSize)
 	}
-	if m.reporter != nil {
-		fields = append(fields, report.FieldReporterID)
+	if m.mime_type != nil {
+		fields = append(fields, media.FieldMimeType)
 	}
-	if m.message != nil {
-		fields = append(fields, report.FieldMessageID)
+	if m.category != nil {
+		fields = append(fields, media.FieldCategory)
 	}
-	if m.group != nil {
-		fields = append(fields, report.FieldGroupID)
+	if m.upload_status != nil {
+		fields = append(fields, media.FieldUploadStatus)
 	}
-	if m.target_user != nil {
-		fields = append(fields, report.FieldTargetUserID)
+	if m.upload_expires_at != nil {
+		fields = append(fields, media.FieldUploadExpiresAt)
 	}
-	if m.resolution_notes != nil {
-		fields = append(fields, report.FieldResolutionNotes)
+	if m.completed_at != nil {
+		fields = append(fields, media.FieldCompletedAt)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, report.FieldResolvedAt)
+	if m.duration_ms != nil {
+		fields = append(fields, media.FieldDurationMs)
 	}
-	if m.resolved_by != nil {
-		fields = append(fields, report.FieldResolvedByID)
+	if m.waveform != nil {
+		fields = append(fields, media.FieldWaveform)
 	}
-	if m.created_at != nil {
-		fields = append(fields, report.FieldCreatedAt)
+	if m.message != nil {
+		fields = append(fields, media.FieldMessageID)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, report.FieldUpdatedAt)
+	if m.uploader != nil {
+		fields = append(fields, media.FieldUploadedByID)
+	}
+	if m.scheduled_message != nil {
+		fields = append(fields, media.FieldScheduledMessageID)
 	}
 	return fields
 }
@@ -7657,36 +9002,38 @@ func (m *ReportMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+func (m *MediaMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case report.FieldTargetType:
-		return m.TargetType()
-	case report.FieldReason:
-		return m.Reason()
-	case report.FieldDescription:
-		return m.Description()
-	case report.FieldEvidenceSnapshot:
-		return m.EvidenceSnapshot()
-	case report.FieldStatus:
-		return m.Status()
-	case report.FieldReporterID:
-		return m.ReporterID()
-	case report.FieldMessageID:
-		return m.MessageID()
-	case report.FieldGroupID:
-		return m.GroupID()
-	case report.FieldTargetUserID:
-		return m.TargetUserID()
-	case report.FieldResolutionNotes:
-		return m.ResolutionNotes()
-	case report.FieldResolvedAt:
-		return m.ResolvedAt()
-	case report.FieldResolvedByID:
-		return m.ResolvedByID()
-	case report.FieldCreatedAt:
+	case media.FieldCreatedAt:
 		return m.CreatedAt()
-	case report.FieldUpdatedAt:
+	case media.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case media.FieldFileName:
+		return m.FileName()
+	case media.FieldOriginalName:
+		return m.OriginalName()
+	case media.FieldFileSize:
+		return m.FileSize()
+	case media.FieldMimeType:
+		return m.MimeType()
+	case media.FieldCategory:
+		return m.Category()
+	case media.FieldUploadStatus:
+		return m.UploadStatus()
+	case media.FieldUploadExpiresAt:
+		return m.UploadExpiresAt()
+	case media.FieldCompletedAt:
+		return m.CompletedAt()
+	case media.FieldDurationMs:
+		return m.DurationMs()
+	case media.FieldWaveform:
+		return m.Waveform()
+	case media.FieldMessageID:
+		return m.MessageID()
+	case media.FieldUploadedByID:
+		return m.UploadedByID()
+	case media.FieldScheduledMessageID:
+		return m.ScheduledMessageID()
 	}
 	return nil, false
 }
@@ -7694,1778 +9041,15225 @@ func (m *ReportMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case report.FieldTargetType:
-		return m.OldTargetType(ctx)
-	case report.FieldReason:
-		return m.OldReason(ctx)
-	case report.FieldDescription:
-		return m.OldDescription(ctx)
-	case report.FieldEvidenceSnapshot:
-		return m.OldEvidenceSnapshot(ctx)
-	case report.FieldStatus:
-		return m.OldStatus(ctx)
-	case report.FieldReporterID:
-		return m.OldReporterID(ctx)
-	case report.FieldMessageID:
-		return m.OldMessageID(ctx)
-	case report.FieldGroupID:
-		return m.OldGroupID(ctx)
-	case report.FieldTargetUserID:
-		return m.OldTargetUserID(ctx)
-	case report.FieldResolutionNotes:
-		return m.OldResolutionNotes(ctx)
-	case report.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
-	case report.FieldResolvedByID:
-		return m.OldResolvedByID(ctx)
-	case report.FieldCreatedAt:
+	case media.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case report.FieldUpdatedAt:
+	case media.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case media.FieldFileName:
+		return m.OldFileName(ctx)
+	case media.FieldOriginalName:
+		return m.OldOriginalName(ctx)
+	case media.FieldFileSize:
+		return m.OldFileSize(ctx)
+	case media.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case media.FieldCategory:
+		return m.OldCategory(ctx)
+	case media.FieldUploadStatus:
+		return m.OldUploadStatus(ctx)
+	case media.FieldUploadExpiresAt:
+		return m.OldUploadExpiresAt(ctx)
+	case media.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case media.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case media.FieldWaveform:
+		return m.OldWaveform(ctx)
+	case media.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case media.FieldUploadedByID:
+		return m.OldUploadedByID(ctx)
+	case media.FieldScheduledMessageID:
+		return m.OldScheduledMessageID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Report field %s", name)
+	return nil, fmt.Errorf("unknown Media field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReportMutation) SetField(name string, value ent.Value) error {
+func (m *MediaMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case report.FieldTargetType:
-		v, ok := value.(report.TargetType)
+	case media.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetType(v)
+		m.SetCreatedAt(v)
 		return nil
-	case report.FieldReason:
+	case media.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case media.FieldFileName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetFileName(v)
 		return nil
-	case report.FieldDescription:
+	case media.FieldOriginalName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetOriginalName(v)
 		return nil
-	case report.FieldEvidenceSnapshot:
-		v, ok := value.(map[string]interface{})
+	case media.FieldFileSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvidenceSnapshot(v)
+		m.SetFileSize(v)
 		return nil
-	case report.FieldStatus:
-		v, ok := value.(report.Status)
+	case media.FieldMimeType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetMimeType(v)
 		return nil
-	case report.FieldReporterID:
-		v, ok := value.(uuid.UUID)
+	case media.FieldCategory:
+		v, ok := value.(media.Category)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReporterID(v)
+		m.SetCategory(v)
 		return nil
-	case report.FieldMessageID:
-		v, ok := value.(uuid.UUID)
+	case media.FieldUploadStatus:
+		v, ok := value.(media.UploadStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessageID(v)
+		m.SetUploadStatus(v)
 		return nil
-	case report.FieldGroupID:
-		v, ok := value.(uuid.UUID)
+	case media.FieldUploadExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroupID(v)
+		m.SetUploadExpiresAt(v)
 		return nil
-	case report.FieldTargetUserID:
-		v, ok := value.(uuid.UUID)
+	case media.FieldCompletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetUserID(v)
+		m.SetCompletedAt(v)
 		return nil
-	case report.FieldResolutionNotes:
-		v, ok := value.(string)
+	case media.FieldDurationMs:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolutionNotes(v)
+		m.SetDurationMs(v)
 		return nil
-	case report.FieldResolvedAt:
-		v, ok := value.(time.Time)
+	case media.FieldWaveform:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedAt(v)
+		m.SetWaveform(v)
 		return nil
-	case report.FieldResolvedByID:
+	case media.FieldMessageID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedByID(v)
+		m.SetMessageID(v)
 		return nil
-	case report.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case media.FieldUploadedByID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUploadedByID(v)
 		return nil
-	case report.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case media.FieldScheduledMessageID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetScheduledMessageID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Report field %s", name)
+	return fmt.Errorf("unknown Media field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReportMutation) AddedFields() []string {
-	return nil
+func (m *MediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addfile_size != nil {
+		fields = append(fields, media.FieldFileSize)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, media.FieldDurationMs)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldFileSize:
+		return m.AddedFileSize()
+	case media.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReportMutation) AddField(name string, value ent.Value) error {
+func (m *MediaMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case media.FieldFileSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileSize(v)
+		return nil
+	case media.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(media.FieldUploadExpiresAt) {
+		fields = append(fields, media.FieldUploadExpiresAt)
+	}
+	if m.FieldCleared(media.FieldCompletedAt) {
+		fields = append(fields, media.FieldCompletedAt)
+	}
+	if m.FieldCleared(media.FieldDurationMs) {
+		fields = append(fields, media.FieldDurationMs)
+	}
+	if m.FieldCleared(media.FieldWaveform) {
+		fields = append(fields, media.FieldWaveform)
+	}
+	if m.FieldCleared(media.FieldMessageID) {
+		fields = append(fields, media.FieldMessageID)
+	}
+	if m.FieldCleared(media.FieldUploadedByID) {
+		fields = append(fields, media.FieldUploadedByID)
+	}
+	if m.FieldCleared(media.FieldScheduledMessageID) {
+		fields = append(fields, media.FieldScheduledMessageID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaMutation) ClearField(name string) error {
+	switch name {
+	case media.FieldUploadExpiresAt:
+		m.ClearUploadExpiresAt()
+		return nil
+	case media.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case media.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	case media.FieldWaveform:
+		m.ClearWaveform()
+		return nil
+	case media.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	case media.FieldUploadedByID:
+		m.ClearUploadedByID()
+		return nil
+	case media.FieldScheduledMessageID:
+		m.ClearScheduledMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown Media nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaMutation) ResetField(name string) error {
+	switch name {
+	case media.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case media.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case media.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case media.FieldOriginalName:
+		m.ResetOriginalName()
+		return nil
+	case media.FieldFileSize:
+		m.ResetFileSize()
+		return nil
+	case media.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case media.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case media.FieldUploadStatus:
+		m.ResetUploadStatus()
+		return nil
+	case media.FieldUploadExpiresAt:
+		m.ResetUploadExpiresAt()
+		return nil
+	case media.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case media.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case media.FieldWaveform:
+		m.ResetWaveform()
+		return nil
+	case media.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case media.FieldUploadedByID:
+		m.ResetUploadedByID()
+		return nil
+	case media.FieldScheduledMessageID:
+		m.ResetScheduledMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.message != nil {
+		edges = append(edges, media.EdgeMessage)
+	}
+	if m.scheduled_message != nil {
+		edges = append(edges, media.EdgeScheduledMessage)
+	}
+	if m.user_avatar != nil {
+		edges = append(edges, media.EdgeUserAvatar)
+	}
+	if m.group_avatar != nil {
+		edges = append(edges, media.EdgeGroupAvatar)
+	}
+	if m.uploader != nil {
+		edges = append(edges, media.EdgeUploader)
+	}
+	if m.reports != nil {
+		edges = append(edges, media.EdgeReports)
+	}
+	if m.message_revisions != nil {
+		edges = append(edges, media.EdgeMessageRevisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case media.EdgeScheduledMessage:
+		if id := m.scheduled_message; id != nil {
+			return []ent.Value{*id}
+		}
+	case media.EdgeUserAvatar:
+		if id := m.user_avatar; id != nil {
+			return []ent.Value{*id}
+		}
+	case media.EdgeGroupAvatar:
+		if id := m.group_avatar; id != nil {
+			return []ent.Value{*id}
+		}
+	case media.EdgeUploader:
+		if id := m.uploader; id != nil {
+			return []ent.Value{*id}
+		}
+	case media.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.reports))
+		for id := range m.reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMessageRevisions:
+		ids := make([]ent.Value, 0, len(m.message_revisions))
+		for id := range m.message_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedreports != nil {
+		edges = append(edges, media.EdgeReports)
+	}
+	if m.removedmessage_revisions != nil {
+		edges = append(edges, media.EdgeMessageRevisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.removedreports))
+		for id := range m.removedreports {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeMessageRevisions:
+		ids := make([]ent.Value, 0, len(m.removedmessage_revisions))
+		for id := range m.removedmessage_revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedmessage {
+		edges = append(edges, media.EdgeMessage)
+	}
+	if m.clearedscheduled_message {
+		edges = append(edges, media.EdgeScheduledMessage)
+	}
+	if m.cleareduser_avatar {
+		edges = append(edges, media.EdgeUserAvatar)
+	}
+	if m.clearedgroup_avatar {
+		edges = append(edges, media.EdgeGroupAvatar)
+	}
+	if m.cleareduploader {
+		edges = append(edges, media.EdgeUploader)
+	}
+	if m.clearedreports {
+		edges = append(edges, media.EdgeReports)
+	}
+	if m.clearedmessage_revisions {
+		edges = append(edges, media.EdgeMessageRevisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case media.EdgeMessage:
+		return m.clearedmessage
+	case media.EdgeScheduledMessage:
+		return m.clearedscheduled_message
+	case media.EdgeUserAvatar:
+		return m.cleareduser_avatar
+	case media.EdgeGroupAvatar:
+		return m.clearedgroup_avatar
+	case media.EdgeUploader:
+		return m.cleareduploader
+	case media.EdgeReports:
+		return m.clearedreports
+	case media.EdgeMessageRevisions:
+		return m.clearedmessage_revisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaMutation) ClearEdge(name string) error {
+	switch name {
+	case media.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case media.EdgeScheduledMessage:
+		m.ClearScheduledMessage()
+		return nil
+	case media.EdgeUserAvatar:
+		m.ClearUserAvatar()
+		return nil
+	case media.EdgeGroupAvatar:
+		m.ClearGroupAvatar()
+		return nil
+	case media.EdgeUploader:
+		m.ClearUploader()
+		return nil
+	}
+	return fmt.Errorf("unknown Media unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaMutation) ResetEdge(name string) error {
+	switch name {
+	case media.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case media.EdgeScheduledMessage:
+		m.ResetScheduledMessage()
+		return nil
+	case media.EdgeUserAvatar:
+		m.ResetUserAvatar()
+		return nil
+	case media.EdgeGroupAvatar:
+		m.ResetGroupAvatar()
+		return nil
+	case media.EdgeUploader:
+		m.ResetUploader()
+		return nil
+	case media.EdgeReports:
+		m.ResetReports()
+		return nil
+	case media.EdgeMessageRevisions:
+		m.ResetMessageRevisions()
+		return nil
+	}
+	return fmt.Errorf("unknown Media edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	_type                        *message.Type
+	content                      *string
+	action_data                  *map[string]interface{}
+	deleted_at                   *time.Time
+	edited_at                    *time.Time
+	reply_count                  *int
+	addreply_count               *int
+	last_reply_at                *time.Time
+	expires_at                   *time.Time
+	link_preview                 **schema.LinkPreview
+	client_message_id            *string
+	location                     **schema.Location
+	clearedFields                map[string]struct{}
+	chat                         *uuid.UUID
+	clearedchat                  bool
+	sender                       *uuid.UUID
+	clearedsender                bool
+	replies                      map[uuid.UUID]struct{}
+	removedreplies               map[uuid.UUID]struct{}
+	clearedreplies               bool
+	reply_to                     *uuid.UUID
+	clearedreply_to              bool
+	attachments                  map[uuid.UUID]struct{}
+	removedattachments           map[uuid.UUID]struct{}
+	clearedattachments           bool
+	reactions                    map[uuid.UUID]struct{}
+	removedreactions             map[uuid.UUID]struct{}
+	clearedreactions             bool
+	pins                         map[uuid.UUID]struct{}
+	removedpins                  map[uuid.UUID]struct{}
+	clearedpins                  bool
+	revisions                    map[uuid.UUID]struct{}
+	removedrevisions             map[uuid.UUID]struct{}
+	clearedrevisions             bool
+	mentions                     map[uuid.UUID]struct{}
+	removedmentions              map[uuid.UUID]struct{}
+	clearedmentions              bool
+	poll                         *uuid.UUID
+	clearedpoll                  bool
+	forwarded_from_sender        *uuid.UUID
+	clearedforwarded_from_sender bool
+	forwarded_from_chat          *uuid.UUID
+	clearedforwarded_from_chat   bool
+	contact_user                 *uuid.UUID
+	clearedcontact_user          bool
+	reports                      map[uuid.UUID]struct{}
+	removedreports               map[uuid.UUID]struct{}
+	clearedreports               bool
+	done                         bool
+	oldValue                     func(context.Context) (*Message, error)
+	predicates                   []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *MessageMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *MessageMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *MessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *MessageMutation) SetSenderID(u uuid.UUID) {
+	m.sender = &u
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *MessageMutation) SenderID() (r uuid.UUID, exists bool) {
+	v := m.sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSenderID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (m *MessageMutation) ClearSenderID() {
+	m.sender = nil
+	m.clearedFields[message.FieldSenderID] = struct{}{}
+}
+
+// SenderIDCleared returns if the "sender_id" field was cleared in this mutation.
+func (m *MessageMutation) SenderIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldSenderID]
+	return ok
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *MessageMutation) ResetSenderID() {
+	m.sender = nil
+	delete(m.clearedFields, message.FieldSenderID)
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (m *MessageMutation) SetReplyToID(u uuid.UUID) {
+	m.reply_to = &u
+}
+
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *MessageMutation) ReplyToID() (r uuid.UUID, exists bool) {
+	v := m.reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToID returns the old "reply_to_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyToID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (m *MessageMutation) ClearReplyToID() {
+	m.reply_to = nil
+	m.clearedFields[message.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
+func (m *MessageMutation) ReplyToIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldReplyToID]
+	return ok
+}
+
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *MessageMutation) ResetReplyToID() {
+	m.reply_to = nil
+	delete(m.clearedFields, message.FieldReplyToID)
+}
+
+// SetType sets the "type" field.
+func (m *MessageMutation) SetType(value message.Type) {
+	m._type = &value
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MessageMutation) GetType() (r message.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldType(ctx context.Context) (v message.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MessageMutation) ResetType() {
+	m._type = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *MessageMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[message.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *MessageMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[message.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, message.FieldContent)
+}
+
+// SetActionData sets the "action_data" field.
+func (m *MessageMutation) SetActionData(value map[string]interface{}) {
+	m.action_data = &value
+}
+
+// ActionData returns the value of the "action_data" field in the mutation.
+func (m *MessageMutation) ActionData() (r map[string]interface{}, exists bool) {
+	v := m.action_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActionData returns the old "action_data" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldActionData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActionData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActionData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActionData: %w", err)
+	}
+	return oldValue.ActionData, nil
+}
+
+// ClearActionData clears the value of the "action_data" field.
+func (m *MessageMutation) ClearActionData() {
+	m.action_data = nil
+	m.clearedFields[message.FieldActionData] = struct{}{}
+}
+
+// ActionDataCleared returns if the "action_data" field was cleared in this mutation.
+func (m *MessageMutation) ActionDataCleared() bool {
+	_, ok := m.clearedFields[message.FieldActionData]
+	return ok
+}
+
+// ResetActionData resets all changes to the "action_data" field.
+func (m *MessageMutation) ResetActionData() {
+	m.action_data = nil
+	delete(m.clearedFields, message.FieldActionData)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// SetForwardedFromSenderID sets the "forwarded_from_sender_id" field.
+func (m *MessageMutation) SetForwardedFromSenderID(u uuid.UUID) {
+	m.forwarded_from_sender = &u
+}
+
+// ForwardedFromSenderID returns the value of the "forwarded_from_sender_id" field in the mutation.
+func (m *MessageMutation) ForwardedFromSenderID() (r uuid.UUID, exists bool) {
+	v := m.forwarded_from_sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForwardedFromSenderID returns the old "forwarded_from_sender_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldForwardedFromSenderID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForwardedFromSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForwardedFromSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForwardedFromSenderID: %w", err)
+	}
+	return oldValue.ForwardedFromSenderID, nil
+}
+
+// ClearForwardedFromSenderID clears the value of the "forwarded_from_sender_id" field.
+func (m *MessageMutation) ClearForwardedFromSenderID() {
+	m.forwarded_from_sender = nil
+	m.clearedFields[message.FieldForwardedFromSenderID] = struct{}{}
+}
+
+// ForwardedFromSenderIDCleared returns if the "forwarded_from_sender_id" field was cleared in this mutation.
+func (m *MessageMutation) ForwardedFromSenderIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldForwardedFromSenderID]
+	return ok
+}
+
+// ResetForwardedFromSenderID resets all changes to the "forwarded_from_sender_id" field.
+func (m *MessageMutation) ResetForwardedFromSenderID() {
+	m.forwarded_from_sender = nil
+	delete(m.clearedFields, message.FieldForwardedFromSenderID)
+}
+
+// SetForwardedFromChatID sets the "forwarded_from_chat_id" field.
+func (m *MessageMutation) SetForwardedFromChatID(u uuid.UUID) {
+	m.forwarded_from_chat = &u
+}
+
+// ForwardedFromChatID returns the value of the "forwarded_from_chat_id" field in the mutation.
+func (m *MessageMutation) ForwardedFromChatID() (r uuid.UUID, exists bool) {
+	v := m.forwarded_from_chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForwardedFromChatID returns the old "forwarded_from_chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldForwardedFromChatID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForwardedFromChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForwardedFromChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForwardedFromChatID: %w", err)
+	}
+	return oldValue.ForwardedFromChatID, nil
+}
+
+// ClearForwardedFromChatID clears the value of the "forwarded_from_chat_id" field.
+func (m *MessageMutation) ClearForwardedFromChatID() {
+	m.forwarded_from_chat = nil
+	m.clearedFields[message.FieldForwardedFromChatID] = struct{}{}
+}
+
+// ForwardedFromChatIDCleared returns if the "forwarded_from_chat_id" field was cleared in this mutation.
+func (m *MessageMutation) ForwardedFromChatIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldForwardedFromChatID]
+	return ok
+}
+
+// ResetForwardedFromChatID resets all changes to the "forwarded_from_chat_id" field.
+func (m *MessageMutation) ResetForwardedFromChatID() {
+	m.forwarded_from_chat = nil
+	delete(m.clearedFields, message.FieldForwardedFromChatID)
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (m *MessageMutation) SetReplyCount(i int) {
+	m.reply_count = &i
+	m.addreply_count = nil
+}
+
+// ReplyCount returns the value of the "reply_count" field in the mutation.
+func (m *MessageMutation) ReplyCount() (r int, exists bool) {
+	v := m.reply_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyCount returns the old "reply_count" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReplyCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyCount: %w", err)
+	}
+	return oldValue.ReplyCount, nil
+}
+
+// AddReplyCount adds i to the "reply_count" field.
+func (m *MessageMutation) AddReplyCount(i int) {
+	if m.addreply_count != nil {
+		*m.addreply_count += i
+	} else {
+		m.addreply_count = &i
+	}
+}
+
+// AddedReplyCount returns the value that was added to the "reply_count" field in this mutation.
+func (m *MessageMutation) AddedReplyCount() (r int, exists bool) {
+	v := m.addreply_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReplyCount resets all changes to the "reply_count" field.
+func (m *MessageMutation) ResetReplyCount() {
+	m.reply_count = nil
+	m.addreply_count = nil
+}
+
+// SetLastReplyAt sets the "last_reply_at" field.
+func (m *MessageMutation) SetLastReplyAt(t time.Time) {
+	m.last_reply_at = &t
+}
+
+// LastReplyAt returns the value of the "last_reply_at" field in the mutation.
+func (m *MessageMutation) LastReplyAt() (r time.Time, exists bool) {
+	v := m.last_reply_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastReplyAt returns the old "last_reply_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldLastReplyAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastReplyAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastReplyAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastReplyAt: %w", err)
+	}
+	return oldValue.LastReplyAt, nil
+}
+
+// ClearLastReplyAt clears the value of the "last_reply_at" field.
+func (m *MessageMutation) ClearLastReplyAt() {
+	m.last_reply_at = nil
+	m.clearedFields[message.FieldLastReplyAt] = struct{}{}
+}
+
+// LastReplyAtCleared returns if the "last_reply_at" field was cleared in this mutation.
+func (m *MessageMutation) LastReplyAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldLastReplyAt]
+	return ok
+}
+
+// ResetLastReplyAt resets all changes to the "last_reply_at" field.
+func (m *MessageMutation) ResetLastReplyAt() {
+	m.last_reply_at = nil
+	delete(m.clearedFields, message.FieldLastReplyAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *MessageMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *MessageMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *MessageMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[message.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *MessageMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *MessageMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, message.FieldExpiresAt)
+}
+
+// SetLinkPreview sets the "link_preview" field.
+func (m *MessageMutation) SetLinkPreview(sp *schema.LinkPreview) {
+	m.link_preview = &sp
+}
+
+// LinkPreview returns the value of the "link_preview" field in the mutation.
+func (m *MessageMutation) LinkPreview() (r *schema.LinkPreview, exists bool) {
+	v := m.link_preview
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinkPreview returns the old "link_preview" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldLinkPreview(ctx context.Context) (v *schema.LinkPreview, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinkPreview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinkPreview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinkPreview: %w", err)
+	}
+	return oldValue.LinkPreview, nil
+}
+
+// ClearLinkPreview clears the value of the "link_preview" field.
+func (m *MessageMutation) ClearLinkPreview() {
+	m.link_preview = nil
+	m.clearedFields[message.FieldLinkPreview] = struct{}{}
+}
+
+// LinkPreviewCleared returns if the "link_preview" field was cleared in this mutation.
+func (m *MessageMutation) LinkPreviewCleared() bool {
+	_, ok := m.clearedFields[message.FieldLinkPreview]
+	return ok
+}
+
+// ResetLinkPreview resets all changes to the "link_preview" field.
+func (m *MessageMutation) ResetLinkPreview() {
+	m.link_preview = nil
+	delete(m.clearedFields, message.FieldLinkPreview)
+}
+
+// SetClientMessageID sets the "client_message_id" field.
+func (m *MessageMutation) SetClientMessageID(s string) {
+	m.client_message_id = &s
+}
+
+// ClientMessageID returns the value of the "client_message_id" field in the mutation.
+func (m *MessageMutation) ClientMessageID() (r string, exists bool) {
+	v := m.client_message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientMessageID returns the old "client_message_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldClientMessageID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientMessageID: %w", err)
+	}
+	return oldValue.ClientMessageID, nil
+}
+
+// ClearClientMessageID clears the value of the "client_message_id" field.
+func (m *MessageMutation) ClearClientMessageID() {
+	m.client_message_id = nil
+	m.clearedFields[message.FieldClientMessageID] = struct{}{}
+}
+
+// ClientMessageIDCleared returns if the "client_message_id" field was cleared in this mutation.
+func (m *MessageMutation) ClientMessageIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldClientMessageID]
+	return ok
+}
+
+// ResetClientMessageID resets all changes to the "client_message_id" field.
+func (m *MessageMutation) ResetClientMessageID() {
+	m.client_message_id = nil
+	delete(m.clearedFields, message.FieldClientMessageID)
+}
+
+// SetLocation sets the "location" field.
+func (m *MessageMutation) SetLocation(s *schema.Location) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *MessageMutation) Location() (r *schema.Location, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldLocation(ctx context.Context) (v *schema.Location, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *MessageMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[message.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *MessageMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[message.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *MessageMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, message.FieldLocation)
+}
+
+// SetContactUserID sets the "contact_user_id" field.
+func (m *MessageMutation) SetContactUserID(u uuid.UUID) {
+	m.contact_user = &u
+}
+
+// ContactUserID returns the value of the "contact_user_id" field in the mutation.
+func (m *MessageMutation) ContactUserID() (r uuid.UUID, exists bool) {
+	v := m.contact_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContactUserID returns the old "contact_user_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContactUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContactUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContactUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContactUserID: %w", err)
+	}
+	return oldValue.ContactUserID, nil
+}
+
+// ClearContactUserID clears the value of the "contact_user_id" field.
+func (m *MessageMutation) ClearContactUserID() {
+	m.contact_user = nil
+	m.clearedFields[message.FieldContactUserID] = struct{}{}
+}
+
+// ContactUserIDCleared returns if the "contact_user_id" field was cleared in this mutation.
+func (m *MessageMutation) ContactUserIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldContactUserID]
+	return ok
+}
+
+// ResetContactUserID resets all changes to the "contact_user_id" field.
+func (m *MessageMutation) ResetContactUserID() {
+	m.contact_user = nil
+	delete(m.clearedFields, message.FieldContactUserID)
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *MessageMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[message.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *MessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *MessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *MessageMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[message.FieldSenderID] = struct{}{}
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *MessageMutation) SenderCleared() bool {
+	return m.SenderIDCleared() || m.clearedsender
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SenderIDs() (ids []uuid.UUID) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *MessageMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by ids.
+func (m *MessageMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Message entity.
+func (m *MessageMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
+func (m *MessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
+func (m *MessageMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *MessageMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *MessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (m *MessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+	m.clearedFields[message.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
+func (m *MessageMutation) ReplyToCleared() bool {
+	return m.ReplyToIDCleared() || m.clearedreply_to
+}
+
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ReplyToIDs() (ids []uuid.UUID) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *MessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by ids.
+func (m *MessageMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Media entity.
+func (m *MessageMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Media entity was cleared.
+func (m *MessageMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Media entity by IDs.
+func (m *MessageMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Media entity.
+func (m *MessageMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *MessageMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *MessageMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by ids.
+func (m *MessageMutation) AddReactionIDs(ids ...uuid.UUID) {
+	if m.reactions == nil {
+		m.reactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the MessageReaction entity was cleared.
+func (m *MessageMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the MessageReaction entity by IDs.
+func (m *MessageMutation) RemoveReactionIDs(ids ...uuid.UUID) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *MessageMutation) ReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *MessageMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// AddPinIDs adds the "pins" edge to the PinnedMessage entity by ids.
+func (m *MessageMutation) AddPinIDs(ids ...uuid.UUID) {
+	if m.pins == nil {
+		m.pins = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.pins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPins clears the "pins" edge to the PinnedMessage entity.
+func (m *MessageMutation) ClearPins() {
+	m.clearedpins = true
+}
+
+// PinsCleared reports if the "pins" edge to the PinnedMessage entity was cleared.
+func (m *MessageMutation) PinsCleared() bool {
+	return m.clearedpins
+}
+
+// RemovePinIDs removes the "pins" edge to the PinnedMessage entity by IDs.
+func (m *MessageMutation) RemovePinIDs(ids ...uuid.UUID) {
+	if m.removedpins == nil {
+		m.removedpins = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.pins, ids[i])
+		m.removedpins[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPins returns the removed IDs of the "pins" edge to the PinnedMessage entity.
+func (m *MessageMutation) RemovedPinsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PinsIDs returns the "pins" edge IDs in the mutation.
+func (m *MessageMutation) PinsIDs() (ids []uuid.UUID) {
+	for id := range m.pins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPins resets all changes to the "pins" edge.
+func (m *MessageMutation) ResetPins() {
+	m.pins = nil
+	m.clearedpins = false
+	m.removedpins = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by ids.
+func (m *MessageMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the MessageRevision entity was cleared.
+func (m *MessageMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the MessageRevision entity by IDs.
+func (m *MessageMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *MessageMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *MessageMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by ids.
+func (m *MessageMutation) AddMentionIDs(ids ...uuid.UUID) {
+	if m.mentions == nil {
+		m.mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentions clears the "mentions" edge to the MessageMention entity.
+func (m *MessageMutation) ClearMentions() {
+	m.clearedmentions = true
+}
+
+// MentionsCleared reports if the "mentions" edge to the MessageMention entity was cleared.
+func (m *MessageMutation) MentionsCleared() bool {
+	return m.clearedmentions
+}
+
+// RemoveMentionIDs removes the "mentions" edge to the MessageMention entity by IDs.
+func (m *MessageMutation) RemoveMentionIDs(ids ...uuid.UUID) {
+	if m.removedmentions == nil {
+		m.removedmentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.mentions, ids[i])
+		m.removedmentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentions returns the removed IDs of the "mentions" edge to the MessageMention entity.
+func (m *MessageMutation) RemovedMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionsIDs returns the "mentions" edge IDs in the mutation.
+func (m *MessageMutation) MentionsIDs() (ids []uuid.UUID) {
+	for id := range m.mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentions resets all changes to the "mentions" edge.
+func (m *MessageMutation) ResetMentions() {
+	m.mentions = nil
+	m.clearedmentions = false
+	m.removedmentions = nil
+}
+
+// SetPollID sets the "poll" edge to the Poll entity by id.
+func (m *MessageMutation) SetPollID(id uuid.UUID) {
+	m.poll = &id
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *MessageMutation) ClearPoll() {
+	m.clearedpoll = true
+}
+
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *MessageMutation) PollCleared() bool {
+	return m.clearedpoll
+}
+
+// PollID returns the "poll" edge ID in the mutation.
+func (m *MessageMutation) PollID() (id uuid.UUID, exists bool) {
+	if m.poll != nil {
+		return *m.poll, true
+	}
+	return
+}
+
+// PollIDs returns the "poll" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PollID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) PollIDs() (ids []uuid.UUID) {
+	if id := m.poll; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPoll resets all changes to the "poll" edge.
+func (m *MessageMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
+}
+
+// ClearForwardedFromSender clears the "forwarded_from_sender" edge to the User entity.
+func (m *MessageMutation) ClearForwardedFromSender() {
+	m.clearedforwarded_from_sender = true
+	m.clearedFields[message.FieldForwardedFromSenderID] = struct{}{}
+}
+
+// ForwardedFromSenderCleared reports if the "forwarded_from_sender" edge to the User entity was cleared.
+func (m *MessageMutation) ForwardedFromSenderCleared() bool {
+	return m.ForwardedFromSenderIDCleared() || m.clearedforwarded_from_sender
+}
+
+// ForwardedFromSenderIDs returns the "forwarded_from_sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ForwardedFromSenderID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ForwardedFromSenderIDs() (ids []uuid.UUID) {
+	if id := m.forwarded_from_sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetForwardedFromSender resets all changes to the "forwarded_from_sender" edge.
+func (m *MessageMutation) ResetForwardedFromSender() {
+	m.forwarded_from_sender = nil
+	m.clearedforwarded_from_sender = false
+}
+
+// ClearForwardedFromChat clears the "forwarded_from_chat" edge to the Chat entity.
+func (m *MessageMutation) ClearForwardedFromChat() {
+	m.clearedforwarded_from_chat = true
+	m.clearedFields[message.FieldForwardedFromChatID] = struct{}{}
+}
+
+// ForwardedFromChatCleared reports if the "forwarded_from_chat" edge to the Chat entity was cleared.
+func (m *MessageMutation) ForwardedFromChatCleared() bool {
+	return m.ForwardedFromChatIDCleared() || m.clearedforwarded_from_chat
+}
+
+// ForwardedFromChatIDs returns the "forwarded_from_chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ForwardedFromChatID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ForwardedFromChatIDs() (ids []uuid.UUID) {
+	if id := m.forwarded_from_chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetForwardedFromChat resets all changes to the "forwarded_from_chat" edge.
+func (m *MessageMutation) ResetForwardedFromChat() {
+	m.forwarded_from_chat = nil
+	m.clearedforwarded_from_chat = false
+}
+
+// ClearContactUser clears the "contact_user" edge to the User entity.
+func (m *MessageMutation) ClearContactUser() {
+	m.clearedcontact_user = true
+	m.clearedFields[message.FieldContactUserID] = struct{}{}
+}
+
+// ContactUserCleared reports if the "contact_user" edge to the User entity was cleared.
+func (m *MessageMutation) ContactUserCleared() bool {
+	return m.ContactUserIDCleared() || m.clearedcontact_user
+}
+
+// ContactUserIDs returns the "contact_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContactUserID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ContactUserIDs() (ids []uuid.UUID) {
+	if id := m.contact_user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContactUser resets all changes to the "contact_user" edge.
+func (m *MessageMutation) ResetContactUser() {
+	m.contact_user = nil
+	m.clearedcontact_user = false
+}
+
+// AddReportIDs adds the "reports" edge to the Report entity by ids.
+func (m *MessageMutation) AddReportIDs(ids ...uuid.UUID) {
+	if m.reports == nil {
+		m.reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReports clears the "reports" edge to the Report entity.
+func (m *MessageMutation) ClearReports() {
+	m.clearedreports = true
+}
+
+// ReportsCleared reports if the "reports" edge to the Report entity was cleared.
+func (m *MessageMutation) ReportsCleared() bool {
+	return m.clearedreports
+}
+
+// RemoveReportIDs removes the "reports" edge to the Report entity by IDs.
+func (m *MessageMutation) RemoveReportIDs(ids ...uuid.UUID) {
+	if m.removedreports == nil {
+		m.removedreports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reports, ids[i])
+		m.removedreports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReports returns the removed IDs of the "reports" edge to the Report entity.
+func (m *MessageMutation) RemovedReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReportsIDs returns the "reports" edge IDs in the mutation.
+func (m *MessageMutation) ReportsIDs() (ids []uuid.UUID) {
+	for id := range m.reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReports resets all changes to the "reports" edge.
+func (m *MessageMutation) ResetReports() {
+	m.reports = nil
+	m.clearedreports = false
+	m.removedreports = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, message.FieldUpdatedAt)
+	}
+	if m.chat != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.sender != nil {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.reply_to != nil {
+		fields = append(fields, message.FieldReplyToID)
+	}
+	if m._type != nil {
+		fields = append(fields, message.FieldType)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.action_data != nil {
+		fields = append(fields, message.FieldActionData)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.forwarded_from_sender != nil {
+		fields = append(fields, message.FieldForwardedFromSenderID)
+	}
+	if m.forwarded_from_chat != nil {
+		fields = append(fields, message.FieldForwardedFromChatID)
+	}
+	if m.reply_count != nil {
+		fields = append(fields, message.FieldReplyCount)
+	}
+	if m.last_reply_at != nil {
+		fields = append(fields, message.FieldLastReplyAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, message.FieldExpiresAt)
+	}
+	if m.link_preview != nil {
+		fields = append(fields, message.FieldLinkPreview)
+	}
+	if m.client_message_id != nil {
+		fields = append(fields, message.FieldClientMessageID)
+	}
+	if m.location != nil {
+		fields = append(fields, message.FieldLocation)
+	}
+	if m.contact_user != nil {
+		fields = append(fields, message.FieldContactUserID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case message.FieldChatID:
+		return m.ChatID()
+	case message.FieldSenderID:
+		return m.SenderID()
+	case message.FieldReplyToID:
+		return m.ReplyToID()
+	case message.FieldType:
+		return m.GetType()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldActionData:
+		return m.ActionData()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	case message.FieldForwardedFromSenderID:
+		return m.ForwardedFromSenderID()
+	case message.FieldForwardedFromChatID:
+		return m.ForwardedFromChatID()
+	case message.FieldReplyCount:
+		return m.ReplyCount()
+	case message.FieldLastReplyAt:
+		return m.LastReplyAt()
+	case message.FieldExpiresAt:
+		return m.ExpiresAt()
+	case message.FieldLinkPreview:
+		return m.LinkPreview()
+	case message.FieldClientMessageID:
+		return m.ClientMessageID()
+	case message.FieldLocation:
+		return m.Location()
+	case message.FieldContactUserID:
+		return m.ContactUserID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case message.FieldChatID:
+		return m.OldChatID(ctx)
+	case message.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case message.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case message.FieldType:
+		return m.OldType(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldActionData:
+		return m.OldActionData(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case message.FieldForwardedFromSenderID:
+		return m.OldForwardedFromSenderID(ctx)
+	case message.FieldForwardedFromChatID:
+		return m.OldForwardedFromChatID(ctx)
+	case message.FieldReplyCount:
+		return m.OldReplyCount(ctx)
+	case message.FieldLastReplyAt:
+		return m.OldLastReplyAt(ctx)
+	case message.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case message.FieldLinkPreview:
+		return m.OldLinkPreview(ctx)
+	case message.FieldClientMessageID:
+		return m.OldClientMessageID(ctx)
+	case message.FieldLocation:
+		return m.OldLocation(ctx)
+	case message.FieldContactUserID:
+		return m.OldContactUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case message.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case message.FieldSenderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case message.FieldReplyToID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case message.FieldType:
+		v, ok := value.(message.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldActionData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActionData(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case message.FieldForwardedFromSenderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForwardedFromSenderID(v)
+		return nil
+	case message.FieldForwardedFromChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForwardedFromChatID(v)
+		return nil
+	case message.FieldReplyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyCount(v)
+		return nil
+	case message.FieldLastReplyAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReplyAt(v)
+		return nil
+	case message.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case message.FieldLinkPreview:
+		v, ok := value.(*schema.LinkPreview)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinkPreview(v)
+		return nil
+	case message.FieldClientMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientMessageID(v)
+		return nil
+	case message.FieldLocation:
+		v, ok := value.(*schema.Location)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case message.FieldContactUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContactUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addreply_count != nil {
+		fields = append(fields, message.FieldReplyCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldReplyCount:
+		return m.AddedReplyCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldReplyCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReplyCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldSenderID) {
+		fields = append(fields, message.FieldSenderID)
+	}
+	if m.FieldCleared(message.FieldReplyToID) {
+		fields = append(fields, message.FieldReplyToID)
+	}
+	if m.FieldCleared(message.FieldContent) {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.FieldCleared(message.FieldActionData) {
+		fields = append(fields, message.FieldActionData)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.FieldCleared(message.FieldForwardedFromSenderID) {
+		fields = append(fields, message.FieldForwardedFromSenderID)
+	}
+	if m.FieldCleared(message.FieldForwardedFromChatID) {
+		fields = append(fields, message.FieldForwardedFromChatID)
+	}
+	if m.FieldCleared(message.FieldLastReplyAt) {
+		fields = append(fields, message.FieldLastReplyAt)
+	}
+	if m.FieldCleared(message.FieldExpiresAt) {
+		fields = append(fields, message.FieldExpiresAt)
+	}
+	if m.FieldCleared(message.FieldLinkPreview) {
+		fields = append(fields, message.FieldLinkPreview)
+	}
+	if m.FieldCleared(message.FieldClientMessageID) {
+		fields = append(fields, message.FieldClientMessageID)
+	}
+	if m.FieldCleared(message.FieldLocation) {
+		fields = append(fields, message.FieldLocation)
+	}
+	if m.FieldCleared(message.FieldContactUserID) {
+		fields = append(fields, message.FieldContactUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldSenderID:
+		m.ClearSenderID()
+		return nil
+	case message.FieldReplyToID:
+		m.ClearReplyToID()
+		return nil
+	case message.FieldContent:
+		m.ClearContent()
+		return nil
+	case message.FieldActionData:
+		m.ClearActionData()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case message.FieldForwardedFromSenderID:
+		m.ClearForwardedFromSenderID()
+		return nil
+	case message.FieldForwardedFromChatID:
+		m.ClearForwardedFromChatID()
+		return nil
+	case message.FieldLastReplyAt:
+		m.ClearLastReplyAt()
+		return nil
+	case message.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case message.FieldLinkPreview:
+		m.ClearLinkPreview()
+		return nil
+	case message.FieldClientMessageID:
+		m.ClearClientMessageID()
+		return nil
+	case message.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case message.FieldContactUserID:
+		m.ClearContactUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case message.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case message.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case message.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case message.FieldType:
+		m.ResetType()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldActionData:
+		m.ResetActionData()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case message.FieldForwardedFromSenderID:
+		m.ResetForwardedFromSenderID()
+		return nil
+	case message.FieldForwardedFromChatID:
+		m.ResetForwardedFromChatID()
+		return nil
+	case message.FieldReplyCount:
+		m.ResetReplyCount()
+		return nil
+	case message.FieldLastReplyAt:
+		m.ResetLastReplyAt()
+		return nil
+	case message.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case message.FieldLinkPreview:
+		m.ResetLinkPreview()
+		return nil
+	case message.FieldClientMessageID:
+		m.ResetClientMessageID()
+		return nil
+	case message.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case message.FieldContactUserID:
+		m.ResetContactUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.chat != nil {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.sender != nil {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.replies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.attachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.reactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.pins != nil {
+		edges = append(edges, message.EdgePins)
+	}
+	if m.revisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.mentions != nil {
+		edges = append(edges, message.EdgeMentions)
+	}
+	if m.poll != nil {
+		edges = append(edges, message.EdgePoll)
+	}
+	if m.forwarded_from_sender != nil {
+		edges = append(edges, message.EdgeForwardedFromSender)
+	}
+	if m.forwarded_from_chat != nil {
+		edges = append(edges, message.EdgeForwardedFromChat)
+	}
+	if m.contact_user != nil {
+		edges = append(edges, message.EdgeContactUser)
+	}
+	if m.reports != nil {
+		edges = append(edges, message.EdgeReports)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgePins:
+		ids := make([]ent.Value, 0, len(m.pins))
+		for id := range m.pins {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.mentions))
+		for id := range m.mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeForwardedFromSender:
+		if id := m.forwarded_from_sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeForwardedFromChat:
+		if id := m.forwarded_from_chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeContactUser:
+		if id := m.contact_user; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.reports))
+		for id := range m.reports {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.removedreplies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.removedpins != nil {
+		edges = append(edges, message.EdgePins)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.removedmentions != nil {
+		edges = append(edges, message.EdgeMentions)
+	}
+	if m.removedreports != nil {
+		edges = append(edges, message.EdgeReports)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgePins:
+		ids := make([]ent.Value, 0, len(m.removedpins))
+		for id := range m.removedpins {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedmentions))
+		for id := range m.removedmentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReports:
+		ids := make([]ent.Value, 0, len(m.removedreports))
+		for id := range m.removedreports {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 14)
+	if m.clearedchat {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.clearedsender {
+		edges = append(edges, message.EdgeSender)
+	}
+	if m.clearedreplies {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, message.EdgeReplyTo)
+	}
+	if m.clearedattachments {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.clearedreactions {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.clearedpins {
+		edges = append(edges, message.EdgePins)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.clearedmentions {
+		edges = append(edges, message.EdgeMentions)
+	}
+	if m.clearedpoll {
+		edges = append(edges, message.EdgePoll)
+	}
+	if m.clearedforwarded_from_sender {
+		edges = append(edges, message.EdgeForwardedFromSender)
+	}
+	if m.clearedforwarded_from_chat {
+		edges = append(edges, message.EdgeForwardedFromChat)
+	}
+	if m.clearedcontact_user {
+		edges = append(edges, message.EdgeContactUser)
+	}
+	if m.clearedreports {
+		edges = append(edges, message.EdgeReports)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeChat:
+		return m.clearedchat
+	case message.EdgeSender:
+		return m.clearedsender
+	case message.EdgeReplies:
+		return m.clearedreplies
+	case message.EdgeReplyTo:
+		return m.clearedreply_to
+	case message.EdgeAttachments:
+		return m.clearedattachments
+	case message.EdgeReactions:
+		return m.clearedreactions
+	case message.EdgePins:
+		return m.clearedpins
+	case message.EdgeRevisions:
+		return m.clearedrevisions
+	case message.EdgeMentions:
+		return m.clearedmentions
+	case message.EdgePoll:
+		return m.clearedpoll
+	case message.EdgeForwardedFromSender:
+		return m.clearedforwarded_from_sender
+	case message.EdgeForwardedFromChat:
+		return m.clearedforwarded_from_chat
+	case message.EdgeContactUser:
+		return m.clearedcontact_user
+	case message.EdgeReports:
+		return m.clearedreports
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ClearChat()
+		return nil
+	case message.EdgeSender:
+		m.ClearSender()
+		return nil
+	case message.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	case message.EdgePoll:
+		m.ClearPoll()
+		return nil
+	case message.EdgeForwardedFromSender:
+		m.ClearForwardedFromSender()
+		return nil
+	case message.EdgeForwardedFromChat:
+		m.ClearForwardedFromChat()
+		return nil
+	case message.EdgeContactUser:
+		m.ClearContactUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ResetChat()
+		return nil
+	case message.EdgeSender:
+		m.ResetSender()
+		return nil
+	case message.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	case message.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case message.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case message.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case message.EdgePins:
+		m.ResetPins()
+		return nil
+	case message.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case message.EdgeMentions:
+		m.ResetMentions()
+		return nil
+	case message.EdgePoll:
+		m.ResetPoll()
+		return nil
+	case message.EdgeForwardedFromSender:
+		m.ResetForwardedFromSender()
+		return nil
+	case message.EdgeForwardedFromChat:
+		m.ResetForwardedFromChat()
+		return nil
+	case message.EdgeContactUser:
+		m.ResetContactUser()
+		return nil
+	case message.EdgeReports:
+		m.ResetReports()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageMentionMutation represents an operation that mutates the MessageMention nodes in the graph.
+type MessageMentionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	updated_at     *time.Time
+	_type          *messagemention.Type
+	_offset        *int
+	add_offset     *int
+	length         *int
+	addlength      *int
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*MessageMention, error)
+	predicates     []predicate.MessageMention
+}
+
+var _ ent.Mutation = (*MessageMentionMutation)(nil)
+
+// messagementionOption allows management of the mutation configuration using functional options.
+type messagementionOption func(*MessageMentionMutation)
+
+// newMessageMentionMutation creates new mutation for the MessageMention entity.
+func newMessageMentionMutation(c config, op Op, opts ...messagementionOption) *MessageMentionMutation {
+	m := &MessageMentionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageMention,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageMentionID sets the ID field of the mutation.
+func withMessageMentionID(id uuid.UUID) messagementionOption {
+	return func(m *MessageMentionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageMention
+		)
+		m.oldValue = func(ctx context.Context) (*MessageMention, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageMention.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageMention sets the old MessageMention of the mutation.
+func withMessageMention(node *MessageMention) messagementionOption {
+	return func(m *MessageMentionMutation) {
+		m.oldValue = func(context.Context) (*MessageMention, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMentionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMentionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageMention entities.
+func (m *MessageMentionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMentionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageMention.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMentionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMentionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMentionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageMentionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageMentionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageMentionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageMentionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageMentionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageMentionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MessageMentionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageMentionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *MessageMentionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[messagemention.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *MessageMentionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[messagemention.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageMentionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, messagemention.FieldUserID)
+}
+
+// SetType sets the "type" field.
+func (m *MessageMentionMutation) SetType(value messagemention.Type) {
+	m._type = &value
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MessageMentionMutation) GetType() (r messagemention.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldType(ctx context.Context) (v messagemention.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MessageMentionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetOffset sets the "offset" field.
+func (m *MessageMentionMutation) SetOffset(i int) {
+	m._offset = &i
+	m.add_offset = nil
+}
+
+// Offset returns the value of the "offset" field in the mutation.
+func (m *MessageMentionMutation) Offset() (r int, exists bool) {
+	v := m._offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffset returns the old "offset" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldOffset(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
+	}
+	return oldValue.Offset, nil
+}
+
+// AddOffset adds i to the "offset" field.
+func (m *MessageMentionMutation) AddOffset(i int) {
+	if m.add_offset != nil {
+		*m.add_offset += i
+	} else {
+		m.add_offset = &i
+	}
+}
+
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *MessageMentionMutation) AddedOffset() (r int, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOffset resets all changes to the "offset" field.
+func (m *MessageMentionMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
+}
+
+// SetLength sets the "length" field.
+func (m *MessageMentionMutation) SetLength(i int) {
+	m.length = &i
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *MessageMentionMutation) Length() (r int, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the MessageMention entity.
+// If the MessageMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMentionMutation) OldLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds i to the "length" field.
+func (m *MessageMentionMutation) AddLength(i int) {
+	if m.addlength != nil {
+		*m.addlength += i
+	} else {
+		m.addlength = &i
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *MessageMentionMutation) AddedLength() (r int, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *MessageMentionMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageMentionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagemention.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageMentionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageMentionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageMentionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageMentionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[messagemention.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageMentionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageMentionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageMentionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MessageMentionMutation builder.
+func (m *MessageMentionMutation) Where(ps ...predicate.MessageMention) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMentionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMentionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageMention, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMentionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMentionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageMention).
+func (m *MessageMentionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMentionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, messagemention.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, messagemention.FieldUpdatedAt)
+	}
+	if m.message != nil {
+		fields = append(fields, messagemention.FieldMessageID)
+	}
+	if m.user != nil {
+		fields = append(fields, messagemention.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, messagemention.FieldType)
+	}
+	if m._offset != nil {
+		fields = append(fields, messagemention.FieldOffset)
+	}
+	if m.length != nil {
+		fields = append(fields, messagemention.FieldLength)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMentionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagemention.FieldCreatedAt:
+		return m.CreatedAt()
+	case messagemention.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case messagemention.FieldMessageID:
+		return m.MessageID()
+	case messagemention.FieldUserID:
+		return m.UserID()
+	case messagemention.FieldType:
+		return m.GetType()
+	case messagemention.FieldOffset:
+		return m.Offset()
+	case messagemention.FieldLength:
+		return m.Length()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagemention.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case messagemention.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case messagemention.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagemention.FieldUserID:
+		return m.OldUserID(ctx)
+	case messagemention.FieldType:
+		return m.OldType(ctx)
+	case messagemention.FieldOffset:
+		return m.OldOffset(ctx)
+	case messagemention.FieldLength:
+		return m.OldLength(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageMention field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMentionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagemention.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case messagemention.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case messagemention.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagemention.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case messagemention.FieldType:
+		v, ok := value.(messagemention.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case messagemention.FieldOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffset(v)
+		return nil
+	case messagemention.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMentionMutation) AddedFields() []string {
+	var fields []string
+	if m.add_offset != nil {
+		fields = append(fields, messagemention.FieldOffset)
+	}
+	if m.addlength != nil {
+		fields = append(fields, messagemention.FieldLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMentionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagemention.FieldOffset:
+		return m.AddedOffset()
+	case messagemention.FieldLength:
+		return m.AddedLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMentionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagemention.FieldOffset:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOffset(v)
+		return nil
+	case messagemention.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMentionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagemention.FieldUserID) {
+		fields = append(fields, messagemention.FieldUserID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMentionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMentionMutation) ClearField(name string) error {
+	switch name {
+	case messagemention.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMentionMutation) ResetField(name string) error {
+	switch name {
+	case messagemention.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case messagemention.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case messagemention.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagemention.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case messagemention.FieldType:
+		m.ResetType()
+		return nil
+	case messagemention.FieldOffset:
+		m.ResetOffset()
+		return nil
+	case messagemention.FieldLength:
+		m.ResetLength()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMentionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagemention.EdgeMessage)
+	}
+	if m.user != nil {
+		edges = append(edges, messagemention.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMentionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagemention.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagemention.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMentionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMentionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMentionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagemention.EdgeMessage)
+	}
+	if m.cleareduser {
+		edges = append(edges, messagemention.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMentionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagemention.EdgeMessage:
+		return m.clearedmessage
+	case messagemention.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMentionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagemention.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagemention.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMentionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagemention.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagemention.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageMention edge %s", name)
+}
+
+// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
+type MessageReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	updated_at     *time.Time
+	emoji          *string
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*MessageReaction, error)
+	predicates     []predicate.MessageReaction
+}
+
+var _ ent.Mutation = (*MessageReactionMutation)(nil)
+
+// messagereactionOption allows management of the mutation configuration using functional options.
+type messagereactionOption func(*MessageReactionMutation)
+
+// newMessageReactionMutation creates new mutation for the MessageReaction entity.
+func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
+	m := &MessageReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReactionID sets the ID field of the mutation.
+func withMessageReactionID(id uuid.UUID) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageReaction
+		)
+		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageReaction sets the old MessageReaction of the mutation.
+func withMessageReaction(node *MessageReaction) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		m.oldValue = func(context.Context) (*MessageReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageReaction entities.
+func (m *MessageReactionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReactionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageReactionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageReactionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageReactionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageReactionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageReactionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageReactionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MessageReactionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MessageReactionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MessageReactionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *MessageReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *MessageReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *MessageReactionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageReactionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagereaction.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageReactionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageReactionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageReactionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[messagereaction.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageReactionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageReactionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MessageReactionMutation builder.
+func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageReaction).
+func (m *MessageReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageReactionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, messagereaction.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, messagereaction.FieldUpdatedAt)
+	}
+	if m.message != nil {
+		fields = append(fields, messagereaction.FieldMessageID)
+	}
+	if m.user != nil {
+		fields = append(fields, messagereaction.FieldUserID)
+	}
+	if m.emoji != nil {
+		fields = append(fields, messagereaction.FieldEmoji)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagereaction.FieldCreatedAt:
+		return m.CreatedAt()
+	case messagereaction.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case messagereaction.FieldMessageID:
+		return m.MessageID()
+	case messagereaction.FieldUserID:
+		return m.UserID()
+	case messagereaction.FieldEmoji:
+		return m.Emoji()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagereaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case messagereaction.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case messagereaction.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagereaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case messagereaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagereaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case messagereaction.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case messagereaction.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagereaction.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case messagereaction.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageReactionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ResetField(name string) error {
+	switch name {
+	case messagereaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case messagereaction.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case messagereaction.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagereaction.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case messagereaction.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagereaction.EdgeMessage)
+	}
+	if m.user != nil {
+		edges = append(edges, messagereaction.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagereaction.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagereaction.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagereaction.EdgeMessage)
+	}
+	if m.cleareduser {
+		edges = append(edges, messagereaction.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageReactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagereaction.EdgeMessage:
+		return m.clearedmessage
+	case messagereaction.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageReactionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagereaction.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagereaction.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction edge %s", name)
+}
+
+// MessageRevisionMutation represents an operation that mutates the MessageRevision nodes in the graph.
+type MessageRevisionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	content            *string
+	clearedFields      map[string]struct{}
+	message            *uuid.UUID
+	clearedmessage     bool
+	attachments        map[uuid.UUID]struct{}
+	removedattachments map[uuid.UUID]struct{}
+	clearedattachments bool
+	done               bool
+	oldValue           func(context.Context) (*MessageRevision, error)
+	predicates         []predicate.MessageRevision
+}
+
+var _ ent.Mutation = (*MessageRevisionMutation)(nil)
+
+// messagerevisionOption allows management of the mutation configuration using functional options.
+type messagerevisionOption func(*MessageRevisionMutation)
+
+// newMessageRevisionMutation creates new mutation for the MessageRevision entity.
+func newMessageRevisionMutation(c config, op Op, opts ...messagerevisionOption) *MessageRevisionMutation {
+	m := &MessageRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageRevisionID sets the ID field of the mutation.
+func withMessageRevisionID(id uuid.UUID) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageRevision
+		)
+		m.oldValue = func(ctx context.Context) (*MessageRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageRevision sets the old MessageRevision of the mutation.
+func withMessageRevision(node *MessageRevision) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		m.oldValue = func(context.Context) (*MessageRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageRevision entities.
+func (m *MessageRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MessageRevisionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MessageRevisionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MessageRevisionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *MessageRevisionMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *MessageRevisionMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *MessageRevisionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldContent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *MessageRevisionMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[messagerevision.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *MessageRevisionMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[messagerevision.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageRevisionMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, messagerevision.FieldContent)
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageRevisionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[messagerevision.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageRevisionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageRevisionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by ids.
+func (m *MessageRevisionMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Media entity.
+func (m *MessageRevisionMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Media entity was cleared.
+func (m *MessageRevisionMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Media entity by IDs.
+func (m *MessageRevisionMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Media entity.
+func (m *MessageRevisionMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *MessageRevisionMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *MessageRevisionMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// Where appends a list predicates to the MessageRevisionMutation builder.
+func (m *MessageRevisionMutation) Where(ps ...predicate.MessageRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageRevision).
+func (m *MessageRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, messagerevision.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, messagerevision.FieldUpdatedAt)
+	}
+	if m.message != nil {
+		fields = append(fields, messagerevision.FieldMessageID)
+	}
+	if m.content != nil {
+		fields = append(fields, messagerevision.FieldContent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagerevision.FieldCreatedAt:
+		return m.CreatedAt()
+	case messagerevision.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case messagerevision.FieldMessageID:
+		return m.MessageID()
+	case messagerevision.FieldContent:
+		return m.Content()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagerevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case messagerevision.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case messagerevision.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case messagerevision.FieldContent:
+		return m.OldContent(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagerevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case messagerevision.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case messagerevision.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case messagerevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagerevision.FieldContent) {
+		fields = append(fields, messagerevision.FieldContent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ClearField(name string) error {
+	switch name {
+	case messagerevision.FieldContent:
+		m.ClearContent()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ResetField(name string) error {
+	switch name {
+	case messagerevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case messagerevision.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case messagerevision.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case messagerevision.FieldContent:
+		m.ResetContent()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	if m.attachments != nil {
+		edges = append(edges, messagerevision.EdgeAttachments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagerevision.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagerevision.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedattachments != nil {
+		edges = append(edges, messagerevision.EdgeAttachments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageRevisionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case messagerevision.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	if m.clearedattachments {
+		edges = append(edges, messagerevision.EdgeAttachments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagerevision.EdgeMessage:
+		return m.clearedmessage
+	case messagerevision.EdgeAttachments:
+		return m.clearedattachments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagerevision.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision edge %s", name)
+}
+
+// PinnedChatMutation represents an operation that mutates the PinnedChat nodes in the graph.
+type PinnedChatMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	position      *int
+	addposition   *int
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	chat          *uuid.UUID
+	clearedchat   bool
+	done          bool
+	oldValue      func(context.Context) (*PinnedChat, error)
+	predicates    []predicate.PinnedChat
+}
+
+var _ ent.Mutation = (*PinnedChatMutation)(nil)
+
+// pinnedchatOption allows management of the mutation configuration using functional options.
+type pinnedchatOption func(*PinnedChatMutation)
+
+// newPinnedChatMutation creates new mutation for the PinnedChat entity.
+func newPinnedChatMutation(c config, op Op, opts ...pinnedchatOption) *PinnedChatMutation {
+	m := &PinnedChatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePinnedChat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPinnedChatID sets the ID field of the mutation.
+func withPinnedChatID(id uuid.UUID) pinnedchatOption {
+	return func(m *PinnedChatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PinnedChat
+		)
+		m.oldValue = func(ctx context.Context) (*PinnedChat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PinnedChat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPinnedChat sets the old PinnedChat of the mutation.
+func withPinnedChat(node *PinnedChat) pinnedchatOption {
+	return func(m *PinnedChatMutation) {
+		m.oldValue = func(context.Context) (*PinnedChat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PinnedChatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PinnedChatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PinnedChat entities.
+func (m *PinnedChatMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PinnedChatMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PinnedChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PinnedChat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PinnedChatMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PinnedChatMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PinnedChat entity.
+// If the PinnedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PinnedChatMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PinnedChatMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PinnedChatMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PinnedChat entity.
+// If the PinnedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedChatMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PinnedChatMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PinnedChatMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PinnedChatMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PinnedChat entity.
+// If the PinnedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedChatMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PinnedChatMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *PinnedChatMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *PinnedChatMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the PinnedChat entity.
+// If the PinnedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedChatMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *PinnedChatMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *PinnedChatMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PinnedChatMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PinnedChat entity.
+// If the PinnedChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedChatMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PinnedChatMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PinnedChatMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PinnedChatMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PinnedChatMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[pinnedchat.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PinnedChatMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PinnedChatMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PinnedChatMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *PinnedChatMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[pinnedchat.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *PinnedChatMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *PinnedChatMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *PinnedChatMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// Where appends a list predicates to the PinnedChatMutation builder.
+func (m *PinnedChatMutation) Where(ps ...predicate.PinnedChat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PinnedChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PinnedChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PinnedChat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PinnedChatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PinnedChatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PinnedChat).
+func (m *PinnedChatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PinnedChatMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, pinnedchat.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pinnedchat.FieldUpdatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, pinnedchat.FieldUserID)
+	}
+	if m.chat != nil {
+		fields = append(fields, pinnedchat.FieldChatID)
+	}
+	if m.position != nil {
+		fields = append(fields, pinnedchat.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PinnedChatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pinnedchat.FieldCreatedAt:
+		return m.CreatedAt()
+	case pinnedchat.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pinnedchat.FieldUserID:
+		return m.UserID()
+	case pinnedchat.FieldChatID:
+		return m.ChatID()
+	case pinnedchat.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PinnedChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pinnedchat.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pinnedchat.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pinnedchat.FieldUserID:
+		return m.OldUserID(ctx)
+	case pinnedchat.FieldChatID:
+		return m.OldChatID(ctx)
+	case pinnedchat.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown PinnedChat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedChatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pinnedchat.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pinnedchat.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pinnedchat.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pinnedchat.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case pinnedchat.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedChat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PinnedChatMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, pinnedchat.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PinnedChatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pinnedchat.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedChatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pinnedchat.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedChat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PinnedChatMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PinnedChatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PinnedChatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PinnedChat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PinnedChatMutation) ResetField(name string) error {
+	switch name {
+	case pinnedchat.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pinnedchat.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pinnedchat.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pinnedchat.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case pinnedchat.FieldPosition:
+		m.ResetPosition()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedChat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PinnedChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, pinnedchat.EdgeUser)
+	}
+	if m.chat != nil {
+		edges = append(edges, pinnedchat.EdgeChat)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PinnedChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pinnedchat.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case pinnedchat.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PinnedChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PinnedChatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PinnedChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, pinnedchat.EdgeUser)
+	}
+	if m.clearedchat {
+		edges = append(edges, pinnedchat.EdgeChat)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PinnedChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pinnedchat.EdgeUser:
+		return m.cleareduser
+	case pinnedchat.EdgeChat:
+		return m.clearedchat
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PinnedChatMutation) ClearEdge(name string) error {
+	switch name {
+	case pinnedchat.EdgeUser:
+		m.ClearUser()
+		return nil
+	case pinnedchat.EdgeChat:
+		m.ClearChat()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedChat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PinnedChatMutation) ResetEdge(name string) error {
+	switch name {
+	case pinnedchat.EdgeUser:
+		m.ResetUser()
+		return nil
+	case pinnedchat.EdgeChat:
+		m.ResetChat()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedChat edge %s", name)
+}
+
+// PinnedMessageMutation represents an operation that mutates the PinnedMessage nodes in the graph.
+type PinnedMessageMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	chat             *uuid.UUID
+	clearedchat      bool
+	message          *uuid.UUID
+	clearedmessage   bool
+	pinned_by        *uuid.UUID
+	clearedpinned_by bool
+	done             bool
+	oldValue         func(context.Context) (*PinnedMessage, error)
+	predicates       []predicate.PinnedMessage
+}
+
+var _ ent.Mutation = (*PinnedMessageMutation)(nil)
+
+// pinnedmessageOption allows management of the mutation configuration using functional options.
+type pinnedmessageOption func(*PinnedMessageMutation)
+
+// newPinnedMessageMutation creates new mutation for the PinnedMessage entity.
+func newPinnedMessageMutation(c config, op Op, opts ...pinnedmessageOption) *PinnedMessageMutation {
+	m := &PinnedMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePinnedMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPinnedMessageID sets the ID field of the mutation.
+func withPinnedMessageID(id uuid.UUID) pinnedmessageOption {
+	return func(m *PinnedMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PinnedMessage
+		)
+		m.oldValue = func(ctx context.Context) (*PinnedMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PinnedMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPinnedMessage sets the old PinnedMessage of the mutation.
+func withPinnedMessage(node *PinnedMessage) pinnedmessageOption {
+	return func(m *PinnedMessageMutation) {
+		m.oldValue = func(context.Context) (*PinnedMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PinnedMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PinnedMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PinnedMessage entities.
+func (m *PinnedMessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PinnedMessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PinnedMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PinnedMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PinnedMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PinnedMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PinnedMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PinnedMessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PinnedMessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PinnedMessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *PinnedMessageMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *PinnedMessageMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *PinnedMessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *PinnedMessageMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *PinnedMessageMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *PinnedMessageMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (m *PinnedMessageMutation) SetPinnedByID(u uuid.UUID) {
+	m.pinned_by = &u
+}
+
+// PinnedByID returns the value of the "pinned_by_id" field in the mutation.
+func (m *PinnedMessageMutation) PinnedByID() (r uuid.UUID, exists bool) {
+	v := m.pinned_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinnedByID returns the old "pinned_by_id" field's value of the PinnedMessage entity.
+// If the PinnedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PinnedMessageMutation) OldPinnedByID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinnedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinnedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinnedByID: %w", err)
+	}
+	return oldValue.PinnedByID, nil
+}
+
+// ClearPinnedByID clears the value of the "pinned_by_id" field.
+func (m *PinnedMessageMutation) ClearPinnedByID() {
+	m.pinned_by = nil
+	m.clearedFields[pinnedmessage.FieldPinnedByID] = struct{}{}
+}
+
+// PinnedByIDCleared returns if the "pinned_by_id" field was cleared in this mutation.
+func (m *PinnedMessageMutation) PinnedByIDCleared() bool {
+	_, ok := m.clearedFields[pinnedmessage.FieldPinnedByID]
+	return ok
+}
+
+// ResetPinnedByID resets all changes to the "pinned_by_id" field.
+func (m *PinnedMessageMutation) ResetPinnedByID() {
+	m.pinned_by = nil
+	delete(m.clearedFields, pinnedmessage.FieldPinnedByID)
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *PinnedMessageMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[pinnedmessage.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *PinnedMessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *PinnedMessageMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *PinnedMessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *PinnedMessageMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[pinnedmessage.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *PinnedMessageMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *PinnedMessageMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *PinnedMessageMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the User entity.
+func (m *PinnedMessageMutation) ClearPinnedBy() {
+	m.clearedpinned_by = true
+	m.clearedFields[pinnedmessage.FieldPinnedByID] = struct{}{}
+}
+
+// PinnedByCleared reports if the "pinned_by" edge to the User entity was cleared.
+func (m *PinnedMessageMutation) PinnedByCleared() bool {
+	return m.PinnedByIDCleared() || m.clearedpinned_by
+}
+
+// PinnedByIDs returns the "pinned_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PinnedByID instead. It exists only for internal usage by the builders.
+func (m *PinnedMessageMutation) PinnedByIDs() (ids []uuid.UUID) {
+	if id := m.pinned_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPinnedBy resets all changes to the "pinned_by" edge.
+func (m *PinnedMessageMutation) ResetPinnedBy() {
+	m.pinned_by = nil
+	m.clearedpinned_by = false
+}
+
+// Where appends a list predicates to the PinnedMessageMutation builder.
+func (m *PinnedMessageMutation) Where(ps ...predicate.PinnedMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PinnedMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PinnedMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PinnedMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PinnedMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PinnedMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PinnedMessage).
+func (m *PinnedMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PinnedMessageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, pinnedmessage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pinnedmessage.FieldUpdatedAt)
+	}
+	if m.chat != nil {
+		fields = append(fields, pinnedmessage.FieldChatID)
+	}
+	if m.message != nil {
+		fields = append(fields, pinnedmessage.FieldMessageID)
+	}
+	if m.pinned_by != nil {
+		fields = append(fields, pinnedmessage.FieldPinnedByID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PinnedMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pinnedmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	case pinnedmessage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pinnedmessage.FieldChatID:
+		return m.ChatID()
+	case pinnedmessage.FieldMessageID:
+		return m.MessageID()
+	case pinnedmessage.FieldPinnedByID:
+		return m.PinnedByID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PinnedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pinnedmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pinnedmessage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pinnedmessage.FieldChatID:
+		return m.OldChatID(ctx)
+	case pinnedmessage.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case pinnedmessage.FieldPinnedByID:
+		return m.OldPinnedByID(ctx)
+	}
+	return nil, fmt.Errorf("unknown PinnedMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pinnedmessage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pinnedmessage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pinnedmessage.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case pinnedmessage.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case pinnedmessage.FieldPinnedByID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedByID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PinnedMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PinnedMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PinnedMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PinnedMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PinnedMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pinnedmessage.FieldPinnedByID) {
+		fields = append(fields, pinnedmessage.FieldPinnedByID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PinnedMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PinnedMessageMutation) ClearField(name string) error {
+	switch name {
+	case pinnedmessage.FieldPinnedByID:
+		m.ClearPinnedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PinnedMessageMutation) ResetField(name string) error {
+	switch name {
+	case pinnedmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pinnedmessage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pinnedmessage.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case pinnedmessage.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case pinnedmessage.FieldPinnedByID:
+		m.ResetPinnedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PinnedMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.chat != nil {
+		edges = append(edges, pinnedmessage.EdgeChat)
+	}
+	if m.message != nil {
+		edges = append(edges, pinnedmessage.EdgeMessage)
+	}
+	if m.pinned_by != nil {
+		edges = append(edges, pinnedmessage.EdgePinnedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PinnedMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pinnedmessage.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case pinnedmessage.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case pinnedmessage.EdgePinnedBy:
+		if id := m.pinned_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PinnedMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PinnedMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PinnedMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedchat {
+		edges = append(edges, pinnedmessage.EdgeChat)
+	}
+	if m.clearedmessage {
+		edges = append(edges, pinnedmessage.EdgeMessage)
+	}
+	if m.clearedpinned_by {
+		edges = append(edges, pinnedmessage.EdgePinnedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PinnedMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pinnedmessage.EdgeChat:
+		return m.clearedchat
+	case pinnedmessage.EdgeMessage:
+		return m.clearedmessage
+	case pinnedmessage.EdgePinnedBy:
+		return m.clearedpinned_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PinnedMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case pinnedmessage.EdgeChat:
+		m.ClearChat()
+		return nil
+	case pinnedmessage.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case pinnedmessage.EdgePinnedBy:
+		m.ClearPinnedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PinnedMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case pinnedmessage.EdgeChat:
+		m.ResetChat()
+		return nil
+	case pinnedmessage.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case pinnedmessage.EdgePinnedBy:
+		m.ResetPinnedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown PinnedMessage edge %s", name)
+}
+
+// PollMutation represents an operation that mutates the Poll nodes in the graph.
+type PollMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	question        *string
+	multiple_choice *bool
+	anonymous       *bool
+	quiz            *bool
+	closes_at       *time.Time
+	closed_at       *time.Time
+	clearedFields   map[string]struct{}
+	message         *uuid.UUID
+	clearedmessage  bool
+	options         map[uuid.UUID]struct{}
+	removedoptions  map[uuid.UUID]struct{}
+	clearedoptions  bool
+	votes           map[uuid.UUID]struct{}
+	removedvotes    map[uuid.UUID]struct{}
+	clearedvotes    bool
+	done            bool
+	oldValue        func(context.Context) (*Poll, error)
+	predicates      []predicate.Poll
+}
+
+var _ ent.Mutation = (*PollMutation)(nil)
+
+// pollOption allows management of the mutation configuration using functional options.
+type pollOption func(*PollMutation)
+
+// newPollMutation creates new mutation for the Poll entity.
+func newPollMutation(c config, op Op, opts ...pollOption) *PollMutation {
+	m := &PollMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePoll,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPollID sets the ID field of the mutation.
+func withPollID(id uuid.UUID) pollOption {
+	return func(m *PollMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Poll
+		)
+		m.oldValue = func(ctx context.Context) (*Poll, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Poll.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPoll sets the old Poll of the mutation.
+func withPoll(node *Poll) pollOption {
+	return func(m *PollMutation) {
+		m.oldValue = func(context.Context) (*Poll, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PollMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PollMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Poll entities.
+func (m *PollMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PollMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PollMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Poll.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PollMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PollMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PollMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PollMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PollMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PollMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *PollMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *PollMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldMessageID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *PollMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetQuestion sets the "question" field.
+func (m *PollMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *PollMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *PollMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (m *PollMutation) SetMultipleChoice(b bool) {
+	m.multiple_choice = &b
+}
+
+// MultipleChoice returns the value of the "multiple_choice" field in the mutation.
+func (m *PollMutation) MultipleChoice() (r bool, exists bool) {
+	v := m.multiple_choice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultipleChoice returns the old "multiple_choice" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldMultipleChoice(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultipleChoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultipleChoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultipleChoice: %w", err)
+	}
+	return oldValue.MultipleChoice, nil
+}
+
+// ResetMultipleChoice resets all changes to the "multiple_choice" field.
+func (m *PollMutation) ResetMultipleChoice() {
+	m.multiple_choice = nil
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (m *PollMutation) SetAnonymous(b bool) {
+	m.anonymous = &b
+}
+
+// Anonymous returns the value of the "anonymous" field in the mutation.
+func (m *PollMutation) Anonymous() (r bool, exists bool) {
+	v := m.anonymous
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnonymous returns the old "anonymous" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldAnonymous(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnonymous is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnonymous requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnonymous: %w", err)
+	}
+	return oldValue.Anonymous, nil
+}
+
+// ResetAnonymous resets all changes to the "anonymous" field.
+func (m *PollMutation) ResetAnonymous() {
+	m.anonymous = nil
+}
+
+// SetQuiz sets the "quiz" field.
+func (m *PollMutation) SetQuiz(b bool) {
+	m.quiz = &b
+}
+
+// Quiz returns the value of the "quiz" field in the mutation.
+func (m *PollMutation) Quiz() (r bool, exists bool) {
+	v := m.quiz
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuiz returns the old "quiz" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldQuiz(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuiz is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuiz requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuiz: %w", err)
+	}
+	return oldValue.Quiz, nil
+}
+
+// ResetQuiz resets all changes to the "quiz" field.
+func (m *PollMutation) ResetQuiz() {
+	m.quiz = nil
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (m *PollMutation) SetClosesAt(t time.Time) {
+	m.closes_at = &t
+}
+
+// ClosesAt returns the value of the "closes_at" field in the mutation.
+func (m *PollMutation) ClosesAt() (r time.Time, exists bool) {
+	v := m.closes_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosesAt returns the old "closes_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldClosesAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosesAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosesAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosesAt: %w", err)
+	}
+	return oldValue.ClosesAt, nil
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (m *PollMutation) ClearClosesAt() {
+	m.closes_at = nil
+	m.clearedFields[poll.FieldClosesAt] = struct{}{}
+}
+
+// ClosesAtCleared returns if the "closes_at" field was cleared in this mutation.
+func (m *PollMutation) ClosesAtCleared() bool {
+	_, ok := m.clearedFields[poll.FieldClosesAt]
+	return ok
+}
+
+// ResetClosesAt resets all changes to the "closes_at" field.
+func (m *PollMutation) ResetClosesAt() {
+	m.closes_at = nil
+	delete(m.clearedFields, poll.FieldClosesAt)
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (m *PollMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *PollMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *PollMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[poll.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *PollMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[poll.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *PollMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, poll.FieldClosedAt)
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *PollMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[poll.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *PollMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *PollMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *PollMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by ids.
+func (m *PollMutation) AddOptionIDs(ids ...uuid.UUID) {
+	if m.options == nil {
+		m.options = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.options[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOptions clears the "options" edge to the PollOption entity.
+func (m *PollMutation) ClearOptions() {
+	m.clearedoptions = true
+}
+
+// OptionsCleared reports if the "options" edge to the PollOption entity was cleared.
+func (m *PollMutation) OptionsCleared() bool {
+	return m.clearedoptions
+}
+
+// RemoveOptionIDs removes the "options" edge to the PollOption entity by IDs.
+func (m *PollMutation) RemoveOptionIDs(ids ...uuid.UUID) {
+	if m.removedoptions == nil {
+		m.removedoptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.options, ids[i])
+		m.removedoptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOptions returns the removed IDs of the "options" edge to the PollOption entity.
+func (m *PollMutation) RemovedOptionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedoptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OptionsIDs returns the "options" edge IDs in the mutation.
+func (m *PollMutation) OptionsIDs() (ids []uuid.UUID) {
+	for id := range m.options {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOptions resets all changes to the "options" edge.
+func (m *PollMutation) ResetOptions() {
+	m.options = nil
+	m.clearedoptions = false
+	m.removedoptions = nil
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by ids.
+func (m *PollMutation) AddVoteIDs(ids ...uuid.UUID) {
+	if m.votes == nil {
+		m.votes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVotes clears the "votes" edge to the PollVote entity.
+func (m *PollMutation) ClearVotes() {
+	m.clearedvotes = true
+}
+
+// VotesCleared reports if the "votes" edge to the PollVote entity was cleared.
+func (m *PollMutation) VotesCleared() bool {
+	return m.clearedvotes
+}
+
+// RemoveVoteIDs removes the "votes" edge to the PollVote entity by IDs.
+func (m *PollMutation) RemoveVoteIDs(ids ...uuid.UUID) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVotes returns the removed IDs of the "votes" edge to the PollVote entity.
+func (m *PollMutation) RemovedVotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *PollMutation) VotesIDs() (ids []uuid.UUID) {
+	for id := range m.votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVotes resets all changes to the "votes" edge.
+func (m *PollMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
+}
+
+// Where appends a list predicates to the PollMutation builder.
+func (m *PollMutation) Where(ps ...predicate.Poll) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PollMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PollMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Poll, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PollMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PollMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Poll).
+func (m *PollMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PollMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, poll.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, poll.FieldUpdatedAt)
+	}
+	if m.message != nil {
+		fields = append(fields, poll.FieldMessageID)
+	}
+	if m.question != nil {
+		fields = append(fields, poll.FieldQuestion)
+	}
+	if m.multiple_choice != nil {
+		fields = append(fields, poll.FieldMultipleChoice)
+	}
+	if m.anonymous != nil {
+		fields = append(fields, poll.FieldAnonymous)
+	}
+	if m.quiz != nil {
+		fields = append(fields, poll.FieldQuiz)
+	}
+	if m.closes_at != nil {
+		fields = append(fields, poll.FieldClosesAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, poll.FieldClosedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PollMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case poll.FieldCreatedAt:
+		return m.CreatedAt()
+	case poll.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case poll.FieldMessageID:
+		return m.MessageID()
+	case poll.FieldQuestion:
+		return m.Question()
+	case poll.FieldMultipleChoice:
+		return m.MultipleChoice()
+	case poll.FieldAnonymous:
+		return m.Anonymous()
+	case poll.FieldQuiz:
+		return m.Quiz()
+	case poll.FieldClosesAt:
+		return m.ClosesAt()
+	case poll.FieldClosedAt:
+		return m.ClosedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PollMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case poll.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case poll.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case poll.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case poll.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case poll.FieldMultipleChoice:
+		return m.OldMultipleChoice(ctx)
+	case poll.FieldAnonymous:
+		return m.OldAnonymous(ctx)
+	case poll.FieldQuiz:
+		return m.OldQuiz(ctx)
+	case poll.FieldClosesAt:
+		return m.OldClosesAt(ctx)
+	case poll.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Poll field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case poll.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case poll.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case poll.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case poll.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case poll.FieldMultipleChoice:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultipleChoice(v)
+		return nil
+	case poll.FieldAnonymous:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnonymous(v)
+		return nil
+	case poll.FieldQuiz:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuiz(v)
+		return nil
+	case poll.FieldClosesAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosesAt(v)
+		return nil
+	case poll.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Poll field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PollMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PollMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Poll numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PollMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(poll.FieldClosesAt) {
+		fields = append(fields, poll.FieldClosesAt)
+	}
+	if m.FieldCleared(poll.FieldClosedAt) {
+		fields = append(fields, poll.FieldClosedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PollMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PollMutation) ClearField(name string) error {
+	switch name {
+	case poll.FieldClosesAt:
+		m.ClearClosesAt()
+		return nil
+	case poll.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PollMutation) ResetField(name string) error {
+	switch name {
+	case poll.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case poll.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case poll.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case poll.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case poll.FieldMultipleChoice:
+		m.ResetMultipleChoice()
+		return nil
+	case poll.FieldAnonymous:
+		m.ResetAnonymous()
+		return nil
+	case poll.FieldQuiz:
+		m.ResetQuiz()
+		return nil
+	case poll.FieldClosesAt:
+		m.ResetClosesAt()
+		return nil
+	case poll.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PollMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.message != nil {
+		edges = append(edges, poll.EdgeMessage)
+	}
+	if m.options != nil {
+		edges = append(edges, poll.EdgeOptions)
+	}
+	if m.votes != nil {
+		edges = append(edges, poll.EdgeVotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PollMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case poll.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case poll.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.options))
+		for id := range m.options {
+			ids = append(ids, id)
+		}
+		return ids
+	case poll.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PollMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedoptions != nil {
+		edges = append(edges, poll.EdgeOptions)
+	}
+	if m.removedvotes != nil {
+		edges = append(edges, poll.EdgeVotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PollMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case poll.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.removedoptions))
+		for id := range m.removedoptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case poll.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PollMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmessage {
+		edges = append(edges, poll.EdgeMessage)
+	}
+	if m.clearedoptions {
+		edges = append(edges, poll.EdgeOptions)
+	}
+	if m.clearedvotes {
+		edges = append(edges, poll.EdgeVotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PollMutation) EdgeCleared(name string) bool {
+	switch name {
+	case poll.EdgeMessage:
+		return m.clearedmessage
+	case poll.EdgeOptions:
+		return m.clearedoptions
+	case poll.EdgeVotes:
+		return m.clearedvotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PollMutation) ClearEdge(name string) error {
+	switch name {
+	case poll.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PollMutation) ResetEdge(name string) error {
+	switch name {
+	case poll.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case poll.EdgeOptions:
+		m.ResetOptions()
+		return nil
+	case poll.EdgeVotes:
+		m.ResetVotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll edge %s", name)
+}
+
+// PollOptionMutation represents an operation that mutates the PollOption nodes in the graph.
+type PollOptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	text          *string
+	position      *int
+	addposition   *int
+	is_correct    *bool
+	clearedFields map[string]struct{}
+	poll          *uuid.UUID
+	clearedpoll   bool
+	votes         map[uuid.UUID]struct{}
+	removedvotes  map[uuid.UUID]struct{}
+	clearedvotes  bool
+	done          bool
+	oldValue      func(context.Context) (*PollOption, error)
+	predicates    []predicate.PollOption
+}
+
+var _ ent.Mutation = (*PollOptionMutation)(nil)
+
+// polloptionOption allows management of the mutation configuration using functional options.
+type polloptionOption func(*PollOptionMutation)
+
+// newPollOptionMutation creates new mutation for the PollOption entity.
+func newPollOptionMutation(c config, op Op, opts ...polloptionOption) *PollOptionMutation {
+	m := &PollOptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePollOption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPollOptionID sets the ID field of the mutation.
+func withPollOptionID(id uuid.UUID) polloptionOption {
+	return func(m *PollOptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PollOption
+		)
+		m.oldValue = func(ctx context.Context) (*PollOption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PollOption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPollOption sets the old PollOption of the mutation.
+func withPollOption(node *PollOption) polloptionOption {
+	return func(m *PollOptionMutation) {
+		m.oldValue = func(context.Context) (*PollOption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PollOptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PollOptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PollOption entities.
+func (m *PollOptionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PollOptionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PollOptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PollOption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PollOptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PollOptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PollOptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PollOptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PollOptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PollOptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPollID sets the "poll_id" field.
+func (m *PollOptionMutation) SetPollID(u uuid.UUID) {
+	m.poll = &u
+}
+
+// PollID returns the value of the "poll_id" field in the mutation.
+func (m *PollOptionMutation) PollID() (r uuid.UUID, exists bool) {
+	v := m.poll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollID returns the old "poll_id" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollOptionMutation) OldPollID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollID: %w", err)
+	}
+	return oldValue.PollID, nil
+}
+
+// ResetPollID resets all changes to the "poll_id" field.
+func (m *PollOptionMutation) ResetPollID() {
+	m.poll = nil
+}
+
+// SetText sets the "text" field.
+func (m *PollOptionMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *PollOptionMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollOptionMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *PollOptionMutation) ResetText() {
+	m.text = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *PollOptionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *PollOptionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollOptionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *PollOptionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PollOptionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *PollOptionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (m *PollOptionMutation) SetIsCorrect(b bool) {
+	m.is_correct = &b
+}
+
+// IsCorrect returns the value of the "is_correct" field in the mutation.
+func (m *PollOptionMutation) IsCorrect() (r bool, exists bool) {
+	v := m.is_correct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCorrect returns the old "is_correct" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollOptionMutation) OldIsCorrect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCorrect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCorrect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCorrect: %w", err)
+	}
+	return oldValue.IsCorrect, nil
+}
+
+// ResetIsCorrect resets all changes to the "is_correct" field.
+func (m *PollOptionMutation) ResetIsCorrect() {
+	m.is_correct = nil
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *PollOptionMutation) ClearPoll() {
+	m.clearedpoll = true
+	m.clearedFields[polloption.FieldPollID] = struct{}{}
+}
+
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *PollOptionMutation) PollCleared() bool {
+	return m.clearedpoll
+}
+
+// PollIDs returns the "poll" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PollID instead. It exists only for internal usage by the builders.
+func (m *PollOptionMutation) PollIDs() (ids []uuid.UUID) {
+	if id := m.poll; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPoll resets all changes to the "poll" edge.
+func (m *PollOptionMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by ids.
+func (m *PollOptionMutation) AddVoteIDs(ids ...uuid.UUID) {
+	if m.votes == nil {
+		m.votes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVotes clears the "votes" edge to the PollVote entity.
+func (m *PollOptionMutation) ClearVotes() {
+	m.clearedvotes = true
+}
+
+// VotesCleared reports if the "votes" edge to the PollVote entity was cleared.
+func (m *PollOptionMutation) VotesCleared() bool {
+	return m.clearedvotes
+}
+
+// RemoveVoteIDs removes the "votes" edge to the PollVote entity by IDs.
+func (m *PollOptionMutation) RemoveVoteIDs(ids ...uuid.UUID) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVotes returns the removed IDs of the "votes" edge to the PollVote entity.
+func (m *PollOptionMutation) RemovedVotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *PollOptionMutation) VotesIDs() (ids []uuid.UUID) {
+	for id := range m.votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVotes resets all changes to the "votes" edge.
+func (m *PollOptionMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
+}
+
+// Where appends a list predicates to the PollOptionMutation builder.
+func (m *PollOptionMutation) Where(ps ...predicate.PollOption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PollOptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PollOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PollOption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PollOptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PollOptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PollOption).
+func (m *PollOptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PollOptionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, polloption.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, polloption.FieldUpdatedAt)
+	}
+	if m.poll != nil {
+		fields = append(fields, polloption.FieldPollID)
+	}
+	if m.text != nil {
+		fields = append(fields, polloption.FieldText)
+	}
+	if m.position != nil {
+		fields = append(fields, polloption.FieldPosition)
+	}
+	if m.is_correct != nil {
+		fields = append(fields, polloption.FieldIsCorrect)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PollOptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case polloption.FieldCreatedAt:
+		return m.CreatedAt()
+	case polloption.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case polloption.FieldPollID:
+		return m.PollID()
+	case polloption.FieldText:
+		return m.Text()
+	case polloption.FieldPosition:
+		return m.Position()
+	case polloption.FieldIsCorrect:
+		return m.IsCorrect()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PollOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case polloption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case polloption.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case polloption.FieldPollID:
+		return m.OldPollID(ctx)
+	case polloption.FieldText:
+		return m.OldText(ctx)
+	case polloption.FieldPosition:
+		return m.OldPosition(ctx)
+	case polloption.FieldIsCorrect:
+		return m.OldIsCorrect(ctx)
+	}
+	return nil, fmt.Errorf("unknown PollOption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollOptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case polloption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case polloption.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case polloption.FieldPollID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollID(v)
+		return nil
+	case polloption.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case polloption.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case polloption.FieldIsCorrect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCorrect(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PollOptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, polloption.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PollOptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case polloption.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollOptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case polloption.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PollOptionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PollOptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PollOptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PollOption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PollOptionMutation) ResetField(name string) error {
+	switch name {
+	case polloption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case polloption.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case polloption.FieldPollID:
+		m.ResetPollID()
+		return nil
+	case polloption.FieldText:
+		m.ResetText()
+		return nil
+	case polloption.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case polloption.FieldIsCorrect:
+		m.ResetIsCorrect()
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PollOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.poll != nil {
+		edges = append(edges, polloption.EdgePoll)
+	}
+	if m.votes != nil {
+		edges = append(edges, polloption.EdgeVotes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PollOptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case polloption.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	case polloption.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PollOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedvotes != nil {
+		edges = append(edges, polloption.EdgeVotes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PollOptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case polloption.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PollOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpoll {
+		edges = append(edges, polloption.EdgePoll)
+	}
+	if m.clearedvotes {
+		edges = append(edges, polloption.EdgeVotes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PollOptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case polloption.EdgePoll:
+		return m.clearedpoll
+	case polloption.EdgeVotes:
+		return m.clearedvotes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PollOptionMutation) ClearEdge(name string) error {
+	switch name {
+	case polloption.EdgePoll:
+		m.ClearPoll()
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PollOptionMutation) ResetEdge(name string) error {
+	switch name {
+	case polloption.EdgePoll:
+		m.ResetPoll()
+		return nil
+	case polloption.EdgeVotes:
+		m.ResetVotes()
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption edge %s", name)
+}
+
+// PollVoteMutation represents an operation that mutates the PollVote nodes in the graph.
+type PollVoteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	poll          *uuid.UUID
+	clearedpoll   bool
+	option        *uuid.UUID
+	clearedoption bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PollVote, error)
+	predicates    []predicate.PollVote
+}
+
+var _ ent.Mutation = (*PollVoteMutation)(nil)
+
+// pollvoteOption allows management of the mutation configuration using functional options.
+type pollvoteOption func(*PollVoteMutation)
+
+// newPollVoteMutation creates new mutation for the PollVote entity.
+func newPollVoteMutation(c config, op Op, opts ...pollvoteOption) *PollVoteMutation {
+	m := &PollVoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePollVote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPollVoteID sets the ID field of the mutation.
+func withPollVoteID(id uuid.UUID) pollvoteOption {
+	return func(m *PollVoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PollVote
+		)
+		m.oldValue = func(ctx context.Context) (*PollVote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PollVote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPollVote sets the old PollVote of the mutation.
+func withPollVote(node *PollVote) pollvoteOption {
+	return func(m *PollVoteMutation) {
+		m.oldValue = func(context.Context) (*PollVote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PollVoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PollVoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PollVote entities.
+func (m *PollVoteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PollVoteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PollVoteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PollVote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PollVoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PollVoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PollVoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PollVoteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PollVoteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PollVoteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPollID sets the "poll_id" field.
+func (m *PollVoteMutation) SetPollID(u uuid.UUID) {
+	m.poll = &u
+}
+
+// PollID returns the value of the "poll_id" field in the mutation.
+func (m *PollVoteMutation) PollID() (r uuid.UUID, exists bool) {
+	v := m.poll
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPollID returns the old "poll_id" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldPollID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPollID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPollID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPollID: %w", err)
+	}
+	return oldValue.PollID, nil
+}
+
+// ResetPollID resets all changes to the "poll_id" field.
+func (m *PollVoteMutation) ResetPollID() {
+	m.poll = nil
+}
+
+// SetOptionID sets the "option_id" field.
+func (m *PollVoteMutation) SetOptionID(u uuid.UUID) {
+	m.option = &u
+}
+
+// OptionID returns the value of the "option_id" field in the mutation.
+func (m *PollVoteMutation) OptionID() (r uuid.UUID, exists bool) {
+	v := m.option
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptionID returns the old "option_id" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldOptionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptionID: %w", err)
+	}
+	return oldValue.OptionID, nil
+}
+
+// ResetOptionID resets all changes to the "option_id" field.
+func (m *PollVoteMutation) ResetOptionID() {
+	m.option = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PollVoteMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PollVoteMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollVoteMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PollVoteMutation) ResetUserID() {
+	m.user = nil
+}
+
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *PollVoteMutation) ClearPoll() {
+	m.clearedpoll = true
+	m.clearedFields[pollvote.FieldPollID] = struct{}{}
+}
+
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *PollVoteMutation) PollCleared() bool {
+	return m.clearedpoll
+}
+
+// PollIDs returns the "poll" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PollID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) PollIDs() (ids []uuid.UUID) {
+	if id := m.poll; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPoll resets all changes to the "poll" edge.
+func (m *PollVoteMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
+}
+
+// ClearOption clears the "option" edge to the PollOption entity.
+func (m *PollVoteMutation) ClearOption() {
+	m.clearedoption = true
+	m.clearedFields[pollvote.FieldOptionID] = struct{}{}
+}
+
+// OptionCleared reports if the "option" edge to the PollOption entity was cleared.
+func (m *PollVoteMutation) OptionCleared() bool {
+	return m.clearedoption
+}
+
+// OptionIDs returns the "option" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OptionID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) OptionIDs() (ids []uuid.UUID) {
+	if id := m.option; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOption resets all changes to the "option" edge.
+func (m *PollVoteMutation) ResetOption() {
+	m.option = nil
+	m.clearedoption = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PollVoteMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[pollvote.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PollVoteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PollVoteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PollVoteMutation builder.
+func (m *PollVoteMutation) Where(ps ...predicate.PollVote) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PollVoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PollVoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PollVote, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PollVoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PollVoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PollVote).
+func (m *PollVoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PollVoteMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, pollvote.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pollvote.FieldUpdatedAt)
+	}
+	if m.poll != nil {
+		fields = append(fields, pollvote.FieldPollID)
+	}
+	if m.option != nil {
+		fields = append(fields, pollvote.FieldOptionID)
+	}
+	if m.user != nil {
+		fields = append(fields, pollvote.FieldUserID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PollVoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pollvote.FieldCreatedAt:
+		return m.CreatedAt()
+	case pollvote.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pollvote.FieldPollID:
+		return m.PollID()
+	case pollvote.FieldOptionID:
+		return m.OptionID()
+	case pollvote.FieldUserID:
+		return m.UserID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PollVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pollvote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pollvote.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pollvote.FieldPollID:
+		return m.OldPollID(ctx)
+	case pollvote.FieldOptionID:
+		return m.OldOptionID(ctx)
+	case pollvote.FieldUserID:
+		return m.OldUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown PollVote field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollVoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pollvote.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pollvote.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pollvote.FieldPollID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPollID(v)
+		return nil
+	case pollvote.FieldOptionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptionID(v)
+		return nil
+	case pollvote.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PollVoteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PollVoteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollVoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PollVote numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PollVoteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PollVoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PollVoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PollVote nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PollVoteMutation) ResetField(name string) error {
+	switch name {
+	case pollvote.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pollvote.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pollvote.FieldPollID:
+		m.ResetPollID()
+		return nil
+	case pollvote.FieldOptionID:
+		m.ResetOptionID()
+		return nil
+	case pollvote.FieldUserID:
+		m.ResetUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PollVoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.poll != nil {
+		edges = append(edges, pollvote.EdgePoll)
+	}
+	if m.option != nil {
+		edges = append(edges, pollvote.EdgeOption)
+	}
+	if m.user != nil {
+		edges = append(edges, pollvote.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PollVoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pollvote.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	case pollvote.EdgeOption:
+		if id := m.option; id != nil {
+			return []ent.Value{*id}
+		}
+	case pollvote.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PollVoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PollVoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PollVoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpoll {
+		edges = append(edges, pollvote.EdgePoll)
+	}
+	if m.clearedoption {
+		edges = append(edges, pollvote.EdgeOption)
+	}
+	if m.cleareduser {
+		edges = append(edges, pollvote.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PollVoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pollvote.EdgePoll:
+		return m.clearedpoll
+	case pollvote.EdgeOption:
+		return m.clearedoption
+	case pollvote.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PollVoteMutation) ClearEdge(name string) error {
+	switch name {
+	case pollvote.EdgePoll:
+		m.ClearPoll()
+		return nil
+	case pollvote.EdgeOption:
+		m.ClearOption()
+		return nil
+	case pollvote.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PollVoteMutation) ResetEdge(name string) error {
+	switch name {
+	case pollvote.EdgePoll:
+		m.ResetPoll()
+		return nil
+	case pollvote.EdgeOption:
+		m.ResetOption()
+		return nil
+	case pollvote.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PollVote edge %s", name)
+}
+
+// PrivateChatMutation represents an operation that mutates the PrivateChat nodes in the graph.
+type PrivateChatMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	user1_last_read_at    *time.Time
+	user2_last_read_at    *time.Time
+	user1_hidden_at       *time.Time
+	user2_hidden_at       *time.Time
+	user1_archived_at     *time.Time
+	user2_archived_at     *time.Time
+	user1_keep_archived   *bool
+	user2_keep_archived   *bool
+	user1_muted           *bool
+	user2_muted           *bool
+	user1_muted_until     *time.Time
+	user2_muted_until     *time.Time
+	user1_unread_count    *int
+	adduser1_unread_count *int
+	user2_unread_count    *int
+	adduser2_unread_count *int
+	user1_marked_unread   *bool
+	user2_marked_unread   *bool
+	clearedFields         map[string]struct{}
+	chat                  *uuid.UUID
+	clearedchat           bool
+	user1                 *uuid.UUID
+	cleareduser1          bool
+	user2                 *uuid.UUID
+	cleareduser2          bool
+	done                  bool
+	oldValue              func(context.Context) (*PrivateChat, error)
+	predicates            []predicate.PrivateChat
+}
+
+var _ ent.Mutation = (*PrivateChatMutation)(nil)
+
+// privatechatOption allows management of the mutation configuration using functional options.
+type privatechatOption func(*PrivateChatMutation)
+
+// newPrivateChatMutation creates new mutation for the PrivateChat entity.
+func newPrivateChatMutation(c config, op Op, opts ...privatechatOption) *PrivateChatMutation {
+	m := &PrivateChatMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivateChat,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivateChatID sets the ID field of the mutation.
+func withPrivateChatID(id uuid.UUID) privatechatOption {
+	return func(m *PrivateChatMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivateChat
+		)
+		m.oldValue = func(ctx context.Context) (*PrivateChat, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivateChat.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivateChat sets the old PrivateChat of the mutation.
+func withPrivateChat(node *PrivateChat) privatechatOption {
+	return func(m *PrivateChatMutation) {
+		m.oldValue = func(context.Context) (*PrivateChat, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivateChatMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivateChatMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PrivateChat entities.
+func (m *PrivateChatMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivateChatMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivateChatMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivateChat.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *PrivateChatMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *PrivateChatMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *PrivateChatMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetUser1ID sets the "user1_id" field.
+func (m *PrivateChatMutation) SetUser1ID(u uuid.UUID) {
+	m.user1 = &u
+}
+
+// User1ID returns the value of the "user1_id" field in the mutation.
+func (m *PrivateChatMutation) User1ID() (r uuid.UUID, exists bool) {
+	v := m.user1
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1ID returns the old "user1_id" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1ID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1ID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1ID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1ID: %w", err)
+	}
+	return oldValue.User1ID, nil
+}
+
+// ClearUser1ID clears the value of the "user1_id" field.
+func (m *PrivateChatMutation) ClearUser1ID() {
+	m.user1 = nil
+	m.clearedFields[privatechat.FieldUser1ID] = struct{}{}
+}
+
+// User1IDCleared returns if the "user1_id" field was cleared in this mutation.
+func (m *PrivateChatMutation) User1IDCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser1ID]
+	return ok
+}
+
+// ResetUser1ID resets all changes to the "user1_id" field.
+func (m *PrivateChatMutation) ResetUser1ID() {
+	m.user1 = nil
+	delete(m.clearedFields, privatechat.FieldUser1ID)
+}
+
+// SetUser2ID sets the "user2_id" field.
+func (m *PrivateChatMutation) SetUser2ID(u uuid.UUID) {
+	m.user2 = &u
+}
+
+// User2ID returns the value of the "user2_id" field in the mutation.
+func (m *PrivateChatMutation) User2ID() (r uuid.UUID, exists bool) {
+	v := m.user2
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2ID returns the old "user2_id" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2ID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2ID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2ID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2ID: %w", err)
+	}
+	return oldValue.User2ID, nil
+}
+
+// ClearUser2ID clears the value of the "user2_id" field.
+func (m *PrivateChatMutation) ClearUser2ID() {
+	m.user2 = nil
+	m.clearedFields[privatechat.FieldUser2ID] = struct{}{}
+}
+
+// User2IDCleared returns if the "user2_id" field was cleared in this mutation.
+func (m *PrivateChatMutation) User2IDCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser2ID]
+	return ok
+}
+
+// ResetUser2ID resets all changes to the "user2_id" field.
+func (m *PrivateChatMutation) ResetUser2ID() {
+	m.user2 = nil
+	delete(m.clearedFields, privatechat.FieldUser2ID)
+}
+
+// SetUser1LastReadAt sets the "user1_last_read_at" field.
+func (m *PrivateChatMutation) SetUser1LastReadAt(t time.Time) {
+	m.user1_last_read_at = &t
+}
+
+// User1LastReadAt returns the value of the "user1_last_read_at" field in the mutation.
+func (m *PrivateChatMutation) User1LastReadAt() (r time.Time, exists bool) {
+	v := m.user1_last_read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1LastReadAt returns the old "user1_last_read_at" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1LastReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1LastReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1LastReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1LastReadAt: %w", err)
+	}
+	return oldValue.User1LastReadAt, nil
+}
+
+// ClearUser1LastReadAt clears the value of the "user1_last_read_at" field.
+func (m *PrivateChatMutation) ClearUser1LastReadAt() {
+	m.user1_last_read_at = nil
+	m.clearedFields[privatechat.FieldUser1LastReadAt] = struct{}{}
+}
+
+// User1LastReadAtCleared returns if the "user1_last_read_at" field was cleared in this mutation.
+func (m *PrivateChatMutation) User1LastReadAtCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser1LastReadAt]
+	return ok
+}
+
+// ResetUser1LastReadAt resets all changes to the "user1_last_read_at" field.
+func (m *PrivateChatMutation) ResetUser1LastReadAt() {
+	m.user1_last_read_at = nil
+	delete(m.clearedFields, privatechat.FieldUser1LastReadAt)
+}
+
+// SetUser2LastReadAt sets the "user2_last_read_at" field.
+func (m *PrivateChatMutation) SetUser2LastReadAt(t time.Time) {
+	m.user2_last_read_at = &t
+}
+
+// User2LastReadAt returns the value of the "user2_last_read_at" field in the mutation.
+func (m *PrivateChatMutation) User2LastReadAt() (r time.Time, exists bool) {
+	v := m.user2_last_read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2LastReadAt returns the old "user2_last_read_at" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2LastReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2LastReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2LastReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2LastReadAt: %w", err)
+	}
+	return oldValue.User2LastReadAt, nil
+}
+
+// ClearUser2LastReadAt clears the value of the "user2_last_read_at" field.
+func (m *PrivateChatMutation) ClearUser2LastReadAt() {
+	m.user2_last_read_at = nil
+	m.clearedFields[privatechat.FieldUser2LastReadAt] = struct{}{}
+}
+
+// User2LastReadAtCleared returns if the "user2_last_read_at" field was cleared in this mutation.
+func (m *PrivateChatMutation) User2LastReadAtCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser2LastReadAt]
+	return ok
+}
+
+// ResetUser2LastReadAt resets all changes to the "user2_last_read_at" field.
+func (m *PrivateChatMutation) ResetUser2LastReadAt() {
+	m.user2_last_read_at = nil
+	delete(m.clearedFields, privatechat.FieldUser2LastReadAt)
+}
+
+// SetUser1HiddenAt sets the "user1_hidden_at" field.
+func (m *PrivateChatMutation) SetUser1HiddenAt(t time.Time) {
+	m.user1_hidden_at = &t
+}
+
+// User1HiddenAt returns the value of the "user1_hidden_at" field in the mutation.
+func (m *PrivateChatMutation) User1HiddenAt() (r time.Time, exists bool) {
+	v := m.user1_hidden_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1HiddenAt returns the old "user1_hidden_at" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1HiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1HiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1HiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1HiddenAt: %w", err)
+	}
+	return oldValue.User1HiddenAt, nil
+}
+
+// ClearUser1HiddenAt clears the value of the "user1_hidden_at" field.
+func (m *PrivateChatMutation) ClearUser1HiddenAt() {
+	m.user1_hidden_at = nil
+	m.clearedFields[privatechat.FieldUser1HiddenAt] = struct{}{}
+}
+
+// User1HiddenAtCleared returns if the "user1_hidden_at" field was cleared in this mutation.
+func (m *PrivateChatMutation) User1HiddenAtCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser1HiddenAt]
+	return ok
+}
+
+// ResetUser1HiddenAt resets all changes to the "user1_hidden_at" field.
+func (m *PrivateChatMutation) ResetUser1HiddenAt() {
+	m.user1_hidden_at = nil
+	delete(m.clearedFields, privatechat.FieldUser1HiddenAt)
+}
+
+// SetUser2HiddenAt sets the "user2_hidden_at" field.
+func (m *PrivateChatMutation) SetUser2HiddenAt(t time.Time) {
+	m.user2_hidden_at = &t
+}
+
+// User2HiddenAt returns the value of the "user2_hidden_at" field in the mutation.
+func (m *PrivateChatMutation) User2HiddenAt() (r time.Time, exists bool) {
+	v := m.user2_hidden_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2HiddenAt returns the old "user2_hidden_at" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2HiddenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2HiddenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2HiddenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2HiddenAt: %w", err)
+	}
+	return oldValue.User2HiddenAt, nil
+}
+
+// ClearUser2HiddenAt clears the value of the "user2_hidden_at" field.
+func (m *PrivateChatMutation) ClearUser2HiddenAt() {
+	m.user2_hidden_at = nil
+	m.clearedFields[privatechat.FieldUser2HiddenAt] = struct{}{}
+}
+
+// User2HiddenAtCleared returns if the "user2_hidden_at" field was cleared in this mutation.
+func (m *PrivateChatMutation) User2HiddenAtCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser2HiddenAt]
+	return ok
+}
+
+// ResetUser2HiddenAt resets all changes to the "user2_hidden_at" field.
+func (m *PrivateChatMutation) ResetUser2HiddenAt() {
+	m.user2_hidden_at = nil
+	delete(m.clearedFields, privatechat.FieldUser2HiddenAt)
+}
+
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (m *PrivateChatMutation) SetUser1ArchivedAt(t time.Time) {
+	m.user1_archived_at = &t
+}
+
+// User1ArchivedAt returns the value of the "user1_archived_at" field in the mutation.
+func (m *PrivateChatMutation) User1ArchivedAt() (r time.Time, exists bool) {
+	v := m.user1_archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1ArchivedAt returns the old "user1_archived_at" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1ArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1ArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1ArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1ArchivedAt: %w", err)
+	}
+	return oldValue.User1ArchivedAt, nil
+}
+
+// ClearUser1ArchivedAt clears the value of the "user1_archived_at" field.
+func (m *PrivateChatMutation) ClearUser1ArchivedAt() {
+	m.user1_archived_at = nil
+	m.clearedFields[privatechat.FieldUser1ArchivedAt] = struct{}{}
+}
+
+// User1ArchivedAtCleared returns if the "user1_archived_at" field was cleared in this mutation.
+func (m *PrivateChatMutation) User1ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser1ArchivedAt]
+	return ok
+}
+
+// ResetUser1ArchivedAt resets all changes to the "user1_archived_at" field.
+func (m *PrivateChatMutation) ResetUser1ArchivedAt() {
+	m.user1_archived_at = nil
+	delete(m.clearedFields, privatechat.FieldUser1ArchivedAt)
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (m *PrivateChatMutation) SetUser2ArchivedAt(t time.Time) {
+	m.user2_archived_at = &t
+}
+
+// User2ArchivedAt returns the value of the "user2_archived_at" field in the mutation.
+func (m *PrivateChatMutation) User2ArchivedAt() (r time.Time, exists bool) {
+	v := m.user2_archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2ArchivedAt returns the old "user2_archived_at" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2ArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2ArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2ArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2ArchivedAt: %w", err)
+	}
+	return oldValue.User2ArchivedAt, nil
+}
+
+// ClearUser2ArchivedAt clears the value of the "user2_archived_at" field.
+func (m *PrivateChatMutation) ClearUser2ArchivedAt() {
+	m.user2_archived_at = nil
+	m.clearedFields[privatechat.FieldUser2ArchivedAt] = struct{}{}
+}
+
+// User2ArchivedAtCleared returns if the "user2_archived_at" field was cleared in this mutation.
+func (m *PrivateChatMutation) User2ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser2ArchivedAt]
+	return ok
+}
+
+// ResetUser2ArchivedAt resets all changes to the "user2_archived_at" field.
+func (m *PrivateChatMutation) ResetUser2ArchivedAt() {
+	m.user2_archived_at = nil
+	delete(m.clearedFields, privatechat.FieldUser2ArchivedAt)
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (m *PrivateChatMutation) SetUser1KeepArchived(b bool) {
+	m.user1_keep_archived = &b
+}
+
+// User1KeepArchived returns the value of the "user1_keep_archived" field in the mutation.
+func (m *PrivateChatMutation) User1KeepArchived() (r bool, exists bool) {
+	v := m.user1_keep_archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1KeepArchived returns the old "user1_keep_archived" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1KeepArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1KeepArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1KeepArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1KeepArchived: %w", err)
+	}
+	return oldValue.User1KeepArchived, nil
+}
+
+// ResetUser1KeepArchived resets all changes to the "user1_keep_archived" field.
+func (m *PrivateChatMutation) ResetUser1KeepArchived() {
+	m.user1_keep_archived = nil
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (m *PrivateChatMutation) SetUser2KeepArchived(b bool) {
+	m.user2_keep_archived = &b
+}
+
+// User2KeepArchived returns the value of the "user2_keep_archived" field in the mutation.
+func (m *PrivateChatMutation) User2KeepArchived() (r bool, exists bool) {
+	v := m.user2_keep_archived
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2KeepArchived returns the old "user2_keep_archived" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2KeepArchived(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2KeepArchived is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2KeepArchived requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2KeepArchived: %w", err)
+	}
+	return oldValue.User2KeepArchived, nil
+}
+
+// ResetUser2KeepArchived resets all changes to the "user2_keep_archived" field.
+func (m *PrivateChatMutation) ResetUser2KeepArchived() {
+	m.user2_keep_archived = nil
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (m *PrivateChatMutation) SetUser1Muted(b bool) {
+	m.user1_muted = &b
+}
+
+// User1Muted returns the value of the "user1_muted" field in the mutation.
+func (m *PrivateChatMutation) User1Muted() (r bool, exists bool) {
+	v := m.user1_muted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1Muted returns the old "user1_muted" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1Muted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1Muted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1Muted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1Muted: %w", err)
+	}
+	return oldValue.User1Muted, nil
+}
+
+// ResetUser1Muted resets all changes to the "user1_muted" field.
+func (m *PrivateChatMutation) ResetUser1Muted() {
+	m.user1_muted = nil
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (m *PrivateChatMutation) SetUser2Muted(b bool) {
+	m.user2_muted = &b
+}
+
+// User2Muted returns the value of the "user2_muted" field in the mutation.
+func (m *PrivateChatMutation) User2Muted() (r bool, exists bool) {
+	v := m.user2_muted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2Muted returns the old "user2_muted" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2Muted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2Muted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2Muted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2Muted: %w", err)
+	}
+	return oldValue.User2Muted, nil
+}
+
+// ResetUser2Muted resets all changes to the "user2_muted" field.
+func (m *PrivateChatMutation) ResetUser2Muted() {
+	m.user2_muted = nil
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (m *PrivateChatMutation) SetUser1MutedUntil(t time.Time) {
+	m.user1_muted_until = &t
+}
+
+// User1MutedUntil returns the value of the "user1_muted_until" field in the mutation.
+func (m *PrivateChatMutation) User1MutedUntil() (r time.Time, exists bool) {
+	v := m.user1_muted_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1MutedUntil returns the old "user1_muted_until" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1MutedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1MutedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1MutedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1MutedUntil: %w", err)
+	}
+	return oldValue.User1MutedUntil, nil
+}
+
+// ClearUser1MutedUntil clears the value of the "user1_muted_until" field.
+func (m *PrivateChatMutation) ClearUser1MutedUntil() {
+	m.user1_muted_until = nil
+	m.clearedFields[privatechat.FieldUser1MutedUntil] = struct{}{}
+}
+
+// User1MutedUntilCleared returns if the "user1_muted_until" field was cleared in this mutation.
+func (m *PrivateChatMutation) User1MutedUntilCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser1MutedUntil]
+	return ok
+}
+
+// ResetUser1MutedUntil resets all changes to the "user1_muted_until" field.
+func (m *PrivateChatMutation) ResetUser1MutedUntil() {
+	m.user1_muted_until = nil
+	delete(m.clearedFields, privatechat.FieldUser1MutedUntil)
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (m *PrivateChatMutation) SetUser2MutedUntil(t time.Time) {
+	m.user2_muted_until = &t
+}
+
+// User2MutedUntil returns the value of the "user2_muted_until" field in the mutation.
+func (m *PrivateChatMutation) User2MutedUntil() (r time.Time, exists bool) {
+	v := m.user2_muted_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2MutedUntil returns the old "user2_muted_until" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2MutedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2MutedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2MutedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2MutedUntil: %w", err)
+	}
+	return oldValue.User2MutedUntil, nil
+}
+
+// ClearUser2MutedUntil clears the value of the "user2_muted_until" field.
+func (m *PrivateChatMutation) ClearUser2MutedUntil() {
+	m.user2_muted_until = nil
+	m.clearedFields[privatechat.FieldUser2MutedUntil] = struct{}{}
+}
+
+// User2MutedUntilCleared returns if the "user2_muted_until" field was cleared in this mutation.
+func (m *PrivateChatMutation) User2MutedUntilCleared() bool {
+	_, ok := m.clearedFields[privatechat.FieldUser2MutedUntil]
+	return ok
+}
+
+// ResetUser2MutedUntil resets all changes to the "user2_muted_until" field.
+func (m *PrivateChatMutation) ResetUser2MutedUntil() {
+	m.user2_muted_until = nil
+	delete(m.clearedFields, privatechat.FieldUser2MutedUntil)
+}
+
+// SetUser1UnreadCount sets the "user1_unread_count" field.
+func (m *PrivateChatMutation) SetUser1UnreadCount(i int) {
+	m.user1_unread_count = &i
+	m.adduser1_unread_count = nil
+}
+
+// User1UnreadCount returns the value of the "user1_unread_count" field in the mutation.
+func (m *PrivateChatMutation) User1UnreadCount() (r int, exists bool) {
+	v := m.user1_unread_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1UnreadCount returns the old "user1_unread_count" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1UnreadCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1UnreadCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1UnreadCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1UnreadCount: %w", err)
+	}
+	return oldValue.User1UnreadCount, nil
+}
+
+// AddUser1UnreadCount adds i to the "user1_unread_count" field.
+func (m *PrivateChatMutation) AddUser1UnreadCount(i int) {
+	if m.adduser1_unread_count != nil {
+		*m.adduser1_unread_count += i
+	} else {
+		m.adduser1_unread_count = &i
+	}
+}
+
+// AddedUser1UnreadCount returns the value that was added to the "user1_unread_count" field in this mutation.
+func (m *PrivateChatMutation) AddedUser1UnreadCount() (r int, exists bool) {
+	v := m.adduser1_unread_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUser1UnreadCount resets all changes to the "user1_unread_count" field.
+func (m *PrivateChatMutation) ResetUser1UnreadCount() {
+	m.user1_unread_count = nil
+	m.adduser1_unread_count = nil
+}
+
+// SetUser2UnreadCount sets the "user2_unread_count" field.
+func (m *PrivateChatMutation) SetUser2UnreadCount(i int) {
+	m.user2_unread_count = &i
+	m.adduser2_unread_count = nil
+}
+
+// User2UnreadCount returns the value of the "user2_unread_count" field in the mutation.
+func (m *PrivateChatMutation) User2UnreadCount() (r int, exists bool) {
+	v := m.user2_unread_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2UnreadCount returns the old "user2_unread_count" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2UnreadCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2UnreadCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2UnreadCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2UnreadCount: %w", err)
+	}
+	return oldValue.User2UnreadCount, nil
+}
+
+// AddUser2UnreadCount adds i to the "user2_unread_count" field.
+func (m *PrivateChatMutation) AddUser2UnreadCount(i int) {
+	if m.adduser2_unread_count != nil {
+		*m.adduser2_unread_count += i
+	} else {
+		m.adduser2_unread_count = &i
+	}
+}
+
+// AddedUser2UnreadCount returns the value that was added to the "user2_unread_count" field in this mutation.
+func (m *PrivateChatMutation) AddedUser2UnreadCount() (r int, exists bool) {
+	v := m.adduser2_unread_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUser2UnreadCount resets all changes to the "user2_unread_count" field.
+func (m *PrivateChatMutation) ResetUser2UnreadCount() {
+	m.user2_unread_count = nil
+	m.adduser2_unread_count = nil
+}
+
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (m *PrivateChatMutation) SetUser1MarkedUnread(b bool) {
+	m.user1_marked_unread = &b
+}
+
+// User1MarkedUnread returns the value of the "user1_marked_unread" field in the mutation.
+func (m *PrivateChatMutation) User1MarkedUnread() (r bool, exists bool) {
+	v := m.user1_marked_unread
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser1MarkedUnread returns the old "user1_marked_unread" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser1MarkedUnread(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser1MarkedUnread is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser1MarkedUnread requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser1MarkedUnread: %w", err)
+	}
+	return oldValue.User1MarkedUnread, nil
+}
+
+// ResetUser1MarkedUnread resets all changes to the "user1_marked_unread" field.
+func (m *PrivateChatMutation) ResetUser1MarkedUnread() {
+	m.user1_marked_unread = nil
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (m *PrivateChatMutation) SetUser2MarkedUnread(b bool) {
+	m.user2_marked_unread = &b
+}
+
+// User2MarkedUnread returns the value of the "user2_marked_unread" field in the mutation.
+func (m *PrivateChatMutation) User2MarkedUnread() (r bool, exists bool) {
+	v := m.user2_marked_unread
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUser2MarkedUnread returns the old "user2_marked_unread" field's value of the PrivateChat entity.
+// If the PrivateChat object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateChatMutation) OldUser2MarkedUnread(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUser2MarkedUnread is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUser2MarkedUnread requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUser2MarkedUnread: %w", err)
+	}
+	return oldValue.User2MarkedUnread, nil
+}
+
+// ResetUser2MarkedUnread resets all changes to the "user2_marked_unread" field.
+func (m *PrivateChatMutation) ResetUser2MarkedUnread() {
+	m.user2_marked_unread = nil
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *PrivateChatMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[privatechat.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *PrivateChatMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *PrivateChatMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *PrivateChatMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearUser1 clears the "user1" edge to the User entity.
+func (m *PrivateChatMutation) ClearUser1() {
+	m.cleareduser1 = true
+	m.clearedFields[privatechat.FieldUser1ID] = struct{}{}
+}
+
+// User1Cleared reports if the "user1" edge to the User entity was cleared.
+func (m *PrivateChatMutation) User1Cleared() bool {
+	return m.User1IDCleared() || m.cleareduser1
+}
+
+// User1IDs returns the "user1" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// User1ID instead. It exists only for internal usage by the builders.
+func (m *PrivateChatMutation) User1IDs() (ids []uuid.UUID) {
+	if id := m.user1; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser1 resets all changes to the "user1" edge.
+func (m *PrivateChatMutation) ResetUser1() {
+	m.user1 = nil
+	m.cleareduser1 = false
+}
+
+// ClearUser2 clears the "user2" edge to the User entity.
+func (m *PrivateChatMutation) ClearUser2() {
+	m.cleareduser2 = true
+	m.clearedFields[privatechat.FieldUser2ID] = struct{}{}
+}
+
+// User2Cleared reports if the "user2" edge to the User entity was cleared.
+func (m *PrivateChatMutation) User2Cleared() bool {
+	return m.User2IDCleared() || m.cleareduser2
+}
+
+// User2IDs returns the "user2" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// User2ID instead. It exists only for internal usage by the builders.
+func (m *PrivateChatMutation) User2IDs() (ids []uuid.UUID) {
+	if id := m.user2; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser2 resets all changes to the "user2" edge.
+func (m *PrivateChatMutation) ResetUser2() {
+	m.user2 = nil
+	m.cleareduser2 = false
+}
+
+// Where appends a list predicates to the PrivateChatMutation builder.
+func (m *PrivateChatMutation) Where(ps ...predicate.PrivateChat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrivateChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrivateChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrivateChat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrivateChatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrivateChatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PrivateChat).
+func (m *PrivateChatMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivateChatMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.chat != nil {
+		fields = append(fields, privatechat.FieldChatID)
+	}
+	if m.user1 != nil {
+		fields = append(fields, privatechat.FieldUser1ID)
+	}
+	if m.user2 != nil {
+		fields = append(fields, privatechat.FieldUser2ID)
+	}
+	if m.user1_last_read_at != nil {
+		fields = append(fields, privatechat.FieldUser1LastReadAt)
+	}
+	if m.user2_last_read_at != nil {
+		fields = append(fields, privatechat.FieldUser2LastReadAt)
+	}
+	if m.user1_hidden_at != nil {
+		fields = append(fields, privatechat.FieldUser1HiddenAt)
+	}
+	if m.user2_hidden_at != nil {
+		fields = append(fields, privatechat.FieldUser2HiddenAt)
+	}
+	if m.user1_archived_at != nil {
+		fields = append(fields, privatechat.FieldUser1ArchivedAt)
+	}
+	if m.user2_archived_at != nil {
+		fields = append(fields, privatechat.FieldUser2ArchivedAt)
+	}
+	if m.user1_keep_archived != nil {
+		fields = append(fields, privatechat.FieldUser1KeepArchived)
+	}
+	if m.user2_keep_archived != nil {
+		fields = append(fields, privatechat.FieldUser2KeepArchived)
+	}
+	if m.user1_muted != nil {
+		fields = append(fields, privatechat.FieldUser1Muted)
+	}
+	if m.user2_muted != nil {
+		fields = append(fields, privatechat.FieldUser2Muted)
+	}
+	if m.user1_muted_until != nil {
+		fields = append(fields, privatechat.FieldUser1MutedUntil)
+	}
+	if m.user2_muted_until != nil {
+		fields = append(fields, privatechat.FieldUser2MutedUntil)
+	}
+	if m.user1_unread_count != nil {
+		fields = append(fields, privatechat.FieldUser1UnreadCount)
+	}
+	if m.user2_unread_count != nil {
+		fields = append(fields, privatechat.FieldUser2UnreadCount)
+	}
+	if m.user1_marked_unread != nil {
+		fields = append(fields, privatechat.FieldUser1MarkedUnread)
+	}
+	if m.user2_marked_unread != nil {
+		fields = append(fields, privatechat.FieldUser2MarkedUnread)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivateChatMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privatechat.FieldChatID:
+		return m.ChatID()
+	case privatechat.FieldUser1ID:
+		return m.User1ID()
+	case privatechat.FieldUser2ID:
+		return m.User2ID()
+	case privatechat.FieldUser1LastReadAt:
+		return m.User1LastReadAt()
+	case privatechat.FieldUser2LastReadAt:
+		return m.User2LastReadAt()
+	case privatechat.FieldUser1HiddenAt:
+		return m.User1HiddenAt()
+	case privatechat.FieldUser2HiddenAt:
+		return m.User2HiddenAt()
+	case privatechat.FieldUser1ArchivedAt:
+		return m.User1ArchivedAt()
+	case privatechat.FieldUser2ArchivedAt:
+		return m.User2ArchivedAt()
+	case privatechat.FieldUser1KeepArchived:
+		return m.User1KeepArchived()
+	case privatechat.FieldUser2KeepArchived:
+		return m.User2KeepArchived()
+	case privatechat.FieldUser1Muted:
+		return m.User1Muted()
+	case privatechat.FieldUser2Muted:
+		return m.User2Muted()
+	case privatechat.FieldUser1MutedUntil:
+		return m.User1MutedUntil()
+	case privatechat.FieldUser2MutedUntil:
+		return m.User2MutedUntil()
+	case privatechat.FieldUser1UnreadCount:
+		return m.User1UnreadCount()
+	case privatechat.FieldUser2UnreadCount:
+		return m.User2UnreadCount()
+	case privatechat.FieldUser1MarkedUnread:
+		return m.User1MarkedUnread()
+	case privatechat.FieldUser2MarkedUnread:
+		return m.User2MarkedUnread()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivateChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privatechat.FieldChatID:
+		return m.OldChatID(ctx)
+	case privatechat.FieldUser1ID:
+		return m.OldUser1ID(ctx)
+	case privatechat.FieldUser2ID:
+		return m.OldUser2ID(ctx)
+	case privatechat.FieldUser1LastReadAt:
+		return m.OldUser1LastReadAt(ctx)
+	case privatechat.FieldUser2LastReadAt:
+		return m.OldUser2LastReadAt(ctx)
+	case privatechat.FieldUser1HiddenAt:
+		return m.OldUser1HiddenAt(ctx)
+	case privatechat.FieldUser2HiddenAt:
+		return m.OldUser2HiddenAt(ctx)
+	case privatechat.FieldUser1ArchivedAt:
+		return m.OldUser1ArchivedAt(ctx)
+	case privatechat.FieldUser2ArchivedAt:
+		return m.OldUser2ArchivedAt(ctx)
+	case privatechat.FieldUser1KeepArchived:
+		return m.OldUser1KeepArchived(ctx)
+	case privatechat.FieldUser2KeepArchived:
+		return m.OldUser2KeepArchived(ctx)
+	case privatechat.FieldUser1Muted:
+		return m.OldUser1Muted(ctx)
+	case privatechat.FieldUser2Muted:
+		return m.OldUser2Muted(ctx)
+	case privatechat.FieldUser1MutedUntil:
+		return m.OldUser1MutedUntil(ctx)
+	case privatechat.FieldUser2MutedUntil:
+		return m.OldUser2MutedUntil(ctx)
+	case privatechat.FieldUser1UnreadCount:
+		return m.OldUser1UnreadCount(ctx)
+	case privatechat.FieldUser2UnreadCount:
+		return m.OldUser2UnreadCount(ctx)
+	case privatechat.FieldUser1MarkedUnread:
+		return m.OldUser1MarkedUnread(ctx)
+	case privatechat.FieldUser2MarkedUnread:
+		return m.OldUser2MarkedUnread(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivateChat field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivateChatMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privatechat.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case privatechat.FieldUser1ID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1ID(v)
+		return nil
+	case privatechat.FieldUser2ID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2ID(v)
+		return nil
+	case privatechat.FieldUser1LastReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1LastReadAt(v)
+		return nil
+	case privatechat.FieldUser2LastReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2LastReadAt(v)
+		return nil
+	case privatechat.FieldUser1HiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1HiddenAt(v)
+		return nil
+	case privatechat.FieldUser2HiddenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2HiddenAt(v)
+		return nil
+	case privatechat.FieldUser1ArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1ArchivedAt(v)
+		return nil
+	case privatechat.FieldUser2ArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2ArchivedAt(v)
+		return nil
+	case privatechat.FieldUser1KeepArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1KeepArchived(v)
+		return nil
+	case privatechat.FieldUser2KeepArchived:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2KeepArchived(v)
+		return nil
+	case privatechat.FieldUser1Muted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1Muted(v)
+		return nil
+	case privatechat.FieldUser2Muted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2Muted(v)
+		return nil
+	case privatechat.FieldUser1MutedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1MutedUntil(v)
+		return nil
+	case privatechat.FieldUser2MutedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2MutedUntil(v)
+		return nil
+	case privatechat.FieldUser1UnreadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1UnreadCount(v)
+		return nil
+	case privatechat.FieldUser2UnreadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2UnreadCount(v)
+		return nil
+	case privatechat.FieldUser1MarkedUnread:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser1MarkedUnread(v)
+		return nil
+	case privatechat.FieldUser2MarkedUnread:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUser2MarkedUnread(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateChat field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivateChatMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser1_unread_count != nil {
+		fields = append(fields, privatechat.FieldUser1UnreadCount)
+	}
+	if m.adduser2_unread_count != nil {
+		fields = append(fields, privatechat.FieldUser2UnreadCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivateChatMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case privatechat.FieldUser1UnreadCount:
+		return m.AddedUser1UnreadCount()
+	case privatechat.FieldUser2UnreadCount:
+		return m.AddedUser2UnreadCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivateChatMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case privatechat.FieldUser1UnreadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUser1UnreadCount(v)
+		return nil
+	case privatechat.FieldUser2UnreadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUser2UnreadCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateChat numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivateChatMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(privatechat.FieldUser1ID) {
+		fields = append(fields, privatechat.FieldUser1ID)
+	}
+	if m.FieldCleared(privatechat.FieldUser2ID) {
+		fields = append(fields, privatechat.FieldUser2ID)
+	}
+	if m.FieldCleared(privatechat.FieldUser1LastReadAt) {
+		fields = append(fields, privatechat.FieldUser1LastReadAt)
+	}
+	if m.FieldCleared(privatechat.FieldUser2LastReadAt) {
+		fields = append(fields, privatechat.FieldUser2LastReadAt)
+	}
+	if m.FieldCleared(privatechat.FieldUser1HiddenAt) {
+		fields = append(fields, privatechat.FieldUser1HiddenAt)
+	}
+	if m.FieldCleared(privatechat.FieldUser2HiddenAt) {
+		fields = append(fields, privatechat.FieldUser2HiddenAt)
+	}
+	if m.FieldCleared(privatechat.FieldUser1ArchivedAt) {
+		fields = append(fields, privatechat.FieldUser1ArchivedAt)
+	}
+	if m.FieldCleared(privatechat.FieldUser2ArchivedAt) {
+		fields = append(fields, privatechat.FieldUser2ArchivedAt)
+	}
+	if m.FieldCleared(privatechat.FieldUser1MutedUntil) {
+		fields = append(fields, privatechat.FieldUser1MutedUntil)
+	}
+	if m.FieldCleared(privatechat.FieldUser2MutedUntil) {
+		fields = append(fields, privatechat.FieldUser2MutedUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivateChatMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivateChatMutation) ClearField(name string) error {
+	switch name {
+	case privatechat.FieldUser1ID:
+		m.ClearUser1ID()
+		return nil
+	case privatechat.FieldUser2ID:
+		m.ClearUser2ID()
+		return nil
+	case privatechat.FieldUser1LastReadAt:
+		m.ClearUser1LastReadAt()
+		return nil
+	case privatechat.FieldUser2LastReadAt:
+		m.ClearUser2LastReadAt()
+		return nil
+	case privatechat.FieldUser1HiddenAt:
+		m.ClearUser1HiddenAt()
+		return nil
+	case privatechat.FieldUser2HiddenAt:
+		m.ClearUser2HiddenAt()
+		return nil
+	case privatechat.FieldUser1ArchivedAt:
+		m.ClearUser1ArchivedAt()
+		return nil
+	case privatechat.FieldUser2ArchivedAt:
+		m.ClearUser2ArchivedAt()
+		return nil
+	case privatechat.FieldUser1MutedUntil:
+		m.ClearUser1MutedUntil()
+		return nil
+	case privatechat.FieldUser2MutedUntil:
+		m.ClearUser2MutedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateChat nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivateChatMutation) ResetField(name string) error {
+	switch name {
+	case privatechat.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case privatechat.FieldUser1ID:
+		m.ResetUser1ID()
+		return nil
+	case privatechat.FieldUser2ID:
+		m.ResetUser2ID()
+		return nil
+	case privatechat.FieldUser1LastReadAt:
+		m.ResetUser1LastReadAt()
+		return nil
+	case privatechat.FieldUser2LastReadAt:
+		m.ResetUser2LastReadAt()
+		return nil
+	case privatechat.FieldUser1HiddenAt:
+		m.ResetUser1HiddenAt()
+		return nil
+	case privatechat.FieldUser2HiddenAt:
+		m.ResetUser2HiddenAt()
+		return nil
+	case privatechat.FieldUser1ArchivedAt:
+		m.ResetUser1ArchivedAt()
+		return nil
+	case privatechat.FieldUser2ArchivedAt:
+		m.ResetUser2ArchivedAt()
+		return nil
+	case privatechat.FieldUser1KeepArchived:
+		m.ResetUser1KeepArchived()
+		return nil
+	case privatechat.FieldUser2KeepArchived:
+		m.ResetUser2KeepArchived()
+		return nil
+	case privatechat.FieldUser1Muted:
+		m.ResetUser1Muted()
+		return nil
+	case privatechat.FieldUser2Muted:
+		m.ResetUser2Muted()
+		return nil
+	case privatechat.FieldUser1MutedUntil:
+		m.ResetUser1MutedUntil()
+		return nil
+	case privatechat.FieldUser2MutedUntil:
+		m.ResetUser2MutedUntil()
+		return nil
+	case privatechat.FieldUser1UnreadCount:
+		m.ResetUser1UnreadCount()
+		return nil
+	case privatechat.FieldUser2UnreadCount:
+		m.ResetUser2UnreadCount()
+		return nil
+	case privatechat.FieldUser1MarkedUnread:
+		m.ResetUser1MarkedUnread()
+		return nil
+	case privatechat.FieldUser2MarkedUnread:
+		m.ResetUser2MarkedUnread()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateChat field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivateChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.chat != nil {
+		edges = append(edges, privatechat.EdgeChat)
+	}
+	if m.user1 != nil {
+		edges = append(edges, privatechat.EdgeUser1)
+	}
+	if m.user2 != nil {
+		edges = append(edges, privatechat.EdgeUser2)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivateChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case privatechat.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case privatechat.EdgeUser1:
+		if id := m.user1; id != nil {
+			return []ent.Value{*id}
+		}
+	case privatechat.EdgeUser2:
+		if id := m.user2; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivateChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivateChatMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivateChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedchat {
+		edges = append(edges, privatechat.EdgeChat)
+	}
+	if m.cleareduser1 {
+		edges = append(edges, privatechat.EdgeUser1)
+	}
+	if m.cleareduser2 {
+		edges = append(edges, privatechat.EdgeUser2)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivateChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case privatechat.EdgeChat:
+		return m.clearedchat
+	case privatechat.EdgeUser1:
+		return m.cleareduser1
+	case privatechat.EdgeUser2:
+		return m.cleareduser2
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivateChatMutation) ClearEdge(name string) error {
+	switch name {
+	case privatechat.EdgeChat:
+		m.ClearChat()
+		return nil
+	case privatechat.EdgeUser1:
+		m.ClearUser1()
+		return nil
+	case privatechat.EdgeUser2:
+		m.ClearUser2()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateChat unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivateChatMutation) ResetEdge(name string) error {
+	switch name {
+	case privatechat.EdgeChat:
+		m.ResetChat()
+		return nil
+	case privatechat.EdgeUser1:
+		m.ResetUser1()
+		return nil
+	case privatechat.EdgeUser2:
+		m.ResetUser2()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateChat edge %s", name)
+}
+
+// ReportMutation represents an operation that mutates the Report nodes in the graph.
+type ReportMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	target_type           *report.TargetType
+	reason                *string
+	description           *string
+	evidence_snapshot     *map[string]interface{}
+	status                *report.Status
+	resolution_notes      *string
+	resolved_at           *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	reporter              *uuid.UUID
+	clearedreporter       bool
+	message               *uuid.UUID
+	clearedmessage        bool
+	group                 *uuid.UUID
+	clearedgroup          bool
+	target_user           *uuid.UUID
+	clearedtarget_user    bool
+	evidence_media        map[uuid.UUID]struct{}
+	removedevidence_media map[uuid.UUID]struct{}
+	clearedevidence_media bool
+	resolved_by           *uuid.UUID
+	clearedresolved_by    bool
+	done                  bool
+	oldValue              func(context.Context) (*Report, error)
+	predicates            []predicate.Report
+}
+
+var _ ent.Mutation = (*ReportMutation)(nil)
+
+// reportOption allows management of the mutation configuration using functional options.
+type reportOption func(*ReportMutation)
+
+// newReportMutation creates new mutation for the Report entity.
+func newReportMutation(c config, op Op, opts ...reportOption) *ReportMutation {
+	m := &ReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReportID sets the ID field of the mutation.
+func withReportID(id uuid.UUID) reportOption {
+	return func(m *ReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Report
+		)
+		m.oldValue = func(ctx context.Context) (*Report, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Report.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReport sets the old Report of the mutation.
+func withReport(node *Report) reportOption {
+	return func(m *ReportMutation) {
+		m.oldValue = func(context.Context) (*Report, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Report entities.
+func (m *ReportMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReportMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Report.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *ReportMutation) SetTargetType(rt report.TargetType) {
+	m.target_type = &rt
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *ReportMutation) TargetType() (r report.TargetType, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetType(ctx context.Context) (v report.TargetType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *ReportMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ReportMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ReportMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ReportMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ReportMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ReportMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ReportMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[report.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ReportMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[report.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ReportMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, report.FieldDescription)
+}
+
+// SetEvidenceSnapshot sets the "evidence_snapshot" field.
+func (m *ReportMutation) SetEvidenceSnapshot(value map[string]interface{}) {
+	m.evidence_snapshot = &value
+}
+
+// EvidenceSnapshot returns the value of the "evidence_snapshot" field in the mutation.
+func (m *ReportMutation) EvidenceSnapshot() (r map[string]interface{}, exists bool) {
+	v := m.evidence_snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvidenceSnapshot returns the old "evidence_snapshot" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldEvidenceSnapshot(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvidenceSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvidenceSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvidenceSnapshot: %w", err)
+	}
+	return oldValue.EvidenceSnapshot, nil
+}
+
+// ClearEvidenceSnapshot clears the value of the "evidence_snapshot" field.
+func (m *ReportMutation) ClearEvidenceSnapshot() {
+	m.evidence_snapshot = nil
+	m.clearedFields[report.FieldEvidenceSnapshot] = struct{}{}
+}
+
+// EvidenceSnapshotCleared returns if the "evidence_snapshot" field was cleared in this mutation.
+func (m *ReportMutation) EvidenceSnapshotCleared() bool {
+	_, ok := m.clearedFields[report.FieldEvidenceSnapshot]
+	return ok
+}
+
+// ResetEvidenceSnapshot resets all changes to the "evidence_snapshot" field.
+func (m *ReportMutation) ResetEvidenceSnapshot() {
+	m.evidence_snapshot = nil
+	delete(m.clearedFields, report.FieldEvidenceSnapshot)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReportMutation) SetStatus(r report.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReportMutation) Status() (r report.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldStatus(ctx context.Context) (v report.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReporterID sets the "reporter_id" field.
+func (m *ReportMutation) SetReporterID(u uuid.UUID) {
+	m.reporter = &u
+}
+
+// ReporterID returns the value of the "reporter_id" field in the mutation.
+func (m *ReportMutation) ReporterID() (r uuid.UUID, exists bool) {
+	v := m.reporter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReporterID returns the old "reporter_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldReporterID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReporterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReporterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReporterID: %w", err)
+	}
+	return oldValue.ReporterID, nil
+}
+
+// ClearReporterID clears the value of the "reporter_id" field.
+func (m *ReportMutation) ClearReporterID() {
+	m.reporter = nil
+	m.clearedFields[report.FieldReporterID] = struct{}{}
+}
+
+// ReporterIDCleared returns if the "reporter_id" field was cleared in this mutation.
+func (m *ReportMutation) ReporterIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldReporterID]
+	return ok
+}
+
+// ResetReporterID resets all changes to the "reporter_id" field.
+func (m *ReportMutation) ResetReporterID() {
+	m.reporter = nil
+	delete(m.clearedFields, report.FieldReporterID)
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *ReportMutation) SetMessageID(u uuid.UUID) {
+	m.message = &u
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *ReportMutation) MessageID() (r uuid.UUID, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldMessageID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (m *ReportMutation) ClearMessageID() {
+	m.message = nil
+	m.clearedFields[report.FieldMessageID] = struct{}{}
+}
+
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *ReportMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldMessageID]
+	return ok
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *ReportMutation) ResetMessageID() {
+	m.message = nil
+	delete(m.clearedFields, report.FieldMessageID)
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *ReportMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *ReportMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroupID returns the old "group_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldGroupID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (m *ReportMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[report.FieldGroupID] = struct{}{}
+}
+
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *ReportMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldGroupID]
+	return ok
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *ReportMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, report.FieldGroupID)
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (m *ReportMutation) SetTargetUserID(u uuid.UUID) {
+	m.target_user = &u
+}
+
+// TargetUserID returns the value of the "target_user_id" field in the mutation.
+func (m *ReportMutation) TargetUserID() (r uuid.UUID, exists bool) {
+	v := m.target_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetUserID returns the old "target_user_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldTargetUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
+	}
+	return oldValue.TargetUserID, nil
+}
+
+// ClearTargetUserID clears the value of the "target_user_id" field.
+func (m *ReportMutation) ClearTargetUserID() {
+	m.target_user = nil
+	m.clearedFields[report.FieldTargetUserID] = struct{}{}
+}
+
+// TargetUserIDCleared returns if the "target_user_id" field was cleared in this mutation.
+func (m *ReportMutation) TargetUserIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldTargetUserID]
+	return ok
+}
+
+// ResetTargetUserID resets all changes to the "target_user_id" field.
+func (m *ReportMutation) ResetTargetUserID() {
+	m.target_user = nil
+	delete(m.clearedFields, report.FieldTargetUserID)
+}
+
+// SetResolutionNotes sets the "resolution_notes" field.
+func (m *ReportMutation) SetResolutionNotes(s string) {
+	m.resolution_notes = &s
+}
+
+// ResolutionNotes returns the value of the "resolution_notes" field in the mutation.
+func (m *ReportMutation) ResolutionNotes() (r string, exists bool) {
+	v := m.resolution_notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolutionNotes returns the old "resolution_notes" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolutionNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolutionNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolutionNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolutionNotes: %w", err)
+	}
+	return oldValue.ResolutionNotes, nil
+}
+
+// ClearResolutionNotes clears the value of the "resolution_notes" field.
+func (m *ReportMutation) ClearResolutionNotes() {
+	m.resolution_notes = nil
+	m.clearedFields[report.FieldResolutionNotes] = struct{}{}
+}
+
+// ResolutionNotesCleared returns if the "resolution_notes" field was cleared in this mutation.
+func (m *ReportMutation) ResolutionNotesCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolutionNotes]
+	return ok
+}
+
+// ResetResolutionNotes resets all changes to the "resolution_notes" field.
+func (m *ReportMutation) ResetResolutionNotes() {
+	m.resolution_notes = nil
+	delete(m.clearedFields, report.FieldResolutionNotes)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ReportMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ReportMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ReportMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[report.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ReportMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ReportMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, report.FieldResolvedAt)
+}
+
+// SetResolvedByID sets the "resolved_by_id" field.
+func (m *ReportMutation) SetResolvedByID(u uuid.UUID) {
+	m.resolved_by = &u
+}
+
+// ResolvedByID returns the value of the "resolved_by_id" field in the mutation.
+func (m *ReportMutation) ResolvedByID() (r uuid.UUID, exists bool) {
+	v := m.resolved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedByID returns the old "resolved_by_id" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldResolvedByID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedByID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedByID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedByID: %w", err)
+	}
+	return oldValue.ResolvedByID, nil
+}
+
+// ClearResolvedByID clears the value of the "resolved_by_id" field.
+func (m *ReportMutation) ClearResolvedByID() {
+	m.resolved_by = nil
+	m.clearedFields[report.FieldResolvedByID] = struct{}{}
+}
+
+// ResolvedByIDCleared returns if the "resolved_by_id" field was cleared in this mutation.
+func (m *ReportMutation) ResolvedByIDCleared() bool {
+	_, ok := m.clearedFields[report.FieldResolvedByID]
+	return ok
+}
+
+// ResetResolvedByID resets all changes to the "resolved_by_id" field.
+func (m *ReportMutation) ResetResolvedByID() {
+	m.resolved_by = nil
+	delete(m.clearedFields, report.FieldResolvedByID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReportMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReportMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Report entity.
+// If the Report object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReportMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearReporter clears the "reporter" edge to the User entity.
+func (m *ReportMutation) ClearReporter() {
+	m.clearedreporter = true
+	m.clearedFields[report.FieldReporterID] = struct{}{}
+}
+
+// ReporterCleared reports if the "reporter" edge to the User entity was cleared.
+func (m *ReportMutation) ReporterCleared() bool {
+	return m.ReporterIDCleared() || m.clearedreporter
+}
+
+// ReporterIDs returns the "reporter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReporterID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ReporterIDs() (ids []uuid.UUID) {
+	if id := m.reporter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReporter resets all changes to the "reporter" edge.
+func (m *ReportMutation) ResetReporter() {
+	m.reporter = nil
+	m.clearedreporter = false
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *ReportMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[report.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *ReportMutation) MessageCleared() bool {
+	return m.MessageIDCleared() || m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *ReportMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// ClearGroup clears the "group" edge to the GroupChat entity.
+func (m *ReportMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[report.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the GroupChat entity was cleared.
+func (m *ReportMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *ReportMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// ClearTargetUser clears the "target_user" edge to the User entity.
+func (m *ReportMutation) ClearTargetUser() {
+	m.clearedtarget_user = true
+	m.clearedFields[report.FieldTargetUserID] = struct{}{}
+}
+
+// TargetUserCleared reports if the "target_user" edge to the User entity was cleared.
+func (m *ReportMutation) TargetUserCleared() bool {
+	return m.TargetUserIDCleared() || m.clearedtarget_user
+}
+
+// TargetUserIDs returns the "target_user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetUserID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) TargetUserIDs() (ids []uuid.UUID) {
+	if id := m.target_user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargetUser resets all changes to the "target_user" edge.
+func (m *ReportMutation) ResetTargetUser() {
+	m.target_user = nil
+	m.clearedtarget_user = false
+}
+
+// AddEvidenceMediumIDs adds the "evidence_media" edge to the Media entity by ids.
+func (m *ReportMutation) AddEvidenceMediumIDs(ids ...uuid.UUID) {
+	if m.evidence_media == nil {
+		m.evidence_media = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.evidence_media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvidenceMedia clears the "evidence_media" edge to the Media entity.
+func (m *ReportMutation) ClearEvidenceMedia() {
+	m.clearedevidence_media = true
+}
+
+// EvidenceMediaCleared reports if the "evidence_media" edge to the Media entity was cleared.
+func (m *ReportMutation) EvidenceMediaCleared() bool {
+	return m.clearedevidence_media
+}
+
+// RemoveEvidenceMediumIDs removes the "evidence_media" edge to the Media entity by IDs.
+func (m *ReportMutation) RemoveEvidenceMediumIDs(ids ...uuid.UUID) {
+	if m.removedevidence_media == nil {
+		m.removedevidence_media = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.evidence_media, ids[i])
+		m.removedevidence_media[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvidenceMedia returns the removed IDs of the "evidence_media" edge to the Media entity.
+func (m *ReportMutation) RemovedEvidenceMediaIDs() (ids []uuid.UUID) {
+	for id := range m.removedevidence_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EvidenceMediaIDs returns the "evidence_media" edge IDs in the mutation.
+func (m *ReportMutation) EvidenceMediaIDs() (ids []uuid.UUID) {
+	for id := range m.evidence_media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvidenceMedia resets all changes to the "evidence_media" edge.
+func (m *ReportMutation) ResetEvidenceMedia() {
+	m.evidence_media = nil
+	m.clearedevidence_media = false
+	m.removedevidence_media = nil
+}
+
+// ClearResolvedBy clears the "resolved_by" edge to the User entity.
+func (m *ReportMutation) ClearResolvedBy() {
+	m.clearedresolved_by = true
+	m.clearedFields[report.FieldResolvedByID] = struct{}{}
+}
+
+// ResolvedByCleared reports if the "resolved_by" edge to the User entity was cleared.
+func (m *ReportMutation) ResolvedByCleared() bool {
+	return m.ResolvedByIDCleared() || m.clearedresolved_by
+}
+
+// ResolvedByIDs returns the "resolved_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResolvedByID instead. It exists only for internal usage by the builders.
+func (m *ReportMutation) ResolvedByIDs() (ids []uuid.UUID) {
+	if id := m.resolved_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResolvedBy resets all changes to the "resolved_by" edge.
+func (m *ReportMutation) ResetResolvedBy() {
+	m.resolved_by = nil
+	m.clearedresolved_by = false
+}
+
+// Where appends a list predicates to the ReportMutation builder.
+func (m *ReportMutation) Where(ps ...predicate.Report) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Report, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Report).
+func (m *ReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReportMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.target_type != nil {
+		fields = append(fields, report.FieldTargetType)
+	}
+	if m.reason != nil {
+		fields = append(fields, report.FieldReason)
+	}
+	if m.description != nil {
+		fields = append(fields, report.FieldDescription)
+	}
+	if m.evidence_snapshot != nil {
+		fields = append(fields, report.FieldEvidenceSnapshot)
+	}
+	if m.status != nil {
+		fields = append(fields, report.FieldStatus)
+	}
+	if m.reporter != nil {
+		fields = append(fields, report.FieldReporterID)
+	}
+	if m.message != nil {
+		fields = append(fields, report.FieldMessageID)
+	}
+	if m.group != nil {
+		fields = append(fields, report.FieldGroupID)
+	}
+	if m.target_user != nil {
+		fields = append(fields, report.FieldTargetUserID)
+	}
+	if m.resolution_notes != nil {
+		fields = append(fields, report.FieldResolutionNotes)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, report.FieldResolvedAt)
+	}
+	if m.resolved_by != nil {
+		fields = append(fields, report.FieldResolvedByID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, report.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, report.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case report.FieldTargetType:
+		return m.TargetType()
+	case report.FieldReason:
+		return m.Reason()
+	case report.FieldDescription:
+		return m.Description()
+	case report.FieldEvidenceSnapshot:
+		return m.EvidenceSnapshot()
+	case report.FieldStatus:
+		return m.Status()
+	case report.FieldReporterID:
+		return m.ReporterID()
+	case report.FieldMessageID:
+		return m.MessageID()
+	case report.FieldGroupID:
+		return m.GroupID()
+	case report.FieldTargetUserID:
+		return m.TargetUserID()
+	case report.FieldResolutionNotes:
+		return m.ResolutionNotes()
+	case report.FieldResolvedAt:
+		return m.ResolvedAt()
+	case report.FieldResolvedByID:
+		return m.ResolvedByID()
+	case report.FieldCreatedAt:
+		return m.CreatedAt()
+	case report.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case report.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case report.FieldReason:
+		return m.OldReason(ctx)
+	case report.FieldDescription:
+		return m.OldDescription(ctx)
+	case report.FieldEvidenceSnapshot:
+		return m.OldEvidenceSnapshot(ctx)
+	case report.FieldStatus:
+		return m.OldStatus(ctx)
+	case report.FieldReporterID:
+		return m.OldReporterID(ctx)
+	case report.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case report.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case report.FieldTargetUserID:
+		return m.OldTargetUserID(ctx)
+	case report.FieldResolutionNotes:
+		return m.OldResolutionNotes(ctx)
+	case report.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case report.FieldResolvedByID:
+		return m.OldResolvedByID(ctx)
+	case report.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case report.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Report field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case report.FieldTargetType:
+		v, ok := value.(report.TargetType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case report.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case report.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case report.FieldEvidenceSnapshot:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvidenceSnapshot(v)
+		return nil
+	case report.FieldStatus:
+		v, ok := value.(report.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case report.FieldReporterID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReporterID(v)
+		return nil
+	case report.FieldMessageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case report.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case report.FieldTargetUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetUserID(v)
+		return nil
+	case report.FieldResolutionNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolutionNotes(v)
+		return nil
+	case report.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	case report.FieldResolvedByID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedByID(v)
+		return nil
+	case report.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case report.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReportMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReportMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Report numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(report.FieldDescription) {
+		fields = append(fields, report.FieldDescription)
+	}
+	if m.FieldCleared(report.FieldEvidenceSnapshot) {
+		fields = append(fields, report.FieldEvidenceSnapshot)
+	}
+	if m.FieldCleared(report.FieldReporterID) {
+		fields = append(fields, report.FieldReporterID)
+	}
+	if m.FieldCleared(report.FieldMessageID) {
+		fields = append(fields, report.FieldMessageID)
+	}
+	if m.FieldCleared(report.FieldGroupID) {
+		fields = append(fields, report.FieldGroupID)
+	}
+	if m.FieldCleared(report.FieldTargetUserID) {
+		fields = append(fields, report.FieldTargetUserID)
+	}
+	if m.FieldCleared(report.FieldResolutionNotes) {
+		fields = append(fields, report.FieldResolutionNotes)
+	}
+	if m.FieldCleared(report.FieldResolvedAt) {
+		fields = append(fields, report.FieldResolvedAt)
+	}
+	if m.FieldCleared(report.FieldResolvedByID) {
+		fields = append(fields, report.FieldResolvedByID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReportMutation) ClearField(name string) error {
+	switch name {
+	case report.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case report.FieldEvidenceSnapshot:
+		m.ClearEvidenceSnapshot()
+		return nil
+	case report.FieldReporterID:
+		m.ClearReporterID()
+		return nil
+	case report.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	case report.FieldGroupID:
+		m.ClearGroupID()
+		return nil
+	case report.FieldTargetUserID:
+		m.ClearTargetUserID()
+		return nil
+	case report.FieldResolutionNotes:
+		m.ClearResolutionNotes()
+		return nil
+	case report.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	case report.FieldResolvedByID:
+		m.ClearResolvedByID()
+		return nil
+	}
+	return fmt.Errorf("unknown Report nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReportMutation) ResetField(name string) error {
+	switch name {
+	case report.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case report.FieldReason:
+		m.ResetReason()
+		return nil
+	case report.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case report.FieldEvidenceSnapshot:
+		m.ResetEvidenceSnapshot()
+		return nil
+	case report.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case report.FieldReporterID:
+		m.ResetReporterID()
+		return nil
+	case report.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case report.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case report.FieldTargetUserID:
+		m.ResetTargetUserID()
+		return nil
+	case report.FieldResolutionNotes:
+		m.ResetResolutionNotes()
+		return nil
+	case report.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	case report.FieldResolvedByID:
+		m.ResetResolvedByID()
+		return nil
+	case report.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case report.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Report field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.reporter != nil {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.message != nil {
+		edges = append(edges, report.EdgeMessage)
+	}
+	if m.group != nil {
+		edges = append(edges, report.EdgeGroup)
+	}
+	if m.target_user != nil {
+		edges = append(edges, report.EdgeTargetUser)
+	}
+	if m.evidence_media != nil {
+		edges = append(edges, report.EdgeEvidenceMedia)
+	}
+	if m.resolved_by != nil {
+		edges = append(edges, report.EdgeResolvedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case report.EdgeReporter:
+		if id := m.reporter; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeTargetUser:
+		if id := m.target_user; id != nil {
+			return []ent.Value{*id}
+		}
+	case report.EdgeEvidenceMedia:
+		ids := make([]ent.Value, 0, len(m.evidence_media))
+		for id := range m.evidence_media {
+			ids = append(ids, id)
+		}
+		return ids
+	case report.EdgeResolvedBy:
+		if id := m.resolved_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedevidence_media != nil {
+		edges = append(edges, report.EdgeEvidenceMedia)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case report.EdgeEvidenceMedia:
+		ids := make([]ent.Value, 0, len(m.removedevidence_media))
+		for id := range m.removedevidence_media {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedreporter {
+		edges = append(edges, report.EdgeReporter)
+	}
+	if m.clearedmessage {
+		edges = append(edges, report.EdgeMessage)
+	}
+	if m.clearedgroup {
+		edges = append(edges, report.EdgeGroup)
+	}
+	if m.clearedtarget_user {
+		edges = append(edges, report.EdgeTargetUser)
+	}
+	if m.clearedevidence_media {
+		edges = append(edges, report.EdgeEvidenceMedia)
+	}
+	if m.clearedresolved_by {
+		edges = append(edges, report.EdgeResolvedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case report.EdgeReporter:
+		return m.clearedreporter
+	case report.EdgeMessage:
+		return m.clearedmessage
+	case report.EdgeGroup:
+		return m.clearedgroup
+	case report.EdgeTargetUser:
+		return m.clearedtarget_user
+	case report.EdgeEvidenceMedia:
+		return m.clearedevidence_media
+	case report.EdgeResolvedBy:
+		return m.clearedresolved_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReportMutation) ClearEdge(name string) error {
+	switch name {
+	case report.EdgeReporter:
+		m.ClearReporter()
+		return nil
+	case report.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case report.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	case report.EdgeTargetUser:
+		m.ClearTargetUser()
+		return nil
+	case report.EdgeResolvedBy:
+		m.ClearResolvedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Report unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReportMutation) ResetEdge(name string) error {
+	switch name {
+	case report.EdgeReporter:
+		m.ResetReporter()
+		return nil
+	case report.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case report.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case report.EdgeTargetUser:
+		m.ResetTargetUser()
+		return nil
+	case report.EdgeEvidenceMedia:
+		m.ResetEvidenceMedia()
+		return nil
+	case report.EdgeResolvedBy:
+		m.ResetResolvedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Report edge %s", name)
+}
+
+// ScheduledMessageMutation represents an operation that mutates the ScheduledMessage nodes in the graph.
+type ScheduledMessageMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	content            *string
+	send_at            *time.Time
+	status             *scheduledmessage.Status
+	failure_reason     *string
+	clearedFields      map[string]struct{}
+	chat               *uuid.UUID
+	clearedchat        bool
+	sender             *uuid.UUID
+	clearedsender      bool
+	reply_to           *uuid.UUID
+	clearedreply_to    bool
+	attachments        map[uuid.UUID]struct{}
+	removedattachments map[uuid.UUID]struct{}
+	clearedattachments bool
+	done               bool
+	oldValue           func(context.Context) (*ScheduledMessage, error)
+	predicates         []predicate.ScheduledMessage
+}
+
+var _ ent.Mutation = (*ScheduledMessageMutation)(nil)
+
+// scheduledmessageOption allows management of the mutation configuration using functional options.
+type scheduledmessageOption func(*ScheduledMessageMutation)
+
+// newScheduledMessageMutation creates new mutation for the ScheduledMessage entity.
+func newScheduledMessageMutation(c config, op Op, opts ...scheduledmessageOption) *ScheduledMessageMutation {
+	m := &ScheduledMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScheduledMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScheduledMessageID sets the ID field of the mutation.
+func withScheduledMessageID(id uuid.UUID) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ScheduledMessage
+		)
+		m.oldValue = func(ctx context.Context) (*ScheduledMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ScheduledMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScheduledMessage sets the old ScheduledMessage of the mutation.
+func withScheduledMessage(node *ScheduledMessage) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		m.oldValue = func(context.Context) (*ScheduledMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduledMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduledMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ScheduledMessage entities.
+func (m *ScheduledMessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduledMessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduledMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ScheduledMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScheduledMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScheduledMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScheduledMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ScheduledMessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ScheduledMessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ScheduledMessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *ScheduledMessageMutation) SetChatID(u uuid.UUID) {
+	m.chat = &u
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ScheduledMessageMutation) ChatID() (r uuid.UUID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldChatID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ScheduledMessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetSenderID sets the "sender_id" field.
+func (m *ScheduledMessageMutation) SetSenderID(u uuid.UUID) {
+	m.sender = &u
+}
+
+// SenderID returns the value of the "sender_id" field in the mutation.
+func (m *ScheduledMessageMutation) SenderID() (r uuid.UUID, exists bool) {
+	v := m.sender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderID returns the old "sender_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldSenderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderID: %w", err)
+	}
+	return oldValue.SenderID, nil
+}
+
+// ResetSenderID resets all changes to the "sender_id" field.
+func (m *ScheduledMessageMutation) ResetSenderID() {
+	m.sender = nil
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (m *ScheduledMessageMutation) SetReplyToID(u uuid.UUID) {
+	m.reply_to = &u
+}
+
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *ScheduledMessageMutation) ReplyToID() (r uuid.UUID, exists bool) {
+	v := m.reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToID returns the old "reply_to_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldReplyToID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (m *ScheduledMessageMutation) ClearReplyToID() {
+	m.reply_to = nil
+	m.clearedFields[scheduledmessage.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) ReplyToIDCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldReplyToID]
+	return ok
+}
+
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *ScheduledMessageMutation) ResetReplyToID() {
+	m.reply_to = nil
+	delete(m.clearedFields, scheduledmessage.FieldReplyToID)
+}
+
+// SetContent sets the "content" field.
+func (m *ScheduledMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ScheduledMessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldContent(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *ScheduledMessageMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[scheduledmessage.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ScheduledMessageMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, scheduledmessage.FieldContent)
+}
+
+// SetSendAt sets the "send_at" field.
+func (m *ScheduledMessageMutation) SetSendAt(t time.Time) {
+	m.send_at = &t
+}
+
+// SendAt returns the value of the "send_at" field in the mutation.
+func (m *ScheduledMessageMutation) SendAt() (r time.Time, exists bool) {
+	v := m.send_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendAt returns the old "send_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldSendAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendAt: %w", err)
+	}
+	return oldValue.SendAt, nil
+}
+
+// ResetSendAt resets all changes to the "send_at" field.
+func (m *ScheduledMessageMutation) ResetSendAt() {
+	m.send_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ScheduledMessageMutation) SetStatus(s scheduledmessage.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduledMessageMutation) Status() (r scheduledmessage.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldStatus(ctx context.Context) (v scheduledmessage.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduledMessageMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (m *ScheduledMessageMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
+}
+
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *ScheduledMessageMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failure_reason" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldFailureReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (m *ScheduledMessageMutation) ClearFailureReason() {
+	m.failure_reason = nil
+	m.clearedFields[scheduledmessage.FieldFailureReason] = struct{}{}
+}
+
+// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldFailureReason]
+	return ok
+}
+
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *ScheduledMessageMutation) ResetFailureReason() {
+	m.failure_reason = nil
+	delete(m.clearedFields, scheduledmessage.FieldFailureReason)
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *ScheduledMessageMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[scheduledmessage.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *ScheduledMessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *ScheduledMessageMutation) ChatIDs() (ids []uuid.UUID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *ScheduledMessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (m *ScheduledMessageMutation) ClearSender() {
+	m.clearedsender = true
+	m.clearedFields[scheduledmessage.FieldSenderID] = struct{}{}
+}
+
+// SenderCleared reports if the "sender" edge to the User entity was cleared.
+func (m *ScheduledMessageMutation) SenderCleared() bool {
+	return m.clearedsender
+}
+
+// SenderIDs returns the "sender" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SenderID instead. It exists only for internal usage by the builders.
+func (m *ScheduledMessageMutation) SenderIDs() (ids []uuid.UUID) {
+	if id := m.sender; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSender resets all changes to the "sender" edge.
+func (m *ScheduledMessageMutation) ResetSender() {
+	m.sender = nil
+	m.clearedsender = false
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (m *ScheduledMessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+	m.clearedFields[scheduledmessage.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToCleared reports if the "reply_to" edge to the Message entity was cleared.
+func (m *ScheduledMessageMutation) ReplyToCleared() bool {
+	return m.ReplyToIDCleared() || m.clearedreply_to
+}
+
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *ScheduledMessageMutation) ReplyToIDs() (ids []uuid.UUID) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *ScheduledMessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by ids.
+func (m *ScheduledMessageMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Media entity.
+func (m *ScheduledMessageMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Media entity was cleared.
+func (m *ScheduledMessageMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Media entity by IDs.
+func (m *ScheduledMessageMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Media entity.
+func (m *ScheduledMessageMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *ScheduledMessageMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *ScheduledMessageMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// Where appends a list predicates to the ScheduledMessageMutation builder.
+func (m *ScheduledMessageMutation) Where(ps ...predicate.ScheduledMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScheduledMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScheduledMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduledMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScheduledMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScheduledMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ScheduledMessage).
+func (m *ScheduledMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScheduledMessageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, scheduledmessage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, scheduledmessage.FieldUpdatedAt)
+	}
+	if m.chat != nil {
+		fields = append(fields, scheduledmessage.FieldChatID)
+	}
+	if m.sender != nil {
+		fields = append(fields, scheduledmessage.FieldSenderID)
+	}
+	if m.reply_to != nil {
+		fields = append(fields, scheduledmessage.FieldReplyToID)
+	}
+	if m.content != nil {
+		fields = append(fields, scheduledmessage.FieldContent)
+	}
+	if m.send_at != nil {
+		fields = append(fields, scheduledmessage.FieldSendAt)
+	}
+	if m.status != nil {
+		fields = append(fields, scheduledmessage.FieldStatus)
+	}
+	if m.failure_reason != nil {
+		fields = append(fields, scheduledmessage.FieldFailureReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScheduledMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledmessage.FieldCreatedAt:
+		return m.CreatedAt()
+	case scheduledmessage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case scheduledmessage.FieldChatID:
+		return m.ChatID()
+	case scheduledmessage.FieldSenderID:
+		return m.SenderID()
+	case scheduledmessage.FieldReplyToID:
+		return m.ReplyToID()
+	case scheduledmessage.FieldContent:
+		return m.Content()
+	case scheduledmessage.FieldSendAt:
+		return m.SendAt()
+	case scheduledmessage.FieldStatus:
+		return m.Status()
+	case scheduledmessage.FieldFailureReason:
+		return m.FailureReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScheduledMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scheduledmessage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case scheduledmessage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case scheduledmessage.FieldChatID:
+		return m.OldChatID(ctx)
+	case scheduledmessage.FieldSenderID:
+		return m.OldSenderID(ctx)
+	case scheduledmessage.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case scheduledmessage.FieldContent:
+		return m.OldContent(ctx)
+	case scheduledmessage.FieldSendAt:
+		return m.OldSendAt(ctx)
+	case scheduledmessage.FieldStatus:
+		return m.OldStatus(ctx)
+	case scheduledmessage.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown ScheduledMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scheduledmessage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case scheduledmessage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case scheduledmessage.FieldChatID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case scheduledmessage.FieldSenderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderID(v)
+		return nil
+	case scheduledmessage.FieldReplyToID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case scheduledmessage.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case scheduledmessage.FieldSendAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendAt(v)
+		return nil
+	case scheduledmessage.FieldStatus:
+		v, ok := value.(scheduledmessage.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scheduledmessage.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScheduledMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScheduledMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScheduledMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ScheduledMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScheduledMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scheduledmessage.FieldReplyToID) {
+		fields = append(fields, scheduledmessage.FieldReplyToID)
+	}
+	if m.FieldCleared(scheduledmessage.FieldContent) {
+		fields = append(fields, scheduledmessage.FieldContent)
+	}
+	if m.FieldCleared(scheduledmessage.FieldFailureReason) {
+		fields = append(fields, scheduledmessage.FieldFailureReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScheduledMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScheduledMessageMutation) ClearField(name string) error {
+	switch name {
+	case scheduledmessage.FieldReplyToID:
+		m.ClearReplyToID()
+		return nil
+	case scheduledmessage.FieldContent:
+		m.ClearContent()
+		return nil
+	case scheduledmessage.FieldFailureReason:
+		m.ClearFailureReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScheduledMessageMutation) ResetField(name string) error {
+	switch name {
+	case scheduledmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case scheduledmessage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case scheduledmessage.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case scheduledmessage.FieldSenderID:
+		m.ResetSenderID()
+		return nil
+	case scheduledmessage.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case scheduledmessage.FieldContent:
+		m.ResetContent()
+		return nil
+	case scheduledmessage.FieldSendAt:
+		m.ResetSendAt()
+		return nil
+	case scheduledmessage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scheduledmessage.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScheduledMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.chat != nil {
+		edges = append(edges, scheduledmessage.EdgeChat)
+	}
+	if m.sender != nil {
+		edges = append(edges, scheduledmessage.EdgeSender)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, scheduledmessage.EdgeReplyTo)
+	}
+	if m.attachments != nil {
+		edges = append(edges, scheduledmessage.EdgeAttachments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScheduledMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scheduledmessage.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case scheduledmessage.EdgeSender:
+		if id := m.sender; id != nil {
+			return []ent.Value{*id}
+		}
+	case scheduledmessage.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case scheduledmessage.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduledMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedattachments != nil {
+		edges = append(edges, scheduledmessage.EdgeAttachments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScheduledMessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case scheduledmessage.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScheduledMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedchat {
+		edges = append(edges, scheduledmessage.EdgeChat)
+	}
+	if m.clearedsender {
+		edges = append(edges, scheduledmessage.EdgeSender)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, scheduledmessage.EdgeReplyTo)
+	}
+	if m.clearedattachments {
+		edges = append(edges, scheduledmessage.EdgeAttachments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScheduledMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scheduledmessage.EdgeChat:
+		return m.clearedchat
+	case scheduledmessage.EdgeSender:
+		return m.clearedsender
+	case scheduledmessage.EdgeReplyTo:
+		return m.clearedreply_to
+	case scheduledmessage.EdgeAttachments:
+		return m.clearedattachments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScheduledMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case scheduledmessage.EdgeChat:
+		m.ClearChat()
+		return nil
+	case scheduledmessage.EdgeSender:
+		m.ClearSender()
+		return nil
+	case scheduledmessage.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScheduledMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case scheduledmessage.EdgeChat:
+		m.ResetChat()
+		return nil
+	case scheduledmessage.EdgeSender:
+		m.ResetSender()
+		return nil
+	case scheduledmessage.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case scheduledmessage.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	email                         *string
+	username                      *string
+	password_hash                 *string
+	full_name                     *string
+	bio                           *string
+	last_seen_at                  *time.Time
+	deleted_at                    *time.Time
+	role                          *user.Role
+	is_banned                     *bool
+	banned_until                  *time.Time
+	ban_reason                    *string
+	clearedFields                 map[string]struct{}
+	avatar                        *uuid.UUID
+	clearedavatar                 bool
+	identities                    map[uuid.UUID]struct{}
+	removedidentities             map[uuid.UUID]struct{}
+	clearedidentities             bool
+	sent_messages                 map[uuid.UUID]struct{}
+	removedsent_messages          map[uuid.UUID]struct{}
+	clearedsent_messages          bool
+	created_groups                map[uuid.UUID]struct{}
+	removedcreated_groups         map[uuid.UUID]struct{}
+	clearedcreated_groups         bool
+	group_memberships             map[uuid.UUID]struct{}
+	removedgroup_memberships      map[uuid.UUID]struct{}
+	clearedgroup_memberships      bool
+	private_chats_as_user1        map[uuid.UUID]struct{}
+	removedprivate_chats_as_user1 map[uuid.UUID]struct{}
+	clearedprivate_chats_as_user1 bool
+	private_chats_as_user2        map[uuid.UUID]struct{}
+	removedprivate_chats_as_user2 map[uuid.UUID]struct{}
+	clearedprivate_chats_as_user2 bool
+	uploaded_media                map[uuid.UUID]struct{}
+	removeduploaded_media         map[uuid.UUID]struct{}
+	cleareduploaded_media         bool
+	blocked_users_rel             map[uuid.UUID]struct{}
+	removedblocked_users_rel      map[uuid.UUID]struct{}
+	clearedblocked_users_rel      bool
+	blocked_by_rel                map[uuid.UUID]struct{}
+	removedblocked_by_rel         map[uuid.UUID]struct{}
+	clearedblocked_by_rel         bool
+	message_reactions             map[uuid.UUID]struct{}
+	removedmessage_reactions      map[uuid.UUID]struct{}
+	clearedmessage_reactions      bool
+	pinned_messages               map[uuid.UUID]struct{}
+	removedpinned_messages        map[uuid.UUID]struct{}
+	clearedpinned_messages        bool
+	scheduled_messages            map[uuid.UUID]struct{}
+	removedscheduled_messages     map[uuid.UUID]struct{}
+	clearedscheduled_messages     bool
+	mentions                      map[uuid.UUID]struct{}
+	removedmentions               map[uuid.UUID]struct{}
+	clearedmentions               bool
+	poll_votes                    map[uuid.UUID]struct{}
+	removedpoll_votes             map[uuid.UUID]struct{}
+	clearedpoll_votes             bool
+	chat_exports                  map[uuid.UUID]struct{}
+	removedchat_exports           map[uuid.UUID]struct{}
+	clearedchat_exports           bool
+	pinned_chats                  map[uuid.UUID]struct{}
+	removedpinned_chats           map[uuid.UUID]struct{}
+	clearedpinned_chats           bool
+	chat_folders                  map[uuid.UUID]struct{}
+	removedchat_folders           map[uuid.UUID]struct{}
+	clearedchat_folders           bool
+	group_join_requests           map[uuid.UUID]struct{}
+	removedgroup_join_requests    map[uuid.UUID]struct{}
+	clearedgroup_join_requests    bool
+	reports_made                  map[uuid.UUID]struct{}
+	removedreports_made           map[uuid.UUID]struct{}
+	clearedreports_made           bool
+	reports_received              map[uuid.UUID]struct{}
+	removedreports_received       map[uuid.UUID]struct{}
+	clearedreports_received       bool
+	done                          bool
+	oldValue                      func(context.Context) (*User, error)
+	predicates                    []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ClearUsername clears the value of the "username" field.
+func (m *UserMutation) ClearUsername() {
+	m.username = nil
+	m.clearedFields[user.FieldUsername] = struct{}{}
+}
+
+// UsernameCleared returns if the "username" field was cleared in this mutation.
+func (m *UserMutation) UsernameCleared() bool {
+	_, ok := m.clearedFields[user.FieldUsername]
+	return ok
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+	delete(m.clearedFields, user.FieldUsername)
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *UserMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[user.FieldPasswordHash] = struct{}{}
+}
+
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *UserMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordHash]
+	return ok
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, user.FieldPasswordHash)
+}
+
+// SetFullName sets the "full_name" field.
+func (m *UserMutation) SetFullName(s string) {
+	m.full_name = &s
+}
+
+// FullName returns the value of the "full_name" field in the mutation.
+func (m *UserMutation) FullName() (r string, exists bool) {
+	v := m.full_name
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Report numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ReportMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(report.FieldDescription) {
-		fields = append(fields, report.FieldDescription)
-	}
-	if m.FieldCleared(report.FieldEvidenceSnapshot) {
-		fields = append(fields, report.FieldEvidenceSnapshot)
-	}
-	if m.FieldCleared(report.FieldReporterID) {
-		fields = append(fields, report.FieldReporterID)
-	}
-	if m.FieldCleared(report.FieldMessageID) {
-		fields = append(fields, report.FieldMessageID)
-	}
-	if m.FieldCleared(report.FieldGroupID) {
-		fields = append(fields, report.FieldGroupID)
-	}
-	if m.FieldCleared(report.FieldTargetUserID) {
-		fields = append(fields, report.FieldTargetUserID)
-	}
-	if m.FieldCleared(report.FieldResolutionNotes) {
-		fields = append(fields, report.FieldResolutionNotes)
+// OldFullName returns the old "full_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFullName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullName is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(report.FieldResolvedAt) {
-		fields = append(fields, report.FieldResolvedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullName requires an ID field in the mutation")
 	}
-	if m.FieldCleared(report.FieldResolvedByID) {
-		fields = append(fields, report.FieldResolvedByID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullName: %w", err)
 	}
-	return fields
+	return oldValue.FullName, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ReportMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearFullName clears the value of the "full_name" field.
+func (m *UserMutation) ClearFullName() {
+	m.full_name = nil
+	m.clearedFields[user.FieldFullName] = struct{}{}
+}
+
+// FullNameCleared returns if the "full_name" field was cleared in this mutation.
+func (m *UserMutation) FullNameCleared() bool {
+	_, ok := m.clearedFields[user.FieldFullName]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ReportMutation) ClearField(name string) error {
-	switch name {
-	case report.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case report.FieldEvidenceSnapshot:
-		m.ClearEvidenceSnapshot()
-		return nil
-	case report.FieldReporterID:
-		m.ClearReporterID()
-		return nil
-	case report.FieldMessageID:
-		m.ClearMessageID()
-		return nil
-	case report.FieldGroupID:
-		m.ClearGroupID()
-		return nil
-	case report.FieldTargetUserID:
-		m.ClearTargetUserID()
-		return nil
-	case report.FieldResolutionNotes:
-		m.ClearResolutionNotes()
-		return nil
-	case report.FieldResolvedAt:
-		m.ClearResolvedAt()
-		return nil
-	case report.FieldResolvedByID:
-		m.ClearResolvedByID()
-		return nil
-	}
-	return fmt.Errorf("unknown Report nullable field %s", name)
+// ResetFullName resets all changes to the "full_name" field.
+func (m *UserMutation) ResetFullName() {
+	m.full_name = nil
+	delete(m.clearedFields, user.FieldFullName)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ReportMutation) ResetField(name string) error {
-	switch name {
-	case report.FieldTargetType:
-		m.ResetTargetType()
-		return nil
-	case report.FieldReason:
-		m.ResetReason()
-		return nil
-	case report.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case report.FieldEvidenceSnapshot:
-		m.ResetEvidenceSnapshot()
-		return nil
-	case report.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case report.FieldReporterID:
-		m.ResetReporterID()
-		return nil
-	case report.FieldMessageID:
-		m.ResetMessageID()
-		return nil
-	case report.FieldGroupID:
-		m.ResetGroupID()
-		return nil
-	case report.FieldTargetUserID:
-		m.ResetTargetUserID()
-		return nil
-	case report.FieldResolutionNotes:
-		m.ResetResolutionNotes()
-		return nil
-	case report.FieldResolvedAt:
-		m.ResetResolvedAt()
-		return nil
-	case report.FieldResolvedByID:
-		m.ResetResolvedByID()
-		return nil
-	case report.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case report.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Report field %s", name)
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReportMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.reporter != nil {
-		edges = append(edges, report.EdgeReporter)
-	}
-	if m.message != nil {
-		edges = append(edges, report.EdgeMessage)
-	}
-	if m.group != nil {
-		edges = append(edges, report.EdgeGroup)
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
 	}
-	if m.target_user != nil {
-		edges = append(edges, report.EdgeTargetUser)
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
 	}
-	if m.evidence_media != nil {
-		edges = append(edges, report.EdgeEvidenceMedia)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
 	}
-	if m.resolved_by != nil {
-		edges = append(edges, report.EdgeResolvedBy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
 	}
-	return edges
+	return oldValue.Bio, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ReportMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case report.EdgeReporter:
-		if id := m.reporter; id != nil {
-			return []ent.Value{*id}
-		}
-	case report.EdgeMessage:
-		if id := m.message; id != nil {
-			return []ent.Value{*id}
-		}
-	case report.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
-		}
-	case report.EdgeTargetUser:
-		if id := m.target_user; id != nil {
-			return []ent.Value{*id}
-		}
-	case report.EdgeEvidenceMedia:
-		ids := make([]ent.Value, 0, len(m.evidence_media))
-		for id := range m.evidence_media {
-			ids = append(ids, id)
-		}
-		return ids
-	case report.EdgeResolvedBy:
-		if id := m.resolved_by; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReportMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.removedevidence_media != nil {
-		edges = append(edges, report.EdgeEvidenceMedia)
-	}
-	return edges
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ReportMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case report.EdgeEvidenceMedia:
-		ids := make([]ent.Value, 0, len(m.removedevidence_media))
-		for id := range m.removedevidence_media {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetAvatarID sets the "avatar_id" field.
+func (m *UserMutation) SetAvatarID(u uuid.UUID) {
+	m.avatar = &u
+}
+
+// AvatarID returns the value of the "avatar_id" field in the mutation.
+func (m *UserMutation) AvatarID() (r uuid.UUID, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReportMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.clearedreporter {
-		edges = append(edges, report.EdgeReporter)
-	}
-	if m.clearedmessage {
-		edges = append(edges, report.EdgeMessage)
-	}
-	if m.clearedgroup {
-		edges = append(edges, report.EdgeGroup)
-	}
-	if m.clearedtarget_user {
-		edges = append(edges, report.EdgeTargetUser)
+// OldAvatarID returns the old "avatar_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatarID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
 	}
-	if m.clearedevidence_media {
-		edges = append(edges, report.EdgeEvidenceMedia)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarID requires an ID field in the mutation")
 	}
-	if m.clearedresolved_by {
-		edges = append(edges, report.EdgeResolvedBy)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
 	}
-	return edges
+	return oldValue.AvatarID, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ReportMutation) EdgeCleared(name string) bool {
-	switch name {
-	case report.EdgeReporter:
-		return m.clearedreporter
-	case report.EdgeMessage:
-		return m.clearedmessage
-	case report.EdgeGroup:
-		return m.clearedgroup
-	case report.EdgeTargetUser:
-		return m.clearedtarget_user
-	case report.EdgeEvidenceMedia:
-		return m.clearedevidence_media
-	case report.EdgeResolvedBy:
-		return m.clearedresolved_by
-	}
-	return false
+// ClearAvatarID clears the value of the "avatar_id" field.
+func (m *UserMutation) ClearAvatarID() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatarID] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ReportMutation) ClearEdge(name string) error {
-	switch name {
-	case report.EdgeReporter:
-		m.ClearReporter()
-		return nil
-	case report.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	case report.EdgeGroup:
-		m.ClearGroup()
-		return nil
-	case report.EdgeTargetUser:
-		m.ClearTargetUser()
-		return nil
-	case report.EdgeResolvedBy:
-		m.ClearResolvedBy()
-		return nil
-	}
-	return fmt.Errorf("unknown Report unique edge %s", name)
+// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
+func (m *UserMutation) AvatarIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatarID]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ReportMutation) ResetEdge(name string) error {
-	switch name {
-	case report.EdgeReporter:
-		m.ResetReporter()
-		return nil
-	case report.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	case report.EdgeGroup:
-		m.ResetGroup()
-		return nil
-	case report.EdgeTargetUser:
-		m.ResetTargetUser()
-		return nil
-	case report.EdgeEvidenceMedia:
-		m.ResetEvidenceMedia()
-		return nil
-	case report.EdgeResolvedBy:
-		m.ResetResolvedBy()
-		return nil
-	}
-	return fmt.Errorf("unknown Report edge %s", name)
+// ResetAvatarID resets all changes to the "avatar_id" field.
+func (m *UserMutation) ResetAvatarID() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatarID)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                            Op
-	typ                           string
-	id                            *uuid.UUID
-	created_at                    *time.Time
-	updated_at                    *time.Time
-	email                         *string
-	username                      *string
-	password_hash                 *string
-	full_name                     *string
-	bio                           *string
-	last_seen_at                  *time.Time
-	deleted_at                    *time.Time
-	role                          *user.Role
-	is_banned                     *bool
-	banned_until                  *time.Time
-	ban_reason                    *string
-	clearedFields                 map[string]struct{}
-	avatar                        *uuid.UUID
-	clearedavatar                 bool
-	identities                    map[uuid.UUID]struct{}
-	removedidentities             map[uuid.UUID]struct{}
-	clearedidentities             bool
-	sent_messages                 map[uuid.UUID]struct{}
-	removedsent_messages          map[uuid.UUID]struct{}
-	clearedsent_messages          bool
-	created_groups                map[uuid.UUID]struct{}
-	removedcreated_groups         map[uuid.UUID]struct{}
-	clearedcreated_groups         bool
-	group_memberships             map[uuid.UUID]struct{}
-	removedgroup_memberships      map[uuid.UUID]struct{}
-	clearedgroup_memberships      bool
-	private_chats_as_user1        map[uuid.UUID]struct{}
-	removedprivate_chats_as_user1 map[uuid.UUID]struct{}
-	clearedprivate_chats_as_user1 bool
-	private_chats_as_user2        map[uuid.UUID]struct{}
-	removedprivate_chats_as_user2 map[uuid.UUID]struct{}
-	clearedprivate_chats_as_user2 bool
-	uploaded_media                map[uuid.UUID]struct{}
-	removeduploaded_media         map[uuid.UUID]struct{}
-	cleareduploaded_media         bool
-	blocked_users_rel             map[uuid.UUID]struct{}
-	removedblocked_users_rel      map[uuid.UUID]struct{}
-	clearedblocked_users_rel      bool
-	blocked_by_rel                map[uuid.UUID]struct{}
-	removedblocked_by_rel         map[uuid.UUID]struct{}
-	clearedblocked_by_rel         bool
-	reports_made                  map[uuid.UUID]struct{}
-	removedreports_made           map[uuid.UUID]struct{}
-	clearedreports_made           bool
-	reports_received              map[uuid.UUID]struct{}
-	removedreports_received       map[uuid.UUID]struct{}
-	clearedreports_received       bool
-	done                          bool
-	oldValue                      func(context.Context) (*User, error)
-	predicates                    []predicate.User
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *UserMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *UserMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeenAt]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, user.FieldLastSeenAt)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
+	return oldValue.DeletedAt, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// OldRole returns the old "role" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Role, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetIsBanned sets the "is_banned" field.
+func (m *UserMutation) SetIsBanned(b bool) {
+	m.is_banned = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// IsBanned returns the value of the "is_banned" field in the mutation.
+func (m *UserMutation) IsBanned() (r bool, exists bool) {
+	v := m.is_banned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// OldIsBanned returns the old "is_banned" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsBanned requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.IsBanned, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetIsBanned resets all changes to the "is_banned" field.
+func (m *UserMutation) ResetIsBanned() {
+	m.is_banned = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetBannedUntil sets the "banned_until" field.
+func (m *UserMutation) SetBannedUntil(t time.Time) {
+	m.banned_until = &t
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// BannedUntil returns the value of the "banned_until" field in the mutation.
+func (m *UserMutation) BannedUntil() (r time.Time, exists bool) {
+	v := m.banned_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
+// OldBannedUntil returns the old "banned_until" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+func (m *UserMutation) OldBannedUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldBannedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldBannedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldBannedUntil: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.BannedUntil, nil
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *UserMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[user.FieldEmail] = struct{}{}
+// ClearBannedUntil clears the value of the "banned_until" field.
+func (m *UserMutation) ClearBannedUntil() {
+	m.banned_until = nil
+	m.clearedFields[user.FieldBannedUntil] = struct{}{}
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *UserMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[user.FieldEmail]
+// BannedUntilCleared returns if the "banned_until" field was cleared in this mutation.
+func (m *UserMutation) BannedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldBannedUntil]
 	return ok
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, user.FieldEmail)
+// ResetBannedUntil resets all changes to the "banned_until" field.
+func (m *UserMutation) ResetBannedUntil() {
+	m.banned_until = nil
+	delete(m.clearedFields, user.FieldBannedUntil)
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// SetBanReason sets the "ban_reason" field.
+func (m *UserMutation) SetBanReason(s string) {
+	m.ban_reason = &s
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// BanReason returns the value of the "ban_reason" field in the mutation.
+func (m *UserMutation) BanReason() (r string, exists bool) {
+	v := m.ban_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
+// OldBanReason returns the old "ban_reason" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v *string, err error) {
+func (m *UserMutation) OldBanReason(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldBanReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldBanReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldBanReason: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.BanReason, nil
 }
 
-// ClearUsername clears the value of the "username" field.
-func (m *UserMutation) ClearUsername() {
-	m.username = nil
-	m.clearedFields[user.FieldUsername] = struct{}{}
+// ClearBanReason clears the value of the "ban_reason" field.
+func (m *UserMutation) ClearBanReason() {
+	m.ban_reason = nil
+	m.clearedFields[user.FieldBanReason] = struct{}{}
 }
 
-// UsernameCleared returns if the "username" field was cleared in this mutation.
-func (m *UserMutation) UsernameCleared() bool {
-	_, ok := m.clearedFields[user.FieldUsername]
+// BanReasonCleared returns if the "ban_reason" field was cleared in this mutation.
+func (m *UserMutation) BanReasonCleared() bool {
+	_, ok := m.clearedFields[user.FieldBanReason]
 	return ok
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
-	delete(m.clearedFields, user.FieldUsername)
+// ResetBanReason resets all changes to the "ban_reason" field.
+func (m *UserMutation) ResetBanReason() {
+	m.ban_reason = nil
+	delete(m.clearedFields, user.FieldBanReason)
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// ClearAvatar clears the "avatar" edge to the Media entity.
+func (m *UserMutation) ClearAvatar() {
+	m.clearedavatar = true
+	m.clearedFields[user.FieldAvatarID] = struct{}{}
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
-	if v == nil {
-		return
-	}
-	return *v, true
+// AvatarCleared reports if the "avatar" edge to the Media entity was cleared.
+func (m *UserMutation) AvatarCleared() bool {
+	return m.AvatarIDCleared() || m.clearedavatar
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+// AvatarIDs returns the "avatar" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AvatarID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) AvatarIDs() (ids []uuid.UUID) {
+	if id := m.avatar; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	return
+}
+
+// ResetAvatar resets all changes to the "avatar" edge.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	m.clearedavatar = false
+}
+
+// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...uuid.UUID) {
+	if m.identities == nil {
+		m.identities = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
 	}
-	return oldValue.PasswordHash, nil
 }
 
-// ClearPasswordHash clears the value of the "password_hash" field.
-func (m *UserMutation) ClearPasswordHash() {
-	m.password_hash = nil
-	m.clearedFields[user.FieldPasswordHash] = struct{}{}
+// ClearIdentities clears the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
 }
 
-// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
-func (m *UserMutation) PasswordHashCleared() bool {
-	_, ok := m.clearedFields[user.FieldPasswordHash]
-	return ok
+// IdentitiesCleared reports if the "identities" edge to the UserIdentity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
-	delete(m.clearedFields, user.FieldPasswordHash)
+// RemoveIdentityIDs removes the "identities" edge to the UserIdentity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...uuid.UUID) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
+	}
 }
 
-// SetFullName sets the "full_name" field.
-func (m *UserMutation) SetFullName(s string) {
-	m.full_name = &s
+// RemovedIdentities returns the removed IDs of the "identities" edge to the UserIdentity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// FullName returns the value of the "full_name" field in the mutation.
-func (m *UserMutation) FullName() (r string, exists bool) {
-	v := m.full_name
-	if v == nil {
-		return
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []uuid.UUID) {
+	for id := range m.identities {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldFullName returns the old "full_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFullName(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFullName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFullName requires an ID field in the mutation")
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
+}
+
+// AddSentMessageIDs adds the "sent_messages" edge to the Message entity by ids.
+func (m *UserMutation) AddSentMessageIDs(ids ...uuid.UUID) {
+	if m.sent_messages == nil {
+		m.sent_messages = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFullName: %w", err)
+	for i := range ids {
+		m.sent_messages[ids[i]] = struct{}{}
 	}
-	return oldValue.FullName, nil
 }
 
-// ClearFullName clears the value of the "full_name" field.
-func (m *UserMutation) ClearFullName() {
-	m.full_name = nil
-	m.clearedFields[user.FieldFullName] = struct{}{}
+// ClearSentMessages clears the "sent_messages" edge to the Message entity.
+func (m *UserMutation) ClearSentMessages() {
+	m.clearedsent_messages = true
 }
 
-// FullNameCleared returns if the "full_name" field was cleared in this mutation.
-func (m *UserMutation) FullNameCleared() bool {
-	_, ok := m.clearedFields[user.FieldFullName]
-	return ok
+// SentMessagesCleared reports if the "sent_messages" edge to the Message entity was cleared.
+func (m *UserMutation) SentMessagesCleared() bool {
+	return m.clearedsent_messages
 }
 
-// ResetFullName resets all changes to the "full_name" field.
-func (m *UserMutation) ResetFullName() {
-	m.full_name = nil
-	delete(m.clearedFields, user.FieldFullName)
+// RemoveSentMessageIDs removes the "sent_messages" edge to the Message entity by IDs.
+func (m *UserMutation) RemoveSentMessageIDs(ids ...uuid.UUID) {
+	if m.removedsent_messages == nil {
+		m.removedsent_messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sent_messages, ids[i])
+		m.removedsent_messages[ids[i]] = struct{}{}
+	}
 }
 
-// SetBio sets the "bio" field.
-func (m *UserMutation) SetBio(s string) {
-	m.bio = &s
+// RemovedSentMessages returns the removed IDs of the "sent_messages" edge to the Message entity.
+func (m *UserMutation) RemovedSentMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsent_messages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *UserMutation) Bio() (r string, exists bool) {
-	v := m.bio
-	if v == nil {
-		return
+// SentMessagesIDs returns the "sent_messages" edge IDs in the mutation.
+func (m *UserMutation) SentMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.sent_messages {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldBio returns the old "bio" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBio(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+// ResetSentMessages resets all changes to the "sent_messages" edge.
+func (m *UserMutation) ResetSentMessages() {
+	m.sent_messages = nil
+	m.clearedsent_messages = false
+	m.removedsent_messages = nil
+}
+
+// AddCreatedGroupIDs adds the "created_groups" edge to the GroupChat entity by ids.
+func (m *UserMutation) AddCreatedGroupIDs(ids ...uuid.UUID) {
+	if m.created_groups == nil {
+		m.created_groups = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	for i := range ids {
+		m.created_groups[ids[i]] = struct{}{}
 	}
-	return oldValue.Bio, nil
 }
 
-// ClearBio clears the value of the "bio" field.
-func (m *UserMutation) ClearBio() {
-	m.bio = nil
-	m.clearedFields[user.FieldBio] = struct{}{}
+// ClearCreatedGroups clears the "created_groups" edge to the GroupChat entity.
+func (m *UserMutation) ClearCreatedGroups() {
+	m.clearedcreated_groups = true
 }
 
-// BioCleared returns if the "bio" field was cleared in this mutation.
-func (m *UserMutation) BioCleared() bool {
-	_, ok := m.clearedFields[user.FieldBio]
-	return ok
+// CreatedGroupsCleared reports if the "created_groups" edge to the GroupChat entity was cleared.
+func (m *UserMutation) CreatedGroupsCleared() bool {
+	return m.clearedcreated_groups
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *UserMutation) ResetBio() {
-	m.bio = nil
-	delete(m.clearedFields, user.FieldBio)
+// RemoveCreatedGroupIDs removes the "created_groups" edge to the GroupChat entity by IDs.
+func (m *UserMutation) RemoveCreatedGroupIDs(ids ...uuid.UUID) {
+	if m.removedcreated_groups == nil {
+		m.removedcreated_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.created_groups, ids[i])
+		m.removedcreated_groups[ids[i]] = struct{}{}
+	}
 }
 
-// SetAvatarID sets the "avatar_id" field.
-func (m *UserMutation) SetAvatarID(u uuid.UUID) {
-	m.avatar = &u
+// RemovedCreatedGroups returns the removed IDs of the "created_groups" edge to the GroupChat entity.
+func (m *UserMutation) RemovedCreatedGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_groups {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AvatarID returns the value of the "avatar_id" field in the mutation.
-func (m *UserMutation) AvatarID() (r uuid.UUID, exists bool) {
-	v := m.avatar
-	if v == nil {
-		return
+// CreatedGroupsIDs returns the "created_groups" edge IDs in the mutation.
+func (m *UserMutation) CreatedGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.created_groups {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldAvatarID returns the old "avatar_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatarID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatarID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatarID requires an ID field in the mutation")
+// ResetCreatedGroups resets all changes to the "created_groups" edge.
+func (m *UserMutation) ResetCreatedGroups() {
+	m.created_groups = nil
+	m.clearedcreated_groups = false
+	m.removedcreated_groups = nil
+}
+
+// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMember entity by ids.
+func (m *UserMutation) AddGroupMembershipIDs(ids ...uuid.UUID) {
+	if m.group_memberships == nil {
+		m.group_memberships = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatarID: %w", err)
+	for i := range ids {
+		m.group_memberships[ids[i]] = struct{}{}
 	}
-	return oldValue.AvatarID, nil
 }
 
-// ClearAvatarID clears the value of the "avatar_id" field.
-func (m *UserMutation) ClearAvatarID() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatarID] = struct{}{}
+// ClearGroupMemberships clears the "group_memberships" edge to the GroupMember entity.
+func (m *UserMutation) ClearGroupMemberships() {
+	m.clearedgroup_memberships = true
 }
 
-// AvatarIDCleared returns if the "avatar_id" field was cleared in this mutation.
-func (m *UserMutation) AvatarIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatarID]
-	return ok
+// GroupMembershipsCleared reports if the "group_memberships" edge to the GroupMember entity was cleared.
+func (m *UserMutation) GroupMembershipsCleared() bool {
+	return m.clearedgroup_memberships
+}
+
+// RemoveGroupMembershipIDs removes the "group_memberships" edge to the GroupMember entity by IDs.
+func (m *UserMutation) RemoveGroupMembershipIDs(ids ...uuid.UUID) {
+	if m.removedgroup_memberships == nil {
+		m.removedgroup_memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.group_memberships, ids[i])
+		m.removedgroup_memberships[ids[i]] = struct{}{}
+	}
 }
 
-// ResetAvatarID resets all changes to the "avatar_id" field.
-func (m *UserMutation) ResetAvatarID() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatarID)
+// RemovedGroupMemberships returns the removed IDs of the "group_memberships" edge to the GroupMember entity.
+func (m *UserMutation) RemovedGroupMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroup_memberships {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetLastSeenAt sets the "last_seen_at" field.
-func (m *UserMutation) SetLastSeenAt(t time.Time) {
-	m.last_seen_at = &t
+// GroupMembershipsIDs returns the "group_memberships" edge IDs in the mutation.
+func (m *UserMutation) GroupMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.group_memberships {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
-func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
-	v := m.last_seen_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetGroupMemberships resets all changes to the "group_memberships" edge.
+func (m *UserMutation) ResetGroupMemberships() {
+	m.group_memberships = nil
+	m.clearedgroup_memberships = false
+	m.removedgroup_memberships = nil
 }
 
-// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+// AddPrivateChatsAsUser1IDs adds the "private_chats_as_user1" edge to the PrivateChat entity by ids.
+func (m *UserMutation) AddPrivateChatsAsUser1IDs(ids ...uuid.UUID) {
+	if m.private_chats_as_user1 == nil {
+		m.private_chats_as_user1 = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	for i := range ids {
+		m.private_chats_as_user1[ids[i]] = struct{}{}
 	}
-	return oldValue.LastSeenAt, nil
 }
 
-// ClearLastSeenAt clears the value of the "last_seen_at" field.
-func (m *UserMutation) ClearLastSeenAt() {
-	m.last_seen_at = nil
-	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+// ClearPrivateChatsAsUser1 clears the "private_chats_as_user1" edge to the PrivateChat entity.
+func (m *UserMutation) ClearPrivateChatsAsUser1() {
+	m.clearedprivate_chats_as_user1 = true
 }
 
-// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
-func (m *UserMutation) LastSeenAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastSeenAt]
-	return ok
+// PrivateChatsAsUser1Cleared reports if the "private_chats_as_user1" edge to the PrivateChat entity was cleared.
+func (m *UserMutation) PrivateChatsAsUser1Cleared() bool {
+	return m.clearedprivate_chats_as_user1
 }
 
-// ResetLastSeenAt resets all changes to the "last_seen_at" field.
-func (m *UserMutation) ResetLastSeenAt() {
-	m.last_seen_at = nil
-	delete(m.clearedFields, user.FieldLastSeenAt)
+// RemovePrivateChatsAsUser1IDs removes the "private_chats_as_user1" edge to the PrivateChat entity by IDs.
+func (m *UserMutation) RemovePrivateChatsAsUser1IDs(ids ...uuid.UUID) {
+	if m.removedprivate_chats_as_user1 == nil {
+		m.removedprivate_chats_as_user1 = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.private_chats_as_user1, ids[i])
+		m.removedprivate_chats_as_user1[ids[i]] = struct{}{}
+	}
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// RemovedPrivateChatsAsUser1 returns the removed IDs of the "private_chats_as_user1" edge to the PrivateChat entity.
+func (m *UserMutation) RemovedPrivateChatsAsUser1IDs() (ids []uuid.UUID) {
+	for id := range m.removedprivate_chats_as_user1 {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
+// PrivateChatsAsUser1IDs returns the "private_chats_as_user1" edge IDs in the mutation.
+func (m *UserMutation) PrivateChatsAsUser1IDs() (ids []uuid.UUID) {
+	for id := range m.private_chats_as_user1 {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+// ResetPrivateChatsAsUser1 resets all changes to the "private_chats_as_user1" edge.
+func (m *UserMutation) ResetPrivateChatsAsUser1() {
+	m.private_chats_as_user1 = nil
+	m.clearedprivate_chats_as_user1 = false
+	m.removedprivate_chats_as_user1 = nil
+}
+
+// AddPrivateChatsAsUser2IDs adds the "private_chats_as_user2" edge to the PrivateChat entity by ids.
+func (m *UserMutation) AddPrivateChatsAsUser2IDs(ids ...uuid.UUID) {
+	if m.private_chats_as_user2 == nil {
+		m.private_chats_as_user2 = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for i := range ids {
+		m.private_chats_as_user2[ids[i]] = struct{}{}
 	}
-	return oldValue.DeletedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+// ClearPrivateChatsAsUser2 clears the "private_chats_as_user2" edge to the PrivateChat entity.
+func (m *UserMutation) ClearPrivateChatsAsUser2() {
+	m.clearedprivate_chats_as_user2 = true
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedAt]
-	return ok
+// PrivateChatsAsUser2Cleared reports if the "private_chats_as_user2" edge to the PrivateChat entity was cleared.
+func (m *UserMutation) PrivateChatsAsUser2Cleared() bool {
+	return m.clearedprivate_chats_as_user2
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, user.FieldDeletedAt)
+// RemovePrivateChatsAsUser2IDs removes the "private_chats_as_user2" edge to the PrivateChat entity by IDs.
+func (m *UserMutation) RemovePrivateChatsAsUser2IDs(ids ...uuid.UUID) {
+	if m.removedprivate_chats_as_user2 == nil {
+		m.removedprivate_chats_as_user2 = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.private_chats_as_user2, ids[i])
+		m.removedprivate_chats_as_user2[ids[i]] = struct{}{}
+	}
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(u user.Role) {
-	m.role = &u
+// RemovedPrivateChatsAsUser2 returns the removed IDs of the "private_chats_as_user2" edge to the PrivateChat entity.
+func (m *UserMutation) RemovedPrivateChatsAsUser2IDs() (ids []uuid.UUID) {
+	for id := range m.removedprivate_chats_as_user2 {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r user.Role, exists bool) {
-	v := m.role
-	if v == nil {
-		return
+// PrivateChatsAsUser2IDs returns the "private_chats_as_user2" edge IDs in the mutation.
+func (m *UserMutation) PrivateChatsAsUser2IDs() (ids []uuid.UUID) {
+	for id := range m.private_chats_as_user2 {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
-	}
-	return oldValue.Role, nil
+// ResetPrivateChatsAsUser2 resets all changes to the "private_chats_as_user2" edge.
+func (m *UserMutation) ResetPrivateChatsAsUser2() {
+	m.private_chats_as_user2 = nil
+	m.clearedprivate_chats_as_user2 = false
+	m.removedprivate_chats_as_user2 = nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// AddUploadedMediumIDs adds the "uploaded_media" edge to the Media entity by ids.
+func (m *UserMutation) AddUploadedMediumIDs(ids ...uuid.UUID) {
+	if m.uploaded_media == nil {
+		m.uploaded_media = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.uploaded_media[ids[i]] = struct{}{}
+	}
 }
 
-// SetIsBanned sets the "is_banned" field.
-func (m *UserMutation) SetIsBanned(b bool) {
-	m.is_banned = &b
+// ClearUploadedMedia clears the "uploaded_media" edge to the Media entity.
+func (m *UserMutation) ClearUploadedMedia() {
+	m.cleareduploaded_media = true
 }
 
-// IsBanned returns the value of the "is_banned" field in the mutation.
-func (m *UserMutation) IsBanned() (r bool, exists bool) {
-	v := m.is_banned
-	if v == nil {
-		return
-	}
-	return *v, true
+// UploadedMediaCleared reports if the "uploaded_media" edge to the Media entity was cleared.
+func (m *UserMutation) UploadedMediaCleared() bool {
+	return m.cleareduploaded_media
 }
 
-// OldIsBanned returns the old "is_banned" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsBanned(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBanned is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBanned requires an ID field in the mutation")
+// RemoveUploadedMediumIDs removes the "uploaded_media" edge to the Media entity by IDs.
+func (m *UserMutation) RemoveUploadedMediumIDs(ids ...uuid.UUID) {
+	if m.removeduploaded_media == nil {
+		m.removeduploaded_media = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBanned: %w", err)
+	for i := range ids {
+		delete(m.uploaded_media, ids[i])
+		m.removeduploaded_media[ids[i]] = struct{}{}
 	}
-	return oldValue.IsBanned, nil
 }
 
-// ResetIsBanned resets all changes to the "is_banned" field.
-func (m *UserMutation) ResetIsBanned() {
-	m.is_banned = nil
+// RemovedUploadedMedia returns the removed IDs of the "uploaded_media" edge to the Media entity.
+func (m *UserMutation) RemovedUploadedMediaIDs() (ids []uuid.UUID) {
+	for id := range m.removeduploaded_media {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetBannedUntil sets the "banned_until" field.
-func (m *UserMutation) SetBannedUntil(t time.Time) {
-	m.banned_until = &t
+// UploadedMediaIDs returns the "uploaded_media" edge IDs in the mutation.
+func (m *UserMutation) UploadedMediaIDs() (ids []uuid.UUID) {
+	for id := range m.uploaded_media {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// BannedUntil returns the value of the "banned_until" field in the mutation.
-func (m *UserMutation) BannedUntil() (r time.Time, exists bool) {
-	v := m.banned_until
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetUploadedMedia resets all changes to the "uploaded_media" edge.
+func (m *UserMutation) ResetUploadedMedia() {
+	m.uploaded_media = nil
+	m.cleareduploaded_media = false
+	m.removeduploaded_media = nil
 }
 
-// OldBannedUntil returns the old "banned_until" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBannedUntil(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBannedUntil is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBannedUntil requires an ID field in the mutation")
+// AddBlockedUsersRelIDs adds the "blocked_users_rel" edge to the UserBlock entity by ids.
+func (m *UserMutation) AddBlockedUsersRelIDs(ids ...uuid.UUID) {
+	if m.blocked_users_rel == nil {
+		m.blocked_users_rel = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBannedUntil: %w", err)
+	for i := range ids {
+		m.blocked_users_rel[ids[i]] = struct{}{}
 	}
-	return oldValue.BannedUntil, nil
 }
 
-// ClearBannedUntil clears the value of the "banned_until" field.
-func (m *UserMutation) ClearBannedUntil() {
-	m.banned_until = nil
-	m.clearedFields[user.FieldBannedUntil] = struct{}{}
+// ClearBlockedUsersRel clears the "blocked_users_rel" edge to the UserBlock entity.
+func (m *UserMutation) ClearBlockedUsersRel() {
+	m.clearedblocked_users_rel = true
 }
 
-// BannedUntilCleared returns if the "banned_until" field was cleared in this mutation.
-func (m *UserMutation) BannedUntilCleared() bool {
-	_, ok := m.clearedFields[user.FieldBannedUntil]
-	return ok
+// BlockedUsersRelCleared reports if the "blocked_users_rel" edge to the UserBlock entity was cleared.
+func (m *UserMutation) BlockedUsersRelCleared() bool {
+	return m.clearedblocked_users_rel
 }
 
-// ResetBannedUntil resets all changes to the "banned_until" field.
-func (m *UserMutation) ResetBannedUntil() {
-	m.banned_until = nil
-	delete(m.clearedFields, user.FieldBannedUntil)
+// RemoveBlockedUsersRelIDs removes the "blocked_users_rel" edge to the UserBlock entity by IDs.
+func (m *UserMutation) RemoveBlockedUsersRelIDs(ids ...uuid.UUID) {
+	if m.removedblocked_users_rel == nil {
+		m.removedblocked_users_rel = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_users_rel, ids[i])
+		m.removedblocked_users_rel[ids[i]] = struct{}{}
+	}
 }
 
-// SetBanReason sets the "ban_reason" field.
-func (m *UserMutation) SetBanReason(s string) {
-	m.ban_reason = &s
+// RemovedBlockedUsersRel returns the removed IDs of the "blocked_users_rel" edge to the UserBlock entity.
+func (m *UserMutation) RemovedBlockedUsersRelIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_users_rel {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// BanReason returns the value of the "ban_reason" field in the mutation.
-func (m *UserMutation) BanReason() (r string, exists bool) {
-	v := m.ban_reason
-	if v == nil {
-		return
+// BlockedUsersRelIDs returns the "blocked_users_rel" edge IDs in the mutation.
+func (m *UserMutation) BlockedUsersRelIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_users_rel {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldBanReason returns the old "ban_reason" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBanReason(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBanReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBanReason requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBanReason: %w", err)
-	}
-	return oldValue.BanReason, nil
+// ResetBlockedUsersRel resets all changes to the "blocked_users_rel" edge.
+func (m *UserMutation) ResetBlockedUsersRel() {
+	m.blocked_users_rel = nil
+	m.clearedblocked_users_rel = false
+	m.removedblocked_users_rel = nil
 }
 
-// ClearBanReason clears the value of the "ban_reason" field.
-func (m *UserMutation) ClearBanReason() {
-	m.ban_reason = nil
-	m.clearedFields[user.FieldBanReason] = struct{}{}
+// AddBlockedByRelIDs adds the "blocked_by_rel" edge to the UserBlock entity by ids.
+func (m *UserMutation) AddBlockedByRelIDs(ids ...uuid.UUID) {
+	if m.blocked_by_rel == nil {
+		m.blocked_by_rel = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocked_by_rel[ids[i]] = struct{}{}
+	}
 }
 
-// BanReasonCleared returns if the "ban_reason" field was cleared in this mutation.
-func (m *UserMutation) BanReasonCleared() bool {
-	_, ok := m.clearedFields[user.FieldBanReason]
-	return ok
+// ClearBlockedByRel clears the "blocked_by_rel" edge to the UserBlock entity.
+func (m *UserMutation) ClearBlockedByRel() {
+	m.clearedblocked_by_rel = true
 }
 
-// ResetBanReason resets all changes to the "ban_reason" field.
-func (m *UserMutation) ResetBanReason() {
-	m.ban_reason = nil
-	delete(m.clearedFields, user.FieldBanReason)
+// BlockedByRelCleared reports if the "blocked_by_rel" edge to the UserBlock entity was cleared.
+func (m *UserMutation) BlockedByRelCleared() bool {
+	return m.clearedblocked_by_rel
 }
 
-// ClearAvatar clears the "avatar" edge to the Media entity.
-func (m *UserMutation) ClearAvatar() {
-	m.clearedavatar = true
-	m.clearedFields[user.FieldAvatarID] = struct{}{}
+// RemoveBlockedByRelIDs removes the "blocked_by_rel" edge to the UserBlock entity by IDs.
+func (m *UserMutation) RemoveBlockedByRelIDs(ids ...uuid.UUID) {
+	if m.removedblocked_by_rel == nil {
+		m.removedblocked_by_rel = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_by_rel, ids[i])
+		m.removedblocked_by_rel[ids[i]] = struct{}{}
+	}
 }
 
-// AvatarCleared reports if the "avatar" edge to the Media entity was cleared.
-func (m *UserMutation) AvatarCleared() bool {
-	return m.AvatarIDCleared() || m.clearedavatar
+// RemovedBlockedByRel returns the removed IDs of the "blocked_by_rel" edge to the UserBlock entity.
+func (m *UserMutation) RemovedBlockedByRelIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocked_by_rel {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AvatarIDs returns the "avatar" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AvatarID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) AvatarIDs() (ids []uuid.UUID) {
-	if id := m.avatar; id != nil {
-		ids = append(ids, *id)
+// BlockedByRelIDs returns the "blocked_by_rel" edge IDs in the mutation.
+func (m *UserMutation) BlockedByRelIDs() (ids []uuid.UUID) {
+	for id := range m.blocked_by_rel {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAvatar resets all changes to the "avatar" edge.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	m.clearedavatar = false
+// ResetBlockedByRel resets all changes to the "blocked_by_rel" edge.
+func (m *UserMutation) ResetBlockedByRel() {
+	m.blocked_by_rel = nil
+	m.clearedblocked_by_rel = false
+	m.removedblocked_by_rel = nil
 }
 
-// AddIdentityIDs adds the "identities" edge to the UserIdentity entity by ids.
-func (m *UserMutation) AddIdentityIDs(ids ...uuid.UUID) {
-	if m.identities == nil {
-		m.identities = make(map[uuid.UUID]struct{})
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by ids.
+func (m *UserMutation) AddMessageReactionIDs(ids ...uuid.UUID) {
+	if m.message_reactions == nil {
+		m.message_reactions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.identities[ids[i]] = struct{}{}
+		m.message_reactions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearIdentities clears the "identities" edge to the UserIdentity entity.
-func (m *UserMutation) ClearIdentities() {
-	m.clearedidentities = true
+// ClearMessageReactions clears the "message_reactions" edge to the MessageReaction entity.
+func (m *UserMutation) ClearMessageReactions() {
+	m.clearedmessage_reactions = true
 }
 
-// IdentitiesCleared reports if the "identities" edge to the UserIdentity entity was cleared.
-func (m *UserMutation) IdentitiesCleared() bool {
-	return m.clearedidentities
+// MessageReactionsCleared reports if the "message_reactions" edge to the MessageReaction entity was cleared.
+func (m *UserMutation) MessageReactionsCleared() bool {
+	return m.clearedmessage_reactions
 }
 
-// RemoveIdentityIDs removes the "identities" edge to the UserIdentity entity by IDs.
-func (m *UserMutation) RemoveIdentityIDs(ids ...uuid.UUID) {
-	if m.removedidentities == nil {
-		m.removedidentities = make(map[uuid.UUID]struct{})
+// RemoveMessageReactionIDs removes the "message_reactions" edge to the MessageReaction entity by IDs.
+func (m *UserMutation) RemoveMessageReactionIDs(ids ...uuid.UUID) {
+	if m.removedmessage_reactions == nil {
+		m.removedmessage_reactions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.identities, ids[i])
-		m.removedidentities[ids[i]] = struct{}{}
+		delete(m.message_reactions, ids[i])
+		m.removedmessage_reactions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedIdentities returns the removed IDs of the "identities" edge to the UserIdentity entity.
-func (m *UserMutation) RemovedIdentitiesIDs() (ids []uuid.UUID) {
-	for id := range m.removedidentities {
+// RemovedMessageReactions returns the removed IDs of the "message_reactions" edge to the MessageReaction entity.
+func (m *UserMutation) RemovedMessageReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessage_reactions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// IdentitiesIDs returns the "identities" edge IDs in the mutation.
-func (m *UserMutation) IdentitiesIDs() (ids []uuid.UUID) {
-	for id := range m.identities {
+// MessageReactionsIDs returns the "message_reactions" edge IDs in the mutation.
+func (m *UserMutation) MessageReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.message_reactions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetIdentities resets all changes to the "identities" edge.
-func (m *UserMutation) ResetIdentities() {
-	m.identities = nil
-	m.clearedidentities = false
-	m.removedidentities = nil
+// ResetMessageReactions resets all changes to the "message_reactions" edge.
+func (m *UserMutation) ResetMessageReactions() {
+	m.message_reactions = nil
+	m.clearedmessage_reactions = false
+	m.removedmessage_reactions = nil
 }
 
-// AddSentMessageIDs adds the "sent_messages" edge to the Message entity by ids.
-func (m *UserMutation) AddSentMessageIDs(ids ...uuid.UUID) {
-	if m.sent_messages == nil {
-		m.sent_messages = make(map[uuid.UUID]struct{})
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by ids.
+func (m *UserMutation) AddPinnedMessageIDs(ids ...uuid.UUID) {
+	if m.pinned_messages == nil {
+		m.pinned_messages = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.sent_messages[ids[i]] = struct{}{}
+		m.pinned_messages[ids[i]] = struct{}{}
 	}
 }
 
-// ClearSentMessages clears the "sent_messages" edge to the Message entity.
-func (m *UserMutation) ClearSentMessages() {
-	m.clearedsent_messages = true
+// ClearPinnedMessages clears the "pinned_messages" edge to the PinnedMessage entity.
+func (m *UserMutation) ClearPinnedMessages() {
+	m.clearedpinned_messages = true
 }
 
-// SentMessagesCleared reports if the "sent_messages" edge to the Message entity was cleared.
-func (m *UserMutation) SentMessagesCleared() bool {
-	return m.clearedsent_messages
+// PinnedMessagesCleared reports if the "pinned_messages" edge to the PinnedMessage entity was cleared.
+func (m *UserMutation) PinnedMessagesCleared() bool {
+	return m.clearedpinned_messages
 }
 
-// RemoveSentMessageIDs removes the "sent_messages" edge to the Message entity by IDs.
-func (m *UserMutation) RemoveSentMessageIDs(ids ...uuid.UUID) {
-	if m.removedsent_messages == nil {
-		m.removedsent_messages = make(map[uuid.UUID]struct{})
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (m *UserMutation) RemovePinnedMessageIDs(ids ...uuid.UUID) {
+	if m.removedpinned_messages == nil {
+		m.removedpinned_messages = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.sent_messages, ids[i])
-		m.removedsent_messages[ids[i]] = struct{}{}
+		delete(m.pinned_messages, ids[i])
+		m.removedpinned_messages[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedSentMessages returns the removed IDs of the "sent_messages" edge to the Message entity.
-func (m *UserMutation) RemovedSentMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedsent_messages {
+// RemovedPinnedMessages returns the removed IDs of the "pinned_messages" edge to the PinnedMessage entity.
+func (m *UserMutation) RemovedPinnedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedpinned_messages {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// SentMessagesIDs returns the "sent_messages" edge IDs in the mutation.
-func (m *UserMutation) SentMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.sent_messages {
+// PinnedMessagesIDs returns the "pinned_messages" edge IDs in the mutation.
+func (m *UserMutation) PinnedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.pinned_messages {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSentMessages resets all changes to the "sent_messages" edge.
-func (m *UserMutation) ResetSentMessages() {
-	m.sent_messages = nil
-	m.clearedsent_messages = false
-	m.removedsent_messages = nil
+// ResetPinnedMessages resets all changes to the "pinned_messages" edge.
+func (m *UserMutation) ResetPinnedMessages() {
+	m.pinned_messages = nil
+	m.clearedpinned_messages = false
+	m.removedpinned_messages = nil
 }
 
-// AddCreatedGroupIDs adds the "created_groups" edge to the GroupChat entity by ids.
-func (m *UserMutation) AddCreatedGroupIDs(ids ...uuid.UUID) {
-	if m.created_groups == nil {
-		m.created_groups = make(map[uuid.UUID]struct{})
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by ids.
+func (m *UserMutation) AddScheduledMessageIDs(ids ...uuid.UUID) {
+	if m.scheduled_messages == nil {
+		m.scheduled_messages = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.created_groups[ids[i]] = struct{}{}
+		m.scheduled_messages[ids[i]] = struct{}{}
 	}
 }
 
-// ClearCreatedGroups clears the "created_groups" edge to the GroupChat entity.
-func (m *UserMutation) ClearCreatedGroups() {
-	m.clearedcreated_groups = true
+// ClearScheduledMessages clears the "scheduled_messages" edge to the ScheduledMessage entity.
+func (m *UserMutation) ClearScheduledMessages() {
+	m.clearedscheduled_messages = true
 }
 
-// CreatedGroupsCleared reports if the "created_groups" edge to the GroupChat entity was cleared.
-func (m *UserMutation) CreatedGroupsCleared() bool {
-	return m.clearedcreated_groups
+// ScheduledMessagesCleared reports if the "scheduled_messages" edge to the ScheduledMessage entity was cleared.
+func (m *UserMutation) ScheduledMessagesCleared() bool {
+	return m.clearedscheduled_messages
 }
 
-// RemoveCreatedGroupIDs removes the "created_groups" edge to the GroupChat entity by IDs.
-func (m *UserMutation) RemoveCreatedGroupIDs(ids ...uuid.UUID) {
-	if m.removedcreated_groups == nil {
-		m.removedcreated_groups = make(map[uuid.UUID]struct{})
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (m *UserMutation) RemoveScheduledMessageIDs(ids ...uuid.UUID) {
+	if m.removedscheduled_messages == nil {
+		m.removedscheduled_messages = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.created_groups, ids[i])
-		m.removedcreated_groups[ids[i]] = struct{}{}
+		delete(m.scheduled_messages, ids[i])
+		m.removedscheduled_messages[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedCreatedGroups returns the removed IDs of the "created_groups" edge to the GroupChat entity.
-func (m *UserMutation) RemovedCreatedGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_groups {
+// RemovedScheduledMessages returns the removed IDs of the "scheduled_messages" edge to the ScheduledMessage entity.
+func (m *UserMutation) RemovedScheduledMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedscheduled_messages {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// CreatedGroupsIDs returns the "created_groups" edge IDs in the mutation.
-func (m *UserMutation) CreatedGroupsIDs() (ids []uuid.UUID) {
-	for id := range m.created_groups {
+// ScheduledMessagesIDs returns the "scheduled_messages" edge IDs in the mutation.
+func (m *UserMutation) ScheduledMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.scheduled_messages {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCreatedGroups resets all changes to the "created_groups" edge.
-func (m *UserMutation) ResetCreatedGroups() {
-	m.created_groups = nil
-	m.clearedcreated_groups = false
-	m.removedcreated_groups = nil
-}
-
-// AddGroupMembershipIDs adds the "group_memberships" edge to the GroupMember entity by ids.
-func (m *UserMutation) AddGroupMembershipIDs(ids ...uuid.UUID) {
-	if m.group_memberships == nil {
-		m.group_memberships = make(map[uuid.UUID]struct{})
+// ResetScheduledMessages resets all changes to the "scheduled_messages" edge.
+func (m *UserMutation) ResetScheduledMessages() {
+	m.scheduled_messages = nil
+	m.clearedscheduled_messages = false
+	m.removedscheduled_messages = nil
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by ids.
+func (m *UserMutation) AddMentionIDs(ids ...uuid.UUID) {
+	if m.mentions == nil {
+		m.mentions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.group_memberships[ids[i]] = struct{}{}
+		m.mentions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearGroupMemberships clears the "group_memberships" edge to the GroupMember entity.
-func (m *UserMutation) ClearGroupMemberships() {
-	m.clearedgroup_memberships = true
+// ClearMentions clears the "mentions" edge to the MessageMention entity.
+func (m *UserMutation) ClearMentions() {
+	m.clearedmentions = true
 }
 
-// GroupMembershipsCleared reports if the "group_memberships" edge to the GroupMember entity was cleared.
-func (m *UserMutation) GroupMembershipsCleared() bool {
-	return m.clearedgroup_memberships
+// MentionsCleared reports if the "mentions" edge to the MessageMention entity was cleared.
+func (m *UserMutation) MentionsCleared() bool {
+	return m.clearedmentions
 }
 
-// RemoveGroupMembershipIDs removes the "group_memberships" edge to the GroupMember entity by IDs.
-func (m *UserMutation) RemoveGroupMembershipIDs(ids ...uuid.UUID) {
-	if m.removedgroup_memberships == nil {
-		m.removedgroup_memberships = make(map[uuid.UUID]struct{})
+// RemoveMentionIDs removes the "mentions" edge to the MessageMention entity by IDs.
+func (m *UserMutation) RemoveMentionIDs(ids ...uuid.UUID) {
+	if m.removedmentions == nil {
+		m.removedmentions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.group_memberships, ids[i])
-		m.removedgroup_memberships[ids[i]] = struct{}{}
+		delete(m.mentions, ids[i])
+		m.removedmentions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedGroupMemberships returns the removed IDs of the "group_memberships" edge to the GroupMember entity.
-func (m *UserMutation) RemovedGroupMembershipsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgroup_memberships {
+// RemovedMentions returns the removed IDs of the "mentions" edge to the MessageMention entity.
+func (m *UserMutation) RemovedMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmentions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupMembershipsIDs returns the "group_memberships" edge IDs in the mutation.
-func (m *UserMutation) GroupMembershipsIDs() (ids []uuid.UUID) {
-	for id := range m.group_memberships {
+// MentionsIDs returns the "mentions" edge IDs in the mutation.
+func (m *UserMutation) MentionsIDs() (ids []uuid.UUID) {
+	for id := range m.mentions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroupMemberships resets all changes to the "group_memberships" edge.
-func (m *UserMutation) ResetGroupMemberships() {
-	m.group_memberships = nil
-	m.clearedgroup_memberships = false
-	m.removedgroup_memberships = nil
+// ResetMentions resets all changes to the "mentions" edge.
+func (m *UserMutation) ResetMentions() {
+	m.mentions = nil
+	m.clearedmentions = false
+	m.removedmentions = nil
 }
 
-// AddPrivateChatsAsUser1IDs adds the "private_chats_as_user1" edge to the PrivateChat entity by ids.
-func (m *UserMutation) AddPrivateChatsAsUser1IDs(ids ...uuid.UUID) {
-	if m.private_chats_as_user1 == nil {
-		m.private_chats_as_user1 = make(map[uuid.UUID]struct{})
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by ids.
+func (m *UserMutation) AddPollVoteIDs(ids ...uuid.UUID) {
+	if m.poll_votes == nil {
+		m.poll_votes = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.private_chats_as_user1[ids[i]] = struct{}{}
+		m.poll_votes[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPrivateChatsAsUser1 clears the "private_chats_as_user1" edge to the PrivateChat entity.
-func (m *UserMutation) ClearPrivateChatsAsUser1() {
-	m.clearedprivate_chats_as_user1 = true
+// ClearPollVotes clears the "poll_votes" edge to the PollVote entity.
+func (m *UserMutation) ClearPollVotes() {
+	m.clearedpoll_votes = true
 }
 
-// PrivateChatsAsUser1Cleared reports if the "private_chats_as_user1" edge to the PrivateChat entity was cleared.
-func (m *UserMutation) PrivateChatsAsUser1Cleared() bool {
-	return m.clearedprivate_chats_as_user1
+// PollVotesCleared reports if the "poll_votes" edge to the PollVote entity was cleared.
+func (m *UserMutation) PollVotesCleared() bool {
+	return m.clearedpoll_votes
 }
 
-// RemovePrivateChatsAsUser1IDs removes the "private_chats_as_user1" edge to the PrivateChat entity by IDs.
-func (m *UserMutation) RemovePrivateChatsAsUser1IDs(ids ...uuid.UUID) {
-	if m.removedprivate_chats_as_user1 == nil {
-		m.removedprivate_chats_as_user1 = make(map[uuid.UUID]struct{})
+// RemovePollVoteIDs removes the "poll_votes" edge to the PollVote entity by IDs.
+func (m *UserMutation) RemovePollVoteIDs(ids ...uuid.UUID) {
+	if m.removedpoll_votes == nil {
+		m.removedpoll_votes = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.private_chats_as_user1, ids[i])
-		m.removedprivate_chats_as_user1[ids[i]] = struct{}{}
+		delete(m.poll_votes, ids[i])
+		m.removedpoll_votes[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPrivateChatsAsUser1 returns the removed IDs of the "private_chats_as_user1" edge to the PrivateChat entity.
-func (m *UserMutation) RemovedPrivateChatsAsUser1IDs() (ids []uuid.UUID) {
-	for id := range m.removedprivate_chats_as_user1 {
+// RemovedPollVotes returns the removed IDs of the "poll_votes" edge to the PollVote entity.
+func (m *UserMutation) RemovedPollVotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedpoll_votes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PrivateChatsAsUser1IDs returns the "private_chats_as_user1" edge IDs in the mutation.
-func (m *UserMutation) PrivateChatsAsUser1IDs() (ids []uuid.UUID) {
-	for id := range m.private_chats_as_user1 {
+// PollVotesIDs returns the "poll_votes" edge IDs in the mutation.
+func (m *UserMutation) PollVotesIDs() (ids []uuid.UUID) {
+	for id := range m.poll_votes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPrivateChatsAsUser1 resets all changes to the "private_chats_as_user1" edge.
-func (m *UserMutation) ResetPrivateChatsAsUser1() {
-	m.private_chats_as_user1 = nil
-	m.clearedprivate_chats_as_user1 = false
-	m.removedprivate_chats_as_user1 = nil
+// ResetPollVotes resets all changes to the "poll_votes" edge.
+func (m *UserMutation) ResetPollVotes() {
+	m.poll_votes = nil
+	m.clearedpoll_votes = false
+	m.removedpoll_votes = nil
 }
 
-// AddPrivateChatsAsUser2IDs adds the "private_chats_as_user2" edge to the PrivateChat entity by ids.
-func (m *UserMutation) AddPrivateChatsAsUser2IDs(ids ...uuid.UUID) {
-	if m.private_chats_as_user2 == nil {
-		m.private_chats_as_user2 = make(map[uuid.UUID]struct{})
+// AddChatExportIDs adds the "chat_exports" edge to the ChatExport entity by ids.
+func (m *UserMutation) AddChatExportIDs(ids ...uuid.UUID) {
+	if m.chat_exports == nil {
+		m.chat_exports = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.private_chats_as_user2[ids[i]] = struct{}{}
+		m.chat_exports[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPrivateChatsAsUser2 clears the "private_chats_as_user2" edge to the PrivateChat entity.
-func (m *UserMutation) ClearPrivateChatsAsUser2() {
-	m.clearedprivate_chats_as_user2 = true
+// ClearChatExports clears the "chat_exports" edge to the ChatExport entity.
+func (m *UserMutation) ClearChatExports() {
+	m.clearedchat_exports = true
 }
 
-// PrivateChatsAsUser2Cleared reports if the "private_chats_as_user2" edge to the PrivateChat entity was cleared.
-func (m *UserMutation) PrivateChatsAsUser2Cleared() bool {
-	return m.clearedprivate_chats_as_user2
+// ChatExportsCleared reports if the "chat_exports" edge to the ChatExport entity was cleared.
+func (m *UserMutation) ChatExportsCleared() bool {
+	return m.clearedchat_exports
 }
 
-// RemovePrivateChatsAsUser2IDs removes the "private_chats_as_user2" edge to the PrivateChat entity by IDs.
-func (m *UserMutation) RemovePrivateChatsAsUser2IDs(ids ...uuid.UUID) {
-	if m.removedprivate_chats_as_user2 == nil {
-		m.removedprivate_chats_as_user2 = make(map[uuid.UUID]struct{})
+// RemoveChatExportIDs removes the "chat_exports" edge to the ChatExport entity by IDs.
+func (m *UserMutation) RemoveChatExportIDs(ids ...uuid.UUID) {
+	if m.removedchat_exports == nil {
+		m.removedchat_exports = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.private_chats_as_user2, ids[i])
-		m.removedprivate_chats_as_user2[ids[i]] = struct{}{}
+		delete(m.chat_exports, ids[i])
+		m.removedchat_exports[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPrivateChatsAsUser2 returns the removed IDs of the "private_chats_as_user2" edge to the PrivateChat entity.
-func (m *UserMutation) RemovedPrivateChatsAsUser2IDs() (ids []uuid.UUID) {
-	for id := range m.removedprivate_chats_as_user2 {
+// RemovedChatExports returns the removed IDs of the "chat_exports" edge to the ChatExport entity.
+func (m *UserMutation) RemovedChatExportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedchat_exports {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PrivateChatsAsUser2IDs returns the "private_chats_as_user2" edge IDs in the mutation.
-func (m *UserMutation) PrivateChatsAsUser2IDs() (ids []uuid.UUID) {
-	for id := range m.private_chats_as_user2 {
+// ChatExportsIDs returns the "chat_exports" edge IDs in the mutation.
+func (m *UserMutation) ChatExportsIDs() (ids []uuid.UUID) {
+	for id := range m.chat_exports {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPrivateChatsAsUser2 resets all changes to the "private_chats_as_user2" edge.
-func (m *UserMutation) ResetPrivateChatsAsUser2() {
-	m.private_chats_as_user2 = nil
-	m.clearedprivate_chats_as_user2 = false
-	m.removedprivate_chats_as_user2 = nil
+// ResetChatExports resets all changes to the "chat_exports" edge.
+func (m *UserMutation) ResetChatExports() {
+	m.chat_exports = nil
+	m.clearedchat_exports = false
+	m.removedchat_exports = nil
 }
 
-// AddUploadedMediumIDs adds the "uploaded_media" edge to the Media entity by ids.
-func (m *UserMutation) AddUploadedMediumIDs(ids ...uuid.UUID) {
-	if m.uploaded_media == nil {
-		m.uploaded_media = make(map[uuid.UUID]struct{})
+// AddPinnedChatIDs adds the "pinned_chats" edge to the PinnedChat entity by ids.
+func (m *UserMutation) AddPinnedChatIDs(ids ...uuid.UUID) {
+	if m.pinned_chats == nil {
+		m.pinned_chats = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.uploaded_media[ids[i]] = struct{}{}
+		m.pinned_chats[ids[i]] = struct{}{}
 	}
 }
 
-// ClearUploadedMedia clears the "uploaded_media" edge to the Media entity.
-func (m *UserMutation) ClearUploadedMedia() {
-	m.cleareduploaded_media = true
+// ClearPinnedChats clears the "pinned_chats" edge to the PinnedChat entity.
+func (m *UserMutation) ClearPinnedChats() {
+	m.clearedpinned_chats = true
 }
 
-// UploadedMediaCleared reports if the "uploaded_media" edge to the Media entity was cleared.
-func (m *UserMutation) UploadedMediaCleared() bool {
-	return m.cleareduploaded_media
+// PinnedChatsCleared reports if the "pinned_chats" edge to the PinnedChat entity was cleared.
+func (m *UserMutation) PinnedChatsCleared() bool {
+	return m.clearedpinned_chats
 }
 
-// RemoveUploadedMediumIDs removes the "uploaded_media" edge to the Media entity by IDs.
-func (m *UserMutation) RemoveUploadedMediumIDs(ids ...uuid.UUID) {
-	if m.removeduploaded_media == nil {
-		m.removeduploaded_media = make(map[uuid.UUID]struct{})
+// RemovePinnedChatIDs removes the "pinned_chats" edge to the PinnedChat entity by IDs.
+func (m *UserMutation) RemovePinnedChatIDs(ids ...uuid.UUID) {
+	if m.removedpinned_chats == nil {
+		m.removedpinned_chats = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.uploaded_media, ids[i])
-		m.removeduploaded_media[ids[i]] = struct{}{}
+		delete(m.pinned_chats, ids[i])
+		m.removedpinned_chats[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedUploadedMedia returns the removed IDs of the "uploaded_media" edge to the Media entity.
-func (m *UserMutation) RemovedUploadedMediaIDs() (ids []uuid.UUID) {
-	for id := range m.removeduploaded_media {
+// RemovedPinnedChats returns the removed IDs of the "pinned_chats" edge to the PinnedChat entity.
+func (m *UserMutation) RemovedPinnedChatsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpinned_chats {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// UploadedMediaIDs returns the "uploaded_media" edge IDs in the mutation.
-func (m *UserMutation) UploadedMediaIDs() (ids []uuid.UUID) {
-	for id := range m.uploaded_media {
+// PinnedChatsIDs returns the "pinned_chats" edge IDs in the mutation.
+func (m *UserMutation) PinnedChatsIDs() (ids []uuid.UUID) {
+	for id := range m.pinned_chats {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUploadedMedia resets all changes to the "uploaded_media" edge.
-func (m *UserMutation) ResetUploadedMedia() {
-	m.uploaded_media = nil
-	m.cleareduploaded_media = false
-	m.removeduploaded_media = nil
+// ResetPinnedChats resets all changes to the "pinned_chats" edge.
+func (m *UserMutation) ResetPinnedChats() {
+	m.pinned_chats = nil
+	m.clearedpinned_chats = false
+	m.removedpinned_chats = nil
 }
 
-// AddBlockedUsersRelIDs adds the "blocked_users_rel" edge to the UserBlock entity by ids.
-func (m *UserMutation) AddBlockedUsersRelIDs(ids ...uuid.UUID) {
-	if m.blocked_users_rel == nil {
-		m.blocked_users_rel = make(map[uuid.UUID]struct{})
+// AddChatFolderIDs adds the "chat_folders" edge to the ChatFolder entity by ids.
+func (m *UserMutation) AddChatFolderIDs(ids ...uuid.UUID) {
+	if m.chat_folders == nil {
+		m.chat_folders = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.blocked_users_rel[ids[i]] = struct{}{}
+		m.chat_folders[ids[i]] = struct{}{}
 	}
 }
 
-// ClearBlockedUsersRel clears the "blocked_users_rel" edge to the UserBlock entity.
-func (m *UserMutation) ClearBlockedUsersRel() {
-	m.clearedblocked_users_rel = true
+// ClearChatFolders clears the "chat_folders" edge to the ChatFolder entity.
+func (m *UserMutation) ClearChatFolders() {
+	m.clearedchat_folders = true
 }
 
-// BlockedUsersRelCleared reports if the "blocked_users_rel" edge to the UserBlock entity was cleared.
-func (m *UserMutation) BlockedUsersRelCleared() bool {
-	return m.clearedblocked_users_rel
+// ChatFoldersCleared reports if the "chat_folders" edge to the ChatFolder entity was cleared.
+func (m *UserMutation) ChatFoldersCleared() bool {
+	return m.clearedchat_folders
 }
 
-// RemoveBlockedUsersRelIDs removes the "blocked_users_rel" edge to the UserBlock entity by IDs.
-func (m *UserMutation) RemoveBlockedUsersRelIDs(ids ...uuid.UUID) {
-	if m.removedblocked_users_rel == nil {
-		m.removedblocked_users_rel = make(map[uuid.UUID]struct{})
+// RemoveChatFolderIDs removes the "chat_folders" edge to the ChatFolder entity by IDs.
+func (m *UserMutation) RemoveChatFolderIDs(ids ...uuid.UUID) {
+	if m.removedchat_folders == nil {
+		m.removedchat_folders = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.blocked_users_rel, ids[i])
-		m.removedblocked_users_rel[ids[i]] = struct{}{}
+		delete(m.chat_folders, ids[i])
+		m.removedchat_folders[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedBlockedUsersRel returns the removed IDs of the "blocked_users_rel" edge to the UserBlock entity.
-func (m *UserMutation) RemovedBlockedUsersRelIDs() (ids []uuid.UUID) {
-	for id := range m.removedblocked_users_rel {
+// RemovedChatFolders returns the removed IDs of the "chat_folders" edge to the ChatFolder entity.
+func (m *UserMutation) RemovedChatFoldersIDs() (ids []uuid.UUID) {
+	for id := range m.removedchat_folders {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// BlockedUsersRelIDs returns the "blocked_users_rel" edge IDs in the mutation.
-func (m *UserMutation) BlockedUsersRelIDs() (ids []uuid.UUID) {
-	for id := range m.blocked_users_rel {
+// ChatFoldersIDs returns the "chat_folders" edge IDs in the mutation.
+func (m *UserMutation) ChatFoldersIDs() (ids []uuid.UUID) {
+	for id := range m.chat_folders {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBlockedUsersRel resets all changes to the "blocked_users_rel" edge.
-func (m *UserMutation) ResetBlockedUsersRel() {
-	m.blocked_users_rel = nil
-	m.clearedblocked_users_rel = false
-	m.removedblocked_users_rel = nil
+// ResetChatFolders resets all changes to the "chat_folders" edge.
+func (m *UserMutation) ResetChatFolders() {
+	m.chat_folders = nil
+	m.clearedchat_folders = false
+	m.removedchat_folders = nil
 }
 
-// AddBlockedByRelIDs adds the "blocked_by_rel" edge to the UserBlock entity by ids.
-func (m *UserMutation) AddBlockedByRelIDs(ids ...uuid.UUID) {
-	if m.blocked_by_rel == nil {
-		m.blocked_by_rel = make(map[uuid.UUID]struct{})
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by ids.
+func (m *UserMutation) AddGroupJoinRequestIDs(ids ...uuid.UUID) {
+	if m.group_join_requests == nil {
+		m.group_join_requests = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.blocked_by_rel[ids[i]] = struct{}{}
+		m.group_join_requests[ids[i]] = struct{}{}
 	}
 }
 
-// ClearBlockedByRel clears the "blocked_by_rel" edge to the UserBlock entity.
-func (m *UserMutation) ClearBlockedByRel() {
-	m.clearedblocked_by_rel = true
+// ClearGroupJoinRequests clears the "group_join_requests" edge to the GroupJoinRequest entity.
+func (m *UserMutation) ClearGroupJoinRequests() {
+	m.clearedgroup_join_requests = true
 }
 
-// BlockedByRelCleared reports if the "blocked_by_rel" edge to the UserBlock entity was cleared.
-func (m *UserMutation) BlockedByRelCleared() bool {
-	return m.clearedblocked_by_rel
+// GroupJoinRequestsCleared reports if the "group_join_requests" edge to the GroupJoinRequest entity was cleared.
+func (m *UserMutation) GroupJoinRequestsCleared() bool {
+	return m.clearedgroup_join_requests
 }
 
-// RemoveBlockedByRelIDs removes the "blocked_by_rel" edge to the UserBlock entity by IDs.
-func (m *UserMutation) RemoveBlockedByRelIDs(ids ...uuid.UUID) {
-	if m.removedblocked_by_rel == nil {
-		m.removedblocked_by_rel = make(map[uuid.UUID]struct{})
+// RemoveGroupJoinRequestIDs removes the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (m *UserMutation) RemoveGroupJoinRequestIDs(ids ...uuid.UUID) {
+	if m.removedgroup_join_requests == nil {
+		m.removedgroup_join_requests = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.blocked_by_rel, ids[i])
-		m.removedblocked_by_rel[ids[i]] = struct{}{}
+		delete(m.group_join_requests, ids[i])
+		m.removedgroup_join_requests[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedBlockedByRel returns the removed IDs of the "blocked_by_rel" edge to the UserBlock entity.
-func (m *UserMutation) RemovedBlockedByRelIDs() (ids []uuid.UUID) {
-	for id := range m.removedblocked_by_rel {
+// RemovedGroupJoinRequests returns the removed IDs of the "group_join_requests" edge to the GroupJoinRequest entity.
+func (m *UserMutation) RemovedGroupJoinRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroup_join_requests {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// BlockedByRelIDs returns the "blocked_by_rel" edge IDs in the mutation.
-func (m *UserMutation) BlockedByRelIDs() (ids []uuid.UUID) {
-	for id := range m.blocked_by_rel {
+// GroupJoinRequestsIDs returns the "group_join_requests" edge IDs in the mutation.
+func (m *UserMutation) GroupJoinRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.group_join_requests {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBlockedByRel resets all changes to the "blocked_by_rel" edge.
-func (m *UserMutation) ResetBlockedByRel() {
-	m.blocked_by_rel = nil
-	m.clearedblocked_by_rel = false
-	m.removedblocked_by_rel = nil
+// ResetGroupJoinRequests resets all changes to the "group_join_requests" edge.
+func (m *UserMutation) ResetGroupJoinRequests() {
+	m.group_join_requests = nil
+	m.clearedgroup_join_requests = false
+	m.removedgroup_join_requests = nil
 }
 
 // AddReportsMadeIDs adds the "reports_made" edge to the Report entity by ids.
@@ -9993,7 +24787,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 12)
+	edges := make([]string, 0, 21)
 	if m.avatar != nil {
 		edges = append(edges, user.EdgeAvatar)
 	}
@@ -10024,6 +24818,33 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.blocked_by_rel != nil {
 		edges = append(edges, user.EdgeBlockedByRel)
 	}
+	if m.message_reactions != nil {
+		edges = append(edges, user.EdgeMessageReactions)
+	}
+	if m.pinned_messages != nil {
+		edges = append(edges, user.EdgePinnedMessages)
+	}
+	if m.scheduled_messages != nil {
+		edges = append(edges, user.EdgeScheduledMessages)
+	}
+	if m.mentions != nil {
+		edges = append(edges, user.EdgeMentions)
+	}
+	if m.poll_votes != nil {
+		edges = append(edges, user.EdgePollVotes)
+	}
+	if m.chat_exports != nil {
+		edges = append(edges, user.EdgeChatExports)
+	}
+	if m.pinned_chats != nil {
+		edges = append(edges, user.EdgePinnedChats)
+	}
+	if m.chat_folders != nil {
+		edges = append(edges, user.EdgeChatFolders)
+	}
+	if m.group_join_requests != nil {
+		edges = append(edges, user.EdgeGroupJoinRequests)
+	}
 	if m.reports_made != nil {
 		edges = append(edges, user.EdgeReportsMade)
 	}
@@ -10095,6 +24916,60 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeMessageReactions:
+		ids := make([]ent.Value, 0, len(m.message_reactions))
+		for id := range m.message_reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePinnedMessages:
+		ids := make([]ent.Value, 0, len(m.pinned_messages))
+		for id := range m.pinned_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeScheduledMessages:
+		ids := make([]ent.Value, 0, len(m.scheduled_messages))
+		for id := range m.scheduled_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.mentions))
+		for id := range m.mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePollVotes:
+		ids := make([]ent.Value, 0, len(m.poll_votes))
+		for id := range m.poll_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChatExports:
+		ids := make([]ent.Value, 0, len(m.chat_exports))
+		for id := range m.chat_exports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePinnedChats:
+		ids := make([]ent.Value, 0, len(m.pinned_chats))
+		for id := range m.pinned_chats {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChatFolders:
+		ids := make([]ent.Value, 0, len(m.chat_folders))
+		for id := range m.chat_folders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupJoinRequests:
+		ids := make([]ent.Value, 0, len(m.group_join_requests))
+		for id := range m.group_join_requests {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeReportsMade:
 		ids := make([]ent.Value, 0, len(m.reports_made))
 		for id := range m.reports_made {
@@ -10113,7 +24988,7 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 12)
+	edges := make([]string, 0, 21)
 	if m.removedidentities != nil {
 		edges = append(edges, user.EdgeIdentities)
 	}
@@ -10141,6 +25016,33 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedblocked_by_rel != nil {
 		edges = append(edges, user.EdgeBlockedByRel)
 	}
+	if m.removedmessage_reactions != nil {
+		edges = append(edges, user.EdgeMessageReactions)
+	}
+	if m.removedpinned_messages != nil {
+		edges = append(edges, user.EdgePinnedMessages)
+	}
+	if m.removedscheduled_messages != nil {
+		edges = append(edges, user.EdgeScheduledMessages)
+	}
+	if m.removedmentions != nil {
+		edges = append(edges, user.EdgeMentions)
+	}
+	if m.removedpoll_votes != nil {
+		edges = append(edges, user.EdgePollVotes)
+	}
+	if m.removedchat_exports != nil {
+		edges = append(edges, user.EdgeChatExports)
+	}
+	if m.removedpinned_chats != nil {
+		edges = append(edges, user.EdgePinnedChats)
+	}
+	if m.removedchat_folders != nil {
+		edges = append(edges, user.EdgeChatFolders)
+	}
+	if m.removedgroup_join_requests != nil {
+		edges = append(edges, user.EdgeGroupJoinRequests)
+	}
 	if m.removedreports_made != nil {
 		edges = append(edges, user.EdgeReportsMade)
 	}
@@ -10208,6 +25110,60 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeMessageReactions:
+		ids := make([]ent.Value, 0, len(m.removedmessage_reactions))
+		for id := range m.removedmessage_reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePinnedMessages:
+		ids := make([]ent.Value, 0, len(m.removedpinned_messages))
+		for id := range m.removedpinned_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeScheduledMessages:
+		ids := make([]ent.Value, 0, len(m.removedscheduled_messages))
+		for id := range m.removedscheduled_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMentions:
+		ids := make([]ent.Value, 0, len(m.removedmentions))
+		for id := range m.removedmentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePollVotes:
+		ids := make([]ent.Value, 0, len(m.removedpoll_votes))
+		for id := range m.removedpoll_votes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChatExports:
+		ids := make([]ent.Value, 0, len(m.removedchat_exports))
+		for id := range m.removedchat_exports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePinnedChats:
+		ids := make([]ent.Value, 0, len(m.removedpinned_chats))
+		for id := range m.removedpinned_chats {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeChatFolders:
+		ids := make([]ent.Value, 0, len(m.removedchat_folders))
+		for id := range m.removedchat_folders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeGroupJoinRequests:
+		ids := make([]ent.Value, 0, len(m.removedgroup_join_requests))
+		for id := range m.removedgroup_join_requests {
+			ids = append(ids, id)
+		}
+		return ids
 	case user.EdgeReportsMade:
 		ids := make([]ent.Value, 0, len(m.removedreports_made))
 		for id := range m.removedreports_made {
@@ -10226,7 +25182,7 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 12)
+	edges := make([]string, 0, 21)
 	if m.clearedavatar {
 		edges = append(edges, user.EdgeAvatar)
 	}
@@ -10257,6 +25213,33 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedblocked_by_rel {
 		edges = append(edges, user.EdgeBlockedByRel)
 	}
+	if m.clearedmessage_reactions {
+		edges = append(edges, user.EdgeMessageReactions)
+	}
+	if m.clearedpinned_messages {
+		edges = append(edges, user.EdgePinnedMessages)
+	}
+	if m.clearedscheduled_messages {
+		edges = append(edges, user.EdgeScheduledMessages)
+	}
+	if m.clearedmentions {
+		edges = append(edges, user.EdgeMentions)
+	}
+	if m.clearedpoll_votes {
+		edges = append(edges, user.EdgePollVotes)
+	}
+	if m.clearedchat_exports {
+		edges = append(edges, user.EdgeChatExports)
+	}
+	if m.clearedpinned_chats {
+		edges = append(edges, user.EdgePinnedChats)
+	}
+	if m.clearedchat_folders {
+		edges = append(edges, user.EdgeChatFolders)
+	}
+	if m.clearedgroup_join_requests {
+		edges = append(edges, user.EdgeGroupJoinRequests)
+	}
 	if m.clearedreports_made {
 		edges = append(edges, user.EdgeReportsMade)
 	}
@@ -10290,6 +25273,24 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedblocked_users_rel
 	case user.EdgeBlockedByRel:
 		return m.clearedblocked_by_rel
+	case user.EdgeMessageReactions:
+		return m.clearedmessage_reactions
+	case user.EdgePinnedMessages:
+		return m.clearedpinned_messages
+	case user.EdgeScheduledMessages:
+		return m.clearedscheduled_messages
+	case user.EdgeMentions:
+		return m.clearedmentions
+	case user.EdgePollVotes:
+		return m.clearedpoll_votes
+	case user.EdgeChatExports:
+		return m.clearedchat_exports
+	case user.EdgePinnedChats:
+		return m.clearedpinned_chats
+	case user.EdgeChatFolders:
+		return m.clearedchat_folders
+	case user.EdgeGroupJoinRequests:
+		return m.clearedgroup_join_requests
 	case user.EdgeReportsMade:
 		return m.clearedreports_made
 	case user.EdgeReportsReceived:
@@ -10343,6 +25344,33 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeBlockedByRel:
 		m.ResetBlockedByRel()
 		return nil
+	case user.EdgeMessageReactions:
+		m.ResetMessageReactions()
+		return nil
+	case user.EdgePinnedMessages:
+		m.ResetPinnedMessages()
+		return nil
+	case user.EdgeScheduledMessages:
+		m.ResetScheduledMessages()
+		return nil
+	case user.EdgeMentions:
+		m.ResetMentions()
+		return nil
+	case user.EdgePollVotes:
+		m.ResetPollVotes()
+		return nil
+	case user.EdgeChatExports:
+		m.ResetChatExports()
+		return nil
+	case user.EdgePinnedChats:
+		m.ResetPinnedChats()
+		return nil
+	case user.EdgeChatFolders:
+		m.ResetChatFolders()
+		return nil
+	case user.EdgeGroupJoinRequests:
+		m.ResetGroupJoinRequests()
+		return nil
 	case user.EdgeReportsMade:
 		m.ResetReportsMade()
 		return nil
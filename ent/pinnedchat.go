@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PinnedChat is the model entity for the PinnedChat schema.
+type PinnedChat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID uuid.UUID `json:"chat_id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PinnedChatQuery when eager-loading is set.
+	Edges        PinnedChatEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PinnedChatEdges holds the relations/edges for other nodes in the graph.
+type PinnedChatEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Chat holds the value of the chat edge.
+	Chat *Chat `json:"chat,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedChatEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ChatOrErr returns the Chat value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedChatEdges) ChatOrErr() (*Chat, error) {
+	if e.Chat != nil {
+		return e.Chat, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: chat.Label}
+	}
+	return nil, &NotLoadedError{edge: "chat"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PinnedChat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pinnedchat.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case pinnedchat.FieldCreatedAt, pinnedchat.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pinnedchat.FieldID, pinnedchat.FieldUserID, pinnedchat.FieldChatID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PinnedChat fields.
+func (_m *PinnedChat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pinnedchat.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case pinnedchat.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case pinnedchat.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case pinnedchat.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case pinnedchat.FieldChatID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				_m.ChatID = *value
+			}
+		case pinnedchat.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PinnedChat.
+// This includes values selected through modifiers, order, etc.
+func (_m *PinnedChat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PinnedChat entity.
+func (_m *PinnedChat) QueryUser() *UserQuery {
+	return NewPinnedChatClient(_m.config).QueryUser(_m)
+}
+
+// QueryChat queries the "chat" edge of the PinnedChat entity.
+func (_m *PinnedChat) QueryChat() *ChatQuery {
+	return NewPinnedChatClient(_m.config).QueryChat(_m)
+}
+
+// Update returns a builder for updating this PinnedChat.
+// Note that you need to call PinnedChat.Unwrap() before calling this method if this PinnedChat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PinnedChat) Update() *PinnedChatUpdateOne {
+	return NewPinnedChatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PinnedChat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PinnedChat) Unwrap() *PinnedChat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PinnedChat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PinnedChat) String() string {
+	var builder strings.Builder
+	builder.WriteString("PinnedChat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PinnedChats is a parsable slice of PinnedChat.
+type PinnedChats []*PinnedChat
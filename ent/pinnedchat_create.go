@@ -0,0 +1,792 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PinnedChatCreate is the builder for creating a PinnedChat entity.
+type PinnedChatCreate struct {
+	config
+	mutation *PinnedChatMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PinnedChatCreate) SetCreatedAt(v time.Time) *PinnedChatCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PinnedChatCreate) SetNillableCreatedAt(v *time.Time) *PinnedChatCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PinnedChatCreate) SetUpdatedAt(v time.Time) *PinnedChatCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PinnedChatCreate) SetNillableUpdatedAt(v *time.Time) *PinnedChatCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PinnedChatCreate) SetUserID(v uuid.UUID) *PinnedChatCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *PinnedChatCreate) SetChatID(v uuid.UUID) *PinnedChatCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *PinnedChatCreate) SetPosition(v int) *PinnedChatCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *PinnedChatCreate) SetNillablePosition(v *int) *PinnedChatCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PinnedChatCreate) SetID(v uuid.UUID) *PinnedChatCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PinnedChatCreate) SetNillableID(v *uuid.UUID) *PinnedChatCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *PinnedChatCreate) SetUser(v *User) *PinnedChatCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_c *PinnedChatCreate) SetChat(v *Chat) *PinnedChatCreate {
+	return _c.SetChatID(v.ID)
+}
+
+// Mutation returns the PinnedChatMutation object of the builder.
+func (_c *PinnedChatCreate) Mutation() *PinnedChatMutation {
+	return _c.mutation
+}
+
+// Save creates the PinnedChat in the database.
+func (_c *PinnedChatCreate) Save(ctx context.Context) (*PinnedChat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PinnedChatCreate) SaveX(ctx context.Context) *PinnedChat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PinnedChatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PinnedChatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PinnedChatCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := pinnedchat.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := pinnedchat.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := pinnedchat.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := pinnedchat.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PinnedChatCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PinnedChat.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PinnedChat.updated_at"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PinnedChat.user_id"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "PinnedChat.chat_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PinnedChat.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := pinnedchat.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PinnedChat.position": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PinnedChat.user"`)}
+	}
+	if len(_c.mutation.ChatIDs()) == 0 {
+		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "PinnedChat.chat"`)}
+	}
+	return nil
+}
+
+func (_c *PinnedChatCreate) sqlSave(ctx context.Context) (*PinnedChat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PinnedChatCreate) createSpec() (*PinnedChat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PinnedChat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pinnedchat.Table, sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pinnedchat.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedchat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(pinnedchat.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.UserTable,
+			Columns: []string{pinnedchat.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.ChatTable,
+			Columns: []string{pinnedchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChatID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PinnedChat.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PinnedChatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PinnedChatCreate) OnConflict(opts ...sql.ConflictOption) *PinnedChatUpsertOne {
+	_c.conflict = opts
+	return &PinnedChatUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PinnedChat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PinnedChatCreate) OnConflictColumns(columns ...string) *PinnedChatUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PinnedChatUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PinnedChatUpsertOne is the builder for "upsert"-ing
+	//  one PinnedChat node.
+	PinnedChatUpsertOne struct {
+		create *PinnedChatCreate
+	}
+
+	// PinnedChatUpsert is the "OnConflict" setter.
+	PinnedChatUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PinnedChatUpsert) SetUpdatedAt(v time.Time) *PinnedChatUpsert {
+	u.Set(pinnedchat.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PinnedChatUpsert) UpdateUpdatedAt() *PinnedChatUpsert {
+	u.SetExcluded(pinnedchat.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PinnedChatUpsert) SetUserID(v uuid.UUID) *PinnedChatUpsert {
+	u.Set(pinnedchat.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PinnedChatUpsert) UpdateUserID() *PinnedChatUpsert {
+	u.SetExcluded(pinnedchat.FieldUserID)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *PinnedChatUpsert) SetChatID(v uuid.UUID) *PinnedChatUpsert {
+	u.Set(pinnedchat.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *PinnedChatUpsert) UpdateChatID() *PinnedChatUpsert {
+	u.SetExcluded(pinnedchat.FieldChatID)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *PinnedChatUpsert) SetPosition(v int) *PinnedChatUpsert {
+	u.Set(pinnedchat.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PinnedChatUpsert) UpdatePosition() *PinnedChatUpsert {
+	u.SetExcluded(pinnedchat.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PinnedChatUpsert) AddPosition(v int) *PinnedChatUpsert {
+	u.Add(pinnedchat.FieldPosition, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PinnedChat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pinnedchat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PinnedChatUpsertOne) UpdateNewValues() *PinnedChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pinnedchat.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pinnedchat.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PinnedChat.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PinnedChatUpsertOne) Ignore() *PinnedChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PinnedChatUpsertOne) DoNothing() *PinnedChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PinnedChatCreate.OnConflict
+// documentation for more info.
+func (u *PinnedChatUpsertOne) Update(set func(*PinnedChatUpsert)) *PinnedChatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PinnedChatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PinnedChatUpsertOne) SetUpdatedAt(v time.Time) *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PinnedChatUpsertOne) UpdateUpdatedAt() *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PinnedChatUpsertOne) SetUserID(v uuid.UUID) *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PinnedChatUpsertOne) UpdateUserID() *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *PinnedChatUpsertOne) SetChatID(v uuid.UUID) *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *PinnedChatUpsertOne) UpdateChatID() *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *PinnedChatUpsertOne) SetPosition(v int) *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PinnedChatUpsertOne) AddPosition(v int) *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PinnedChatUpsertOne) UpdatePosition() *PinnedChatUpsertOne {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *PinnedChatUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PinnedChatCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PinnedChatUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PinnedChatUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PinnedChatUpsertOne.ID is not supported by MySQL driver. Use PinnedChatUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PinnedChatUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PinnedChatCreateBulk is the builder for creating many PinnedChat entities in bulk.
+type PinnedChatCreateBulk struct {
+	config
+	err      error
+	builders []*PinnedChatCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PinnedChat entities in the database.
+func (_c *PinnedChatCreateBulk) Save(ctx context.Context) ([]*PinnedChat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PinnedChat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PinnedChatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PinnedChatCreateBulk) SaveX(ctx context.Context) []*PinnedChat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PinnedChatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PinnedChatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PinnedChat.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PinnedChatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PinnedChatCreateBulk) OnConflict(opts ...sql.ConflictOption) *PinnedChatUpsertBulk {
+	_c.conflict = opts
+	return &PinnedChatUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PinnedChat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PinnedChatCreateBulk) OnConflictColumns(columns ...string) *PinnedChatUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PinnedChatUpsertBulk{
+		create: _c,
+	}
+}
+
+// PinnedChatUpsertBulk is the builder for "upsert"-ing
+// a bulk of PinnedChat nodes.
+type PinnedChatUpsertBulk struct {
+	create *PinnedChatCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PinnedChat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pinnedchat.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PinnedChatUpsertBulk) UpdateNewValues() *PinnedChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pinnedchat.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pinnedchat.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PinnedChat.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PinnedChatUpsertBulk) Ignore() *PinnedChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PinnedChatUpsertBulk) DoNothing() *PinnedChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PinnedChatCreateBulk.OnConflict
+// documentation for more info.
+func (u *PinnedChatUpsertBulk) Update(set func(*PinnedChatUpsert)) *PinnedChatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PinnedChatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PinnedChatUpsertBulk) SetUpdatedAt(v time.Time) *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PinnedChatUpsertBulk) UpdateUpdatedAt() *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PinnedChatUpsertBulk) SetUserID(v uuid.UUID) *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PinnedChatUpsertBulk) UpdateUserID() *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *PinnedChatUpsertBulk) SetChatID(v uuid.UUID) *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *PinnedChatUpsertBulk) UpdateChatID() *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *PinnedChatUpsertBulk) SetPosition(v int) *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PinnedChatUpsertBulk) AddPosition(v int) *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PinnedChatUpsertBulk) UpdatePosition() *PinnedChatUpsertBulk {
+	return u.Update(func(s *PinnedChatUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *PinnedChatUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PinnedChatCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PinnedChatCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PinnedChatUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
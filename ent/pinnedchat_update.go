@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PinnedChatUpdate is the builder for updating PinnedChat entities.
+type PinnedChatUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PinnedChatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PinnedChatUpdate builder.
+func (_u *PinnedChatUpdate) Where(ps ...predicate.PinnedChat) *PinnedChatUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PinnedChatUpdate) SetUpdatedAt(v time.Time) *PinnedChatUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PinnedChatUpdate) SetUserID(v uuid.UUID) *PinnedChatUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PinnedChatUpdate) SetNillableUserID(v *uuid.UUID) *PinnedChatUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *PinnedChatUpdate) SetChatID(v uuid.UUID) *PinnedChatUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *PinnedChatUpdate) SetNillableChatID(v *uuid.UUID) *PinnedChatUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PinnedChatUpdate) SetPosition(v int) *PinnedChatUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PinnedChatUpdate) SetNillablePosition(v *int) *PinnedChatUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PinnedChatUpdate) AddPosition(v int) *PinnedChatUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PinnedChatUpdate) SetUser(v *User) *PinnedChatUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *PinnedChatUpdate) SetChat(v *Chat) *PinnedChatUpdate {
+	return _u.SetChatID(v.ID)
+}
+
+// Mutation returns the PinnedChatMutation object of the builder.
+func (_u *PinnedChatUpdate) Mutation() *PinnedChatMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PinnedChatUpdate) ClearUser() *PinnedChatUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *PinnedChatUpdate) ClearChat() *PinnedChatUpdate {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PinnedChatUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PinnedChatUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PinnedChatUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PinnedChatUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PinnedChatUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pinnedchat.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PinnedChatUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := pinnedchat.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PinnedChat.position": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedChat.user"`)
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedChat.chat"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PinnedChatUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PinnedChatUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PinnedChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedchat.Table, pinnedchat.Columns, sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedchat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(pinnedchat.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(pinnedchat.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.UserTable,
+			Columns: []string{pinnedchat.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.UserTable,
+			Columns: []string{pinnedchat.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.ChatTable,
+			Columns: []string{pinnedchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.ChatTable,
+			Columns: []string{pinnedchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedchat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PinnedChatUpdateOne is the builder for updating a single PinnedChat entity.
+type PinnedChatUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PinnedChatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PinnedChatUpdateOne) SetUpdatedAt(v time.Time) *PinnedChatUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PinnedChatUpdateOne) SetUserID(v uuid.UUID) *PinnedChatUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PinnedChatUpdateOne) SetNillableUserID(v *uuid.UUID) *PinnedChatUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *PinnedChatUpdateOne) SetChatID(v uuid.UUID) *PinnedChatUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *PinnedChatUpdateOne) SetNillableChatID(v *uuid.UUID) *PinnedChatUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *PinnedChatUpdateOne) SetPosition(v int) *PinnedChatUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *PinnedChatUpdateOne) SetNillablePosition(v *int) *PinnedChatUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *PinnedChatUpdateOne) AddPosition(v int) *PinnedChatUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *PinnedChatUpdateOne) SetUser(v *User) *PinnedChatUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *PinnedChatUpdateOne) SetChat(v *Chat) *PinnedChatUpdateOne {
+	return _u.SetChatID(v.ID)
+}
+
+// Mutation returns the PinnedChatMutation object of the builder.
+func (_u *PinnedChatUpdateOne) Mutation() *PinnedChatMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *PinnedChatUpdateOne) ClearUser() *PinnedChatUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *PinnedChatUpdateOne) ClearChat() *PinnedChatUpdateOne {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// Where appends a list predicates to the PinnedChatUpdate builder.
+func (_u *PinnedChatUpdateOne) Where(ps ...predicate.PinnedChat) *PinnedChatUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PinnedChatUpdateOne) Select(field string, fields ...string) *PinnedChatUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PinnedChat entity.
+func (_u *PinnedChatUpdateOne) Save(ctx context.Context) (*PinnedChat, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PinnedChatUpdateOne) SaveX(ctx context.Context) *PinnedChat {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PinnedChatUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PinnedChatUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PinnedChatUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pinnedchat.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PinnedChatUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := pinnedchat.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PinnedChat.position": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedChat.user"`)
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedChat.chat"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PinnedChatUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PinnedChatUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PinnedChatUpdateOne) sqlSave(ctx context.Context) (_node *PinnedChat, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedchat.Table, pinnedchat.Columns, sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PinnedChat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedchat.FieldID)
+		for _, f := range fields {
+			if !pinnedchat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pinnedchat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedchat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(pinnedchat.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(pinnedchat.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.UserTable,
+			Columns: []string{pinnedchat.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.UserTable,
+			Columns: []string{pinnedchat.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.ChatTable,
+			Columns: []string{pinnedchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedchat.ChatTable,
+			Columns: []string{pinnedchat.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PinnedChat{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedchat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PinnedMessage is the model entity for the PinnedMessage schema.
+type PinnedMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID uuid.UUID `json:"chat_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID uuid.UUID `json:"message_id,omitempty"`
+	// PinnedByID holds the value of the "pinned_by_id" field.
+	PinnedByID *uuid.UUID `json:"pinned_by_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PinnedMessageQuery when eager-loading is set.
+	Edges        PinnedMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PinnedMessageEdges holds the relations/edges for other nodes in the graph.
+type PinnedMessageEdges struct {
+	// Chat holds the value of the chat edge.
+	Chat *Chat `json:"chat,omitempty"`
+	// Message holds the value of the message edge.
+	Message *Message `json:"message,omitempty"`
+	// PinnedBy holds the value of the pinned_by edge.
+	PinnedBy *User `json:"pinned_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ChatOrErr returns the Chat value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) ChatOrErr() (*Chat, error) {
+	if e.Chat != nil {
+		return e.Chat, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: chat.Label}
+	}
+	return nil, &NotLoadedError{edge: "chat"}
+}
+
+// MessageOrErr returns the Message value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) MessageOrErr() (*Message, error) {
+	if e.Message != nil {
+		return e.Message, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "message"}
+}
+
+// PinnedByOrErr returns the PinnedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PinnedMessageEdges) PinnedByOrErr() (*User, error) {
+	if e.PinnedBy != nil {
+		return e.PinnedBy, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "pinned_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PinnedMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pinnedmessage.FieldPinnedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case pinnedmessage.FieldCreatedAt, pinnedmessage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case pinnedmessage.FieldID, pinnedmessage.FieldChatID, pinnedmessage.FieldMessageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PinnedMessage fields.
+func (_m *PinnedMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pinnedmessage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case pinnedmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case pinnedmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case pinnedmessage.FieldChatID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				_m.ChatID = *value
+			}
+		case pinnedmessage.FieldMessageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				_m.MessageID = *value
+			}
+		case pinnedmessage.FieldPinnedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned_by_id", values[i])
+			} else if value.Valid {
+				_m.PinnedByID = new(uuid.UUID)
+				*_m.PinnedByID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PinnedMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *PinnedMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryChat queries the "chat" edge of the PinnedMessage entity.
+func (_m *PinnedMessage) QueryChat() *ChatQuery {
+	return NewPinnedMessageClient(_m.config).QueryChat(_m)
+}
+
+// QueryMessage queries the "message" edge of the PinnedMessage entity.
+func (_m *PinnedMessage) QueryMessage() *MessageQuery {
+	return NewPinnedMessageClient(_m.config).QueryMessage(_m)
+}
+
+// QueryPinnedBy queries the "pinned_by" edge of the PinnedMessage entity.
+func (_m *PinnedMessage) QueryPinnedBy() *UserQuery {
+	return NewPinnedMessageClient(_m.config).QueryPinnedBy(_m)
+}
+
+// Update returns a builder for updating this PinnedMessage.
+// Note that you need to call PinnedMessage.Unwrap() before calling this method if this PinnedMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PinnedMessage) Update() *PinnedMessageUpdateOne {
+	return NewPinnedMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PinnedMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PinnedMessage) Unwrap() *PinnedMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PinnedMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PinnedMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("PinnedMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageID))
+	builder.WriteString(", ")
+	if v := _m.PinnedByID; v != nil {
+		builder.WriteString("pinned_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PinnedMessages is a parsable slice of PinnedMessage.
+type PinnedMessages []*PinnedMessage
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pinnedmessage
+
+import (
+	"AtoiTalkAPI/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// PinnedByID applies equality check predicate on the "pinned_by_id" field. It's identical to PinnedByIDEQ.
+func PinnedByID(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedByID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// PinnedByIDEQ applies the EQ predicate on the "pinned_by_id" field.
+func PinnedByIDEQ(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldEQ(FieldPinnedByID, v))
+}
+
+// PinnedByIDNEQ applies the NEQ predicate on the "pinned_by_id" field.
+func PinnedByIDNEQ(v uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNEQ(FieldPinnedByID, v))
+}
+
+// PinnedByIDIn applies the In predicate on the "pinned_by_id" field.
+func PinnedByIDIn(vs ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIn(FieldPinnedByID, vs...))
+}
+
+// PinnedByIDNotIn applies the NotIn predicate on the "pinned_by_id" field.
+func PinnedByIDNotIn(vs ...uuid.UUID) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotIn(FieldPinnedByID, vs...))
+}
+
+// PinnedByIDIsNil applies the IsNil predicate on the "pinned_by_id" field.
+func PinnedByIDIsNil() predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldIsNull(FieldPinnedByID))
+}
+
+// PinnedByIDNotNil applies the NotNil predicate on the "pinned_by_id" field.
+func PinnedByIDNotNil() predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.FieldNotNull(FieldPinnedByID))
+}
+
+// HasChat applies the HasEdge predicate on the "chat" edge.
+func HasChat() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChatTable, ChatColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatWith applies the HasEdge predicate on the "chat" edge with a given conditions (other predicates).
+func HasChatWith(preds ...predicate.Chat) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newChatStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMessage applies the HasEdge predicate on the "message" edge.
+func HasMessage() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MessageTable, MessageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageWith applies the HasEdge predicate on the "message" edge with a given conditions (other predicates).
+func HasMessageWith(preds ...predicate.Message) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newMessageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPinnedBy applies the HasEdge predicate on the "pinned_by" edge.
+func HasPinnedBy() predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PinnedByTable, PinnedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedByWith applies the HasEdge predicate on the "pinned_by" edge with a given conditions (other predicates).
+func HasPinnedByWith(preds ...predicate.User) predicate.PinnedMessage {
+	return predicate.PinnedMessage(func(s *sql.Selector) {
+		step := newPinnedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PinnedMessage) predicate.PinnedMessage {
+	return predicate.PinnedMessage(sql.NotPredicates(p))
+}
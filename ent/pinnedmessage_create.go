@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PinnedMessageCreate is the builder for creating a PinnedMessage entity.
+type PinnedMessageCreate struct {
+	config
+	mutation *PinnedMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PinnedMessageCreate) SetCreatedAt(v time.Time) *PinnedMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PinnedMessageCreate) SetNillableCreatedAt(v *time.Time) *PinnedMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PinnedMessageCreate) SetUpdatedAt(v time.Time) *PinnedMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PinnedMessageCreate) SetNillableUpdatedAt(v *time.Time) *PinnedMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *PinnedMessageCreate) SetChatID(v uuid.UUID) *PinnedMessageCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *PinnedMessageCreate) SetMessageID(v uuid.UUID) *PinnedMessageCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (_c *PinnedMessageCreate) SetPinnedByID(v uuid.UUID) *PinnedMessageCreate {
+	_c.mutation.SetPinnedByID(v)
+	return _c
+}
+
+// SetNillablePinnedByID sets the "pinned_by_id" field if the given value is not nil.
+func (_c *PinnedMessageCreate) SetNillablePinnedByID(v *uuid.UUID) *PinnedMessageCreate {
+	if v != nil {
+		_c.SetPinnedByID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PinnedMessageCreate) SetID(v uuid.UUID) *PinnedMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PinnedMessageCreate) SetNillableID(v *uuid.UUID) *PinnedMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_c *PinnedMessageCreate) SetChat(v *Chat) *PinnedMessageCreate {
+	return _c.SetChatID(v.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *PinnedMessageCreate) SetMessage(v *Message) *PinnedMessageCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// SetPinnedBy sets the "pinned_by" edge to the User entity.
+func (_c *PinnedMessageCreate) SetPinnedBy(v *User) *PinnedMessageCreate {
+	return _c.SetPinnedByID(v.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (_c *PinnedMessageCreate) Mutation() *PinnedMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the PinnedMessage in the database.
+func (_c *PinnedMessageCreate) Save(ctx context.Context) (*PinnedMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PinnedMessageCreate) SaveX(ctx context.Context) *PinnedMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PinnedMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PinnedMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PinnedMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := pinnedmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := pinnedmessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := pinnedmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PinnedMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PinnedMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PinnedMessage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "PinnedMessage.chat_id"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "PinnedMessage.message_id"`)}
+	}
+	if len(_c.mutation.ChatIDs()) == 0 {
+		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "PinnedMessage.chat"`)}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "PinnedMessage.message"`)}
+	}
+	return nil
+}
+
+func (_c *PinnedMessageCreate) sqlSave(ctx context.Context) (*PinnedMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PinnedMessageCreate) createSpec() (*PinnedMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PinnedMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pinnedmessage.Table, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.ChatTable,
+			Columns: []string{pinnedmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChatID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PinnedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PinnedMessage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PinnedMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PinnedMessageCreate) OnConflict(opts ...sql.ConflictOption) *PinnedMessageUpsertOne {
+	_c.conflict = opts
+	return &PinnedMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PinnedMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PinnedMessageCreate) OnConflictColumns(columns ...string) *PinnedMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PinnedMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PinnedMessageUpsertOne is the builder for "upsert"-ing
+	//  one PinnedMessage node.
+	PinnedMessageUpsertOne struct {
+		create *PinnedMessageCreate
+	}
+
+	// PinnedMessageUpsert is the "OnConflict" setter.
+	PinnedMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PinnedMessageUpsert) SetUpdatedAt(v time.Time) *PinnedMessageUpsert {
+	u.Set(pinnedmessage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PinnedMessageUpsert) UpdateUpdatedAt() *PinnedMessageUpsert {
+	u.SetExcluded(pinnedmessage.FieldUpdatedAt)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *PinnedMessageUpsert) SetChatID(v uuid.UUID) *PinnedMessageUpsert {
+	u.Set(pinnedmessage.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsert) UpdateChatID() *PinnedMessageUpsert {
+	u.SetExcluded(pinnedmessage.FieldChatID)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *PinnedMessageUpsert) SetMessageID(v uuid.UUID) *PinnedMessageUpsert {
+	u.Set(pinnedmessage.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsert) UpdateMessageID() *PinnedMessageUpsert {
+	u.SetExcluded(pinnedmessage.FieldMessageID)
+	return u
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (u *PinnedMessageUpsert) SetPinnedByID(v uuid.UUID) *PinnedMessageUpsert {
+	u.Set(pinnedmessage.FieldPinnedByID, v)
+	return u
+}
+
+// UpdatePinnedByID sets the "pinned_by_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsert) UpdatePinnedByID() *PinnedMessageUpsert {
+	u.SetExcluded(pinnedmessage.FieldPinnedByID)
+	return u
+}
+
+// ClearPinnedByID clears the value of the "pinned_by_id" field.
+func (u *PinnedMessageUpsert) ClearPinnedByID() *PinnedMessageUpsert {
+	u.SetNull(pinnedmessage.FieldPinnedByID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PinnedMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pinnedmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PinnedMessageUpsertOne) UpdateNewValues() *PinnedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pinnedmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pinnedmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PinnedMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PinnedMessageUpsertOne) Ignore() *PinnedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PinnedMessageUpsertOne) DoNothing() *PinnedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PinnedMessageCreate.OnConflict
+// documentation for more info.
+func (u *PinnedMessageUpsertOne) Update(set func(*PinnedMessageUpsert)) *PinnedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PinnedMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PinnedMessageUpsertOne) SetUpdatedAt(v time.Time) *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PinnedMessageUpsertOne) UpdateUpdatedAt() *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *PinnedMessageUpsertOne) SetChatID(v uuid.UUID) *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsertOne) UpdateChatID() *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *PinnedMessageUpsertOne) SetMessageID(v uuid.UUID) *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsertOne) UpdateMessageID() *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (u *PinnedMessageUpsertOne) SetPinnedByID(v uuid.UUID) *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetPinnedByID(v)
+	})
+}
+
+// UpdatePinnedByID sets the "pinned_by_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsertOne) UpdatePinnedByID() *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdatePinnedByID()
+	})
+}
+
+// ClearPinnedByID clears the value of the "pinned_by_id" field.
+func (u *PinnedMessageUpsertOne) ClearPinnedByID() *PinnedMessageUpsertOne {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.ClearPinnedByID()
+	})
+}
+
+// Exec executes the query.
+func (u *PinnedMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PinnedMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PinnedMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PinnedMessageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PinnedMessageUpsertOne.ID is not supported by MySQL driver. Use PinnedMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PinnedMessageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PinnedMessageCreateBulk is the builder for creating many PinnedMessage entities in bulk.
+type PinnedMessageCreateBulk struct {
+	config
+	err      error
+	builders []*PinnedMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PinnedMessage entities in the database.
+func (_c *PinnedMessageCreateBulk) Save(ctx context.Context) ([]*PinnedMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PinnedMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PinnedMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PinnedMessageCreateBulk) SaveX(ctx context.Context) []*PinnedMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PinnedMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PinnedMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PinnedMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PinnedMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PinnedMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *PinnedMessageUpsertBulk {
+	_c.conflict = opts
+	return &PinnedMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PinnedMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PinnedMessageCreateBulk) OnConflictColumns(columns ...string) *PinnedMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PinnedMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// PinnedMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of PinnedMessage nodes.
+type PinnedMessageUpsertBulk struct {
+	create *PinnedMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PinnedMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pinnedmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PinnedMessageUpsertBulk) UpdateNewValues() *PinnedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pinnedmessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pinnedmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PinnedMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PinnedMessageUpsertBulk) Ignore() *PinnedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PinnedMessageUpsertBulk) DoNothing() *PinnedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PinnedMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *PinnedMessageUpsertBulk) Update(set func(*PinnedMessageUpsert)) *PinnedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PinnedMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PinnedMessageUpsertBulk) SetUpdatedAt(v time.Time) *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PinnedMessageUpsertBulk) UpdateUpdatedAt() *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *PinnedMessageUpsertBulk) SetChatID(v uuid.UUID) *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsertBulk) UpdateChatID() *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *PinnedMessageUpsertBulk) SetMessageID(v uuid.UUID) *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsertBulk) UpdateMessageID() *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (u *PinnedMessageUpsertBulk) SetPinnedByID(v uuid.UUID) *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.SetPinnedByID(v)
+	})
+}
+
+// UpdatePinnedByID sets the "pinned_by_id" field to the value that was provided on create.
+func (u *PinnedMessageUpsertBulk) UpdatePinnedByID() *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.UpdatePinnedByID()
+	})
+}
+
+// ClearPinnedByID clears the value of the "pinned_by_id" field.
+func (u *PinnedMessageUpsertBulk) ClearPinnedByID() *PinnedMessageUpsertBulk {
+	return u.Update(func(s *PinnedMessageUpsert) {
+		s.ClearPinnedByID()
+	})
+}
+
+// Exec executes the query.
+func (u *PinnedMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PinnedMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PinnedMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PinnedMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PinnedMessageUpdate is the builder for updating PinnedMessage entities.
+type PinnedMessageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PinnedMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PinnedMessageUpdate builder.
+func (_u *PinnedMessageUpdate) Where(ps ...predicate.PinnedMessage) *PinnedMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PinnedMessageUpdate) SetUpdatedAt(v time.Time) *PinnedMessageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *PinnedMessageUpdate) SetChatID(v uuid.UUID) *PinnedMessageUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *PinnedMessageUpdate) SetNillableChatID(v *uuid.UUID) *PinnedMessageUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *PinnedMessageUpdate) SetMessageID(v uuid.UUID) *PinnedMessageUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *PinnedMessageUpdate) SetNillableMessageID(v *uuid.UUID) *PinnedMessageUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (_u *PinnedMessageUpdate) SetPinnedByID(v uuid.UUID) *PinnedMessageUpdate {
+	_u.mutation.SetPinnedByID(v)
+	return _u
+}
+
+// SetNillablePinnedByID sets the "pinned_by_id" field if the given value is not nil.
+func (_u *PinnedMessageUpdate) SetNillablePinnedByID(v *uuid.UUID) *PinnedMessageUpdate {
+	if v != nil {
+		_u.SetPinnedByID(*v)
+	}
+	return _u
+}
+
+// ClearPinnedByID clears the value of the "pinned_by_id" field.
+func (_u *PinnedMessageUpdate) ClearPinnedByID() *PinnedMessageUpdate {
+	_u.mutation.ClearPinnedByID()
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *PinnedMessageUpdate) SetChat(v *Chat) *PinnedMessageUpdate {
+	return _u.SetChatID(v.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *PinnedMessageUpdate) SetMessage(v *Message) *PinnedMessageUpdate {
+	return _u.SetMessageID(v.ID)
+}
+
+// SetPinnedBy sets the "pinned_by" edge to the User entity.
+func (_u *PinnedMessageUpdate) SetPinnedBy(v *User) *PinnedMessageUpdate {
+	return _u.SetPinnedByID(v.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (_u *PinnedMessageUpdate) Mutation() *PinnedMessageMutation {
+	return _u.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *PinnedMessageUpdate) ClearChat() *PinnedMessageUpdate {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *PinnedMessageUpdate) ClearMessage() *PinnedMessageUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the User entity.
+func (_u *PinnedMessageUpdate) ClearPinnedBy() *PinnedMessageUpdate {
+	_u.mutation.ClearPinnedBy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PinnedMessageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PinnedMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PinnedMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PinnedMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PinnedMessageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pinnedmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PinnedMessageUpdate) check() error {
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.chat"`)
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PinnedMessageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PinnedMessageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PinnedMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.ChatTable,
+			Columns: []string{pinnedmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.ChatTable,
+			Columns: []string{pinnedmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PinnedMessageUpdateOne is the builder for updating a single PinnedMessage entity.
+type PinnedMessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PinnedMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PinnedMessageUpdateOne) SetUpdatedAt(v time.Time) *PinnedMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *PinnedMessageUpdateOne) SetChatID(v uuid.UUID) *PinnedMessageUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *PinnedMessageUpdateOne) SetNillableChatID(v *uuid.UUID) *PinnedMessageUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *PinnedMessageUpdateOne) SetMessageID(v uuid.UUID) *PinnedMessageUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *PinnedMessageUpdateOne) SetNillableMessageID(v *uuid.UUID) *PinnedMessageUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetPinnedByID sets the "pinned_by_id" field.
+func (_u *PinnedMessageUpdateOne) SetPinnedByID(v uuid.UUID) *PinnedMessageUpdateOne {
+	_u.mutation.SetPinnedByID(v)
+	return _u
+}
+
+// SetNillablePinnedByID sets the "pinned_by_id" field if the given value is not nil.
+func (_u *PinnedMessageUpdateOne) SetNillablePinnedByID(v *uuid.UUID) *PinnedMessageUpdateOne {
+	if v != nil {
+		_u.SetPinnedByID(*v)
+	}
+	return _u
+}
+
+// ClearPinnedByID clears the value of the "pinned_by_id" field.
+func (_u *PinnedMessageUpdateOne) ClearPinnedByID() *PinnedMessageUpdateOne {
+	_u.mutation.ClearPinnedByID()
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *PinnedMessageUpdateOne) SetChat(v *Chat) *PinnedMessageUpdateOne {
+	return _u.SetChatID(v.ID)
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *PinnedMessageUpdateOne) SetMessage(v *Message) *PinnedMessageUpdateOne {
+	return _u.SetMessageID(v.ID)
+}
+
+// SetPinnedBy sets the "pinned_by" edge to the User entity.
+func (_u *PinnedMessageUpdateOne) SetPinnedBy(v *User) *PinnedMessageUpdateOne {
+	return _u.SetPinnedByID(v.ID)
+}
+
+// Mutation returns the PinnedMessageMutation object of the builder.
+func (_u *PinnedMessageUpdateOne) Mutation() *PinnedMessageMutation {
+	return _u.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *PinnedMessageUpdateOne) ClearChat() *PinnedMessageUpdateOne {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *PinnedMessageUpdateOne) ClearMessage() *PinnedMessageUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the User entity.
+func (_u *PinnedMessageUpdateOne) ClearPinnedBy() *PinnedMessageUpdateOne {
+	_u.mutation.ClearPinnedBy()
+	return _u
+}
+
+// Where appends a list predicates to the PinnedMessageUpdate builder.
+func (_u *PinnedMessageUpdateOne) Where(ps ...predicate.PinnedMessage) *PinnedMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PinnedMessageUpdateOne) Select(field string, fields ...string) *PinnedMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PinnedMessage entity.
+func (_u *PinnedMessageUpdateOne) Save(ctx context.Context) (*PinnedMessage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PinnedMessageUpdateOne) SaveX(ctx context.Context) *PinnedMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PinnedMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PinnedMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PinnedMessageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := pinnedmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PinnedMessageUpdateOne) check() error {
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.chat"`)
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PinnedMessage.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PinnedMessageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PinnedMessageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PinnedMessageUpdateOne) sqlSave(ctx context.Context) (_node *PinnedMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pinnedmessage.Table, pinnedmessage.Columns, sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PinnedMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pinnedmessage.FieldID)
+		for _, f := range fields {
+			if !pinnedmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pinnedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(pinnedmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.ChatTable,
+			Columns: []string{pinnedmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.ChatTable,
+			Columns: []string{pinnedmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.MessageTable,
+			Columns: []string{pinnedmessage.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PinnedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinnedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pinnedmessage.PinnedByTable,
+			Columns: []string{pinnedmessage.PinnedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PinnedMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pinnedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PollCreate is the builder for creating a Poll entity.
+type PollCreate struct {
+	config
+	mutation *PollMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PollCreate) SetCreatedAt(v time.Time) *PollCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableCreatedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PollCreate) SetUpdatedAt(v time.Time) *PollCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableUpdatedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *PollCreate) SetMessageID(v uuid.UUID) *PollCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetQuestion sets the "question" field.
+func (_c *PollCreate) SetQuestion(v string) *PollCreate {
+	_c.mutation.SetQuestion(v)
+	return _c
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (_c *PollCreate) SetMultipleChoice(v bool) *PollCreate {
+	_c.mutation.SetMultipleChoice(v)
+	return _c
+}
+
+// SetNillableMultipleChoice sets the "multiple_choice" field if the given value is not nil.
+func (_c *PollCreate) SetNillableMultipleChoice(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetMultipleChoice(*v)
+	}
+	return _c
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_c *PollCreate) SetAnonymous(v bool) *PollCreate {
+	_c.mutation.SetAnonymous(v)
+	return _c
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_c *PollCreate) SetNillableAnonymous(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetAnonymous(*v)
+	}
+	return _c
+}
+
+// SetQuiz sets the "quiz" field.
+func (_c *PollCreate) SetQuiz(v bool) *PollCreate {
+	_c.mutation.SetQuiz(v)
+	return _c
+}
+
+// SetNillableQuiz sets the "quiz" field if the given value is not nil.
+func (_c *PollCreate) SetNillableQuiz(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetQuiz(*v)
+	}
+	return _c
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_c *PollCreate) SetClosesAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosesAt(v)
+	return _c
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosesAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosesAt(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *PollCreate) SetClosedAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PollCreate) SetID(v uuid.UUID) *PollCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PollCreate) SetNillableID(v *uuid.UUID) *PollCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_c *PollCreate) SetMessage(v *Message) *PollCreate {
+	return _c.SetMessageID(v.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
+func (_c *PollCreate) AddOptionIDs(ids ...uuid.UUID) *PollCreate {
+	_c.mutation.AddOptionIDs(ids...)
+	return _c
+}
+
+// AddOptions adds the "options" edges to the PollOption entity.
+func (_c *PollCreate) AddOptions(v ...*PollOption) *PollCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOptionIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (_c *PollCreate) AddVoteIDs(ids ...uuid.UUID) *PollCreate {
+	_c.mutation.AddVoteIDs(ids...)
+	return _c
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (_c *PollCreate) AddVotes(v ...*PollVote) *PollCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (_c *PollCreate) Mutation() *PollMutation {
+	return _c.mutation
+}
+
+// Save creates the Poll in the database.
+func (_c *PollCreate) Save(ctx context.Context) (*Poll, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PollCreate) SaveX(ctx context.Context) *Poll {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PollCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := poll.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := poll.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.MultipleChoice(); !ok {
+		v := poll.DefaultMultipleChoice
+		_c.mutation.SetMultipleChoice(v)
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		v := poll.DefaultAnonymous
+		_c.mutation.SetAnonymous(v)
+	}
+	if _, ok := _c.mutation.Quiz(); !ok {
+		v := poll.DefaultQuiz
+		_c.mutation.SetQuiz(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := poll.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PollCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Poll.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Poll.updated_at"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "Poll.message_id"`)}
+	}
+	if _, ok := _c.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "Poll.question"`)}
+	}
+	if v, ok := _c.mutation.Question(); ok {
+		if err := poll.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "Poll.question": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MultipleChoice(); !ok {
+		return &ValidationError{Name: "multiple_choice", err: errors.New(`ent: missing required field "Poll.multiple_choice"`)}
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "Poll.anonymous"`)}
+	}
+	if _, ok := _c.mutation.Quiz(); !ok {
+		return &ValidationError{Name: "quiz", err: errors.New(`ent: missing required field "Poll.quiz"`)}
+	}
+	if len(_c.mutation.MessageIDs()) == 0 {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required edge "Poll.message"`)}
+	}
+	return nil
+}
+
+func (_c *PollCreate) sqlSave(ctx context.Context) (*Poll, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Poll{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(poll.Table, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Question(); ok {
+		_spec.SetField(poll.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := _c.mutation.MultipleChoice(); ok {
+		_spec.SetField(poll.FieldMultipleChoice, field.TypeBool, value)
+		_node.MultipleChoice = value
+	}
+	if value, ok := _c.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
+	if value, ok := _c.mutation.Quiz(); ok {
+		_spec.SetField(poll.FieldQuiz, field.TypeBool, value)
+		_node.Quiz = value
+	}
+	if value, ok := _c.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if nodes := _c.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MessageID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Poll.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PollUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PollCreate) OnConflict(opts ...sql.ConflictOption) *PollUpsertOne {
+	_c.conflict = opts
+	return &PollUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PollCreate) OnConflictColumns(columns ...string) *PollUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PollUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PollUpsertOne is the builder for "upsert"-ing
+	//  one Poll node.
+	PollUpsertOne struct {
+		create *PollCreate
+	}
+
+	// PollUpsert is the "OnConflict" setter.
+	PollUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollUpsert) SetUpdatedAt(v time.Time) *PollUpsert {
+	u.Set(poll.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollUpsert) UpdateUpdatedAt() *PollUpsert {
+	u.SetExcluded(poll.FieldUpdatedAt)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *PollUpsert) SetMessageID(v uuid.UUID) *PollUpsert {
+	u.Set(poll.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *PollUpsert) UpdateMessageID() *PollUpsert {
+	u.SetExcluded(poll.FieldMessageID)
+	return u
+}
+
+// SetQuestion sets the "question" field.
+func (u *PollUpsert) SetQuestion(v string) *PollUpsert {
+	u.Set(poll.FieldQuestion, v)
+	return u
+}
+
+// UpdateQuestion sets the "question" field to the value that was provided on create.
+func (u *PollUpsert) UpdateQuestion() *PollUpsert {
+	u.SetExcluded(poll.FieldQuestion)
+	return u
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (u *PollUpsert) SetMultipleChoice(v bool) *PollUpsert {
+	u.Set(poll.FieldMultipleChoice, v)
+	return u
+}
+
+// UpdateMultipleChoice sets the "multiple_choice" field to the value that was provided on create.
+func (u *PollUpsert) UpdateMultipleChoice() *PollUpsert {
+	u.SetExcluded(poll.FieldMultipleChoice)
+	return u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (u *PollUpsert) SetAnonymous(v bool) *PollUpsert {
+	u.Set(poll.FieldAnonymous, v)
+	return u
+}
+
+// UpdateAnonymous sets the "anonymous" field to the value that was provided on create.
+func (u *PollUpsert) UpdateAnonymous() *PollUpsert {
+	u.SetExcluded(poll.FieldAnonymous)
+	return u
+}
+
+// SetQuiz sets the "quiz" field.
+func (u *PollUpsert) SetQuiz(v bool) *PollUpsert {
+	u.Set(poll.FieldQuiz, v)
+	return u
+}
+
+// UpdateQuiz sets the "quiz" field to the value that was provided on create.
+func (u *PollUpsert) UpdateQuiz() *PollUpsert {
+	u.SetExcluded(poll.FieldQuiz)
+	return u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *PollUpsert) SetClosesAt(v time.Time) *PollUpsert {
+	u.Set(poll.FieldClosesAt, v)
+	return u
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *PollUpsert) UpdateClosesAt() *PollUpsert {
+	u.SetExcluded(poll.FieldClosesAt)
+	return u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *PollUpsert) ClearClosesAt() *PollUpsert {
+	u.SetNull(poll.FieldClosesAt)
+	return u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *PollUpsert) SetClosedAt(v time.Time) *PollUpsert {
+	u.Set(poll.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *PollUpsert) UpdateClosedAt() *PollUpsert {
+	u.SetExcluded(poll.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *PollUpsert) ClearClosedAt() *PollUpsert {
+	u.SetNull(poll.FieldClosedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(poll.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PollUpsertOne) UpdateNewValues() *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(poll.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(poll.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PollUpsertOne) Ignore() *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PollUpsertOne) DoNothing() *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PollCreate.OnConflict
+// documentation for more info.
+func (u *PollUpsertOne) Update(set func(*PollUpsert)) *PollUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PollUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollUpsertOne) SetUpdatedAt(v time.Time) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateUpdatedAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *PollUpsertOne) SetMessageID(v uuid.UUID) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateMessageID() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetQuestion sets the "question" field.
+func (u *PollUpsertOne) SetQuestion(v string) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetQuestion(v)
+	})
+}
+
+// UpdateQuestion sets the "question" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateQuestion() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateQuestion()
+	})
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (u *PollUpsertOne) SetMultipleChoice(v bool) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetMultipleChoice(v)
+	})
+}
+
+// UpdateMultipleChoice sets the "multiple_choice" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateMultipleChoice() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateMultipleChoice()
+	})
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (u *PollUpsertOne) SetAnonymous(v bool) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetAnonymous(v)
+	})
+}
+
+// UpdateAnonymous sets the "anonymous" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateAnonymous() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateAnonymous()
+	})
+}
+
+// SetQuiz sets the "quiz" field.
+func (u *PollUpsertOne) SetQuiz(v bool) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetQuiz(v)
+	})
+}
+
+// UpdateQuiz sets the "quiz" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateQuiz() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateQuiz()
+	})
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *PollUpsertOne) SetClosesAt(v time.Time) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetClosesAt(v)
+	})
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateClosesAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateClosesAt()
+	})
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *PollUpsertOne) ClearClosesAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.ClearClosesAt()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *PollUpsertOne) SetClosedAt(v time.Time) *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *PollUpsertOne) UpdateClosedAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *PollUpsertOne) ClearClosedAt() *PollUpsertOne {
+	return u.Update(func(s *PollUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PollUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PollCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PollUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PollUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PollUpsertOne.ID is not supported by MySQL driver. Use PollUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PollUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PollCreateBulk is the builder for creating many Poll entities in bulk.
+type PollCreateBulk struct {
+	config
+	err      error
+	builders []*PollCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Poll entities in the database.
+func (_c *PollCreateBulk) Save(ctx context.Context) ([]*Poll, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Poll, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PollCreateBulk) SaveX(ctx context.Context) []*Poll {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Poll.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PollUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PollCreateBulk) OnConflict(opts ...sql.ConflictOption) *PollUpsertBulk {
+	_c.conflict = opts
+	return &PollUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PollCreateBulk) OnConflictColumns(columns ...string) *PollUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PollUpsertBulk{
+		create: _c,
+	}
+}
+
+// PollUpsertBulk is the builder for "upsert"-ing
+// a bulk of Poll nodes.
+type PollUpsertBulk struct {
+	create *PollCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(poll.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PollUpsertBulk) UpdateNewValues() *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(poll.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(poll.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Poll.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PollUpsertBulk) Ignore() *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PollUpsertBulk) DoNothing() *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PollCreateBulk.OnConflict
+// documentation for more info.
+func (u *PollUpsertBulk) Update(set func(*PollUpsert)) *PollUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PollUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollUpsertBulk) SetUpdatedAt(v time.Time) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateUpdatedAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *PollUpsertBulk) SetMessageID(v uuid.UUID) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateMessageID() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// SetQuestion sets the "question" field.
+func (u *PollUpsertBulk) SetQuestion(v string) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetQuestion(v)
+	})
+}
+
+// UpdateQuestion sets the "question" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateQuestion() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateQuestion()
+	})
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (u *PollUpsertBulk) SetMultipleChoice(v bool) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetMultipleChoice(v)
+	})
+}
+
+// UpdateMultipleChoice sets the "multiple_choice" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateMultipleChoice() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateMultipleChoice()
+	})
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (u *PollUpsertBulk) SetAnonymous(v bool) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetAnonymous(v)
+	})
+}
+
+// UpdateAnonymous sets the "anonymous" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateAnonymous() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateAnonymous()
+	})
+}
+
+// SetQuiz sets the "quiz" field.
+func (u *PollUpsertBulk) SetQuiz(v bool) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetQuiz(v)
+	})
+}
+
+// UpdateQuiz sets the "quiz" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateQuiz() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateQuiz()
+	})
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (u *PollUpsertBulk) SetClosesAt(v time.Time) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetClosesAt(v)
+	})
+}
+
+// UpdateClosesAt sets the "closes_at" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateClosesAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateClosesAt()
+	})
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (u *PollUpsertBulk) ClearClosesAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.ClearClosesAt()
+	})
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (u *PollUpsertBulk) SetClosedAt(v time.Time) *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *PollUpsertBulk) UpdateClosedAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *PollUpsertBulk) ClearClosedAt() *PollUpsertBulk {
+	return u.Update(func(s *PollUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PollUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PollCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PollCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PollUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
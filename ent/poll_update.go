@@ -0,0 +1,936 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
+	"AtoiTalkAPI/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PollUpdate is the builder for updating Poll entities.
+type PollUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PollMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PollUpdate builder.
+func (_u *PollUpdate) Where(ps ...predicate.Poll) *PollUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PollUpdate) SetUpdatedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *PollUpdate) SetMessageID(v uuid.UUID) *PollUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableMessageID(v *uuid.UUID) *PollUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *PollUpdate) SetQuestion(v string) *PollUpdate {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableQuestion(v *string) *PollUpdate {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (_u *PollUpdate) SetMultipleChoice(v bool) *PollUpdate {
+	_u.mutation.SetMultipleChoice(v)
+	return _u
+}
+
+// SetNillableMultipleChoice sets the "multiple_choice" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableMultipleChoice(v *bool) *PollUpdate {
+	if v != nil {
+		_u.SetMultipleChoice(*v)
+	}
+	return _u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_u *PollUpdate) SetAnonymous(v bool) *PollUpdate {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableAnonymous(v *bool) *PollUpdate {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
+// SetQuiz sets the "quiz" field.
+func (_u *PollUpdate) SetQuiz(v bool) *PollUpdate {
+	_u.mutation.SetQuiz(v)
+	return _u
+}
+
+// SetNillableQuiz sets the "quiz" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableQuiz(v *bool) *PollUpdate {
+	if v != nil {
+		_u.SetQuiz(*v)
+	}
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *PollUpdate) SetClosesAt(v time.Time) *PollUpdate {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableClosesAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *PollUpdate) ClearClosesAt() *PollUpdate {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PollUpdate) SetClosedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableClosedAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PollUpdate) ClearClosedAt() *PollUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *PollUpdate) SetMessage(v *Message) *PollUpdate {
+	return _u.SetMessageID(v.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
+func (_u *PollUpdate) AddOptionIDs(ids ...uuid.UUID) *PollUpdate {
+	_u.mutation.AddOptionIDs(ids...)
+	return _u
+}
+
+// AddOptions adds the "options" edges to the PollOption entity.
+func (_u *PollUpdate) AddOptions(v ...*PollOption) *PollUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOptionIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (_u *PollUpdate) AddVoteIDs(ids ...uuid.UUID) *PollUpdate {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (_u *PollUpdate) AddVotes(v ...*PollVote) *PollUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (_u *PollUpdate) Mutation() *PollMutation {
+	return _u.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *PollUpdate) ClearMessage() *PollUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// ClearOptions clears all "options" edges to the PollOption entity.
+func (_u *PollUpdate) ClearOptions() *PollUpdate {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// RemoveOptionIDs removes the "options" edge to PollOption entities by IDs.
+func (_u *PollUpdate) RemoveOptionIDs(ids ...uuid.UUID) *PollUpdate {
+	_u.mutation.RemoveOptionIDs(ids...)
+	return _u
+}
+
+// RemoveOptions removes "options" edges to PollOption entities.
+func (_u *PollUpdate) RemoveOptions(v ...*PollOption) *PollUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOptionIDs(ids...)
+}
+
+// ClearVotes clears all "votes" edges to the PollVote entity.
+func (_u *PollUpdate) ClearVotes() *PollUpdate {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to PollVote entities by IDs.
+func (_u *PollUpdate) RemoveVoteIDs(ids ...uuid.UUID) *PollUpdate {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to PollVote entities.
+func (_u *PollUpdate) RemoveVotes(v ...*PollVote) *PollUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PollUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PollUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PollUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := poll.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollUpdate) check() error {
+	if v, ok := _u.mutation.Question(); ok {
+		if err := poll.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "Poll.question": %w`, err)}
+		}
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Poll.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PollUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PollUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(poll.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MultipleChoice(); ok {
+		_spec.SetField(poll.FieldMultipleChoice, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Quiz(); ok {
+		_spec.SetField(poll.FieldQuiz, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !_u.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poll.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PollUpdateOne is the builder for updating a single Poll entity.
+type PollUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PollMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PollUpdateOne) SetUpdatedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *PollUpdateOne) SetMessageID(v uuid.UUID) *PollUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableMessageID(v *uuid.UUID) *PollUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetQuestion sets the "question" field.
+func (_u *PollUpdateOne) SetQuestion(v string) *PollUpdateOne {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableQuestion(v *string) *PollUpdateOne {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetMultipleChoice sets the "multiple_choice" field.
+func (_u *PollUpdateOne) SetMultipleChoice(v bool) *PollUpdateOne {
+	_u.mutation.SetMultipleChoice(v)
+	return _u
+}
+
+// SetNillableMultipleChoice sets the "multiple_choice" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableMultipleChoice(v *bool) *PollUpdateOne {
+	if v != nil {
+		_u.SetMultipleChoice(*v)
+	}
+	return _u
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_u *PollUpdateOne) SetAnonymous(v bool) *PollUpdateOne {
+	_u.mutation.SetAnonymous(v)
+	return _u
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableAnonymous(v *bool) *PollUpdateOne {
+	if v != nil {
+		_u.SetAnonymous(*v)
+	}
+	return _u
+}
+
+// SetQuiz sets the "quiz" field.
+func (_u *PollUpdateOne) SetQuiz(v bool) *PollUpdateOne {
+	_u.mutation.SetQuiz(v)
+	return _u
+}
+
+// SetNillableQuiz sets the "quiz" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableQuiz(v *bool) *PollUpdateOne {
+	if v != nil {
+		_u.SetQuiz(*v)
+	}
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *PollUpdateOne) SetClosesAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableClosesAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *PollUpdateOne) ClearClosesAt() *PollUpdateOne {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PollUpdateOne) SetClosedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableClosedAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PollUpdateOne) ClearClosedAt() *PollUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetMessage sets the "message" edge to the Message entity.
+func (_u *PollUpdateOne) SetMessage(v *Message) *PollUpdateOne {
+	return _u.SetMessageID(v.ID)
+}
+
+// AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
+func (_u *PollUpdateOne) AddOptionIDs(ids ...uuid.UUID) *PollUpdateOne {
+	_u.mutation.AddOptionIDs(ids...)
+	return _u
+}
+
+// AddOptions adds the "options" edges to the PollOption entity.
+func (_u *PollUpdateOne) AddOptions(v ...*PollOption) *PollUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOptionIDs(ids...)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (_u *PollUpdateOne) AddVoteIDs(ids ...uuid.UUID) *PollUpdateOne {
+	_u.mutation.AddVoteIDs(ids...)
+	return _u
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (_u *PollUpdateOne) AddVotes(v ...*PollVote) *PollUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollMutation object of the builder.
+func (_u *PollUpdateOne) Mutation() *PollMutation {
+	return _u.mutation
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (_u *PollUpdateOne) ClearMessage() *PollUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// ClearOptions clears all "options" edges to the PollOption entity.
+func (_u *PollUpdateOne) ClearOptions() *PollUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// RemoveOptionIDs removes the "options" edge to PollOption entities by IDs.
+func (_u *PollUpdateOne) RemoveOptionIDs(ids ...uuid.UUID) *PollUpdateOne {
+	_u.mutation.RemoveOptionIDs(ids...)
+	return _u
+}
+
+// RemoveOptions removes "options" edges to PollOption entities.
+func (_u *PollUpdateOne) RemoveOptions(v ...*PollOption) *PollUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOptionIDs(ids...)
+}
+
+// ClearVotes clears all "votes" edges to the PollVote entity.
+func (_u *PollUpdateOne) ClearVotes() *PollUpdateOne {
+	_u.mutation.ClearVotes()
+	return _u
+}
+
+// RemoveVoteIDs removes the "votes" edge to PollVote entities by IDs.
+func (_u *PollUpdateOne) RemoveVoteIDs(ids ...uuid.UUID) *PollUpdateOne {
+	_u.mutation.RemoveVoteIDs(ids...)
+	return _u
+}
+
+// RemoveVotes removes "votes" edges to PollVote entities.
+func (_u *PollUpdateOne) RemoveVotes(v ...*PollVote) *PollUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteIDs(ids...)
+}
+
+// Where appends a list predicates to the PollUpdate builder.
+func (_u *PollUpdateOne) Where(ps ...predicate.Poll) *PollUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PollUpdateOne) Select(field string, fields ...string) *PollUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Poll entity.
+func (_u *PollUpdateOne) Save(ctx context.Context) (*Poll, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PollUpdateOne) SaveX(ctx context.Context) *Poll {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PollUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PollUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PollUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := poll.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PollUpdateOne) check() error {
+	if v, ok := _u.mutation.Question(); ok {
+		if err := poll.QuestionValidator(v); err != nil {
+			return &ValidationError{Name: "question", err: fmt.Errorf(`ent: validator failed for field "Poll.question": %w`, err)}
+		}
+	}
+	if _u.mutation.MessageCleared() && len(_u.mutation.MessageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Poll.message"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PollUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PollUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(poll.Table, poll.Columns, sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Poll.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, poll.FieldID)
+		for _, f := range fields {
+			if !poll.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != poll.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Question(); ok {
+		_spec.SetField(poll.FieldQuestion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MultipleChoice(); ok {
+		_spec.SetField(poll.FieldMultipleChoice, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Quiz(); ok {
+		_spec.SetField(poll.FieldQuiz, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.MessageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   poll.MessageTable,
+			Columns: []string{poll.MessageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !_u.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.OptionsTable,
+			Columns: []string{poll.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Poll{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poll.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
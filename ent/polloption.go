@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PollOption is the model entity for the PollOption schema.
+type PollOption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID uuid.UUID `json:"poll_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// IsCorrect holds the value of the "is_correct" field.
+	IsCorrect bool `json:"is_correct,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PollOptionQuery when eager-loading is set.
+	Edges        PollOptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PollOptionEdges holds the relations/edges for other nodes in the graph.
+type PollOptionEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// Votes holds the value of the votes edge.
+	Votes []*PollVote `json:"votes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollOptionEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// VotesOrErr returns the Votes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollOptionEdges) VotesOrErr() ([]*PollVote, error) {
+	if e.loadedTypes[1] {
+		return e.Votes, nil
+	}
+	return nil, &NotLoadedError{edge: "votes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PollOption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case polloption.FieldIsCorrect:
+			values[i] = new(sql.NullBool)
+		case polloption.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case polloption.FieldText:
+			values[i] = new(sql.NullString)
+		case polloption.FieldCreatedAt, polloption.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case polloption.FieldID, polloption.FieldPollID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PollOption fields.
+func (_m *PollOption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case polloption.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case polloption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case polloption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case polloption.FieldPollID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value != nil {
+				_m.PollID = *value
+			}
+		case polloption.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case polloption.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case polloption.FieldIsCorrect:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_correct", values[i])
+			} else if value.Valid {
+				_m.IsCorrect = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PollOption.
+// This includes values selected through modifiers, order, etc.
+func (_m *PollOption) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the PollOption entity.
+func (_m *PollOption) QueryPoll() *PollQuery {
+	return NewPollOptionClient(_m.config).QueryPoll(_m)
+}
+
+// QueryVotes queries the "votes" edge of the PollOption entity.
+func (_m *PollOption) QueryVotes() *PollVoteQuery {
+	return NewPollOptionClient(_m.config).QueryVotes(_m)
+}
+
+// Update returns a builder for updating this PollOption.
+// Note that you need to call PollOption.Unwrap() before calling this method if this PollOption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PollOption) Update() *PollOptionUpdateOne {
+	return NewPollOptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PollOption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PollOption) Unwrap() *PollOption {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PollOption is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PollOption) String() string {
+	var builder strings.Builder
+	builder.WriteString("PollOption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("is_correct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsCorrect))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PollOptions is a parsable slice of PollOption.
+type PollOptions []*PollOption
@@ -0,0 +1,860 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PollOptionCreate is the builder for creating a PollOption entity.
+type PollOptionCreate struct {
+	config
+	mutation *PollOptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PollOptionCreate) SetCreatedAt(v time.Time) *PollOptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PollOptionCreate) SetNillableCreatedAt(v *time.Time) *PollOptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PollOptionCreate) SetUpdatedAt(v time.Time) *PollOptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PollOptionCreate) SetNillableUpdatedAt(v *time.Time) *PollOptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *PollOptionCreate) SetPollID(v uuid.UUID) *PollOptionCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *PollOptionCreate) SetText(v string) *PollOptionCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *PollOptionCreate) SetPosition(v int) *PollOptionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (_c *PollOptionCreate) SetIsCorrect(v bool) *PollOptionCreate {
+	_c.mutation.SetIsCorrect(v)
+	return _c
+}
+
+// SetNillableIsCorrect sets the "is_correct" field if the given value is not nil.
+func (_c *PollOptionCreate) SetNillableIsCorrect(v *bool) *PollOptionCreate {
+	if v != nil {
+		_c.SetIsCorrect(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PollOptionCreate) SetID(v uuid.UUID) *PollOptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PollOptionCreate) SetNillableID(v *uuid.UUID) *PollOptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *PollOptionCreate) SetPoll(v *Poll) *PollOptionCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by IDs.
+func (_c *PollOptionCreate) AddVoteIDs(ids ...uuid.UUID) *PollOptionCreate {
+	_c.mutation.AddVoteIDs(ids...)
+	return _c
+}
+
+// AddVotes adds the "votes" edges to the PollVote entity.
+func (_c *PollOptionCreate) AddVotes(v ...*PollVote) *PollOptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoteIDs(ids...)
+}
+
+// Mutation returns the PollOptionMutation object of the builder.
+func (_c *PollOptionCreate) Mutation() *PollOptionMutation {
+	return _c.mutation
+}
+
+// Save creates the PollOption in the database.
+func (_c *PollOptionCreate) Save(ctx context.Context) (*PollOption, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PollOptionCreate) SaveX(ctx context.Context) *PollOption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollOptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollOptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PollOptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := polloption.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := polloption.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.IsCorrect(); !ok {
+		v := polloption.DefaultIsCorrect
+		_c.mutation.SetIsCorrect(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := polloption.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PollOptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PollOption.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PollOption.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "PollOption.poll_id"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "PollOption.text"`)}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := polloption.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "PollOption.text": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "PollOption.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := polloption.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "PollOption.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsCorrect(); !ok {
+		return &ValidationError{Name: "is_correct", err: errors.New(`ent: missing required field "PollOption.is_correct"`)}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "PollOption.poll"`)}
+	}
+	return nil
+}
+
+func (_c *PollOptionCreate) sqlSave(ctx context.Context) (*PollOption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PollOptionCreate) createSpec() (*PollOption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PollOption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(polloption.Table, sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(polloption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(polloption.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(polloption.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(polloption.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.IsCorrect(); ok {
+		_spec.SetField(polloption.FieldIsCorrect, field.TypeBool, value)
+		_node.IsCorrect = value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   polloption.PollTable,
+			Columns: []string{polloption.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.VotesTable,
+			Columns: []string{polloption.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PollOption.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PollOptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PollOptionCreate) OnConflict(opts ...sql.ConflictOption) *PollOptionUpsertOne {
+	_c.conflict = opts
+	return &PollOptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PollOption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PollOptionCreate) OnConflictColumns(columns ...string) *PollOptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PollOptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PollOptionUpsertOne is the builder for "upsert"-ing
+	//  one PollOption node.
+	PollOptionUpsertOne struct {
+		create *PollOptionCreate
+	}
+
+	// PollOptionUpsert is the "OnConflict" setter.
+	PollOptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollOptionUpsert) SetUpdatedAt(v time.Time) *PollOptionUpsert {
+	u.Set(polloption.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollOptionUpsert) UpdateUpdatedAt() *PollOptionUpsert {
+	u.SetExcluded(polloption.FieldUpdatedAt)
+	return u
+}
+
+// SetPollID sets the "poll_id" field.
+func (u *PollOptionUpsert) SetPollID(v uuid.UUID) *PollOptionUpsert {
+	u.Set(polloption.FieldPollID, v)
+	return u
+}
+
+// UpdatePollID sets the "poll_id" field to the value that was provided on create.
+func (u *PollOptionUpsert) UpdatePollID() *PollOptionUpsert {
+	u.SetExcluded(polloption.FieldPollID)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *PollOptionUpsert) SetText(v string) *PollOptionUpsert {
+	u.Set(polloption.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *PollOptionUpsert) UpdateText() *PollOptionUpsert {
+	u.SetExcluded(polloption.FieldText)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *PollOptionUpsert) SetPosition(v int) *PollOptionUpsert {
+	u.Set(polloption.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PollOptionUpsert) UpdatePosition() *PollOptionUpsert {
+	u.SetExcluded(polloption.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PollOptionUpsert) AddPosition(v int) *PollOptionUpsert {
+	u.Add(polloption.FieldPosition, v)
+	return u
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (u *PollOptionUpsert) SetIsCorrect(v bool) *PollOptionUpsert {
+	u.Set(polloption.FieldIsCorrect, v)
+	return u
+}
+
+// UpdateIsCorrect sets the "is_correct" field to the value that was provided on create.
+func (u *PollOptionUpsert) UpdateIsCorrect() *PollOptionUpsert {
+	u.SetExcluded(polloption.FieldIsCorrect)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PollOption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(polloption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PollOptionUpsertOne) UpdateNewValues() *PollOptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(polloption.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(polloption.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PollOption.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PollOptionUpsertOne) Ignore() *PollOptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PollOptionUpsertOne) DoNothing() *PollOptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PollOptionCreate.OnConflict
+// documentation for more info.
+func (u *PollOptionUpsertOne) Update(set func(*PollOptionUpsert)) *PollOptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PollOptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollOptionUpsertOne) SetUpdatedAt(v time.Time) *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollOptionUpsertOne) UpdateUpdatedAt() *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPollID sets the "poll_id" field.
+func (u *PollOptionUpsertOne) SetPollID(v uuid.UUID) *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetPollID(v)
+	})
+}
+
+// UpdatePollID sets the "poll_id" field to the value that was provided on create.
+func (u *PollOptionUpsertOne) UpdatePollID() *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdatePollID()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *PollOptionUpsertOne) SetText(v string) *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *PollOptionUpsertOne) UpdateText() *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *PollOptionUpsertOne) SetPosition(v int) *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PollOptionUpsertOne) AddPosition(v int) *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PollOptionUpsertOne) UpdatePosition() *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (u *PollOptionUpsertOne) SetIsCorrect(v bool) *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetIsCorrect(v)
+	})
+}
+
+// UpdateIsCorrect sets the "is_correct" field to the value that was provided on create.
+func (u *PollOptionUpsertOne) UpdateIsCorrect() *PollOptionUpsertOne {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdateIsCorrect()
+	})
+}
+
+// Exec executes the query.
+func (u *PollOptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PollOptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PollOptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PollOptionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PollOptionUpsertOne.ID is not supported by MySQL driver. Use PollOptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PollOptionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PollOptionCreateBulk is the builder for creating many PollOption entities in bulk.
+type PollOptionCreateBulk struct {
+	config
+	err      error
+	builders []*PollOptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PollOption entities in the database.
+func (_c *PollOptionCreateBulk) Save(ctx context.Context) ([]*PollOption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PollOption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PollOptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PollOptionCreateBulk) SaveX(ctx context.Context) []*PollOption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PollOptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PollOptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PollOption.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PollOptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PollOptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PollOptionUpsertBulk {
+	_c.conflict = opts
+	return &PollOptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PollOption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PollOptionCreateBulk) OnConflictColumns(columns ...string) *PollOptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PollOptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PollOptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PollOption nodes.
+type PollOptionUpsertBulk struct {
+	create *PollOptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PollOption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(polloption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PollOptionUpsertBulk) UpdateNewValues() *PollOptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(polloption.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(polloption.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PollOption.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PollOptionUpsertBulk) Ignore() *PollOptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PollOptionUpsertBulk) DoNothing() *PollOptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PollOptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PollOptionUpsertBulk) Update(set func(*PollOptionUpsert)) *PollOptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PollOptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PollOptionUpsertBulk) SetUpdatedAt(v time.Time) *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PollOptionUpsertBulk) UpdateUpdatedAt() *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPollID sets the "poll_id" field.
+func (u *PollOptionUpsertBulk) SetPollID(v uuid.UUID) *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetPollID(v)
+	})
+}
+
+// UpdatePollID sets the "poll_id" field to the value that was provided on create.
+func (u *PollOptionUpsertBulk) UpdatePollID() *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdatePollID()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *PollOptionUpsertBulk) SetText(v string) *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *PollOptionUpsertBulk) UpdateText() *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *PollOptionUpsertBulk) SetPosition(v int) *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *PollOptionUpsertBulk) AddPosition(v int) *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *PollOptionUpsertBulk) UpdatePosition() *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (u *PollOptionUpsertBulk) SetIsCorrect(v bool) *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.SetIsCorrect(v)
+	})
+}
+
+// UpdateIsCorrect sets the "is_correct" field to the value that was provided on create.
+func (u *PollOptionUpsertBulk) UpdateIsCorrect() *PollOptionUpsertBulk {
+	return u.Update(func(s *PollOptionUpsert) {
+		s.UpdateIsCorrect()
+	})
+}
+
+// Exec executes the query.
+func (u *PollOptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PollOptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PollOptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PollOptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
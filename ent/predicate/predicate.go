@@ -9,9 +9,21 @@ import (
 // Chat is the predicate function for chat builders.
 type Chat func(*sql.Selector)
 
+// ChatExport is the predicate function for chatexport builders.
+type ChatExport func(*sql.Selector)
+
+// ChatFolder is the predicate function for chatfolder builders.
+type ChatFolder func(*sql.Selector)
+
+// ChatFolderChat is the predicate function for chatfolderchat builders.
+type ChatFolderChat func(*sql.Selector)
+
 // GroupChat is the predicate function for groupchat builders.
 type GroupChat func(*sql.Selector)
 
+// GroupJoinRequest is the predicate function for groupjoinrequest builders.
+type GroupJoinRequest func(*sql.Selector)
+
 // GroupMember is the predicate function for groupmember builders.
 type GroupMember func(*sql.Selector)
 
@@ -21,12 +33,39 @@ type Media func(*sql.Selector)
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// MessageMention is the predicate function for messagemention builders.
+type MessageMention func(*sql.Selector)
+
+// MessageReaction is the predicate function for messagereaction builders.
+type MessageReaction func(*sql.Selector)
+
+// MessageRevision is the predicate function for messagerevision builders.
+type MessageRevision func(*sql.Selector)
+
+// PinnedChat is the predicate function for pinnedchat builders.
+type PinnedChat func(*sql.Selector)
+
+// PinnedMessage is the predicate function for pinnedmessage builders.
+type PinnedMessage func(*sql.Selector)
+
+// Poll is the predicate function for poll builders.
+type Poll func(*sql.Selector)
+
+// PollOption is the predicate function for polloption builders.
+type PollOption func(*sql.Selector)
+
+// PollVote is the predicate function for pollvote builders.
+type PollVote func(*sql.Selector)
+
 // PrivateChat is the predicate function for privatechat builders.
 type PrivateChat func(*sql.Selector)
 
 // Report is the predicate function for report builders.
 type Report func(*sql.Selector)
 
+// ScheduledMessage is the predicate function for scheduledmessage builders.
+type ScheduledMessage func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
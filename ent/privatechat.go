@@ -34,10 +34,30 @@ type PrivateChat struct {
 	User1HiddenAt *time.Time `json:"user1_hidden_at,omitempty"`
 	// User2HiddenAt holds the value of the "user2_hidden_at" field.
 	User2HiddenAt *time.Time `json:"user2_hidden_at,omitempty"`
+	// User1ArchivedAt holds the value of the "user1_archived_at" field.
+	User1ArchivedAt *time.Time `json:"user1_archived_at,omitempty"`
+	// User2ArchivedAt holds the value of the "user2_archived_at" field.
+	User2ArchivedAt *time.Time `json:"user2_archived_at,omitempty"`
+	// User1KeepArchived holds the value of the "user1_keep_archived" field.
+	User1KeepArchived bool `json:"user1_keep_archived,omitempty"`
+	// User2KeepArchived holds the value of the "user2_keep_archived" field.
+	User2KeepArchived bool `json:"user2_keep_archived,omitempty"`
+	// User1Muted holds the value of the "user1_muted" field.
+	User1Muted bool `json:"user1_muted,omitempty"`
+	// User2Muted holds the value of the "user2_muted" field.
+	User2Muted bool `json:"user2_muted,omitempty"`
+	// User1MutedUntil holds the value of the "user1_muted_until" field.
+	User1MutedUntil *time.Time `json:"user1_muted_until,omitempty"`
+	// User2MutedUntil holds the value of the "user2_muted_until" field.
+	User2MutedUntil *time.Time `json:"user2_muted_until,omitempty"`
 	// User1UnreadCount holds the value of the "user1_unread_count" field.
 	User1UnreadCount int `json:"user1_unread_count,omitempty"`
 	// User2UnreadCount holds the value of the "user2_unread_count" field.
 	User2UnreadCount int `json:"user2_unread_count,omitempty"`
+	// User1MarkedUnread holds the value of the "user1_marked_unread" field.
+	User1MarkedUnread bool `json:"user1_marked_unread,omitempty"`
+	// User2MarkedUnread holds the value of the "user2_marked_unread" field.
+	User2MarkedUnread bool `json:"user2_marked_unread,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PrivateChatQuery when eager-loading is set.
 	Edges        PrivateChatEdges `json:"edges"`
@@ -97,9 +117,11 @@ func (*PrivateChat) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case privatechat.FieldUser1ID, privatechat.FieldUser2ID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case privatechat.FieldUser1KeepArchived, privatechat.FieldUser2KeepArchived, privatechat.FieldUser1Muted, privatechat.FieldUser2Muted, privatechat.FieldUser1MarkedUnread, privatechat.FieldUser2MarkedUnread:
+			values[i] = new(sql.NullBool)
 		case privatechat.FieldUser1UnreadCount, privatechat.FieldUser2UnreadCount:
 			values[i] = new(sql.NullInt64)
-		case privatechat.FieldUser1LastReadAt, privatechat.FieldUser2LastReadAt, privatechat.FieldUser1HiddenAt, privatechat.FieldUser2HiddenAt:
+		case privatechat.FieldUser1LastReadAt, privatechat.FieldUser2LastReadAt, privatechat.FieldUser1HiddenAt, privatechat.FieldUser2HiddenAt, privatechat.FieldUser1ArchivedAt, privatechat.FieldUser2ArchivedAt, privatechat.FieldUser1MutedUntil, privatechat.FieldUser2MutedUntil:
 			values[i] = new(sql.NullTime)
 		case privatechat.FieldID, privatechat.FieldChatID:
 			values[i] = new(uuid.UUID)
@@ -172,6 +194,58 @@ func (_m *PrivateChat) assignValues(columns []string, values []any) error {
 				_m.User2HiddenAt = new(time.Time)
 				*_m.User2HiddenAt = value.Time
 			}
+		case privatechat.FieldUser1ArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field user1_archived_at", values[i])
+			} else if value.Valid {
+				_m.User1ArchivedAt = new(time.Time)
+				*_m.User1ArchivedAt = value.Time
+			}
+		case privatechat.FieldUser2ArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field user2_archived_at", values[i])
+			} else if value.Valid {
+				_m.User2ArchivedAt = new(time.Time)
+				*_m.User2ArchivedAt = value.Time
+			}
+		case privatechat.FieldUser1KeepArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user1_keep_archived", values[i])
+			} else if value.Valid {
+				_m.User1KeepArchived = value.Bool
+			}
+		case privatechat.FieldUser2KeepArchived:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user2_keep_archived", values[i])
+			} else if value.Valid {
+				_m.User2KeepArchived = value.Bool
+			}
+		case privatechat.FieldUser1Muted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user1_muted", values[i])
+			} else if value.Valid {
+				_m.User1Muted = value.Bool
+			}
+		case privatechat.FieldUser2Muted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user2_muted", values[i])
+			} else if value.Valid {
+				_m.User2Muted = value.Bool
+			}
+		case privatechat.FieldUser1MutedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field user1_muted_until", values[i])
+			} else if value.Valid {
+				_m.User1MutedUntil = new(time.Time)
+				*_m.User1MutedUntil = value.Time
+			}
+		case privatechat.FieldUser2MutedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field user2_muted_until", values[i])
+			} else if value.Valid {
+				_m.User2MutedUntil = new(time.Time)
+				*_m.User2MutedUntil = value.Time
+			}
 		case privatechat.FieldUser1UnreadCount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field user1_unread_count", values[i])
@@ -184,6 +258,18 @@ func (_m *PrivateChat) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.User2UnreadCount = int(value.Int64)
 			}
+		case privatechat.FieldUser1MarkedUnread:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user1_marked_unread", values[i])
+			} else if value.Valid {
+				_m.User1MarkedUnread = value.Bool
+			}
+		case privatechat.FieldUser2MarkedUnread:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user2_marked_unread", values[i])
+			} else if value.Valid {
+				_m.User2MarkedUnread = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -268,11 +354,49 @@ func (_m *PrivateChat) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.User1ArchivedAt; v != nil {
+		builder.WriteString("user1_archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.User2ArchivedAt; v != nil {
+		builder.WriteString("user2_archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user1_keep_archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.User1KeepArchived))
+	builder.WriteString(", ")
+	builder.WriteString("user2_keep_archived=")
+	builder.WriteString(fmt.Sprintf("%v", _m.User2KeepArchived))
+	builder.WriteString(", ")
+	builder.WriteString("user1_muted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.User1Muted))
+	builder.WriteString(", ")
+	builder.WriteString("user2_muted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.User2Muted))
+	builder.WriteString(", ")
+	if v := _m.User1MutedUntil; v != nil {
+		builder.WriteString("user1_muted_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.User2MutedUntil; v != nil {
+		builder.WriteString("user2_muted_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("user1_unread_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.User1UnreadCount))
 	builder.WriteString(", ")
 	builder.WriteString("user2_unread_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.User2UnreadCount))
+	builder.WriteString(", ")
+	builder.WriteString("user1_marked_unread=")
+	builder.WriteString(fmt.Sprintf("%v", _m.User1MarkedUnread))
+	builder.WriteString(", ")
+	builder.WriteString("user2_marked_unread=")
+	builder.WriteString(fmt.Sprintf("%v", _m.User2MarkedUnread))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -28,10 +28,30 @@ const (
 	FieldUser1HiddenAt = "user1_hidden_at"
 	// FieldUser2HiddenAt holds the string denoting the user2_hidden_at field in the database.
 	FieldUser2HiddenAt = "user2_hidden_at"
+	// FieldUser1ArchivedAt holds the string denoting the user1_archived_at field in the database.
+	FieldUser1ArchivedAt = "user1_archived_at"
+	// FieldUser2ArchivedAt holds the string denoting the user2_archived_at field in the database.
+	FieldUser2ArchivedAt = "user2_archived_at"
+	// FieldUser1KeepArchived holds the string denoting the user1_keep_archived field in the database.
+	FieldUser1KeepArchived = "user1_keep_archived"
+	// FieldUser2KeepArchived holds the string denoting the user2_keep_archived field in the database.
+	FieldUser2KeepArchived = "user2_keep_archived"
+	// FieldUser1Muted holds the string denoting the user1_muted field in the database.
+	FieldUser1Muted = "user1_muted"
+	// FieldUser2Muted holds the string denoting the user2_muted field in the database.
+	FieldUser2Muted = "user2_muted"
+	// FieldUser1MutedUntil holds the string denoting the user1_muted_until field in the database.
+	FieldUser1MutedUntil = "user1_muted_until"
+	// FieldUser2MutedUntil holds the string denoting the user2_muted_until field in the database.
+	FieldUser2MutedUntil = "user2_muted_until"
 	// FieldUser1UnreadCount holds the string denoting the user1_unread_count field in the database.
 	FieldUser1UnreadCount = "user1_unread_count"
 	// FieldUser2UnreadCount holds the string denoting the user2_unread_count field in the database.
 	FieldUser2UnreadCount = "user2_unread_count"
+	// FieldUser1MarkedUnread holds the string denoting the user1_marked_unread field in the database.
+	FieldUser1MarkedUnread = "user1_marked_unread"
+	// FieldUser2MarkedUnread holds the string denoting the user2_marked_unread field in the database.
+	FieldUser2MarkedUnread = "user2_marked_unread"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
 	EdgeChat = "chat"
 	// EdgeUser1 holds the string denoting the user1 edge name in mutations.
@@ -73,8 +93,18 @@ var Columns = []string{
 	FieldUser2LastReadAt,
 	FieldUser1HiddenAt,
 	FieldUser2HiddenAt,
+	FieldUser1ArchivedAt,
+	FieldUser2ArchivedAt,
+	FieldUser1KeepArchived,
+	FieldUser2KeepArchived,
+	FieldUser1Muted,
+	FieldUser2Muted,
+	FieldUser1MutedUntil,
+	FieldUser2MutedUntil,
 	FieldUser1UnreadCount,
 	FieldUser2UnreadCount,
+	FieldUser1MarkedUnread,
+	FieldUser2MarkedUnread,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -94,10 +124,22 @@ func ValidColumn(column string) bool {
 //	import _ "AtoiTalkAPI/ent/runtime"
 var (
 	Hooks [1]ent.Hook
+	// DefaultUser1KeepArchived holds the default value on creation for the "user1_keep_archived" field.
+	DefaultUser1KeepArchived bool
+	// DefaultUser2KeepArchived holds the default value on creation for the "user2_keep_archived" field.
+	DefaultUser2KeepArchived bool
+	// DefaultUser1Muted holds the default value on creation for the "user1_muted" field.
+	DefaultUser1Muted bool
+	// DefaultUser2Muted holds the default value on creation for the "user2_muted" field.
+	DefaultUser2Muted bool
 	// DefaultUser1UnreadCount holds the default value on creation for the "user1_unread_count" field.
 	DefaultUser1UnreadCount int
 	// DefaultUser2UnreadCount holds the default value on creation for the "user2_unread_count" field.
 	DefaultUser2UnreadCount int
+	// DefaultUser1MarkedUnread holds the default value on creation for the "user1_marked_unread" field.
+	DefaultUser1MarkedUnread bool
+	// DefaultUser2MarkedUnread holds the default value on creation for the "user2_marked_unread" field.
+	DefaultUser2MarkedUnread bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -145,6 +187,46 @@ func ByUser2HiddenAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUser2HiddenAt, opts...).ToFunc()
 }
 
+// ByUser1ArchivedAt orders the results by the user1_archived_at field.
+func ByUser1ArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser1ArchivedAt, opts...).ToFunc()
+}
+
+// ByUser2ArchivedAt orders the results by the user2_archived_at field.
+func ByUser2ArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser2ArchivedAt, opts...).ToFunc()
+}
+
+// ByUser1KeepArchived orders the results by the user1_keep_archived field.
+func ByUser1KeepArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser1KeepArchived, opts...).ToFunc()
+}
+
+// ByUser2KeepArchived orders the results by the user2_keep_archived field.
+func ByUser2KeepArchived(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser2KeepArchived, opts...).ToFunc()
+}
+
+// ByUser1Muted orders the results by the user1_muted field.
+func ByUser1Muted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser1Muted, opts...).ToFunc()
+}
+
+// ByUser2Muted orders the results by the user2_muted field.
+func ByUser2Muted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser2Muted, opts...).ToFunc()
+}
+
+// ByUser1MutedUntil orders the results by the user1_muted_until field.
+func ByUser1MutedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser1MutedUntil, opts...).ToFunc()
+}
+
+// ByUser2MutedUntil orders the results by the user2_muted_until field.
+func ByUser2MutedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser2MutedUntil, opts...).ToFunc()
+}
+
 // ByUser1UnreadCount orders the results by the user1_unread_count field.
 func ByUser1UnreadCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUser1UnreadCount, opts...).ToFunc()
@@ -155,6 +237,16 @@ func ByUser2UnreadCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUser2UnreadCount, opts...).ToFunc()
 }
 
+// ByUser1MarkedUnread orders the results by the user1_marked_unread field.
+func ByUser1MarkedUnread(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser1MarkedUnread, opts...).ToFunc()
+}
+
+// ByUser2MarkedUnread orders the results by the user2_marked_unread field.
+func ByUser2MarkedUnread(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUser2MarkedUnread, opts...).ToFunc()
+}
+
 // ByChatField orders the results by chat field.
 func ByChatField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
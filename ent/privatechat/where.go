@@ -91,6 +91,46 @@ func User2HiddenAt(v time.Time) predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldEQ(FieldUser2HiddenAt, v))
 }
 
+// User1ArchivedAt applies equality check predicate on the "user1_archived_at" field. It's identical to User1ArchivedAtEQ.
+func User1ArchivedAt(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1ArchivedAt, v))
+}
+
+// User2ArchivedAt applies equality check predicate on the "user2_archived_at" field. It's identical to User2ArchivedAtEQ.
+func User2ArchivedAt(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2ArchivedAt, v))
+}
+
+// User1KeepArchived applies equality check predicate on the "user1_keep_archived" field. It's identical to User1KeepArchivedEQ.
+func User1KeepArchived(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1KeepArchived, v))
+}
+
+// User2KeepArchived applies equality check predicate on the "user2_keep_archived" field. It's identical to User2KeepArchivedEQ.
+func User2KeepArchived(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2KeepArchived, v))
+}
+
+// User1Muted applies equality check predicate on the "user1_muted" field. It's identical to User1MutedEQ.
+func User1Muted(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1Muted, v))
+}
+
+// User2Muted applies equality check predicate on the "user2_muted" field. It's identical to User2MutedEQ.
+func User2Muted(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2Muted, v))
+}
+
+// User1MutedUntil applies equality check predicate on the "user1_muted_until" field. It's identical to User1MutedUntilEQ.
+func User1MutedUntil(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1MutedUntil, v))
+}
+
+// User2MutedUntil applies equality check predicate on the "user2_muted_until" field. It's identical to User2MutedUntilEQ.
+func User2MutedUntil(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2MutedUntil, v))
+}
+
 // User1UnreadCount applies equality check predicate on the "user1_unread_count" field. It's identical to User1UnreadCountEQ.
 func User1UnreadCount(v int) predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldEQ(FieldUser1UnreadCount, v))
@@ -101,6 +141,16 @@ func User2UnreadCount(v int) predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldEQ(FieldUser2UnreadCount, v))
 }
 
+// User1MarkedUnread applies equality check predicate on the "user1_marked_unread" field. It's identical to User1MarkedUnreadEQ.
+func User1MarkedUnread(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1MarkedUnread, v))
+}
+
+// User2MarkedUnread applies equality check predicate on the "user2_marked_unread" field. It's identical to User2MarkedUnreadEQ.
+func User2MarkedUnread(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2MarkedUnread, v))
+}
+
 // ChatIDEQ applies the EQ predicate on the "chat_id" field.
 func ChatIDEQ(v uuid.UUID) predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldEQ(FieldChatID, v))
@@ -381,6 +431,246 @@ func User2HiddenAtNotNil() predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldNotNull(FieldUser2HiddenAt))
 }
 
+// User1ArchivedAtEQ applies the EQ predicate on the "user1_archived_at" field.
+func User1ArchivedAtEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1ArchivedAt, v))
+}
+
+// User1ArchivedAtNEQ applies the NEQ predicate on the "user1_archived_at" field.
+func User1ArchivedAtNEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser1ArchivedAt, v))
+}
+
+// User1ArchivedAtIn applies the In predicate on the "user1_archived_at" field.
+func User1ArchivedAtIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIn(FieldUser1ArchivedAt, vs...))
+}
+
+// User1ArchivedAtNotIn applies the NotIn predicate on the "user1_archived_at" field.
+func User1ArchivedAtNotIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotIn(FieldUser1ArchivedAt, vs...))
+}
+
+// User1ArchivedAtGT applies the GT predicate on the "user1_archived_at" field.
+func User1ArchivedAtGT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGT(FieldUser1ArchivedAt, v))
+}
+
+// User1ArchivedAtGTE applies the GTE predicate on the "user1_archived_at" field.
+func User1ArchivedAtGTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGTE(FieldUser1ArchivedAt, v))
+}
+
+// User1ArchivedAtLT applies the LT predicate on the "user1_archived_at" field.
+func User1ArchivedAtLT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLT(FieldUser1ArchivedAt, v))
+}
+
+// User1ArchivedAtLTE applies the LTE predicate on the "user1_archived_at" field.
+func User1ArchivedAtLTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLTE(FieldUser1ArchivedAt, v))
+}
+
+// User1ArchivedAtIsNil applies the IsNil predicate on the "user1_archived_at" field.
+func User1ArchivedAtIsNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIsNull(FieldUser1ArchivedAt))
+}
+
+// User1ArchivedAtNotNil applies the NotNil predicate on the "user1_archived_at" field.
+func User1ArchivedAtNotNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotNull(FieldUser1ArchivedAt))
+}
+
+// User2ArchivedAtEQ applies the EQ predicate on the "user2_archived_at" field.
+func User2ArchivedAtEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2ArchivedAt, v))
+}
+
+// User2ArchivedAtNEQ applies the NEQ predicate on the "user2_archived_at" field.
+func User2ArchivedAtNEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser2ArchivedAt, v))
+}
+
+// User2ArchivedAtIn applies the In predicate on the "user2_archived_at" field.
+func User2ArchivedAtIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIn(FieldUser2ArchivedAt, vs...))
+}
+
+// User2ArchivedAtNotIn applies the NotIn predicate on the "user2_archived_at" field.
+func User2ArchivedAtNotIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotIn(FieldUser2ArchivedAt, vs...))
+}
+
+// User2ArchivedAtGT applies the GT predicate on the "user2_archived_at" field.
+func User2ArchivedAtGT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGT(FieldUser2ArchivedAt, v))
+}
+
+// User2ArchivedAtGTE applies the GTE predicate on the "user2_archived_at" field.
+func User2ArchivedAtGTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGTE(FieldUser2ArchivedAt, v))
+}
+
+// User2ArchivedAtLT applies the LT predicate on the "user2_archived_at" field.
+func User2ArchivedAtLT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLT(FieldUser2ArchivedAt, v))
+}
+
+// User2ArchivedAtLTE applies the LTE predicate on the "user2_archived_at" field.
+func User2ArchivedAtLTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLTE(FieldUser2ArchivedAt, v))
+}
+
+// User2ArchivedAtIsNil applies the IsNil predicate on the "user2_archived_at" field.
+func User2ArchivedAtIsNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIsNull(FieldUser2ArchivedAt))
+}
+
+// User2ArchivedAtNotNil applies the NotNil predicate on the "user2_archived_at" field.
+func User2ArchivedAtNotNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotNull(FieldUser2ArchivedAt))
+}
+
+// User1KeepArchivedEQ applies the EQ predicate on the "user1_keep_archived" field.
+func User1KeepArchivedEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1KeepArchived, v))
+}
+
+// User1KeepArchivedNEQ applies the NEQ predicate on the "user1_keep_archived" field.
+func User1KeepArchivedNEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser1KeepArchived, v))
+}
+
+// User2KeepArchivedEQ applies the EQ predicate on the "user2_keep_archived" field.
+func User2KeepArchivedEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2KeepArchived, v))
+}
+
+// User2KeepArchivedNEQ applies the NEQ predicate on the "user2_keep_archived" field.
+func User2KeepArchivedNEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser2KeepArchived, v))
+}
+
+// User1MutedEQ applies the EQ predicate on the "user1_muted" field.
+func User1MutedEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1Muted, v))
+}
+
+// User1MutedNEQ applies the NEQ predicate on the "user1_muted" field.
+func User1MutedNEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser1Muted, v))
+}
+
+// User2MutedEQ applies the EQ predicate on the "user2_muted" field.
+func User2MutedEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2Muted, v))
+}
+
+// User2MutedNEQ applies the NEQ predicate on the "user2_muted" field.
+func User2MutedNEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser2Muted, v))
+}
+
+// User1MutedUntilEQ applies the EQ predicate on the "user1_muted_until" field.
+func User1MutedUntilEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1MutedUntil, v))
+}
+
+// User1MutedUntilNEQ applies the NEQ predicate on the "user1_muted_until" field.
+func User1MutedUntilNEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser1MutedUntil, v))
+}
+
+// User1MutedUntilIn applies the In predicate on the "user1_muted_until" field.
+func User1MutedUntilIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIn(FieldUser1MutedUntil, vs...))
+}
+
+// User1MutedUntilNotIn applies the NotIn predicate on the "user1_muted_until" field.
+func User1MutedUntilNotIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotIn(FieldUser1MutedUntil, vs...))
+}
+
+// User1MutedUntilGT applies the GT predicate on the "user1_muted_until" field.
+func User1MutedUntilGT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGT(FieldUser1MutedUntil, v))
+}
+
+// User1MutedUntilGTE applies the GTE predicate on the "user1_muted_until" field.
+func User1MutedUntilGTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGTE(FieldUser1MutedUntil, v))
+}
+
+// User1MutedUntilLT applies the LT predicate on the "user1_muted_until" field.
+func User1MutedUntilLT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLT(FieldUser1MutedUntil, v))
+}
+
+// User1MutedUntilLTE applies the LTE predicate on the "user1_muted_until" field.
+func User1MutedUntilLTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLTE(FieldUser1MutedUntil, v))
+}
+
+// User1MutedUntilIsNil applies the IsNil predicate on the "user1_muted_until" field.
+func User1MutedUntilIsNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIsNull(FieldUser1MutedUntil))
+}
+
+// User1MutedUntilNotNil applies the NotNil predicate on the "user1_muted_until" field.
+func User1MutedUntilNotNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotNull(FieldUser1MutedUntil))
+}
+
+// User2MutedUntilEQ applies the EQ predicate on the "user2_muted_until" field.
+func User2MutedUntilEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2MutedUntil, v))
+}
+
+// User2MutedUntilNEQ applies the NEQ predicate on the "user2_muted_until" field.
+func User2MutedUntilNEQ(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser2MutedUntil, v))
+}
+
+// User2MutedUntilIn applies the In predicate on the "user2_muted_until" field.
+func User2MutedUntilIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIn(FieldUser2MutedUntil, vs...))
+}
+
+// User2MutedUntilNotIn applies the NotIn predicate on the "user2_muted_until" field.
+func User2MutedUntilNotIn(vs ...time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotIn(FieldUser2MutedUntil, vs...))
+}
+
+// User2MutedUntilGT applies the GT predicate on the "user2_muted_until" field.
+func User2MutedUntilGT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGT(FieldUser2MutedUntil, v))
+}
+
+// User2MutedUntilGTE applies the GTE predicate on the "user2_muted_until" field.
+func User2MutedUntilGTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldGTE(FieldUser2MutedUntil, v))
+}
+
+// User2MutedUntilLT applies the LT predicate on the "user2_muted_until" field.
+func User2MutedUntilLT(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLT(FieldUser2MutedUntil, v))
+}
+
+// User2MutedUntilLTE applies the LTE predicate on the "user2_muted_until" field.
+func User2MutedUntilLTE(v time.Time) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldLTE(FieldUser2MutedUntil, v))
+}
+
+// User2MutedUntilIsNil applies the IsNil predicate on the "user2_muted_until" field.
+func User2MutedUntilIsNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldIsNull(FieldUser2MutedUntil))
+}
+
+// User2MutedUntilNotNil applies the NotNil predicate on the "user2_muted_until" field.
+func User2MutedUntilNotNil() predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNotNull(FieldUser2MutedUntil))
+}
+
 // User1UnreadCountEQ applies the EQ predicate on the "user1_unread_count" field.
 func User1UnreadCountEQ(v int) predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldEQ(FieldUser1UnreadCount, v))
@@ -461,6 +751,26 @@ func User2UnreadCountLTE(v int) predicate.PrivateChat {
 	return predicate.PrivateChat(sql.FieldLTE(FieldUser2UnreadCount, v))
 }
 
+// User1MarkedUnreadEQ applies the EQ predicate on the "user1_marked_unread" field.
+func User1MarkedUnreadEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser1MarkedUnread, v))
+}
+
+// User1MarkedUnreadNEQ applies the NEQ predicate on the "user1_marked_unread" field.
+func User1MarkedUnreadNEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser1MarkedUnread, v))
+}
+
+// User2MarkedUnreadEQ applies the EQ predicate on the "user2_marked_unread" field.
+func User2MarkedUnreadEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldEQ(FieldUser2MarkedUnread, v))
+}
+
+// User2MarkedUnreadNEQ applies the NEQ predicate on the "user2_marked_unread" field.
+func User2MarkedUnreadNEQ(v bool) predicate.PrivateChat {
+	return predicate.PrivateChat(sql.FieldNEQ(FieldUser2MarkedUnread, v))
+}
+
 // HasChat applies the HasEdge predicate on the "chat" edge.
 func HasChat() predicate.PrivateChat {
 	return predicate.PrivateChat(func(s *sql.Selector) {
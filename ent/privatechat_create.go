@@ -116,6 +116,118 @@ func (_c *PrivateChatCreate) SetNillableUser2HiddenAt(v *time.Time) *PrivateChat
 	return _c
 }
 
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (_c *PrivateChatCreate) SetUser1ArchivedAt(v time.Time) *PrivateChatCreate {
+	_c.mutation.SetUser1ArchivedAt(v)
+	return _c
+}
+
+// SetNillableUser1ArchivedAt sets the "user1_archived_at" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser1ArchivedAt(v *time.Time) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser1ArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (_c *PrivateChatCreate) SetUser2ArchivedAt(v time.Time) *PrivateChatCreate {
+	_c.mutation.SetUser2ArchivedAt(v)
+	return _c
+}
+
+// SetNillableUser2ArchivedAt sets the "user2_archived_at" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser2ArchivedAt(v *time.Time) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser2ArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (_c *PrivateChatCreate) SetUser1KeepArchived(v bool) *PrivateChatCreate {
+	_c.mutation.SetUser1KeepArchived(v)
+	return _c
+}
+
+// SetNillableUser1KeepArchived sets the "user1_keep_archived" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser1KeepArchived(v *bool) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser1KeepArchived(*v)
+	}
+	return _c
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (_c *PrivateChatCreate) SetUser2KeepArchived(v bool) *PrivateChatCreate {
+	_c.mutation.SetUser2KeepArchived(v)
+	return _c
+}
+
+// SetNillableUser2KeepArchived sets the "user2_keep_archived" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser2KeepArchived(v *bool) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser2KeepArchived(*v)
+	}
+	return _c
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (_c *PrivateChatCreate) SetUser1Muted(v bool) *PrivateChatCreate {
+	_c.mutation.SetUser1Muted(v)
+	return _c
+}
+
+// SetNillableUser1Muted sets the "user1_muted" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser1Muted(v *bool) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser1Muted(*v)
+	}
+	return _c
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (_c *PrivateChatCreate) SetUser2Muted(v bool) *PrivateChatCreate {
+	_c.mutation.SetUser2Muted(v)
+	return _c
+}
+
+// SetNillableUser2Muted sets the "user2_muted" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser2Muted(v *bool) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser2Muted(*v)
+	}
+	return _c
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (_c *PrivateChatCreate) SetUser1MutedUntil(v time.Time) *PrivateChatCreate {
+	_c.mutation.SetUser1MutedUntil(v)
+	return _c
+}
+
+// SetNillableUser1MutedUntil sets the "user1_muted_until" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser1MutedUntil(v *time.Time) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser1MutedUntil(*v)
+	}
+	return _c
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (_c *PrivateChatCreate) SetUser2MutedUntil(v time.Time) *PrivateChatCreate {
+	_c.mutation.SetUser2MutedUntil(v)
+	return _c
+}
+
+// SetNillableUser2MutedUntil sets the "user2_muted_until" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser2MutedUntil(v *time.Time) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser2MutedUntil(*v)
+	}
+	return _c
+}
+
 // SetUser1UnreadCount sets the "user1_unread_count" field.
 func (_c *PrivateChatCreate) SetUser1UnreadCount(v int) *PrivateChatCreate {
 	_c.mutation.SetUser1UnreadCount(v)
@@ -144,6 +256,34 @@ func (_c *PrivateChatCreate) SetNillableUser2UnreadCount(v *int) *PrivateChatCre
 	return _c
 }
 
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (_c *PrivateChatCreate) SetUser1MarkedUnread(v bool) *PrivateChatCreate {
+	_c.mutation.SetUser1MarkedUnread(v)
+	return _c
+}
+
+// SetNillableUser1MarkedUnread sets the "user1_marked_unread" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser1MarkedUnread(v *bool) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser1MarkedUnread(*v)
+	}
+	return _c
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (_c *PrivateChatCreate) SetUser2MarkedUnread(v bool) *PrivateChatCreate {
+	_c.mutation.SetUser2MarkedUnread(v)
+	return _c
+}
+
+// SetNillableUser2MarkedUnread sets the "user2_marked_unread" field if the given value is not nil.
+func (_c *PrivateChatCreate) SetNillableUser2MarkedUnread(v *bool) *PrivateChatCreate {
+	if v != nil {
+		_c.SetUser2MarkedUnread(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PrivateChatCreate) SetID(v uuid.UUID) *PrivateChatCreate {
 	_c.mutation.SetID(v)
@@ -210,6 +350,22 @@ func (_c *PrivateChatCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PrivateChatCreate) defaults() error {
+	if _, ok := _c.mutation.User1KeepArchived(); !ok {
+		v := privatechat.DefaultUser1KeepArchived
+		_c.mutation.SetUser1KeepArchived(v)
+	}
+	if _, ok := _c.mutation.User2KeepArchived(); !ok {
+		v := privatechat.DefaultUser2KeepArchived
+		_c.mutation.SetUser2KeepArchived(v)
+	}
+	if _, ok := _c.mutation.User1Muted(); !ok {
+		v := privatechat.DefaultUser1Muted
+		_c.mutation.SetUser1Muted(v)
+	}
+	if _, ok := _c.mutation.User2Muted(); !ok {
+		v := privatechat.DefaultUser2Muted
+		_c.mutation.SetUser2Muted(v)
+	}
 	if _, ok := _c.mutation.User1UnreadCount(); !ok {
 		v := privatechat.DefaultUser1UnreadCount
 		_c.mutation.SetUser1UnreadCount(v)
@@ -218,6 +374,14 @@ func (_c *PrivateChatCreate) defaults() error {
 		v := privatechat.DefaultUser2UnreadCount
 		_c.mutation.SetUser2UnreadCount(v)
 	}
+	if _, ok := _c.mutation.User1MarkedUnread(); !ok {
+		v := privatechat.DefaultUser1MarkedUnread
+		_c.mutation.SetUser1MarkedUnread(v)
+	}
+	if _, ok := _c.mutation.User2MarkedUnread(); !ok {
+		v := privatechat.DefaultUser2MarkedUnread
+		_c.mutation.SetUser2MarkedUnread(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		if privatechat.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized privatechat.DefaultID (forgotten import ent/runtime?)")
@@ -233,12 +397,30 @@ func (_c *PrivateChatCreate) check() error {
 	if _, ok := _c.mutation.ChatID(); !ok {
 		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "PrivateChat.chat_id"`)}
 	}
+	if _, ok := _c.mutation.User1KeepArchived(); !ok {
+		return &ValidationError{Name: "user1_keep_archived", err: errors.New(`ent: missing required field "PrivateChat.user1_keep_archived"`)}
+	}
+	if _, ok := _c.mutation.User2KeepArchived(); !ok {
+		return &ValidationError{Name: "user2_keep_archived", err: errors.New(`ent: missing required field "PrivateChat.user2_keep_archived"`)}
+	}
+	if _, ok := _c.mutation.User1Muted(); !ok {
+		return &ValidationError{Name: "user1_muted", err: errors.New(`ent: missing required field "PrivateChat.user1_muted"`)}
+	}
+	if _, ok := _c.mutation.User2Muted(); !ok {
+		return &ValidationError{Name: "user2_muted", err: errors.New(`ent: missing required field "PrivateChat.user2_muted"`)}
+	}
 	if _, ok := _c.mutation.User1UnreadCount(); !ok {
 		return &ValidationError{Name: "user1_unread_count", err: errors.New(`ent: missing required field "PrivateChat.user1_unread_count"`)}
 	}
 	if _, ok := _c.mutation.User2UnreadCount(); !ok {
 		return &ValidationError{Name: "user2_unread_count", err: errors.New(`ent: missing required field "PrivateChat.user2_unread_count"`)}
 	}
+	if _, ok := _c.mutation.User1MarkedUnread(); !ok {
+		return &ValidationError{Name: "user1_marked_unread", err: errors.New(`ent: missing required field "PrivateChat.user1_marked_unread"`)}
+	}
+	if _, ok := _c.mutation.User2MarkedUnread(); !ok {
+		return &ValidationError{Name: "user2_marked_unread", err: errors.New(`ent: missing required field "PrivateChat.user2_marked_unread"`)}
+	}
 	if len(_c.mutation.ChatIDs()) == 0 {
 		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "PrivateChat.chat"`)}
 	}
@@ -294,6 +476,38 @@ func (_c *PrivateChatCreate) createSpec() (*PrivateChat, *sqlgraph.CreateSpec) {
 		_spec.SetField(privatechat.FieldUser2HiddenAt, field.TypeTime, value)
 		_node.User2HiddenAt = &value
 	}
+	if value, ok := _c.mutation.User1ArchivedAt(); ok {
+		_spec.SetField(privatechat.FieldUser1ArchivedAt, field.TypeTime, value)
+		_node.User1ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.User2ArchivedAt(); ok {
+		_spec.SetField(privatechat.FieldUser2ArchivedAt, field.TypeTime, value)
+		_node.User2ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.User1KeepArchived(); ok {
+		_spec.SetField(privatechat.FieldUser1KeepArchived, field.TypeBool, value)
+		_node.User1KeepArchived = value
+	}
+	if value, ok := _c.mutation.User2KeepArchived(); ok {
+		_spec.SetField(privatechat.FieldUser2KeepArchived, field.TypeBool, value)
+		_node.User2KeepArchived = value
+	}
+	if value, ok := _c.mutation.User1Muted(); ok {
+		_spec.SetField(privatechat.FieldUser1Muted, field.TypeBool, value)
+		_node.User1Muted = value
+	}
+	if value, ok := _c.mutation.User2Muted(); ok {
+		_spec.SetField(privatechat.FieldUser2Muted, field.TypeBool, value)
+		_node.User2Muted = value
+	}
+	if value, ok := _c.mutation.User1MutedUntil(); ok {
+		_spec.SetField(privatechat.FieldUser1MutedUntil, field.TypeTime, value)
+		_node.User1MutedUntil = &value
+	}
+	if value, ok := _c.mutation.User2MutedUntil(); ok {
+		_spec.SetField(privatechat.FieldUser2MutedUntil, field.TypeTime, value)
+		_node.User2MutedUntil = &value
+	}
 	if value, ok := _c.mutation.User1UnreadCount(); ok {
 		_spec.SetField(privatechat.FieldUser1UnreadCount, field.TypeInt, value)
 		_node.User1UnreadCount = value
@@ -302,6 +516,14 @@ func (_c *PrivateChatCreate) createSpec() (*PrivateChat, *sqlgraph.CreateSpec) {
 		_spec.SetField(privatechat.FieldUser2UnreadCount, field.TypeInt, value)
 		_node.User2UnreadCount = value
 	}
+	if value, ok := _c.mutation.User1MarkedUnread(); ok {
+		_spec.SetField(privatechat.FieldUser1MarkedUnread, field.TypeBool, value)
+		_node.User1MarkedUnread = value
+	}
+	if value, ok := _c.mutation.User2MarkedUnread(); ok {
+		_spec.SetField(privatechat.FieldUser2MarkedUnread, field.TypeBool, value)
+		_node.User2MarkedUnread = value
+	}
 	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -525,6 +747,126 @@ func (u *PrivateChatUpsert) ClearUser2HiddenAt() *PrivateChatUpsert {
 	return u
 }
 
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (u *PrivateChatUpsert) SetUser1ArchivedAt(v time.Time) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser1ArchivedAt, v)
+	return u
+}
+
+// UpdateUser1ArchivedAt sets the "user1_archived_at" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser1ArchivedAt() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser1ArchivedAt)
+	return u
+}
+
+// ClearUser1ArchivedAt clears the value of the "user1_archived_at" field.
+func (u *PrivateChatUpsert) ClearUser1ArchivedAt() *PrivateChatUpsert {
+	u.SetNull(privatechat.FieldUser1ArchivedAt)
+	return u
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (u *PrivateChatUpsert) SetUser2ArchivedAt(v time.Time) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser2ArchivedAt, v)
+	return u
+}
+
+// UpdateUser2ArchivedAt sets the "user2_archived_at" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser2ArchivedAt() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser2ArchivedAt)
+	return u
+}
+
+// ClearUser2ArchivedAt clears the value of the "user2_archived_at" field.
+func (u *PrivateChatUpsert) ClearUser2ArchivedAt() *PrivateChatUpsert {
+	u.SetNull(privatechat.FieldUser2ArchivedAt)
+	return u
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (u *PrivateChatUpsert) SetUser1KeepArchived(v bool) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser1KeepArchived, v)
+	return u
+}
+
+// UpdateUser1KeepArchived sets the "user1_keep_archived" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser1KeepArchived() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser1KeepArchived)
+	return u
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (u *PrivateChatUpsert) SetUser2KeepArchived(v bool) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser2KeepArchived, v)
+	return u
+}
+
+// UpdateUser2KeepArchived sets the "user2_keep_archived" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser2KeepArchived() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser2KeepArchived)
+	return u
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (u *PrivateChatUpsert) SetUser1Muted(v bool) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser1Muted, v)
+	return u
+}
+
+// UpdateUser1Muted sets the "user1_muted" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser1Muted() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser1Muted)
+	return u
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (u *PrivateChatUpsert) SetUser2Muted(v bool) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser2Muted, v)
+	return u
+}
+
+// UpdateUser2Muted sets the "user2_muted" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser2Muted() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser2Muted)
+	return u
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (u *PrivateChatUpsert) SetUser1MutedUntil(v time.Time) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser1MutedUntil, v)
+	return u
+}
+
+// UpdateUser1MutedUntil sets the "user1_muted_until" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser1MutedUntil() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser1MutedUntil)
+	return u
+}
+
+// ClearUser1MutedUntil clears the value of the "user1_muted_until" field.
+func (u *PrivateChatUpsert) ClearUser1MutedUntil() *PrivateChatUpsert {
+	u.SetNull(privatechat.FieldUser1MutedUntil)
+	return u
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (u *PrivateChatUpsert) SetUser2MutedUntil(v time.Time) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser2MutedUntil, v)
+	return u
+}
+
+// UpdateUser2MutedUntil sets the "user2_muted_until" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser2MutedUntil() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser2MutedUntil)
+	return u
+}
+
+// ClearUser2MutedUntil clears the value of the "user2_muted_until" field.
+func (u *PrivateChatUpsert) ClearUser2MutedUntil() *PrivateChatUpsert {
+	u.SetNull(privatechat.FieldUser2MutedUntil)
+	return u
+}
+
 // SetUser1UnreadCount sets the "user1_unread_count" field.
 func (u *PrivateChatUpsert) SetUser1UnreadCount(v int) *PrivateChatUpsert {
 	u.Set(privatechat.FieldUser1UnreadCount, v)
@@ -561,6 +903,30 @@ func (u *PrivateChatUpsert) AddUser2UnreadCount(v int) *PrivateChatUpsert {
 	return u
 }
 
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (u *PrivateChatUpsert) SetUser1MarkedUnread(v bool) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser1MarkedUnread, v)
+	return u
+}
+
+// UpdateUser1MarkedUnread sets the "user1_marked_unread" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser1MarkedUnread() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser1MarkedUnread)
+	return u
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (u *PrivateChatUpsert) SetUser2MarkedUnread(v bool) *PrivateChatUpsert {
+	u.Set(privatechat.FieldUser2MarkedUnread, v)
+	return u
+}
+
+// UpdateUser2MarkedUnread sets the "user2_marked_unread" field to the value that was provided on create.
+func (u *PrivateChatUpsert) UpdateUser2MarkedUnread() *PrivateChatUpsert {
+	u.SetExcluded(privatechat.FieldUser2MarkedUnread)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -749,6 +1115,146 @@ func (u *PrivateChatUpsertOne) ClearUser2HiddenAt() *PrivateChatUpsertOne {
 	})
 }
 
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (u *PrivateChatUpsertOne) SetUser1ArchivedAt(v time.Time) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1ArchivedAt(v)
+	})
+}
+
+// UpdateUser1ArchivedAt sets the "user1_archived_at" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser1ArchivedAt() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1ArchivedAt()
+	})
+}
+
+// ClearUser1ArchivedAt clears the value of the "user1_archived_at" field.
+func (u *PrivateChatUpsertOne) ClearUser1ArchivedAt() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser1ArchivedAt()
+	})
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (u *PrivateChatUpsertOne) SetUser2ArchivedAt(v time.Time) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2ArchivedAt(v)
+	})
+}
+
+// UpdateUser2ArchivedAt sets the "user2_archived_at" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser2ArchivedAt() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2ArchivedAt()
+	})
+}
+
+// ClearUser2ArchivedAt clears the value of the "user2_archived_at" field.
+func (u *PrivateChatUpsertOne) ClearUser2ArchivedAt() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser2ArchivedAt()
+	})
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (u *PrivateChatUpsertOne) SetUser1KeepArchived(v bool) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1KeepArchived(v)
+	})
+}
+
+// UpdateUser1KeepArchived sets the "user1_keep_archived" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser1KeepArchived() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1KeepArchived()
+	})
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (u *PrivateChatUpsertOne) SetUser2KeepArchived(v bool) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2KeepArchived(v)
+	})
+}
+
+// UpdateUser2KeepArchived sets the "user2_keep_archived" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser2KeepArchived() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2KeepArchived()
+	})
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (u *PrivateChatUpsertOne) SetUser1Muted(v bool) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1Muted(v)
+	})
+}
+
+// UpdateUser1Muted sets the "user1_muted" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser1Muted() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1Muted()
+	})
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (u *PrivateChatUpsertOne) SetUser2Muted(v bool) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2Muted(v)
+	})
+}
+
+// UpdateUser2Muted sets the "user2_muted" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser2Muted() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2Muted()
+	})
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (u *PrivateChatUpsertOne) SetUser1MutedUntil(v time.Time) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1MutedUntil(v)
+	})
+}
+
+// UpdateUser1MutedUntil sets the "user1_muted_until" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser1MutedUntil() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1MutedUntil()
+	})
+}
+
+// ClearUser1MutedUntil clears the value of the "user1_muted_until" field.
+func (u *PrivateChatUpsertOne) ClearUser1MutedUntil() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser1MutedUntil()
+	})
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (u *PrivateChatUpsertOne) SetUser2MutedUntil(v time.Time) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2MutedUntil(v)
+	})
+}
+
+// UpdateUser2MutedUntil sets the "user2_muted_until" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser2MutedUntil() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2MutedUntil()
+	})
+}
+
+// ClearUser2MutedUntil clears the value of the "user2_muted_until" field.
+func (u *PrivateChatUpsertOne) ClearUser2MutedUntil() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser2MutedUntil()
+	})
+}
+
 // SetUser1UnreadCount sets the "user1_unread_count" field.
 func (u *PrivateChatUpsertOne) SetUser1UnreadCount(v int) *PrivateChatUpsertOne {
 	return u.Update(func(s *PrivateChatUpsert) {
@@ -791,6 +1297,34 @@ func (u *PrivateChatUpsertOne) UpdateUser2UnreadCount() *PrivateChatUpsertOne {
 	})
 }
 
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (u *PrivateChatUpsertOne) SetUser1MarkedUnread(v bool) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1MarkedUnread(v)
+	})
+}
+
+// UpdateUser1MarkedUnread sets the "user1_marked_unread" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser1MarkedUnread() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1MarkedUnread()
+	})
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (u *PrivateChatUpsertOne) SetUser2MarkedUnread(v bool) *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2MarkedUnread(v)
+	})
+}
+
+// UpdateUser2MarkedUnread sets the "user2_marked_unread" field to the value that was provided on create.
+func (u *PrivateChatUpsertOne) UpdateUser2MarkedUnread() *PrivateChatUpsertOne {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2MarkedUnread()
+	})
+}
+
 // Exec executes the query.
 func (u *PrivateChatUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1146,6 +1680,146 @@ func (u *PrivateChatUpsertBulk) ClearUser2HiddenAt() *PrivateChatUpsertBulk {
 	})
 }
 
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (u *PrivateChatUpsertBulk) SetUser1ArchivedAt(v time.Time) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1ArchivedAt(v)
+	})
+}
+
+// UpdateUser1ArchivedAt sets the "user1_archived_at" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser1ArchivedAt() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1ArchivedAt()
+	})
+}
+
+// ClearUser1ArchivedAt clears the value of the "user1_archived_at" field.
+func (u *PrivateChatUpsertBulk) ClearUser1ArchivedAt() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser1ArchivedAt()
+	})
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (u *PrivateChatUpsertBulk) SetUser2ArchivedAt(v time.Time) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2ArchivedAt(v)
+	})
+}
+
+// UpdateUser2ArchivedAt sets the "user2_archived_at" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser2ArchivedAt() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2ArchivedAt()
+	})
+}
+
+// ClearUser2ArchivedAt clears the value of the "user2_archived_at" field.
+func (u *PrivateChatUpsertBulk) ClearUser2ArchivedAt() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser2ArchivedAt()
+	})
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (u *PrivateChatUpsertBulk) SetUser1KeepArchived(v bool) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1KeepArchived(v)
+	})
+}
+
+// UpdateUser1KeepArchived sets the "user1_keep_archived" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser1KeepArchived() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1KeepArchived()
+	})
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (u *PrivateChatUpsertBulk) SetUser2KeepArchived(v bool) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2KeepArchived(v)
+	})
+}
+
+// UpdateUser2KeepArchived sets the "user2_keep_archived" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser2KeepArchived() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2KeepArchived()
+	})
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (u *PrivateChatUpsertBulk) SetUser1Muted(v bool) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1Muted(v)
+	})
+}
+
+// UpdateUser1Muted sets the "user1_muted" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser1Muted() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1Muted()
+	})
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (u *PrivateChatUpsertBulk) SetUser2Muted(v bool) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2Muted(v)
+	})
+}
+
+// UpdateUser2Muted sets the "user2_muted" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser2Muted() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2Muted()
+	})
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (u *PrivateChatUpsertBulk) SetUser1MutedUntil(v time.Time) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1MutedUntil(v)
+	})
+}
+
+// UpdateUser1MutedUntil sets the "user1_muted_until" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser1MutedUntil() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1MutedUntil()
+	})
+}
+
+// ClearUser1MutedUntil clears the value of the "user1_muted_until" field.
+func (u *PrivateChatUpsertBulk) ClearUser1MutedUntil() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser1MutedUntil()
+	})
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (u *PrivateChatUpsertBulk) SetUser2MutedUntil(v time.Time) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2MutedUntil(v)
+	})
+}
+
+// UpdateUser2MutedUntil sets the "user2_muted_until" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser2MutedUntil() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2MutedUntil()
+	})
+}
+
+// ClearUser2MutedUntil clears the value of the "user2_muted_until" field.
+func (u *PrivateChatUpsertBulk) ClearUser2MutedUntil() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.ClearUser2MutedUntil()
+	})
+}
+
 // SetUser1UnreadCount sets the "user1_unread_count" field.
 func (u *PrivateChatUpsertBulk) SetUser1UnreadCount(v int) *PrivateChatUpsertBulk {
 	return u.Update(func(s *PrivateChatUpsert) {
@@ -1188,6 +1862,34 @@ func (u *PrivateChatUpsertBulk) UpdateUser2UnreadCount() *PrivateChatUpsertBulk
 	})
 }
 
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (u *PrivateChatUpsertBulk) SetUser1MarkedUnread(v bool) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser1MarkedUnread(v)
+	})
+}
+
+// UpdateUser1MarkedUnread sets the "user1_marked_unread" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser1MarkedUnread() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser1MarkedUnread()
+	})
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (u *PrivateChatUpsertBulk) SetUser2MarkedUnread(v bool) *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.SetUser2MarkedUnread(v)
+	})
+}
+
+// UpdateUser2MarkedUnread sets the "user2_marked_unread" field to the value that was provided on create.
+func (u *PrivateChatUpsertBulk) UpdateUser2MarkedUnread() *PrivateChatUpsertBulk {
+	return u.Update(func(s *PrivateChatUpsert) {
+		s.UpdateUser2MarkedUnread()
+	})
+}
+
 // Exec executes the query.
 func (u *PrivateChatUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -166,6 +166,142 @@ func (_u *PrivateChatUpdate) ClearUser2HiddenAt() *PrivateChatUpdate {
 	return _u
 }
 
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (_u *PrivateChatUpdate) SetUser1ArchivedAt(v time.Time) *PrivateChatUpdate {
+	_u.mutation.SetUser1ArchivedAt(v)
+	return _u
+}
+
+// SetNillableUser1ArchivedAt sets the "user1_archived_at" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser1ArchivedAt(v *time.Time) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser1ArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearUser1ArchivedAt clears the value of the "user1_archived_at" field.
+func (_u *PrivateChatUpdate) ClearUser1ArchivedAt() *PrivateChatUpdate {
+	_u.mutation.ClearUser1ArchivedAt()
+	return _u
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (_u *PrivateChatUpdate) SetUser2ArchivedAt(v time.Time) *PrivateChatUpdate {
+	_u.mutation.SetUser2ArchivedAt(v)
+	return _u
+}
+
+// SetNillableUser2ArchivedAt sets the "user2_archived_at" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser2ArchivedAt(v *time.Time) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser2ArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearUser2ArchivedAt clears the value of the "user2_archived_at" field.
+func (_u *PrivateChatUpdate) ClearUser2ArchivedAt() *PrivateChatUpdate {
+	_u.mutation.ClearUser2ArchivedAt()
+	return _u
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (_u *PrivateChatUpdate) SetUser1KeepArchived(v bool) *PrivateChatUpdate {
+	_u.mutation.SetUser1KeepArchived(v)
+	return _u
+}
+
+// SetNillableUser1KeepArchived sets the "user1_keep_archived" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser1KeepArchived(v *bool) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser1KeepArchived(*v)
+	}
+	return _u
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (_u *PrivateChatUpdate) SetUser2KeepArchived(v bool) *PrivateChatUpdate {
+	_u.mutation.SetUser2KeepArchived(v)
+	return _u
+}
+
+// SetNillableUser2KeepArchived sets the "user2_keep_archived" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser2KeepArchived(v *bool) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser2KeepArchived(*v)
+	}
+	return _u
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (_u *PrivateChatUpdate) SetUser1Muted(v bool) *PrivateChatUpdate {
+	_u.mutation.SetUser1Muted(v)
+	return _u
+}
+
+// SetNillableUser1Muted sets the "user1_muted" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser1Muted(v *bool) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser1Muted(*v)
+	}
+	return _u
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (_u *PrivateChatUpdate) SetUser2Muted(v bool) *PrivateChatUpdate {
+	_u.mutation.SetUser2Muted(v)
+	return _u
+}
+
+// SetNillableUser2Muted sets the "user2_muted" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser2Muted(v *bool) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser2Muted(*v)
+	}
+	return _u
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (_u *PrivateChatUpdate) SetUser1MutedUntil(v time.Time) *PrivateChatUpdate {
+	_u.mutation.SetUser1MutedUntil(v)
+	return _u
+}
+
+// SetNillableUser1MutedUntil sets the "user1_muted_until" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser1MutedUntil(v *time.Time) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser1MutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearUser1MutedUntil clears the value of the "user1_muted_until" field.
+func (_u *PrivateChatUpdate) ClearUser1MutedUntil() *PrivateChatUpdate {
+	_u.mutation.ClearUser1MutedUntil()
+	return _u
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (_u *PrivateChatUpdate) SetUser2MutedUntil(v time.Time) *PrivateChatUpdate {
+	_u.mutation.SetUser2MutedUntil(v)
+	return _u
+}
+
+// SetNillableUser2MutedUntil sets the "user2_muted_until" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser2MutedUntil(v *time.Time) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser2MutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearUser2MutedUntil clears the value of the "user2_muted_until" field.
+func (_u *PrivateChatUpdate) ClearUser2MutedUntil() *PrivateChatUpdate {
+	_u.mutation.ClearUser2MutedUntil()
+	return _u
+}
+
 // SetUser1UnreadCount sets the "user1_unread_count" field.
 func (_u *PrivateChatUpdate) SetUser1UnreadCount(v int) *PrivateChatUpdate {
 	_u.mutation.ResetUser1UnreadCount()
@@ -208,6 +344,34 @@ func (_u *PrivateChatUpdate) AddUser2UnreadCount(v int) *PrivateChatUpdate {
 	return _u
 }
 
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (_u *PrivateChatUpdate) SetUser1MarkedUnread(v bool) *PrivateChatUpdate {
+	_u.mutation.SetUser1MarkedUnread(v)
+	return _u
+}
+
+// SetNillableUser1MarkedUnread sets the "user1_marked_unread" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser1MarkedUnread(v *bool) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser1MarkedUnread(*v)
+	}
+	return _u
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (_u *PrivateChatUpdate) SetUser2MarkedUnread(v bool) *PrivateChatUpdate {
+	_u.mutation.SetUser2MarkedUnread(v)
+	return _u
+}
+
+// SetNillableUser2MarkedUnread sets the "user2_marked_unread" field if the given value is not nil.
+func (_u *PrivateChatUpdate) SetNillableUser2MarkedUnread(v *bool) *PrivateChatUpdate {
+	if v != nil {
+		_u.SetUser2MarkedUnread(*v)
+	}
+	return _u
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (_u *PrivateChatUpdate) SetChat(v *Chat) *PrivateChatUpdate {
 	return _u.SetChatID(v.ID)
@@ -323,6 +487,42 @@ func (_u *PrivateChatUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.User2HiddenAtCleared() {
 		_spec.ClearField(privatechat.FieldUser2HiddenAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.User1ArchivedAt(); ok {
+		_spec.SetField(privatechat.FieldUser1ArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.User1ArchivedAtCleared() {
+		_spec.ClearField(privatechat.FieldUser1ArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.User2ArchivedAt(); ok {
+		_spec.SetField(privatechat.FieldUser2ArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.User2ArchivedAtCleared() {
+		_spec.ClearField(privatechat.FieldUser2ArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.User1KeepArchived(); ok {
+		_spec.SetField(privatechat.FieldUser1KeepArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User2KeepArchived(); ok {
+		_spec.SetField(privatechat.FieldUser2KeepArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User1Muted(); ok {
+		_spec.SetField(privatechat.FieldUser1Muted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User2Muted(); ok {
+		_spec.SetField(privatechat.FieldUser2Muted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User1MutedUntil(); ok {
+		_spec.SetField(privatechat.FieldUser1MutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.User1MutedUntilCleared() {
+		_spec.ClearField(privatechat.FieldUser1MutedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.User2MutedUntil(); ok {
+		_spec.SetField(privatechat.FieldUser2MutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.User2MutedUntilCleared() {
+		_spec.ClearField(privatechat.FieldUser2MutedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.User1UnreadCount(); ok {
 		_spec.SetField(privatechat.FieldUser1UnreadCount, field.TypeInt, value)
 	}
@@ -335,6 +535,12 @@ func (_u *PrivateChatUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedUser2UnreadCount(); ok {
 		_spec.AddField(privatechat.FieldUser2UnreadCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.User1MarkedUnread(); ok {
+		_spec.SetField(privatechat.FieldUser1MarkedUnread, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User2MarkedUnread(); ok {
+		_spec.SetField(privatechat.FieldUser2MarkedUnread, field.TypeBool, value)
+	}
 	if _u.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -578,6 +784,142 @@ func (_u *PrivateChatUpdateOne) ClearUser2HiddenAt() *PrivateChatUpdateOne {
 	return _u
 }
 
+// SetUser1ArchivedAt sets the "user1_archived_at" field.
+func (_u *PrivateChatUpdateOne) SetUser1ArchivedAt(v time.Time) *PrivateChatUpdateOne {
+	_u.mutation.SetUser1ArchivedAt(v)
+	return _u
+}
+
+// SetNillableUser1ArchivedAt sets the "user1_archived_at" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser1ArchivedAt(v *time.Time) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser1ArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearUser1ArchivedAt clears the value of the "user1_archived_at" field.
+func (_u *PrivateChatUpdateOne) ClearUser1ArchivedAt() *PrivateChatUpdateOne {
+	_u.mutation.ClearUser1ArchivedAt()
+	return _u
+}
+
+// SetUser2ArchivedAt sets the "user2_archived_at" field.
+func (_u *PrivateChatUpdateOne) SetUser2ArchivedAt(v time.Time) *PrivateChatUpdateOne {
+	_u.mutation.SetUser2ArchivedAt(v)
+	return _u
+}
+
+// SetNillableUser2ArchivedAt sets the "user2_archived_at" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser2ArchivedAt(v *time.Time) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser2ArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearUser2ArchivedAt clears the value of the "user2_archived_at" field.
+func (_u *PrivateChatUpdateOne) ClearUser2ArchivedAt() *PrivateChatUpdateOne {
+	_u.mutation.ClearUser2ArchivedAt()
+	return _u
+}
+
+// SetUser1KeepArchived sets the "user1_keep_archived" field.
+func (_u *PrivateChatUpdateOne) SetUser1KeepArchived(v bool) *PrivateChatUpdateOne {
+	_u.mutation.SetUser1KeepArchived(v)
+	return _u
+}
+
+// SetNillableUser1KeepArchived sets the "user1_keep_archived" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser1KeepArchived(v *bool) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser1KeepArchived(*v)
+	}
+	return _u
+}
+
+// SetUser2KeepArchived sets the "user2_keep_archived" field.
+func (_u *PrivateChatUpdateOne) SetUser2KeepArchived(v bool) *PrivateChatUpdateOne {
+	_u.mutation.SetUser2KeepArchived(v)
+	return _u
+}
+
+// SetNillableUser2KeepArchived sets the "user2_keep_archived" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser2KeepArchived(v *bool) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser2KeepArchived(*v)
+	}
+	return _u
+}
+
+// SetUser1Muted sets the "user1_muted" field.
+func (_u *PrivateChatUpdateOne) SetUser1Muted(v bool) *PrivateChatUpdateOne {
+	_u.mutation.SetUser1Muted(v)
+	return _u
+}
+
+// SetNillableUser1Muted sets the "user1_muted" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser1Muted(v *bool) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser1Muted(*v)
+	}
+	return _u
+}
+
+// SetUser2Muted sets the "user2_muted" field.
+func (_u *PrivateChatUpdateOne) SetUser2Muted(v bool) *PrivateChatUpdateOne {
+	_u.mutation.SetUser2Muted(v)
+	return _u
+}
+
+// SetNillableUser2Muted sets the "user2_muted" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser2Muted(v *bool) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser2Muted(*v)
+	}
+	return _u
+}
+
+// SetUser1MutedUntil sets the "user1_muted_until" field.
+func (_u *PrivateChatUpdateOne) SetUser1MutedUntil(v time.Time) *PrivateChatUpdateOne {
+	_u.mutation.SetUser1MutedUntil(v)
+	return _u
+}
+
+// SetNillableUser1MutedUntil sets the "user1_muted_until" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser1MutedUntil(v *time.Time) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser1MutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearUser1MutedUntil clears the value of the "user1_muted_until" field.
+func (_u *PrivateChatUpdateOne) ClearUser1MutedUntil() *PrivateChatUpdateOne {
+	_u.mutation.ClearUser1MutedUntil()
+	return _u
+}
+
+// SetUser2MutedUntil sets the "user2_muted_until" field.
+func (_u *PrivateChatUpdateOne) SetUser2MutedUntil(v time.Time) *PrivateChatUpdateOne {
+	_u.mutation.SetUser2MutedUntil(v)
+	return _u
+}
+
+// SetNillableUser2MutedUntil sets the "user2_muted_until" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser2MutedUntil(v *time.Time) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser2MutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearUser2MutedUntil clears the value of the "user2_muted_until" field.
+func (_u *PrivateChatUpdateOne) ClearUser2MutedUntil() *PrivateChatUpdateOne {
+	_u.mutation.ClearUser2MutedUntil()
+	return _u
+}
+
 // SetUser1UnreadCount sets the "user1_unread_count" field.
 func (_u *PrivateChatUpdateOne) SetUser1UnreadCount(v int) *PrivateChatUpdateOne {
 	_u.mutation.ResetUser1UnreadCount()
@@ -620,6 +962,34 @@ func (_u *PrivateChatUpdateOne) AddUser2UnreadCount(v int) *PrivateChatUpdateOne
 	return _u
 }
 
+// SetUser1MarkedUnread sets the "user1_marked_unread" field.
+func (_u *PrivateChatUpdateOne) SetUser1MarkedUnread(v bool) *PrivateChatUpdateOne {
+	_u.mutation.SetUser1MarkedUnread(v)
+	return _u
+}
+
+// SetNillableUser1MarkedUnread sets the "user1_marked_unread" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser1MarkedUnread(v *bool) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser1MarkedUnread(*v)
+	}
+	return _u
+}
+
+// SetUser2MarkedUnread sets the "user2_marked_unread" field.
+func (_u *PrivateChatUpdateOne) SetUser2MarkedUnread(v bool) *PrivateChatUpdateOne {
+	_u.mutation.SetUser2MarkedUnread(v)
+	return _u
+}
+
+// SetNillableUser2MarkedUnread sets the "user2_marked_unread" field if the given value is not nil.
+func (_u *PrivateChatUpdateOne) SetNillableUser2MarkedUnread(v *bool) *PrivateChatUpdateOne {
+	if v != nil {
+		_u.SetUser2MarkedUnread(*v)
+	}
+	return _u
+}
+
 // SetChat sets the "chat" edge to the Chat entity.
 func (_u *PrivateChatUpdateOne) SetChat(v *Chat) *PrivateChatUpdateOne {
 	return _u.SetChatID(v.ID)
@@ -765,6 +1135,42 @@ func (_u *PrivateChatUpdateOne) sqlSave(ctx context.Context) (_node *PrivateChat
 	if _u.mutation.User2HiddenAtCleared() {
 		_spec.ClearField(privatechat.FieldUser2HiddenAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.User1ArchivedAt(); ok {
+		_spec.SetField(privatechat.FieldUser1ArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.User1ArchivedAtCleared() {
+		_spec.ClearField(privatechat.FieldUser1ArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.User2ArchivedAt(); ok {
+		_spec.SetField(privatechat.FieldUser2ArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.User2ArchivedAtCleared() {
+		_spec.ClearField(privatechat.FieldUser2ArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.User1KeepArchived(); ok {
+		_spec.SetField(privatechat.FieldUser1KeepArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User2KeepArchived(); ok {
+		_spec.SetField(privatechat.FieldUser2KeepArchived, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User1Muted(); ok {
+		_spec.SetField(privatechat.FieldUser1Muted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User2Muted(); ok {
+		_spec.SetField(privatechat.FieldUser2Muted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User1MutedUntil(); ok {
+		_spec.SetField(privatechat.FieldUser1MutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.User1MutedUntilCleared() {
+		_spec.ClearField(privatechat.FieldUser1MutedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.User2MutedUntil(); ok {
+		_spec.SetField(privatechat.FieldUser2MutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.User2MutedUntilCleared() {
+		_spec.ClearField(privatechat.FieldUser2MutedUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.User1UnreadCount(); ok {
 		_spec.SetField(privatechat.FieldUser1UnreadCount, field.TypeInt, value)
 	}
@@ -777,6 +1183,12 @@ func (_u *PrivateChatUpdateOne) sqlSave(ctx context.Context) (_node *PrivateChat
 	if value, ok := _u.mutation.AddedUser2UnreadCount(); ok {
 		_spec.AddField(privatechat.FieldUser2UnreadCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.User1MarkedUnread(); ok {
+		_spec.SetField(privatechat.FieldUser1MarkedUnread, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.User2MarkedUnread(); ok {
+		_spec.SetField(privatechat.FieldUser2MarkedUnread, field.TypeBool, value)
+	}
 	if _u.mutation.ChatCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
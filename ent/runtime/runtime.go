@@ -4,12 +4,25 @@ package runtime
 
 import (
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/schema"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
@@ -38,10 +51,107 @@ func init() {
 	chat.DefaultUpdatedAt = chatDescUpdatedAt.Default.(func() time.Time)
 	// chat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	chat.UpdateDefaultUpdatedAt = chatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// chatDescMessageTTL is the schema descriptor for message_ttl field.
+	chatDescMessageTTL := chatFields[4].Descriptor()
+	// chat.MessageTTLValidator is a validator for the "message_ttl" field. It is called by the builders before save.
+	chat.MessageTTLValidator = chatDescMessageTTL.Validators[0].(func(int) error)
 	// chatDescID is the schema descriptor for id field.
 	chatDescID := chatFields[0].Descriptor()
 	// chat.DefaultID holds the default value on creation for the id field.
 	chat.DefaultID = chatDescID.Default.(func() uuid.UUID)
+	chatexportMixin := schema.ChatExport{}.Mixin()
+	chatexportMixinFields0 := chatexportMixin[0].Fields()
+	_ = chatexportMixinFields0
+	chatexportFields := schema.ChatExport{}.Fields()
+	_ = chatexportFields
+	// chatexportDescCreatedAt is the schema descriptor for created_at field.
+	chatexportDescCreatedAt := chatexportMixinFields0[0].Descriptor()
+	// chatexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatexport.DefaultCreatedAt = chatexportDescCreatedAt.Default.(func() time.Time)
+	// chatexportDescUpdatedAt is the schema descriptor for updated_at field.
+	chatexportDescUpdatedAt := chatexportMixinFields0[1].Descriptor()
+	// chatexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatexport.DefaultUpdatedAt = chatexportDescUpdatedAt.Default.(func() time.Time)
+	// chatexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatexport.UpdateDefaultUpdatedAt = chatexportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// chatexportDescFileName is the schema descriptor for file_name field.
+	chatexportDescFileName := chatexportFields[4].Descriptor()
+	// chatexport.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	chatexport.FileNameValidator = chatexportDescFileName.Validators[0].(func(string) error)
+	// chatexportDescMessageCount is the schema descriptor for message_count field.
+	chatexportDescMessageCount := chatexportFields[6].Descriptor()
+	// chatexport.DefaultMessageCount holds the default value on creation for the message_count field.
+	chatexport.DefaultMessageCount = chatexportDescMessageCount.Default.(int)
+	// chatexport.MessageCountValidator is a validator for the "message_count" field. It is called by the builders before save.
+	chatexport.MessageCountValidator = chatexportDescMessageCount.Validators[0].(func(int) error)
+	// chatexportDescFailureReason is the schema descriptor for failure_reason field.
+	chatexportDescFailureReason := chatexportFields[7].Descriptor()
+	// chatexport.FailureReasonValidator is a validator for the "failure_reason" field. It is called by the builders before save.
+	chatexport.FailureReasonValidator = chatexportDescFailureReason.Validators[0].(func(string) error)
+	// chatexportDescID is the schema descriptor for id field.
+	chatexportDescID := chatexportFields[0].Descriptor()
+	// chatexport.DefaultID holds the default value on creation for the id field.
+	chatexport.DefaultID = chatexportDescID.Default.(func() uuid.UUID)
+	chatfolderMixin := schema.ChatFolder{}.Mixin()
+	chatfolderMixinFields0 := chatfolderMixin[0].Fields()
+	_ = chatfolderMixinFields0
+	chatfolderFields := schema.ChatFolder{}.Fields()
+	_ = chatfolderFields
+	// chatfolderDescCreatedAt is the schema descriptor for created_at field.
+	chatfolderDescCreatedAt := chatfolderMixinFields0[0].Descriptor()
+	// chatfolder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatfolder.DefaultCreatedAt = chatfolderDescCreatedAt.Default.(func() time.Time)
+	// chatfolderDescUpdatedAt is the schema descriptor for updated_at field.
+	chatfolderDescUpdatedAt := chatfolderMixinFields0[1].Descriptor()
+	// chatfolder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatfolder.DefaultUpdatedAt = chatfolderDescUpdatedAt.Default.(func() time.Time)
+	// chatfolder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatfolder.UpdateDefaultUpdatedAt = chatfolderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// chatfolderDescName is the schema descriptor for name field.
+	chatfolderDescName := chatfolderFields[2].Descriptor()
+	// chatfolder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	chatfolder.NameValidator = func() func(string) error {
+		validators := chatfolderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// chatfolderDescUnreadOnly is the schema descriptor for unread_only field.
+	chatfolderDescUnreadOnly := chatfolderFields[4].Descriptor()
+	// chatfolder.DefaultUnreadOnly holds the default value on creation for the unread_only field.
+	chatfolder.DefaultUnreadOnly = chatfolderDescUnreadOnly.Default.(bool)
+	// chatfolderDescID is the schema descriptor for id field.
+	chatfolderDescID := chatfolderFields[0].Descriptor()
+	// chatfolder.DefaultID holds the default value on creation for the id field.
+	chatfolder.DefaultID = chatfolderDescID.Default.(func() uuid.UUID)
+	chatfolderchatMixin := schema.ChatFolderChat{}.Mixin()
+	chatfolderchatMixinFields0 := chatfolderchatMixin[0].Fields()
+	_ = chatfolderchatMixinFields0
+	chatfolderchatFields := schema.ChatFolderChat{}.Fields()
+	_ = chatfolderchatFields
+	// chatfolderchatDescCreatedAt is the schema descriptor for created_at field.
+	chatfolderchatDescCreatedAt := chatfolderchatMixinFields0[0].Descriptor()
+	// chatfolderchat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatfolderchat.DefaultCreatedAt = chatfolderchatDescCreatedAt.Default.(func() time.Time)
+	// chatfolderchatDescUpdatedAt is the schema descriptor for updated_at field.
+	chatfolderchatDescUpdatedAt := chatfolderchatMixinFields0[1].Descriptor()
+	// chatfolderchat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chatfolderchat.DefaultUpdatedAt = chatfolderchatDescUpdatedAt.Default.(func() time.Time)
+	// chatfolderchat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chatfolderchat.UpdateDefaultUpdatedAt = chatfolderchatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// chatfolderchatDescID is the schema descriptor for id field.
+	chatfolderchatDescID := chatfolderchatFields[0].Descriptor()
+	// chatfolderchat.DefaultID holds the default value on creation for the id field.
+	chatfolderchat.DefaultID = chatfolderchatDescID.Default.(func() uuid.UUID)
 	groupchatFields := schema.GroupChat{}.Fields()
 	_ = groupchatFields
 	// groupchatDescName is the schema descriptor for name field.
@@ -66,8 +176,18 @@ func init() {
 	groupchatDescIsPublic := groupchatFields[6].Descriptor()
 	// groupchat.DefaultIsPublic holds the default value on creation for the is_public field.
 	groupchat.DefaultIsPublic = groupchatDescIsPublic.Default.(bool)
+	// groupchatDescSlowModeSeconds is the schema descriptor for slow_mode_seconds field.
+	groupchatDescSlowModeSeconds := groupchatFields[7].Descriptor()
+	// groupchat.DefaultSlowModeSeconds holds the default value on creation for the slow_mode_seconds field.
+	groupchat.DefaultSlowModeSeconds = groupchatDescSlowModeSeconds.Default.(int)
+	// groupchat.SlowModeSecondsValidator is a validator for the "slow_mode_seconds" field. It is called by the builders before save.
+	groupchat.SlowModeSecondsValidator = groupchatDescSlowModeSeconds.Validators[0].(func(int) error)
+	// groupchatDescRequiresApproval is the schema descriptor for requires_approval field.
+	groupchatDescRequiresApproval := groupchatFields[8].Descriptor()
+	// groupchat.DefaultRequiresApproval holds the default value on creation for the requires_approval field.
+	groupchat.DefaultRequiresApproval = groupchatDescRequiresApproval.Default.(bool)
 	// groupchatDescInviteCode is the schema descriptor for invite_code field.
-	groupchatDescInviteCode := groupchatFields[7].Descriptor()
+	groupchatDescInviteCode := groupchatFields[9].Descriptor()
 	// groupchat.InviteCodeValidator is a validator for the "invite_code" field. It is called by the builders before save.
 	groupchat.InviteCodeValidator = func() func(string) error {
 		validators := groupchatDescInviteCode.Validators
@@ -88,6 +208,29 @@ func init() {
 	groupchatDescID := groupchatFields[0].Descriptor()
 	// groupchat.DefaultID holds the default value on creation for the id field.
 	groupchat.DefaultID = groupchatDescID.Default.(func() uuid.UUID)
+	groupjoinrequestMixin := schema.GroupJoinRequest{}.Mixin()
+	groupjoinrequestMixinFields0 := groupjoinrequestMixin[0].Fields()
+	_ = groupjoinrequestMixinFields0
+	groupjoinrequestFields := schema.GroupJoinRequest{}.Fields()
+	_ = groupjoinrequestFields
+	// groupjoinrequestDescCreatedAt is the schema descriptor for created_at field.
+	groupjoinrequestDescCreatedAt := groupjoinrequestMixinFields0[0].Descriptor()
+	// groupjoinrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	groupjoinrequest.DefaultCreatedAt = groupjoinrequestDescCreatedAt.Default.(func() time.Time)
+	// groupjoinrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	groupjoinrequestDescUpdatedAt := groupjoinrequestMixinFields0[1].Descriptor()
+	// groupjoinrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	groupjoinrequest.DefaultUpdatedAt = groupjoinrequestDescUpdatedAt.Default.(func() time.Time)
+	// groupjoinrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	groupjoinrequest.UpdateDefaultUpdatedAt = groupjoinrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupjoinrequestDescRequestedAt is the schema descriptor for requested_at field.
+	groupjoinrequestDescRequestedAt := groupjoinrequestFields[4].Descriptor()
+	// groupjoinrequest.DefaultRequestedAt holds the default value on creation for the requested_at field.
+	groupjoinrequest.DefaultRequestedAt = groupjoinrequestDescRequestedAt.Default.(func() time.Time)
+	// groupjoinrequestDescID is the schema descriptor for id field.
+	groupjoinrequestDescID := groupjoinrequestFields[0].Descriptor()
+	// groupjoinrequest.DefaultID holds the default value on creation for the id field.
+	groupjoinrequest.DefaultID = groupjoinrequestDescID.Default.(func() uuid.UUID)
 	groupmemberFields := schema.GroupMember{}.Fields()
 	_ = groupmemberFields
 	// groupmemberDescJoinedAt is the schema descriptor for joined_at field.
@@ -98,6 +241,26 @@ func init() {
 	groupmemberDescUnreadCount := groupmemberFields[6].Descriptor()
 	// groupmember.DefaultUnreadCount holds the default value on creation for the unread_count field.
 	groupmember.DefaultUnreadCount = groupmemberDescUnreadCount.Default.(int)
+	// groupmemberDescUnreadMentionCount is the schema descriptor for unread_mention_count field.
+	groupmemberDescUnreadMentionCount := groupmemberFields[7].Descriptor()
+	// groupmember.DefaultUnreadMentionCount holds the default value on creation for the unread_mention_count field.
+	groupmember.DefaultUnreadMentionCount = groupmemberDescUnreadMentionCount.Default.(int)
+	// groupmemberDescMarkedUnread is the schema descriptor for marked_unread field.
+	groupmemberDescMarkedUnread := groupmemberFields[8].Descriptor()
+	// groupmember.DefaultMarkedUnread holds the default value on creation for the marked_unread field.
+	groupmember.DefaultMarkedUnread = groupmemberDescMarkedUnread.Default.(bool)
+	// groupmemberDescKeepArchived is the schema descriptor for keep_archived field.
+	groupmemberDescKeepArchived := groupmemberFields[12].Descriptor()
+	// groupmember.DefaultKeepArchived holds the default value on creation for the keep_archived field.
+	groupmember.DefaultKeepArchived = groupmemberDescKeepArchived.Default.(bool)
+	// groupmemberDescMuted is the schema descriptor for muted field.
+	groupmemberDescMuted := groupmemberFields[13].Descriptor()
+	// groupmember.DefaultMuted holds the default value on creation for the muted field.
+	groupmember.DefaultMuted = groupmemberDescMuted.Default.(bool)
+	// groupmemberDescNotifyMentions is the schema descriptor for notify_mentions field.
+	groupmemberDescNotifyMentions := groupmemberFields[15].Descriptor()
+	// groupmember.DefaultNotifyMentions holds the default value on creation for the notify_mentions field.
+	groupmember.DefaultNotifyMentions = groupmemberDescNotifyMentions.Default.(bool)
 	// groupmemberDescID is the schema descriptor for id field.
 	groupmemberDescID := groupmemberFields[0].Descriptor()
 	// groupmember.DefaultID holds the default value on creation for the id field.
@@ -175,6 +338,10 @@ func init() {
 			return nil
 		}
 	}()
+	// mediaDescDurationMs is the schema descriptor for duration_ms field.
+	mediaDescDurationMs := mediaFields[9].Descriptor()
+	// media.DurationMsValidator is a validator for the "duration_ms" field. It is called by the builders before save.
+	media.DurationMsValidator = mediaDescDurationMs.Validators[0].(func(int) error)
 	// mediaDescID is the schema descriptor for id field.
 	mediaDescID := mediaFields[0].Descriptor()
 	// media.DefaultID holds the default value on creation for the id field.
@@ -194,22 +361,296 @@ func init() {
 	message.DefaultUpdatedAt = messageDescUpdatedAt.Default.(func() time.Time)
 	// message.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	message.UpdateDefaultUpdatedAt = messageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messageDescReplyCount is the schema descriptor for reply_count field.
+	messageDescReplyCount := messageFields[11].Descriptor()
+	// message.DefaultReplyCount holds the default value on creation for the reply_count field.
+	message.DefaultReplyCount = messageDescReplyCount.Default.(int)
+	// message.ReplyCountValidator is a validator for the "reply_count" field. It is called by the builders before save.
+	message.ReplyCountValidator = messageDescReplyCount.Validators[0].(func(int) error)
+	// messageDescClientMessageID is the schema descriptor for client_message_id field.
+	messageDescClientMessageID := messageFields[15].Descriptor()
+	// message.ClientMessageIDValidator is a validator for the "client_message_id" field. It is called by the builders before save.
+	message.ClientMessageIDValidator = messageDescClientMessageID.Validators[0].(func(string) error)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() uuid.UUID)
+	messagementionMixin := schema.MessageMention{}.Mixin()
+	messagementionMixinFields0 := messagementionMixin[0].Fields()
+	_ = messagementionMixinFields0
+	messagementionFields := schema.MessageMention{}.Fields()
+	_ = messagementionFields
+	// messagementionDescCreatedAt is the schema descriptor for created_at field.
+	messagementionDescCreatedAt := messagementionMixinFields0[0].Descriptor()
+	// messagemention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagemention.DefaultCreatedAt = messagementionDescCreatedAt.Default.(func() time.Time)
+	// messagementionDescUpdatedAt is the schema descriptor for updated_at field.
+	messagementionDescUpdatedAt := messagementionMixinFields0[1].Descriptor()
+	// messagemention.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagemention.DefaultUpdatedAt = messagementionDescUpdatedAt.Default.(func() time.Time)
+	// messagemention.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagemention.UpdateDefaultUpdatedAt = messagementionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagementionDescOffset is the schema descriptor for offset field.
+	messagementionDescOffset := messagementionFields[4].Descriptor()
+	// messagemention.OffsetValidator is a validator for the "offset" field. It is called by the builders before save.
+	messagemention.OffsetValidator = messagementionDescOffset.Validators[0].(func(int) error)
+	// messagementionDescLength is the schema descriptor for length field.
+	messagementionDescLength := messagementionFields[5].Descriptor()
+	// messagemention.LengthValidator is a validator for the "length" field. It is called by the builders before save.
+	messagemention.LengthValidator = messagementionDescLength.Validators[0].(func(int) error)
+	// messagementionDescID is the schema descriptor for id field.
+	messagementionDescID := messagementionFields[0].Descriptor()
+	// messagemention.DefaultID holds the default value on creation for the id field.
+	messagemention.DefaultID = messagementionDescID.Default.(func() uuid.UUID)
+	messagereactionMixin := schema.MessageReaction{}.Mixin()
+	messagereactionMixinFields0 := messagereactionMixin[0].Fields()
+	_ = messagereactionMixinFields0
+	messagereactionFields := schema.MessageReaction{}.Fields()
+	_ = messagereactionFields
+	// messagereactionDescCreatedAt is the schema descriptor for created_at field.
+	messagereactionDescCreatedAt := messagereactionMixinFields0[0].Descriptor()
+	// messagereaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagereaction.DefaultCreatedAt = messagereactionDescCreatedAt.Default.(func() time.Time)
+	// messagereactionDescUpdatedAt is the schema descriptor for updated_at field.
+	messagereactionDescUpdatedAt := messagereactionMixinFields0[1].Descriptor()
+	// messagereaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagereaction.DefaultUpdatedAt = messagereactionDescUpdatedAt.Default.(func() time.Time)
+	// messagereaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagereaction.UpdateDefaultUpdatedAt = messagereactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagereactionDescEmoji is the schema descriptor for emoji field.
+	messagereactionDescEmoji := messagereactionFields[3].Descriptor()
+	// messagereaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	messagereaction.EmojiValidator = func() func(string) error {
+		validators := messagereactionDescEmoji.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(emoji string) error {
+			for _, fn := range fns {
+				if err := fn(emoji); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// messagereactionDescID is the schema descriptor for id field.
+	messagereactionDescID := messagereactionFields[0].Descriptor()
+	// messagereaction.DefaultID holds the default value on creation for the id field.
+	messagereaction.DefaultID = messagereactionDescID.Default.(func() uuid.UUID)
+	messagerevisionMixin := schema.MessageRevision{}.Mixin()
+	messagerevisionMixinFields0 := messagerevisionMixin[0].Fields()
+	_ = messagerevisionMixinFields0
+	messagerevisionFields := schema.MessageRevision{}.Fields()
+	_ = messagerevisionFields
+	// messagerevisionDescCreatedAt is the schema descriptor for created_at field.
+	messagerevisionDescCreatedAt := messagerevisionMixinFields0[0].Descriptor()
+	// messagerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagerevision.DefaultCreatedAt = messagerevisionDescCreatedAt.Default.(func() time.Time)
+	// messagerevisionDescUpdatedAt is the schema descriptor for updated_at field.
+	messagerevisionDescUpdatedAt := messagerevisionMixinFields0[1].Descriptor()
+	// messagerevision.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	messagerevision.DefaultUpdatedAt = messagerevisionDescUpdatedAt.Default.(func() time.Time)
+	// messagerevision.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	messagerevision.UpdateDefaultUpdatedAt = messagerevisionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// messagerevisionDescID is the schema descriptor for id field.
+	messagerevisionDescID := messagerevisionFields[0].Descriptor()
+	// messagerevision.DefaultID holds the default value on creation for the id field.
+	messagerevision.DefaultID = messagerevisionDescID.Default.(func() uuid.UUID)
+	pinnedchatMixin := schema.PinnedChat{}.Mixin()
+	pinnedchatMixinFields0 := pinnedchatMixin[0].Fields()
+	_ = pinnedchatMixinFields0
+	pinnedchatFields := schema.PinnedChat{}.Fields()
+	_ = pinnedchatFields
+	// pinnedchatDescCreatedAt is the schema descriptor for created_at field.
+	pinnedchatDescCreatedAt := pinnedchatMixinFields0[0].Descriptor()
+	// pinnedchat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pinnedchat.DefaultCreatedAt = pinnedchatDescCreatedAt.Default.(func() time.Time)
+	// pinnedchatDescUpdatedAt is the schema descriptor for updated_at field.
+	pinnedchatDescUpdatedAt := pinnedchatMixinFields0[1].Descriptor()
+	// pinnedchat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pinnedchat.DefaultUpdatedAt = pinnedchatDescUpdatedAt.Default.(func() time.Time)
+	// pinnedchat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pinnedchat.UpdateDefaultUpdatedAt = pinnedchatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pinnedchatDescPosition is the schema descriptor for position field.
+	pinnedchatDescPosition := pinnedchatFields[3].Descriptor()
+	// pinnedchat.DefaultPosition holds the default value on creation for the position field.
+	pinnedchat.DefaultPosition = pinnedchatDescPosition.Default.(int)
+	// pinnedchat.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	pinnedchat.PositionValidator = pinnedchatDescPosition.Validators[0].(func(int) error)
+	// pinnedchatDescID is the schema descriptor for id field.
+	pinnedchatDescID := pinnedchatFields[0].Descriptor()
+	// pinnedchat.DefaultID holds the default value on creation for the id field.
+	pinnedchat.DefaultID = pinnedchatDescID.Default.(func() uuid.UUID)
+	pinnedmessageMixin := schema.PinnedMessage{}.Mixin()
+	pinnedmessageMixinFields0 := pinnedmessageMixin[0].Fields()
+	_ = pinnedmessageMixinFields0
+	pinnedmessageFields := schema.PinnedMessage{}.Fields()
+	_ = pinnedmessageFields
+	// pinnedmessageDescCreatedAt is the schema descriptor for created_at field.
+	pinnedmessageDescCreatedAt := pinnedmessageMixinFields0[0].Descriptor()
+	// pinnedmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pinnedmessage.DefaultCreatedAt = pinnedmessageDescCreatedAt.Default.(func() time.Time)
+	// pinnedmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	pinnedmessageDescUpdatedAt := pinnedmessageMixinFields0[1].Descriptor()
+	// pinnedmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pinnedmessage.DefaultUpdatedAt = pinnedmessageDescUpdatedAt.Default.(func() time.Time)
+	// pinnedmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pinnedmessage.UpdateDefaultUpdatedAt = pinnedmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pinnedmessageDescID is the schema descriptor for id field.
+	pinnedmessageDescID := pinnedmessageFields[0].Descriptor()
+	// pinnedmessage.DefaultID holds the default value on creation for the id field.
+	pinnedmessage.DefaultID = pinnedmessageDescID.Default.(func() uuid.UUID)
+	pollMixin := schema.Poll{}.Mixin()
+	pollMixinFields0 := pollMixin[0].Fields()
+	_ = pollMixinFields0
+	pollFields := schema.Poll{}.Fields()
+	_ = pollFields
+	// pollDescCreatedAt is the schema descriptor for created_at field.
+	pollDescCreatedAt := pollMixinFields0[0].Descriptor()
+	// poll.DefaultCreatedAt holds the default value on creation for the created_at field.
+	poll.DefaultCreatedAt = pollDescCreatedAt.Default.(func() time.Time)
+	// pollDescUpdatedAt is the schema descriptor for updated_at field.
+	pollDescUpdatedAt := pollMixinFields0[1].Descriptor()
+	// poll.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	poll.DefaultUpdatedAt = pollDescUpdatedAt.Default.(func() time.Time)
+	// poll.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	poll.UpdateDefaultUpdatedAt = pollDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pollDescQuestion is the schema descriptor for question field.
+	pollDescQuestion := pollFields[2].Descriptor()
+	// poll.QuestionValidator is a validator for the "question" field. It is called by the builders before save.
+	poll.QuestionValidator = func() func(string) error {
+		validators := pollDescQuestion.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(question string) error {
+			for _, fn := range fns {
+				if err := fn(question); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// pollDescMultipleChoice is the schema descriptor for multiple_choice field.
+	pollDescMultipleChoice := pollFields[3].Descriptor()
+	// poll.DefaultMultipleChoice holds the default value on creation for the multiple_choice field.
+	poll.DefaultMultipleChoice = pollDescMultipleChoice.Default.(bool)
+	// pollDescAnonymous is the schema descriptor for anonymous field.
+	pollDescAnonymous := pollFields[4].Descriptor()
+	// poll.DefaultAnonymous holds the default value on creation for the anonymous field.
+	poll.DefaultAnonymous = pollDescAnonymous.Default.(bool)
+	// pollDescQuiz is the schema descriptor for quiz field.
+	pollDescQuiz := pollFields[5].Descriptor()
+	// poll.DefaultQuiz holds the default value on creation for the quiz field.
+	poll.DefaultQuiz = pollDescQuiz.Default.(bool)
+	// pollDescID is the schema descriptor for id field.
+	pollDescID := pollFields[0].Descriptor()
+	// poll.DefaultID holds the default value on creation for the id field.
+	poll.DefaultID = pollDescID.Default.(func() uuid.UUID)
+	polloptionMixin := schema.PollOption{}.Mixin()
+	polloptionMixinFields0 := polloptionMixin[0].Fields()
+	_ = polloptionMixinFields0
+	polloptionFields := schema.PollOption{}.Fields()
+	_ = polloptionFields
+	// polloptionDescCreatedAt is the schema descriptor for created_at field.
+	polloptionDescCreatedAt := polloptionMixinFields0[0].Descriptor()
+	// polloption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	polloption.DefaultCreatedAt = polloptionDescCreatedAt.Default.(func() time.Time)
+	// polloptionDescUpdatedAt is the schema descriptor for updated_at field.
+	polloptionDescUpdatedAt := polloptionMixinFields0[1].Descriptor()
+	// polloption.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	polloption.DefaultUpdatedAt = polloptionDescUpdatedAt.Default.(func() time.Time)
+	// polloption.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	polloption.UpdateDefaultUpdatedAt = polloptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// polloptionDescText is the schema descriptor for text field.
+	polloptionDescText := polloptionFields[2].Descriptor()
+	// polloption.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	polloption.TextValidator = func() func(string) error {
+		validators := polloptionDescText.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(text string) error {
+			for _, fn := range fns {
+				if err := fn(text); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// polloptionDescPosition is the schema descriptor for position field.
+	polloptionDescPosition := polloptionFields[3].Descriptor()
+	// polloption.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	polloption.PositionValidator = polloptionDescPosition.Validators[0].(func(int) error)
+	// polloptionDescIsCorrect is the schema descriptor for is_correct field.
+	polloptionDescIsCorrect := polloptionFields[4].Descriptor()
+	// polloption.DefaultIsCorrect holds the default value on creation for the is_correct field.
+	polloption.DefaultIsCorrect = polloptionDescIsCorrect.Default.(bool)
+	// polloptionDescID is the schema descriptor for id field.
+	polloptionDescID := polloptionFields[0].Descriptor()
+	// polloption.DefaultID holds the default value on creation for the id field.
+	polloption.DefaultID = polloptionDescID.Default.(func() uuid.UUID)
+	pollvoteMixin := schema.PollVote{}.Mixin()
+	pollvoteMixinFields0 := pollvoteMixin[0].Fields()
+	_ = pollvoteMixinFields0
+	pollvoteFields := schema.PollVote{}.Fields()
+	_ = pollvoteFields
+	// pollvoteDescCreatedAt is the schema descriptor for created_at field.
+	pollvoteDescCreatedAt := pollvoteMixinFields0[0].Descriptor()
+	// pollvote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollvote.DefaultCreatedAt = pollvoteDescCreatedAt.Default.(func() time.Time)
+	// pollvoteDescUpdatedAt is the schema descriptor for updated_at field.
+	pollvoteDescUpdatedAt := pollvoteMixinFields0[1].Descriptor()
+	// pollvote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pollvote.DefaultUpdatedAt = pollvoteDescUpdatedAt.Default.(func() time.Time)
+	// pollvote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pollvote.UpdateDefaultUpdatedAt = pollvoteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pollvoteDescID is the schema descriptor for id field.
+	pollvoteDescID := pollvoteFields[0].Descriptor()
+	// pollvote.DefaultID holds the default value on creation for the id field.
+	pollvote.DefaultID = pollvoteDescID.Default.(func() uuid.UUID)
 	privatechatHooks := schema.PrivateChat{}.Hooks()
 	privatechat.Hooks[0] = privatechatHooks[0]
 	privatechatFields := schema.PrivateChat{}.Fields()
 	_ = privatechatFields
+	// privatechatDescUser1KeepArchived is the schema descriptor for user1_keep_archived field.
+	privatechatDescUser1KeepArchived := privatechatFields[10].Descriptor()
+	// privatechat.DefaultUser1KeepArchived holds the default value on creation for the user1_keep_archived field.
+	privatechat.DefaultUser1KeepArchived = privatechatDescUser1KeepArchived.Default.(bool)
+	// privatechatDescUser2KeepArchived is the schema descriptor for user2_keep_archived field.
+	privatechatDescUser2KeepArchived := privatechatFields[11].Descriptor()
+	// privatechat.DefaultUser2KeepArchived holds the default value on creation for the user2_keep_archived field.
+	privatechat.DefaultUser2KeepArchived = privatechatDescUser2KeepArchived.Default.(bool)
+	// privatechatDescUser1Muted is the schema descriptor for user1_muted field.
+	privatechatDescUser1Muted := privatechatFields[12].Descriptor()
+	// privatechat.DefaultUser1Muted holds the default value on creation for the user1_muted field.
+	privatechat.DefaultUser1Muted = privatechatDescUser1Muted.Default.(bool)
+	// privatechatDescUser2Muted is the schema descriptor for user2_muted field.
+	privatechatDescUser2Muted := privatechatFields[13].Descriptor()
+	// privatechat.DefaultUser2Muted holds the default value on creation for the user2_muted field.
+	privatechat.DefaultUser2Muted = privatechatDescUser2Muted.Default.(bool)
 	// privatechatDescUser1UnreadCount is the schema descriptor for user1_unread_count field.
-	privatechatDescUser1UnreadCount := privatechatFields[8].Descriptor()
+	privatechatDescUser1UnreadCount := privatechatFields[16].Descriptor()
 	// privatechat.DefaultUser1UnreadCount holds the default value on creation for the user1_unread_count field.
 	privatechat.DefaultUser1UnreadCount = privatechatDescUser1UnreadCount.Default.(int)
 	// privatechatDescUser2UnreadCount is the schema descriptor for user2_unread_count field.
-	privatechatDescUser2UnreadCount := privatechatFields[9].Descriptor()
+	privatechatDescUser2UnreadCount := privatechatFields[17].Descriptor()
 	// privatechat.DefaultUser2UnreadCount holds the default value on creation for the user2_unread_count field.
 	privatechat.DefaultUser2UnreadCount = privatechatDescUser2UnreadCount.Default.(int)
+	// privatechatDescUser1MarkedUnread is the schema descriptor for user1_marked_unread field.
+	privatechatDescUser1MarkedUnread := privatechatFields[18].Descriptor()
+	// privatechat.DefaultUser1MarkedUnread holds the default value on creation for the user1_marked_unread field.
+	privatechat.DefaultUser1MarkedUnread = privatechatDescUser1MarkedUnread.Default.(bool)
+	// privatechatDescUser2MarkedUnread is the schema descriptor for user2_marked_unread field.
+	privatechatDescUser2MarkedUnread := privatechatFields[19].Descriptor()
+	// privatechat.DefaultUser2MarkedUnread holds the default value on creation for the user2_marked_unread field.
+	privatechat.DefaultUser2MarkedUnread = privatechatDescUser2MarkedUnread.Default.(bool)
 	// privatechatDescID is the schema descriptor for id field.
 	privatechatDescID := privatechatFields[0].Descriptor()
 	// privatechat.DefaultID holds the default value on creation for the id field.
@@ -234,6 +675,29 @@ func init() {
 	reportDescID := reportFields[0].Descriptor()
 	// report.DefaultID holds the default value on creation for the id field.
 	report.DefaultID = reportDescID.Default.(func() uuid.UUID)
+	scheduledmessageMixin := schema.ScheduledMessage{}.Mixin()
+	scheduledmessageMixinFields0 := scheduledmessageMixin[0].Fields()
+	_ = scheduledmessageMixinFields0
+	scheduledmessageFields := schema.ScheduledMessage{}.Fields()
+	_ = scheduledmessageFields
+	// scheduledmessageDescCreatedAt is the schema descriptor for created_at field.
+	scheduledmessageDescCreatedAt := scheduledmessageMixinFields0[0].Descriptor()
+	// scheduledmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledmessage.DefaultCreatedAt = scheduledmessageDescCreatedAt.Default.(func() time.Time)
+	// scheduledmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledmessageDescUpdatedAt := scheduledmessageMixinFields0[1].Descriptor()
+	// scheduledmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledmessage.DefaultUpdatedAt = scheduledmessageDescUpdatedAt.Default.(func() time.Time)
+	// scheduledmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledmessage.UpdateDefaultUpdatedAt = scheduledmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledmessageDescFailureReason is the schema descriptor for failure_reason field.
+	scheduledmessageDescFailureReason := scheduledmessageFields[7].Descriptor()
+	// scheduledmessage.FailureReasonValidator is a validator for the "failure_reason" field. It is called by the builders before save.
+	scheduledmessage.FailureReasonValidator = scheduledmessageDescFailureReason.Validators[0].(func(string) error)
+	// scheduledmessageDescID is the schema descriptor for id field.
+	scheduledmessageDescID := scheduledmessageFields[0].Descriptor()
+	// scheduledmessage.DefaultID holds the default value on creation for the id field.
+	scheduledmessage.DefaultID = scheduledmessageDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
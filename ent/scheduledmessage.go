@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ScheduledMessage is the model entity for the ScheduledMessage schema.
+type ScheduledMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID uuid.UUID `json:"chat_id,omitempty"`
+	// SenderID holds the value of the "sender_id" field.
+	SenderID uuid.UUID `json:"sender_id,omitempty"`
+	// ReplyToID holds the value of the "reply_to_id" field.
+	ReplyToID *uuid.UUID `json:"reply_to_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content *string `json:"content,omitempty"`
+	// SendAt holds the value of the "send_at" field.
+	SendAt time.Time `json:"send_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status scheduledmessage.Status `json:"status,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason *string `json:"failure_reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduledMessageQuery when eager-loading is set.
+	Edges        ScheduledMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduledMessageEdges holds the relations/edges for other nodes in the graph.
+type ScheduledMessageEdges struct {
+	// Chat holds the value of the chat edge.
+	Chat *Chat `json:"chat,omitempty"`
+	// Sender holds the value of the sender edge.
+	Sender *User `json:"sender,omitempty"`
+	// ReplyTo holds the value of the reply_to edge.
+	ReplyTo *Message `json:"reply_to,omitempty"`
+	// Attachments holds the value of the attachments edge.
+	Attachments []*Media `json:"attachments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ChatOrErr returns the Chat value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledMessageEdges) ChatOrErr() (*Chat, error) {
+	if e.Chat != nil {
+		return e.Chat, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: chat.Label}
+	}
+	return nil, &NotLoadedError{edge: "chat"}
+}
+
+// SenderOrErr returns the Sender value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledMessageEdges) SenderOrErr() (*User, error) {
+	if e.Sender != nil {
+		return e.Sender, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "sender"}
+}
+
+// ReplyToOrErr returns the ReplyTo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduledMessageEdges) ReplyToOrErr() (*Message, error) {
+	if e.ReplyTo != nil {
+		return e.ReplyTo, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: message.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScheduledMessageEdges) AttachmentsOrErr() ([]*Media, error) {
+	if e.loadedTypes[3] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldReplyToID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case scheduledmessage.FieldContent, scheduledmessage.FieldStatus, scheduledmessage.FieldFailureReason:
+			values[i] = new(sql.NullString)
+		case scheduledmessage.FieldCreatedAt, scheduledmessage.FieldUpdatedAt, scheduledmessage.FieldSendAt:
+			values[i] = new(sql.NullTime)
+		case scheduledmessage.FieldID, scheduledmessage.FieldChatID, scheduledmessage.FieldSenderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledMessage fields.
+func (_m *ScheduledMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case scheduledmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case scheduledmessage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case scheduledmessage.FieldChatID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				_m.ChatID = *value
+			}
+		case scheduledmessage.FieldSenderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_id", values[i])
+			} else if value != nil {
+				_m.SenderID = *value
+			}
+		case scheduledmessage.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				_m.ReplyToID = new(uuid.UUID)
+				*_m.ReplyToID = *value.S.(*uuid.UUID)
+			}
+		case scheduledmessage.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = new(string)
+				*_m.Content = value.String
+			}
+		case scheduledmessage.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				_m.SendAt = value.Time
+			}
+		case scheduledmessage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = scheduledmessage.Status(value.String)
+			}
+		case scheduledmessage.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				_m.FailureReason = new(string)
+				*_m.FailureReason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduledMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *ScheduledMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryChat queries the "chat" edge of the ScheduledMessage entity.
+func (_m *ScheduledMessage) QueryChat() *ChatQuery {
+	return NewScheduledMessageClient(_m.config).QueryChat(_m)
+}
+
+// QuerySender queries the "sender" edge of the ScheduledMessage entity.
+func (_m *ScheduledMessage) QuerySender() *UserQuery {
+	return NewScheduledMessageClient(_m.config).QuerySender(_m)
+}
+
+// QueryReplyTo queries the "reply_to" edge of the ScheduledMessage entity.
+func (_m *ScheduledMessage) QueryReplyTo() *MessageQuery {
+	return NewScheduledMessageClient(_m.config).QueryReplyTo(_m)
+}
+
+// QueryAttachments queries the "attachments" edge of the ScheduledMessage entity.
+func (_m *ScheduledMessage) QueryAttachments() *MediaQuery {
+	return NewScheduledMessageClient(_m.config).QueryAttachments(_m)
+}
+
+// Update returns a builder for updating this ScheduledMessage.
+// Note that you need to call ScheduledMessage.Unwrap() before calling this method if this ScheduledMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ScheduledMessage) Update() *ScheduledMessageUpdateOne {
+	return NewScheduledMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ScheduledMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ScheduledMessage) Unwrap() *ScheduledMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduledMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ScheduledMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("sender_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SenderID))
+	builder.WriteString(", ")
+	if v := _m.ReplyToID; v != nil {
+		builder.WriteString("reply_to_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Content; v != nil {
+		builder.WriteString("content=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("send_at=")
+	builder.WriteString(_m.SendAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.FailureReason; v != nil {
+		builder.WriteString("failure_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledMessages is a parsable slice of ScheduledMessage.
+type ScheduledMessages []*ScheduledMessage
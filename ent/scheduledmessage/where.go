@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"AtoiTalkAPI/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// SenderID applies equality check predicate on the "sender_id" field. It's identical to SenderIDEQ.
+func SenderID(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSenderID, v))
+}
+
+// ReplyToID applies equality check predicate on the "reply_to_id" field. It's identical to ReplyToIDEQ.
+func ReplyToID(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldReplyToID, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// SenderIDEQ applies the EQ predicate on the "sender_id" field.
+func SenderIDEQ(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSenderID, v))
+}
+
+// SenderIDNEQ applies the NEQ predicate on the "sender_id" field.
+func SenderIDNEQ(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSenderID, v))
+}
+
+// SenderIDIn applies the In predicate on the "sender_id" field.
+func SenderIDIn(vs ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSenderID, vs...))
+}
+
+// SenderIDNotIn applies the NotIn predicate on the "sender_id" field.
+func SenderIDNotIn(vs ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSenderID, vs...))
+}
+
+// ReplyToIDEQ applies the EQ predicate on the "reply_to_id" field.
+func ReplyToIDEQ(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldReplyToID, v))
+}
+
+// ReplyToIDNEQ applies the NEQ predicate on the "reply_to_id" field.
+func ReplyToIDNEQ(v uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldReplyToID, v))
+}
+
+// ReplyToIDIn applies the In predicate on the "reply_to_id" field.
+func ReplyToIDIn(vs ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldReplyToID, vs...))
+}
+
+// ReplyToIDNotIn applies the NotIn predicate on the "reply_to_id" field.
+func ReplyToIDNotIn(vs ...uuid.UUID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldReplyToID, vs...))
+}
+
+// ReplyToIDIsNil applies the IsNil predicate on the "reply_to_id" field.
+func ReplyToIDIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldReplyToID))
+}
+
+// ReplyToIDNotNil applies the NotNil predicate on the "reply_to_id" field.
+func ReplyToIDNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldReplyToID))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldContent, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldSendAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// HasChat applies the HasEdge predicate on the "chat" edge.
+func HasChat() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChatTable, ChatColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatWith applies the HasEdge predicate on the "chat" edge with a given conditions (other predicates).
+func HasChatWith(preds ...predicate.Chat) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newChatStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSender applies the HasEdge predicate on the "sender" edge.
+func HasSender() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SenderTable, SenderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSenderWith applies the HasEdge predicate on the "sender" edge with a given conditions (other predicates).
+func HasSenderWith(preds ...predicate.User) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newSenderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplyTo applies the HasEdge predicate on the "reply_to" edge.
+func HasReplyTo() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ReplyToTable, ReplyToColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplyToWith applies the HasEdge predicate on the "reply_to" edge with a given conditions (other predicates).
+func HasReplyToWith(preds ...predicate.Message) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newReplyToStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Media) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,1115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScheduledMessageCreate is the builder for creating a ScheduledMessage entity.
+type ScheduledMessageCreate struct {
+	config
+	mutation *ScheduledMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ScheduledMessageCreate) SetCreatedAt(v time.Time) *ScheduledMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableCreatedAt(v *time.Time) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ScheduledMessageCreate) SetUpdatedAt(v time.Time) *ScheduledMessageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableUpdatedAt(v *time.Time) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *ScheduledMessageCreate) SetChatID(v uuid.UUID) *ScheduledMessageCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_c *ScheduledMessageCreate) SetSenderID(v uuid.UUID) *ScheduledMessageCreate {
+	_c.mutation.SetSenderID(v)
+	return _c
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_c *ScheduledMessageCreate) SetReplyToID(v uuid.UUID) *ScheduledMessageCreate {
+	_c.mutation.SetReplyToID(v)
+	return _c
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableReplyToID(v *uuid.UUID) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetReplyToID(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *ScheduledMessageCreate) SetContent(v string) *ScheduledMessageCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableContent(v *string) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetContent(*v)
+	}
+	return _c
+}
+
+// SetSendAt sets the "send_at" field.
+func (_c *ScheduledMessageCreate) SetSendAt(v time.Time) *ScheduledMessageCreate {
+	_c.mutation.SetSendAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ScheduledMessageCreate) SetStatus(v scheduledmessage.Status) *ScheduledMessageCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableStatus(v *scheduledmessage.Status) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_c *ScheduledMessageCreate) SetFailureReason(v string) *ScheduledMessageCreate {
+	_c.mutation.SetFailureReason(v)
+	return _c
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableFailureReason(v *string) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetFailureReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ScheduledMessageCreate) SetID(v uuid.UUID) *ScheduledMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ScheduledMessageCreate) SetNillableID(v *uuid.UUID) *ScheduledMessageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_c *ScheduledMessageCreate) SetChat(v *Chat) *ScheduledMessageCreate {
+	return _c.SetChatID(v.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (_c *ScheduledMessageCreate) SetSender(v *User) *ScheduledMessageCreate {
+	return _c.SetSenderID(v.ID)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (_c *ScheduledMessageCreate) SetReplyTo(v *Message) *ScheduledMessageCreate {
+	return _c.SetReplyToID(v.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by IDs.
+func (_c *ScheduledMessageCreate) AddAttachmentIDs(ids ...uuid.UUID) *ScheduledMessageCreate {
+	_c.mutation.AddAttachmentIDs(ids...)
+	return _c
+}
+
+// AddAttachments adds the "attachments" edges to the Media entity.
+func (_c *ScheduledMessageCreate) AddAttachments(v ...*Media) *ScheduledMessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (_c *ScheduledMessageCreate) Mutation() *ScheduledMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the ScheduledMessage in the database.
+func (_c *ScheduledMessageCreate) Save(ctx context.Context) (*ScheduledMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ScheduledMessageCreate) SaveX(ctx context.Context) *ScheduledMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ScheduledMessageCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := scheduledmessage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := scheduledmessage.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := scheduledmessage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ScheduledMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduledMessage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ScheduledMessage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ScheduledMessage.chat_id"`)}
+	}
+	if _, ok := _c.mutation.SenderID(); !ok {
+		return &ValidationError{Name: "sender_id", err: errors.New(`ent: missing required field "ScheduledMessage.sender_id"`)}
+	}
+	if _, ok := _c.mutation.SendAt(); !ok {
+		return &ValidationError{Name: "send_at", err: errors.New(`ent: missing required field "ScheduledMessage.send_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduledMessage.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FailureReason(); ok {
+		if err := scheduledmessage.FailureReasonValidator(v); err != nil {
+			return &ValidationError{Name: "failure_reason", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.failure_reason": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ChatIDs()) == 0 {
+		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "ScheduledMessage.chat"`)}
+	}
+	if len(_c.mutation.SenderIDs()) == 0 {
+		return &ValidationError{Name: "sender", err: errors.New(`ent: missing required edge "ScheduledMessage.sender"`)}
+	}
+	return nil
+}
+
+func (_c *ScheduledMessageCreate) sqlSave(ctx context.Context) (*ScheduledMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ScheduledMessageCreate) createSpec() (*ScheduledMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledmessage.Table, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+		_node.Content = &value
+	}
+	if value, ok := _c.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.FailureReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = &value
+	}
+	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.ChatTable,
+			Columns: []string{scheduledmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChatID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SenderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ReplyToTable,
+			Columns: []string{scheduledmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledMessage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScheduledMessageCreate) OnConflict(opts ...sql.ConflictOption) *ScheduledMessageUpsertOne {
+	_c.conflict = opts
+	return &ScheduledMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScheduledMessageCreate) OnConflictColumns(columns ...string) *ScheduledMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ScheduledMessageUpsertOne is the builder for "upsert"-ing
+	//  one ScheduledMessage node.
+	ScheduledMessageUpsertOne struct {
+		create *ScheduledMessageCreate
+	}
+
+	// ScheduledMessageUpsert is the "OnConflict" setter.
+	ScheduledMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledMessageUpsert) SetUpdatedAt(v time.Time) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateUpdatedAt() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldUpdatedAt)
+	return u
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ScheduledMessageUpsert) SetChatID(v uuid.UUID) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldChatID, v)
+	return u
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateChatID() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldChatID)
+	return u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *ScheduledMessageUpsert) SetSenderID(v uuid.UUID) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldSenderID, v)
+	return u
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateSenderID() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldSenderID)
+	return u
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (u *ScheduledMessageUpsert) SetReplyToID(v uuid.UUID) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldReplyToID, v)
+	return u
+}
+
+// UpdateReplyToID sets the "reply_to_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateReplyToID() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldReplyToID)
+	return u
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (u *ScheduledMessageUpsert) ClearReplyToID() *ScheduledMessageUpsert {
+	u.SetNull(scheduledmessage.FieldReplyToID)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *ScheduledMessageUpsert) SetContent(v string) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateContent() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ScheduledMessageUpsert) ClearContent() *ScheduledMessageUpsert {
+	u.SetNull(scheduledmessage.FieldContent)
+	return u
+}
+
+// SetSendAt sets the "send_at" field.
+func (u *ScheduledMessageUpsert) SetSendAt(v time.Time) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldSendAt, v)
+	return u
+}
+
+// UpdateSendAt sets the "send_at" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateSendAt() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldSendAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledMessageUpsert) SetStatus(v scheduledmessage.Status) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateStatus() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldStatus)
+	return u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *ScheduledMessageUpsert) SetFailureReason(v string) *ScheduledMessageUpsert {
+	u.Set(scheduledmessage.FieldFailureReason, v)
+	return u
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *ScheduledMessageUpsert) UpdateFailureReason() *ScheduledMessageUpsert {
+	u.SetExcluded(scheduledmessage.FieldFailureReason)
+	return u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *ScheduledMessageUpsert) ClearFailureReason() *ScheduledMessageUpsert {
+	u.SetNull(scheduledmessage.FieldFailureReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledMessageUpsertOne) UpdateNewValues() *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scheduledmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scheduledmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduledMessageUpsertOne) Ignore() *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledMessageUpsertOne) DoNothing() *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledMessageCreate.OnConflict
+// documentation for more info.
+func (u *ScheduledMessageUpsertOne) Update(set func(*ScheduledMessageUpsert)) *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledMessageUpsertOne) SetUpdatedAt(v time.Time) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateUpdatedAt() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ScheduledMessageUpsertOne) SetChatID(v uuid.UUID) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateChatID() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *ScheduledMessageUpsertOne) SetSenderID(v uuid.UUID) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetSenderID(v)
+	})
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateSenderID() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateSenderID()
+	})
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (u *ScheduledMessageUpsertOne) SetReplyToID(v uuid.UUID) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetReplyToID(v)
+	})
+}
+
+// UpdateReplyToID sets the "reply_to_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateReplyToID() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateReplyToID()
+	})
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (u *ScheduledMessageUpsertOne) ClearReplyToID() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.ClearReplyToID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ScheduledMessageUpsertOne) SetContent(v string) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateContent() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ScheduledMessageUpsertOne) ClearContent() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetSendAt sets the "send_at" field.
+func (u *ScheduledMessageUpsertOne) SetSendAt(v time.Time) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetSendAt(v)
+	})
+}
+
+// UpdateSendAt sets the "send_at" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateSendAt() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateSendAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledMessageUpsertOne) SetStatus(v scheduledmessage.Status) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateStatus() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *ScheduledMessageUpsertOne) SetFailureReason(v string) *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertOne) UpdateFailureReason() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *ScheduledMessageUpsertOne) ClearFailureReason() *ScheduledMessageUpsertOne {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduledMessageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ScheduledMessageUpsertOne.ID is not supported by MySQL driver. Use ScheduledMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduledMessageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduledMessageCreateBulk is the builder for creating many ScheduledMessage entities in bulk.
+type ScheduledMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduledMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduledMessage entities in the database.
+func (_c *ScheduledMessageCreateBulk) Save(ctx context.Context) ([]*ScheduledMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ScheduledMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ScheduledMessageCreateBulk) SaveX(ctx context.Context) []*ScheduledMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ScheduledMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ScheduledMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ScheduledMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduledMessageUpsertBulk {
+	_c.conflict = opts
+	return &ScheduledMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ScheduledMessageCreateBulk) OnConflictColumns(columns ...string) *ScheduledMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// ScheduledMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduledMessage nodes.
+type ScheduledMessageUpsertBulk struct {
+	create *ScheduledMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledMessageUpsertBulk) UpdateNewValues() *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scheduledmessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scheduledmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduledMessageUpsertBulk) Ignore() *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledMessageUpsertBulk) DoNothing() *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduledMessageUpsertBulk) Update(set func(*ScheduledMessageUpsert)) *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduledMessageUpsertBulk) SetUpdatedAt(v time.Time) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateUpdatedAt() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetChatID sets the "chat_id" field.
+func (u *ScheduledMessageUpsertBulk) SetChatID(v uuid.UUID) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetChatID(v)
+	})
+}
+
+// UpdateChatID sets the "chat_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateChatID() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateChatID()
+	})
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *ScheduledMessageUpsertBulk) SetSenderID(v uuid.UUID) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetSenderID(v)
+	})
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateSenderID() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateSenderID()
+	})
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (u *ScheduledMessageUpsertBulk) SetReplyToID(v uuid.UUID) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetReplyToID(v)
+	})
+}
+
+// UpdateReplyToID sets the "reply_to_id" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateReplyToID() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateReplyToID()
+	})
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (u *ScheduledMessageUpsertBulk) ClearReplyToID() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.ClearReplyToID()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *ScheduledMessageUpsertBulk) SetContent(v string) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateContent() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *ScheduledMessageUpsertBulk) ClearContent() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetSendAt sets the "send_at" field.
+func (u *ScheduledMessageUpsertBulk) SetSendAt(v time.Time) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetSendAt(v)
+	})
+}
+
+// UpdateSendAt sets the "send_at" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateSendAt() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateSendAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduledMessageUpsertBulk) SetStatus(v scheduledmessage.Status) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateStatus() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (u *ScheduledMessageUpsertBulk) SetFailureReason(v string) *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.SetFailureReason(v)
+	})
+}
+
+// UpdateFailureReason sets the "failure_reason" field to the value that was provided on create.
+func (u *ScheduledMessageUpsertBulk) UpdateFailureReason() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.UpdateFailureReason()
+	})
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (u *ScheduledMessageUpsertBulk) ClearFailureReason() *ScheduledMessageUpsertBulk {
+	return u.Update(func(s *ScheduledMessageUpsert) {
+		s.ClearFailureReason()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduledMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduledMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduledMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,951 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScheduledMessageUpdate is the builder for updating ScheduledMessage entities.
+type ScheduledMessageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduledMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (_u *ScheduledMessageUpdate) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledMessageUpdate) SetUpdatedAt(v time.Time) *ScheduledMessageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ScheduledMessageUpdate) SetChatID(v uuid.UUID) *ScheduledMessageUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableChatID(v *uuid.UUID) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *ScheduledMessageUpdate) SetSenderID(v uuid.UUID) *ScheduledMessageUpdate {
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableSenderID(v *uuid.UUID) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_u *ScheduledMessageUpdate) SetReplyToID(v uuid.UUID) *ScheduledMessageUpdate {
+	_u.mutation.SetReplyToID(v)
+	return _u
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableReplyToID(v *uuid.UUID) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetReplyToID(*v)
+	}
+	return _u
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (_u *ScheduledMessageUpdate) ClearReplyToID() *ScheduledMessageUpdate {
+	_u.mutation.ClearReplyToID()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ScheduledMessageUpdate) SetContent(v string) *ScheduledMessageUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableContent(v *string) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *ScheduledMessageUpdate) ClearContent() *ScheduledMessageUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *ScheduledMessageUpdate) SetSendAt(v time.Time) *ScheduledMessageUpdate {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableSendAt(v *time.Time) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScheduledMessageUpdate) SetStatus(v scheduledmessage.Status) *ScheduledMessageUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableStatus(v *scheduledmessage.Status) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *ScheduledMessageUpdate) SetFailureReason(v string) *ScheduledMessageUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *ScheduledMessageUpdate) SetNillableFailureReason(v *string) *ScheduledMessageUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *ScheduledMessageUpdate) ClearFailureReason() *ScheduledMessageUpdate {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *ScheduledMessageUpdate) SetChat(v *Chat) *ScheduledMessageUpdate {
+	return _u.SetChatID(v.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (_u *ScheduledMessageUpdate) SetSender(v *User) *ScheduledMessageUpdate {
+	return _u.SetSenderID(v.ID)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (_u *ScheduledMessageUpdate) SetReplyTo(v *Message) *ScheduledMessageUpdate {
+	return _u.SetReplyToID(v.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by IDs.
+func (_u *ScheduledMessageUpdate) AddAttachmentIDs(ids ...uuid.UUID) *ScheduledMessageUpdate {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the Media entity.
+func (_u *ScheduledMessageUpdate) AddAttachments(v ...*Media) *ScheduledMessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (_u *ScheduledMessageUpdate) Mutation() *ScheduledMessageMutation {
+	return _u.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *ScheduledMessageUpdate) ClearChat() *ScheduledMessageUpdate {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (_u *ScheduledMessageUpdate) ClearSender() *ScheduledMessageUpdate {
+	_u.mutation.ClearSender()
+	return _u
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (_u *ScheduledMessageUpdate) ClearReplyTo() *ScheduledMessageUpdate {
+	_u.mutation.ClearReplyTo()
+	return _u
+}
+
+// ClearAttachments clears all "attachments" edges to the Media entity.
+func (_u *ScheduledMessageUpdate) ClearAttachments() *ScheduledMessageUpdate {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Media entities by IDs.
+func (_u *ScheduledMessageUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *ScheduledMessageUpdate {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to Media entities.
+func (_u *ScheduledMessageUpdate) RemoveAttachments(v ...*Media) *ScheduledMessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ScheduledMessageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ScheduledMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledMessageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledMessageUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureReason(); ok {
+		if err := scheduledmessage.FailureReasonValidator(v); err != nil {
+			return &ValidationError{Name: "failure_reason", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.failure_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.chat"`)
+	}
+	if _u.mutation.SenderCleared() && len(_u.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.sender"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduledMessageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduledMessageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduledMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(scheduledmessage.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(scheduledmessage.FieldFailureReason, field.TypeString)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.ChatTable,
+			Columns: []string{scheduledmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.ChatTable,
+			Columns: []string{scheduledmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ReplyToTable,
+			Columns: []string{scheduledmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ReplyToTable,
+			Columns: []string{scheduledmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ScheduledMessageUpdateOne is the builder for updating a single ScheduledMessage entity.
+type ScheduledMessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduledMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ScheduledMessageUpdateOne) SetUpdatedAt(v time.Time) *ScheduledMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *ScheduledMessageUpdateOne) SetChatID(v uuid.UUID) *ScheduledMessageUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableChatID(v *uuid.UUID) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *ScheduledMessageUpdateOne) SetSenderID(v uuid.UUID) *ScheduledMessageUpdateOne {
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableSenderID(v *uuid.UUID) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_u *ScheduledMessageUpdateOne) SetReplyToID(v uuid.UUID) *ScheduledMessageUpdateOne {
+	_u.mutation.SetReplyToID(v)
+	return _u
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableReplyToID(v *uuid.UUID) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetReplyToID(*v)
+	}
+	return _u
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (_u *ScheduledMessageUpdateOne) ClearReplyToID() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearReplyToID()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *ScheduledMessageUpdateOne) SetContent(v string) *ScheduledMessageUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableContent(v *string) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *ScheduledMessageUpdateOne) ClearContent() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *ScheduledMessageUpdateOne) SetSendAt(v time.Time) *ScheduledMessageUpdateOne {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableSendAt(v *time.Time) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ScheduledMessageUpdateOne) SetStatus(v scheduledmessage.Status) *ScheduledMessageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableStatus(v *scheduledmessage.Status) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *ScheduledMessageUpdateOne) SetFailureReason(v string) *ScheduledMessageUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *ScheduledMessageUpdateOne) SetNillableFailureReason(v *string) *ScheduledMessageUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *ScheduledMessageUpdateOne) ClearFailureReason() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *ScheduledMessageUpdateOne) SetChat(v *Chat) *ScheduledMessageUpdateOne {
+	return _u.SetChatID(v.ID)
+}
+
+// SetSender sets the "sender" edge to the User entity.
+func (_u *ScheduledMessageUpdateOne) SetSender(v *User) *ScheduledMessageUpdateOne {
+	return _u.SetSenderID(v.ID)
+}
+
+// SetReplyTo sets the "reply_to" edge to the Message entity.
+func (_u *ScheduledMessageUpdateOne) SetReplyTo(v *Message) *ScheduledMessageUpdateOne {
+	return _u.SetReplyToID(v.ID)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Media entity by IDs.
+func (_u *ScheduledMessageUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *ScheduledMessageUpdateOne {
+	_u.mutation.AddAttachmentIDs(ids...)
+	return _u
+}
+
+// AddAttachments adds the "attachments" edges to the Media entity.
+func (_u *ScheduledMessageUpdateOne) AddAttachments(v ...*Media) *ScheduledMessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttachmentIDs(ids...)
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (_u *ScheduledMessageUpdateOne) Mutation() *ScheduledMessageMutation {
+	return _u.mutation
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *ScheduledMessageUpdateOne) ClearChat() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearSender clears the "sender" edge to the User entity.
+func (_u *ScheduledMessageUpdateOne) ClearSender() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearSender()
+	return _u
+}
+
+// ClearReplyTo clears the "reply_to" edge to the Message entity.
+func (_u *ScheduledMessageUpdateOne) ClearReplyTo() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearReplyTo()
+	return _u
+}
+
+// ClearAttachments clears all "attachments" edges to the Media entity.
+func (_u *ScheduledMessageUpdateOne) ClearAttachments() *ScheduledMessageUpdateOne {
+	_u.mutation.ClearAttachments()
+	return _u
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Media entities by IDs.
+func (_u *ScheduledMessageUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *ScheduledMessageUpdateOne {
+	_u.mutation.RemoveAttachmentIDs(ids...)
+	return _u
+}
+
+// RemoveAttachments removes "attachments" edges to Media entities.
+func (_u *ScheduledMessageUpdateOne) RemoveAttachments(v ...*Media) *ScheduledMessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttachmentIDs(ids...)
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (_u *ScheduledMessageUpdateOne) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ScheduledMessageUpdateOne) Select(field string, fields ...string) *ScheduledMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ScheduledMessage entity.
+func (_u *ScheduledMessageUpdateOne) Save(ctx context.Context) (*ScheduledMessage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ScheduledMessageUpdateOne) SaveX(ctx context.Context) *ScheduledMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ScheduledMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ScheduledMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ScheduledMessageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := scheduledmessage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ScheduledMessageUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := scheduledmessage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureReason(); ok {
+		if err := scheduledmessage.FailureReasonValidator(v); err != nil {
+			return &ValidationError{Name: "failure_reason", err: fmt.Errorf(`ent: validator failed for field "ScheduledMessage.failure_reason": %w`, err)}
+		}
+	}
+	if _u.mutation.ChatCleared() && len(_u.mutation.ChatIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.chat"`)
+	}
+	if _u.mutation.SenderCleared() && len(_u.mutation.SenderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScheduledMessage.sender"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ScheduledMessageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduledMessageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ScheduledMessageUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduledMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledmessage.FieldID)
+		for _, f := range fields {
+			if !scheduledmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scheduledmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(scheduledmessage.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(scheduledmessage.FieldContent, field.TypeString)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(scheduledmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(scheduledmessage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(scheduledmessage.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(scheduledmessage.FieldFailureReason, field.TypeString)
+	}
+	if _u.mutation.ChatCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.ChatTable,
+			Columns: []string{scheduledmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.ChatTable,
+			Columns: []string{scheduledmessage.ChatColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SenderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SenderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scheduledmessage.SenderTable,
+			Columns: []string{scheduledmessage.SenderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ReplyToTable,
+			Columns: []string{scheduledmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   scheduledmessage.ReplyToTable,
+			Columns: []string{scheduledmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !_u.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scheduledmessage.AttachmentsTable,
+			Columns: []string{scheduledmessage.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ScheduledMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,7 @@ func (Chat) Fields() []ent.Field {
 		field.Enum("type").Values("private", "group").Immutable(),
 		field.UUID("last_message_id", uuid.UUID{}).Optional().Nillable(),
 		field.Time("last_message_at").Optional().Nillable(),
+		field.Int("message_ttl").Optional().Nillable().Positive(),
 		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
@@ -31,6 +32,11 @@ func (Chat) Edges() []ent.Edge {
 		edge.To("private_chat", PrivateChat.Type).Unique().Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("group_chat", GroupChat.Type).Unique().Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("last_message", Message.Type).Unique().Field("last_message_id"),
+		edge.To("pinned_messages", PinnedMessage.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("scheduled_messages", ScheduledMessage.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("exports", ChatExport.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("pinned_by", PinnedChat.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("folders", ChatFolderChat.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type ChatExport struct {
+	ent.Schema
+}
+
+func (ChatExport) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (ChatExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("chat_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("status").Values("pending", "processing", "completed", "failed").Default("pending"),
+		field.String("file_name").MaxLen(255).Optional().Nillable(),
+		field.Int64("file_size").Optional().Nillable(),
+		field.Int("message_count").Default(0).NonNegative(),
+		field.String("failure_reason").MaxLen(255).Optional().Nillable(),
+		field.Time("completed_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+	}
+}
+
+func (ChatExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("chat", Chat.Type).Ref("exports").Field("chat_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).Ref("chat_exports").Field("user_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (ChatExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "updated_at"),
+		index.Fields("user_id", "chat_id"),
+		index.Fields("expires_at").
+			Annotations(entsql.IndexWhere("expires_at IS NOT NULL")),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatFolder groups chats in the chat list of one user. A chat belongs to the
+// folder when it was added explicitly, or when the folder has rules and the chat
+// matches all of them. A nil rule matches any chat.
+type ChatFolder struct {
+	ent.Schema
+}
+
+func (ChatFolder) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (ChatFolder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("name").NotEmpty().MaxLen(32),
+		field.Enum("chat_type").Values("private", "group").Optional().Nillable(),
+		field.Bool("unread_only").Default(false),
+		field.Bool("muted").Optional().Nillable(),
+		field.Bool("archived").Optional().Nillable(),
+	}
+}
+
+func (ChatFolder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("chat_folders").Field("user_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("chats", ChatFolderChat.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (ChatFolder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatFolderChat adds a chat to a folder explicitly, regardless of the folder
+// rules.
+type ChatFolderChat struct {
+	ent.Schema
+}
+
+func (ChatFolderChat) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (ChatFolderChat) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("folder_id", uuid.UUID{}),
+		field.UUID("chat_id", uuid.UUID{}),
+	}
+}
+
+func (ChatFolderChat) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("folder", ChatFolder.Type).Ref("chats").Field("folder_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("chat", Chat.Type).Ref("folders").Field("chat_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (ChatFolderChat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("folder_id", "chat_id").Unique(),
+		index.Fields("chat_id"),
+	}
+}
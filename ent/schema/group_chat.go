@@ -21,6 +21,8 @@ func (GroupChat) Fields() []ent.Field {
 		field.Text("description").Optional().Nillable(),
 		field.UUID("avatar_id", uuid.UUID{}).Optional().Nillable(),
 		field.Bool("is_public").Default(false),
+		field.Int("slow_mode_seconds").Default(0).NonNegative(),
+		field.Bool("requires_approval").Default(false),
 		
 		field.String("invite_code").MaxLen(50).Unique().NotEmpty(),
 		field.Time("invite_expires_at").Optional().Nillable(),
@@ -38,6 +40,7 @@ func (GroupChat) Edges() []ent.Edge {
 		edge.From("chat", Chat.Type).Ref("group_chat").Field("chat_id").Unique().Required(),
 		edge.From("creator", User.Type).Ref("created_groups").Field("created_by").Unique(),
 		edge.To("members", GroupMember.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("join_requests", GroupJoinRequest.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 
 		edge.From("reports", Report.Type).Ref("group"),
 	}
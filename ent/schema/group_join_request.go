@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// GroupJoinRequest is a request of a user to join a group that requires admin
+// approval. A user has one request per group, which is reopened when the user
+// asks again after a decision.
+type GroupJoinRequest struct {
+	ent.Schema
+}
+
+func (GroupJoinRequest) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (GroupJoinRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("group_chat_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("status").Values("pending", "approved", "declined").Default("pending"),
+		field.Time("requested_at").Default(nowUTC),
+		field.UUID("reviewed_by", uuid.UUID{}).Optional().Nillable(),
+		field.Time("reviewed_at").Optional().Nillable(),
+	}
+}
+
+func (GroupJoinRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("group_chat", GroupChat.Type).Ref("join_requests").Field("group_chat_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).Ref("group_join_requests").Field("user_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (GroupJoinRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_chat_id", "user_id").Unique(),
+		index.Fields("group_chat_id", "status", "requested_at"),
+	}
+}
@@ -22,6 +22,15 @@ func (GroupMember) Fields() []ent.Field {
 		field.Time("last_read_at").Optional().Nillable(),
 		field.Time("joined_at").Default(nowUTC).Immutable(),
 		field.Int("unread_count").Default(0),
+		field.Int("unread_mention_count").Default(0),
+		field.Bool("marked_unread").Default(false),
+		field.UUID("last_read_message_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("last_delivered_message_id", uuid.UUID{}).Optional().Nillable(),
+		field.Time("archived_at").Optional().Nillable(),
+		field.Bool("keep_archived").Default(false),
+		field.Bool("muted").Default(false),
+		field.Time("muted_until").Optional().Nillable(),
+		field.Bool("notify_mentions").Default(false),
 	}
 }
 
@@ -31,6 +40,10 @@ func (GroupMember) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.From("user", User.Type).Ref("group_memberships").Field("user_id").Unique().Required().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("last_read_message", Message.Type).Field("last_read_message_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("last_delivered_message", Message.Type).Field("last_delivered_message_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -16,16 +17,19 @@ func (Media) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
 func (Media) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
-		field.String("file_name").Unique().MaxLen(255).NotEmpty(),
+		field.String("file_name").MaxLen(255).NotEmpty(),
 		field.String("original_name").MaxLen(255).NotEmpty(),
 		field.Int64("file_size").Positive(),
 		field.String("mime_type").MaxLen(100).NotEmpty(),
-		field.Enum("category").Values("user_avatar", "group_avatar", "message_attachment").Default("message_attachment"),
+		field.Enum("category").Values("user_avatar", "group_avatar", "message_attachment", "voice").Default("message_attachment"),
 		field.Enum("upload_status").Values("pending", "completed").Default("completed"),
 		field.Time("upload_expires_at").Optional().Nillable(),
 		field.Time("completed_at").Optional().Nillable(),
+		field.Int("duration_ms").Optional().Nillable().NonNegative(),
+		field.JSON("waveform", []int{}).Optional(),
 		field.UUID("message_id", uuid.UUID{}).Optional().Nillable(),
 		field.UUID("uploaded_by_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("scheduled_message_id", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 func (Media) Edges() []ent.Edge {
@@ -36,6 +40,11 @@ func (Media) Edges() []ent.Edge {
 			Field("message_id").
 			Unique(),
 
+		edge.From("scheduled_message", ScheduledMessage.Type).
+			Ref("attachments").
+			Field("scheduled_message_id").
+			Unique(),
+
 		edge.To("user_avatar", User.Type).
 			Unique(),
 		edge.To("group_avatar", GroupChat.Type).
@@ -48,5 +57,14 @@ func (Media) Edges() []ent.Edge {
 
 		edge.From("reports", Report.Type).
 			Ref("evidence_media"),
+
+		edge.From("message_revisions", MessageRevision.Type).
+			Ref("attachments"),
+	}
+}
+
+func (Media) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("file_name"),
 	}
 }
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
@@ -13,6 +15,27 @@ type Message struct {
 	ent.Schema
 }
 
+// LinkPreview is the metadata of the first URL in a message, fetched after the
+// message is sent. ImagePath points into the public bucket.
+type LinkPreview struct {
+	URL         string `json:"url"`
+	SiteName    string `json:"site_name,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImagePath   string `json:"image_path,omitempty"`
+}
+
+// Location is the position shared by a location message. A live location keeps
+// receiving position updates from its sender until LiveUntil.
+type Location struct {
+	Latitude  float64    `json:"latitude"`
+	Longitude float64    `json:"longitude"`
+	Accuracy  float64    `json:"accuracy"`
+	VenueName string     `json:"venue_name,omitempty"`
+	LiveUntil *time.Time `json:"live_until,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
 func (Message) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
 
 func (Message) Fields() []ent.Field {
@@ -35,6 +58,14 @@ func (Message) Fields() []ent.Field {
 				"system_promote",
 				"system_demote",
 				"system_visibility",
+				"system_pin",
+				"system_unpin",
+				"system_ttl",
+				"system_slow_mode",
+				"system_join_approval",
+				"poll",
+				"location",
+				"contact",
 			).
 			Default("regular"),
 		field.Text("content").Optional().Nillable(),
@@ -42,6 +73,15 @@ func (Message) Fields() []ent.Field {
 			Optional(),
 		field.Time("deleted_at").Optional().Nillable(),
 		field.Time("edited_at").Optional().Nillable(),
+		field.UUID("forwarded_from_sender_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("forwarded_from_chat_id", uuid.UUID{}).Optional().Nillable(),
+		field.Int("reply_count").Default(0).NonNegative(),
+		field.Time("last_reply_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.JSON("link_preview", &LinkPreview{}).Optional(),
+		field.String("client_message_id").MaxLen(64).Optional().Nillable().Immutable(),
+		field.JSON("location", &Location{}).Optional(),
+		field.UUID("contact_user_id", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 
@@ -52,6 +92,17 @@ func (Message) Edges() []ent.Edge {
 		edge.To("reply_to", Message.Type).Field("reply_to_id").Unique().From("replies").
 			Annotations(entsql.OnDelete(entsql.SetNull)),
 		edge.To("attachments", Media.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("reactions", MessageReaction.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("pins", PinnedMessage.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", MessageRevision.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("mentions", MessageMention.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("poll", Poll.Type).Unique().Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("forwarded_from_sender", User.Type).Field("forwarded_from_sender_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("forwarded_from_chat", Chat.Type).Field("forwarded_from_chat_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("contact_user", User.Type).Field("contact_user_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 
 		edge.From("reports", Report.Type).Ref("message"),
 	}
@@ -67,5 +118,10 @@ func (Message) Indexes() []ent.Index {
 			StorageKey("idx_messages_chat_active"),
 		index.Fields("reply_to_id").
 			Annotations(entsql.IndexWhere("reply_to_id IS NOT NULL AND deleted_at IS NULL")),
+		index.Fields("expires_at").
+			Annotations(entsql.IndexWhere("expires_at IS NOT NULL")),
+		index.Fields("sender_id", "chat_id", "client_message_id").
+			Unique().
+			Annotations(entsql.IndexWhere("client_message_id IS NOT NULL")),
 	}
 }
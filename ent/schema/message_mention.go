@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageMention is an @username or @all mention inside a group message.
+// user_id is only set for user mentions.
+type MessageMention struct {
+	ent.Schema
+}
+
+func (MessageMention) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (MessageMention) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("message_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable(),
+		field.Enum("type").Values("user", "all"),
+		field.Int("offset").NonNegative(),
+		field.Int("length").Positive(),
+	}
+}
+
+func (MessageMention) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("mentions").Field("message_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).Ref("mentions").Field("user_id").Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (MessageMention) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id"),
+		index.Fields("user_id"),
+	}
+}
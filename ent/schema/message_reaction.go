@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type MessageReaction struct {
+	ent.Schema
+}
+
+func (MessageReaction) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (MessageReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("message_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("emoji").MaxLen(32).NotEmpty(),
+	}
+}
+
+func (MessageReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("reactions").Field("message_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).Ref("message_reactions").Field("user_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (MessageReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "user_id", "emoji").Unique(),
+		index.Fields("user_id"),
+	}
+}
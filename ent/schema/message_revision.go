@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type MessageRevision struct {
+	ent.Schema
+}
+
+func (MessageRevision) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (MessageRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("message_id", uuid.UUID{}),
+		field.Text("content").Optional().Nillable(),
+	}
+}
+
+func (MessageRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("revisions").Field("message_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("attachments", Media.Type),
+	}
+}
+
+func (MessageRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "created_at"),
+	}
+}
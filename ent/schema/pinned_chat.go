@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PinnedChat keeps a chat at the top of one user's chat list. Position orders
+// the pins of a user, lowest first.
+type PinnedChat struct {
+	ent.Schema
+}
+
+func (PinnedChat) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (PinnedChat) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("chat_id", uuid.UUID{}),
+		field.Int("position").Default(0).NonNegative(),
+	}
+}
+
+func (PinnedChat) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("pinned_chats").Field("user_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("chat", Chat.Type).Ref("pinned_by").Field("chat_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (PinnedChat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "chat_id").Unique(),
+		index.Fields("chat_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type PinnedMessage struct {
+	ent.Schema
+}
+
+func (PinnedMessage) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (PinnedMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("chat_id", uuid.UUID{}),
+		field.UUID("message_id", uuid.UUID{}),
+		field.UUID("pinned_by_id", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (PinnedMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("chat", Chat.Type).Ref("pinned_messages").Field("chat_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("message", Message.Type).Ref("pins").Field("message_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("pinned_by", User.Type).Ref("pinned_messages").Field("pinned_by_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (PinnedMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("chat_id", "message_id").Unique(),
+		index.Fields("message_id"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Poll holds the settings of a poll message. The question is also stored as the
+// message content so chat previews and search keep working.
+type Poll struct {
+	ent.Schema
+}
+
+func (Poll) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (Poll) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("message_id", uuid.UUID{}).Unique(),
+		field.String("question").MaxLen(300).NotEmpty(),
+		field.Bool("multiple_choice").Default(false),
+		field.Bool("anonymous").Default(false),
+		field.Bool("quiz").Default(false),
+		field.Time("closes_at").Optional().Nillable(),
+		field.Time("closed_at").Optional().Nillable(),
+	}
+}
+
+func (Poll) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", Message.Type).Ref("poll").Field("message_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("options", PollOption.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("votes", PollVote.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type PollOption struct {
+	ent.Schema
+}
+
+func (PollOption) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (PollOption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("poll_id", uuid.UUID{}),
+		field.String("text").MaxLen(100).NotEmpty(),
+		field.Int("position").NonNegative(),
+		field.Bool("is_correct").Default(false),
+	}
+}
+
+func (PollOption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).Ref("options").Field("poll_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("votes", PollVote.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (PollOption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "position").Unique(),
+	}
+}
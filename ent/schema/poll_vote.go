@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type PollVote struct {
+	ent.Schema
+}
+
+func (PollVote) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (PollVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("poll_id", uuid.UUID{}),
+		field.UUID("option_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+	}
+}
+
+func (PollVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).Ref("votes").Field("poll_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("option", PollOption.Type).Ref("votes").Field("option_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).Ref("poll_votes").Field("user_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (PollVote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("option_id", "user_id").Unique(),
+		index.Fields("poll_id", "user_id"),
+		index.Fields("user_id"),
+	}
+}
@@ -25,8 +25,18 @@ func (PrivateChat) Fields() []ent.Field {
 		field.Time("user2_last_read_at").Optional().Nillable(),
 		field.Time("user1_hidden_at").Optional().Nillable(),
 		field.Time("user2_hidden_at").Optional().Nillable(),
+		field.Time("user1_archived_at").Optional().Nillable(),
+		field.Time("user2_archived_at").Optional().Nillable(),
+		field.Bool("user1_keep_archived").Default(false),
+		field.Bool("user2_keep_archived").Default(false),
+		field.Bool("user1_muted").Default(false),
+		field.Bool("user2_muted").Default(false),
+		field.Time("user1_muted_until").Optional().Nillable(),
+		field.Time("user2_muted_until").Optional().Nillable(),
 		field.Int("user1_unread_count").Default(0),
 		field.Int("user2_unread_count").Default(0),
+		field.Bool("user1_marked_unread").Default(false),
+		field.Bool("user2_marked_unread").Default(false),
 	}
 }
 
@@ -40,6 +50,7 @@ func (PrivateChat) Hooks() []ent.Hook {
 					if exists1 && exists2 {
 						id1, ok1 := v1.(uuid.UUID)
 						id2, ok2 := v2.(uuid.UUID)
+						// A self-chat has the same user on both sides and needs no ordering.
 						if ok1 && ok2 && id1.String() > id2.String() {
 							m.SetField("user1_id", id2)
 							m.SetField("user2_id", id1)
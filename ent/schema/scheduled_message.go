@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+type ScheduledMessage struct {
+	ent.Schema
+}
+
+func (ScheduledMessage) Mixin() []ent.Mixin { return []ent.Mixin{TimeMixin{}} }
+
+func (ScheduledMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(newUUIDv7),
+		field.UUID("chat_id", uuid.UUID{}),
+		field.UUID("sender_id", uuid.UUID{}),
+		field.UUID("reply_to_id", uuid.UUID{}).Optional().Nillable(),
+		field.Text("content").Optional().Nillable(),
+		field.Time("send_at"),
+		field.Enum("status").Values("pending", "failed").Default("pending"),
+		field.String("failure_reason").MaxLen(255).Optional().Nillable(),
+	}
+}
+
+func (ScheduledMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("chat", Chat.Type).Ref("scheduled_messages").Field("chat_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("sender", User.Type).Ref("scheduled_messages").Field("sender_id").Unique().Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reply_to", Message.Type).Field("reply_to_id").Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("attachments", Media.Type).Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+func (ScheduledMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "send_at"),
+		index.Fields("sender_id", "send_at"),
+	}
+}
@@ -59,6 +59,24 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("blocked_by_rel", UserBlock.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("message_reactions", MessageReaction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("pinned_messages", PinnedMessage.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("scheduled_messages", ScheduledMessage.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("mentions", MessageMention.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("poll_votes", PollVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("chat_exports", ChatExport.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("pinned_chats", PinnedChat.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("chat_folders", ChatFolder.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("group_join_requests", GroupJoinRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 
 		edge.To("reports_made", Report.Type),
 		edge.To("reports_received", Report.Type),
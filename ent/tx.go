@@ -14,18 +14,44 @@ type Tx struct {
 	config
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
+	// ChatExport is the client for interacting with the ChatExport builders.
+	ChatExport *ChatExportClient
+	// ChatFolder is the client for interacting with the ChatFolder builders.
+	ChatFolder *ChatFolderClient
+	// ChatFolderChat is the client for interacting with the ChatFolderChat builders.
+	ChatFolderChat *ChatFolderChatClient
 	// GroupChat is the client for interacting with the GroupChat builders.
 	GroupChat *GroupChatClient
+	// GroupJoinRequest is the client for interacting with the GroupJoinRequest builders.
+	GroupJoinRequest *GroupJoinRequestClient
 	// GroupMember is the client for interacting with the GroupMember builders.
 	GroupMember *GroupMemberClient
 	// Media is the client for interacting with the Media builders.
 	Media *MediaClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// MessageMention is the client for interacting with the MessageMention builders.
+	MessageMention *MessageMentionClient
+	// MessageReaction is the client for interacting with the MessageReaction builders.
+	MessageReaction *MessageReactionClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// PinnedChat is the client for interacting with the PinnedChat builders.
+	PinnedChat *PinnedChatClient
+	// PinnedMessage is the client for interacting with the PinnedMessage builders.
+	PinnedMessage *PinnedMessageClient
+	// Poll is the client for interacting with the Poll builders.
+	Poll *PollClient
+	// PollOption is the client for interacting with the PollOption builders.
+	PollOption *PollOptionClient
+	// PollVote is the client for interacting with the PollVote builders.
+	PollVote *PollVoteClient
 	// PrivateChat is the client for interacting with the PrivateChat builders.
 	PrivateChat *PrivateChatClient
 	// Report is the client for interacting with the Report builders.
 	Report *ReportClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserBlock is the client for interacting with the UserBlock builders.
@@ -164,12 +190,25 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Chat = NewChatClient(tx.config)
+	tx.ChatExport = NewChatExportClient(tx.config)
+	tx.ChatFolder = NewChatFolderClient(tx.config)
+	tx.ChatFolderChat = NewChatFolderChatClient(tx.config)
 	tx.GroupChat = NewGroupChatClient(tx.config)
+	tx.GroupJoinRequest = NewGroupJoinRequestClient(tx.config)
 	tx.GroupMember = NewGroupMemberClient(tx.config)
 	tx.Media = NewMediaClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.MessageMention = NewMessageMentionClient(tx.config)
+	tx.MessageReaction = NewMessageReactionClient(tx.config)
+	tx.MessageRevision = NewMessageRevisionClient(tx.config)
+	tx.PinnedChat = NewPinnedChatClient(tx.config)
+	tx.PinnedMessage = NewPinnedMessageClient(tx.config)
+	tx.Poll = NewPollClient(tx.config)
+	tx.PollOption = NewPollOptionClient(tx.config)
+	tx.PollVote = NewPollVoteClient(tx.config)
 	tx.PrivateChat = NewPrivateChatClient(tx.config)
 	tx.Report = NewReportClient(tx.config)
+	tx.ScheduledMessage = NewScheduledMessageClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserBlock = NewUserBlockClient(tx.config)
 	tx.UserIdentity = NewUserIdentityClient(tx.config)
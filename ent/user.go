@@ -75,13 +75,31 @@ type UserEdges struct {
 	BlockedUsersRel []*UserBlock `json:"blocked_users_rel,omitempty"`
 	// BlockedByRel holds the value of the blocked_by_rel edge.
 	BlockedByRel []*UserBlock `json:"blocked_by_rel,omitempty"`
+	// MessageReactions holds the value of the message_reactions edge.
+	MessageReactions []*MessageReaction `json:"message_reactions,omitempty"`
+	// PinnedMessages holds the value of the pinned_messages edge.
+	PinnedMessages []*PinnedMessage `json:"pinned_messages,omitempty"`
+	// ScheduledMessages holds the value of the scheduled_messages edge.
+	ScheduledMessages []*ScheduledMessage `json:"scheduled_messages,omitempty"`
+	// Mentions holds the value of the mentions edge.
+	Mentions []*MessageMention `json:"mentions,omitempty"`
+	// PollVotes holds the value of the poll_votes edge.
+	PollVotes []*PollVote `json:"poll_votes,omitempty"`
+	// ChatExports holds the value of the chat_exports edge.
+	ChatExports []*ChatExport `json:"chat_exports,omitempty"`
+	// PinnedChats holds the value of the pinned_chats edge.
+	PinnedChats []*PinnedChat `json:"pinned_chats,omitempty"`
+	// ChatFolders holds the value of the chat_folders edge.
+	ChatFolders []*ChatFolder `json:"chat_folders,omitempty"`
+	// GroupJoinRequests holds the value of the group_join_requests edge.
+	GroupJoinRequests []*GroupJoinRequest `json:"group_join_requests,omitempty"`
 	// ReportsMade holds the value of the reports_made edge.
 	ReportsMade []*Report `json:"reports_made,omitempty"`
 	// ReportsReceived holds the value of the reports_received edge.
 	ReportsReceived []*Report `json:"reports_received,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [12]bool
+	loadedTypes [21]bool
 }
 
 // AvatarOrErr returns the Avatar value or an error if the edge
@@ -176,10 +194,91 @@ func (e UserEdges) BlockedByRelOrErr() ([]*UserBlock, error) {
 	return nil, &NotLoadedError{edge: "blocked_by_rel"}
 }
 
+// MessageReactionsOrErr returns the MessageReactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MessageReactionsOrErr() ([]*MessageReaction, error) {
+	if e.loadedTypes[10] {
+		return e.MessageReactions, nil
+	}
+	return nil, &NotLoadedError{edge: "message_reactions"}
+}
+
+// PinnedMessagesOrErr returns the PinnedMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PinnedMessagesOrErr() ([]*PinnedMessage, error) {
+	if e.loadedTypes[11] {
+		return e.PinnedMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "pinned_messages"}
+}
+
+// ScheduledMessagesOrErr returns the ScheduledMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ScheduledMessagesOrErr() ([]*ScheduledMessage, error) {
+	if e.loadedTypes[12] {
+		return e.ScheduledMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "scheduled_messages"}
+}
+
+// MentionsOrErr returns the Mentions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MentionsOrErr() ([]*MessageMention, error) {
+	if e.loadedTypes[13] {
+		return e.Mentions, nil
+	}
+	return nil, &NotLoadedError{edge: "mentions"}
+}
+
+// PollVotesOrErr returns the PollVotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PollVotesOrErr() ([]*PollVote, error) {
+	if e.loadedTypes[14] {
+		return e.PollVotes, nil
+	}
+	return nil, &NotLoadedError{edge: "poll_votes"}
+}
+
+// ChatExportsOrErr returns the ChatExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChatExportsOrErr() ([]*ChatExport, error) {
+	if e.loadedTypes[15] {
+		return e.ChatExports, nil
+	}
+	return nil, &NotLoadedError{edge: "chat_exports"}
+}
+
+// PinnedChatsOrErr returns the PinnedChats value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PinnedChatsOrErr() ([]*PinnedChat, error) {
+	if e.loadedTypes[16] {
+		return e.PinnedChats, nil
+	}
+	return nil, &NotLoadedError{edge: "pinned_chats"}
+}
+
+// ChatFoldersOrErr returns the ChatFolders value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChatFoldersOrErr() ([]*ChatFolder, error) {
+	if e.loadedTypes[17] {
+		return e.ChatFolders, nil
+	}
+	return nil, &NotLoadedError{edge: "chat_folders"}
+}
+
+// GroupJoinRequestsOrErr returns the GroupJoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupJoinRequestsOrErr() ([]*GroupJoinRequest, error) {
+	if e.loadedTypes[18] {
+		return e.GroupJoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "group_join_requests"}
+}
+
 // ReportsMadeOrErr returns the ReportsMade value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReportsMadeOrErr() ([]*Report, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[19] {
 		return e.ReportsMade, nil
 	}
 	return nil, &NotLoadedError{edge: "reports_made"}
@@ -188,7 +287,7 @@ func (e UserEdges) ReportsMadeOrErr() ([]*Report, error) {
 // ReportsReceivedOrErr returns the ReportsReceived value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ReportsReceivedOrErr() ([]*Report, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[20] {
 		return e.ReportsReceived, nil
 	}
 	return nil, &NotLoadedError{edge: "reports_received"}
@@ -387,6 +486,51 @@ func (_m *User) QueryBlockedByRel() *UserBlockQuery {
 	return NewUserClient(_m.config).QueryBlockedByRel(_m)
 }
 
+// QueryMessageReactions queries the "message_reactions" edge of the User entity.
+func (_m *User) QueryMessageReactions() *MessageReactionQuery {
+	return NewUserClient(_m.config).QueryMessageReactions(_m)
+}
+
+// QueryPinnedMessages queries the "pinned_messages" edge of the User entity.
+func (_m *User) QueryPinnedMessages() *PinnedMessageQuery {
+	return NewUserClient(_m.config).QueryPinnedMessages(_m)
+}
+
+// QueryScheduledMessages queries the "scheduled_messages" edge of the User entity.
+func (_m *User) QueryScheduledMessages() *ScheduledMessageQuery {
+	return NewUserClient(_m.config).QueryScheduledMessages(_m)
+}
+
+// QueryMentions queries the "mentions" edge of the User entity.
+func (_m *User) QueryMentions() *MessageMentionQuery {
+	return NewUserClient(_m.config).QueryMentions(_m)
+}
+
+// QueryPollVotes queries the "poll_votes" edge of the User entity.
+func (_m *User) QueryPollVotes() *PollVoteQuery {
+	return NewUserClient(_m.config).QueryPollVotes(_m)
+}
+
+// QueryChatExports queries the "chat_exports" edge of the User entity.
+func (_m *User) QueryChatExports() *ChatExportQuery {
+	return NewUserClient(_m.config).QueryChatExports(_m)
+}
+
+// QueryPinnedChats queries the "pinned_chats" edge of the User entity.
+func (_m *User) QueryPinnedChats() *PinnedChatQuery {
+	return NewUserClient(_m.config).QueryPinnedChats(_m)
+}
+
+// QueryChatFolders queries the "chat_folders" edge of the User entity.
+func (_m *User) QueryChatFolders() *ChatFolderQuery {
+	return NewUserClient(_m.config).QueryChatFolders(_m)
+}
+
+// QueryGroupJoinRequests queries the "group_join_requests" edge of the User entity.
+func (_m *User) QueryGroupJoinRequests() *GroupJoinRequestQuery {
+	return NewUserClient(_m.config).QueryGroupJoinRequests(_m)
+}
+
 // QueryReportsMade queries the "reports_made" edge of the User entity.
 func (_m *User) QueryReportsMade() *ReportQuery {
 	return NewUserClient(_m.config).QueryReportsMade(_m)
@@ -64,6 +64,24 @@ const (
 	EdgeBlockedUsersRel = "blocked_users_rel"
 	// EdgeBlockedByRel holds the string denoting the blocked_by_rel edge name in mutations.
 	EdgeBlockedByRel = "blocked_by_rel"
+	// EdgeMessageReactions holds the string denoting the message_reactions edge name in mutations.
+	EdgeMessageReactions = "message_reactions"
+	// EdgePinnedMessages holds the string denoting the pinned_messages edge name in mutations.
+	EdgePinnedMessages = "pinned_messages"
+	// EdgeScheduledMessages holds the string denoting the scheduled_messages edge name in mutations.
+	EdgeScheduledMessages = "scheduled_messages"
+	// EdgeMentions holds the string denoting the mentions edge name in mutations.
+	EdgeMentions = "mentions"
+	// EdgePollVotes holds the string denoting the poll_votes edge name in mutations.
+	EdgePollVotes = "poll_votes"
+	// EdgeChatExports holds the string denoting the chat_exports edge name in mutations.
+	EdgeChatExports = "chat_exports"
+	// EdgePinnedChats holds the string denoting the pinned_chats edge name in mutations.
+	EdgePinnedChats = "pinned_chats"
+	// EdgeChatFolders holds the string denoting the chat_folders edge name in mutations.
+	EdgeChatFolders = "chat_folders"
+	// EdgeGroupJoinRequests holds the string denoting the group_join_requests edge name in mutations.
+	EdgeGroupJoinRequests = "group_join_requests"
 	// EdgeReportsMade holds the string denoting the reports_made edge name in mutations.
 	EdgeReportsMade = "reports_made"
 	// EdgeReportsReceived holds the string denoting the reports_received edge name in mutations.
@@ -140,6 +158,69 @@ const (
 	BlockedByRelInverseTable = "user_blocks"
 	// BlockedByRelColumn is the table column denoting the blocked_by_rel relation/edge.
 	BlockedByRelColumn = "blocked_id"
+	// MessageReactionsTable is the table that holds the message_reactions relation/edge.
+	MessageReactionsTable = "message_reactions"
+	// MessageReactionsInverseTable is the table name for the MessageReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "messagereaction" package.
+	MessageReactionsInverseTable = "message_reactions"
+	// MessageReactionsColumn is the table column denoting the message_reactions relation/edge.
+	MessageReactionsColumn = "user_id"
+	// PinnedMessagesTable is the table that holds the pinned_messages relation/edge.
+	PinnedMessagesTable = "pinned_messages"
+	// PinnedMessagesInverseTable is the table name for the PinnedMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedmessage" package.
+	PinnedMessagesInverseTable = "pinned_messages"
+	// PinnedMessagesColumn is the table column denoting the pinned_messages relation/edge.
+	PinnedMessagesColumn = "pinned_by_id"
+	// ScheduledMessagesTable is the table that holds the scheduled_messages relation/edge.
+	ScheduledMessagesTable = "scheduled_messages"
+	// ScheduledMessagesInverseTable is the table name for the ScheduledMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "scheduledmessage" package.
+	ScheduledMessagesInverseTable = "scheduled_messages"
+	// ScheduledMessagesColumn is the table column denoting the scheduled_messages relation/edge.
+	ScheduledMessagesColumn = "sender_id"
+	// MentionsTable is the table that holds the mentions relation/edge.
+	MentionsTable = "message_mentions"
+	// MentionsInverseTable is the table name for the MessageMention entity.
+	// It exists in this package in order to avoid circular dependency with the "messagemention" package.
+	MentionsInverseTable = "message_mentions"
+	// MentionsColumn is the table column denoting the mentions relation/edge.
+	MentionsColumn = "user_id"
+	// PollVotesTable is the table that holds the poll_votes relation/edge.
+	PollVotesTable = "poll_votes"
+	// PollVotesInverseTable is the table name for the PollVote entity.
+	// It exists in this package in order to avoid circular dependency with the "pollvote" package.
+	PollVotesInverseTable = "poll_votes"
+	// PollVotesColumn is the table column denoting the poll_votes relation/edge.
+	PollVotesColumn = "user_id"
+	// ChatExportsTable is the table that holds the chat_exports relation/edge.
+	ChatExportsTable = "chat_exports"
+	// ChatExportsInverseTable is the table name for the ChatExport entity.
+	// It exists in this package in order to avoid circular dependency with the "chatexport" package.
+	ChatExportsInverseTable = "chat_exports"
+	// ChatExportsColumn is the table column denoting the chat_exports relation/edge.
+	ChatExportsColumn = "user_id"
+	// PinnedChatsTable is the table that holds the pinned_chats relation/edge.
+	PinnedChatsTable = "pinned_chats"
+	// PinnedChatsInverseTable is the table name for the PinnedChat entity.
+	// It exists in this package in order to avoid circular dependency with the "pinnedchat" package.
+	PinnedChatsInverseTable = "pinned_chats"
+	// PinnedChatsColumn is the table column denoting the pinned_chats relation/edge.
+	PinnedChatsColumn = "user_id"
+	// ChatFoldersTable is the table that holds the chat_folders relation/edge.
+	ChatFoldersTable = "chat_folders"
+	// ChatFoldersInverseTable is the table name for the ChatFolder entity.
+	// It exists in this package in order to avoid circular dependency with the "chatfolder" package.
+	ChatFoldersInverseTable = "chat_folders"
+	// ChatFoldersColumn is the table column denoting the chat_folders relation/edge.
+	ChatFoldersColumn = "user_id"
+	// GroupJoinRequestsTable is the table that holds the group_join_requests relation/edge.
+	GroupJoinRequestsTable = "group_join_requests"
+	// GroupJoinRequestsInverseTable is the table name for the GroupJoinRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "groupjoinrequest" package.
+	GroupJoinRequestsInverseTable = "group_join_requests"
+	// GroupJoinRequestsColumn is the table column denoting the group_join_requests relation/edge.
+	GroupJoinRequestsColumn = "user_id"
 	// ReportsMadeTable is the table that holds the reports_made relation/edge.
 	ReportsMadeTable = "reports"
 	// ReportsMadeInverseTable is the table name for the Report entity.
@@ -445,6 +526,132 @@ func ByBlockedByRel(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByMessageReactionsCount orders the results by message_reactions count.
+func ByMessageReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMessageReactionsStep(), opts...)
+	}
+}
+
+// ByMessageReactions orders the results by message_reactions terms.
+func ByMessageReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMessageReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPinnedMessagesCount orders the results by pinned_messages count.
+func ByPinnedMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinnedMessagesStep(), opts...)
+	}
+}
+
+// ByPinnedMessages orders the results by pinned_messages terms.
+func ByPinnedMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinnedMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByScheduledMessagesCount orders the results by scheduled_messages count.
+func ByScheduledMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScheduledMessagesStep(), opts...)
+	}
+}
+
+// ByScheduledMessages orders the results by scheduled_messages terms.
+func ByScheduledMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScheduledMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionsCount orders the results by mentions count.
+func ByMentionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionsStep(), opts...)
+	}
+}
+
+// ByMentions orders the results by mentions terms.
+func ByMentions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPollVotesCount orders the results by poll_votes count.
+func ByPollVotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPollVotesStep(), opts...)
+	}
+}
+
+// ByPollVotes orders the results by poll_votes terms.
+func ByPollVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChatExportsCount orders the results by chat_exports count.
+func ByChatExportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatExportsStep(), opts...)
+	}
+}
+
+// ByChatExports orders the results by chat_exports terms.
+func ByChatExports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatExportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPinnedChatsCount orders the results by pinned_chats count.
+func ByPinnedChatsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPinnedChatsStep(), opts...)
+	}
+}
+
+// ByPinnedChats orders the results by pinned_chats terms.
+func ByPinnedChats(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPinnedChatsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChatFoldersCount orders the results by chat_folders count.
+func ByChatFoldersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatFoldersStep(), opts...)
+	}
+}
+
+// ByChatFolders orders the results by chat_folders terms.
+func ByChatFolders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatFoldersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupJoinRequestsCount orders the results by group_join_requests count.
+func ByGroupJoinRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupJoinRequestsStep(), opts...)
+	}
+}
+
+// ByGroupJoinRequests orders the results by group_join_requests terms.
+func ByGroupJoinRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupJoinRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByReportsMadeCount orders the results by reports_made count.
 func ByReportsMadeCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -542,6 +749,69 @@ func newBlockedByRelStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, BlockedByRelTable, BlockedByRelColumn),
 	)
 }
+func newMessageReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MessageReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MessageReactionsTable, MessageReactionsColumn),
+	)
+}
+func newPinnedMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinnedMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinnedMessagesTable, PinnedMessagesColumn),
+	)
+}
+func newScheduledMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScheduledMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ScheduledMessagesTable, ScheduledMessagesColumn),
+	)
+}
+func newMentionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+	)
+}
+func newPollVotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollVotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PollVotesTable, PollVotesColumn),
+	)
+}
+func newChatExportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatExportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatExportsTable, ChatExportsColumn),
+	)
+}
+func newPinnedChatsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PinnedChatsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PinnedChatsTable, PinnedChatsColumn),
+	)
+}
+func newChatFoldersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatFoldersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatFoldersTable, ChatFoldersColumn),
+	)
+}
+func newGroupJoinRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupJoinRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GroupJoinRequestsTable, GroupJoinRequestsColumn),
+	)
+}
 func newReportsMadeStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -1091,6 +1091,213 @@ func HasBlockedByRelWith(preds ...predicate.UserBlock) predicate.User {
 	})
 }
 
+// HasMessageReactions applies the HasEdge predicate on the "message_reactions" edge.
+func HasMessageReactions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MessageReactionsTable, MessageReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMessageReactionsWith applies the HasEdge predicate on the "message_reactions" edge with a given conditions (other predicates).
+func HasMessageReactionsWith(preds ...predicate.MessageReaction) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMessageReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPinnedMessages applies the HasEdge predicate on the "pinned_messages" edge.
+func HasPinnedMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PinnedMessagesTable, PinnedMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedMessagesWith applies the HasEdge predicate on the "pinned_messages" edge with a given conditions (other predicates).
+func HasPinnedMessagesWith(preds ...predicate.PinnedMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPinnedMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScheduledMessages applies the HasEdge predicate on the "scheduled_messages" edge.
+func HasScheduledMessages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ScheduledMessagesTable, ScheduledMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduledMessagesWith applies the HasEdge predicate on the "scheduled_messages" edge with a given conditions (other predicates).
+func HasScheduledMessagesWith(preds ...predicate.ScheduledMessage) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newScheduledMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentions applies the HasEdge predicate on the "mentions" edge.
+func HasMentions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MentionsTable, MentionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionsWith applies the HasEdge predicate on the "mentions" edge with a given conditions (other predicates).
+func HasMentionsWith(preds ...predicate.MessageMention) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMentionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPollVotes applies the HasEdge predicate on the "poll_votes" edge.
+func HasPollVotes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PollVotesTable, PollVotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollVotesWith applies the HasEdge predicate on the "poll_votes" edge with a given conditions (other predicates).
+func HasPollVotesWith(preds ...predicate.PollVote) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPollVotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChatExports applies the HasEdge predicate on the "chat_exports" edge.
+func HasChatExports() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatExportsTable, ChatExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatExportsWith applies the HasEdge predicate on the "chat_exports" edge with a given conditions (other predicates).
+func HasChatExportsWith(preds ...predicate.ChatExport) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newChatExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPinnedChats applies the HasEdge predicate on the "pinned_chats" edge.
+func HasPinnedChats() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PinnedChatsTable, PinnedChatsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPinnedChatsWith applies the HasEdge predicate on the "pinned_chats" edge with a given conditions (other predicates).
+func HasPinnedChatsWith(preds ...predicate.PinnedChat) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPinnedChatsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChatFolders applies the HasEdge predicate on the "chat_folders" edge.
+func HasChatFolders() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatFoldersTable, ChatFoldersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatFoldersWith applies the HasEdge predicate on the "chat_folders" edge with a given conditions (other predicates).
+func HasChatFoldersWith(preds ...predicate.ChatFolder) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newChatFoldersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroupJoinRequests applies the HasEdge predicate on the "group_join_requests" edge.
+func HasGroupJoinRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GroupJoinRequestsTable, GroupJoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupJoinRequestsWith applies the HasEdge predicate on the "group_join_requests" edge with a given conditions (other predicates).
+func HasGroupJoinRequestsWith(preds ...predicate.GroupJoinRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGroupJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasReportsMade applies the HasEdge predicate on the "reports_made" edge.
 func HasReportsMade() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
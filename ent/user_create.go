@@ -3,12 +3,21 @@
 package ent
 
 import (
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/ent/useridentity"
@@ -382,6 +391,141 @@ func (_c *UserCreate) AddBlockedByRel(v ...*UserBlock) *UserCreate {
 	return _c.AddBlockedByRelIDs(ids...)
 }
 
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (_c *UserCreate) AddMessageReactionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddMessageReactionIDs(ids...)
+	return _c
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (_c *UserCreate) AddMessageReactions(v ...*MessageReaction) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMessageReactionIDs(ids...)
+}
+
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (_c *UserCreate) AddPinnedMessageIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPinnedMessageIDs(ids...)
+	return _c
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (_c *UserCreate) AddPinnedMessages(v ...*PinnedMessage) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPinnedMessageIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (_c *UserCreate) AddScheduledMessageIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddScheduledMessageIDs(ids...)
+	return _c
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (_c *UserCreate) AddScheduledMessages(v ...*ScheduledMessage) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScheduledMessageIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (_c *UserCreate) AddMentionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddMentionIDs(ids...)
+	return _c
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (_c *UserCreate) AddMentions(v ...*MessageMention) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionIDs(ids...)
+}
+
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by IDs.
+func (_c *UserCreate) AddPollVoteIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPollVoteIDs(ids...)
+	return _c
+}
+
+// AddPollVotes adds the "poll_votes" edges to the PollVote entity.
+func (_c *UserCreate) AddPollVotes(v ...*PollVote) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPollVoteIDs(ids...)
+}
+
+// AddChatExportIDs adds the "chat_exports" edge to the ChatExport entity by IDs.
+func (_c *UserCreate) AddChatExportIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddChatExportIDs(ids...)
+	return _c
+}
+
+// AddChatExports adds the "chat_exports" edges to the ChatExport entity.
+func (_c *UserCreate) AddChatExports(v ...*ChatExport) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChatExportIDs(ids...)
+}
+
+// AddPinnedChatIDs adds the "pinned_chats" edge to the PinnedChat entity by IDs.
+func (_c *UserCreate) AddPinnedChatIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddPinnedChatIDs(ids...)
+	return _c
+}
+
+// AddPinnedChats adds the "pinned_chats" edges to the PinnedChat entity.
+func (_c *UserCreate) AddPinnedChats(v ...*PinnedChat) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPinnedChatIDs(ids...)
+}
+
+// AddChatFolderIDs adds the "chat_folders" edge to the ChatFolder entity by IDs.
+func (_c *UserCreate) AddChatFolderIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddChatFolderIDs(ids...)
+	return _c
+}
+
+// AddChatFolders adds the "chat_folders" edges to the ChatFolder entity.
+func (_c *UserCreate) AddChatFolders(v ...*ChatFolder) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChatFolderIDs(ids...)
+}
+
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (_c *UserCreate) AddGroupJoinRequestIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddGroupJoinRequestIDs(ids...)
+	return _c
+}
+
+// AddGroupJoinRequests adds the "group_join_requests" edges to the GroupJoinRequest entity.
+func (_c *UserCreate) AddGroupJoinRequests(v ...*GroupJoinRequest) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGroupJoinRequestIDs(ids...)
+}
+
 // AddReportsMadeIDs adds the "reports_made" edge to the Report entity by IDs.
 func (_c *UserCreate) AddReportsMadeIDs(ids ...uuid.UUID) *UserCreate {
 	_c.mutation.AddReportsMadeIDs(ids...)
@@ -762,6 +906,150 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MessageReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PollVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PinnedChatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChatFoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GroupJoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ReportsMadeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
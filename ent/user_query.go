@@ -3,13 +3,22 @@
 package ent
 
 import (
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/ent/useridentity"
@@ -43,6 +52,15 @@ type UserQuery struct {
 	withUploadedMedia       *MediaQuery
 	withBlockedUsersRel     *UserBlockQuery
 	withBlockedByRel        *UserBlockQuery
+	withMessageReactions    *MessageReactionQuery
+	withPinnedMessages      *PinnedMessageQuery
+	withScheduledMessages   *ScheduledMessageQuery
+	withMentions            *MessageMentionQuery
+	withPollVotes           *PollVoteQuery
+	withChatExports         *ChatExportQuery
+	withPinnedChats         *PinnedChatQuery
+	withChatFolders         *ChatFolderQuery
+	withGroupJoinRequests   *GroupJoinRequestQuery
 	withReportsMade         *ReportQuery
 	withReportsReceived     *ReportQuery
 	modifiers               []func(*sql.Selector)
@@ -302,6 +320,204 @@ func (_q *UserQuery) QueryBlockedByRel() *UserBlockQuery {
 	return query
 }
 
+// QueryMessageReactions chains the current query on the "message_reactions" edge.
+func (_q *UserQuery) QueryMessageReactions() *MessageReactionQuery {
+	query := (&MessageReactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(messagereaction.Table, messagereaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MessageReactionsTable, user.MessageReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPinnedMessages chains the current query on the "pinned_messages" edge.
+func (_q *UserQuery) QueryPinnedMessages() *PinnedMessageQuery {
+	query := (&PinnedMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(pinnedmessage.Table, pinnedmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PinnedMessagesTable, user.PinnedMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScheduledMessages chains the current query on the "scheduled_messages" edge.
+func (_q *UserQuery) QueryScheduledMessages() *ScheduledMessageQuery {
+	query := (&ScheduledMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(scheduledmessage.Table, scheduledmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ScheduledMessagesTable, user.ScheduledMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentions chains the current query on the "mentions" edge.
+func (_q *UserQuery) QueryMentions() *MessageMentionQuery {
+	query := (&MessageMentionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(messagemention.Table, messagemention.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MentionsTable, user.MentionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPollVotes chains the current query on the "poll_votes" edge.
+func (_q *UserQuery) QueryPollVotes() *PollVoteQuery {
+	query := (&PollVoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(pollvote.Table, pollvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PollVotesTable, user.PollVotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChatExports chains the current query on the "chat_exports" edge.
+func (_q *UserQuery) QueryChatExports() *ChatExportQuery {
+	query := (&ChatExportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(chatexport.Table, chatexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChatExportsTable, user.ChatExportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPinnedChats chains the current query on the "pinned_chats" edge.
+func (_q *UserQuery) QueryPinnedChats() *PinnedChatQuery {
+	query := (&PinnedChatClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(pinnedchat.Table, pinnedchat.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PinnedChatsTable, user.PinnedChatsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChatFolders chains the current query on the "chat_folders" edge.
+func (_q *UserQuery) QueryChatFolders() *ChatFolderQuery {
+	query := (&ChatFolderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(chatfolder.Table, chatfolder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChatFoldersTable, user.ChatFoldersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroupJoinRequests chains the current query on the "group_join_requests" edge.
+func (_q *UserQuery) QueryGroupJoinRequests() *GroupJoinRequestQuery {
+	query := (&GroupJoinRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(groupjoinrequest.Table, groupjoinrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GroupJoinRequestsTable, user.GroupJoinRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryReportsMade chains the current query on the "reports_made" edge.
 func (_q *UserQuery) QueryReportsMade() *ReportQuery {
 	query := (&ReportClient{config: _q.config}).Query()
@@ -548,6 +764,15 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withUploadedMedia:       _q.withUploadedMedia.Clone(),
 		withBlockedUsersRel:     _q.withBlockedUsersRel.Clone(),
 		withBlockedByRel:        _q.withBlockedByRel.Clone(),
+		withMessageReactions:    _q.withMessageReactions.Clone(),
+		withPinnedMessages:      _q.withPinnedMessages.Clone(),
+		withScheduledMessages:   _q.withScheduledMessages.Clone(),
+		withMentions:            _q.withMentions.Clone(),
+		withPollVotes:           _q.withPollVotes.Clone(),
+		withChatExports:         _q.withChatExports.Clone(),
+		withPinnedChats:         _q.withPinnedChats.Clone(),
+		withChatFolders:         _q.withChatFolders.Clone(),
+		withGroupJoinRequests:   _q.withGroupJoinRequests.Clone(),
 		withReportsMade:         _q.withReportsMade.Clone(),
 		withReportsReceived:     _q.withReportsReceived.Clone(),
 		// clone intermediate query.
@@ -667,6 +892,105 @@ func (_q *UserQuery) WithBlockedByRel(opts ...func(*UserBlockQuery)) *UserQuery
 	return _q
 }
 
+// WithMessageReactions tells the query-builder to eager-load the nodes that are connected to
+// the "message_reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithMessageReactions(opts ...func(*MessageReactionQuery)) *UserQuery {
+	query := (&MessageReactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMessageReactions = query
+	return _q
+}
+
+// WithPinnedMessages tells the query-builder to eager-load the nodes that are connected to
+// the "pinned_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPinnedMessages(opts ...func(*PinnedMessageQuery)) *UserQuery {
+	query := (&PinnedMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPinnedMessages = query
+	return _q
+}
+
+// WithScheduledMessages tells the query-builder to eager-load the nodes that are connected to
+// the "scheduled_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithScheduledMessages(opts ...func(*ScheduledMessageQuery)) *UserQuery {
+	query := (&ScheduledMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScheduledMessages = query
+	return _q
+}
+
+// WithMentions tells the query-builder to eager-load the nodes that are connected to
+// the "mentions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithMentions(opts ...func(*MessageMentionQuery)) *UserQuery {
+	query := (&MessageMentionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentions = query
+	return _q
+}
+
+// WithPollVotes tells the query-builder to eager-load the nodes that are connected to
+// the "poll_votes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPollVotes(opts ...func(*PollVoteQuery)) *UserQuery {
+	query := (&PollVoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPollVotes = query
+	return _q
+}
+
+// WithChatExports tells the query-builder to eager-load the nodes that are connected to
+// the "chat_exports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithChatExports(opts ...func(*ChatExportQuery)) *UserQuery {
+	query := (&ChatExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChatExports = query
+	return _q
+}
+
+// WithPinnedChats tells the query-builder to eager-load the nodes that are connected to
+// the "pinned_chats" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPinnedChats(opts ...func(*PinnedChatQuery)) *UserQuery {
+	query := (&PinnedChatClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPinnedChats = query
+	return _q
+}
+
+// WithChatFolders tells the query-builder to eager-load the nodes that are connected to
+// the "chat_folders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithChatFolders(opts ...func(*ChatFolderQuery)) *UserQuery {
+	query := (&ChatFolderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChatFolders = query
+	return _q
+}
+
+// WithGroupJoinRequests tells the query-builder to eager-load the nodes that are connected to
+// the "group_join_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithGroupJoinRequests(opts ...func(*GroupJoinRequestQuery)) *UserQuery {
+	query := (&GroupJoinRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroupJoinRequests = query
+	return _q
+}
+
 // WithReportsMade tells the query-builder to eager-load the nodes that are connected to
 // the "reports_made" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithReportsMade(opts ...func(*ReportQuery)) *UserQuery {
@@ -767,7 +1091,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [12]bool{
+		loadedTypes = [21]bool{
 			_q.withAvatar != nil,
 			_q.withIdentities != nil,
 			_q.withSentMessages != nil,
@@ -778,6 +1102,15 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withUploadedMedia != nil,
 			_q.withBlockedUsersRel != nil,
 			_q.withBlockedByRel != nil,
+			_q.withMessageReactions != nil,
+			_q.withPinnedMessages != nil,
+			_q.withScheduledMessages != nil,
+			_q.withMentions != nil,
+			_q.withPollVotes != nil,
+			_q.withChatExports != nil,
+			_q.withPinnedChats != nil,
+			_q.withChatFolders != nil,
+			_q.withGroupJoinRequests != nil,
 			_q.withReportsMade != nil,
 			_q.withReportsReceived != nil,
 		}
@@ -872,6 +1205,69 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withMessageReactions; query != nil {
+		if err := _q.loadMessageReactions(ctx, query, nodes,
+			func(n *User) { n.Edges.MessageReactions = []*MessageReaction{} },
+			func(n *User, e *MessageReaction) { n.Edges.MessageReactions = append(n.Edges.MessageReactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPinnedMessages; query != nil {
+		if err := _q.loadPinnedMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.PinnedMessages = []*PinnedMessage{} },
+			func(n *User, e *PinnedMessage) { n.Edges.PinnedMessages = append(n.Edges.PinnedMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withScheduledMessages; query != nil {
+		if err := _q.loadScheduledMessages(ctx, query, nodes,
+			func(n *User) { n.Edges.ScheduledMessages = []*ScheduledMessage{} },
+			func(n *User, e *ScheduledMessage) { n.Edges.ScheduledMessages = append(n.Edges.ScheduledMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMentions; query != nil {
+		if err := _q.loadMentions(ctx, query, nodes,
+			func(n *User) { n.Edges.Mentions = []*MessageMention{} },
+			func(n *User, e *MessageMention) { n.Edges.Mentions = append(n.Edges.Mentions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPollVotes; query != nil {
+		if err := _q.loadPollVotes(ctx, query, nodes,
+			func(n *User) { n.Edges.PollVotes = []*PollVote{} },
+			func(n *User, e *PollVote) { n.Edges.PollVotes = append(n.Edges.PollVotes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChatExports; query != nil {
+		if err := _q.loadChatExports(ctx, query, nodes,
+			func(n *User) { n.Edges.ChatExports = []*ChatExport{} },
+			func(n *User, e *ChatExport) { n.Edges.ChatExports = append(n.Edges.ChatExports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPinnedChats; query != nil {
+		if err := _q.loadPinnedChats(ctx, query, nodes,
+			func(n *User) { n.Edges.PinnedChats = []*PinnedChat{} },
+			func(n *User, e *PinnedChat) { n.Edges.PinnedChats = append(n.Edges.PinnedChats, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChatFolders; query != nil {
+		if err := _q.loadChatFolders(ctx, query, nodes,
+			func(n *User) { n.Edges.ChatFolders = []*ChatFolder{} },
+			func(n *User, e *ChatFolder) { n.Edges.ChatFolders = append(n.Edges.ChatFolders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroupJoinRequests; query != nil {
+		if err := _q.loadGroupJoinRequests(ctx, query, nodes,
+			func(n *User) { n.Edges.GroupJoinRequests = []*GroupJoinRequest{} },
+			func(n *User, e *GroupJoinRequest) { n.Edges.GroupJoinRequests = append(n.Edges.GroupJoinRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withReportsMade; query != nil {
 		if err := _q.loadReportsMade(ctx, query, nodes,
 			func(n *User) { n.Edges.ReportsMade = []*Report{} },
@@ -1206,6 +1602,282 @@ func (_q *UserQuery) loadBlockedByRel(ctx context.Context, query *UserBlockQuery
 	}
 	return nil
 }
+func (_q *UserQuery) loadMessageReactions(ctx context.Context, query *MessageReactionQuery, nodes []*User, init func(*User), assign func(*User, *MessageReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagereaction.FieldUserID)
+	}
+	query.Where(predicate.MessageReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MessageReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPinnedMessages(ctx context.Context, query *PinnedMessageQuery, nodes []*User, init func(*User), assign func(*User, *PinnedMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedmessage.FieldPinnedByID)
+	}
+	query.Where(predicate.PinnedMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PinnedMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PinnedByID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "pinned_by_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "pinned_by_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadScheduledMessages(ctx context.Context, query *ScheduledMessageQuery, nodes []*User, init func(*User), assign func(*User, *ScheduledMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(scheduledmessage.FieldSenderID)
+	}
+	query.Where(predicate.ScheduledMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ScheduledMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SenderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sender_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadMentions(ctx context.Context, query *MessageMentionQuery, nodes []*User, init func(*User), assign func(*User, *MessageMention)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(messagemention.FieldUserID)
+	}
+	query.Where(predicate.MessageMention(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MentionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPollVotes(ctx context.Context, query *PollVoteQuery, nodes []*User, init func(*User), assign func(*User, *PollVote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pollvote.FieldUserID)
+	}
+	query.Where(predicate.PollVote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PollVotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadChatExports(ctx context.Context, query *ChatExportQuery, nodes []*User, init func(*User), assign func(*User, *ChatExport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(chatexport.FieldUserID)
+	}
+	query.Where(predicate.ChatExport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ChatExportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPinnedChats(ctx context.Context, query *PinnedChatQuery, nodes []*User, init func(*User), assign func(*User, *PinnedChat)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pinnedchat.FieldUserID)
+	}
+	query.Where(predicate.PinnedChat(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PinnedChatsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadChatFolders(ctx context.Context, query *ChatFolderQuery, nodes []*User, init func(*User), assign func(*User, *ChatFolder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(chatfolder.FieldUserID)
+	}
+	query.Where(predicate.ChatFolder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ChatFoldersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadGroupJoinRequests(ctx context.Context, query *GroupJoinRequestQuery, nodes []*User, init func(*User), assign func(*User, *GroupJoinRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(groupjoinrequest.FieldUserID)
+	}
+	query.Where(predicate.GroupJoinRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GroupJoinRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *UserQuery) loadReportsMade(ctx context.Context, query *ReportQuery, nodes []*User, init func(*User), assign func(*User, *Report)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
@@ -3,13 +3,22 @@
 package ent
 
 import (
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/chatfolder"
 	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/pollvote"
 	"AtoiTalkAPI/ent/predicate"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/scheduledmessage"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/ent/useridentity"
@@ -412,6 +421,141 @@ func (_u *UserUpdate) AddBlockedByRel(v ...*UserBlock) *UserUpdate {
 	return _u.AddBlockedByRelIDs(ids...)
 }
 
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (_u *UserUpdate) AddMessageReactionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddMessageReactionIDs(ids...)
+	return _u
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (_u *UserUpdate) AddMessageReactions(v ...*MessageReaction) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageReactionIDs(ids...)
+}
+
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (_u *UserUpdate) AddPinnedMessageIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddPinnedMessageIDs(ids...)
+	return _u
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (_u *UserUpdate) AddPinnedMessages(v ...*PinnedMessage) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedMessageIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (_u *UserUpdate) AddScheduledMessageIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddScheduledMessageIDs(ids...)
+	return _u
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *UserUpdate) AddScheduledMessages(v ...*ScheduledMessage) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledMessageIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (_u *UserUpdate) AddMentionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (_u *UserUpdate) AddMentions(v ...*MessageMention) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by IDs.
+func (_u *UserUpdate) AddPollVoteIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddPollVoteIDs(ids...)
+	return _u
+}
+
+// AddPollVotes adds the "poll_votes" edges to the PollVote entity.
+func (_u *UserUpdate) AddPollVotes(v ...*PollVote) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPollVoteIDs(ids...)
+}
+
+// AddChatExportIDs adds the "chat_exports" edge to the ChatExport entity by IDs.
+func (_u *UserUpdate) AddChatExportIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddChatExportIDs(ids...)
+	return _u
+}
+
+// AddChatExports adds the "chat_exports" edges to the ChatExport entity.
+func (_u *UserUpdate) AddChatExports(v ...*ChatExport) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatExportIDs(ids...)
+}
+
+// AddPinnedChatIDs adds the "pinned_chats" edge to the PinnedChat entity by IDs.
+func (_u *UserUpdate) AddPinnedChatIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddPinnedChatIDs(ids...)
+	return _u
+}
+
+// AddPinnedChats adds the "pinned_chats" edges to the PinnedChat entity.
+func (_u *UserUpdate) AddPinnedChats(v ...*PinnedChat) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedChatIDs(ids...)
+}
+
+// AddChatFolderIDs adds the "chat_folders" edge to the ChatFolder entity by IDs.
+func (_u *UserUpdate) AddChatFolderIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddChatFolderIDs(ids...)
+	return _u
+}
+
+// AddChatFolders adds the "chat_folders" edges to the ChatFolder entity.
+func (_u *UserUpdate) AddChatFolders(v ...*ChatFolder) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatFolderIDs(ids...)
+}
+
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (_u *UserUpdate) AddGroupJoinRequestIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddGroupJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddGroupJoinRequests adds the "group_join_requests" edges to the GroupJoinRequest entity.
+func (_u *UserUpdate) AddGroupJoinRequests(v ...*GroupJoinRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupJoinRequestIDs(ids...)
+}
+
 // AddReportsMadeIDs adds the "reports_made" edge to the Report entity by IDs.
 func (_u *UserUpdate) AddReportsMadeIDs(ids ...uuid.UUID) *UserUpdate {
 	_u.mutation.AddReportsMadeIDs(ids...)
@@ -642,6 +786,195 @@ func (_u *UserUpdate) RemoveBlockedByRel(v ...*UserBlock) *UserUpdate {
 	return _u.RemoveBlockedByRelIDs(ids...)
 }
 
+// ClearMessageReactions clears all "message_reactions" edges to the MessageReaction entity.
+func (_u *UserUpdate) ClearMessageReactions() *UserUpdate {
+	_u.mutation.ClearMessageReactions()
+	return _u
+}
+
+// RemoveMessageReactionIDs removes the "message_reactions" edge to MessageReaction entities by IDs.
+func (_u *UserUpdate) RemoveMessageReactionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveMessageReactionIDs(ids...)
+	return _u
+}
+
+// RemoveMessageReactions removes "message_reactions" edges to MessageReaction entities.
+func (_u *UserUpdate) RemoveMessageReactions(v ...*MessageReaction) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageReactionIDs(ids...)
+}
+
+// ClearPinnedMessages clears all "pinned_messages" edges to the PinnedMessage entity.
+func (_u *UserUpdate) ClearPinnedMessages() *UserUpdate {
+	_u.mutation.ClearPinnedMessages()
+	return _u
+}
+
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to PinnedMessage entities by IDs.
+func (_u *UserUpdate) RemovePinnedMessageIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemovePinnedMessageIDs(ids...)
+	return _u
+}
+
+// RemovePinnedMessages removes "pinned_messages" edges to PinnedMessage entities.
+func (_u *UserUpdate) RemovePinnedMessages(v ...*PinnedMessage) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedMessageIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *UserUpdate) ClearScheduledMessages() *UserUpdate {
+	_u.mutation.ClearScheduledMessages()
+	return _u
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (_u *UserUpdate) RemoveScheduledMessageIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveScheduledMessageIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (_u *UserUpdate) RemoveScheduledMessages(v ...*ScheduledMessage) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (_u *UserUpdate) ClearMentions() *UserUpdate {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (_u *UserUpdate) RemoveMentionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (_u *UserUpdate) RemoveMentions(v ...*MessageMention) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// ClearPollVotes clears all "poll_votes" edges to the PollVote entity.
+func (_u *UserUpdate) ClearPollVotes() *UserUpdate {
+	_u.mutation.ClearPollVotes()
+	return _u
+}
+
+// RemovePollVoteIDs removes the "poll_votes" edge to PollVote entities by IDs.
+func (_u *UserUpdate) RemovePollVoteIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemovePollVoteIDs(ids...)
+	return _u
+}
+
+// RemovePollVotes removes "poll_votes" edges to PollVote entities.
+func (_u *UserUpdate) RemovePollVotes(v ...*PollVote) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePollVoteIDs(ids...)
+}
+
+// ClearChatExports clears all "chat_exports" edges to the ChatExport entity.
+func (_u *UserUpdate) ClearChatExports() *UserUpdate {
+	_u.mutation.ClearChatExports()
+	return _u
+}
+
+// RemoveChatExportIDs removes the "chat_exports" edge to ChatExport entities by IDs.
+func (_u *UserUpdate) RemoveChatExportIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveChatExportIDs(ids...)
+	return _u
+}
+
+// RemoveChatExports removes "chat_exports" edges to ChatExport entities.
+func (_u *UserUpdate) RemoveChatExports(v ...*ChatExport) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatExportIDs(ids...)
+}
+
+// ClearPinnedChats clears all "pinned_chats" edges to the PinnedChat entity.
+func (_u *UserUpdate) ClearPinnedChats() *UserUpdate {
+	_u.mutation.ClearPinnedChats()
+	return _u
+}
+
+// RemovePinnedChatIDs removes the "pinned_chats" edge to PinnedChat entities by IDs.
+func (_u *UserUpdate) RemovePinnedChatIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemovePinnedChatIDs(ids...)
+	return _u
+}
+
+// RemovePinnedChats removes "pinned_chats" edges to PinnedChat entities.
+func (_u *UserUpdate) RemovePinnedChats(v ...*PinnedChat) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedChatIDs(ids...)
+}
+
+// ClearChatFolders clears all "chat_folders" edges to the ChatFolder entity.
+func (_u *UserUpdate) ClearChatFolders() *UserUpdate {
+	_u.mutation.ClearChatFolders()
+	return _u
+}
+
+// RemoveChatFolderIDs removes the "chat_folders" edge to ChatFolder entities by IDs.
+func (_u *UserUpdate) RemoveChatFolderIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveChatFolderIDs(ids...)
+	return _u
+}
+
+// RemoveChatFolders removes "chat_folders" edges to ChatFolder entities.
+func (_u *UserUpdate) RemoveChatFolders(v ...*ChatFolder) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChatFolderIDs(ids...)
+}
+
+// ClearGroupJoinRequests clears all "group_join_requests" edges to the GroupJoinRequest entity.
+func (_u *UserUpdate) ClearGroupJoinRequests() *UserUpdate {
+	_u.mutation.ClearGroupJoinRequests()
+	return _u
+}
+
+// RemoveGroupJoinRequestIDs removes the "group_join_requests" edge to GroupJoinRequest entities by IDs.
+func (_u *UserUpdate) RemoveGroupJoinRequestIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveGroupJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveGroupJoinRequests removes "group_join_requests" edges to GroupJoinRequest entities.
+func (_u *UserUpdate) RemoveGroupJoinRequests(v ...*GroupJoinRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupJoinRequestIDs(ids...)
+}
+
 // ClearReportsMade clears all "reports_made" edges to the Report entity.
 func (_u *UserUpdate) ClearReportsMade() *UserUpdate {
 	_u.mutation.ClearReportsMade()
@@ -1270,28 +1603,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsMadeCleared() {
+	if _u.mutation.MessageReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsMadeTable,
-			Columns: []string{user.ReportsMadeColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsMadeIDs(); len(nodes) > 0 && !_u.mutation.ReportsMadeCleared() {
+	if nodes := _u.mutation.RemovedMessageReactionsIDs(); len(nodes) > 0 && !_u.mutation.MessageReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsMadeTable,
-			Columns: []string{user.ReportsMadeColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1299,15 +1632,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsMadeIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MessageReactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsMadeTable,
-			Columns: []string{user.ReportsMadeColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1315,28 +1648,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReportsReceivedCleared() {
+	if _u.mutation.PinnedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsReceivedTable,
-			Columns: []string{user.ReportsReceivedColumn},
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !_u.mutation.ReportsReceivedCleared() {
+	if nodes := _u.mutation.RemovedPinnedMessagesIDs(); len(nodes) > 0 && !_u.mutation.PinnedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsReceivedTable,
-			Columns: []string{user.ReportsReceivedColumn},
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1344,15 +1677,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReportsReceivedTable,
-			Columns: []string{user.ReportsReceivedColumn},
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1360,44 +1693,449 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *UserMutation
-	modifiers []func(*sql.UpdateBuilder)
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+	if nodes := _u.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !_u.mutation.ScheduledMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PollVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPollVotesIDs(); len(nodes) > 0 && !_u.mutation.PollVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PollVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatExportsIDs(); len(nodes) > 0 && !_u.mutation.ChatExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PinnedChatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPinnedChatsIDs(); len(nodes) > 0 && !_u.mutation.PinnedChatsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PinnedChatsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChatFoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChatFoldersIDs(); len(nodes) > 0 && !_u.mutation.ChatFoldersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChatFoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupJoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.GroupJoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupJoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportsMadeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsMadeTable,
+			Columns: []string{user.ReportsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportsMadeIDs(); len(nodes) > 0 && !_u.mutation.ReportsMadeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsMadeTable,
+			Columns: []string{user.ReportsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportsMadeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsMadeTable,
+			Columns: []string{user.ReportsMadeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReportsReceivedIDs(); len(nodes) > 0 && !_u.mutation.ReportsReceivedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReportsReceivedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReportsReceivedTable,
+			Columns: []string{user.ReportsReceivedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(report.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
 	}
 	return _u
 }
@@ -1756,6 +2494,141 @@ func (_u *UserUpdateOne) AddBlockedByRel(v ...*UserBlock) *UserUpdateOne {
 	return _u.AddBlockedByRelIDs(ids...)
 }
 
+// AddMessageReactionIDs adds the "message_reactions" edge to the MessageReaction entity by IDs.
+func (_u *UserUpdateOne) AddMessageReactionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddMessageReactionIDs(ids...)
+	return _u
+}
+
+// AddMessageReactions adds the "message_reactions" edges to the MessageReaction entity.
+func (_u *UserUpdateOne) AddMessageReactions(v ...*MessageReaction) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageReactionIDs(ids...)
+}
+
+// AddPinnedMessageIDs adds the "pinned_messages" edge to the PinnedMessage entity by IDs.
+func (_u *UserUpdateOne) AddPinnedMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddPinnedMessageIDs(ids...)
+	return _u
+}
+
+// AddPinnedMessages adds the "pinned_messages" edges to the PinnedMessage entity.
+func (_u *UserUpdateOne) AddPinnedMessages(v ...*PinnedMessage) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedMessageIDs(ids...)
+}
+
+// AddScheduledMessageIDs adds the "scheduled_messages" edge to the ScheduledMessage entity by IDs.
+func (_u *UserUpdateOne) AddScheduledMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddScheduledMessageIDs(ids...)
+	return _u
+}
+
+// AddScheduledMessages adds the "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *UserUpdateOne) AddScheduledMessages(v ...*ScheduledMessage) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScheduledMessageIDs(ids...)
+}
+
+// AddMentionIDs adds the "mentions" edge to the MessageMention entity by IDs.
+func (_u *UserUpdateOne) AddMentionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddMentionIDs(ids...)
+	return _u
+}
+
+// AddMentions adds the "mentions" edges to the MessageMention entity.
+func (_u *UserUpdateOne) AddMentions(v ...*MessageMention) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionIDs(ids...)
+}
+
+// AddPollVoteIDs adds the "poll_votes" edge to the PollVote entity by IDs.
+func (_u *UserUpdateOne) AddPollVoteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddPollVoteIDs(ids...)
+	return _u
+}
+
+// AddPollVotes adds the "poll_votes" edges to the PollVote entity.
+func (_u *UserUpdateOne) AddPollVotes(v ...*PollVote) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPollVoteIDs(ids...)
+}
+
+// AddChatExportIDs adds the "chat_exports" edge to the ChatExport entity by IDs.
+func (_u *UserUpdateOne) AddChatExportIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddChatExportIDs(ids...)
+	return _u
+}
+
+// AddChatExports adds the "chat_exports" edges to the ChatExport entity.
+func (_u *UserUpdateOne) AddChatExports(v ...*ChatExport) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatExportIDs(ids...)
+}
+
+// AddPinnedChatIDs adds the "pinned_chats" edge to the PinnedChat entity by IDs.
+func (_u *UserUpdateOne) AddPinnedChatIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddPinnedChatIDs(ids...)
+	return _u
+}
+
+// AddPinnedChats adds the "pinned_chats" edges to the PinnedChat entity.
+func (_u *UserUpdateOne) AddPinnedChats(v ...*PinnedChat) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPinnedChatIDs(ids...)
+}
+
+// AddChatFolderIDs adds the "chat_folders" edge to the ChatFolder entity by IDs.
+func (_u *UserUpdateOne) AddChatFolderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddChatFolderIDs(ids...)
+	return _u
+}
+
+// AddChatFolders adds the "chat_folders" edges to the ChatFolder entity.
+func (_u *UserUpdateOne) AddChatFolders(v ...*ChatFolder) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChatFolderIDs(ids...)
+}
+
+// AddGroupJoinRequestIDs adds the "group_join_requests" edge to the GroupJoinRequest entity by IDs.
+func (_u *UserUpdateOne) AddGroupJoinRequestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddGroupJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddGroupJoinRequests adds the "group_join_requests" edges to the GroupJoinRequest entity.
+func (_u *UserUpdateOne) AddGroupJoinRequests(v ...*GroupJoinRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupJoinRequestIDs(ids...)
+}
+
 // AddReportsMadeIDs adds the "reports_made" edge to the Report entity by IDs.
 func (_u *UserUpdateOne) AddReportsMadeIDs(ids ...uuid.UUID) *UserUpdateOne {
 	_u.mutation.AddReportsMadeIDs(ids...)
@@ -1908,82 +2781,271 @@ func (_u *UserUpdateOne) ClearPrivateChatsAsUser2() *UserUpdateOne {
 	return _u
 }
 
-// RemovePrivateChatsAsUser2IDs removes the "private_chats_as_user2" edge to PrivateChat entities by IDs.
-func (_u *UserUpdateOne) RemovePrivateChatsAsUser2IDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemovePrivateChatsAsUser2IDs(ids...)
+// RemovePrivateChatsAsUser2IDs removes the "private_chats_as_user2" edge to PrivateChat entities by IDs.
+func (_u *UserUpdateOne) RemovePrivateChatsAsUser2IDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePrivateChatsAsUser2IDs(ids...)
+	return _u
+}
+
+// RemovePrivateChatsAsUser2 removes "private_chats_as_user2" edges to PrivateChat entities.
+func (_u *UserUpdateOne) RemovePrivateChatsAsUser2(v ...*PrivateChat) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrivateChatsAsUser2IDs(ids...)
+}
+
+// ClearUploadedMedia clears all "uploaded_media" edges to the Media entity.
+func (_u *UserUpdateOne) ClearUploadedMedia() *UserUpdateOne {
+	_u.mutation.ClearUploadedMedia()
+	return _u
+}
+
+// RemoveUploadedMediumIDs removes the "uploaded_media" edge to Media entities by IDs.
+func (_u *UserUpdateOne) RemoveUploadedMediumIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveUploadedMediumIDs(ids...)
+	return _u
+}
+
+// RemoveUploadedMedia removes "uploaded_media" edges to Media entities.
+func (_u *UserUpdateOne) RemoveUploadedMedia(v ...*Media) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadedMediumIDs(ids...)
+}
+
+// ClearBlockedUsersRel clears all "blocked_users_rel" edges to the UserBlock entity.
+func (_u *UserUpdateOne) ClearBlockedUsersRel() *UserUpdateOne {
+	_u.mutation.ClearBlockedUsersRel()
+	return _u
+}
+
+// RemoveBlockedUsersRelIDs removes the "blocked_users_rel" edge to UserBlock entities by IDs.
+func (_u *UserUpdateOne) RemoveBlockedUsersRelIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBlockedUsersRelIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedUsersRel removes "blocked_users_rel" edges to UserBlock entities.
+func (_u *UserUpdateOne) RemoveBlockedUsersRel(v ...*UserBlock) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedUsersRelIDs(ids...)
+}
+
+// ClearBlockedByRel clears all "blocked_by_rel" edges to the UserBlock entity.
+func (_u *UserUpdateOne) ClearBlockedByRel() *UserUpdateOne {
+	_u.mutation.ClearBlockedByRel()
+	return _u
+}
+
+// RemoveBlockedByRelIDs removes the "blocked_by_rel" edge to UserBlock entities by IDs.
+func (_u *UserUpdateOne) RemoveBlockedByRelIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBlockedByRelIDs(ids...)
+	return _u
+}
+
+// RemoveBlockedByRel removes "blocked_by_rel" edges to UserBlock entities.
+func (_u *UserUpdateOne) RemoveBlockedByRel(v ...*UserBlock) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBlockedByRelIDs(ids...)
+}
+
+// ClearMessageReactions clears all "message_reactions" edges to the MessageReaction entity.
+func (_u *UserUpdateOne) ClearMessageReactions() *UserUpdateOne {
+	_u.mutation.ClearMessageReactions()
+	return _u
+}
+
+// RemoveMessageReactionIDs removes the "message_reactions" edge to MessageReaction entities by IDs.
+func (_u *UserUpdateOne) RemoveMessageReactionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveMessageReactionIDs(ids...)
+	return _u
+}
+
+// RemoveMessageReactions removes "message_reactions" edges to MessageReaction entities.
+func (_u *UserUpdateOne) RemoveMessageReactions(v ...*MessageReaction) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageReactionIDs(ids...)
+}
+
+// ClearPinnedMessages clears all "pinned_messages" edges to the PinnedMessage entity.
+func (_u *UserUpdateOne) ClearPinnedMessages() *UserUpdateOne {
+	_u.mutation.ClearPinnedMessages()
+	return _u
+}
+
+// RemovePinnedMessageIDs removes the "pinned_messages" edge to PinnedMessage entities by IDs.
+func (_u *UserUpdateOne) RemovePinnedMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePinnedMessageIDs(ids...)
+	return _u
+}
+
+// RemovePinnedMessages removes "pinned_messages" edges to PinnedMessage entities.
+func (_u *UserUpdateOne) RemovePinnedMessages(v ...*PinnedMessage) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePinnedMessageIDs(ids...)
+}
+
+// ClearScheduledMessages clears all "scheduled_messages" edges to the ScheduledMessage entity.
+func (_u *UserUpdateOne) ClearScheduledMessages() *UserUpdateOne {
+	_u.mutation.ClearScheduledMessages()
+	return _u
+}
+
+// RemoveScheduledMessageIDs removes the "scheduled_messages" edge to ScheduledMessage entities by IDs.
+func (_u *UserUpdateOne) RemoveScheduledMessageIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveScheduledMessageIDs(ids...)
+	return _u
+}
+
+// RemoveScheduledMessages removes "scheduled_messages" edges to ScheduledMessage entities.
+func (_u *UserUpdateOne) RemoveScheduledMessages(v ...*ScheduledMessage) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScheduledMessageIDs(ids...)
+}
+
+// ClearMentions clears all "mentions" edges to the MessageMention entity.
+func (_u *UserUpdateOne) ClearMentions() *UserUpdateOne {
+	_u.mutation.ClearMentions()
+	return _u
+}
+
+// RemoveMentionIDs removes the "mentions" edge to MessageMention entities by IDs.
+func (_u *UserUpdateOne) RemoveMentionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveMentionIDs(ids...)
+	return _u
+}
+
+// RemoveMentions removes "mentions" edges to MessageMention entities.
+func (_u *UserUpdateOne) RemoveMentions(v ...*MessageMention) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionIDs(ids...)
+}
+
+// ClearPollVotes clears all "poll_votes" edges to the PollVote entity.
+func (_u *UserUpdateOne) ClearPollVotes() *UserUpdateOne {
+	_u.mutation.ClearPollVotes()
+	return _u
+}
+
+// RemovePollVoteIDs removes the "poll_votes" edge to PollVote entities by IDs.
+func (_u *UserUpdateOne) RemovePollVoteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePollVoteIDs(ids...)
+	return _u
+}
+
+// RemovePollVotes removes "poll_votes" edges to PollVote entities.
+func (_u *UserUpdateOne) RemovePollVotes(v ...*PollVote) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePollVoteIDs(ids...)
+}
+
+// ClearChatExports clears all "chat_exports" edges to the ChatExport entity.
+func (_u *UserUpdateOne) ClearChatExports() *UserUpdateOne {
+	_u.mutation.ClearChatExports()
+	return _u
+}
+
+// RemoveChatExportIDs removes the "chat_exports" edge to ChatExport entities by IDs.
+func (_u *UserUpdateOne) RemoveChatExportIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveChatExportIDs(ids...)
 	return _u
 }
 
-// RemovePrivateChatsAsUser2 removes "private_chats_as_user2" edges to PrivateChat entities.
-func (_u *UserUpdateOne) RemovePrivateChatsAsUser2(v ...*PrivateChat) *UserUpdateOne {
+// RemoveChatExports removes "chat_exports" edges to ChatExport entities.
+func (_u *UserUpdateOne) RemoveChatExports(v ...*ChatExport) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePrivateChatsAsUser2IDs(ids...)
+	return _u.RemoveChatExportIDs(ids...)
 }
 
-// ClearUploadedMedia clears all "uploaded_media" edges to the Media entity.
-func (_u *UserUpdateOne) ClearUploadedMedia() *UserUpdateOne {
-	_u.mutation.ClearUploadedMedia()
+// ClearPinnedChats clears all "pinned_chats" edges to the PinnedChat entity.
+func (_u *UserUpdateOne) ClearPinnedChats() *UserUpdateOne {
+	_u.mutation.ClearPinnedChats()
 	return _u
 }
 
-// RemoveUploadedMediumIDs removes the "uploaded_media" edge to Media entities by IDs.
-func (_u *UserUpdateOne) RemoveUploadedMediumIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveUploadedMediumIDs(ids...)
+// RemovePinnedChatIDs removes the "pinned_chats" edge to PinnedChat entities by IDs.
+func (_u *UserUpdateOne) RemovePinnedChatIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemovePinnedChatIDs(ids...)
 	return _u
 }
 
-// RemoveUploadedMedia removes "uploaded_media" edges to Media entities.
-func (_u *UserUpdateOne) RemoveUploadedMedia(v ...*Media) *UserUpdateOne {
+// RemovePinnedChats removes "pinned_chats" edges to PinnedChat entities.
+func (_u *UserUpdateOne) RemovePinnedChats(v ...*PinnedChat) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUploadedMediumIDs(ids...)
+	return _u.RemovePinnedChatIDs(ids...)
 }
 
-// ClearBlockedUsersRel clears all "blocked_users_rel" edges to the UserBlock entity.
-func (_u *UserUpdateOne) ClearBlockedUsersRel() *UserUpdateOne {
-	_u.mutation.ClearBlockedUsersRel()
+// ClearChatFolders clears all "chat_folders" edges to the ChatFolder entity.
+func (_u *UserUpdateOne) ClearChatFolders() *UserUpdateOne {
+	_u.mutation.ClearChatFolders()
 	return _u
 }
 
-// RemoveBlockedUsersRelIDs removes the "blocked_users_rel" edge to UserBlock entities by IDs.
-func (_u *UserUpdateOne) RemoveBlockedUsersRelIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveBlockedUsersRelIDs(ids...)
+// RemoveChatFolderIDs removes the "chat_folders" edge to ChatFolder entities by IDs.
+func (_u *UserUpdateOne) RemoveChatFolderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveChatFolderIDs(ids...)
 	return _u
 }
 
-// RemoveBlockedUsersRel removes "blocked_users_rel" edges to UserBlock entities.
-func (_u *UserUpdateOne) RemoveBlockedUsersRel(v ...*UserBlock) *UserUpdateOne {
+// RemoveChatFolders removes "chat_folders" edges to ChatFolder entities.
+func (_u *UserUpdateOne) RemoveChatFolders(v ...*ChatFolder) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBlockedUsersRelIDs(ids...)
+	return _u.RemoveChatFolderIDs(ids...)
 }
 
-// ClearBlockedByRel clears all "blocked_by_rel" edges to the UserBlock entity.
-func (_u *UserUpdateOne) ClearBlockedByRel() *UserUpdateOne {
-	_u.mutation.ClearBlockedByRel()
+// ClearGroupJoinRequests clears all "group_join_requests" edges to the GroupJoinRequest entity.
+func (_u *UserUpdateOne) ClearGroupJoinRequests() *UserUpdateOne {
+	_u.mutation.ClearGroupJoinRequests()
 	return _u
 }
 
-// RemoveBlockedByRelIDs removes the "blocked_by_rel" edge to UserBlock entities by IDs.
-func (_u *UserUpdateOne) RemoveBlockedByRelIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveBlockedByRelIDs(ids...)
+// RemoveGroupJoinRequestIDs removes the "group_join_requests" edge to GroupJoinRequest entities by IDs.
+func (_u *UserUpdateOne) RemoveGroupJoinRequestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveGroupJoinRequestIDs(ids...)
 	return _u
 }
 
-// RemoveBlockedByRel removes "blocked_by_rel" edges to UserBlock entities.
-func (_u *UserUpdateOne) RemoveBlockedByRel(v ...*UserBlock) *UserUpdateOne {
+// RemoveGroupJoinRequests removes "group_join_requests" edges to GroupJoinRequest entities.
+func (_u *UserUpdateOne) RemoveGroupJoinRequests(v ...*GroupJoinRequest) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBlockedByRelIDs(ids...)
+	return _u.RemoveGroupJoinRequestIDs(ids...)
 }
 
 // ClearReportsMade clears all "reports_made" edges to the Report entity.
@@ -2083,155 +3145,560 @@ func (_u *UserUpdateOne) check() error {
 		if err := user.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
 		}
-	}
-	if v, ok := _u.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+	}
+	if v, ok := _u.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordHash(); ok {
+		if err := user.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FullName(); ok {
+		if err := user.FullNameValidator(v); err != nil {
+			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "User.full_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bio(); ok {
+		if err := user.BioValidator(v); err != nil {
+			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(user.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if _u.mutation.UsernameCleared() {
+		_spec.ClearField(user.FieldUsername, field.TypeString)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	}
+	if _u.mutation.PasswordHashCleared() {
+		_spec.ClearField(user.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.FullName(); ok {
+		_spec.SetField(user.FieldFullName, field.TypeString, value)
+	}
+	if _u.mutation.FullNameCleared() {
+		_spec.ClearField(user.FieldFullName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsBanned(); ok {
+		_spec.SetField(user.FieldIsBanned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BannedUntil(); ok {
+		_spec.SetField(user.FieldBannedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.BannedUntilCleared() {
+		_spec.ClearField(user.FieldBannedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BanReason(); ok {
+		_spec.SetField(user.FieldBanReason, field.TypeString, value)
+	}
+	if _u.mutation.BanReasonCleared() {
+		_spec.ClearField(user.FieldBanReason, field.TypeString)
+	}
+	if _u.mutation.AvatarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AvatarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   user.AvatarTable,
+			Columns: []string{user.AvatarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.IdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SentMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSentMessagesIDs(); len(nodes) > 0 && !_u.mutation.SentMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.PasswordHash(); ok {
-		if err := user.PasswordHashValidator(v); err != nil {
-			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "User.password_hash": %w`, err)}
+	if nodes := _u.mutation.SentMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentMessagesTable,
+			Columns: []string{user.SentMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.FullName(); ok {
-		if err := user.FullNameValidator(v); err != nil {
-			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "User.full_name": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.Bio(); ok {
-		if err := user.BioValidator(v); err != nil {
-			return &ValidationError{Name: "bio", err: fmt.Errorf(`ent: validator failed for field "User.bio": %w`, err)}
+	if _u.mutation.CreatedGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+	if nodes := _u.mutation.RemovedCreatedGroupsIDs(); len(nodes) > 0 && !_u.mutation.CreatedGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	return nil
-}
-
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *UserUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserUpdateOne {
-	_u.modifiers = append(_u.modifiers, modifiers...)
-	return _u
-}
-
-func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.CreatedGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedGroupsTable,
+			Columns: []string{user.CreatedGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
-	}
-	if _u.mutation.EmailCleared() {
-		_spec.ClearField(user.FieldEmail, field.TypeString)
-	}
-	if value, ok := _u.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
-	}
-	if _u.mutation.UsernameCleared() {
-		_spec.ClearField(user.FieldUsername, field.TypeString)
-	}
-	if value, ok := _u.mutation.PasswordHash(); ok {
-		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
+	if _u.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.PasswordHashCleared() {
-		_spec.ClearField(user.FieldPasswordHash, field.TypeString)
+	if nodes := _u.mutation.RemovedGroupMembershipsIDs(); len(nodes) > 0 && !_u.mutation.GroupMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.FullName(); ok {
-		_spec.SetField(user.FieldFullName, field.TypeString, value)
+	if nodes := _u.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GroupMembershipsTable,
+			Columns: []string{user.GroupMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FullNameCleared() {
-		_spec.ClearField(user.FieldFullName, field.TypeString)
+	if _u.mutation.PrivateChatsAsUser1Cleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PrivateChatsAsUser1Table,
+			Columns: []string{user.PrivateChatsAsUser1Column},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Bio(); ok {
-		_spec.SetField(user.FieldBio, field.TypeString, value)
+	if nodes := _u.mutation.RemovedPrivateChatsAsUser1IDs(); len(nodes) > 0 && !_u.mutation.PrivateChatsAsUser1Cleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PrivateChatsAsUser1Table,
+			Columns: []string{user.PrivateChatsAsUser1Column},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.BioCleared() {
-		_spec.ClearField(user.FieldBio, field.TypeString)
+	if nodes := _u.mutation.PrivateChatsAsUser1IDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PrivateChatsAsUser1Table,
+			Columns: []string{user.PrivateChatsAsUser1Column},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.LastSeenAt(); ok {
-		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+	if _u.mutation.PrivateChatsAsUser2Cleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PrivateChatsAsUser2Table,
+			Columns: []string{user.PrivateChatsAsUser2Column},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.LastSeenAtCleared() {
-		_spec.ClearField(user.FieldLastSeenAt, field.TypeTime)
+	if nodes := _u.mutation.RemovedPrivateChatsAsUser2IDs(); len(nodes) > 0 && !_u.mutation.PrivateChatsAsUser2Cleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PrivateChatsAsUser2Table,
+			Columns: []string{user.PrivateChatsAsUser2Column},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	if nodes := _u.mutation.PrivateChatsAsUser2IDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PrivateChatsAsUser2Table,
+			Columns: []string{user.PrivateChatsAsUser2Column},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	if _u.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Role(); ok {
-		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	if nodes := _u.mutation.RemovedUploadedMediaIDs(); len(nodes) > 0 && !_u.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IsBanned(); ok {
-		_spec.SetField(user.FieldIsBanned, field.TypeBool, value)
+	if nodes := _u.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.BannedUntil(); ok {
-		_spec.SetField(user.FieldBannedUntil, field.TypeTime, value)
+	if _u.mutation.BlockedUsersRelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedUsersRelTable,
+			Columns: []string{user.BlockedUsersRelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.BannedUntilCleared() {
-		_spec.ClearField(user.FieldBannedUntil, field.TypeTime)
+	if nodes := _u.mutation.RemovedBlockedUsersRelIDs(); len(nodes) > 0 && !_u.mutation.BlockedUsersRelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedUsersRelTable,
+			Columns: []string{user.BlockedUsersRelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.BanReason(); ok {
-		_spec.SetField(user.FieldBanReason, field.TypeString, value)
+	if nodes := _u.mutation.BlockedUsersRelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedUsersRelTable,
+			Columns: []string{user.BlockedUsersRelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BanReasonCleared() {
-		_spec.ClearField(user.FieldBanReason, field.TypeString)
+	if _u.mutation.BlockedByRelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByRelTable,
+			Columns: []string{user.BlockedByRelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.AvatarCleared() {
+	if nodes := _u.mutation.RemovedBlockedByRelIDs(); len(nodes) > 0 && !_u.mutation.BlockedByRelCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
-			Inverse: true,
-			Table:   user.AvatarTable,
-			Columns: []string{user.AvatarColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByRelTable,
+			Columns: []string{user.BlockedByRelColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.AvatarIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BlockedByRelIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
-			Inverse: true,
-			Table:   user.AvatarTable,
-			Columns: []string{user.AvatarColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BlockedByRelTable,
+			Columns: []string{user.BlockedByRelColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2239,28 +3706,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.IdentitiesCleared() {
+	if _u.mutation.MessageReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.IdentitiesTable,
-			Columns: []string{user.IdentitiesColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedIdentitiesIDs(); len(nodes) > 0 && !_u.mutation.IdentitiesCleared() {
+	if nodes := _u.mutation.RemovedMessageReactionsIDs(); len(nodes) > 0 && !_u.mutation.MessageReactionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.IdentitiesTable,
-			Columns: []string{user.IdentitiesColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2268,15 +3735,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.IdentitiesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MessageReactionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.IdentitiesTable,
-			Columns: []string{user.IdentitiesColumn},
+			Table:   user.MessageReactionsTable,
+			Columns: []string{user.MessageReactionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagereaction.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2284,28 +3751,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SentMessagesCleared() {
+	if _u.mutation.PinnedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSentMessagesIDs(); len(nodes) > 0 && !_u.mutation.SentMessagesCleared() {
+	if nodes := _u.mutation.RemovedPinnedMessagesIDs(); len(nodes) > 0 && !_u.mutation.PinnedMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2313,15 +3780,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SentMessagesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PinnedMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SentMessagesTable,
-			Columns: []string{user.SentMessagesColumn},
+			Table:   user.PinnedMessagesTable,
+			Columns: []string{user.PinnedMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(message.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedmessage.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2329,28 +3796,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CreatedGroupsCleared() {
+	if _u.mutation.ScheduledMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedGroupsTable,
-			Columns: []string{user.CreatedGroupsColumn},
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCreatedGroupsIDs(); len(nodes) > 0 && !_u.mutation.CreatedGroupsCleared() {
+	if nodes := _u.mutation.RemovedScheduledMessagesIDs(); len(nodes) > 0 && !_u.mutation.ScheduledMessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedGroupsTable,
-			Columns: []string{user.CreatedGroupsColumn},
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2358,15 +3825,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CreatedGroupsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ScheduledMessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedGroupsTable,
-			Columns: []string{user.CreatedGroupsColumn},
+			Table:   user.ScheduledMessagesTable,
+			Columns: []string{user.ScheduledMessagesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupchat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2374,28 +3841,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.GroupMembershipsCleared() {
+	if _u.mutation.MentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.GroupMembershipsTable,
-			Columns: []string{user.GroupMembershipsColumn},
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedGroupMembershipsIDs(); len(nodes) > 0 && !_u.mutation.GroupMembershipsCleared() {
+	if nodes := _u.mutation.RemovedMentionsIDs(); len(nodes) > 0 && !_u.mutation.MentionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.GroupMembershipsTable,
-			Columns: []string{user.GroupMembershipsColumn},
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2403,15 +3870,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.GroupMembershipsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.MentionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.GroupMembershipsTable,
-			Columns: []string{user.GroupMembershipsColumn},
+			Table:   user.MentionsTable,
+			Columns: []string{user.MentionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(groupmember.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(messagemention.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2419,28 +3886,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PrivateChatsAsUser1Cleared() {
+	if _u.mutation.PollVotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PrivateChatsAsUser1Table,
-			Columns: []string{user.PrivateChatsAsUser1Column},
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPrivateChatsAsUser1IDs(); len(nodes) > 0 && !_u.mutation.PrivateChatsAsUser1Cleared() {
+	if nodes := _u.mutation.RemovedPollVotesIDs(); len(nodes) > 0 && !_u.mutation.PollVotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PrivateChatsAsUser1Table,
-			Columns: []string{user.PrivateChatsAsUser1Column},
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2448,15 +3915,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PrivateChatsAsUser1IDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PollVotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PrivateChatsAsUser1Table,
-			Columns: []string{user.PrivateChatsAsUser1Column},
+			Table:   user.PollVotesTable,
+			Columns: []string{user.PollVotesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pollvote.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2464,28 +3931,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PrivateChatsAsUser2Cleared() {
+	if _u.mutation.ChatExportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PrivateChatsAsUser2Table,
-			Columns: []string{user.PrivateChatsAsUser2Column},
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPrivateChatsAsUser2IDs(); len(nodes) > 0 && !_u.mutation.PrivateChatsAsUser2Cleared() {
+	if nodes := _u.mutation.RemovedChatExportsIDs(); len(nodes) > 0 && !_u.mutation.ChatExportsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PrivateChatsAsUser2Table,
-			Columns: []string{user.PrivateChatsAsUser2Column},
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2493,15 +3960,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PrivateChatsAsUser2IDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ChatExportsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PrivateChatsAsUser2Table,
-			Columns: []string{user.PrivateChatsAsUser2Column},
+			Table:   user.ChatExportsTable,
+			Columns: []string{user.ChatExportsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(privatechat.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(chatexport.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2509,28 +3976,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UploadedMediaCleared() {
+	if _u.mutation.PinnedChatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UploadedMediaTable,
-			Columns: []string{user.UploadedMediaColumn},
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedUploadedMediaIDs(); len(nodes) > 0 && !_u.mutation.UploadedMediaCleared() {
+	if nodes := _u.mutation.RemovedPinnedChatsIDs(); len(nodes) > 0 && !_u.mutation.PinnedChatsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UploadedMediaTable,
-			Columns: []string{user.UploadedMediaColumn},
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2538,15 +4005,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.PinnedChatsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.UploadedMediaTable,
-			Columns: []string{user.UploadedMediaColumn},
+			Table:   user.PinnedChatsTable,
+			Columns: []string{user.PinnedChatsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(pinnedchat.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2554,28 +4021,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BlockedUsersRelCleared() {
+	if _u.mutation.ChatFoldersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedUsersRelTable,
-			Columns: []string{user.BlockedUsersRelColumn},
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBlockedUsersRelIDs(); len(nodes) > 0 && !_u.mutation.BlockedUsersRelCleared() {
+	if nodes := _u.mutation.RemovedChatFoldersIDs(); len(nodes) > 0 && !_u.mutation.ChatFoldersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedUsersRelTable,
-			Columns: []string{user.BlockedUsersRelColumn},
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2583,15 +4050,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BlockedUsersRelIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ChatFoldersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedUsersRelTable,
-			Columns: []string{user.BlockedUsersRelColumn},
+			Table:   user.ChatFoldersTable,
+			Columns: []string{user.ChatFoldersColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(chatfolder.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2599,28 +4066,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BlockedByRelCleared() {
+	if _u.mutation.GroupJoinRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByRelTable,
-			Columns: []string{user.BlockedByRelColumn},
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBlockedByRelIDs(); len(nodes) > 0 && !_u.mutation.BlockedByRelCleared() {
+	if nodes := _u.mutation.RemovedGroupJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.GroupJoinRequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByRelTable,
-			Columns: []string{user.BlockedByRelColumn},
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -2628,15 +4095,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BlockedByRelIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.GroupJoinRequestsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BlockedByRelTable,
-			Columns: []string{user.BlockedByRelColumn},
+			Table:   user.GroupJoinRequestsTable,
+			Columns: []string{user.GroupJoinRequestsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(userblock.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(groupjoinrequest.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,427 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	linkPreviewCachePrefix  = "link_preview:"
+	linkPreviewMissTTL      = time.Hour
+	linkPreviewTimeout      = 5 * time.Second
+	linkPreviewUserAgent    = "AtoiTalkBot/1.0 (+link preview)"
+	maxLinkPreviewRedirects = 3
+	maxLinkPreviewPageSize  = 1 << 20
+	maxLinkPreviewImageSize = 5 << 20
+	maxLinkPreviewTitle     = 300
+	maxLinkPreviewText      = 1000
+)
+
+const (
+	// LinkPreviewImagePrefix is where preview images are stored in the public bucket.
+	LinkPreviewImagePrefix = "link_previews/"
+	// LinkPreviewCacheTTL is how long a fetched preview, and so its image path,
+	// can be handed out again without being fetched anew.
+	LinkPreviewCacheTTL = 24 * time.Hour
+)
+
+// ErrLinkPreviewBlocked is returned when a preview target resolves to an address
+// that is not reachable from the public internet.
+var ErrLinkPreviewBlocked = errors.New("link preview target is not a public address")
+
+// Ranges not covered by the netip helpers that must never be fetched.
+var blockedLinkPreviewPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+var linkPreviewImageExts = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+type LinkPreview struct {
+	URL         string `json:"url"`
+	SiteName    string `json:"site_name,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImagePath   string `json:"image_path,omitempty"`
+}
+
+type LinkPreviewAdapter struct {
+	httpClient     *http.Client
+	redisAdapter   *RedisAdapter
+	storageAdapter *StorageAdapter
+}
+
+func NewLinkPreviewAdapter(httpClient *http.Client, redisAdapter *RedisAdapter, storageAdapter *StorageAdapter) *LinkPreviewAdapter {
+	return &LinkPreviewAdapter{
+		httpClient:     newLinkPreviewClient(httpClient, publicAddressOnly),
+		redisAdapter:   redisAdapter,
+		storageAdapter: storageAdapter,
+	}
+}
+
+// newLinkPreviewClient derives a client from base that checks every address it
+// dials with control. The check runs after DNS resolution and for each redirect,
+// so neither can point the client at internal services.
+func newLinkPreviewClient(base *http.Client, control func(network, address string, c syscall.RawConn) error) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if base != nil {
+		if t, ok := base.Transport.(*http.Transport); ok {
+			transport = t.Clone()
+		}
+	}
+
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout: linkPreviewTimeout,
+		Control: control,
+	}).DialContext
+	transport.TLSHandshakeTimeout = linkPreviewTimeout
+	transport.ResponseHeaderTimeout = linkPreviewTimeout
+
+	return &http.Client{
+		Timeout:   linkPreviewTimeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxLinkPreviewRedirects {
+				return errors.New("too many redirects")
+			}
+			if err := checkLinkPreviewURL(req.URL); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+func publicAddressOnly(network, address string, _ syscall.RawConn) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if port != "80" && port != "443" {
+		return ErrLinkPreviewBlocked
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !isPublicAddr(ip) {
+		return ErrLinkPreviewBlocked
+	}
+	return nil
+}
+
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, prefix := range blockedLinkPreviewPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkLinkPreviewURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+	if u.Host == "" || u.User != nil {
+		return errors.New("invalid link preview URL")
+	}
+	return nil
+}
+
+// Preview returns the metadata of the page at rawURL, or nil when the page has
+// nothing worth showing. Results, including misses, are cached in Redis.
+func (a *LinkPreviewAdapter) Preview(ctx context.Context, rawURL string) (*LinkPreview, error) {
+	hash := hashLinkPreviewURL(rawURL)
+	key := linkPreviewCachePrefix + hash
+
+	if a.redisAdapter != nil {
+		if cached, err := a.redisAdapter.Get(ctx, key); err == nil {
+			var preview LinkPreview
+			if err := json.Unmarshal([]byte(cached), &preview); err == nil {
+				if preview.URL == "" {
+					return nil, nil
+				}
+				return &preview, nil
+			}
+		}
+	}
+
+	preview, err := a.fetch(ctx, rawURL, hash)
+	if err != nil {
+		a.cache(ctx, key, LinkPreview{}, linkPreviewMissTTL)
+		return nil, err
+	}
+	if preview == nil {
+		a.cache(ctx, key, LinkPreview{}, linkPreviewMissTTL)
+		return nil, nil
+	}
+
+	a.cache(ctx, key, *preview, LinkPreviewCacheTTL)
+	return preview, nil
+}
+
+func (a *LinkPreviewAdapter) cache(ctx context.Context, key string, preview LinkPreview, ttl time.Duration) {
+	if a.redisAdapter == nil {
+		return
+	}
+
+	data, err := json.Marshal(preview)
+	if err != nil {
+		return
+	}
+	if err := a.redisAdapter.Set(ctx, key, data, ttl); err != nil {
+		slog.Warn("Failed to cache link preview", "error", err, "key", key)
+	}
+}
+
+func (a *LinkPreviewAdapter) fetch(ctx context.Context, rawURL, hash string) (*LinkPreview, error) {
+	pageURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkLinkPreviewURL(pageURL); err != nil {
+		return nil, err
+	}
+
+	resp, err := a.get(ctx, pageURL.String(), "text/html,application/xhtml+xml")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return nil, nil
+	}
+
+	meta := parseLinkMetadata(io.LimitReader(resp.Body, maxLinkPreviewPageSize))
+	if meta.title == "" && meta.description == "" {
+		return nil, nil
+	}
+
+	preview := &LinkPreview{
+		URL:         rawURL,
+		SiteName:    truncateRunes(meta.siteName, maxLinkPreviewTitle),
+		Title:       truncateRunes(meta.title, maxLinkPreviewTitle),
+		Description: truncateRunes(meta.description, maxLinkPreviewText),
+	}
+
+	if meta.image != "" && a.storageAdapter != nil {
+		// Relative image URLs are resolved against the final page URL after redirects.
+		imageURL, err := resp.Request.URL.Parse(meta.image)
+		if err == nil && checkLinkPreviewURL(imageURL) == nil {
+			path, err := a.storeImage(ctx, imageURL.String(), hash)
+			if err != nil {
+				slog.Warn("Failed to store link preview image", "error", err, "url", imageURL.String())
+			} else {
+				preview.ImagePath = path
+			}
+		}
+	}
+
+	return preview, nil
+}
+
+func (a *LinkPreviewAdapter) storeImage(ctx context.Context, imageURL, hash string) (string, error) {
+	resp, err := a.get(ctx, imageURL, "image/*")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.ContentLength > maxLinkPreviewImageSize {
+		return "", errors.New("link preview image is too large")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLinkPreviewImageSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxLinkPreviewImageSize {
+		return "", errors.New("link preview image is too large")
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := linkPreviewImageExts[contentType]
+	if !ok {
+		return "", fmt.Errorf("unsupported link preview image type: %s", contentType)
+	}
+
+	path := LinkPreviewImagePrefix + hash + ext
+	if err := a.storageAdapter.StoreFromReader(bytes.NewReader(data), contentType, path, true); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func (a *LinkPreviewAdapter) get(ctx context.Context, rawURL, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", linkPreviewUserAgent)
+	req.Header.Set("Accept", accept)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("link preview request failed with status: %d", resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+type linkMetadata struct {
+	title       string
+	description string
+	siteName    string
+	image       string
+}
+
+// parseLinkMetadata reads the document head and prefers OpenGraph tags, then
+// Twitter card tags, then the plain title and description.
+func parseLinkMetadata(r io.Reader) linkMetadata {
+	values := make(map[string]string)
+	var pageTitle string
+	inTitle := false
+
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		if tt == html.TextToken {
+			if inTitle && pageTitle == "" {
+				pageTitle = strings.TrimSpace(string(z.Text()))
+			}
+			continue
+		}
+
+		name, hasAttr := z.TagName()
+		tag := string(name)
+
+		if tt == html.EndTagToken {
+			if tag == "head" {
+				break
+			}
+			if tag == "title" {
+				inTitle = false
+			}
+			continue
+		}
+
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			continue
+		}
+		if tag == "body" {
+			break
+		}
+		if tag == "title" {
+			inTitle = tt == html.StartTagToken
+			continue
+		}
+		if tag != "meta" || !hasAttr {
+			continue
+		}
+
+		var key, content string
+		for {
+			attrName, attrValue, more := z.TagAttr()
+			switch strings.ToLower(string(attrName)) {
+			case "property", "name":
+				if key == "" {
+					key = strings.ToLower(strings.TrimSpace(string(attrValue)))
+				}
+			case "content":
+				content = strings.TrimSpace(string(attrValue))
+			}
+			if !more {
+				break
+			}
+		}
+		if key != "" && content != "" {
+			if _, ok := values[key]; !ok {
+				values[key] = content
+			}
+		}
+	}
+
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := values[k]; v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	meta := linkMetadata{
+		title:       first("og:title", "twitter:title"),
+		description: first("og:description", "twitter:description", "description"),
+		siteName:    first("og:site_name", "application-name"),
+		image:       first("og:image:secure_url", "og:image:url", "og:image", "twitter:image", "twitter:image:src"),
+	}
+	if meta.title == "" {
+		meta.title = pageTitle
+	}
+
+	return meta
+}
+
+func hashLinkPreviewURL(rawURL string) string {
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:])
+}
+
+func truncateRunes(s string, max int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
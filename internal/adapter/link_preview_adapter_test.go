@@ -0,0 +1,105 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestLinkPreviewAddressFilter(t *testing.T) {
+	public := []string{"93.184.216.34", "1.1.1.1", "2606:4700:4700::1111"}
+	for _, value := range public {
+		if !isPublicAddr(netip.MustParseAddr(value)) {
+			t.Fatalf("expected %s to be public", value)
+		}
+	}
+
+	blocked := []string{
+		"127.0.0.1", "10.0.0.1", "172.16.5.4", "192.168.1.1", "169.254.169.254",
+		"100.64.0.1", "0.0.0.0", "::1", "fd00::1", "fe80::1", "::ffff:127.0.0.1", "64:ff9b::a00:1",
+	}
+	for _, value := range blocked {
+		if isPublicAddr(netip.MustParseAddr(value)) {
+			t.Fatalf("expected %s to be blocked", value)
+		}
+	}
+
+	if err := publicAddressOnly("tcp", "93.184.216.34:22", nil); !errors.Is(err, ErrLinkPreviewBlocked) {
+		t.Fatalf("expected non web port to be blocked, got %v", err)
+	}
+}
+
+func TestLinkPreviewBlocksPrivateTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("private target must not be reached")
+	}))
+	defer server.Close()
+
+	a := &LinkPreviewAdapter{httpClient: newLinkPreviewClient(nil, publicAddressOnly)}
+	if _, err := a.fetch(context.Background(), server.URL, "hash"); !errors.Is(err, ErrLinkPreviewBlocked) {
+		t.Fatalf("expected blocked error, got %v", err)
+	}
+}
+
+func TestLinkPreviewFetch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<!doctype html><html><head>
+			<title>Fallback title</title>
+			<meta name="twitter:title" content="Twitter title">
+			<meta property="og:title" content="Tom &amp; Jerry">
+			<meta name="description" content="  A short
+				description  ">
+			<meta property="og:site_name" content="Example">
+			</head><body><meta property="og:description" content="ignored"></body></html>`))
+	})
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Only a title</title></head></html>`))
+	})
+	mux.HandleFunc("/file", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4"))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/redirect", http.StatusFound)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	allowAll := func(network, address string, c syscall.RawConn) error { return nil }
+	a := &LinkPreviewAdapter{httpClient: newLinkPreviewClient(nil, allowAll)}
+	ctx := context.Background()
+
+	preview, err := a.fetch(ctx, server.URL+"/article", "hash")
+	if err != nil || preview == nil {
+		t.Fatalf("expected preview, got %v, %v", preview, err)
+	}
+	if preview.Title != "Tom & Jerry" || preview.Description != "A short description" || preview.SiteName != "Example" {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+
+	preview, err = a.fetch(ctx, server.URL+"/plain", "hash")
+	if err != nil || preview == nil || preview.Title != "Only a title" {
+		t.Fatalf("expected title fallback, got %+v, %v", preview, err)
+	}
+
+	preview, err = a.fetch(ctx, server.URL+"/file", "hash")
+	if err != nil || preview != nil {
+		t.Fatalf("expected no preview for non HTML content, got %+v, %v", preview, err)
+	}
+
+	if _, err := a.fetch(ctx, server.URL+"/redirect", "hash"); err == nil || !strings.Contains(err.Error(), "too many redirects") {
+		t.Fatalf("expected redirect limit error, got %v", err)
+	}
+
+	if _, err := a.fetch(ctx, "ftp://example.com/file", "hash"); err == nil {
+		t.Fatal("expected unsupported scheme to be rejected")
+	}
+}
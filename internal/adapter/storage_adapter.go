@@ -28,8 +28,10 @@ type StorageAdapter struct {
 }
 
 type StorageObjectInfo struct {
-	Size        int64
-	ContentType string
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
 }
 
 func NewStorageAdapter(cfg *config.AppConfig, s3Client *s3.Client, httpClient *http.Client) *StorageAdapter {
@@ -121,6 +123,29 @@ func (s *StorageAdapter) Download(url string) ([]byte, string, error) {
 	return data, contentType, nil
 }
 
+// Open streams an object from the bucket. The caller closes the reader.
+func (s *StorageAdapter) Open(ctx context.Context, path string, isPublic bool) (io.ReadCloser, error) {
+	if s.client == nil {
+		return nil, errors.New("s3 client is not initialized")
+	}
+
+	bucket := s.bucketPrivate
+	if isPublic {
+		bucket = s.bucketPublic
+	}
+
+	s3Key := filepath.ToSlash(path)
+	resp, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(s3Key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
 func (s *StorageAdapter) Delete(path string, isPublic bool) error {
 	if s.client == nil {
 		return errors.New("s3 client is not initialized")
@@ -228,3 +253,40 @@ func (s *StorageAdapter) Head(path string, isPublic bool) (*StorageObjectInfo, e
 
 	return info, nil
 }
+
+// List returns every object whose key starts with prefix. ContentType is not
+// filled, since listing does not return it.
+func (s *StorageAdapter) List(ctx context.Context, prefix string, isPublic bool) ([]StorageObjectInfo, error) {
+	if s.client == nil {
+		return nil, errors.New("s3 client is not initialized")
+	}
+
+	bucket := s.bucketPrivate
+	if isPublic {
+		bucket = s.bucketPublic
+	}
+
+	var objects []StorageObjectInfo
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(filepath.ToSlash(prefix)),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range page.Contents {
+			info := StorageObjectInfo{Key: aws.ToString(obj.Key)}
+			if obj.Size != nil {
+				info.Size = *obj.Size
+			}
+			if obj.LastModified != nil {
+				info.LastModified = *obj.LastModified
+			}
+			objects = append(objects, info)
+		}
+	}
+
+	return objects, nil
+}
@@ -28,6 +28,7 @@ func Init(appConfig *config.AppConfig, client *ent.Client, validator *validator.
 		slog.Error("Failed to initialize Redis adapter", "error", err)
 		os.Exit(1)
 	}
+	linkPreviewAdapter := adapter.NewLinkPreviewAdapter(httpClient, redisAdapter, storageAdapter)
 
 	wsHub := websocket.NewHub(client, redisAdapter)
 	go wsHub.Run()
@@ -44,7 +45,7 @@ func Init(appConfig *config.AppConfig, client *ent.Client, validator *validator.
 	chatService := service.NewChatService(client, repo, appConfig, validator, wsHub, storageAdapter, redisAdapter)
 	privateChatService := service.NewPrivateChatService(client, appConfig, validator, wsHub, redisAdapter, storageAdapter)
 	groupChatService := service.NewGroupChatService(client, repo, appConfig, validator, wsHub, storageAdapter, redisAdapter)
-	messageService := service.NewMessageService(client, repo, appConfig, validator, storageAdapter, wsHub)
+	messageService := service.NewMessageService(client, repo, appConfig, validator, storageAdapter, wsHub, linkPreviewAdapter)
 	mediaService := service.NewMediaService(client, appConfig, validator, storageAdapter, captchaAdapter)
 	reportService := service.NewReportService(client, appConfig, validator, storageAdapter)
 
@@ -61,7 +62,7 @@ func Init(appConfig *config.AppConfig, client *ent.Client, validator *validator.
 	mediaController := controller.NewMediaController(mediaService)
 	reportController := controller.NewReportController(reportService)
 	adminController := controller.NewAdminController(adminService, groupChatService, validator)
-	wsController := controller.NewWebSocketController(wsHub, appConfig)
+	wsController := controller.NewWebSocketController(wsHub, appConfig, authService, messageService, chatService, repo.RateLimit, repo.Session)
 
 	authMiddleware := middleware.NewAuthMiddleware(authService, repo.Session)
 	rateLimitMiddleware := middleware.NewRateLimitMiddleware(repo.RateLimit, appConfig)
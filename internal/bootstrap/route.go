@@ -102,6 +102,12 @@ func (route *Route) Register() {
 				r.Put("/chats/group/{chatID}", route.groupChatController.UpdateGroupChat)
 			})
 
+			r.Group(func(r chi.Router) {
+				r.Use(middleware.MaxBodySize(100 * 1024))
+				r.Use(route.rateLimitMiddleware.Limit("chat_export", 10, time.Hour))
+				r.Post("/chats/{id}/exports", route.chatController.RequestChatExport)
+			})
+
 			r.Group(func(r chi.Router) {
 				r.Use(route.authMiddleware.AdminOnly)
 				r.Use(route.rateLimitMiddleware.Limit("admin_action", 1000, time.Minute))
@@ -140,10 +146,30 @@ func (route *Route) Register() {
 				r.Delete("/account", route.accountController.DeleteAccount)
 
 				r.Get("/chats", route.chatController.GetChats)
+				r.Get("/chats/archive", route.chatController.GetArchiveSummary)
+				r.Post("/chats/read", route.chatController.MarkAllAsRead)
+				r.Put("/chats/pinned", route.chatController.ReorderPinnedChats)
+				r.Get("/chats/folders", route.chatController.GetChatFolders)
+				r.Post("/chats/folders", route.chatController.CreateChatFolder)
+				r.Put("/chats/folders/{folderID}", route.chatController.UpdateChatFolder)
+				r.Delete("/chats/folders/{folderID}", route.chatController.DeleteChatFolder)
 				r.Get("/chats/{id}", route.chatController.GetChat)
 				r.Post("/chats/{id}/read", route.chatController.MarkAsRead)
+				r.Post("/chats/{id}/unread", route.chatController.MarkAsUnread)
 				r.Post("/chats/{id}/hide", route.chatController.HideChat)
+				r.Post("/chats/{id}/archive", route.chatController.ArchiveChat)
+				r.Post("/chats/{id}/unarchive", route.chatController.UnarchiveChat)
+				r.Post("/chats/{id}/pin", route.chatController.PinChat)
+				r.Post("/chats/{id}/unpin", route.chatController.UnpinChat)
+				r.Put("/chats/{id}/mute", route.chatController.MuteChat)
+				r.Delete("/chats/{id}/mute", route.chatController.UnmuteChat)
+				r.Post("/chats/{id}/pins", route.chatController.PinMessage)
+				r.Delete("/chats/{id}/pins/{messageID}", route.chatController.UnpinMessage)
+				r.Put("/chats/{id}/ttl", route.chatController.UpdateMessageTTL)
+				r.Get("/chats/exports/{exportID}", route.chatController.GetChatExport)
 				r.Get("/chats/{chatID}/messages", route.messageController.GetMessages)
+				r.Get("/chats/{chatID}/messages/search", route.messageController.SearchChatMessages)
+				r.Get("/chats/{chatID}/mentions/next", route.messageController.GetNextUnreadMention)
 
 				r.Post("/chats/private", route.privateChatController.CreatePrivateChat)
 
@@ -153,6 +179,9 @@ func (route *Route) Register() {
 				r.Post("/chats/group/{chatID}/leave", route.groupChatController.LeaveGroup)
 				r.Post("/chats/group/{chatID}/join", route.groupChatController.JoinPublicGroup)
 				r.Post("/chats/group/join/invite", route.groupChatController.JoinGroupByInvite)
+				r.Get("/chats/group/{chatID}/requests", route.groupChatController.GetJoinRequests)
+				r.Post("/chats/group/{chatID}/requests/{userID}/approve", route.groupChatController.ApproveJoinRequest)
+				r.Post("/chats/group/{chatID}/requests/{userID}/decline", route.groupChatController.DeclineJoinRequest)
 
 				r.Put("/chats/group/{chatID}/invite", route.groupChatController.ResetInviteCode)
 				r.Post("/chats/group/{chatID}/members/{userID}/kick", route.groupChatController.KickMember)
@@ -160,9 +189,30 @@ func (route *Route) Register() {
 				r.Post("/chats/group/{chatID}/transfer", route.groupChatController.TransferOwnership)
 				r.Delete("/chats/group/{chatID}", route.groupChatController.DeleteGroup)
 
+				r.Get("/messages/search", route.messageController.SearchMessages)
 				r.Post("/messages", route.messageController.SendMessage)
+				r.Post("/messages/polls", route.messageController.SendPoll)
+				r.Post("/messages/locations", route.messageController.SendLocation)
+				r.Post("/messages/contacts", route.messageController.SendContact)
+				r.Get("/messages/scheduled", route.messageController.GetScheduledMessages)
+				r.Post("/messages/scheduled", route.messageController.ScheduleMessage)
+				r.Put("/messages/scheduled/{scheduledID}", route.messageController.UpdateScheduledMessage)
+				r.Delete("/messages/scheduled/{scheduledID}", route.messageController.CancelScheduledMessage)
 				r.Put("/messages/{messageID}", route.messageController.EditMessage)
 				r.Delete("/messages/{messageID}", route.messageController.DeleteMessage)
+				r.Post("/messages/{messageID}/forward", route.messageController.ForwardMessage)
+				r.Get("/messages/{messageID}/thread", route.messageController.GetThread)
+				r.Get("/messages/{messageID}/revisions", route.messageController.GetMessageRevisions)
+				r.Get("/messages/{messageID}/receipts", route.messageController.GetMessageReceipts)
+				r.Get("/messages/{messageID}/reactions", route.messageController.GetReactions)
+				r.Post("/messages/{messageID}/reactions", route.messageController.AddReaction)
+				r.Delete("/messages/{messageID}/reactions", route.messageController.RemoveReaction)
+				r.Get("/messages/{messageID}/poll/votes", route.messageController.GetPollVotes)
+				r.Post("/messages/{messageID}/poll/votes", route.messageController.VotePoll)
+				r.Delete("/messages/{messageID}/poll/votes", route.messageController.RetractPollVote)
+				r.Post("/messages/{messageID}/poll/close", route.messageController.ClosePoll)
+				r.Put("/messages/{messageID}/location", route.messageController.UpdateLiveLocation)
+				r.Post("/messages/{messageID}/location/stop", route.messageController.StopLiveLocation)
 
 				r.Post("/reports", route.reportController.CreateReport)
 
@@ -66,6 +66,9 @@ type AppConfig struct {
 	EntityCleanupCron      string
 	PrivateChatCleanupCron string
 	MediaCleanupCron       string
+	ScheduledMessageCron   string
+	ExpiredMessageCron     string
+	ChatExportCron         string
 }
 
 func LoadAppConfig() *AppConfig {
@@ -128,6 +131,9 @@ func LoadAppConfig() *AppConfig {
 		EntityCleanupCron:      getEnv("ENTITY_CLEANUP_CRON", "0 2 * * *"),
 		PrivateChatCleanupCron: getEnv("PRIVATE_CHAT_CLEANUP_CRON", "30 2 * * *"),
 		MediaCleanupCron:       getEnv("MEDIA_CLEANUP_CRON", "0 3 * * *"),
+		ScheduledMessageCron:   getEnv("SCHEDULED_MESSAGE_CRON", "@every 15s"),
+		ExpiredMessageCron:     getEnv("EXPIRED_MESSAGE_CRON", "@every 1m"),
+		ChatExportCron:         getEnv("CHAT_EXPORT_CRON", "@every 30s"),
 	}
 
 	if cfg.JWTExp <= 0 {
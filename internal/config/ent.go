@@ -80,6 +80,9 @@ func queryIndexStatements() []string {
 		`CREATE INDEX CONCURRENTLY IF NOT EXISTS chats_active_last_message_idx ON chats (last_message_at DESC, id DESC) WHERE deleted_at IS NULL AND last_message_at IS NOT NULL`,
 		`CREATE INDEX CONCURRENTLY IF NOT EXISTS messages_chat_id_id_idx ON messages (chat_id, id)`,
 		`CREATE INDEX CONCURRENTLY IF NOT EXISTS messages_sender_id_idx ON messages (sender_id) WHERE sender_id IS NOT NULL`,
+		`ALTER TABLE messages ADD COLUMN IF NOT EXISTS content_tsv tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce(content, ''))) STORED`,
+		`CREATE INDEX CONCURRENTLY IF NOT EXISTS messages_content_tsv_idx ON messages USING gin (content_tsv) WHERE deleted_at IS NULL`,
+		`DROP INDEX IF EXISTS media_file_name_key`,
 		`CREATE INDEX CONCURRENTLY IF NOT EXISTS media_pending_expired_idx ON media (upload_expires_at) WHERE upload_status = 'pending' AND upload_expires_at IS NOT NULL`,
 		`CREATE INDEX CONCURRENTLY IF NOT EXISTS media_completed_orphan_idx ON media (created_at) WHERE upload_status = 'completed' AND message_id IS NULL`,
 		`CREATE INDEX CONCURRENTLY IF NOT EXISTS media_uploader_status_category_idx ON media (uploaded_by_id, upload_status, category) WHERE message_id IS NULL AND uploaded_by_id IS NOT NULL`,
@@ -32,6 +32,9 @@ func TestQueryIndexStatementsCoverSearchAndCleanupQueries(t *testing.T) {
 		"chats_active_last_message_idx",
 		"messages_chat_id_id_idx",
 		"messages_sender_id_idx",
+		"content_tsv tsvector GENERATED ALWAYS AS",
+		"messages_content_tsv_idx",
+		"DROP INDEX IF EXISTS media_file_name_key",
 		"media_pending_expired_idx",
 		"media_completed_orphan_idx",
 		"media_uploader_status_category_idx",
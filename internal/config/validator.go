@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -20,9 +21,28 @@ func NewValidator() *validator.Validate {
 	v := validator.New()
 	_ = v.RegisterValidation("password_complexity", validatePasswordComplexity)
 	_ = v.RegisterValidation("imagevalid", validateImage)
+	_ = v.RegisterValidation("emoji", validateEmoji)
 	return v
 }
 
+func validateEmoji(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+	if value == "" || !utf8.ValidString(value) || utf8.RuneCountInString(value) > 16 {
+		return false
+	}
+
+	hasSymbol := false
+	for _, char := range value {
+		switch {
+		case unicode.IsSpace(char) || unicode.IsControl(char) || unicode.IsLetter(char):
+			return false
+		case unicode.IsSymbol(char) || char == '\u20E3':
+			hasSymbol = true
+		}
+	}
+	return hasSymbol
+}
+
 func validatePasswordComplexity(fl validator.FieldLevel) bool {
 	password := fl.Field().String()
 	var (
@@ -0,0 +1,21 @@
+package config
+
+import "testing"
+
+func TestEmojiValidation(t *testing.T) {
+	v := NewValidator()
+
+	valid := []string{"👍", "❤️", "😂", "👨‍👩‍👧", "🇮🇩", "1️⃣", "👍🏽"}
+	for _, value := range valid {
+		if err := v.Var(value, "emoji"); err != nil {
+			t.Fatalf("expected %q to be a valid emoji, got %v", value, err)
+		}
+	}
+
+	invalid := []string{"", "a", "ok", " 👍", "👍 ", "1", "\n", "👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍"}
+	for _, value := range invalid {
+		if err := v.Var(value, "emoji"); err == nil {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+}
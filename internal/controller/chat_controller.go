@@ -5,6 +5,10 @@ import (
 	"AtoiTalkAPI/internal/middleware"
 	"AtoiTalkAPI/internal/model"
 	"AtoiTalkAPI/internal/service"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
 	"net/http"
 	"strconv"
 
@@ -24,11 +28,13 @@ func NewChatController(chatService *service.ChatService) *ChatController {
 
 // GetChats godoc
 // @Summary      Get Chat List
-// @Description  Get a paginated list of user's chats, sorted by last message time. Can be searched.
+// @Description  Get a paginated list of user's chats, sorted by last message time. Can be searched. Archived chats are only listed with archived=true. With folder_id only the chats of that folder are listed, archived or not, and archived is ignored. Without a search query the first page starts with the user's pinned chats in pin order, in addition to limit; later pages leave them out.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
 // @Param        query query string false "Search query for chat name"
+// @Param        archived query bool false "List archived chats instead of the inbox"
+// @Param        folder_id query string false "List the chats of a chat folder (UUID)"
 // @Param        cursor query string false "Pagination cursor"
 // @Param        limit query int false "Number of items per page (default 20, max 50)"
 // @Success      200  {object}  helper.ResponseWithPagination{data=[]model.ChatListResponse}
@@ -49,6 +55,26 @@ func (c *ChatController) GetChats(w http.ResponseWriter, r *http.Request) {
 	cursor := r.URL.Query().Get("cursor")
 	limitStr := r.URL.Query().Get("limit")
 
+	archived := false
+	if archivedStr := r.URL.Query().Get("archived"); archivedStr != "" {
+		a, err := strconv.ParseBool(archivedStr)
+		if err != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid archived"))
+			return
+		}
+		archived = a
+	}
+
+	var folderID *uuid.UUID
+	if folderIDStr := r.URL.Query().Get("folder_id"); folderIDStr != "" {
+		id, err := uuid.Parse(folderIDStr)
+		if err != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid Folder ID"))
+			return
+		}
+		folderID = &id
+	}
+
 	limit := 20
 	if limitStr != "" {
 		l, err := strconv.Atoi(limitStr)
@@ -60,9 +86,11 @@ func (c *ChatController) GetChats(w http.ResponseWriter, r *http.Request) {
 	}
 
 	req := model.GetChatsRequest{
-		Query:  query,
-		Cursor: cursor,
-		Limit:  limit,
+		Query:    query,
+		Archived: archived,
+		FolderID: folderID,
+		Cursor:   cursor,
+		Limit:    limit,
 	}
 
 	chats, nextCursor, hasNext, err := c.chatService.GetChats(r.Context(), userContext.ID, req)
@@ -114,7 +142,7 @@ func (c *ChatController) GetChat(w http.ResponseWriter, r *http.Request) {
 
 // MarkAsRead godoc
 // @Summary      Mark Chat as Read
-// @Description  Mark all messages in a chat as read for the current user.
+// @Description  Mark all messages in a chat as read for the current user. Also clears a mark as unread.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
@@ -150,9 +178,76 @@ func (c *ChatController) MarkAsRead(w http.ResponseWriter, r *http.Request) {
 	helper.WriteSuccess(w, nil)
 }
 
+// MarkAsUnread godoc
+// @Summary      Mark Chat as Unread
+// @Description  Flag a chat as unread for the current user until it is read again. Unread counts and read receipts are not changed. Other devices receive a chat.read event with marked_unread set.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/unread [post]
+func (c *ChatController) MarkAsUnread(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	chat, err := c.chatService.MarkAsUnread(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// MarkAllAsRead godoc
+// @Summary      Mark All Chats as Read
+// @Description  Mark every chat of the current user as read, including chats marked as unread, in one step. Each chat emits a chat.read event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  helper.ResponseSuccess
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Failure      503  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/read [post]
+func (c *ChatController) MarkAllAsRead(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	if err := c.chatService.MarkAllAsRead(r.Context(), userContext.ID); err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, nil)
+}
+
 // HideChat godoc
 // @Summary      Hide Chat
-// @Description  Hide a private chat from the chat list. It will reappear if a new message is sent or received.
+// @Description  Hide a private chat from the chat list. It will reappear if a new message is sent or received. Saved Messages cannot be hidden.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
@@ -187,3 +282,685 @@ func (c *ChatController) HideChat(w http.ResponseWriter, r *http.Request) {
 
 	helper.WriteSuccess(w, nil)
 }
+
+// PinMessage godoc
+// @Summary      Pin Message
+// @Description  Pin a message in a chat. In groups only admins and owners can pin. A chat can have at most 10 pinned messages.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Param        request body model.PinMessageRequest true "Message to pin"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      409  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/pins [post]
+func (c *ChatController) PinMessage(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	var req model.PinMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	chat, err := c.chatService.PinMessage(r.Context(), userContext.ID, chatID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// UnpinMessage godoc
+// @Summary      Unpin Message
+// @Description  Unpin a message from a chat. In groups only admins and owners can unpin.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Param        messageID path string true "Message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/pins/{messageID} [delete]
+func (c *ChatController) UnpinMessage(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	chat, err := c.chatService.UnpinMessage(r.Context(), userContext.ID, chatID, messageID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// UpdateMessageTTL godoc
+// @Summary      Update Message TTL
+// @Description  Turn disappearing messages on or off for a chat. New messages expire message_ttl seconds after they are sent; 0 turns it off. In groups only admins and owners can change it.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Param        request body model.UpdateMessageTTLRequest true "Message TTL"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/ttl [put]
+func (c *ChatController) UpdateMessageTTL(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	var req model.UpdateMessageTTLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.chatService.UpdateMessageTTL(r.Context(), userContext.ID, chatID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// RequestChatExport godoc
+// @Summary      Request Chat Export
+// @Description  Queue a zip archive of the chat history visible to the current user, with messages.json, an HTML transcript and attachments. Private chat participants can export their chats, groups can only be exported by their owner. A chat.export WebSocket event with a download link is sent when the archive is ready; archives are kept for 7 days.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatExportResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      409  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/exports [post]
+func (c *ChatController) RequestChatExport(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	resp, err := c.chatService.RequestChatExport(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// GetChatExport godoc
+// @Summary      Get Chat Export
+// @Description  Get the status of a chat export requested by the current user. Completed exports include a download link valid for one hour.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        exportID path string true "Export ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatExportResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/exports/{exportID} [get]
+func (c *ChatController) GetChatExport(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	exportIDStr := chi.URLParam(r, "exportID")
+	exportID, err := uuid.Parse(exportIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Export ID"))
+		return
+	}
+
+	resp, err := c.chatService.GetChatExport(r.Context(), userContext.ID, exportID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// ArchiveChat godoc
+// @Summary      Archive Chat
+// @Description  Move a chat to the archive of the current user. The chat returns to the inbox when a new message arrives unless keep_archived is set. Unread counts are kept.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Param        request body model.ArchiveChatRequest false "Archive options"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/archive [post]
+func (c *ChatController) ArchiveChat(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	var req model.ArchiveChatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	chat, err := c.chatService.ArchiveChat(r.Context(), userContext.ID, chatID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// UnarchiveChat godoc
+// @Summary      Unarchive Chat
+// @Description  Move an archived chat back to the inbox of the current user.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/unarchive [post]
+func (c *ChatController) UnarchiveChat(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	chat, err := c.chatService.UnarchiveChat(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// GetArchiveSummary godoc
+// @Summary      Get Archive Summary
+// @Description  Get the number of archived chats and their aggregated unread counts.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ArchiveSummaryResponse}
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/archive [get]
+func (c *ChatController) GetArchiveSummary(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	summary, err := c.chatService.GetArchiveSummary(r.Context(), userContext.ID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, summary)
+}
+
+// PinChat godoc
+// @Summary      Pin Chat
+// @Description  Pin a chat to the top of the current user's chat list, ahead of the chats pinned before it. At most 5 chats can be pinned. Other devices receive a chat.pin event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.PinnedChatsResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      409  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/pin [post]
+func (c *ChatController) PinChat(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	pinned, err := c.chatService.PinChat(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, pinned)
+}
+
+// UnpinChat godoc
+// @Summary      Unpin Chat
+// @Description  Remove a chat from the current user's pinned chats. Other devices receive a chat.pin event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.PinnedChatsResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/unpin [post]
+func (c *ChatController) UnpinChat(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	pinned, err := c.chatService.UnpinChat(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, pinned)
+}
+
+// ReorderPinnedChats godoc
+// @Summary      Reorder Pinned Chats
+// @Description  Set the order of the current user's pinned chats. The request must list every pinned chat exactly once. Other devices receive a chat.pin event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        request body model.ReorderPinnedChatsRequest true "Pinned chats in the new order"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.PinnedChatsResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/pinned [put]
+func (c *ChatController) ReorderPinnedChats(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var req model.ReorderPinnedChatsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	pinned, err := c.chatService.ReorderPinnedChats(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, pinned)
+}
+
+// MuteChat godoc
+// @Summary      Mute Chat
+// @Description  Mute alerts of a chat for the current user, until muted_until or until unmuted. Muted chats still count unread messages; message events carry meta.muted so clients can skip the alert. In group chats, notify_mentions keeps alerting on mentions. Other devices receive a chat.mute event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Param        request body model.MuteChatRequest true "Mute settings"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/mute [put]
+func (c *ChatController) MuteChat(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	var req model.MuteChatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	chat, err := c.chatService.MuteChat(r.Context(), userContext.ID, chatID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// UnmuteChat godoc
+// @Summary      Unmute Chat
+// @Description  Restore alerts of a muted chat for the current user. Other devices receive a chat.mute event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Chat ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{id}/mute [delete]
+func (c *ChatController) UnmuteChat(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "id")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	chat, err := c.chatService.UnmuteChat(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, chat)
+}
+
+// GetChatFolders godoc
+// @Summary      Get Chat Folders
+// @Description  Get the chat folders of the current user in creation order, each with the unread counts of the chats it holds.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  helper.ResponseSuccess{data=[]model.ChatFolderResponse}
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/folders [get]
+func (c *ChatController) GetChatFolders(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	folders, err := c.chatService.GetChatFolders(r.Context(), userContext.ID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, folders)
+}
+
+// CreateChatFolder godoc
+// @Summary      Create Chat Folder
+// @Description  Create a chat folder. A chat belongs to the folder when it is listed in chat_ids, or when the folder has rules and the chat matches all of them. At most 10 folders per user. Other devices receive a chat.folder event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        request body model.ChatFolderRequest true "Chat folder"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatFolderResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/folders [post]
+func (c *ChatController) CreateChatFolder(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var req model.ChatFolderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	folder, err := c.chatService.CreateChatFolder(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, folder)
+}
+
+// UpdateChatFolder godoc
+// @Summary      Update Chat Folder
+// @Description  Replace the name, rules and chats of a chat folder. Other devices receive a chat.folder event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        folderID path string true "Folder ID (UUID)"
+// @Param        request body model.ChatFolderRequest true "Chat folder"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ChatFolderResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/folders/{folderID} [put]
+func (c *ChatController) UpdateChatFolder(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	folderIDStr := chi.URLParam(r, "folderID")
+	folderID, err := uuid.Parse(folderIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Folder ID"))
+		return
+	}
+
+	var req model.ChatFolderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	folder, err := c.chatService.UpdateChatFolder(r.Context(), userContext.ID, folderID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, folder)
+}
+
+// DeleteChatFolder godoc
+// @Summary      Delete Chat Folder
+// @Description  Delete a chat folder. The chats in it are not affected. Other devices receive a chat.folder event.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        folderID path string true "Folder ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/folders/{folderID} [delete]
+func (c *ChatController) DeleteChatFolder(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	folderIDStr := chi.URLParam(r, "folderID")
+	folderID, err := uuid.Parse(folderIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Folder ID"))
+		return
+	}
+
+	if err := c.chatService.DeleteChatFolder(r.Context(), userContext.ID, folderID); err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, nil)
+}
@@ -5,6 +5,7 @@ import (
 	"AtoiTalkAPI/internal/middleware"
 	"AtoiTalkAPI/internal/model"
 	"AtoiTalkAPI/internal/service"
+	"context"
 	"encoding/json"
 	"net/http"
 	"strconv"
@@ -62,7 +63,7 @@ func (c *GroupChatController) CreateGroupChat(w http.ResponseWriter, r *http.Req
 
 // UpdateGroupChat godoc
 // @Summary      Update Group Chat Info
-// @Description  Update group name, description, avatar, or visibility. Only owners or admins can perform this action.
+// @Description  Update group name, description, avatar, visibility, slow mode, or join approval. Only owners or admins can perform this action.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
@@ -501,12 +502,13 @@ func (c *GroupChatController) SearchPublicGroups(w http.ResponseWriter, r *http.
 
 // JoinPublicGroup godoc
 // @Summary      Join Public Group
-// @Description  Join a public group chat.
+// @Description  Join a public group chat. If the group requires approval, a pending join request is created for the admins to review and returned with status 202.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
 // @Param        chatID path string true "Group Chat ID (UUID)"
 // @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Success      202  {object}  helper.ResponseSuccess{data=model.GroupJoinRequestDTO}
 // @Failure      400  {object}  helper.ResponseError
 // @Failure      401  {object}  helper.ResponseError
 // @Failure      403  {object}  helper.ResponseError
@@ -530,26 +532,33 @@ func (c *GroupChatController) JoinPublicGroup(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	resp, err := c.groupChatService.JoinPublicGroup(r.Context(), userContext.ID, chatID)
+	resp, joinRequest, err := c.groupChatService.JoinPublicGroup(r.Context(), userContext.ID, chatID)
 	if err != nil {
 		helper.WriteError(w, err)
 		return
 	}
 
+	if joinRequest != nil {
+		helper.WriteJSON(w, http.StatusAccepted, helper.ResponseSuccess{Data: joinRequest})
+		return
+	}
+
 	helper.WriteSuccess(w, resp)
 }
 
 // JoinGroupByInvite godoc
 // @Summary      Join Group by Invite Code
-// @Description  Join a private or public group using an invite code.
+// @Description  Join a private or public group using an invite code. If the group requires approval, a pending join request is created for the admins to review and returned with status 202.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
 // @Param        request body model.JoinGroupByInviteRequest true "Join Request"
 // @Success      200  {object}  helper.ResponseSuccess{data=model.ChatListResponse}
+// @Success      202  {object}  helper.ResponseSuccess{data=model.GroupJoinRequestDTO}
 // @Failure      400  {object}  helper.ResponseError
 // @Failure      401  {object}  helper.ResponseError
 // @Failure      404  {object}  helper.ResponseError
+// @Failure      409  {object}  helper.ResponseError
 // @Failure      429  {object}  helper.ResponseError
 // @Failure      500  {object}  helper.ResponseError
 // @Security     BearerAuth
@@ -567,7 +576,142 @@ func (c *GroupChatController) JoinGroupByInvite(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	resp, err := c.groupChatService.JoinGroupByInvite(r.Context(), userContext.ID, req.InviteCode)
+	resp, joinRequest, err := c.groupChatService.JoinGroupByInvite(r.Context(), userContext.ID, req.InviteCode)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	if joinRequest != nil {
+		helper.WriteJSON(w, http.StatusAccepted, helper.ResponseSuccess{Data: joinRequest})
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// GetJoinRequests godoc
+// @Summary      List Group Join Requests
+// @Description  List the pending join requests of a group, oldest first. Only owners or admins can perform this action.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        chatID path string true "Group Chat ID (UUID)"
+// @Param        cursor query string false "Pagination cursor"
+// @Param        limit query int false "Number of items per page (default 20, max 50)"
+// @Success      200  {object}  helper.ResponseWithPagination{data=[]model.GroupJoinRequestDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/group/{chatID}/requests [get]
+func (c *GroupChatController) GetJoinRequests(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "chatID")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	limit := 20
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		l, err := strconv.Atoi(limitStr)
+		if err != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid limit"))
+			return
+		}
+		limit = l
+	}
+
+	req := model.GetGroupJoinRequestsRequest{
+		GroupID: chatID,
+		Cursor:  r.URL.Query().Get("cursor"),
+		Limit:   limit,
+	}
+
+	requests, nextCursor, hasNext, err := c.groupChatService.GetJoinRequests(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccessWithPagination(w, requests, nextCursor, hasNext)
+}
+
+// ApproveJoinRequest godoc
+// @Summary      Approve Group Join Request
+// @Description  Approve the pending join request of a user, adding them to the group. Only owners or admins can perform this action.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        chatID path string true "Group Chat ID (UUID)"
+// @Param        userID path string true "Requesting User ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.GroupJoinRequestDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      409  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/group/{chatID}/requests/{userID}/approve [post]
+func (c *GroupChatController) ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	c.reviewJoinRequest(w, r, c.groupChatService.ApproveJoinRequest)
+}
+
+// DeclineJoinRequest godoc
+// @Summary      Decline Group Join Request
+// @Description  Decline the pending join request of a user. The user can request to join again later. Only owners or admins can perform this action.
+// @Tags         chat
+// @Accept       json
+// @Produce      json
+// @Param        chatID path string true "Group Chat ID (UUID)"
+// @Param        userID path string true "Requesting User ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.GroupJoinRequestDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/group/{chatID}/requests/{userID}/decline [post]
+func (c *GroupChatController) DeclineJoinRequest(w http.ResponseWriter, r *http.Request) {
+	c.reviewJoinRequest(w, r, c.groupChatService.DeclineJoinRequest)
+}
+
+func (c *GroupChatController) reviewJoinRequest(w http.ResponseWriter, r *http.Request, review func(ctx context.Context, requestorID, groupID, targetUserID uuid.UUID) (*model.GroupJoinRequestDTO, error)) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "chatID")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	targetUserIDStr := chi.URLParam(r, "userID")
+	targetUserID, err := uuid.Parse(targetUserIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid User ID"))
+		return
+	}
+
+	resp, err := review(r.Context(), userContext.ID, chatID, targetUserID)
 	if err != nil {
 		helper.WriteError(w, err)
 		return
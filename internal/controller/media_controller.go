@@ -60,7 +60,7 @@ func (c *MediaController) UploadMedia(w http.ResponseWriter, r *http.Request) {
 
 // CompleteUpload godoc
 // @Summary      Complete Media Upload
-// @Description  Verify the uploaded object exists and mark pending media as completed.
+// @Description  Verify the uploaded object exists and mark pending media as completed. Voice notes must be Ogg/Opus or M4A audio; their duration and waveform are read from the file.
 // @Tags         media
 // @Accept       json
 // @Produce      json
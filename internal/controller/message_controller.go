@@ -26,7 +26,7 @@ func NewMessageController(messageService *service.MessageService) *MessageContro
 
 // SendMessage godoc
 // @Summary      Send Message
-// @Description  Send a message to a chat (private or group). Supports text and attachments (via IDs).
+// @Description  Send a message to a chat (private or group). Supports text and attachments (via IDs). Sending again with the same client_message_id returns the existing message instead of creating a duplicate. In groups with slow mode, members who sent a message too recently get a 429 with the seconds left.
 // @Tags         message
 // @Accept       json
 // @Produce      json
@@ -221,3 +221,1029 @@ func (c *MessageController) DeleteMessage(w http.ResponseWriter, r *http.Request
 
 	helper.WriteSuccess(w, nil)
 }
+
+// ForwardMessage godoc
+// @Summary      Forward Message
+// @Description  Forward a message to one or more chats (max 10). Attachments are shared with the original and each copy records its original sender and chat.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Message ID (UUID)"
+// @Param        request body model.ForwardMessageRequest true "Forward Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=[]model.MessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/forward [post]
+func (c *MessageController) ForwardMessage(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	var req model.ForwardMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.ForwardMessage(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// ScheduleMessage godoc
+// @Summary      Schedule Message
+// @Description  Compose a message now and have it sent at send_at. The payload is checked with the same rules as Send Message, both now and when it is sent. A user can have at most 100 scheduled messages.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        request body model.ScheduleMessageRequest true "Schedule Message Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ScheduledMessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/scheduled [post]
+func (c *MessageController) ScheduleMessage(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var req model.ScheduleMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.ScheduleMessage(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// GetScheduledMessages godoc
+// @Summary      Get Scheduled Messages
+// @Description  List your scheduled messages that have not been sent yet, ordered by send_at. Includes failed ones with their failure reason.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        chat_id query string false "Only list messages scheduled for this chat (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=[]model.ScheduledMessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/scheduled [get]
+func (c *MessageController) GetScheduledMessages(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var chatID *uuid.UUID
+	if chatIDStr := r.URL.Query().Get("chat_id"); chatIDStr != "" {
+		parsed, err := uuid.Parse(chatIDStr)
+		if err != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+			return
+		}
+		chatID = &parsed
+	}
+
+	resp, err := c.messageService.GetScheduledMessages(r.Context(), userContext.ID, chatID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// UpdateScheduledMessage godoc
+// @Summary      Update Scheduled Message
+// @Description  Replace the content, attachments, reply target and send_at of a scheduled message. Updating a failed message schedules it again.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        scheduledID path string true "Scheduled Message ID (UUID)"
+// @Param        request body model.UpdateScheduledMessageRequest true "Update Scheduled Message Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.ScheduledMessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/scheduled/{scheduledID} [put]
+func (c *MessageController) UpdateScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	scheduledID, err := uuid.Parse(chi.URLParam(r, "scheduledID"))
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Scheduled Message ID"))
+		return
+	}
+
+	var req model.UpdateScheduledMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.UpdateScheduledMessage(r.Context(), userContext.ID, scheduledID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// CancelScheduledMessage godoc
+// @Summary      Cancel Scheduled Message
+// @Description  Cancel a scheduled message before it is sent.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        scheduledID path string true "Scheduled Message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/scheduled/{scheduledID} [delete]
+func (c *MessageController) CancelScheduledMessage(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	scheduledID, err := uuid.Parse(chi.URLParam(r, "scheduledID"))
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Scheduled Message ID"))
+		return
+	}
+
+	if err := c.messageService.CancelScheduledMessage(r.Context(), userContext.ID, scheduledID); err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, nil)
+}
+
+// AddReaction godoc
+// @Summary      Add Reaction
+// @Description  React to a message with an emoji. Adding the same emoji twice is a no-op.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Message ID (UUID)"
+// @Param        request body model.MessageReactionRequest true "Reaction Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageReactionResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/reactions [post]
+func (c *MessageController) AddReaction(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	var req model.MessageReactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.AddReaction(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// RemoveReaction godoc
+// @Summary      Remove Reaction
+// @Description  Remove one of your own emoji reactions from a message.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Message ID (UUID)"
+// @Param        request body model.MessageReactionRequest true "Reaction Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageReactionResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/reactions [delete]
+func (c *MessageController) RemoveReaction(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	var req model.MessageReactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.RemoveReaction(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// GetReactions godoc
+// @Summary      Get Reactions
+// @Description  Get a paginated list of users who reacted to a message, optionally filtered by emoji.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Message ID (UUID)"
+// @Param        emoji query string false "Filter by emoji"
+// @Param        cursor query string false "Pagination cursor"
+// @Param        limit query int false "Number of reactions to fetch (default 20, max 50)"
+// @Success      200  {object}  helper.ResponseWithPagination{data=[]model.MessageReactionDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/reactions [get]
+func (c *MessageController) GetReactions(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	limit := 0
+	limitRaw := r.URL.Query().Get("limit")
+	if limitRaw != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitRaw)
+		if parseErr != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid limit"))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	req := model.GetMessageReactionsRequest{
+		Emoji:  r.URL.Query().Get("emoji"),
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	}
+
+	reactions, nextCursor, hasNext, err := c.messageService.GetReactions(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccessWithPagination(w, reactions, nextCursor, hasNext)
+}
+
+// GetThread godoc
+// @Summary      Get Thread
+// @Description  Get a paginated list of replies to a message, oldest first. Deleted replies are excluded.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Thread root message ID (UUID)"
+// @Param        cursor query string false "Pagination cursor"
+// @Param        limit query int false "Number of replies to fetch (default 20, max 50)"
+// @Success      200  {object}  helper.ResponseWithPagination{data=[]model.MessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/thread [get]
+func (c *MessageController) GetThread(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	limit := 0
+	limitRaw := r.URL.Query().Get("limit")
+	if limitRaw != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitRaw)
+		if parseErr != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid limit"))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	req := model.GetThreadRequest{
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	}
+
+	replies, nextCursor, hasNext, err := c.messageService.GetThread(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccessWithPagination(w, replies, nextCursor, hasNext)
+}
+
+// GetMessageRevisions godoc
+// @Summary      Get Message Revisions
+// @Description  Get the earlier versions of an edited message, oldest first. Each revision holds the content and attachments the message had before an edit.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=[]model.MessageRevisionResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/revisions [get]
+func (c *MessageController) GetMessageRevisions(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	revisions, err := c.messageService.GetMessageRevisions(r.Context(), userContext.ID, messageID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, revisions)
+}
+
+// GetMessageReceipts godoc
+// @Summary      Get Message Receipts
+// @Description  List the group members who have read a message and those whose client only acknowledged receiving it. Only available in group chats; the sender is not listed.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageReceiptsResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/receipts [get]
+func (c *MessageController) GetMessageReceipts(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	receipts, err := c.messageService.GetMessageReceipts(r.Context(), userContext.ID, messageID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, receipts)
+}
+
+// SearchMessages godoc
+// @Summary      Search Messages
+// @Description  Full-text search across every chat the user participates in. Results are ranked by relevance; use message_id as around_message_id to jump to a hit.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        query query string true "Search query (2-100 characters)"
+// @Param        cursor query string false "Pagination cursor"
+// @Param        limit query int false "Number of results to fetch (default 20, max 50)"
+// @Success      200  {object}  helper.ResponseWithPagination{data=[]model.MessageSearchResultDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/search [get]
+func (c *MessageController) SearchMessages(w http.ResponseWriter, r *http.Request) {
+	c.searchMessages(w, r, nil)
+}
+
+// SearchChatMessages godoc
+// @Summary      Search Chat Messages
+// @Description  Full-text search inside a single chat. Results are ranked by relevance; use message_id as around_message_id to jump to a hit.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        chatID path string true "Chat ID (UUID)"
+// @Param        query query string true "Search query (2-100 characters)"
+// @Param        cursor query string false "Pagination cursor"
+// @Param        limit query int false "Number of results to fetch (default 20, max 50)"
+// @Success      200  {object}  helper.ResponseWithPagination{data=[]model.MessageSearchResultDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{chatID}/messages/search [get]
+func (c *MessageController) SearchChatMessages(w http.ResponseWriter, r *http.Request) {
+	chatIDStr := chi.URLParam(r, "chatID")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	c.searchMessages(w, r, &chatID)
+}
+
+func (c *MessageController) searchMessages(w http.ResponseWriter, r *http.Request, chatID *uuid.UUID) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	limit := 0
+	limitRaw := r.URL.Query().Get("limit")
+	if limitRaw != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitRaw)
+		if parseErr != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid limit"))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	req := model.SearchMessagesRequest{
+		ChatID: chatID,
+		Query:  r.URL.Query().Get("query"),
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	}
+
+	results, nextCursor, hasNext, err := c.messageService.SearchMessages(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccessWithPagination(w, results, nextCursor, hasNext)
+}
+
+// GetNextUnreadMention godoc
+// @Summary      Get Next Unread Mention
+// @Description  Get the oldest message in a group chat that mentions the current user (directly or with @all) and comes after their read position. Pass the returned message ID as after_message_id to continue to the following mention, and as around_message_id to load the surrounding messages.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        chatID path string true "Chat ID (UUID)"
+// @Param        after_message_id query string false "Continue after this message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/chats/{chatID}/mentions/next [get]
+func (c *MessageController) GetNextUnreadMention(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	chatIDStr := chi.URLParam(r, "chatID")
+	chatID, err := uuid.Parse(chatIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Chat ID"))
+		return
+	}
+
+	var req model.GetNextMentionRequest
+	if afterStr := r.URL.Query().Get("after_message_id"); afterStr != "" {
+		id, err := uuid.Parse(afterStr)
+		if err != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid after_message_id format"))
+			return
+		}
+		req.AfterMessageID = &id
+	}
+
+	resp, err := c.messageService.GetNextUnreadMention(r.Context(), userContext.ID, chatID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// SendPoll godoc
+// @Summary      Send Poll
+// @Description  Send a poll message to a chat. Quizzes need exactly one correct_option and allow a single answer. The question is also used as the message content.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        request body model.SendPollRequest true "Send Poll Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/polls [post]
+func (c *MessageController) SendPoll(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var req model.SendPollRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.SendPoll(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// VotePoll godoc
+// @Summary      Vote on Poll
+// @Description  Replace the current user's votes on a poll with the given options. Only one option is allowed unless the poll is multiple choice. Quiz answers cannot be changed.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Poll message ID (UUID)"
+// @Param        request body model.PollVoteRequest true "Poll Vote Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.PollDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      409  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/poll/votes [post]
+func (c *MessageController) VotePoll(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	var req model.PollVoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.VotePoll(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// RetractPollVote godoc
+// @Summary      Retract Poll Vote
+// @Description  Remove all of the current user's votes on an open poll. Not available for quizzes.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Poll message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.PollDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/poll/votes [delete]
+func (c *MessageController) RetractPollVote(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	resp, err := c.messageService.RetractPollVote(r.Context(), userContext.ID, messageID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// GetPollVotes godoc
+// @Summary      Get Poll Votes
+// @Description  Get a paginated list of users who voted on a poll, optionally filtered by option. Not available for anonymous polls.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Poll message ID (UUID)"
+// @Param        option_id query string false "Filter by option ID (UUID)"
+// @Param        cursor query string false "Pagination cursor"
+// @Param        limit query int false "Number of votes to fetch (default 20, max 50)"
+// @Success      200  {object}  helper.ResponseWithPagination{data=[]model.PollVoteDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/poll/votes [get]
+func (c *MessageController) GetPollVotes(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	limit := 0
+	limitRaw := r.URL.Query().Get("limit")
+	if limitRaw != "" {
+		parsedLimit, parseErr := strconv.Atoi(limitRaw)
+		if parseErr != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid limit"))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	req := model.GetPollVotesRequest{
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  limit,
+	}
+
+	if optionStr := r.URL.Query().Get("option_id"); optionStr != "" {
+		id, err := uuid.Parse(optionStr)
+		if err != nil {
+			helper.WriteError(w, helper.NewBadRequestError("Invalid option_id format"))
+			return
+		}
+		req.OptionID = &id
+	}
+
+	votes, nextCursor, hasNext, err := c.messageService.GetPollVotes(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccessWithPagination(w, votes, nextCursor, hasNext)
+}
+
+// ClosePoll godoc
+// @Summary      Close Poll
+// @Description  Stop a poll from accepting votes. Allowed for the poll creator and, in groups, for admins and owners.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Poll message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.PollDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/poll/close [post]
+func (c *MessageController) ClosePoll(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	resp, err := c.messageService.ClosePoll(r.Context(), userContext.ID, messageID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// SendLocation godoc
+// @Summary      Send Location
+// @Description  Share a position in a chat. With live_period the location stays live for that many seconds and the sender can keep moving it.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        request body model.SendLocationRequest true "Send Location Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/locations [post]
+func (c *MessageController) SendLocation(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var req model.SendLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.SendLocation(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// UpdateLiveLocation godoc
+// @Summary      Update Live Location
+// @Description  Move the position of a live location while its live period lasts. Only the sender can update it. Chat members receive a message.location event.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Location message ID (UUID)"
+// @Param        request body model.UpdateLiveLocationRequest true "Update Live Location Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.LocationDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/location [put]
+func (c *MessageController) UpdateLiveLocation(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	var req model.UpdateLiveLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.UpdateLiveLocation(r.Context(), userContext.ID, messageID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// StopLiveLocation godoc
+// @Summary      Stop Live Location
+// @Description  End the live period of a live location early. The last position is kept. Chat members receive a message.location event.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        messageID path string true "Location message ID (UUID)"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.LocationDTO}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/{messageID}/location/stop [post]
+func (c *MessageController) StopLiveLocation(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	messageIDStr := chi.URLParam(r, "messageID")
+	messageID, err := uuid.Parse(messageIDStr)
+	if err != nil {
+		helper.WriteError(w, helper.NewBadRequestError("Invalid Message ID"))
+		return
+	}
+
+	resp, err := c.messageService.StopLiveLocation(r.Context(), userContext.ID, messageID)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
+
+// SendContact godoc
+// @Summary      Send Contact
+// @Description  Share another AtoiTalk user as a contact card. Users who blocked the sender, or were blocked by them, cannot be shared.
+// @Tags         message
+// @Accept       json
+// @Produce      json
+// @Param        request body model.SendContactRequest true "Send Contact Request"
+// @Success      200  {object}  helper.ResponseSuccess{data=model.MessageResponse}
+// @Failure      400  {object}  helper.ResponseError
+// @Failure      401  {object}  helper.ResponseError
+// @Failure      403  {object}  helper.ResponseError
+// @Failure      404  {object}  helper.ResponseError
+// @Failure      429  {object}  helper.ResponseError
+// @Failure      500  {object}  helper.ResponseError
+// @Security     BearerAuth
+// @Router       /api/messages/contacts [post]
+func (c *MessageController) SendContact(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(middleware.UserContextKey).(*model.UserDTO)
+	if !ok {
+		helper.WriteError(w, helper.NewUnauthorizedError(""))
+		return
+	}
+
+	var req model.SendContactRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("Invalid request body", "error", err)
+		helper.WriteError(w, helper.NewBadRequestError(""))
+		return
+	}
+
+	resp, err := c.messageService.SendContact(r.Context(), userContext.ID, req)
+	if err != nil {
+		helper.WriteError(w, err)
+		return
+	}
+
+	helper.WriteSuccess(w, resp)
+}
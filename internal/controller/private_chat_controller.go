@@ -21,7 +21,7 @@ func NewPrivateChatController(privateChatService *service.PrivateChatService) *P
 
 // CreatePrivateChat godoc
 // @Summary      Create Private Chat
-// @Description  Create a new private chat with another user. If it already exists, returns the existing chat.
+// @Description  Create a new private chat with another user. If it already exists, returns the existing chat. Using your own ID as target opens your Saved Messages chat, created on first use.
 // @Tags         chat
 // @Accept       json
 // @Produce      json
@@ -5,22 +5,45 @@ import (
 	"AtoiTalkAPI/internal/helper"
 	"AtoiTalkAPI/internal/middleware"
 	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/repository"
+	"AtoiTalkAPI/internal/service"
 	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	ws "github.com/gorilla/websocket"
 )
 
+// Commands share the rate limit buckets of the REST routes they mirror, in the
+// order the routes apply them.
+var wsCommandRateLimits = []struct {
+	key    string
+	limit  int
+	window time.Duration
+}{
+	{"auth_verify", 1000, time.Minute},
+	{"general_read", 200, time.Minute},
+}
+
 type WebSocketController struct {
 	hub            *websocket.Hub
+	authService    *service.AuthService
+	messageService *service.MessageService
+	chatService    *service.ChatService
+	rateLimitRepo  *repository.RateLimitRepository
+	sessionRepo    *repository.SessionRepository
 	allowAllOrigin bool
 	allowedOrigins map[string]struct{}
 }
 
-func NewWebSocketController(hub *websocket.Hub, cfg *config.AppConfig) *WebSocketController {
+func NewWebSocketController(hub *websocket.Hub, cfg *config.AppConfig, authService *service.AuthService, messageService *service.MessageService, chatService *service.ChatService, rateLimitRepo *repository.RateLimitRepository, sessionRepo *repository.SessionRepository) *WebSocketController {
 	allowAllOrigin := false
 	allowedOrigins := make(map[string]struct{})
 
@@ -43,6 +66,11 @@ func NewWebSocketController(hub *websocket.Hub, cfg *config.AppConfig) *WebSocke
 
 	return &WebSocketController{
 		hub:            hub,
+		authService:    authService,
+		messageService: messageService,
+		chatService:    chatService,
+		rateLimitRepo:  rateLimitRepo,
+		sessionRepo:    sessionRepo,
 		allowAllOrigin: allowAllOrigin,
 		allowedOrigins: allowedOrigins,
 	}
@@ -78,7 +106,7 @@ func (c *WebSocketController) checkOrigin(r *http.Request) bool {
 
 // ServeWS godoc
 // @Summary      WebSocket Connection
-// @Description  Upgrade HTTP connection to WebSocket. Requires Bearer token in Authorization header or 'token' query param.
+// @Description  Upgrade HTTP connection to WebSocket. Requires Bearer token in Authorization header or 'token' query param. Besides receiving events, clients can send command frames to send, edit and delete messages and to mark chats as read or hidden (see the AsyncAPI document).
 // @Tags         websocket
 // @Success      101  {string}  string  "Switching Protocols"
 // @Failure      401  {object}  helper.ResponseError
@@ -90,6 +118,7 @@ func (c *WebSocketController) ServeWS(w http.ResponseWriter, r *http.Request) {
 		helper.WriteError(w, helper.NewUnauthorizedError(""))
 		return
 	}
+	token, _ := r.Context().Value(middleware.TokenContextKey).(string)
 
 	upgrader := ws.Upgrader{
 		ReadBufferSize:  1024,
@@ -104,10 +133,12 @@ func (c *WebSocketController) ServeWS(w http.ResponseWriter, r *http.Request) {
 	}
 
 	client := &websocket.Client{
-		Hub:    c.hub,
-		Conn:   conn,
-		Send:   make(chan []byte, 256),
-		UserID: userContext.ID,
+		Hub:      c.hub,
+		Conn:     conn,
+		Send:     make(chan []byte, 256),
+		UserID:   userContext.ID,
+		Token:    token,
+		Commands: c,
 	}
 
 	client.Hub.Register <- client
@@ -115,3 +146,108 @@ func (c *WebSocketController) ServeWS(w http.ResponseWriter, r *http.Request) {
 	go client.WritePump()
 	go client.ReadPump()
 }
+
+type wsMessageIDPayload struct {
+	MessageID uuid.UUID `json:"message_id"`
+}
+
+type wsChatIDPayload struct {
+	ChatID uuid.UUID `json:"chat_id"`
+}
+
+// HandleCommand runs a command frame sent over the socket through the same
+// session check, service methods and rate limits as the REST routes.
+func (c *WebSocketController) HandleCommand(ctx context.Context, userID uuid.UUID, token string, command string, payload json.RawMessage) (interface{}, error) {
+	if err := c.verifySession(ctx, userID, token); err != nil {
+		return nil, err
+	}
+
+	for _, rl := range wsCommandRateLimits {
+		key := fmt.Sprintf("ratelimit:user:%s:%s", rl.key, userID)
+		allowed, _, err := c.rateLimitRepo.Allow(ctx, key, rl.limit, rl.window)
+		if err != nil {
+			slog.Error("Rate limit check failed", "error", err)
+			return nil, helper.NewServiceUnavailableError("Rate limiting service unavailable")
+		}
+		if !allowed {
+			return nil, helper.NewTooManyRequestsError("Rate limit exceeded. Please try again later.")
+		}
+	}
+
+	switch command {
+	case websocket.CommandMessageSend:
+		var req model.SendMessageRequest
+		if err := decodeCommandPayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return c.messageService.SendMessage(ctx, userID, req)
+
+	case websocket.CommandMessageEdit:
+		var target wsMessageIDPayload
+		if err := decodeCommandPayload(payload, &target); err != nil {
+			return nil, err
+		}
+		var req model.EditMessageRequest
+		if err := decodeCommandPayload(payload, &req); err != nil {
+			return nil, err
+		}
+		return c.messageService.EditMessage(ctx, userID, target.MessageID, req)
+
+	case websocket.CommandMessageDelete:
+		var target wsMessageIDPayload
+		if err := decodeCommandPayload(payload, &target); err != nil {
+			return nil, err
+		}
+		return nil, c.messageService.DeleteMessage(ctx, userID, target.MessageID)
+
+	case websocket.CommandChatRead:
+		var target wsChatIDPayload
+		if err := decodeCommandPayload(payload, &target); err != nil {
+			return nil, err
+		}
+		return nil, c.chatService.MarkAsRead(ctx, userID, target.ChatID)
+
+	case websocket.CommandChatHide:
+		var target wsChatIDPayload
+		if err := decodeCommandPayload(payload, &target); err != nil {
+			return nil, err
+		}
+		return nil, c.chatService.HideChat(ctx, userID, target.ChatID)
+	}
+
+	return nil, helper.NewBadRequestError("Unknown command")
+}
+
+// verifySession repeats the checks of the auth middleware for the token the
+// socket was opened with, so a revoked token, session or user stops working
+// while the connection is still open.
+func (c *WebSocketController) verifySession(ctx context.Context, userID uuid.UUID, token string) error {
+	isBlacklisted, err := c.sessionRepo.IsTokenBlacklisted(ctx, token)
+	if err != nil {
+		return helper.NewServiceUnavailableError("Session service unavailable")
+	}
+	if isBlacklisted {
+		return helper.NewUnauthorizedError("Token has been revoked")
+	}
+
+	userContext, err := c.authService.VerifyUser(ctx, token)
+	if err != nil {
+		return err
+	}
+	if userContext.ID != userID {
+		return helper.NewUnauthorizedError("")
+	}
+
+	return nil
+}
+
+func decodeCommandPayload(payload json.RawMessage, v interface{}) error {
+	if len(payload) == 0 {
+		return helper.NewBadRequestError("")
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		slog.Warn("Invalid command payload", "error", err)
+		return helper.NewBadRequestError("")
+	}
+	return nil
+}
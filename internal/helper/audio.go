@@ -0,0 +1,304 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// WaveformBars is the number of bars in a voice note waveform.
+const WaveformBars = 64
+
+var ErrInvalidAudio = errors.New("unsupported or malformed audio container")
+
+// maxVoiceNoteSeconds bounds the duration a file may claim, so the millisecond
+// conversion cannot overflow. Longer files are rejected as malformed.
+const maxVoiceNoteSeconds = 24 * 60 * 60
+
+// VoiceNoteInfo is the playback metadata of a voice note.
+type VoiceNoteInfo struct {
+	DurationMs int
+	Waveform   []int
+}
+
+// ParseVoiceNote reads the duration and a downsampled waveform from an
+// Ogg/Opus or M4A file. Decoding audio is out of scope, so the waveform is
+// approximated from the compressed packet sizes, which track loudness well
+// for the variable bitrate encoders used for speech. Bars range from 0 to 255.
+func ParseVoiceNote(data []byte) (*VoiceNoteInfo, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("OggS")):
+		return parseOggOpus(data)
+	case len(data) >= 8 && string(data[4:8]) == "ftyp":
+		return parseMP4Audio(data)
+	default:
+		return nil, ErrInvalidAudio
+	}
+}
+
+func parseOggOpus(data []byte) (*VoiceNoteInfo, error) {
+	var (
+		serial      uint32
+		started     bool
+		packetIndex int
+		packetSize  int
+		head        []byte
+		preSkip     int64
+		lastGranule int64 = -1
+		sizes       []int
+	)
+
+	for off := 0; off < len(data); {
+		if len(data)-off < 27 || string(data[off:off+4]) != "OggS" {
+			return nil, ErrInvalidAudio
+		}
+		headerType := data[off+5]
+		granule := int64(binary.LittleEndian.Uint64(data[off+6:]))
+		pageSerial := binary.LittleEndian.Uint32(data[off+14:])
+		segments := int(data[off+26])
+
+		bodyStart := off + 27 + segments
+		if bodyStart > len(data) {
+			return nil, ErrInvalidAudio
+		}
+		lacing := data[off+27 : bodyStart]
+		bodyLen := 0
+		for _, l := range lacing {
+			bodyLen += int(l)
+		}
+		if bodyStart+bodyLen > len(data) {
+			return nil, ErrInvalidAudio
+		}
+		next := bodyStart + bodyLen
+
+		if !started {
+			if headerType&0x02 == 0 {
+				return nil, ErrInvalidAudio
+			}
+			serial = pageSerial
+			started = true
+		}
+		// Only the first logical stream is read; a voice note never has more.
+		if pageSerial != serial {
+			off = next
+			continue
+		}
+
+		pos := bodyStart
+		for _, l := range lacing {
+			if packetIndex == 0 {
+				head = append(head, data[pos:pos+int(l)]...)
+			}
+			packetSize += int(l)
+			pos += int(l)
+			if l == 255 {
+				continue
+			}
+
+			switch packetIndex {
+			case 0:
+				if len(head) < 19 || string(head[:8]) != "OpusHead" {
+					return nil, ErrInvalidAudio
+				}
+				preSkip = int64(binary.LittleEndian.Uint16(head[10:12]))
+			case 1:
+				// OpusTags
+			default:
+				sizes = append(sizes, packetSize)
+			}
+			packetIndex++
+			packetSize = 0
+		}
+
+		// A granule position of -1 means no packet finishes on this page.
+		if granule != -1 {
+			lastGranule = granule
+		}
+		off = next
+	}
+
+	if len(sizes) == 0 || lastGranule < preSkip {
+		return nil, ErrInvalidAudio
+	}
+
+	// Opus granule positions always count 48 kHz samples.
+	samples := lastGranule - preSkip
+	if samples/48000 > maxVoiceNoteSeconds {
+		return nil, ErrInvalidAudio
+	}
+
+	return &VoiceNoteInfo{
+		DurationMs: int(samples * 1000 / 48000),
+		Waveform:   waveformFromSizes(sizes),
+	}, nil
+}
+
+type mp4Box struct {
+	kind string
+	body []byte
+}
+
+func readMP4Boxes(data []byte) ([]mp4Box, error) {
+	var boxes []mp4Box
+	for off := 0; off < len(data); {
+		if len(data)-off < 8 {
+			return nil, ErrInvalidAudio
+		}
+		size := uint64(binary.BigEndian.Uint32(data[off:]))
+		kind := string(data[off+4 : off+8])
+		header := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data) - off)
+		case 1:
+			if len(data)-off < 16 {
+				return nil, ErrInvalidAudio
+			}
+			size = binary.BigEndian.Uint64(data[off+8:])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)-off) {
+			return nil, ErrInvalidAudio
+		}
+		boxes = append(boxes, mp4Box{kind: kind, body: data[off+int(header) : off+int(size)]})
+		off += int(size)
+	}
+	return boxes, nil
+}
+
+func findMP4Box(boxes []mp4Box, kind string) []byte {
+	for _, b := range boxes {
+		if b.kind == kind {
+			return b.body
+		}
+	}
+	return nil
+}
+
+func parseMP4Audio(data []byte) (*VoiceNoteInfo, error) {
+	top, err := readMP4Boxes(data)
+	if err != nil {
+		return nil, err
+	}
+	moov := findMP4Box(top, "moov")
+	if moov == nil {
+		return nil, ErrInvalidAudio
+	}
+	tracks, err := readMP4Boxes(moov)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, trak := range tracks {
+		if trak.kind != "trak" {
+			continue
+		}
+		info, err := parseMP4SoundTrack(trak.body)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil {
+			return info, nil
+		}
+	}
+	return nil, ErrInvalidAudio
+}
+
+// parseMP4SoundTrack returns nil without an error when the track is not audio.
+func parseMP4SoundTrack(trak []byte) (*VoiceNoteInfo, error) {
+	children, err := readMP4Boxes(trak)
+	if err != nil {
+		return nil, err
+	}
+	mdia, err := readMP4Boxes(findMP4Box(children, "mdia"))
+	if err != nil {
+		return nil, err
+	}
+
+	hdlr := findMP4Box(mdia, "hdlr")
+	if len(hdlr) < 12 || string(hdlr[8:12]) != "soun" {
+		return nil, nil
+	}
+
+	mdhd := findMP4Box(mdia, "mdhd")
+	var timescale, duration uint64
+	switch {
+	case len(mdhd) >= 20 && mdhd[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(mdhd[12:]))
+		duration = uint64(binary.BigEndian.Uint32(mdhd[16:]))
+	case len(mdhd) >= 32 && mdhd[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(mdhd[20:]))
+		duration = binary.BigEndian.Uint64(mdhd[24:])
+	default:
+		return nil, ErrInvalidAudio
+	}
+	if timescale == 0 || duration/timescale > maxVoiceNoteSeconds {
+		return nil, ErrInvalidAudio
+	}
+
+	minf, err := readMP4Boxes(findMP4Box(mdia, "minf"))
+	if err != nil {
+		return nil, err
+	}
+	stbl, err := readMP4Boxes(findMP4Box(minf, "stbl"))
+	if err != nil {
+		return nil, err
+	}
+	stsz := findMP4Box(stbl, "stsz")
+	if len(stsz) < 12 {
+		return nil, ErrInvalidAudio
+	}
+	sampleSize := int(binary.BigEndian.Uint32(stsz[4:]))
+	count := int(binary.BigEndian.Uint32(stsz[8:]))
+	if count == 0 {
+		return nil, ErrInvalidAudio
+	}
+
+	sizes := make([]int, 0, min(count, len(stsz)/4))
+	if sampleSize != 0 {
+		for range min(count, WaveformBars) {
+			sizes = append(sizes, sampleSize)
+		}
+	} else {
+		if len(stsz) < 12+count*4 {
+			return nil, ErrInvalidAudio
+		}
+		for i := range count {
+			sizes = append(sizes, int(binary.BigEndian.Uint32(stsz[12+i*4:])))
+		}
+	}
+
+	return &VoiceNoteInfo{
+		DurationMs: int(duration * 1000 / timescale),
+		Waveform:   waveformFromSizes(sizes),
+	}, nil
+}
+
+// waveformFromSizes averages the packet sizes into at most WaveformBars bars
+// and stretches them over 0-255. Constant bitrate audio comes out flat.
+func waveformFromSizes(sizes []int) []int {
+	bars := min(WaveformBars, len(sizes))
+	waveform := make([]int, bars)
+	low, high := -1, 0
+	for i := range bars {
+		from, to := i*len(sizes)/bars, (i+1)*len(sizes)/bars
+		sum := 0
+		for _, size := range sizes[from:to] {
+			sum += size
+		}
+		waveform[i] = sum / (to - from)
+		if low < 0 || waveform[i] < low {
+			low = waveform[i]
+		}
+		high = max(high, waveform[i])
+	}
+
+	for i, v := range waveform {
+		if high == low {
+			waveform[i] = 255
+		} else {
+			waveform[i] = (v - low) * 255 / (high - low)
+		}
+	}
+	return waveform
+}
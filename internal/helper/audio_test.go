@@ -0,0 +1,150 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func oggPage(headerType byte, granule int64, serial uint32, packets ...[]byte) []byte {
+	var lacing, body []byte
+	for _, p := range packets {
+		n := len(p)
+		for n >= 255 {
+			lacing = append(lacing, 255)
+			n -= 255
+		}
+		lacing = append(lacing, byte(n))
+		body = append(body, p...)
+	}
+
+	page := make([]byte, 27)
+	copy(page, "OggS")
+	page[5] = headerType
+	binary.LittleEndian.PutUint64(page[6:], uint64(granule))
+	binary.LittleEndian.PutUint32(page[14:], serial)
+	page[26] = byte(len(lacing))
+	return append(append(page, lacing...), body...)
+}
+
+func opusHead(preSkip uint16) []byte {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1
+	head[9] = 1
+	binary.LittleEndian.PutUint16(head[10:], preSkip)
+	binary.LittleEndian.PutUint32(head[12:], 48000)
+	return head
+}
+
+func buildMP4Box(kind string, children ...[]byte) []byte {
+	body := bytes.Join(children, nil)
+	box := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(box, uint32(8+len(body)))
+	copy(box[4:], kind)
+	return append(box, body...)
+}
+
+func mp4Audio(timescale, duration uint32, sampleSizes []uint32) []byte {
+	mdhd := make([]byte, 24)
+	binary.BigEndian.PutUint32(mdhd[12:], timescale)
+	binary.BigEndian.PutUint32(mdhd[16:], duration)
+
+	hdlr := make([]byte, 24)
+	copy(hdlr[8:], "soun")
+
+	stsz := make([]byte, 12+4*len(sampleSizes))
+	binary.BigEndian.PutUint32(stsz[8:], uint32(len(sampleSizes)))
+	for i, size := range sampleSizes {
+		binary.BigEndian.PutUint32(stsz[12+4*i:], size)
+	}
+
+	videoHdlr := make([]byte, 24)
+	copy(videoHdlr[8:], "vide")
+
+	return bytes.Join([][]byte{
+		buildMP4Box("ftyp", []byte("M4A \x00\x00\x00\x00")),
+		buildMP4Box("moov",
+			buildMP4Box("trak", buildMP4Box("mdia", buildMP4Box("hdlr", videoHdlr))),
+			buildMP4Box("trak", buildMP4Box("mdia",
+				buildMP4Box("mdhd", mdhd),
+				buildMP4Box("hdlr", hdlr),
+				buildMP4Box("minf", buildMP4Box("stbl", buildMP4Box("stsz", stsz))),
+			)),
+		),
+		buildMP4Box("mdat", make([]byte, 32)),
+	}, nil)
+}
+
+func TestParseVoiceNoteOggOpus(t *testing.T) {
+	tags := append([]byte("OpusTags"), make([]byte, 300)...)
+	var audio [][]byte
+	for i := range 100 {
+		audio = append(audio, make([]byte, 10+i))
+	}
+
+	data := oggPage(0x02, 0, 7, opusHead(312))
+	data = append(data, oggPage(0, 0, 7, tags)...)
+	data = append(data, oggPage(0, -1, 9, []byte("other stream"))...)
+	data = append(data, oggPage(0, 48000, 7, audio[:50]...)...)
+	data = append(data, oggPage(0x04, 96312, 7, audio[50:]...)...)
+
+	info, err := ParseVoiceNote(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.DurationMs != 2000 {
+		t.Fatalf("expected 2000ms, got %d", info.DurationMs)
+	}
+	if len(info.Waveform) != WaveformBars {
+		t.Fatalf("expected %d bars, got %d", WaveformBars, len(info.Waveform))
+	}
+	if info.Waveform[0] != 0 || info.Waveform[WaveformBars-1] != 255 {
+		t.Fatalf("expected rising waveform from 0 to 255, got %v", info.Waveform)
+	}
+}
+
+func TestParseVoiceNoteM4A(t *testing.T) {
+	info, err := ParseVoiceNote(mp4Audio(44100, 66150, []uint32{100, 400, 250, 100}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.DurationMs != 1500 {
+		t.Fatalf("expected 1500ms, got %d", info.DurationMs)
+	}
+	expected := []int{0, 255, 127, 0}
+	if len(info.Waveform) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, info.Waveform)
+	}
+	for i := range expected {
+		if info.Waveform[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, info.Waveform)
+		}
+	}
+}
+
+func TestParseVoiceNoteRejectsInvalidInput(t *testing.T) {
+	truncated := oggPage(0x02, 0, 1, opusHead(0))
+	vorbis := oggPage(0x02, 0, 1, append([]byte("\x01vorbis"), make([]byte, 20)...))
+	noAudio := append(oggPage(0x02, 0, 1, opusHead(0)), oggPage(0x04, 0, 1, []byte("OpusTags"))...)
+	m4a := mp4Audio(44100, 44100, []uint32{10, 20})
+	endless := append(oggPage(0x02, 0, 1, opusHead(0)), oggPage(0, 0, 1, []byte("OpusTags"))...)
+	endless = append(endless, oggPage(0x04, 1<<62, 1, make([]byte, 10))...)
+
+	cases := map[string][]byte{
+		"empty":            nil,
+		"plain text":       []byte("definitely not audio"),
+		"truncated page":   truncated[:len(truncated)-5],
+		"vorbis stream":    vorbis,
+		"no audio packets": noAudio,
+		"truncated m4a":    m4a[:len(m4a)-20],
+		"endless ogg":      endless,
+		"endless m4a":      mp4Audio(1, 1<<32-1, []uint32{10, 20}),
+	}
+	for name, data := range cases {
+		if _, err := ParseVoiceNote(data); !errors.Is(err, ErrInvalidAudio) {
+			t.Fatalf("%s: expected ErrInvalidAudio, got %v", name, err)
+		}
+	}
+}
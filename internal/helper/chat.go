@@ -10,6 +10,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// SavedMessagesName is the display name of a user's self-chat.
+const SavedMessagesName = "Saved Messages"
+
 type BlockStatus struct {
 	BlockedByMe    bool
 	BlockedByOther bool
@@ -19,19 +22,29 @@ func MapChatToResponse(userID uuid.UUID, c *ent.Chat, blockedMap map[uuid.UUID]B
 	var name, avatar string
 	var description *string
 	var isPublic *bool
+	var requiresApproval *bool
 	var inviteCode *string
 	var inviteExpiresAt *string
 	var lastReadAt *string
 	var otherLastReadAt *string
 	var hiddenAtStr *string
 	var unreadCount int
+	var unreadMentionCount int
+	var isMarkedUnread bool
 	var isOnline bool
 	var otherUserID *uuid.UUID
 	var otherUserIsDeleted bool
 	var otherUserIsBanned bool
 	var isBlockedByMe bool
 	var myRole *string
+	var slowModeSeconds *int
 	var hiddenAt *time.Time
+	var isArchived bool
+	var keepArchived bool
+	var isMuted bool
+	var mutedUntil *time.Time
+	var notifyMentions bool
+	var isSavedMessages bool
 
 	if c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil {
 		pc := c.Edges.PrivateChat
@@ -44,16 +57,29 @@ func MapChatToResponse(userID uuid.UUID, c *ent.Chat, blockedMap map[uuid.UUID]B
 			myLastRead = pc.User1LastReadAt
 			otherUserLastRead = pc.User2LastReadAt
 			unreadCount = pc.User1UnreadCount
+			isMarkedUnread = pc.User1MarkedUnread
 			hiddenAt = pc.User1HiddenAt
+			isArchived = IsChatArchived(pc.User1ArchivedAt, pc.User1KeepArchived, c.LastMessageAt)
+			keepArchived = isArchived && pc.User1KeepArchived
+			isMuted = IsChatMuted(pc.User1Muted, pc.User1MutedUntil, time.Now())
+			mutedUntil = pc.User1MutedUntil
 		} else if pc.User2ID != nil && *pc.User2ID == userID {
 			otherUser = pc.Edges.User1
 			myLastRead = pc.User2LastReadAt
 			otherUserLastRead = pc.User1LastReadAt
 			unreadCount = pc.User2UnreadCount
+			isMarkedUnread = pc.User2MarkedUnread
 			hiddenAt = pc.User2HiddenAt
+			isArchived = IsChatArchived(pc.User2ArchivedAt, pc.User2KeepArchived, c.LastMessageAt)
+			keepArchived = isArchived && pc.User2KeepArchived
+			isMuted = IsChatMuted(pc.User2Muted, pc.User2MutedUntil, time.Now())
+			mutedUntil = pc.User2MutedUntil
 		}
 
-		if otherUser != nil {
+		if IsSavedMessages(pc) {
+			isSavedMessages = true
+			name = SavedMessagesName
+		} else if otherUser != nil {
 			otherUserID = &otherUser.ID
 
 			if otherUser.DeletedAt != nil {
@@ -111,6 +137,10 @@ func MapChatToResponse(userID uuid.UUID, c *ent.Chat, blockedMap map[uuid.UUID]B
 		name = gc.Name
 		description = gc.Description
 		isPublic = &gc.IsPublic
+		requiresApproval = &gc.RequiresApproval
+		if gc.SlowModeSeconds > 0 {
+			slowModeSeconds = &gc.SlowModeSeconds
+		}
 		if gc.Edges.Avatar != nil {
 			avatar = urlGen.GetPublicURL(gc.Edges.Avatar.FileName)
 		}
@@ -122,6 +152,13 @@ func MapChatToResponse(userID uuid.UUID, c *ent.Chat, blockedMap map[uuid.UUID]B
 		if len(gc.Edges.Members) > 0 {
 			member := gc.Edges.Members[0]
 			unreadCount = member.UnreadCount
+			unreadMentionCount = member.UnreadMentionCount
+			isMarkedUnread = member.MarkedUnread
+			isArchived = IsChatArchived(member.ArchivedAt, member.KeepArchived, c.LastMessageAt)
+			keepArchived = isArchived && member.KeepArchived
+			isMuted = IsChatMuted(member.Muted, member.MutedUntil, time.Now())
+			mutedUntil = member.MutedUntil
+			notifyMentions = member.NotifyMentions
 			roleStr := string(member.Role)
 			myRole = &roleStr
 			if member.LastReadAt != nil {
@@ -139,6 +176,12 @@ func MapChatToResponse(userID uuid.UUID, c *ent.Chat, blockedMap map[uuid.UUID]B
 		}
 	}
 
+	var mutedUntilStr *string
+	if isMuted && mutedUntil != nil {
+		t := mutedUntil.Format(time.RFC3339)
+		mutedUntilStr = &t
+	}
+
 	var lastMsgResp *model.MessageResponse
 	if c.Edges.LastMessage != nil {
 		lastMsgResp = ToMessageResponse(c.Edges.LastMessage, urlGen, hiddenAt, "")
@@ -150,19 +193,83 @@ func MapChatToResponse(userID uuid.UUID, c *ent.Chat, blockedMap map[uuid.UUID]B
 		Name:               name,
 		Description:        description,
 		IsPublic:           isPublic,
+		RequiresApproval:   requiresApproval,
 		InviteCode:         inviteCode,
 		InviteExpiresAt:    inviteExpiresAt,
 		Avatar:             avatar,
 		LastMessage:        lastMsgResp,
 		UnreadCount:        unreadCount,
+		UnreadMentionCount: unreadMentionCount,
+		IsMarkedUnread:     isMarkedUnread,
 		LastReadAt:         lastReadAt,
 		OtherLastReadAt:    otherLastReadAt,
 		HiddenAt:           hiddenAtStr,
+		IsArchived:         isArchived,
+		KeepArchived:       keepArchived,
+		IsPinned:           len(c.Edges.PinnedBy) > 0,
+		IsMuted:            isMuted,
+		MutedUntil:         mutedUntilStr,
+		NotifyMentions:     notifyMentions,
 		IsOnline:           isOnline,
+		IsSavedMessages:    isSavedMessages,
 		OtherUserID:        otherUserID,
 		OtherUserIsDeleted: otherUserIsDeleted,
 		OtherUserIsBanned:  otherUserIsBanned,
 		IsBlockedByMe:      isBlockedByMe,
+		PinnedMessages:     MapPinnedMessages(c.Edges.PinnedMessages, hiddenAt),
+		MessageTTL:         c.MessageTTL,
+		SlowModeSeconds:    slowModeSeconds,
 		MyRole:             myRole,
 	}
 }
+
+// IsSavedMessages reports whether pc is a self-chat, the Saved Messages of the
+// one user that takes part in it.
+func IsSavedMessages(pc *ent.PrivateChat) bool {
+	return pc != nil && pc.User1ID != nil && pc.User2ID != nil && *pc.User1ID == *pc.User2ID
+}
+
+// IsChatArchived reports whether a chat archived at archivedAt is still in the
+// archive. New messages move it back to the inbox unless keepArchived is set.
+func IsChatArchived(archivedAt *time.Time, keepArchived bool, lastMessageAt *time.Time) bool {
+	if archivedAt == nil {
+		return false
+	}
+	return keepArchived || lastMessageAt == nil || !archivedAt.Before(*lastMessageAt)
+}
+
+// IsChatMuted reports whether a mute is in effect at now. A mute without an end
+// lasts until the user clears it.
+func IsChatMuted(muted bool, mutedUntil *time.Time, now time.Time) bool {
+	return muted && (mutedUntil == nil || now.Before(*mutedUntil))
+}
+
+// MapPinnedMessages converts loaded pins into previews, skipping messages the
+// viewer hid by clearing a private chat.
+func MapPinnedMessages(pins []*ent.PinnedMessage, hiddenAt *time.Time) []model.PinnedMessageDTO {
+	if len(pins) == 0 {
+		return nil
+	}
+
+	result := make([]model.PinnedMessageDTO, 0, len(pins))
+	for _, p := range pins {
+		msg := p.Edges.Message
+		if msg == nil || msg.DeletedAt != nil {
+			continue
+		}
+		if hiddenAt != nil && !msg.CreatedAt.After(*hiddenAt) {
+			continue
+		}
+
+		result = append(result, model.PinnedMessageDTO{
+			Message:    ToReplyPreviewDTO(msg),
+			PinnedByID: p.PinnedByID,
+			PinnedAt:   p.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
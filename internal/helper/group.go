@@ -4,6 +4,8 @@ import (
 	"AtoiTalkAPI/ent"
 	"AtoiTalkAPI/internal/model"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 func ToGroupMemberDTO(m *ent.GroupMember, urlGen URLGenerator) model.GroupMemberDTO {
@@ -43,3 +45,34 @@ func ToGroupMemberDTO(m *ent.GroupMember, urlGen URLGenerator) model.GroupMember
 		IsBanned: isBanned,
 	}
 }
+
+// ToGroupJoinRequestDTO maps a join request loaded with its user. chatID is the
+// ID of the group's chat, which clients address groups by.
+func ToGroupJoinRequestDTO(r *ent.GroupJoinRequest, chatID uuid.UUID, urlGen URLGenerator) model.GroupJoinRequestDTO {
+	dto := model.GroupJoinRequestDTO{
+		ID:          r.ID,
+		ChatID:      chatID,
+		UserID:      r.UserID,
+		Status:      string(r.Status),
+		RequestedAt: r.RequestedAt.Format(time.RFC3339),
+	}
+
+	if user := r.Edges.User; user != nil {
+		if user.Edges.Avatar != nil {
+			dto.Avatar = urlGen.GetPublicURL(user.Edges.Avatar.FileName)
+		}
+		if user.FullName != nil {
+			dto.FullName = *user.FullName
+		}
+		if user.Username != nil {
+			dto.Username = *user.Username
+		}
+	}
+
+	if r.ReviewedAt != nil {
+		t := r.ReviewedAt.Format(time.RFC3339)
+		dto.ReviewedAt = &t
+	}
+
+	return dto
+}
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"]+`)
+
+// ExtractFirstURL returns the first absolute http or https URL in content, or an
+// empty string. Trailing punctuation that usually ends a sentence is not part of
+// the URL, and a closing parenthesis is only kept when the URL opened one.
+func ExtractFirstURL(content string) string {
+	for _, candidate := range linkPattern.FindAllString(content, -1) {
+		for len(candidate) > 0 {
+			last := candidate[len(candidate)-1]
+			if strings.IndexByte(".,;:!?'\"]}", last) >= 0 ||
+				(last == ')' && strings.Count(candidate, "(") < strings.Count(candidate, ")")) {
+				candidate = candidate[:len(candidate)-1]
+				continue
+			}
+			break
+		}
+
+		u, err := url.Parse(candidate)
+		if err != nil || u.Host == "" || u.User != nil {
+			continue
+		}
+		return candidate
+	}
+
+	return ""
+}
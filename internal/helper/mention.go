@@ -0,0 +1,59 @@
+package helper
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// MentionAll is the username that mentions every member of a group.
+const MentionAll = "all"
+
+// MentionToken is an @username occurrence in message content. Offset and Length
+// are counted in UTF-16 code units and include the leading "@".
+type MentionToken struct {
+	Username string
+	Offset   int
+	Length   int
+}
+
+// ParseMentions returns the first occurrence of every distinct @username in
+// content, in order of appearance. Usernames are normalized to lower case.
+// An "@" only starts a mention at the beginning of the content or after a
+// character that cannot be part of a username, so e-mail addresses are skipped.
+func ParseMentions(content string) []MentionToken {
+	var tokens []MentionToken
+	seen := make(map[string]bool)
+
+	runes := []rune(content)
+	offset := 0
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' || (i > 0 && isUsernameRune(runes[i-1])) {
+			offset += utf16.RuneLen(runes[i])
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isUsernameRune(runes[end]) {
+			end++
+		}
+
+		length := end - i
+		if length > 1 {
+			username := strings.ToLower(string(runes[i+1 : end]))
+			if !seen[username] {
+				seen[username] = true
+				tokens = append(tokens, MentionToken{Username: username, Offset: offset, Length: length})
+			}
+		}
+
+		// Username runes are ASCII, so each is a single UTF-16 code unit.
+		offset += length
+		i = end - 1
+	}
+
+	return tokens
+}
+
+func isUsernameRune(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
@@ -2,12 +2,31 @@ package helper
 
 import (
 	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/schema"
 	"AtoiTalkAPI/internal/model"
+	"html"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Sentinels placed around matched terms by the search query. They are control
+// characters so they cannot collide with the HTML produced by FormatSearchSnippet.
+const (
+	SearchHighlightStart = "\x02"
+	SearchHighlightStop  = "\x03"
+)
+
+// FormatSearchSnippet HTML-escapes a raw search snippet and wraps matched terms in <mark> tags.
+func FormatSearchSnippet(raw string) string {
+	escaped := html.EscapeString(raw)
+	escaped = strings.ReplaceAll(escaped, SearchHighlightStart, "<mark>")
+	return strings.ReplaceAll(escaped, SearchHighlightStop, "</mark>")
+}
+
 func ToMessageResponse(msg *ent.Message, urlGen URLGenerator, hiddenAt *time.Time, senderRole string) *model.MessageResponse {
 	if msg == nil {
 		return nil
@@ -19,6 +38,11 @@ func ToMessageResponse(msg *ent.Message, urlGen URLGenerator, hiddenAt *time.Tim
 	var content string
 	attachments := make([]model.MediaDTO, 0)
 	var actionData map[string]interface{}
+	var mentions []model.MentionDTO
+	var linkPreview *model.LinkPreviewDTO
+	var clientMessageID string
+	var location *model.LocationDTO
+	var contact *model.ContactDTO
 
 	if isDeleted {
 		t := msg.DeletedAt.Format(time.RFC3339)
@@ -43,9 +67,29 @@ func ToMessageResponse(msg *ent.Message, urlGen URLGenerator, hiddenAt *time.Tim
 				OriginalName: att.OriginalName,
 				FileSize:     att.FileSize,
 				MimeType:     att.MimeType,
+				Category:     string(att.Category),
 				URL:          url,
+				DurationMs:   att.DurationMs,
+				Waveform:     att.Waveform,
 			})
 		}
+		mentions = ToMentionDTOs(msg.Edges.Mentions)
+		if msg.ClientMessageID != nil {
+			clientMessageID = *msg.ClientMessageID
+		}
+		if msg.LinkPreview != nil && msg.LinkPreview.URL != "" {
+			linkPreview = &model.LinkPreviewDTO{
+				URL:         msg.LinkPreview.URL,
+				SiteName:    msg.LinkPreview.SiteName,
+				Title:       msg.LinkPreview.Title,
+				Description: msg.LinkPreview.Description,
+			}
+			if msg.LinkPreview.ImagePath != "" {
+				linkPreview.Image = urlGen.GetPublicURL(msg.LinkPreview.ImagePath)
+			}
+		}
+		location = ToLocationDTO(msg.Location, time.Now().UTC())
+		contact = ToContactDTO(msg, urlGen)
 	}
 
 	var senderName string
@@ -67,66 +111,264 @@ func ToMessageResponse(msg *ent.Message, urlGen URLGenerator, hiddenAt *time.Tim
 		}
 	}
 
-	var replyPreview *model.ReplyPreviewDTO
-	if reply := msg.Edges.ReplyTo; reply != nil {
-		replyContent := ""
-		var replyDeletedAt *string
-		var replyActionData map[string]interface{}
-		replySenderName := ""
-		var replySenderID *uuid.UUID
-
-		if reply.Edges.Sender != nil {
-			if reply.Edges.Sender.DeletedAt != nil {
-				replySenderName = "Deleted User"
-				replySenderID = nil
-			} else {
-				if reply.Edges.Sender.FullName != nil {
-					replySenderName = *reply.Edges.Sender.FullName
-				}
-				replySenderID = &reply.Edges.Sender.ID
-			}
-		} else if reply.SenderID != nil {
-			replySenderID = reply.SenderID
+	replyPreview := ToReplyPreviewDTO(msg.Edges.ReplyTo)
+
+	var lastReplyAtStr *string
+	if msg.LastReplyAt != nil {
+		t := msg.LastReplyAt.Format(time.RFC3339)
+		lastReplyAtStr = &t
+	}
+
+	var expiresAtStr *string
+	if msg.ExpiresAt != nil {
+		t := msg.ExpiresAt.Format(time.RFC3339)
+		expiresAtStr = &t
+	}
+
+	var forwardedFrom *model.ForwardedFromDTO
+	if msg.ForwardedFromSenderID != nil || msg.ForwardedFromChatID != nil {
+		forwardedFrom = &model.ForwardedFromDTO{
+			SenderID: msg.ForwardedFromSenderID,
+			ChatID:   msg.ForwardedFromChatID,
+		}
+
+		origin := msg.Edges.ForwardedFromSender
+		switch {
+		case msg.ForwardedFromSenderID == nil, origin != nil && origin.DeletedAt != nil:
+			forwardedFrom.SenderID = nil
+			forwardedFrom.SenderName = "Deleted User"
+		case origin != nil && origin.FullName != nil:
+			forwardedFrom.SenderName = *origin.FullName
 		}
+	}
 
-		if reply.DeletedAt != nil {
-			t := reply.DeletedAt.Format(time.RFC3339)
-			replyDeletedAt = &t
+	return &model.MessageResponse{
+		ID:              msg.ID,
+		ChatID:          msg.ChatID,
+		SenderID:        senderID,
+		SenderName:      senderName,
+		SenderAvatar:    senderAvatar,
+		SenderRole:      senderRole,
+		Type:            string(msg.Type),
+		Content:         content,
+		ClientMessageID: clientMessageID,
+		ActionData:      actionData,
+		Attachments:     attachments,
+		LinkPreview:     linkPreview,
+		ReplyTo:         replyPreview,
+		ForwardedFrom:   forwardedFrom,
+		ReplyCount:      msg.ReplyCount,
+		LastReplyAt:     lastReplyAtStr,
+		ExpiresAt:       expiresAtStr,
+		CreatedAt:       msg.CreatedAt.Format(time.RFC3339),
+		DeletedAt:       deletedAtStr,
+		EditedAt:        editedAtStr,
+		Mentions:        mentions,
+		Location:        location,
+		Contact:         contact,
+	}
+}
+
+// ToLocationDTO maps the position of a location message. A live location is no
+// longer live once its period has ended.
+func ToLocationDTO(loc *schema.Location, now time.Time) *model.LocationDTO {
+	if loc == nil {
+		return nil
+	}
+
+	dto := &model.LocationDTO{
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Accuracy:  loc.Accuracy,
+		VenueName: loc.VenueName,
+	}
+	if loc.LiveUntil != nil {
+		t := loc.LiveUntil.Format(time.RFC3339)
+		dto.LiveUntil = &t
+		dto.IsLive = now.Before(*loc.LiveUntil)
+	}
+	if loc.UpdatedAt != nil {
+		t := loc.UpdatedAt.Format(time.RFC3339)
+		dto.UpdatedAt = &t
+	}
+	return dto
+}
+
+// ToContactDTO maps the user shared by a contact message. Profile fields are
+// filled when the contact_user edge is loaded, the avatar only when urlGen is set.
+func ToContactDTO(msg *ent.Message, urlGen URLGenerator) *model.ContactDTO {
+	if msg.Type != message.TypeContact {
+		return nil
+	}
+
+	u := msg.Edges.ContactUser
+	if msg.ContactUserID == nil || (u != nil && u.DeletedAt != nil) {
+		return &model.ContactDTO{FullName: "Deleted User"}
+	}
+
+	dto := &model.ContactDTO{UserID: msg.ContactUserID}
+	if u != nil {
+		if u.Username != nil {
+			dto.Username = *u.Username
+		}
+		if u.FullName != nil {
+			dto.FullName = *u.FullName
+		}
+		if u.Edges.Avatar != nil && urlGen != nil {
+			dto.Avatar = urlGen.GetPublicURL(u.Edges.Avatar.FileName)
+		}
+	}
+	return dto
+}
+
+// ToMentionDTOs maps the stored mentions of a message, ordered by position.
+func ToMentionDTOs(mentions []*ent.MessageMention) []model.MentionDTO {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	result := make([]model.MentionDTO, 0, len(mentions))
+	for _, m := range mentions {
+		result = append(result, model.MentionDTO{
+			Type:   string(m.Type),
+			UserID: m.UserID,
+			Offset: m.Offset,
+			Length: m.Length,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Offset < result[j].Offset
+	})
+	return result
+}
+
+// ToReplyPreviewDTO builds the compact preview used for replies and pinned messages.
+func ToReplyPreviewDTO(msg *ent.Message) *model.ReplyPreviewDTO {
+	if msg == nil {
+		return nil
+	}
+
+	content := ""
+	var deletedAt *string
+	var actionData map[string]interface{}
+	var location *model.LocationDTO
+	var contact *model.ContactDTO
+	senderName := ""
+	var senderID *uuid.UUID
+
+	if msg.Edges.Sender != nil {
+		if msg.Edges.Sender.DeletedAt != nil {
+			senderName = "Deleted User"
+			senderID = nil
 		} else {
-			if reply.Content != nil {
-				replyContent = *reply.Content
-			}
-			if reply.ActionData != nil {
-				replyActionData = reply.ActionData
+			if msg.Edges.Sender.FullName != nil {
+				senderName = *msg.Edges.Sender.FullName
 			}
+			senderID = &msg.Edges.Sender.ID
 		}
+	} else if msg.SenderID != nil {
+		senderID = msg.SenderID
+	}
 
-		replyPreview = &model.ReplyPreviewDTO{
-			ID:         reply.ID,
-			SenderID:   replySenderID,
-			SenderName: replySenderName,
-			Type:       string(reply.Type),
-			Content:    replyContent,
-			ActionData: replyActionData,
-			DeletedAt:  replyDeletedAt,
-			CreatedAt:  reply.CreatedAt.Format(time.RFC3339),
+	if msg.DeletedAt != nil {
+		t := msg.DeletedAt.Format(time.RFC3339)
+		deletedAt = &t
+	} else {
+		if msg.Content != nil {
+			content = *msg.Content
+		}
+		if msg.ActionData != nil {
+			actionData = msg.ActionData
 		}
+		location = ToLocationDTO(msg.Location, time.Now().UTC())
+		contact = ToContactDTO(msg, nil)
 	}
 
-	return &model.MessageResponse{
-		ID:           msg.ID,
-		ChatID:       msg.ChatID,
-		SenderID:     senderID,
-		SenderName:   senderName,
-		SenderAvatar: senderAvatar,
-		SenderRole:   senderRole,
-		Type:         string(msg.Type),
-		Content:      content,
-		ActionData:   actionData,
-		Attachments:  attachments,
-		ReplyTo:      replyPreview,
-		CreatedAt:    msg.CreatedAt.Format(time.RFC3339),
-		DeletedAt:    deletedAtStr,
-		EditedAt:     editedAtStr,
+	return &model.ReplyPreviewDTO{
+		ID:         msg.ID,
+		SenderID:   senderID,
+		SenderName: senderName,
+		Type:       string(msg.Type),
+		Content:    content,
+		ActionData: actionData,
+		Location:   location,
+		Contact:    contact,
+		DeletedAt:  deletedAt,
+		CreatedAt:  msg.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToScheduledMessageResponse(sm *ent.ScheduledMessage, urlGen URLGenerator) *model.ScheduledMessageResponse {
+	if sm == nil {
+		return nil
+	}
+
+	var content string
+	if sm.Content != nil {
+		content = *sm.Content
+	}
+
+	var failureReason string
+	if sm.FailureReason != nil {
+		failureReason = *sm.FailureReason
+	}
+
+	attachments := make([]model.MediaDTO, 0, len(sm.Edges.Attachments))
+	for _, att := range sm.Edges.Attachments {
+		url, _ := urlGen.GetPresignedURL(att.FileName, 15*time.Minute)
+		attachments = append(attachments, model.MediaDTO{
+			ID:           att.ID,
+			FileName:     att.FileName,
+			OriginalName: att.OriginalName,
+			FileSize:     att.FileSize,
+			MimeType:     att.MimeType,
+			Category:     string(att.Category),
+			URL:          url,
+			DurationMs:   att.DurationMs,
+			Waveform:     att.Waveform,
+		})
+	}
+
+	return &model.ScheduledMessageResponse{
+		ID:            sm.ID,
+		ChatID:        sm.ChatID,
+		Content:       content,
+		Attachments:   attachments,
+		ReplyToID:     sm.ReplyToID,
+		SendAt:        sm.SendAt.Format(time.RFC3339),
+		Status:        string(sm.Status),
+		FailureReason: failureReason,
+		CreatedAt:     sm.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     sm.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToMessageRevisionResponse(rev *ent.MessageRevision, urlGen URLGenerator) *model.MessageRevisionResponse {
+	if rev == nil {
+		return nil
+	}
+
+	attachments := make([]model.MediaDTO, 0, len(rev.Edges.Attachments))
+	for _, att := range rev.Edges.Attachments {
+		url, _ := urlGen.GetPresignedURL(att.FileName, 15*time.Minute)
+		attachments = append(attachments, model.MediaDTO{
+			ID:           att.ID,
+			FileName:     att.FileName,
+			OriginalName: att.OriginalName,
+			FileSize:     att.FileSize,
+			MimeType:     att.MimeType,
+			Category:     string(att.Category),
+			URL:          url,
+			DurationMs:   att.DurationMs,
+			Waveform:     att.Waveform,
+		})
+	}
+
+	return &model.MessageRevisionResponse{
+		ID:          rev.ID,
+		MessageID:   rev.MessageID,
+		Content:     rev.Content,
+		Attachments: attachments,
+		ReplacedAt:  rev.CreatedAt.Format(time.RFC3339),
 	}
 }
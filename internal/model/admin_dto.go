@@ -121,6 +121,10 @@ type ReportDetailResponse struct {
 	// Note: attachment URLs may be refreshed when admin fetches report detail.
 	EvidenceSnapshot map[string]interface{} `json:"evidence_snapshot"`
 
+	// Earlier versions of the reported message, oldest first.
+	// Only set for target_type "message" while the message still exists.
+	MessageRevisions []MessageRevisionResponse `json:"message_revisions,omitempty"`
+
 	AdminNotes *string `json:"admin_notes,omitempty"`
 	CreatedAt  string  `json:"created_at"`
 	UpdatedAt  string  `json:"updated_at"`
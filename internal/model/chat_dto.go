@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -32,17 +34,54 @@ type ChatListResponse struct {
 	// Number of unread messages for the current user
 	UnreadCount int `json:"unread_count"`
 
+	// Number of unread messages mentioning the current user, only for group chats
+	UnreadMentionCount int `json:"unread_mention_count"`
+
+	// Indicates if the current user marked the chat as unread. Cleared when the chat is read.
+	IsMarkedUnread bool `json:"is_marked_unread"`
+
 	// Timestamp when the current user last read the chat
 	LastReadAt *string `json:"last_read_at,omitempty"`
 
 	// Timestamp when the current user hid the chat
 	HiddenAt *string `json:"hidden_at,omitempty"`
 
+	// Indicates if the chat is in the current user's archive
+	IsArchived bool `json:"is_archived"`
+
+	// Indicates if the chat stays archived when new messages arrive
+	KeepArchived bool `json:"keep_archived"`
+
+	// Indicates if the current user pinned the chat to the top of the chat list
+	IsPinned bool `json:"is_pinned"`
+
+	// Indicates if the current user muted the chat. Muted chats still count
+	// unread messages but should not alert.
+	IsMuted bool `json:"is_muted"`
+
+	// End of the current mute, omitted when the chat is not muted or muted until unmuted
+	MutedUntil *string `json:"muted_until,omitempty"`
+
+	// Indicates if mentions of the current user still alert while the chat is muted, only for group chats
+	NotifyMentions bool `json:"notify_mentions"`
+
 	// Indicates if the current user has blocked the other user
 	IsBlockedByMe bool `json:"is_blocked_by_me"`
 
+	// Currently pinned messages, most recently pinned first
+	PinnedMessages []PinnedMessageDTO `json:"pinned_messages,omitempty"`
+
+	// Lifetime in seconds of new messages, omitted when messages do not disappear
+	MessageTTL *int `json:"message_ttl,omitempty"`
+
+	// Seconds members must wait between messages in a group, omitted when slow mode is off
+	SlowModeSeconds *int `json:"slow_mode_seconds,omitempty"`
+
 	// Private Chat specific fields
 
+	// Indicates if the chat is the current user's Saved Messages self-chat, which has no other user
+	IsSavedMessages bool `json:"is_saved_messages"`
+
 	// ID of the other user in a private chat
 	OtherUserID *uuid.UUID `json:"other_user_id,omitempty"`
 
@@ -69,6 +108,9 @@ type ChatListResponse struct {
 	// Indicates if the group is public
 	IsPublic *bool `json:"is_public,omitempty"`
 
+	// Indicates if joining the group needs the approval of an admin
+	RequiresApproval *bool `json:"requires_approval,omitempty"`
+
 	// Invite code for the group, available if public or for admins
 	InviteCode *string `json:"invite_code,omitempty"`
 
@@ -82,8 +124,133 @@ type ChatListResponse struct {
 	MemberCount int `json:"member_count"`
 }
 
+type PinnedMessageDTO struct {
+	// Preview of the pinned message
+	Message *ReplyPreviewDTO `json:"message"`
+
+	// ID of the user who pinned the message.
+	// Can be null when that user's account is deleted.
+	PinnedByID *uuid.UUID `json:"pinned_by_id,omitempty"`
+
+	PinnedAt string `json:"pinned_at"`
+}
+
+type PinMessageRequest struct {
+	MessageID uuid.UUID `json:"message_id" validate:"required"`
+}
+
+type UpdateMessageTTLRequest struct {
+	// Lifetime in seconds of new messages (5 seconds to 1 year), 0 turns disappearing messages off
+	MessageTTL int `json:"message_ttl" validate:"omitempty,min=5,max=31536000"`
+}
+
 type GetChatsRequest struct {
-	Query  string `json:"query" validate:"omitempty,max=100"`
-	Cursor string `json:"cursor" validate:"omitempty"`
-	Limit  int    `json:"limit" validate:"omitempty,gt=0,max=50"`
+	Query string `json:"query" validate:"omitempty,max=100"`
+
+	// List the archive instead of the inbox
+	Archived bool `json:"archived"`
+
+	// List the chats of a folder, archived or not, instead of the inbox or archive
+	FolderID *uuid.UUID `json:"folder_id"`
+	Cursor   string     `json:"cursor" validate:"omitempty"`
+	Limit    int        `json:"limit" validate:"omitempty,gt=0,max=50"`
+}
+
+type ReorderPinnedChatsRequest struct {
+	// Every pinned chat exactly once, in the new order
+	ChatIDs []uuid.UUID `json:"chat_ids" validate:"required,min=1,max=5,unique"`
+}
+
+type PinnedChatsResponse struct {
+	// Pinned chats of the current user in display order
+	ChatIDs []uuid.UUID `json:"chat_ids"`
+}
+
+type MuteChatRequest struct {
+	// End of the mute, must be in the future. Omit to mute until unmuted.
+	MutedUntil *time.Time `json:"muted_until" validate:"omitempty"`
+
+	// Keep alerting on mentions of the current user while muted, only for group chats
+	NotifyMentions bool `json:"notify_mentions"`
+}
+
+type ArchiveChatRequest struct {
+	// Keep the chat archived when new messages arrive instead of moving it back to the inbox
+	KeepArchived bool `json:"keep_archived"`
+}
+
+type ArchiveSummaryResponse struct {
+	// Number of archived chats
+	ChatCount int `json:"chat_count"`
+
+	// Number of archived chats with unread messages
+	UnreadChatCount int `json:"unread_chat_count"`
+
+	// Unread messages across all archived chats
+	UnreadCount int `json:"unread_count"`
+
+	// Unread mentions across all archived group chats
+	UnreadMentionCount int `json:"unread_mention_count"`
+}
+
+type ChatFolderRequest struct {
+	Name string `json:"name" validate:"required,max=32"`
+
+	// Rule: only private or only group chats. Omit to match both.
+	ChatType *string `json:"chat_type" validate:"omitempty,oneof=private group"`
+
+	// Rule: only chats with unread messages or marked as unread
+	UnreadOnly bool `json:"unread_only"`
+
+	// Rule: only muted chats when true, only unmuted chats when false. Omit to match both.
+	Muted *bool `json:"muted"`
+
+	// Rule: only archived chats when true, only chats in the inbox when false. Omit to match both.
+	Archived *bool `json:"archived"`
+
+	// Chats always in the folder, regardless of the rules
+	ChatIDs []uuid.UUID `json:"chat_ids" validate:"omitempty,max=100,unique"`
+}
+
+type ChatFolderResponse struct {
+	ID         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	ChatType   *string     `json:"chat_type,omitempty"`
+	UnreadOnly bool        `json:"unread_only"`
+	Muted      *bool       `json:"muted,omitempty"`
+	Archived   *bool       `json:"archived,omitempty"`
+	ChatIDs    []uuid.UUID `json:"chat_ids"`
+
+	// Number of chats in the folder with unread messages or marked as unread
+	UnreadChatCount int `json:"unread_chat_count"`
+
+	// Unread messages across all chats in the folder
+	UnreadCount int `json:"unread_count"`
+
+	// Unread mentions across all group chats in the folder
+	UnreadMentionCount int `json:"unread_mention_count"`
+
+	CreatedAt string `json:"created_at"`
+}
+
+type ChatExportResponse struct {
+	ID     uuid.UUID `json:"id"`
+	ChatID uuid.UUID `json:"chat_id"`
+
+	// pending, processing, completed or failed
+	Status string `json:"status"`
+
+	// Number of messages in the archive, set once completed
+	MessageCount int    `json:"message_count"`
+	FileSize     *int64 `json:"file_size,omitempty"`
+
+	// Short-lived link to the zip archive, only for completed exports that have not expired
+	DownloadURL string `json:"download_url,omitempty"`
+
+	FailureReason *string `json:"failure_reason,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+	CompletedAt   *string `json:"completed_at,omitempty"`
+
+	// Time after which the archive is deleted
+	ExpiresAt *string `json:"expires_at,omitempty"`
 }
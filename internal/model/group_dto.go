@@ -16,6 +16,12 @@ type UpdateGroupChatRequest struct {
 	AvatarMediaID *uuid.UUID `json:"avatar_media_id" validate:"omitempty"`
 	IsPublic      *bool      `json:"is_public"`
 	DeleteAvatar  bool       `json:"delete_avatar"`
+
+	// Seconds members must wait between messages, 0 turns slow mode off. Admins and owners are exempt.
+	SlowModeSeconds *int `json:"slow_mode_seconds" validate:"omitempty,min=0,max=3600"`
+
+	// When true, joining by invite code or as a public group creates a join request for admins to review.
+	RequiresApproval *bool `json:"requires_approval"`
 }
 
 type SearchGroupMembersRequest struct {
@@ -25,6 +31,12 @@ type SearchGroupMembersRequest struct {
 	Limit   int       `json:"limit" validate:"omitempty,gt=0,max=50"`
 }
 
+type GetGroupJoinRequestsRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	Cursor  string    `json:"cursor" validate:"omitempty"`
+	Limit   int       `json:"limit" validate:"omitempty,gt=0,max=50"`
+}
+
 type SearchPublicGroupsRequest struct {
 	Query  string `json:"query" validate:"omitempty,min=1,max=100"`
 	Cursor string `json:"cursor" validate:"omitempty"`
@@ -60,13 +72,14 @@ type GroupMemberDTO struct {
 }
 
 type PublicGroupDTO struct {
-	ID          uuid.UUID `json:"id"`
-	ChatID      uuid.UUID `json:"chat_id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	Avatar      string    `json:"avatar"`
-	MemberCount int       `json:"member_count"`
-	IsMember    bool      `json:"is_member"`
+	ID               uuid.UUID `json:"id"`
+	ChatID           uuid.UUID `json:"chat_id"`
+	Name             string    `json:"name"`
+	Description      string    `json:"description,omitempty"`
+	Avatar           string    `json:"avatar"`
+	MemberCount      int       `json:"member_count"`
+	IsMember         bool      `json:"is_member"`
+	RequiresApproval bool      `json:"requires_approval"`
 }
 
 type GroupInviteResponse struct {
@@ -75,10 +88,25 @@ type GroupInviteResponse struct {
 }
 
 type GroupPreviewDTO struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	Avatar      string    `json:"avatar"`
-	MemberCount int       `json:"member_count"`
-	IsPublic    bool      `json:"is_public"`
+	ID               uuid.UUID `json:"id"`
+	Name             string    `json:"name"`
+	Description      string    `json:"description,omitempty"`
+	Avatar           string    `json:"avatar"`
+	MemberCount      int       `json:"member_count"`
+	IsPublic         bool      `json:"is_public"`
+	RequiresApproval bool      `json:"requires_approval"`
+}
+
+type GroupJoinRequestDTO struct {
+	ID       uuid.UUID `json:"id"`
+	ChatID   uuid.UUID `json:"chat_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	FullName string    `json:"full_name"`
+	Avatar   string    `json:"avatar"`
+
+	// Status of the request: pending, approved or declined
+	Status      string  `json:"status"`
+	RequestedAt string  `json:"requested_at"`
+	ReviewedAt  *string `json:"reviewed_at,omitempty"`
 }
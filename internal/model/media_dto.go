@@ -7,7 +7,7 @@ import (
 )
 
 type UploadMediaRequest struct {
-	Usage        string `json:"usage" validate:"required,oneof=message_attachment user_avatar group_avatar"`
+	Usage        string `json:"usage" validate:"required,oneof=message_attachment user_avatar group_avatar voice"`
 	OriginalName string `json:"original_name" validate:"required,max=255"`
 	FileSize     int64  `json:"file_size" validate:"required,gt=0"`
 	MimeType     string `json:"mime_type" validate:"required,max=100"`
@@ -23,6 +23,12 @@ type MediaDTO struct {
 	Category     string    `json:"category"`
 	UploadStatus string    `json:"upload_status"`
 	URL          string    `json:"url"`
+
+	// Length of a voice note in milliseconds
+	DurationMs *int `json:"duration_ms,omitempty"`
+
+	// Up to 64 bars between 0 and 255 for drawing a voice note, approximated from packet sizes
+	Waveform []int `json:"waveform,omitempty"`
 }
 
 type UploadMediaResponse struct {
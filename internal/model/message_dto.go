@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,6 +12,50 @@ type SendMessageRequest struct {
 	Content       string      `json:"content" validate:"required_without=AttachmentIDs,max=4000"`
 	AttachmentIDs []uuid.UUID `json:"attachment_ids" validate:"omitempty,dive"`
 	ReplyToID     *uuid.UUID  `json:"reply_to_id" validate:"omitempty"`
+
+	// Optional ID generated by the client. Retrying a send with the same ID in
+	// the same chat returns the message created by the first attempt.
+	// Ignored for scheduled messages.
+	ClientMessageID string `json:"client_message_id" validate:"omitempty,max=64"`
+}
+
+type ForwardMessageRequest struct {
+	ChatIDs []uuid.UUID `json:"chat_ids" validate:"required,min=1,max=10,unique,dive,required"`
+}
+
+type ScheduleMessageRequest struct {
+	SendMessageRequest
+
+	// Time at which the message is sent, must be in the future
+	SendAt time.Time `json:"send_at" validate:"required"`
+}
+
+// UpdateScheduledMessageRequest replaces the payload of a scheduled message.
+// The target chat cannot be changed.
+type UpdateScheduledMessageRequest struct {
+	Content       string      `json:"content" validate:"required_without=AttachmentIDs,max=4000"`
+	AttachmentIDs []uuid.UUID `json:"attachment_ids" validate:"omitempty,dive"`
+	ReplyToID     *uuid.UUID  `json:"reply_to_id" validate:"omitempty"`
+	SendAt        time.Time   `json:"send_at" validate:"required"`
+}
+
+type ScheduledMessageResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	ChatID      uuid.UUID  `json:"chat_id"`
+	Content     string     `json:"content,omitempty"`
+	Attachments []MediaDTO `json:"attachments"`
+	ReplyToID   *uuid.UUID `json:"reply_to_id,omitempty"`
+	SendAt      string     `json:"send_at"`
+
+	// pending, or failed when the message could no longer be sent at send_at.
+	// Updating a failed message schedules it again.
+	Status string `json:"status"`
+
+	// Reason the send failed, only set for failed messages
+	FailureReason string `json:"failure_reason,omitempty"`
+
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
 }
 
 type EditMessageRequest struct {
@@ -35,6 +80,17 @@ func (r *EditMessageRequest) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MessageRevisionResponse is a version of a message as it was before one of its edits.
+type MessageRevisionResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	MessageID   uuid.UUID  `json:"message_id"`
+	Content     *string    `json:"content"`
+	Attachments []MediaDTO `json:"attachments"`
+
+	// When this version was replaced by an edit
+	ReplacedAt string `json:"replaced_at"`
+}
+
 type GetMessagesRequest struct {
 	ChatID          uuid.UUID  `json:"chat_id" validate:"required"`
 	Cursor          string     `json:"cursor" validate:"omitempty"`
@@ -43,6 +99,40 @@ type GetMessagesRequest struct {
 	Direction       string     `json:"direction" validate:"omitempty,oneof=older newer"`
 }
 
+type GetThreadRequest struct {
+	Cursor string `json:"cursor" validate:"omitempty"`
+	Limit  int    `json:"limit" validate:"omitempty,gt=0,max=50"`
+}
+
+type SearchMessagesRequest struct {
+	// Restricts the search to a single chat when set
+	ChatID *uuid.UUID `json:"chat_id" validate:"omitempty"`
+	Query  string     `json:"query" validate:"required,min=2,max=100"`
+	Cursor string     `json:"cursor" validate:"omitempty"`
+	Limit  int        `json:"limit" validate:"omitempty,gt=0,max=50"`
+}
+
+type MessageSearchResultDTO struct {
+	// ID of the matched message, usable as around_message_id when fetching messages
+	MessageID uuid.UUID `json:"message_id"`
+	ChatID    uuid.UUID `json:"chat_id"`
+	ChatType  string    `json:"chat_type"`
+
+	// Group name, or the other participant's name for private chats
+	ChatName string `json:"chat_name"`
+
+	SenderID   *uuid.UUID `json:"sender_id,omitempty"`
+	SenderName string     `json:"sender_name,omitempty"`
+
+	// HTML-escaped excerpt of the message with matched terms wrapped in <mark></mark>
+	Snippet string `json:"snippet"`
+
+	// Relevance score, higher is better
+	Rank float64 `json:"rank"`
+
+	CreatedAt string `json:"created_at"`
+}
+
 type MessageResponse struct {
 	ID     uuid.UUID `json:"id"`
 	ChatID uuid.UUID `json:"chat_id"`
@@ -64,6 +154,9 @@ type MessageResponse struct {
 	Type    string `json:"type"`
 	Content string `json:"content,omitempty"`
 
+	// ID the sender attached to the send request, used to reconcile optimistic UI entries
+	ClientMessageID string `json:"client_message_id,omitempty"`
+
 	// Metadata for system messages (usually empty for regular messages).
 	//
 	// Common payload shapes by message type:
@@ -113,6 +206,40 @@ type MessageResponse struct {
 	//	  "actor_name": "Bob" // optional enrichment
 	//	}
 	//
+	//	system_pin / system_unpin:
+	//	{
+	//	  "message_id": "m1...",
+	//	  "actor_id": "u2...",
+	//	  "actor_name": "Bob" // optional enrichment
+	//	}
+	//
+	//	system_ttl:
+	//	{
+	//	  "message_ttl": 86400, // seconds, 0 when disappearing messages were turned off
+	//	  "actor_id": "u2...",
+	//	  "actor_name": "Bob" // optional enrichment
+	//	}
+	//
+	//	system_slow_mode:
+	//	{
+	//	  "slow_mode_seconds": 30, // 0 when slow mode was turned off
+	//	  "actor_id": "u2...",
+	//	  "actor_name": "Bob" // optional enrichment
+	//	}
+	//
+	//	system_join:
+	//	{
+	//	  "actor_id": "u2...", // only when an admin approved a join request
+	//	  "actor_name": "Bob" // optional enrichment
+	//	}
+	//
+	//	system_join_approval:
+	//	{
+	//	  "requires_approval": true, // false when join approval was turned off
+	//	  "actor_id": "u2...",
+	//	  "actor_name": "Bob" // optional enrichment
+	//	}
+	//
 	// Notes:
 	// - target_name and actor_name are enrichment fields added by service layer.
 	// - target_id and actor_id can be removed when referenced users are deleted.
@@ -120,15 +247,301 @@ type MessageResponse struct {
 
 	Attachments []MediaDTO `json:"attachments"`
 
+	// Preview of the first URL in the content.
+	// Fetched in the background, so it arrives in a later message.update event.
+	LinkPreview *LinkPreviewDTO `json:"link_preview,omitempty"`
+
 	// Preview of the message this message is replying to
 	ReplyTo *ReplyPreviewDTO `json:"reply_to,omitempty"`
 
+	// Origin of a forwarded message, omitted for messages that were not forwarded
+	ForwardedFrom *ForwardedFromDTO `json:"forwarded_from,omitempty"`
+
+	// Number of non-deleted replies to this message
+	ReplyCount int `json:"reply_count,omitempty"`
+
+	// Timestamp of the latest reply to this message
+	LastReplyAt *string `json:"last_reply_at,omitempty"`
+
+	// Timestamp after which the message disappears, set when the chat has a message TTL
+	ExpiresAt *string `json:"expires_at,omitempty"`
+
 	CreatedAt string  `json:"created_at"`
 	DeletedAt *string `json:"deleted_at,omitempty"`
 	EditedAt  *string `json:"edited_at,omitempty"`
 
 	// Total number of members in the group, only for group chats
 	MemberCount *int `json:"member_count,omitempty"`
+
+	// Users mentioned in the content, ordered by position
+	Mentions []MentionDTO `json:"mentions,omitempty"`
+
+	// Aggregated reaction counts, ordered by count descending
+	Reactions []ReactionSummaryDTO `json:"reactions,omitempty"`
+
+	// Emojis the requesting user has reacted with.
+	// Not included in WebSocket payloads since they are shared by all chat members.
+	MyReactions []string `json:"my_reactions,omitempty"`
+
+	// Question, options and vote tallies, only for poll messages
+	Poll *PollDTO `json:"poll,omitempty"`
+
+	// Shared position, only for location messages
+	Location *LocationDTO `json:"location,omitempty"`
+
+	// Shared user, only for contact messages
+	Contact *ContactDTO `json:"contact,omitempty"`
+}
+
+type LinkPreviewDTO struct {
+	URL         string `json:"url"`
+	SiteName    string `json:"site_name,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Public URL of the preview image
+	Image string `json:"image,omitempty"`
+}
+
+type MentionDTO struct {
+	// user for an @username mention, all for @all
+	Type string `json:"type"`
+
+	// Mentioned user, omitted for @all
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+
+	// Position of the mention in the content, in UTF-16 code units including the "@"
+	Offset int `json:"offset"`
+	Length int `json:"length"`
+}
+
+type GetNextMentionRequest struct {
+	// Continue after this message instead of the user's read position
+	AfterMessageID *uuid.UUID `json:"after_message_id" validate:"omitempty"`
+}
+
+type SendPollRequest struct {
+	ChatID   uuid.UUID `json:"chat_id" validate:"required"`
+	Question string    `json:"question" validate:"required,max=300"`
+	Options  []string  `json:"options" validate:"required,min=2,max=10,unique,dive,required,max=100"`
+
+	// Allow voting for more than one option, not available for quizzes
+	MultipleChoice bool `json:"multiple_choice"`
+
+	// Hide who voted for which option
+	Anonymous bool `json:"anonymous"`
+
+	// Quizzes have a single correct option and votes cannot be changed
+	Quiz bool `json:"quiz"`
+
+	// Index in options of the correct answer, required for quizzes
+	CorrectOption *int `json:"correct_option" validate:"omitempty,gte=0"`
+
+	// Time at which the poll stops accepting votes, must be in the future
+	ClosesAt  *time.Time `json:"closes_at" validate:"omitempty"`
+	ReplyToID *uuid.UUID `json:"reply_to_id" validate:"omitempty"`
+}
+
+// PollVoteRequest replaces the user's votes on a poll.
+type PollVoteRequest struct {
+	OptionIDs []uuid.UUID `json:"option_ids" validate:"required,min=1,max=10,unique,dive,required"`
+}
+
+type GetPollVotesRequest struct {
+	OptionID *uuid.UUID `json:"option_id" validate:"omitempty"`
+	Cursor   string     `json:"cursor" validate:"omitempty"`
+	Limit    int        `json:"limit" validate:"omitempty,gt=0,max=50"`
+}
+
+type PollDTO struct {
+	ID             uuid.UUID `json:"id"`
+	Question       string    `json:"question"`
+	MultipleChoice bool      `json:"multiple_choice"`
+	Anonymous      bool      `json:"anonymous"`
+	Quiz           bool      `json:"quiz"`
+	ClosesAt       *string   `json:"closes_at,omitempty"`
+
+	// Set once the poll was closed by hand or reached closes_at
+	ClosedAt *string `json:"closed_at,omitempty"`
+	IsClosed bool    `json:"is_closed"`
+
+	// Number of distinct users who voted
+	TotalVoters int             `json:"total_voters"`
+	Options     []PollOptionDTO `json:"options"`
+
+	// Options the requesting user voted for.
+	// Not included in WebSocket payloads since they are shared by all chat members.
+	MyVotes []uuid.UUID `json:"my_votes,omitempty"`
+}
+
+type PollOptionDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Text      string    `json:"text"`
+	VoteCount int       `json:"vote_count"`
+
+	// Whether this is the answer of a quiz. Only revealed to the quiz creator,
+	// to users who already answered and to everyone once the quiz is closed.
+	IsCorrect *bool `json:"is_correct,omitempty"`
+}
+
+type PollVoteDTO struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username"`
+	FullName  string    `json:"full_name"`
+	Avatar    string    `json:"avatar"`
+	OptionID  uuid.UUID `json:"option_id"`
+	CreatedAt string    `json:"created_at"`
+}
+
+type SendLocationRequest struct {
+	ChatID    uuid.UUID `json:"chat_id" validate:"required"`
+	Latitude  *float64  `json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude *float64  `json:"longitude" validate:"required,gte=-180,lte=180"`
+
+	// Horizontal accuracy in meters
+	Accuracy *float64 `json:"accuracy" validate:"required,gte=0,lte=100000"`
+
+	// Name of the place, for example a restaurant or a meeting point
+	VenueName string `json:"venue_name" validate:"omitempty,max=200"`
+
+	// Share a live location for this many seconds. The sender can move the
+	// position until the period ends.
+	LivePeriod *int `json:"live_period" validate:"omitempty,min=60,max=28800"`
+
+	ReplyToID *uuid.UUID `json:"reply_to_id" validate:"omitempty"`
+}
+
+// UpdateLiveLocationRequest moves the position of a live location.
+type UpdateLiveLocationRequest struct {
+	Latitude  *float64 `json:"latitude" validate:"required,gte=-90,lte=90"`
+	Longitude *float64 `json:"longitude" validate:"required,gte=-180,lte=180"`
+
+	// Horizontal accuracy in meters
+	Accuracy *float64 `json:"accuracy" validate:"required,gte=0,lte=100000"`
+}
+
+type SendContactRequest struct {
+	ChatID uuid.UUID `json:"chat_id" validate:"required"`
+
+	// AtoiTalk user to share
+	UserID    uuid.UUID  `json:"user_id" validate:"required"`
+	ReplyToID *uuid.UUID `json:"reply_to_id" validate:"omitempty"`
+}
+
+type LocationDTO struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+
+	// Horizontal accuracy in meters
+	Accuracy  float64 `json:"accuracy"`
+	VenueName string  `json:"venue_name,omitempty"`
+
+	// End of the live period, only for live locations
+	LiveUntil *string `json:"live_until,omitempty"`
+
+	// Whether the position can still change
+	IsLive bool `json:"is_live"`
+
+	// Time of the latest position update of a live location
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+type ContactDTO struct {
+	// Omitted when the shared user was deleted
+	UserID   *uuid.UUID `json:"user_id,omitempty"`
+	Username string     `json:"username,omitempty"`
+
+	// "Deleted User" when the shared user was deleted
+	FullName string `json:"full_name"`
+	Avatar   string `json:"avatar,omitempty"`
+}
+
+// LiveLocationEvent is the payload of message.location events.
+type LiveLocationEvent struct {
+	MessageID uuid.UUID   `json:"message_id"`
+	ChatID    uuid.UUID   `json:"chat_id"`
+	Location  LocationDTO `json:"location"`
+}
+
+type MessageReactionRequest struct {
+	Emoji string `json:"emoji" validate:"required,emoji"`
+}
+
+type GetMessageReactionsRequest struct {
+	Emoji  string `json:"emoji" validate:"omitempty,emoji"`
+	Cursor string `json:"cursor" validate:"omitempty"`
+	Limit  int    `json:"limit" validate:"omitempty,gt=0,max=50"`
+}
+
+type ReactionSummaryDTO struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+type MessageReactionDTO struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username"`
+	FullName  string    `json:"full_name"`
+	Avatar    string    `json:"avatar"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt string    `json:"created_at"`
+}
+
+type MessageReceiptDTO struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	FullName string    `json:"full_name"`
+	Avatar   string    `json:"avatar"`
+}
+
+type MessageReceiptsResponse struct {
+	MessageID uuid.UUID `json:"message_id"`
+
+	// Members whose read position is at or past the message
+	ReadBy []MessageReceiptDTO `json:"read_by"`
+
+	// Members whose client acknowledged the message but who have not read it yet
+	DeliveredTo []MessageReceiptDTO `json:"delivered_to"`
+}
+
+type MessageReactionResponse struct {
+	MessageID uuid.UUID `json:"message_id"`
+	ChatID    uuid.UUID `json:"chat_id"`
+
+	// User who added or removed the reaction
+	UserID uuid.UUID `json:"user_id"`
+	Emoji  string    `json:"emoji"`
+
+	// Either "added" or "removed"
+	Action string `json:"action"`
+
+	// Aggregated reaction counts after the change
+	Reactions []ReactionSummaryDTO `json:"reactions"`
+
+	// Emojis the requesting user has reacted with, only in the REST response
+	MyReactions []string `json:"my_reactions,omitempty"`
+}
+
+type MessageThreadUpdate struct {
+	// ID of the thread root message
+	MessageID uuid.UUID `json:"message_id"`
+	ChatID    uuid.UUID `json:"chat_id"`
+
+	ReplyCount  int     `json:"reply_count"`
+	LastReplyAt *string `json:"last_reply_at,omitempty"`
+}
+
+type ForwardedFromDTO struct {
+	// ID of the original sender.
+	// Can be null when the original sender account is deleted.
+	SenderID *uuid.UUID `json:"sender_id,omitempty"`
+
+	// Display name of the original sender.
+	// Can be "Deleted User" when the original sender account is deleted.
+	SenderName string `json:"sender_name"`
+
+	// ID of the chat the message was originally sent in
+	ChatID *uuid.UUID `json:"chat_id,omitempty"`
 }
 
 type ReplyPreviewDTO struct {
@@ -139,6 +552,8 @@ type ReplyPreviewDTO struct {
 	Content    string     `json:"content,omitempty"`
 	// Metadata for system messages, same structure and behavior as MessageResponse.ActionData.
 	ActionData map[string]interface{} `json:"action_data,omitempty"`
+	Location   *LocationDTO           `json:"location,omitempty"`
+	Contact    *ContactDTO            `json:"contact,omitempty"`
 	DeletedAt  *string                `json:"deleted_at,omitempty"`
 	CreatedAt  string                 `json:"created_at"`
 }
@@ -3,13 +3,18 @@ package repository
 import (
 	"AtoiTalkAPI/ent"
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatfolderchat"
 	"AtoiTalkAPI/ent/groupchat"
 	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/pinnedmessage"
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/user"
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -29,7 +34,7 @@ func NewChatRepository(client *ent.Client) *ChatRepository {
 }
 
 func (r *ChatRepository) GetChatByID(ctx context.Context, userID, chatID uuid.UUID) (*ent.Chat, error) {
-	return r.client.Chat.Query().
+	query := r.client.Chat.Query().
 		Where(
 			chat.ID(chatID),
 			chat.DeletedAtIsNil(),
@@ -44,78 +49,29 @@ func (r *ChatRepository) GetChatByID(ctx context.Context, userID, chatID uuid.UU
 					),
 				),
 			),
-		).
-		WithPrivateChat(func(q *ent.PrivateChatQuery) {
-			q.WithUser1(func(uq *ent.UserQuery) {
-				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt, user.FieldIsBanned, user.FieldBannedUntil)
-				uq.WithAvatar()
-			})
-			q.WithUser2(func(uq *ent.UserQuery) {
-				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt, user.FieldIsBanned, user.FieldBannedUntil)
-				uq.WithAvatar()
-			})
-		}).
-		WithGroupChat(func(q *ent.GroupChatQuery) {
-			q.WithAvatar()
+		)
 
-			q.WithMembers(func(mq *ent.GroupMemberQuery) {
-				mq.Where(groupmember.UserID(userID))
-			})
-		}).
-		WithLastMessage(func(q *ent.MessageQuery) {
-			q.WithSender(func(uq *ent.UserQuery) {
-				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID)
-				uq.WithAvatar()
-			})
-			q.WithAttachments(func(aq *ent.MediaQuery) {
-				aq.Limit(1)
-			})
-		}).
-		Only(ctx)
+	return withChatListEdges(query, userID).Only(ctx)
 }
 
-func (r *ChatRepository) GetChats(ctx context.Context, userID uuid.UUID, queryStr string, cursor string, limit int) ([]*ent.Chat, string, bool, error) {
-	query := r.client.Chat.Query().
-		Where(
-			chat.DeletedAtIsNil(),
-			chat.LastMessageAtNotNil(),
-			chat.Or(
-				chat.HasPrivateChatWith(privatechat.Or(privatechat.User1ID(userID), privatechat.User2ID(userID))),
-				chat.HasGroupChatWith(groupchat.HasMembersWith(groupmember.UserID(userID))),
-			),
-			func(s *sql.Selector) {
-				t := sql.Table(privatechat.Table)
-				s.Where(
-					sql.Not(
-						sql.Exists(
-							sql.Select(privatechat.FieldID).From(t).Where(
-								sql.And(
-									sql.ColumnsEQ(t.C(privatechat.FieldChatID), s.C(chat.FieldID)),
-									sql.Or(
-										sql.And(
-											sql.EQ(t.C(privatechat.FieldUser1ID), userID),
-											sql.NotNull(t.C(privatechat.FieldUser1HiddenAt)),
-											sql.Or(
-												sql.ColumnsGTE(t.C(privatechat.FieldUser1HiddenAt), s.C(chat.FieldLastMessageAt)),
-												sql.IsNull(s.C(chat.FieldLastMessageAt)),
-											),
-										),
-										sql.And(
-											sql.EQ(t.C(privatechat.FieldUser2ID), userID),
-											sql.NotNull(t.C(privatechat.FieldUser2HiddenAt)),
-											sql.Or(
-												sql.ColumnsGTE(t.C(privatechat.FieldUser2HiddenAt), s.C(chat.FieldLastMessageAt)),
-												sql.IsNull(s.C(chat.FieldLastMessageAt)),
-											),
-										),
-									),
-								),
-							),
-						),
-					),
-				)
-			},
-		)
+// GetChats pages through the chat list of userID by last message time. With a
+// folder the chats are filtered by the folder instead of by archived. Without a
+// search query the chats pinned by the user are left out of the pages and
+// returned in pin order ahead of the first page instead.
+func (r *ChatRepository) GetChats(ctx context.Context, userID uuid.UUID, queryStr string, archived bool, folder *ent.ChatFolder, cursor string, limit int) ([]*ent.Chat, string, bool, error) {
+	listed := func() *ent.ChatQuery {
+		if folder != nil {
+			return r.folderChatsQuery(userID, folder)
+		}
+		return r.listedChatsQuery(userID, archived)
+	}
+
+	query := listed()
+
+	pinnedFirst := queryStr == ""
+	if pinnedFirst {
+		query = query.Where(chat.Not(chat.HasPinnedByWith(pinnedchat.UserID(userID))))
+	}
 
 	if queryStr != "" {
 		otherUserPredicate := user.Or(
@@ -164,9 +120,56 @@ func (r *ChatRepository) GetChats(ctx context.Context, userID uuid.UUID, querySt
 
 	fetchLimit := limit
 
-	chats, err := query.
+	query = query.
 		Order(ent.Desc(chat.FieldLastMessageAt), ent.Desc(chat.FieldID)).
-		Limit(fetchLimit + 1).
+		Limit(fetchLimit + 1)
+
+	chats, err := withChatListEdges(query, userID).All(ctx)
+
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	hasNext := false
+	var nextCursor string
+	if len(chats) > limit {
+		hasNext = true
+		chats = chats[:limit]
+		lastChat := chats[len(chats)-1]
+
+		var cursorTime int64
+		if lastChat.LastMessageAt != nil {
+			cursorTime = lastChat.LastMessageAt.UnixMicro()
+		} else {
+			cursorTime = 0
+		}
+
+		cursorString := fmt.Sprintf("%d,%s", cursorTime, lastChat.ID.String())
+		nextCursor = base64.URLEncoding.EncodeToString([]byte(cursorString))
+	}
+
+	if pinnedFirst && cursor == "" {
+		pinnedQuery := listed().
+			Where(chat.HasPinnedByWith(pinnedchat.UserID(userID)))
+
+		pinned, err := withChatListEdges(pinnedQuery, userID).All(ctx)
+		if err != nil {
+			return nil, "", false, err
+		}
+
+		slices.SortFunc(pinned, func(a, b *ent.Chat) int {
+			return a.Edges.PinnedBy[0].Position - b.Edges.PinnedBy[0].Position
+		})
+		chats = append(pinned, chats...)
+	}
+
+	return chats, nextCursor, hasNext, nil
+}
+
+// withChatListEdges loads what MapChatToResponse needs to render a chat for
+// userID.
+func withChatListEdges(q *ent.ChatQuery, userID uuid.UUID) *ent.ChatQuery {
+	return q.
 		WithPrivateChat(func(q *ent.PrivateChatQuery) {
 			q.WithUser1(func(uq *ent.UserQuery) {
 				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt, user.FieldIsBanned, user.FieldBannedUntil)
@@ -188,33 +191,268 @@ func (r *ChatRepository) GetChats(ctx context.Context, userID uuid.UUID, querySt
 				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID)
 				uq.WithAvatar()
 			})
+			q.WithForwardedFromSender(func(uq *ent.UserQuery) {
+				uq.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+			})
+			q.WithContactUser(func(uq *ent.UserQuery) {
+				uq.WithAvatar()
+			})
 			q.WithAttachments(func(aq *ent.MediaQuery) {
 				aq.Limit(1)
 			})
 		}).
-		All(ctx)
+		WithPinnedMessages(withPinnedMessagePreview).
+		WithPinnedBy(func(pq *ent.PinnedChatQuery) {
+			pq.Where(pinnedchat.UserID(userID))
+		})
+}
 
-	if err != nil {
-		return nil, "", false, err
+// listedChatsQuery selects the chats shown in the chat list of userID, either
+// from the inbox or from the archive.
+func (r *ChatRepository) listedChatsQuery(userID uuid.UUID, archived bool) *ent.ChatQuery {
+	return r.visibleChatsQuery(userID).
+		Where(func(s *sql.Selector) {
+			if archived {
+				s.Where(archivedChat(s, userID))
+			} else {
+				s.Where(sql.Not(archivedChat(s, userID)))
+			}
+		})
+}
+
+// folderChatsQuery selects the chats of userID that belong to folder, archived
+// or not.
+func (r *ChatRepository) folderChatsQuery(userID uuid.UUID, folder *ent.ChatFolder) *ent.ChatQuery {
+	return r.visibleChatsQuery(userID).
+		Where(func(s *sql.Selector) {
+			s.Where(folderChat(s, userID, folder))
+		})
+}
+
+// visibleChatsQuery selects the chats of userID with messages that the user has
+// not hidden.
+func (r *ChatRepository) visibleChatsQuery(userID uuid.UUID) *ent.ChatQuery {
+	return r.client.Chat.Query().
+		Where(
+			chat.DeletedAtIsNil(),
+			chat.LastMessageAtNotNil(),
+			chat.Or(
+				chat.HasPrivateChatWith(privatechat.Or(privatechat.User1ID(userID), privatechat.User2ID(userID))),
+				chat.HasGroupChatWith(groupchat.HasMembersWith(groupmember.UserID(userID))),
+			),
+			func(s *sql.Selector) {
+				t := sql.Table(privatechat.Table)
+				s.Where(
+					sql.Not(
+						sql.Exists(
+							sql.Select(privatechat.FieldID).From(t).Where(
+								sql.And(
+									sql.ColumnsEQ(t.C(privatechat.FieldChatID), s.C(chat.FieldID)),
+									sql.Or(
+										sql.And(
+											sql.EQ(t.C(privatechat.FieldUser1ID), userID),
+											sql.NotNull(t.C(privatechat.FieldUser1HiddenAt)),
+											sql.Or(
+												sql.ColumnsGTE(t.C(privatechat.FieldUser1HiddenAt), s.C(chat.FieldLastMessageAt)),
+												sql.IsNull(s.C(chat.FieldLastMessageAt)),
+											),
+										),
+										sql.And(
+											sql.EQ(t.C(privatechat.FieldUser2ID), userID),
+											sql.NotNull(t.C(privatechat.FieldUser2HiddenAt)),
+											sql.Or(
+												sql.ColumnsGTE(t.C(privatechat.FieldUser2HiddenAt), s.C(chat.FieldLastMessageAt)),
+												sql.IsNull(s.C(chat.FieldLastMessageAt)),
+											),
+										),
+									),
+								),
+							),
+						),
+					),
+				)
+			},
+		)
+}
+
+// GetArchivedChats returns every archived chat of userID with the user's own
+// private chat or membership row loaded, for the archive summary.
+func (r *ChatRepository) GetArchivedChats(ctx context.Context, userID uuid.UUID) ([]*ent.Chat, error) {
+	return withOwnParticipant(r.listedChatsQuery(userID, true), userID).All(ctx)
+}
+
+// GetFolderChats returns every chat of userID in folder with the user's own
+// private chat or membership row loaded, for the folder badge.
+func (r *ChatRepository) GetFolderChats(ctx context.Context, userID uuid.UUID, folder *ent.ChatFolder) ([]*ent.Chat, error) {
+	return withOwnParticipant(r.folderChatsQuery(userID, folder), userID).All(ctx)
+}
+
+func withOwnParticipant(q *ent.ChatQuery, userID uuid.UUID) *ent.ChatQuery {
+	return q.
+		WithPrivateChat().
+		WithGroupChat(func(q *ent.GroupChatQuery) {
+			q.WithMembers(func(mq *ent.GroupMemberQuery) {
+				mq.Where(groupmember.UserID(userID))
+			})
+		})
+}
+
+// folderChat matches chats added to folder explicitly and, when the folder has
+// rules, chats matching all of them.
+func folderChat(s *sql.Selector, userID uuid.UUID, folder *ent.ChatFolder) *sql.Predicate {
+	fc := sql.Table(chatfolderchat.Table)
+	explicit := sql.In(s.C(chat.FieldID),
+		sql.Select(fc.C(chatfolderchat.FieldChatID)).From(fc).
+			Where(sql.EQ(fc.C(chatfolderchat.FieldFolderID), folder.ID)),
+	)
+
+	var rules []*sql.Predicate
+	if folder.ChatType != nil {
+		rules = append(rules, sql.EQ(s.C(chat.FieldType), folder.ChatType.String()))
+	}
+	if folder.UnreadOnly {
+		rules = append(rules, unreadChat(s, userID))
+	}
+	if folder.Muted != nil {
+		rules = append(rules, matchRule(mutedChat(s, userID, time.Now()), *folder.Muted))
+	}
+	if folder.Archived != nil {
+		rules = append(rules, matchRule(archivedChat(s, userID), *folder.Archived))
 	}
 
-	hasNext := false
-	var nextCursor string
-	if len(chats) > limit {
-		hasNext = true
-		chats = chats[:limit]
-		lastChat := chats[len(chats)-1]
+	if len(rules) == 0 {
+		return explicit
+	}
+	return sql.Or(explicit, sql.And(rules...))
+}
 
-		var cursorTime int64
-		if lastChat.LastMessageAt != nil {
-			cursorTime = lastChat.LastMessageAt.UnixMicro()
-		} else {
-			cursorTime = 0
-		}
+func matchRule(p *sql.Predicate, want bool) *sql.Predicate {
+	if want {
+		return p
+	}
+	return sql.Not(p)
+}
 
-		cursorString := fmt.Sprintf("%d,%s", cursorTime, lastChat.ID.String())
-		nextCursor = base64.URLEncoding.EncodeToString([]byte(cursorString))
+// archivedChat matches chats userID archived, unless a message arrived after
+// archiving and the user did not ask to keep the chat archived.
+func archivedChat(s *sql.Selector, userID uuid.UUID) *sql.Predicate {
+	stillArchived := func(archivedAt, keepArchived string) *sql.Predicate {
+		return sql.And(
+			sql.NotNull(archivedAt),
+			sql.Or(
+				sql.EQ(keepArchived, true),
+				sql.ColumnsGTE(archivedAt, s.C(chat.FieldLastMessageAt)),
+				sql.IsNull(s.C(chat.FieldLastMessageAt)),
+			),
+		)
 	}
 
-	return chats, nextCursor, hasNext, nil
+	return participantState(s, userID,
+		func(pc *sql.SelectTable) *sql.Predicate {
+			return stillArchived(pc.C(privatechat.FieldUser1ArchivedAt), pc.C(privatechat.FieldUser1KeepArchived))
+		},
+		func(pc *sql.SelectTable) *sql.Predicate {
+			return stillArchived(pc.C(privatechat.FieldUser2ArchivedAt), pc.C(privatechat.FieldUser2KeepArchived))
+		},
+		func(gm *sql.SelectTable) *sql.Predicate {
+			return stillArchived(gm.C(groupmember.FieldArchivedAt), gm.C(groupmember.FieldKeepArchived))
+		},
+	)
+}
+
+// unreadChat matches chats with messages userID has not read, or that the user
+// marked as unread.
+func unreadChat(s *sql.Selector, userID uuid.UUID) *sql.Predicate {
+	return participantState(s, userID,
+		func(pc *sql.SelectTable) *sql.Predicate {
+			return sql.Or(sql.GT(pc.C(privatechat.FieldUser1UnreadCount), 0), sql.EQ(pc.C(privatechat.FieldUser1MarkedUnread), true))
+		},
+		func(pc *sql.SelectTable) *sql.Predicate {
+			return sql.Or(sql.GT(pc.C(privatechat.FieldUser2UnreadCount), 0), sql.EQ(pc.C(privatechat.FieldUser2MarkedUnread), true))
+		},
+		func(gm *sql.SelectTable) *sql.Predicate {
+			return sql.Or(sql.GT(gm.C(groupmember.FieldUnreadCount), 0), sql.EQ(gm.C(groupmember.FieldMarkedUnread), true))
+		},
+	)
+}
+
+// mutedChat matches chats userID muted, unless the mute ended before now.
+func mutedChat(s *sql.Selector, userID uuid.UUID, now time.Time) *sql.Predicate {
+	stillMuted := func(muted, mutedUntil string) *sql.Predicate {
+		return sql.And(
+			sql.EQ(muted, true),
+			sql.Or(
+				sql.IsNull(mutedUntil),
+				sql.GT(mutedUntil, now),
+			),
+		)
+	}
+
+	return participantState(s, userID,
+		func(pc *sql.SelectTable) *sql.Predicate {
+			return stillMuted(pc.C(privatechat.FieldUser1Muted), pc.C(privatechat.FieldUser1MutedUntil))
+		},
+		func(pc *sql.SelectTable) *sql.Predicate {
+			return stillMuted(pc.C(privatechat.FieldUser2Muted), pc.C(privatechat.FieldUser2MutedUntil))
+		},
+		func(gm *sql.SelectTable) *sql.Predicate {
+			return stillMuted(gm.C(groupmember.FieldMuted), gm.C(groupmember.FieldMutedUntil))
+		},
+	)
+}
+
+// participantState matches chats where the per-user state of userID satisfies
+// user1 or user2 on the private chat row, or member on the group membership row.
+func participantState(s *sql.Selector, userID uuid.UUID, user1, user2 func(pc *sql.SelectTable) *sql.Predicate, member func(gm *sql.SelectTable) *sql.Predicate) *sql.Predicate {
+	pc := sql.Table(privatechat.Table)
+	gm := sql.Table(groupmember.Table)
+	gc := sql.Table(groupchat.Table)
+
+	return sql.Or(
+		sql.Exists(
+			sql.Select(pc.C(privatechat.FieldID)).From(pc).Where(
+				sql.And(
+					sql.ColumnsEQ(pc.C(privatechat.FieldChatID), s.C(chat.FieldID)),
+					sql.Or(
+						sql.And(
+							sql.EQ(pc.C(privatechat.FieldUser1ID), userID),
+							user1(pc),
+						),
+						sql.And(
+							sql.EQ(pc.C(privatechat.FieldUser2ID), userID),
+							user2(pc),
+						),
+					),
+				),
+			),
+		),
+		sql.Exists(
+			sql.Select(gm.C(groupmember.FieldID)).From(gm).
+				Join(gc).On(gm.C(groupmember.FieldGroupChatID), gc.C(groupchat.FieldID)).
+				Where(
+					sql.And(
+						sql.ColumnsEQ(gc.C(groupchat.FieldChatID), s.C(chat.FieldID)),
+						sql.EQ(gm.C(groupmember.FieldUserID), userID),
+						member(gm),
+					),
+				),
+		),
+	)
+}
+
+func (r *ChatRepository) GetPinnedMessages(ctx context.Context, chatID uuid.UUID) ([]*ent.PinnedMessage, error) {
+	q := r.client.PinnedMessage.Query().Where(pinnedmessage.ChatID(chatID))
+	withPinnedMessagePreview(q)
+	return q.All(ctx)
+}
+
+func withPinnedMessagePreview(q *ent.PinnedMessageQuery) {
+	q.Where(pinnedmessage.HasMessageWith(message.DeletedAtIsNil())).
+		Order(ent.Desc(pinnedmessage.FieldCreatedAt), ent.Desc(pinnedmessage.FieldID)).
+		WithMessage(func(mq *ent.MessageQuery) {
+			mq.WithSender(func(uq *ent.UserQuery) {
+				uq.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+			})
+			mq.WithContactUser()
+		})
 }
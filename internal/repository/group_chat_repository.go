@@ -82,7 +82,7 @@ func (r *GroupChatRepository) searchPublicGroupsByName(ctx context.Context, quer
 	}
 
 	query = query.
-		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldAvatarID).
+		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldAvatarID, groupchat.FieldRequiresApproval).
 		Order(ent.Asc(groupchat.FieldName), ent.Asc(groupchat.FieldID)).
 		Limit(limit + 1).
 		WithAvatar()
@@ -173,7 +173,7 @@ func (r *GroupChatRepository) searchPublicGroupsByMemberCount(ctx context.Contex
 	}
 
 	query = query.
-		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldAvatarID).
+		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldAvatarID, groupchat.FieldRequiresApproval).
 		Modify(func(s *sql.Selector) {
 			countExpr := memberCountExpr(s)
 			s.OrderExpr(sql.Expr(countExpr + " DESC"))
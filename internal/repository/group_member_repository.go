@@ -2,6 +2,7 @@ package repository
 
 import (
 	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/groupjoinrequest"
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/internal/helper"
@@ -100,3 +101,65 @@ func (r *GroupMemberRepository) SearchGroupMembers(ctx context.Context, groupID
 
 	return members, nextCursor, hasNext, nil
 }
+
+// GetPendingJoinRequests lists the pending join requests of a group, oldest
+// first.
+func (r *GroupMemberRepository) GetPendingJoinRequests(ctx context.Context, groupID uuid.UUID, cursor string, limit int) ([]*ent.GroupJoinRequest, string, bool, error) {
+	queryBuilder := r.client.GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.GroupChatID(groupID),
+			groupjoinrequest.StatusEQ(groupjoinrequest.StatusPending),
+			groupjoinrequest.HasUserWith(user.DeletedAtIsNil()),
+		).
+		Order(ent.Asc(groupjoinrequest.FieldRequestedAt), ent.Asc(groupjoinrequest.FieldID)).
+		Limit(limit + 1).
+		WithUser(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		})
+
+	if cursor != "" {
+		requestedAtStr, idStr, err := helper.DecodeCursor(cursor, "|")
+		if err != nil {
+			return nil, "", false, fmt.Errorf("invalid cursor format: %w", err)
+		}
+
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, "", false, fmt.Errorf("invalid cursor id format: %w", err)
+		}
+
+		requestedAt, err := time.Parse(time.RFC3339Nano, requestedAtStr)
+		if err != nil {
+			return nil, "", false, fmt.Errorf("invalid cursor time format: %w", err)
+		}
+
+		queryBuilder = queryBuilder.Where(
+			groupjoinrequest.Or(
+				groupjoinrequest.RequestedAtGT(requestedAt),
+				groupjoinrequest.And(
+					groupjoinrequest.RequestedAtEQ(requestedAt),
+					groupjoinrequest.IDGT(id),
+				),
+			),
+		)
+	}
+
+	requests, err := queryBuilder.All(ctx)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	hasNext := false
+	if len(requests) > limit {
+		hasNext = true
+		requests = requests[:limit]
+	}
+
+	var nextCursor string
+	if hasNext && len(requests) > 0 {
+		last := requests[len(requests)-1]
+		nextCursor = helper.EncodeCursor(last.RequestedAt.Format(time.RFC3339Nano), last.ID.String(), "|")
+	}
+
+	return requests, nextCursor, hasNext, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/user"
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+type ReactionCount struct {
+	MessageID uuid.UUID `json:"message_id"`
+	Emoji     string    `json:"emoji"`
+	Count     int       `json:"count"`
+}
+
+type MessageReactionRepository struct {
+	client *ent.Client
+}
+
+func NewMessageReactionRepository(client *ent.Client) *MessageReactionRepository {
+	return &MessageReactionRepository{
+		client: client,
+	}
+}
+
+func (r *MessageReactionRepository) GetReactionCounts(ctx context.Context, messageIDs []uuid.UUID) ([]ReactionCount, error) {
+	var counts []ReactionCount
+	if len(messageIDs) == 0 {
+		return counts, nil
+	}
+
+	err := r.client.MessageReaction.Query().
+		Where(messagereaction.MessageIDIn(messageIDs...)).
+		GroupBy(messagereaction.FieldMessageID, messagereaction.FieldEmoji).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Emoji < counts[j].Emoji
+	})
+
+	return counts, nil
+}
+
+func (r *MessageReactionRepository) GetUserReactions(ctx context.Context, userID uuid.UUID, messageIDs []uuid.UUID) ([]*ent.MessageReaction, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+
+	return r.client.MessageReaction.Query().
+		Where(
+			messagereaction.UserID(userID),
+			messagereaction.MessageIDIn(messageIDs...),
+		).
+		Order(ent.Asc(messagereaction.FieldID)).
+		All(ctx)
+}
+
+func (r *MessageReactionRepository) GetReactions(ctx context.Context, messageID uuid.UUID, emoji string, cursor uuid.UUID, limit int) ([]*ent.MessageReaction, error) {
+	query := r.client.MessageReaction.Query().
+		Where(
+			messagereaction.MessageID(messageID),
+			messagereaction.HasUserWith(user.DeletedAtIsNil()),
+		)
+
+	if emoji != "" {
+		query = query.Where(messagereaction.Emoji(emoji))
+	}
+
+	if cursor != uuid.Nil {
+		query = query.Where(messagereaction.IDGT(cursor))
+	}
+
+	return query.
+		Order(ent.Asc(messagereaction.FieldID)).
+		Limit(limit + 1).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
+			q.WithAvatar()
+		}).
+		All(ctx)
+}
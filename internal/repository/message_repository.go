@@ -3,17 +3,42 @@ package repository
 import (
 	"AtoiTalkAPI/ent"
 	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
 	"context"
 	"errors"
+	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
-var ErrMessageNotFound = errors.New("message not found")
+var (
+	ErrMessageNotFound = errors.New("message not found")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+)
+
+const (
+	// MessageSearchVectorColumn is the generated tsvector column over messages.content.
+	// It is managed by config.queryIndexStatements since ent cannot express generated columns.
+	MessageSearchVectorColumn = "content_tsv"
+
+	searchHeadlineOptions = "StartSel=" + helper.SearchHighlightStart + ", StopSel=" + helper.SearchHighlightStop +
+		", MaxWords=24, MinWords=8, ShortWord=2, MaxFragments=2, FragmentDelimiter=\" ... \""
+)
+
+type MessageSearchHit struct {
+	ID      uuid.UUID `json:"id"`
+	Rank    float64   `json:"rank"`
+	Snippet string    `json:"snippet"`
+}
 
 type MessageRepository struct {
 	client *ent.Client
@@ -54,7 +79,15 @@ func (r *MessageRepository) GetMessages(ctx context.Context, chatID uuid.UUID, h
 			q.WithAvatar()
 		}).
 		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
 		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
 			q.WithSender(func(uq *ent.UserQuery) {
 				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
 				uq.WithAvatar()
@@ -67,6 +100,35 @@ func (r *MessageRepository) GetMessages(ctx context.Context, chatID uuid.UUID, h
 	return query.All(ctx)
 }
 
+func (r *MessageRepository) GetThreadReplies(ctx context.Context, rootID uuid.UUID, cursor uuid.UUID, limit int) ([]*ent.Message, error) {
+	query := r.client.Message.Query().
+		Where(
+			message.ReplyToID(rootID),
+			message.DeletedAtIsNil(),
+		)
+
+	if cursor != uuid.Nil {
+		query = query.Where(message.IDGT(cursor))
+	}
+
+	return query.
+		Order(ent.Asc(message.FieldID)).
+		Limit(limit + 1).
+		WithSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
+			q.WithAvatar()
+		}).
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		All(ctx)
+}
+
 func (r *MessageRepository) GetMessagesAround(ctx context.Context, chatID uuid.UUID, hiddenAt *time.Time, aroundID uuid.UUID, limit int) ([]*ent.Message, error) {
 
 	targetMsg, err := r.client.Message.Query().
@@ -80,7 +142,15 @@ func (r *MessageRepository) GetMessagesAround(ctx context.Context, chatID uuid.U
 			q.WithAvatar()
 		}).
 		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
 		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
 			q.WithSender(func(uq *ent.UserQuery) {
 				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
 				uq.WithAvatar()
@@ -117,7 +187,15 @@ func (r *MessageRepository) GetMessagesAround(ctx context.Context, chatID uuid.U
 			q.WithAvatar()
 		}).
 		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
 		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
 			q.WithSender(func(uq *ent.UserQuery) {
 				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
 				uq.WithAvatar()
@@ -147,7 +225,15 @@ func (r *MessageRepository) GetMessagesAround(ctx context.Context, chatID uuid.U
 			q.WithAvatar()
 		}).
 		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
 		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
 			q.WithSender(func(uq *ent.UserQuery) {
 				uq.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
 				uq.WithAvatar()
@@ -176,3 +262,148 @@ func (r *MessageRepository) GetMessagesAround(ctx context.Context, chatID uuid.U
 
 	return result, nil
 }
+
+// SearchMessages runs a full-text search over regular and poll messages the user can
+// currently see, skipping deleted ones. When chatID is nil every chat the user participates
+// in is searched. Results are ordered by rank, then by newest message first.
+func (r *MessageRepository) SearchMessages(ctx context.Context, userID uuid.UUID, chatID *uuid.UUID, queryStr, cursor string, limit int) ([]MessageSearchHit, string, bool, error) {
+	query := r.client.Message.Query().
+		Where(
+			message.DeletedAtIsNil(),
+			message.TypeIn(message.TypeRegular, message.TypePoll),
+			message.ContentNotNil(),
+			message.HasChatWith(chat.DeletedAtIsNil()),
+			messageVisibleTo(userID),
+			func(s *sql.Selector) {
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString(s.C(MessageSearchVectorColumn)).WriteString(" @@ ")
+					writeTSQuery(b, queryStr)
+				}))
+			},
+		)
+
+	if chatID != nil {
+		query = query.Where(message.ChatID(*chatID))
+	}
+
+	if cursor != "" {
+		rankStr, idStr, err := helper.DecodeCursor(cursor, "|")
+		if err != nil {
+			return nil, "", false, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+
+		cursorRank, err := strconv.ParseFloat(rankStr, 64)
+		if err != nil {
+			return nil, "", false, fmt.Errorf("%w: rank: %v", ErrInvalidCursor, err)
+		}
+
+		cursorID, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, "", false, fmt.Errorf("%w: id: %v", ErrInvalidCursor, err)
+		}
+
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("(")
+				writeSearchRank(b, s, queryStr)
+				b.WriteString(" < ").Arg(cursorRank).WriteString(" OR (")
+				writeSearchRank(b, s, queryStr)
+				b.WriteString(" = ").Arg(cursorRank).WriteString(" AND ").
+					WriteString(s.C(message.FieldID)).WriteString(" < ").Arg(cursorID).
+					WriteString("))")
+			}))
+		})
+	}
+
+	var hits []MessageSearchHit
+	err := query.
+		Limit(limit+1).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(message.FieldID))
+			s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+				writeSearchRank(b, s, queryStr)
+			}), "rank")
+			s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("ts_headline('simple', ").WriteString(s.C(message.FieldContent)).WriteString(", ")
+				writeTSQuery(b, queryStr)
+				b.WriteString(", ").Arg(searchHeadlineOptions).WriteString(")")
+			}), "snippet")
+			s.OrderBy(sql.Desc("rank"), sql.Desc(s.C(message.FieldID)))
+		}).
+		Scan(ctx, &hits)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	hasNext := false
+	if len(hits) > limit {
+		hasNext = true
+		hits = hits[:limit]
+	}
+
+	var nextCursor string
+	if hasNext && len(hits) > 0 {
+		last := hits[len(hits)-1]
+		nextCursor = helper.EncodeCursor(strconv.FormatFloat(last.Rank, 'g', -1, 64), last.ID.String(), "|")
+	}
+
+	return hits, nextCursor, hasNext, nil
+}
+
+func writeTSQuery(b *sql.Builder, queryStr string) {
+	b.WriteString("websearch_to_tsquery('simple', ").Arg(queryStr).WriteString(")")
+}
+
+func writeSearchRank(b *sql.Builder, s *sql.Selector, queryStr string) {
+	b.WriteString("ts_rank_cd(").WriteString(s.C(MessageSearchVectorColumn)).WriteString(", ")
+	writeTSQuery(b, queryStr)
+	b.WriteString(")")
+}
+
+// messageVisibleTo restricts messages to chats the user participates in, excluding
+// private chat history from before the user's hidden_at.
+func messageVisibleTo(userID uuid.UUID) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		pc := sql.Table(privatechat.Table)
+		gm := sql.Table(groupmember.Table)
+		gc := sql.Table(groupchat.Table)
+
+		s.Where(
+			sql.Or(
+				sql.Exists(
+					sql.Select(pc.C(privatechat.FieldID)).From(pc).Where(
+						sql.And(
+							sql.ColumnsEQ(pc.C(privatechat.FieldChatID), s.C(message.FieldChatID)),
+							sql.Or(
+								sql.And(
+									sql.EQ(pc.C(privatechat.FieldUser1ID), userID),
+									sql.Or(
+										sql.IsNull(pc.C(privatechat.FieldUser1HiddenAt)),
+										sql.ColumnsGT(s.C(message.FieldCreatedAt), pc.C(privatechat.FieldUser1HiddenAt)),
+									),
+								),
+								sql.And(
+									sql.EQ(pc.C(privatechat.FieldUser2ID), userID),
+									sql.Or(
+										sql.IsNull(pc.C(privatechat.FieldUser2HiddenAt)),
+										sql.ColumnsGT(s.C(message.FieldCreatedAt), pc.C(privatechat.FieldUser2HiddenAt)),
+									),
+								),
+							),
+						),
+					),
+				),
+				sql.Exists(
+					sql.Select(gm.C(groupmember.FieldID)).From(gm).
+						Join(gc).On(gc.C(groupchat.FieldID), gm.C(groupmember.FieldGroupChatID)).
+						Where(
+							sql.And(
+								sql.ColumnsEQ(gc.C(groupchat.FieldChatID), s.C(message.FieldChatID)),
+								sql.EQ(gm.C(groupmember.FieldUserID), userID),
+							),
+						),
+				),
+			),
+		)
+	}
+}
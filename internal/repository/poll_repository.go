@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/ent/pollvote"
+	"AtoiTalkAPI/ent/user"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+type PollOptionCount struct {
+	OptionID uuid.UUID `json:"option_id"`
+	Count    int       `json:"count"`
+}
+
+type PollVoterCount struct {
+	PollID uuid.UUID `json:"poll_id"`
+	Count  int       `json:"count"`
+}
+
+type PollRepository struct {
+	client *ent.Client
+}
+
+func NewPollRepository(client *ent.Client) *PollRepository {
+	return &PollRepository{
+		client: client,
+	}
+}
+
+// GetPolls loads the polls of the given messages with their options in display order.
+func (r *PollRepository) GetPolls(ctx context.Context, messageIDs []uuid.UUID) ([]*ent.Poll, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+
+	return r.client.Poll.Query().
+		Where(poll.MessageIDIn(messageIDs...)).
+		WithOptions(func(q *ent.PollOptionQuery) {
+			q.Order(ent.Asc(polloption.FieldPosition))
+		}).
+		All(ctx)
+}
+
+func (r *PollRepository) GetOptionCounts(ctx context.Context, pollIDs []uuid.UUID) ([]PollOptionCount, error) {
+	var counts []PollOptionCount
+	if len(pollIDs) == 0 {
+		return counts, nil
+	}
+
+	err := r.client.PollVote.Query().
+		Where(pollvote.PollIDIn(pollIDs...)).
+		GroupBy(pollvote.FieldOptionID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// GetVoterCounts counts distinct voters per poll, which differs from the sum of
+// option counts for multiple choice polls.
+func (r *PollRepository) GetVoterCounts(ctx context.Context, pollIDs []uuid.UUID) ([]PollVoterCount, error) {
+	var counts []PollVoterCount
+	if len(pollIDs) == 0 {
+		return counts, nil
+	}
+
+	err := r.client.PollVote.Query().
+		Where(pollvote.PollIDIn(pollIDs...)).
+		GroupBy(pollvote.FieldPollID).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(sql.Count(sql.Distinct(s.C(pollvote.FieldUserID))), "count")
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *PollRepository) GetUserVotes(ctx context.Context, userID uuid.UUID, pollIDs []uuid.UUID) ([]*ent.PollVote, error) {
+	if len(pollIDs) == 0 {
+		return nil, nil
+	}
+
+	return r.client.PollVote.Query().
+		Where(
+			pollvote.UserID(userID),
+			pollvote.PollIDIn(pollIDs...),
+		).
+		Order(ent.Asc(pollvote.FieldID)).
+		All(ctx)
+}
+
+func (r *PollRepository) GetVotes(ctx context.Context, pollID uuid.UUID, optionID *uuid.UUID, cursor uuid.UUID, limit int) ([]*ent.PollVote, error) {
+	query := r.client.PollVote.Query().
+		Where(
+			pollvote.PollID(pollID),
+			pollvote.HasUserWith(user.DeletedAtIsNil()),
+		)
+
+	if optionID != nil {
+		query = query.Where(pollvote.OptionID(*optionID))
+	}
+
+	if cursor != uuid.Nil {
+		query = query.Where(pollvote.IDGT(cursor))
+	}
+
+	return query.
+		Order(ent.Asc(pollvote.FieldID)).
+		Limit(limit + 1).
+		WithUser(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldUsername, user.FieldFullName, user.FieldAvatarID, user.FieldDeletedAt)
+			q.WithAvatar()
+		}).
+		All(ctx)
+}
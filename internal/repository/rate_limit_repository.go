@@ -48,3 +48,8 @@ func (r *RateLimitRepository) Allow(ctx context.Context, key string, limit int,
 
 	return true, ttl, nil
 }
+
+// Release drops the counter at key, giving back a claim made with Allow.
+func (r *RateLimitRepository) Release(ctx context.Context, key string) error {
+	return r.redisAdapter.Del(ctx, key)
+}
@@ -0,0 +1,33 @@
+package job
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/internal/service"
+	"context"
+	"log/slog"
+)
+
+// Archives can take a while to build, so each run handles a few exports and
+// leaves the rest to the next one.
+const chatExportBatchSize = 10
+
+func RunChatExports(ctx context.Context, client *ent.Client, chatService *service.ChatService) error {
+	ids, err := client.ChatExport.Query().
+		Where(chatexport.StatusIn(chatexport.StatusPending, chatexport.StatusProcessing)).
+		Order(ent.Asc(chatexport.FieldCreatedAt)).
+		Limit(chatExportBatchSize).
+		IDs(ctx)
+	if err != nil {
+		slog.Error("Failed to query pending chat exports", "error", err)
+		return err
+	}
+
+	for _, id := range ids {
+		if err := chatService.ProcessChatExport(ctx, id); err != nil {
+			slog.Error("Failed to process chat export", "error", err, "exportID", id)
+		}
+	}
+
+	return chatService.PurgeExpiredChatExports(ctx)
+}
@@ -0,0 +1,42 @@
+package job
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/service"
+	"context"
+	"log/slog"
+	"time"
+)
+
+const expiredMessageBatchSize = 500
+
+func RunExpiredMessageCleanup(ctx context.Context, client *ent.Client, messageService *service.MessageService) error {
+	for {
+		expiredIDs, err := client.Message.Query().
+			Where(
+				message.ExpiresAtNotNil(),
+				message.ExpiresAtLTE(time.Now().UTC()),
+			).
+			Order(ent.Asc(message.FieldExpiresAt)).
+			Limit(expiredMessageBatchSize).
+			IDs(ctx)
+		if err != nil {
+			slog.Error("Failed to query expired messages", "error", err)
+			return err
+		}
+
+		if len(expiredIDs) == 0 {
+			return nil
+		}
+
+		if err := messageService.PurgeExpiredMessages(ctx, expiredIDs); err != nil {
+			slog.Error("Failed to purge expired messages", "error", err)
+			return err
+		}
+
+		if len(expiredIDs) < expiredMessageBatchSize {
+			return nil
+		}
+	}
+}
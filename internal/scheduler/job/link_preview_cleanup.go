@@ -0,0 +1,79 @@
+package job
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/adapter"
+	"context"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+const linkPreviewCleanupBatchSize = 500
+
+// RunLinkPreviewCleanup deletes stored link preview images that no message
+// references anymore. Images are shared by every message linking the same URL,
+// and a cached preview can still hand out its image path until the cache entry
+// expires, so only images older than the cache lifetime are considered.
+func RunLinkPreviewCleanup(ctx context.Context, client *ent.Client, storage *adapter.StorageAdapter) error {
+	objects, err := storage.List(ctx, adapter.LinkPreviewImagePrefix, true)
+	if err != nil {
+		slog.Error("Failed to list link preview images", "error", err)
+		return err
+	}
+
+	cutoff := time.Now().UTC().Add(-adapter.LinkPreviewCacheTTL - time.Hour)
+	candidates := make([]string, 0)
+	for _, obj := range objects {
+		if obj.LastModified.Before(cutoff) {
+			candidates = append(candidates, obj.Key)
+		}
+	}
+
+	slog.Info("Found link preview image candidates", "count", len(candidates))
+
+	deleted := 0
+	for start := 0; start < len(candidates); start += linkPreviewCleanupBatchSize {
+		batch := candidates[start:min(start+linkPreviewCleanupBatchSize, len(candidates))]
+		paths := make([]any, 0, len(batch))
+		for _, key := range batch {
+			paths = append(paths, key)
+		}
+
+		referencing, err := client.Message.Query().
+			Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueIn(s.C(message.FieldLinkPreview), paths, sqljson.Path("image_path")))
+			}).
+			Select(message.FieldLinkPreview).
+			All(ctx)
+		if err != nil {
+			slog.Error("Failed to query link preview references", "error", err)
+			return err
+		}
+
+		inUse := make(map[string]bool, len(referencing))
+		for _, m := range referencing {
+			if m.LinkPreview != nil {
+				inUse[m.LinkPreview.ImagePath] = true
+			}
+		}
+
+		for _, key := range batch {
+			if inUse[key] {
+				continue
+			}
+			if err := storage.Delete(key, true); err != nil {
+				slog.Error("Failed to delete link preview image", "key", key, "error", err)
+				continue
+			}
+			deleted++
+		}
+	}
+
+	slog.Info("Deleted unused link preview images", "count", deleted)
+
+	return nil
+}
@@ -36,9 +36,11 @@ func RunMediaCleanup(ctx context.Context, client *ent.Client, storage *adapter.S
 				),
 			),
 			media.MessageIDIsNil(),
+			media.ScheduledMessageIDIsNil(),
 			media.Not(media.HasUserAvatar()),
 			media.Not(media.HasGroupAvatar()),
 			media.Not(media.HasReports()),
+			media.Not(media.HasMessageRevisions()),
 		).
 		All(ctx)
 
@@ -50,13 +52,28 @@ func RunMediaCleanup(ctx context.Context, client *ent.Client, storage *adapter.S
 	slog.Info("Found orphan media candidates", "count", len(orphans))
 
 	for _, m := range orphans {
-		isPublic := m.Category == media.CategoryUserAvatar || m.Category == media.CategoryGroupAvatar
-		if err := storage.Delete(m.FileName, isPublic); err != nil {
-			if fallbackErr := storage.Delete(m.FileName, !isPublic); fallbackErr != nil {
-				slog.Error("Failed to delete S3 file", "mediaID", m.ID, "key", m.FileName, "error", err, "fallback_error", fallbackErr)
-				continue
+		// Forwarded attachments are cloned rows sharing the same storage object,
+		// so the object is only removed once no other row references it.
+		shared, err := client.Media.Query().
+			Where(
+				media.FileName(m.FileName),
+				media.IDNEQ(m.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			slog.Error("Failed to check shared media references", "mediaID", m.ID, "error", err)
+			continue
+		}
+
+		if !shared {
+			isPublic := m.Category == media.CategoryUserAvatar || m.Category == media.CategoryGroupAvatar
+			if err := storage.Delete(m.FileName, isPublic); err != nil {
+				if fallbackErr := storage.Delete(m.FileName, !isPublic); fallbackErr != nil {
+					slog.Error("Failed to delete S3 file", "mediaID", m.ID, "key", m.FileName, "error", err, "fallback_error", fallbackErr)
+					continue
+				}
+				slog.Warn("Deleted S3 file using fallback bucket", "mediaID", m.ID, "key", m.FileName)
 			}
-			slog.Warn("Deleted S3 file using fallback bucket", "mediaID", m.ID, "key", m.FileName)
 		}
 
 		err = client.Media.DeleteOneID(m.ID).Exec(ctx)
@@ -0,0 +1,41 @@
+package job
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/internal/service"
+	"context"
+	"log/slog"
+	"time"
+)
+
+const scheduledMessageBatchSize = 500
+
+func RunScheduledMessageDispatch(ctx context.Context, client *ent.Client, messageService *service.MessageService) error {
+	dueIDs, err := client.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.SendAtLTE(time.Now().UTC()),
+		).
+		Order(ent.Asc(scheduledmessage.FieldSendAt)).
+		Limit(scheduledMessageBatchSize).
+		IDs(ctx)
+	if err != nil {
+		slog.Error("Failed to query due scheduled messages", "error", err)
+		return err
+	}
+
+	if len(dueIDs) == 0 {
+		return nil
+	}
+
+	slog.Info("Found due scheduled messages", "count", len(dueIDs))
+
+	for _, id := range dueIDs {
+		if err := messageService.DeliverScheduledMessage(ctx, id); err != nil {
+			slog.Error("Failed to deliver scheduled message", "error", err, "scheduledID", id)
+		}
+	}
+
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"AtoiTalkAPI/ent"
 	"AtoiTalkAPI/internal/adapter"
 	"AtoiTalkAPI/internal/config"
+	"AtoiTalkAPI/internal/repository"
 	"AtoiTalkAPI/internal/scheduler/job"
+	"AtoiTalkAPI/internal/service"
+	"AtoiTalkAPI/internal/websocket"
 	"context"
 	"log/slog"
 	"net/http"
@@ -19,12 +22,21 @@ type Scheduler struct {
 	client         *ent.Client
 	cron           *cron.Cron
 	storageAdapter *adapter.StorageAdapter
+	messageService *service.MessageService
+	chatService    *service.ChatService
 }
 
-func New(cfg *config.AppConfig, client *ent.Client, s3Client *s3.Client) *Scheduler {
+// New wires the services used by the jobs. wsHub should be a publish-only hub,
+// since the scheduler has no client connections of its own.
+func New(cfg *config.AppConfig, client *ent.Client, s3Client *s3.Client, redisAdapter *adapter.RedisAdapter, wsHub *websocket.Hub) *Scheduler {
 	httpClient := &http.Client{Timeout: 30 * time.Second}
 	storageAdapter := adapter.NewStorageAdapter(cfg, s3Client, httpClient)
 
+	repo := repository.NewRepository(client, redisAdapter, cfg)
+	linkPreviewAdapter := adapter.NewLinkPreviewAdapter(httpClient, redisAdapter, storageAdapter)
+	messageService := service.NewMessageService(client, repo, cfg, config.NewValidator(), storageAdapter, wsHub, linkPreviewAdapter)
+	chatService := service.NewChatService(client, repo, cfg, config.NewValidator(), wsHub, storageAdapter, redisAdapter)
+
 	c := cron.New()
 
 	return &Scheduler{
@@ -32,6 +44,8 @@ func New(cfg *config.AppConfig, client *ent.Client, s3Client *s3.Client) *Schedu
 		client:         client,
 		cron:           c,
 		storageAdapter: storageAdapter,
+		messageService: messageService,
+		chatService:    chatService,
 	}
 }
 
@@ -89,10 +103,55 @@ func (s *Scheduler) registerJobs() {
 		} else {
 			slog.Info("Media Cleanup Job completed")
 		}
+		// Link preview images are not Media rows and are swept separately.
+		if err := job.RunLinkPreviewCleanup(ctx, s.client, s.storageAdapter); err != nil {
+			slog.Error("Link Preview Cleanup failed", "error", err)
+		}
 	})
 	if err != nil {
 		slog.Error("Failed to register Media Cleanup job", "error", err)
 	} else {
 		slog.Info("Registered Media Cleanup Job", "schedule", s.cfg.MediaCleanupCron)
 	}
+
+	// The message jobs run every few seconds, so only failures are logged and
+	// overlapping runs are skipped.
+	dispatch := cron.FuncJob(func() {
+		ctx := context.Background()
+		if err := job.RunScheduledMessageDispatch(ctx, s.client, s.messageService); err != nil {
+			slog.Error("Scheduled Message Dispatch Job failed", "error", err)
+		}
+	})
+	_, err = s.cron.AddJob(s.cfg.ScheduledMessageCron, cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(dispatch))
+	if err != nil {
+		slog.Error("Failed to register Scheduled Message Dispatch job", "error", err)
+	} else {
+		slog.Info("Registered Scheduled Message Dispatch Job", "schedule", s.cfg.ScheduledMessageCron)
+	}
+
+	expiry := cron.FuncJob(func() {
+		ctx := context.Background()
+		if err := job.RunExpiredMessageCleanup(ctx, s.client, s.messageService); err != nil {
+			slog.Error("Expired Message Cleanup Job failed", "error", err)
+		}
+	})
+	_, err = s.cron.AddJob(s.cfg.ExpiredMessageCron, cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(expiry))
+	if err != nil {
+		slog.Error("Failed to register Expired Message Cleanup job", "error", err)
+	} else {
+		slog.Info("Registered Expired Message Cleanup Job", "schedule", s.cfg.ExpiredMessageCron)
+	}
+
+	exports := cron.FuncJob(func() {
+		ctx := context.Background()
+		if err := job.RunChatExports(ctx, s.client, s.chatService); err != nil {
+			slog.Error("Chat Export Job failed", "error", err)
+		}
+	})
+	_, err = s.cron.AddJob(s.cfg.ChatExportCron, cron.NewChain(cron.SkipIfStillRunning(cron.DiscardLogger)).Then(exports))
+	if err != nil {
+		slog.Error("Failed to register Chat Export job", "error", err)
+	} else {
+		slog.Info("Registered Chat Export Job", "schedule", s.cfg.ChatExportCron)
+	}
 }
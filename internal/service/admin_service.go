@@ -341,6 +341,7 @@ func (s *AdminService) GetReportDetail(ctx context.Context, reportID uuid.UUID)
 	var targetID *uuid.UUID
 	targetIsDeleted := true
 	targetIsBanned := false
+	var messageRevisions []model.MessageRevisionResponse
 
 	switch r.TargetType {
 	case report.TargetTypeUser:
@@ -391,6 +392,10 @@ func (s *AdminService) GetReportDetail(ctx context.Context, reportID uuid.UUID)
 				if msg.DeletedAt == nil {
 					targetIsDeleted = false
 				}
+				messageRevisions, err = loadMessageRevisions(ctx, s.client, s.storageAdapter, msg.ID)
+				if err != nil {
+					return nil, err
+				}
 				if msg.Edges.Sender != nil {
 					if msg.Edges.Sender.IsBanned {
 						if msg.Edges.Sender.BannedUntil == nil || time.Now().Before(*msg.Edges.Sender.BannedUntil) {
@@ -422,6 +427,7 @@ func (s *AdminService) GetReportDetail(ctx context.Context, reportID uuid.UUID)
 		ReporterIsDeleted: reporterIsDeleted,
 		ReporterIsBanned:  reporterIsBanned,
 		EvidenceSnapshot:  r.EvidenceSnapshot,
+		MessageRevisions:  messageRevisions,
 		AdminNotes:        adminNotes,
 		CreatedAt:         r.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:         r.UpdatedAt.Format(time.RFC3339),
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArchiveChat moves a chat out of the inbox of userID. Unlike HideChat the chat
+// keeps its unread count and stays listed in the archive.
+func (s *ChatService) ArchiveChat(ctx context.Context, userID, chatID uuid.UUID, req model.ArchiveChatRequest) (*model.ChatListResponse, error) {
+	return s.changeArchive(ctx, userID, chatID, true, req.KeepArchived)
+}
+
+// UnarchiveChat moves an archived chat back to the inbox of userID.
+func (s *ChatService) UnarchiveChat(ctx context.Context, userID, chatID uuid.UUID) (*model.ChatListResponse, error) {
+	return s.changeArchive(ctx, userID, chatID, false, false)
+}
+
+func (s *ChatService) changeArchive(ctx context.Context, userID, chatID uuid.UUID, archive, keepArchived bool) (*model.ChatListResponse, error) {
+	c, err := s.client.Chat.Query().
+		Where(
+			chat.ID(chatID),
+			chat.DeletedAtIsNil(),
+		).
+		WithPrivateChat().
+		WithGroupChat().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("")
+		}
+		slog.Error("Failed to query chat", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	now := time.Now().UTC()
+
+	switch {
+	case c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil:
+		pc := c.Edges.PrivateChat
+		update := s.client.PrivateChat.UpdateOneID(pc.ID)
+
+		if pc.User1ID != nil && *pc.User1ID == userID {
+			if archive {
+				update.SetUser1ArchivedAt(now)
+			} else {
+				update.ClearUser1ArchivedAt()
+			}
+			update.SetUser1KeepArchived(keepArchived)
+		} else if pc.User2ID != nil && *pc.User2ID == userID {
+			if archive {
+				update.SetUser2ArchivedAt(now)
+			} else {
+				update.ClearUser2ArchivedAt()
+			}
+			update.SetUser2KeepArchived(keepArchived)
+		} else {
+			return nil, helper.NewForbiddenError("")
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			slog.Error("Failed to update private chat archive", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+
+	case c.Type == chat.TypeGroup && c.Edges.GroupChat != nil:
+		update := s.client.GroupMember.Update().
+			Where(
+				groupmember.GroupChatID(c.Edges.GroupChat.ID),
+				groupmember.UserID(userID),
+			).
+			SetKeepArchived(keepArchived)
+		if archive {
+			update.SetArchivedAt(now)
+		} else {
+			update.ClearArchivedAt()
+		}
+
+		n, err := update.Save(ctx)
+		if err != nil {
+			slog.Error("Failed to update group member archive", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if n == 0 {
+			return nil, helper.NewForbiddenError("Not a member of this group")
+		}
+
+	default:
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		go s.wsHub.BroadcastToUser(userID, websocket.Event{
+			Type: websocket.EventChatArchive,
+			Payload: map[string]interface{}{
+				"chat_id":       chatID,
+				"is_archived":   archive,
+				"keep_archived": keepArchived,
+			},
+			Meta: &websocket.EventMeta{
+				Timestamp: now.UnixMilli(),
+				ChatID:    chatID,
+				SenderID:  userID,
+			},
+		})
+	}
+
+	return s.GetChatByID(ctx, userID, chatID)
+}
+
+// GetArchiveSummary aggregates the unread counters of every chat in the archive
+// of userID, so clients can badge the archive without listing it.
+func (s *ChatService) GetArchiveSummary(ctx context.Context, userID uuid.UUID) (*model.ArchiveSummaryResponse, error) {
+	chats, err := s.repo.Chat.GetArchivedChats(ctx, userID)
+	if err != nil {
+		slog.Error("Failed to get archived chats", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	summary := &model.ArchiveSummaryResponse{ChatCount: len(chats)}
+	summary.UnreadChatCount, summary.UnreadCount, summary.UnreadMentionCount = countUnread(userID, chats)
+
+	return summary, nil
+}
+
+// countUnread sums the unread counters of userID across chats loaded with the
+// user's own private chat or membership row. Chats marked as unread count as
+// unread chats.
+func countUnread(userID uuid.UUID, chats []*ent.Chat) (unreadChats, unread, mentions int) {
+	for _, c := range chats {
+		var chatUnread int
+		var marked bool
+		if pc := c.Edges.PrivateChat; pc != nil {
+			if pc.User1ID != nil && *pc.User1ID == userID {
+				chatUnread, marked = pc.User1UnreadCount, pc.User1MarkedUnread
+			} else {
+				chatUnread, marked = pc.User2UnreadCount, pc.User2MarkedUnread
+			}
+		} else if gc := c.Edges.GroupChat; gc != nil && len(gc.Edges.Members) > 0 {
+			chatUnread, marked = gc.Edges.Members[0].UnreadCount, gc.Edges.Members[0].MarkedUnread
+			mentions += gc.Edges.Members[0].UnreadMentionCount
+		}
+
+		if chatUnread > 0 || marked {
+			unreadChats++
+		}
+		unread += chatUnread
+	}
+
+	return unreadChats, unread, mentions
+}
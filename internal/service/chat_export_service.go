@@ -0,0 +1,645 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/polloption"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	chatExportBatchSize = 500
+
+	// Attachments are added to the archive until their combined size reaches
+	// this cap, later ones are listed in the transcript without the file.
+	maxChatExportAttachmentBytes = 1 << 30
+
+	chatExportRetention = 7 * 24 * time.Hour
+	chatExportLinkTTL   = time.Hour
+
+	// A processing export that has not been touched for this long is assumed
+	// to belong to a crashed worker and is picked up again.
+	chatExportStaleAfter = 2 * time.Hour
+
+	chatExportPurgeBatchSize = 100
+)
+
+var chatExportTemplate = template.Must(template.ParseFS(templateFS, "template/chat_export.html"))
+
+type chatExportInfo struct {
+	ID         uuid.UUID `json:"id"`
+	Type       string    `json:"type"`
+	Name       string    `json:"name"`
+	ExportedAt string    `json:"exported_at"`
+}
+
+type chatExportAttachment struct {
+	ID           uuid.UUID `json:"id"`
+	OriginalName string    `json:"original_name"`
+	MimeType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
+
+	// Location of the file inside the archive, empty when it was left out
+	Path string `json:"path,omitempty"`
+
+	fileName string
+}
+
+func (a chatExportAttachment) IsImage() bool {
+	return strings.HasPrefix(a.MimeType, "image/")
+}
+
+type chatExportPoll struct {
+	Question string   `json:"question"`
+	Options  []string `json:"options"`
+}
+
+type chatExportMessage struct {
+	ID          uuid.UUID              `json:"id"`
+	SenderID    *uuid.UUID             `json:"sender_id,omitempty"`
+	SenderName  string                 `json:"sender_name,omitempty"`
+	Type        string                 `json:"type"`
+	Content     string                 `json:"content,omitempty"`
+	ActionData  map[string]interface{} `json:"action_data,omitempty"`
+	ReplyToID   *uuid.UUID             `json:"reply_to_id,omitempty"`
+	Attachments []chatExportAttachment `json:"attachments,omitempty"`
+	Poll        *chatExportPoll        `json:"poll,omitempty"`
+	Location    *model.LocationDTO     `json:"location,omitempty"`
+	Contact     *model.ContactDTO      `json:"contact,omitempty"`
+	CreatedAt   string                 `json:"created_at"`
+	EditedAt    *string                `json:"edited_at,omitempty"`
+}
+
+func (m chatExportMessage) System() bool {
+	return strings.HasPrefix(m.Type, "system_")
+}
+
+func (m chatExportMessage) Edited() bool {
+	return m.EditedAt != nil
+}
+
+// RequestChatExport queues an archive of the chat history visible to userID.
+// The archive is built by the chat export job, which notifies the user with a
+// chat.export event once it is ready.
+func (s *ChatService) RequestChatExport(ctx context.Context, userID, chatID uuid.UUID) (*model.ChatExportResponse, error) {
+	if _, _, err := s.chatExportAccess(ctx, userID, chatID); err != nil {
+		return nil, err
+	}
+
+	inProgress, err := s.client.ChatExport.Query().
+		Where(
+			chatexport.UserID(userID),
+			chatexport.ChatID(chatID),
+			chatexport.StatusIn(chatexport.StatusPending, chatexport.StatusProcessing),
+		).
+		Exist(ctx)
+	if err != nil {
+		slog.Error("Failed to check pending chat exports", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if inProgress {
+		return nil, helper.NewConflictError("An export of this chat is already in progress")
+	}
+
+	exp, err := s.client.ChatExport.Create().
+		SetUserID(userID).
+		SetChatID(chatID).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create chat export", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.toChatExportResponse(exp), nil
+}
+
+func (s *ChatService) GetChatExport(ctx context.Context, userID, exportID uuid.UUID) (*model.ChatExportResponse, error) {
+	exp, err := s.client.ChatExport.Query().
+		Where(
+			chatexport.ID(exportID),
+			chatexport.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Export not found")
+		}
+		slog.Error("Failed to get chat export", "error", err, "exportID", exportID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.toChatExportResponse(exp), nil
+}
+
+// ProcessChatExport builds and uploads the archive of a pending export. An
+// export already claimed by another run is skipped.
+func (s *ChatService) ProcessChatExport(ctx context.Context, exportID uuid.UUID) error {
+	claimed, err := s.client.ChatExport.Update().
+		Where(
+			chatexport.ID(exportID),
+			chatexport.Or(
+				chatexport.StatusEQ(chatexport.StatusPending),
+				chatexport.And(
+					chatexport.StatusEQ(chatexport.StatusProcessing),
+					chatexport.UpdatedAtLT(time.Now().UTC().Add(-chatExportStaleAfter)),
+				),
+			),
+		).
+		SetStatus(chatexport.StatusProcessing).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if claimed == 0 {
+		return nil
+	}
+
+	exp, err := s.client.ChatExport.Get(ctx, exportID)
+	if err != nil {
+		return err
+	}
+
+	// Access is checked again, the user may have left the chat since asking.
+	c, hiddenAt, err := s.chatExportAccess(ctx, exp.UserID, exp.ChatID)
+	if err != nil {
+		var appErr *helper.AppError
+		if errors.As(err, &appErr) && appErr.Code < http.StatusInternalServerError {
+			return s.failChatExport(ctx, exp, "You no longer have access to this chat")
+		}
+		return err
+	}
+
+	path := fmt.Sprintf("exports/%s/%s.zip", exp.UserID, exp.ID)
+	messageCount, fileSize, err := s.buildChatExport(ctx, exp.UserID, c, hiddenAt, path)
+	if err != nil {
+		slog.Error("Failed to build chat export", "error", err, "exportID", exp.ID)
+		return s.failChatExport(ctx, exp, "Failed to build the archive")
+	}
+
+	now := time.Now().UTC()
+	exp, err = s.client.ChatExport.UpdateOneID(exp.ID).
+		SetStatus(chatexport.StatusCompleted).
+		SetFileName(path).
+		SetFileSize(fileSize).
+		SetMessageCount(messageCount).
+		SetCompletedAt(now).
+		SetExpiresAt(now.Add(chatExportRetention)).
+		ClearFailureReason().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.notifyChatExport(exp)
+	return nil
+}
+
+// PurgeExpiredChatExports removes archives past their retention together with
+// their export records.
+func (s *ChatService) PurgeExpiredChatExports(ctx context.Context) error {
+	expired, err := s.client.ChatExport.Query().
+		Where(chatexport.ExpiresAtLT(time.Now().UTC())).
+		Limit(chatExportPurgeBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, exp := range expired {
+		if exp.FileName != nil {
+			if err := s.storageAdapter.Delete(*exp.FileName, false); err != nil {
+				slog.Error("Failed to delete chat export file", "error", err, "exportID", exp.ID)
+				continue
+			}
+		}
+
+		if err := s.client.ChatExport.DeleteOne(exp).Exec(ctx); err != nil {
+			slog.Error("Failed to delete chat export", "error", err, "exportID", exp.ID)
+		}
+	}
+
+	return nil
+}
+
+// chatExportAccess checks that userID may export chatID. Private chat
+// participants export their own view of the history, group chats can only be
+// exported by their owner. It returns the chat and, for private chats, the
+// user's hidden_at.
+func (s *ChatService) chatExportAccess(ctx context.Context, userID, chatID uuid.UUID) (*ent.Chat, *time.Time, error) {
+	c, err := s.client.Chat.Query().
+		Where(
+			chat.ID(chatID),
+			chat.DeletedAtIsNil(),
+		).
+		WithPrivateChat(func(q *ent.PrivateChatQuery) {
+			q.WithUser1().WithUser2()
+		}).
+		WithGroupChat(func(q *ent.GroupChatQuery) {
+			q.WithMembers(func(mq *ent.GroupMemberQuery) {
+				mq.Where(groupmember.UserID(userID))
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, helper.NewNotFoundError("Chat not found or deleted")
+		}
+		slog.Error("Failed to query chat for export", "error", err, "chatID", chatID)
+		return nil, nil, helper.NewInternalServerError("")
+	}
+
+	if c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil {
+		pc := c.Edges.PrivateChat
+		if pc.User1ID != nil && *pc.User1ID == userID {
+			return c, pc.User1HiddenAt, nil
+		}
+		if pc.User2ID != nil && *pc.User2ID == userID {
+			return c, pc.User2HiddenAt, nil
+		}
+		return nil, nil, helper.NewForbiddenError("")
+	}
+
+	if c.Type == chat.TypeGroup && c.Edges.GroupChat != nil {
+		members := c.Edges.GroupChat.Edges.Members
+		if len(members) == 0 {
+			return nil, nil, helper.NewForbiddenError("You are not a member of this group")
+		}
+		if members[0].Role != groupmember.RoleOwner {
+			return nil, nil, helper.NewForbiddenError("Only the owner can export this group")
+		}
+		return c, nil, nil
+	}
+
+	return nil, nil, helper.NewInternalServerError("")
+}
+
+// buildChatExport writes the zip archive to a temporary file and uploads it to
+// the private bucket. The archive holds messages.json, a self-contained
+// transcript.html and the attachments that fit under the size cap.
+func (s *ChatService) buildChatExport(ctx context.Context, userID uuid.UUID, c *ent.Chat, hiddenAt *time.Time, path string) (int, int64, error) {
+	archive, err := os.CreateTemp("", "chat-export-*.zip")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	// The transcript is rendered next to messages.json, and the zip writer
+	// only accepts one open entry at a time.
+	transcript, err := os.CreateTemp("", "chat-export-*.html")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer os.Remove(transcript.Name())
+	defer transcript.Close()
+
+	info := chatExportInfo{
+		ID:         c.ID,
+		Type:       string(c.Type),
+		Name:       chatExportName(c, userID),
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	zw := zip.NewWriter(archive)
+	htmlWriter := bufio.NewWriter(transcript)
+
+	if err := chatExportTemplate.ExecuteTemplate(htmlWriter, "chat_export_header", map[string]string{
+		"ChatName":   info.Name,
+		"ExportedAt": info.ExportedAt,
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	jsonWriter, err := zw.Create("messages.json")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	header, err := json.Marshal(info)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := fmt.Fprintf(jsonWriter, `{"chat":%s,"messages":[`, header); err != nil {
+		return 0, 0, err
+	}
+
+	var (
+		attachments     []chatExportAttachment
+		attachmentBytes int64
+		messageCount    int
+		lastID          uuid.UUID
+	)
+
+	for {
+		query := s.client.Message.Query().
+			Where(
+				message.ChatID(c.ID),
+				message.DeletedAtIsNil(),
+				message.Or(
+					message.ExpiresAtIsNil(),
+					message.ExpiresAtGT(time.Now().UTC()),
+				),
+			)
+		if hiddenAt != nil {
+			query.Where(message.CreatedAtGT(*hiddenAt))
+		}
+		if lastID != uuid.Nil {
+			query.Where(message.IDGT(lastID))
+		}
+
+		batch, err := query.
+			WithSender().
+			WithAttachments(func(q *ent.MediaQuery) {
+				q.Where(media.UploadStatusEQ(media.UploadStatusCompleted))
+			}).
+			WithPoll(func(q *ent.PollQuery) {
+				q.WithOptions(func(oq *ent.PollOptionQuery) {
+					oq.Order(ent.Asc(polloption.FieldPosition))
+				})
+			}).
+			WithContactUser().
+			Order(ent.Asc(message.FieldID)).
+			Limit(chatExportBatchSize).
+			All(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, msg := range batch {
+			entry := toChatExportMessage(msg)
+			for i := range entry.Attachments {
+				att := &entry.Attachments[i]
+				if attachmentBytes+att.FileSize > maxChatExportAttachmentBytes {
+					continue
+				}
+				attachmentBytes += att.FileSize
+				att.Path = fmt.Sprintf("attachments/%s-%s", att.ID, sanitizeExportFileName(att.OriginalName))
+				attachments = append(attachments, *att)
+			}
+
+			data, err := json.Marshal(entry)
+			if err != nil {
+				return 0, 0, err
+			}
+			if messageCount > 0 {
+				data = append([]byte{','}, data...)
+			}
+			if _, err := jsonWriter.Write(data); err != nil {
+				return 0, 0, err
+			}
+
+			if err := chatExportTemplate.ExecuteTemplate(htmlWriter, "chat_export_message", entry); err != nil {
+				return 0, 0, err
+			}
+
+			messageCount++
+		}
+
+		if len(batch) < chatExportBatchSize {
+			break
+		}
+		lastID = batch[len(batch)-1].ID
+	}
+
+	if _, err := io.WriteString(jsonWriter, "]}"); err != nil {
+		return 0, 0, err
+	}
+
+	if err := chatExportTemplate.ExecuteTemplate(htmlWriter, "chat_export_footer", nil); err != nil {
+		return 0, 0, err
+	}
+	if err := htmlWriter.Flush(); err != nil {
+		return 0, 0, err
+	}
+	if _, err := transcript.Seek(0, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+
+	htmlEntry, err := zw.Create("transcript.html")
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := io.Copy(htmlEntry, transcript); err != nil {
+		return 0, 0, err
+	}
+
+	for _, att := range attachments {
+		if err := s.addChatExportAttachment(ctx, zw, att); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	stat, err := archive.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return 0, 0, err
+	}
+
+	if err := s.storageAdapter.StoreFromReader(archive, "application/zip", path, false); err != nil {
+		return 0, 0, err
+	}
+
+	return messageCount, stat.Size(), nil
+}
+
+func (s *ChatService) addChatExportAttachment(ctx context.Context, zw *zip.Writer, att chatExportAttachment) error {
+	reader, err := s.storageAdapter.Open(ctx, att.fileName, false)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	// Media is usually compressed already, so it is stored as is.
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     att.Path,
+		Method:   zip.Store,
+		Modified: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, reader)
+	return err
+}
+
+func (s *ChatService) failChatExport(ctx context.Context, exp *ent.ChatExport, reason string) error {
+	exp, err := s.client.ChatExport.UpdateOneID(exp.ID).
+		SetStatus(chatexport.StatusFailed).
+		SetFailureReason(reason).
+		SetExpiresAt(time.Now().UTC().Add(chatExportRetention)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.notifyChatExport(exp)
+	return nil
+}
+
+func (s *ChatService) notifyChatExport(exp *ent.ChatExport) {
+	if s.wsHub == nil {
+		return
+	}
+
+	go s.wsHub.BroadcastToUser(exp.UserID, websocket.Event{
+		Type:    websocket.EventChatExport,
+		Payload: s.toChatExportResponse(exp),
+		Meta: &websocket.EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			ChatID:    exp.ChatID,
+		},
+	})
+}
+
+func (s *ChatService) toChatExportResponse(exp *ent.ChatExport) *model.ChatExportResponse {
+	resp := &model.ChatExportResponse{
+		ID:            exp.ID,
+		ChatID:        exp.ChatID,
+		Status:        string(exp.Status),
+		MessageCount:  exp.MessageCount,
+		FileSize:      exp.FileSize,
+		FailureReason: exp.FailureReason,
+		CreatedAt:     exp.CreatedAt.Format(time.RFC3339),
+	}
+
+	if exp.CompletedAt != nil {
+		completedAt := exp.CompletedAt.Format(time.RFC3339)
+		resp.CompletedAt = &completedAt
+	}
+	if exp.ExpiresAt != nil {
+		expiresAt := exp.ExpiresAt.Format(time.RFC3339)
+		resp.ExpiresAt = &expiresAt
+	}
+
+	if exp.Status == chatexport.StatusCompleted && exp.FileName != nil &&
+		exp.ExpiresAt != nil && time.Now().UTC().Before(*exp.ExpiresAt) {
+		url, err := s.storageAdapter.GetPresignedURL(*exp.FileName, chatExportLinkTTL)
+		if err != nil {
+			slog.Error("Failed to presign chat export", "error", err, "exportID", exp.ID)
+		} else {
+			resp.DownloadURL = url
+		}
+	}
+
+	return resp
+}
+
+func toChatExportMessage(msg *ent.Message) chatExportMessage {
+	entry := chatExportMessage{
+		ID:         msg.ID,
+		SenderID:   msg.SenderID,
+		Type:       string(msg.Type),
+		ActionData: msg.ActionData,
+		ReplyToID:  msg.ReplyToID,
+		CreatedAt:  msg.CreatedAt.Format(time.RFC3339),
+	}
+
+	if msg.Content != nil {
+		entry.Content = *msg.Content
+	}
+	if msg.EditedAt != nil {
+		editedAt := msg.EditedAt.Format(time.RFC3339)
+		entry.EditedAt = &editedAt
+	}
+
+	if sender := msg.Edges.Sender; sender != nil {
+		if sender.DeletedAt != nil {
+			entry.SenderName = "Deleted User"
+			entry.SenderID = nil
+		} else if sender.FullName != nil {
+			entry.SenderName = *sender.FullName
+		}
+	}
+
+	for _, att := range msg.Edges.Attachments {
+		entry.Attachments = append(entry.Attachments, chatExportAttachment{
+			ID:           att.ID,
+			OriginalName: att.OriginalName,
+			MimeType:     att.MimeType,
+			FileSize:     att.FileSize,
+			fileName:     att.FileName,
+		})
+	}
+
+	if p := msg.Edges.Poll; p != nil {
+		entry.Poll = &chatExportPoll{Question: p.Question}
+		for _, opt := range p.Edges.Options {
+			entry.Poll.Options = append(entry.Poll.Options, opt.Text)
+		}
+	}
+
+	entry.Location = helper.ToLocationDTO(msg.Location, time.Now().UTC())
+	entry.Contact = helper.ToContactDTO(msg, nil)
+
+	return entry
+}
+
+// chatExportName is the chat title as seen by userID.
+func chatExportName(c *ent.Chat, userID uuid.UUID) string {
+	if gc := c.Edges.GroupChat; gc != nil {
+		return gc.Name
+	}
+
+	if pc := c.Edges.PrivateChat; pc != nil {
+		other := pc.Edges.User2
+		if pc.User2ID != nil && *pc.User2ID == userID {
+			other = pc.Edges.User1
+		}
+		if other == nil || other.DeletedAt != nil {
+			return "Deleted User"
+		}
+		if other.FullName != nil {
+			return *other.FullName
+		}
+	}
+
+	return "Chat"
+}
+
+// sanitizeExportFileName keeps attachment names portable across archive tools.
+func sanitizeExportFileName(name string) string {
+	name = filepath.Base(name)
+	name = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+
+	if len(name) > 100 {
+		name = name[len(name)-100:]
+	}
+	if name == "" || name == "." || name == ".." {
+		name = "file"
+	}
+	return name
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatfolder"
+	"AtoiTalkAPI/ent/chatfolderchat"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const maxChatFolders = 10
+
+// GetChatFolders lists the chat folders of userID in creation order, each with
+// the unread counters of the chats it currently holds.
+func (s *ChatService) GetChatFolders(ctx context.Context, userID uuid.UUID) ([]model.ChatFolderResponse, error) {
+	folders, err := s.client.ChatFolder.Query().
+		Where(chatfolder.UserID(userID)).
+		WithChats().
+		Order(ent.Asc(chatfolder.FieldCreatedAt), ent.Asc(chatfolder.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query chat folders", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp := make([]model.ChatFolderResponse, 0, len(folders))
+	for _, f := range folders {
+		folderResp, err := s.toChatFolderResponse(ctx, userID, f)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, *folderResp)
+	}
+
+	return resp, nil
+}
+
+// CreateChatFolder adds a chat folder for userID.
+func (s *ChatService) CreateChatFolder(ctx context.Context, userID uuid.UUID, req model.ChatFolderRequest) (*model.ChatFolderResponse, error) {
+	if err := s.validateChatFolder(&req); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.User.Query().Where(user.ID(userID)).ForUpdate().Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("User not found")
+		}
+		slog.Error("Failed to lock user for chat folders", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	count, err := tx.ChatFolder.Query().Where(chatfolder.UserID(userID)).Count(ctx)
+	if err != nil {
+		slog.Error("Failed to count chat folders", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if count >= maxChatFolders {
+		return nil, helper.NewBadRequestError("Maximum number of chat folders reached")
+	}
+
+	folder, err := tx.ChatFolder.Create().
+		SetUserID(userID).
+		SetName(req.Name).
+		SetNillableChatType(chatFolderType(req.ChatType)).
+		SetUnreadOnly(req.UnreadOnly).
+		SetNillableMuted(req.Muted).
+		SetNillableArchived(req.Archived).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create chat folder", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.saveChatFolderChats(ctx, tx, userID, folder, req.ChatIDs)
+}
+
+// UpdateChatFolder replaces the name, rules and chats of a folder of userID.
+func (s *ChatService) UpdateChatFolder(ctx context.Context, userID, folderID uuid.UUID, req model.ChatFolderRequest) (*model.ChatFolderResponse, error) {
+	if err := s.validateChatFolder(&req); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	folder, err := tx.ChatFolder.Query().
+		Where(chatfolder.ID(folderID), chatfolder.UserID(userID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Chat folder not found")
+		}
+		slog.Error("Failed to query chat folder", "error", err, "folderID", folderID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	update := folder.Update().
+		SetName(req.Name).
+		SetUnreadOnly(req.UnreadOnly)
+	if t := chatFolderType(req.ChatType); t != nil {
+		update.SetChatType(*t)
+	} else {
+		update.ClearChatType()
+	}
+	if req.Muted != nil {
+		update.SetMuted(*req.Muted)
+	} else {
+		update.ClearMuted()
+	}
+	if req.Archived != nil {
+		update.SetArchived(*req.Archived)
+	} else {
+		update.ClearArchived()
+	}
+
+	folder, err = update.Save(ctx)
+	if err != nil {
+		slog.Error("Failed to update chat folder", "error", err, "folderID", folderID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if _, err := tx.ChatFolderChat.Delete().Where(chatfolderchat.FolderID(folderID)).Exec(ctx); err != nil {
+		slog.Error("Failed to clear chat folder chats", "error", err, "folderID", folderID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.saveChatFolderChats(ctx, tx, userID, folder, req.ChatIDs)
+}
+
+// DeleteChatFolder removes a folder of userID. The chats in it are not touched.
+func (s *ChatService) DeleteChatFolder(ctx context.Context, userID, folderID uuid.UUID) error {
+	n, err := s.client.ChatFolder.Delete().
+		Where(chatfolder.ID(folderID), chatfolder.UserID(userID)).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to delete chat folder", "error", err, "folderID", folderID)
+		return helper.NewInternalServerError("")
+	}
+	if n == 0 {
+		return helper.NewNotFoundError("Chat folder not found")
+	}
+
+	s.broadcastChatFolder(userID, folderID, nil)
+
+	return nil
+}
+
+func (s *ChatService) getChatFolder(ctx context.Context, userID, folderID uuid.UUID) (*ent.ChatFolder, error) {
+	folder, err := s.client.ChatFolder.Query().
+		Where(chatfolder.ID(folderID), chatfolder.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Chat folder not found")
+		}
+		slog.Error("Failed to query chat folder", "error", err, "folderID", folderID)
+		return nil, helper.NewInternalServerError("")
+	}
+	return folder, nil
+}
+
+// validateChatFolder validates req and trims its name. A folder needs at least
+// one chat or one rule, otherwise it could never hold a chat.
+func (s *ChatService) validateChatFolder(req *model.ChatFolderRequest) error {
+	req.Name = strings.TrimSpace(req.Name)
+	if err := s.validator.Struct(req); err != nil || req.Name == "" {
+		return helper.NewBadRequestError("")
+	}
+
+	if len(req.ChatIDs) == 0 && req.ChatType == nil && !req.UnreadOnly && req.Muted == nil && req.Archived == nil {
+		return helper.NewBadRequestError("Chat folder needs at least one chat or rule")
+	}
+
+	return nil
+}
+
+// saveChatFolderChats adds chatIDs to folder, commits tx and syncs the folder to
+// every device of userID. Every chat must be one userID takes part in.
+func (s *ChatService) saveChatFolderChats(ctx context.Context, tx *ent.Tx, userID uuid.UUID, folder *ent.ChatFolder, chatIDs []uuid.UUID) (*model.ChatFolderResponse, error) {
+	if len(chatIDs) > 0 {
+		count, err := tx.Chat.Query().
+			Where(chat.IDIn(chatIDs...), participatingChat(userID)).
+			Count(ctx)
+		if err != nil {
+			slog.Error("Failed to query chats for folder", "error", err, "folderID", folder.ID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if count != len(chatIDs) {
+			return nil, helper.NewBadRequestError("Chat folder can only hold chats of the current user")
+		}
+
+		bulk := make([]*ent.ChatFolderChatCreate, len(chatIDs))
+		for i, id := range chatIDs {
+			bulk[i] = tx.ChatFolderChat.Create().SetFolderID(folder.ID).SetChatID(id)
+		}
+		if err := tx.ChatFolderChat.CreateBulk(bulk...).Exec(ctx); err != nil {
+			slog.Error("Failed to add chats to folder", "error", err, "folderID", folder.ID)
+			return nil, helper.NewInternalServerError("")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	folder, err := s.client.ChatFolder.Query().
+		Where(chatfolder.ID(folder.ID)).
+		WithChats().
+		Only(ctx)
+	if err != nil {
+		slog.Error("Failed to reload chat folder", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp, err := s.toChatFolderResponse(ctx, userID, folder)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastChatFolder(userID, folder.ID, resp)
+
+	return resp, nil
+}
+
+// broadcastChatFolder syncs a changed folder to every device of userID. A nil
+// folder announces that the folder was deleted.
+func (s *ChatService) broadcastChatFolder(userID, folderID uuid.UUID, folder *model.ChatFolderResponse) {
+	if s.wsHub == nil {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"folder_id": folderID,
+		"deleted":   folder == nil,
+	}
+	if folder != nil {
+		payload["folder"] = folder
+	}
+
+	go s.wsHub.BroadcastToUser(userID, websocket.Event{
+		Type:    websocket.EventChatFolder,
+		Payload: payload,
+		Meta: &websocket.EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			SenderID:  userID,
+		},
+	})
+}
+
+// toChatFolderResponse maps a folder loaded with its chats and counts the unread
+// messages of the chats it currently holds.
+func (s *ChatService) toChatFolderResponse(ctx context.Context, userID uuid.UUID, f *ent.ChatFolder) (*model.ChatFolderResponse, error) {
+	chats, err := s.repo.Chat.GetFolderChats(ctx, userID, f)
+	if err != nil {
+		slog.Error("Failed to get folder chats", "error", err, "folderID", f.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp := &model.ChatFolderResponse{
+		ID:         f.ID,
+		Name:       f.Name,
+		UnreadOnly: f.UnreadOnly,
+		Muted:      f.Muted,
+		Archived:   f.Archived,
+		ChatIDs:    make([]uuid.UUID, 0, len(f.Edges.Chats)),
+		CreatedAt:  f.CreatedAt.Format(time.RFC3339),
+	}
+	if f.ChatType != nil {
+		t := f.ChatType.String()
+		resp.ChatType = &t
+	}
+	for _, c := range f.Edges.Chats {
+		resp.ChatIDs = append(resp.ChatIDs, c.ChatID)
+	}
+	resp.UnreadChatCount, resp.UnreadCount, resp.UnreadMentionCount = countUnread(userID, chats)
+
+	return resp, nil
+}
+
+func chatFolderType(t *string) *chatfolder.ChatType {
+	if t == nil {
+		return nil
+	}
+	ct := chatfolder.ChatType(*t)
+	return &ct
+}
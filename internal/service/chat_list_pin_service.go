@@ -0,0 +1,241 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/pinnedchat"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const maxPinnedChats = 5
+
+// PinChat pins a chat to the top of the chat list of userID, ahead of the chats
+// pinned before it.
+func (s *ChatService) PinChat(ctx context.Context, userID, chatID uuid.UUID) (*model.PinnedChatsResponse, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	pins, err := s.lockPinnedChats(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range pins {
+		if p.ChatID == chatID {
+			return nil, helper.NewConflictError("Chat is already pinned")
+		}
+	}
+	if len(pins) >= maxPinnedChats {
+		return nil, helper.NewBadRequestError("Maximum number of pinned chats reached")
+	}
+
+	exists, err := tx.Chat.Query().
+		Where(chat.ID(chatID), participatingChat(userID)).
+		Exist(ctx)
+	if err != nil {
+		slog.Error("Failed to query chat for pin", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if !exists {
+		return nil, helper.NewNotFoundError("")
+	}
+
+	if err := tx.PinnedChat.Update().Where(pinnedchat.UserID(userID)).AddPosition(1).Exec(ctx); err != nil {
+		slog.Error("Failed to shift pinned chats", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	pin, err := tx.PinnedChat.Create().
+		SetUserID(userID).
+		SetChatID(chatID).
+		SetPosition(0).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to pin chat", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.commitPinnedChats(tx, userID, append([]*ent.PinnedChat{pin}, pins...))
+}
+
+// UnpinChat removes a chat from the pinned chats of userID.
+func (s *ChatService) UnpinChat(ctx context.Context, userID, chatID uuid.UUID) (*model.PinnedChatsResponse, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	pins, err := s.lockPinnedChats(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make([]*ent.PinnedChat, 0, len(pins))
+	for _, p := range pins {
+		if p.ChatID != chatID {
+			remaining = append(remaining, p)
+			continue
+		}
+		if err := tx.PinnedChat.DeleteOne(p).Exec(ctx); err != nil {
+			slog.Error("Failed to unpin chat", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+	}
+	if len(remaining) == len(pins) {
+		return nil, helper.NewNotFoundError("Chat is not pinned")
+	}
+
+	if err := s.savePinnedChatOrder(ctx, tx, remaining); err != nil {
+		return nil, err
+	}
+
+	return s.commitPinnedChats(tx, userID, remaining)
+}
+
+// ReorderPinnedChats sets the order of the pinned chats of userID. The request
+// must list every pinned chat exactly once.
+func (s *ChatService) ReorderPinnedChats(ctx context.Context, userID uuid.UUID, req model.ReorderPinnedChatsRequest) (*model.PinnedChatsResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, helper.NewBadRequestError("")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	pins, err := s.lockPinnedChats(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byChat := make(map[uuid.UUID]*ent.PinnedChat, len(pins))
+	for _, p := range pins {
+		byChat[p.ChatID] = p
+	}
+	if len(req.ChatIDs) != len(pins) {
+		return nil, helper.NewBadRequestError("Chat IDs must match the pinned chats")
+	}
+
+	ordered := make([]*ent.PinnedChat, 0, len(pins))
+	for _, id := range req.ChatIDs {
+		p, ok := byChat[id]
+		if !ok {
+			return nil, helper.NewBadRequestError("Chat IDs must match the pinned chats")
+		}
+		ordered = append(ordered, p)
+	}
+
+	if err := s.savePinnedChatOrder(ctx, tx, ordered); err != nil {
+		return nil, err
+	}
+
+	return s.commitPinnedChats(tx, userID, ordered)
+}
+
+// lockPinnedChats serializes pin changes of userID on the user row and returns
+// the user's pins in order. Pins of chats the user no longer takes part in are
+// dropped so they do not count towards the limit.
+func (s *ChatService) lockPinnedChats(ctx context.Context, tx *ent.Tx, userID uuid.UUID) ([]*ent.PinnedChat, error) {
+	if _, err := tx.User.Query().Where(user.ID(userID)).ForUpdate().Only(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("User not found")
+		}
+		slog.Error("Failed to lock user for pinned chats", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	_, err := tx.PinnedChat.Delete().
+		Where(
+			pinnedchat.UserID(userID),
+			pinnedchat.Not(pinnedchat.HasChatWith(participatingChat(userID))),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to drop stale pinned chats", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	pins, err := tx.PinnedChat.Query().
+		Where(pinnedchat.UserID(userID)).
+		Order(ent.Asc(pinnedchat.FieldPosition), ent.Desc(pinnedchat.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query pinned chats", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return pins, nil
+}
+
+func (s *ChatService) savePinnedChatOrder(ctx context.Context, tx *ent.Tx, pins []*ent.PinnedChat) error {
+	for i, p := range pins {
+		if p.Position == i {
+			continue
+		}
+		if err := tx.PinnedChat.UpdateOne(p).SetPosition(i).Exec(ctx); err != nil {
+			slog.Error("Failed to update pinned chat position", "error", err, "pinID", p.ID)
+			return helper.NewInternalServerError("")
+		}
+	}
+	return nil
+}
+
+// commitPinnedChats commits tx and syncs the new pin order to every device of
+// userID.
+func (s *ChatService) commitPinnedChats(tx *ent.Tx, userID uuid.UUID, pins []*ent.PinnedChat) (*model.PinnedChatsResponse, error) {
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp := &model.PinnedChatsResponse{ChatIDs: make([]uuid.UUID, 0, len(pins))}
+	for _, p := range pins {
+		resp.ChatIDs = append(resp.ChatIDs, p.ChatID)
+	}
+
+	if s.wsHub != nil {
+		go s.wsHub.BroadcastToUser(userID, websocket.Event{
+			Type:    websocket.EventChatPin,
+			Payload: resp,
+			Meta: &websocket.EventMeta{
+				Timestamp: time.Now().UTC().UnixMilli(),
+				SenderID:  userID,
+			},
+		})
+	}
+
+	return resp, nil
+}
+
+// participatingChat matches live chats userID takes part in, as a private chat
+// participant or a group member.
+func participatingChat(userID uuid.UUID) predicate.Chat {
+	return chat.And(
+		chat.DeletedAtIsNil(),
+		chat.Or(
+			chat.HasPrivateChatWith(privatechat.Or(privatechat.User1ID(userID), privatechat.User2ID(userID))),
+			chat.HasGroupChatWith(groupchat.HasMembersWith(groupmember.UserID(userID))),
+		),
+	)
+}
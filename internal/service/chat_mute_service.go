@@ -0,0 +1,133 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MuteChat silences alerts of a chat for userID, until req.MutedUntil or until
+// unmuted. Muted chats keep counting unread messages.
+func (s *ChatService) MuteChat(ctx context.Context, userID, chatID uuid.UUID, req model.MuteChatRequest) (*model.ChatListResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, helper.NewBadRequestError("")
+	}
+	if req.MutedUntil != nil && !req.MutedUntil.After(time.Now()) {
+		return nil, helper.NewBadRequestError("muted_until must be in the future")
+	}
+
+	return s.changeMute(ctx, userID, chatID, true, req.MutedUntil, req.NotifyMentions)
+}
+
+// UnmuteChat restores alerts of a chat for userID.
+func (s *ChatService) UnmuteChat(ctx context.Context, userID, chatID uuid.UUID) (*model.ChatListResponse, error) {
+	return s.changeMute(ctx, userID, chatID, false, nil, false)
+}
+
+func (s *ChatService) changeMute(ctx context.Context, userID, chatID uuid.UUID, muted bool, mutedUntil *time.Time, notifyMentions bool) (*model.ChatListResponse, error) {
+	c, err := s.client.Chat.Query().
+		Where(
+			chat.ID(chatID),
+			chat.DeletedAtIsNil(),
+		).
+		WithPrivateChat().
+		WithGroupChat().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("")
+		}
+		slog.Error("Failed to query chat", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	var until *time.Time
+	if mutedUntil != nil {
+		t := mutedUntil.UTC()
+		until = &t
+	}
+
+	switch {
+	case c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil:
+		pc := c.Edges.PrivateChat
+		update := s.client.PrivateChat.UpdateOneID(pc.ID)
+
+		if pc.User1ID != nil && *pc.User1ID == userID {
+			update.SetUser1Muted(muted).SetNillableUser1MutedUntil(until)
+			if until == nil {
+				update.ClearUser1MutedUntil()
+			}
+		} else if pc.User2ID != nil && *pc.User2ID == userID {
+			update.SetUser2Muted(muted).SetNillableUser2MutedUntil(until)
+			if until == nil {
+				update.ClearUser2MutedUntil()
+			}
+		} else {
+			return nil, helper.NewForbiddenError("")
+		}
+
+		// Mention alerts only exist in group chats.
+		notifyMentions = false
+
+		if err := update.Exec(ctx); err != nil {
+			slog.Error("Failed to update private chat mute", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+
+	case c.Type == chat.TypeGroup && c.Edges.GroupChat != nil:
+		update := s.client.GroupMember.Update().
+			Where(
+				groupmember.GroupChatID(c.Edges.GroupChat.ID),
+				groupmember.UserID(userID),
+			).
+			SetMuted(muted).
+			SetNillableMutedUntil(until).
+			SetNotifyMentions(notifyMentions)
+		if until == nil {
+			update.ClearMutedUntil()
+		}
+
+		n, err := update.Save(ctx)
+		if err != nil {
+			slog.Error("Failed to update group member mute", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if n == 0 {
+			return nil, helper.NewForbiddenError("Not a member of this group")
+		}
+
+	default:
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		payload := map[string]interface{}{
+			"chat_id":         chatID,
+			"is_muted":        muted,
+			"notify_mentions": notifyMentions,
+		}
+		if until != nil {
+			payload["muted_until"] = until.Format(time.RFC3339)
+		}
+
+		go s.wsHub.BroadcastToUser(userID, websocket.Event{
+			Type:    websocket.EventChatMute,
+			Payload: payload,
+			Meta: &websocket.EventMeta{
+				Timestamp: time.Now().UTC().UnixMilli(),
+				ChatID:    chatID,
+				SenderID:  userID,
+			},
+		})
+	}
+
+	return s.GetChatByID(ctx, userID, chatID)
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/ent/userblock"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const maxPinnedMessages = 10
+
+func (s *ChatService) PinMessage(ctx context.Context, userID, chatID uuid.UUID, req model.PinMessageRequest) (*model.ChatListResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, helper.NewBadRequestError("")
+	}
+
+	return s.changePin(ctx, userID, chatID, req.MessageID, true)
+}
+
+func (s *ChatService) UnpinMessage(ctx context.Context, userID, chatID, messageID uuid.UUID) (*model.ChatListResponse, error) {
+	return s.changePin(ctx, userID, chatID, messageID, false)
+}
+
+func (s *ChatService) changePin(ctx context.Context, userID, chatID, messageID uuid.UUID, pin bool) (*model.ChatListResponse, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	c, senderRole, hiddenAt, err := s.lockManageableChat(ctx, tx, userID, chatID, "pin messages")
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := tx.PinnedMessage.Query().
+		Where(
+			pinnedmessage.ChatID(chatID),
+			pinnedmessage.MessageID(messageID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		slog.Error("Failed to query pinned message", "error", err, "chatID", chatID, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	var systemType message.Type
+	if pin {
+		if existing != nil {
+			return nil, helper.NewConflictError("Message is already pinned")
+		}
+
+		target, err := tx.Message.Query().
+			Where(
+				message.ID(messageID),
+				message.ChatID(chatID),
+				message.DeletedAtIsNil(),
+				message.TypeIn(userMessageTypes...),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, helper.NewNotFoundError("Message not found")
+			}
+			slog.Error("Failed to query message for pin", "error", err, "messageID", messageID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if hiddenAt != nil && !target.CreatedAt.After(*hiddenAt) {
+			return nil, helper.NewNotFoundError("Message not found")
+		}
+
+		pinCount, err := tx.PinnedMessage.Query().Where(pinnedmessage.ChatID(chatID)).Count(ctx)
+		if err != nil {
+			slog.Error("Failed to count pinned messages", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if pinCount >= maxPinnedMessages {
+			return nil, helper.NewBadRequestError("Maximum number of pinned messages reached")
+		}
+
+		err = tx.PinnedMessage.Create().
+			SetChatID(chatID).
+			SetMessageID(messageID).
+			SetPinnedByID(userID).
+			Exec(ctx)
+		if err != nil {
+			slog.Error("Failed to pin message", "error", err, "messageID", messageID)
+			return nil, helper.NewInternalServerError("")
+		}
+		systemType = message.TypeSystemPin
+	} else {
+		if existing == nil {
+			return nil, helper.NewNotFoundError("Message is not pinned")
+		}
+
+		if err := tx.PinnedMessage.DeleteOne(existing).Exec(ctx); err != nil {
+			slog.Error("Failed to unpin message", "error", err, "messageID", messageID)
+			return nil, helper.NewInternalServerError("")
+		}
+		systemType = message.TypeSystemUnpin
+	}
+
+	sysMsg, err := tx.Message.Create().
+		SetChatID(chatID).
+		SetSenderID(userID).
+		SetType(systemType).
+		SetActionData(map[string]interface{}{
+			"message_id": messageID,
+			"actor_id":   userID,
+		}).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create system message", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	err = tx.Chat.UpdateOneID(chatID).
+		SetLastMessage(sysMsg).
+		SetLastMessageAt(sysMsg.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to update chat last message", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		go s.broadcastSystemChange(chatID, userID, sysMsg.ID, senderRole, privateParticipantIDs(c))
+	}
+
+	return s.GetChatByID(ctx, userID, chatID)
+}
+
+// lockManageableChat locks a chat inside tx for a change of its shared state,
+// such as pins or settings. Private chat participants may change it unless
+// either side blocked the other, group chats require an admin or owner.
+// It returns the actor's group role and, for private chats, the actor's hidden_at.
+func (s *ChatService) lockManageableChat(ctx context.Context, tx *ent.Tx, userID, chatID uuid.UUID, action string) (*ent.Chat, string, *time.Time, error) {
+	c, err := tx.Chat.Query().
+		Where(
+			chat.ID(chatID),
+			chat.DeletedAtIsNil(),
+		).
+		ForUpdate().
+		WithPrivateChat().
+		WithGroupChat(func(q *ent.GroupChatQuery) {
+			q.WithMembers(func(mq *ent.GroupMemberQuery) {
+				mq.Where(groupmember.UserID(userID))
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", nil, helper.NewNotFoundError("Chat not found or deleted")
+		}
+		slog.Error("Failed to query chat for update", "error", err, "chatID", chatID)
+		return nil, "", nil, helper.NewInternalServerError("")
+	}
+
+	var senderRole string
+	var hiddenAt *time.Time
+
+	if c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil {
+		pc := c.Edges.PrivateChat
+		var otherUserID uuid.UUID
+
+		if pc.User1ID != nil && *pc.User1ID == userID {
+			hiddenAt = pc.User1HiddenAt
+			if pc.User2ID != nil {
+				otherUserID = *pc.User2ID
+			}
+		} else if pc.User2ID != nil && *pc.User2ID == userID {
+			hiddenAt = pc.User2HiddenAt
+			if pc.User1ID != nil {
+				otherUserID = *pc.User1ID
+			}
+		} else {
+			return nil, "", nil, helper.NewForbiddenError("")
+		}
+
+		if otherUserID == uuid.Nil {
+			return nil, "", nil, helper.NewForbiddenError("User does not exist")
+		}
+
+		isBlocked, err := tx.UserBlock.Query().
+			Where(
+				userblock.Or(
+					userblock.And(userblock.BlockerID(userID), userblock.BlockedID(otherUserID)),
+					userblock.And(userblock.BlockerID(otherUserID), userblock.BlockedID(userID)),
+				),
+			).
+			Exist(ctx)
+		if err != nil {
+			slog.Error("Failed to check block status", "error", err)
+			return nil, "", nil, helper.NewInternalServerError("")
+		}
+		if isBlocked {
+			return nil, "", nil, helper.NewForbiddenError("")
+		}
+	} else if c.Type == chat.TypeGroup && c.Edges.GroupChat != nil {
+		if len(c.Edges.GroupChat.Edges.Members) == 0 {
+			return nil, "", nil, helper.NewForbiddenError("You are not a member of this group")
+		}
+		role := c.Edges.GroupChat.Edges.Members[0].Role
+		if role != groupmember.RoleOwner && role != groupmember.RoleAdmin {
+			return nil, "", nil, helper.NewForbiddenError("Only admins or owners can " + action)
+		}
+		senderRole = string(role)
+	} else {
+		return nil, "", nil, helper.NewInternalServerError("")
+	}
+
+	return c, senderRole, hiddenAt, nil
+}
+
+// privateParticipantIDs returns the remaining participants of a private chat,
+// or nil for group chats.
+func privateParticipantIDs(c *ent.Chat) []uuid.UUID {
+	pc := c.Edges.PrivateChat
+	if pc == nil {
+		return nil
+	}
+
+	participantIDs := make([]uuid.UUID, 0, 2)
+	if pc.User1ID != nil {
+		participantIDs = append(participantIDs, *pc.User1ID)
+	}
+	if pc.User2ID != nil {
+		participantIDs = append(participantIDs, *pc.User2ID)
+	}
+	return participantIDs
+}
+
+// broadcastSystemChange sends a system message as message.new to the chat and
+// the refreshed chat as chat.update to each participant. Group members are
+// looked up when participantIDs is empty.
+func (s *ChatService) broadcastSystemChange(chatID, actorID, systemMessageID uuid.UUID, senderRole string, participantIDs []uuid.UUID) {
+	ctx := context.Background()
+
+	fullMsg, err := s.client.Message.Query().
+		Where(message.ID(systemMessageID)).
+		WithSender().
+		Only(ctx)
+	if err != nil {
+		slog.Error("Failed to fetch system message for broadcast", "error", err, "messageID", systemMessageID)
+		return
+	}
+
+	s.wsHub.BroadcastToChat(chatID, websocket.Event{
+		Type:    websocket.EventMessageNew,
+		Payload: helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole),
+		Meta: &websocket.EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			ChatID:    chatID,
+			SenderID:  actorID,
+		},
+	})
+
+	if len(participantIDs) == 0 {
+		members, err := s.client.GroupMember.Query().
+			Where(groupmember.HasGroupChatWith(groupchat.ChatID(chatID))).
+			Select(groupmember.FieldUserID).
+			All(ctx)
+		if err != nil {
+			slog.Error("Failed to fetch group members for chat update broadcast", "error", err, "chatID", chatID)
+			return
+		}
+		for _, m := range members {
+			participantIDs = append(participantIDs, m.UserID)
+		}
+	}
+
+	for _, participantID := range participantIDs {
+		payload, err := s.GetChatByID(ctx, participantID, chatID)
+		if err != nil {
+			continue
+		}
+		s.wsHub.BroadcastToUser(participantID, websocket.Event{
+			Type:    websocket.EventChatUpdate,
+			Payload: payload,
+			Meta: &websocket.EventMeta{
+				Timestamp: time.Now().UTC().UnixMilli(),
+				ChatID:    chatID,
+				SenderID:  actorID,
+			},
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/ent/userblock"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MarkAsUnread flags a chat as unread for userID until the user reads it again.
+// Unread counters and read receipts are left untouched.
+func (s *ChatService) MarkAsUnread(ctx context.Context, userID, chatID uuid.UUID) (*model.ChatListResponse, error) {
+	c, err := s.client.Chat.Query().
+		Where(
+			chat.ID(chatID),
+			chat.DeletedAtIsNil(),
+		).
+		WithPrivateChat().
+		WithGroupChat().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("")
+		}
+		slog.Error("Failed to query chat", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	switch {
+	case c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil:
+		pc := c.Edges.PrivateChat
+		update := s.client.PrivateChat.UpdateOneID(pc.ID)
+
+		if pc.User1ID != nil && *pc.User1ID == userID {
+			update.SetUser1MarkedUnread(true)
+		} else if pc.User2ID != nil && *pc.User2ID == userID {
+			update.SetUser2MarkedUnread(true)
+		} else {
+			return nil, helper.NewForbiddenError("")
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			slog.Error("Failed to mark private chat as unread", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+
+	case c.Type == chat.TypeGroup && c.Edges.GroupChat != nil:
+		n, err := s.client.GroupMember.Update().
+			Where(
+				groupmember.GroupChatID(c.Edges.GroupChat.ID),
+				groupmember.UserID(userID),
+			).
+			SetMarkedUnread(true).
+			Save(ctx)
+		if err != nil {
+			slog.Error("Failed to mark group chat as unread", "error", err, "chatID", chatID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if n == 0 {
+			return nil, helper.NewForbiddenError("Not a member of this group")
+		}
+
+	default:
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		go s.wsHub.BroadcastToUser(userID, chatReadEvent(chatID, userID, nil, true))
+	}
+
+	return s.GetChatByID(ctx, userID, chatID)
+}
+
+// MarkAllAsRead reads every chat of userID with unread messages or an unread
+// flag in one transaction. Like MarkAsRead, read receipts are not moved in
+// private chats with a block in either direction.
+func (s *ChatService) MarkAllAsRead(ctx context.Context, userID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	// Locked in ID order so concurrent senders and bulk reads cannot deadlock.
+	chats, err := tx.Chat.Query().
+		Where(
+			chat.DeletedAtIsNil(),
+			chat.Or(
+				chat.HasPrivateChatWith(privatechat.Or(
+					privatechat.And(
+						privatechat.User1ID(userID),
+						privatechat.Or(privatechat.User1UnreadCountGT(0), privatechat.User1MarkedUnread(true)),
+					),
+					privatechat.And(
+						privatechat.User2ID(userID),
+						privatechat.Or(privatechat.User2UnreadCountGT(0), privatechat.User2MarkedUnread(true)),
+					),
+				)),
+				chat.HasGroupChatWith(groupchat.HasMembersWith(
+					groupmember.UserID(userID),
+					groupmember.Or(
+						groupmember.UnreadCountGT(0),
+						groupmember.UnreadMentionCountGT(0),
+						groupmember.MarkedUnread(true),
+					),
+				)),
+			),
+		).
+		Order(ent.Asc(chat.FieldID)).
+		ForUpdate().
+		WithPrivateChat().
+		WithGroupChat().
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query unread chats", "error", err, "userID", userID)
+		return helper.NewInternalServerError("")
+	}
+	if len(chats) == 0 {
+		return nil
+	}
+
+	otherUserIDs := make([]uuid.UUID, 0)
+	for _, c := range chats {
+		if pc := c.Edges.PrivateChat; pc != nil && !helper.IsSavedMessages(pc) {
+			if pc.User1ID != nil && *pc.User1ID == userID && pc.User2ID != nil {
+				otherUserIDs = append(otherUserIDs, *pc.User2ID)
+			} else if pc.User1ID != nil && *pc.User1ID != userID {
+				otherUserIDs = append(otherUserIDs, *pc.User1ID)
+			}
+		}
+	}
+
+	blocked := make(map[uuid.UUID]bool)
+	if len(otherUserIDs) > 0 {
+		blocks, err := tx.UserBlock.Query().
+			Where(
+				userblock.Or(
+					userblock.And(userblock.BlockerID(userID), userblock.BlockedIDIn(otherUserIDs...)),
+					userblock.And(userblock.BlockerIDIn(otherUserIDs...), userblock.BlockedID(userID)),
+				),
+			).
+			All(ctx)
+		if err != nil {
+			slog.Error("Failed to check block status in MarkAllAsRead", "error", err)
+			return helper.NewServiceUnavailableError("Unable to verify block status")
+		}
+		for _, b := range blocks {
+			blocked[b.BlockerID] = true
+			blocked[b.BlockedID] = true
+		}
+	}
+
+	now := time.Now().UTC()
+	blockedChats := make(map[uuid.UUID]bool)
+
+	for _, c := range chats {
+		switch {
+		case c.Edges.PrivateChat != nil:
+			pc := c.Edges.PrivateChat
+			update := tx.PrivateChat.UpdateOneID(pc.ID)
+
+			if pc.User1ID != nil && *pc.User1ID == userID {
+				isBlocked := pc.User2ID != nil && !helper.IsSavedMessages(pc) && blocked[*pc.User2ID]
+				update.SetUser1UnreadCount(0).SetUser1MarkedUnread(false)
+				if !isBlocked {
+					update.SetUser1LastReadAt(now)
+				}
+				blockedChats[c.ID] = isBlocked
+			} else {
+				isBlocked := pc.User1ID != nil && blocked[*pc.User1ID]
+				update.SetUser2UnreadCount(0).SetUser2MarkedUnread(false)
+				if !isBlocked {
+					update.SetUser2LastReadAt(now)
+				}
+				blockedChats[c.ID] = isBlocked
+			}
+
+			if err := update.Exec(ctx); err != nil {
+				slog.Error("Failed to mark private chat as read", "error", err, "chatID", c.ID)
+				return helper.NewInternalServerError("")
+			}
+
+		case c.Edges.GroupChat != nil:
+			err := tx.GroupMember.Update().
+				Where(
+					groupmember.GroupChatID(c.Edges.GroupChat.ID),
+					groupmember.UserID(userID),
+				).
+				SetUnreadCount(0).
+				SetUnreadMentionCount(0).
+				SetMarkedUnread(false).
+				SetLastReadAt(now).
+				SetNillableLastReadMessageID(c.LastMessageID).
+				Exec(ctx)
+			if err != nil {
+				slog.Error("Failed to mark group chat as read", "error", err, "chatID", c.ID)
+				return helper.NewInternalServerError("")
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		go func() {
+			for _, c := range chats {
+				event := chatReadEvent(c.ID, userID, c.LastMessageID, false)
+				// Blocked participants do not get the read receipt, only the
+				// user's own devices do.
+				if blockedChats[c.ID] {
+					s.wsHub.BroadcastToUser(userID, event)
+				} else {
+					s.wsHub.BroadcastToChat(c.ID, event)
+				}
+			}
+		}()
+	}
+
+	return nil
+}
@@ -200,7 +200,16 @@ func (s *ChatService) GetChats(ctx context.Context, userID uuid.UUID, req model.
 
 	req.Query = strings.TrimSpace(req.Query)
 
-	chats, nextCursor, hasNext, err := s.repo.Chat.GetChats(ctx, userID, req.Query, req.Cursor, req.Limit)
+	var folder *ent.ChatFolder
+	if req.FolderID != nil {
+		f, err := s.getChatFolder(ctx, userID, *req.FolderID)
+		if err != nil {
+			return nil, "", false, err
+		}
+		folder = f
+	}
+
+	chats, nextCursor, hasNext, err := s.repo.Chat.GetChats(ctx, userID, req.Query, req.Archived, folder, req.Cursor, req.Limit)
 	if err != nil {
 		slog.Error("Failed to get chats", "error", err)
 		return nil, "", false, helper.NewInternalServerError("")
@@ -391,39 +400,41 @@ func (s *ChatService) MarkAsRead(ctx context.Context, userID uuid.UUID, chatID u
 		update := tx.PrivateChat.UpdateOneID(pc.ID)
 
 		if pc.User1ID != nil && *pc.User1ID == userID {
-			if pc.User1UnreadCount == 0 {
+			if pc.User1UnreadCount == 0 && !pc.User1MarkedUnread {
 				return nil
 			}
 			if pc.User2ID != nil {
 				otherUserID = *pc.User2ID
 			}
-			update.SetUser1UnreadCount(0)
+			update.SetUser1UnreadCount(0).SetUser1MarkedUnread(false)
 		} else if pc.User2ID != nil && *pc.User2ID == userID {
-			if pc.User2UnreadCount == 0 {
+			if pc.User2UnreadCount == 0 && !pc.User2MarkedUnread {
 				return nil
 			}
 			if pc.User1ID != nil {
 				otherUserID = *pc.User1ID
 			}
-			update.SetUser2UnreadCount(0)
+			update.SetUser2UnreadCount(0).SetUser2MarkedUnread(false)
 		} else {
 			return helper.NewForbiddenError("")
 		}
 
-		blockExists, err := tx.UserBlock.Query().
-			Where(
-				userblock.Or(
-					userblock.And(userblock.BlockerID(userID), userblock.BlockedID(otherUserID)),
-					userblock.And(userblock.BlockerID(otherUserID), userblock.BlockedID(userID)),
-				),
-			).
-			Exist(ctx)
+		if !helper.IsSavedMessages(pc) {
+			blockExists, err := tx.UserBlock.Query().
+				Where(
+					userblock.Or(
+						userblock.And(userblock.BlockerID(userID), userblock.BlockedID(otherUserID)),
+						userblock.And(userblock.BlockerID(otherUserID), userblock.BlockedID(userID)),
+					),
+				).
+				Exist(ctx)
 
-		if err != nil {
-			slog.Error("Failed to check block status in MarkAsRead", "error", err)
-			return helper.NewServiceUnavailableError("Unable to verify block status")
+			if err != nil {
+				slog.Error("Failed to check block status in MarkAsRead", "error", err)
+				return helper.NewServiceUnavailableError("Unable to verify block status")
+			}
+			isBlocked = blockExists
 		}
-		isBlocked = blockExists
 
 		if !isBlocked {
 			if pc.User1ID != nil && *pc.User1ID == userID {
@@ -453,13 +464,16 @@ func (s *ChatService) MarkAsRead(ctx context.Context, userID uuid.UUID, chatID u
 			return helper.NewInternalServerError("")
 		}
 
-		if member.UnreadCount == 0 {
+		if member.UnreadCount == 0 && member.UnreadMentionCount == 0 && !member.MarkedUnread && sameMessageID(member.LastReadMessageID, c.LastMessageID) {
 			return nil
 		}
 
 		err = tx.GroupMember.UpdateOne(member).
 			SetUnreadCount(0).
+			SetUnreadMentionCount(0).
+			SetMarkedUnread(false).
 			SetLastReadAt(time.Now().UTC()).
+			SetNillableLastReadMessageID(c.LastMessageID).
 			Exec(ctx)
 		if err != nil {
 			slog.Error("Failed to mark group chat as read", "error", err)
@@ -473,23 +487,38 @@ func (s *ChatService) MarkAsRead(ctx context.Context, userID uuid.UUID, chatID u
 	}
 
 	if s.wsHub != nil && !isBlocked {
-		go s.wsHub.BroadcastToChat(chatID, websocket.Event{
-			Type: websocket.EventChatRead,
-			Payload: map[string]interface{}{
-				"chat_id": chatID,
-				"user_id": userID,
-			},
-			Meta: &websocket.EventMeta{
-				Timestamp: time.Now().UTC().UnixMilli(),
-				ChatID:    chatID,
-				SenderID:  userID,
-			},
-		})
+		go s.wsHub.BroadcastToChat(chatID, chatReadEvent(chatID, userID, c.LastMessageID, false))
 	}
 
 	return nil
 }
 
+// chatReadEvent builds the chat.read event of userID reading chatID up to
+// lastMessageID, or marking it unread.
+func chatReadEvent(chatID, userID uuid.UUID, lastMessageID *uuid.UUID, markedUnread bool) websocket.Event {
+	return websocket.Event{
+		Type: websocket.EventChatRead,
+		Payload: map[string]interface{}{
+			"chat_id":              chatID,
+			"user_id":              userID,
+			"last_read_message_id": lastMessageID,
+			"marked_unread":        markedUnread,
+		},
+		Meta: &websocket.EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			ChatID:    chatID,
+			SenderID:  userID,
+		},
+	}
+}
+
+func sameMessageID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func (s *ChatService) HideChat(ctx context.Context, userID uuid.UUID, chatID uuid.UUID) error {
 	c, err := s.client.Chat.Query().
 		Where(
@@ -516,6 +545,10 @@ func (s *ChatService) HideChat(ctx context.Context, userID uuid.UUID, chatID uui
 	}
 
 	pc := c.Edges.PrivateChat
+	if helper.IsSavedMessages(pc) && *pc.User1ID == userID {
+		return helper.NewBadRequestError("Saved Messages cannot be hidden")
+	}
+
 	update := s.client.PrivateChat.UpdateOneID(pc.ID)
 
 	if pc.User1ID != nil && *pc.User1ID == userID {
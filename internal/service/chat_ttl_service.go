@@ -0,0 +1,78 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// UpdateMessageTTL changes how long new messages in a chat live before they
+// disappear. Messages sent before the change keep their original expiry.
+func (s *ChatService) UpdateMessageTTL(ctx context.Context, userID, chatID uuid.UUID, req model.UpdateMessageTTLRequest) (*model.ChatListResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, helper.NewBadRequestError("")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	c, senderRole, _, err := s.lockManageableChat(ctx, tx, userID, chatID, "change disappearing messages")
+	if err != nil {
+		return nil, err
+	}
+
+	currentTTL := 0
+	if c.MessageTTL != nil {
+		currentTTL = *c.MessageTTL
+	}
+	if currentTTL == req.MessageTTL {
+		return s.GetChatByID(ctx, userID, chatID)
+	}
+
+	sysMsg, err := tx.Message.Create().
+		SetChatID(chatID).
+		SetSenderID(userID).
+		SetType(message.TypeSystemTTL).
+		SetActionData(map[string]interface{}{
+			"message_ttl": req.MessageTTL,
+			"actor_id":    userID,
+		}).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create system message", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	update := tx.Chat.UpdateOneID(chatID).
+		SetLastMessage(sysMsg).
+		SetLastMessageAt(sysMsg.CreatedAt)
+	if req.MessageTTL > 0 {
+		update.SetMessageTTL(req.MessageTTL)
+	} else {
+		update.ClearMessageTTL()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		slog.Error("Failed to update chat message ttl", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		go s.broadcastSystemChange(chatID, userID, sysMsg.ID, senderRole, privateParticipantIDs(c))
+	}
+
+	return s.GetChatByID(ctx, userID, chatID)
+}
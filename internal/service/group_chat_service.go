@@ -54,17 +54,36 @@ func (s *GroupChatService) buildGroupChatListResponse(ctx context.Context, gc *e
 		slog.Error("Failed to count group members", "error", err, "groupID", gc.ID)
 	}
 
+	pins, err := s.repo.Chat.GetPinnedMessages(ctx, gc.ChatID)
+	if err != nil {
+		slog.Error("Failed to get pinned messages", "error", err, "chatID", gc.ChatID)
+	}
+
+	var messageTTL *int
+	if c, err := s.client.Chat.Query().Where(chat.ID(gc.ChatID)).Select(chat.FieldMessageTTL).Only(ctx); err != nil {
+		slog.Error("Failed to get chat message ttl", "error", err, "chatID", gc.ChatID)
+	} else {
+		messageTTL = c.MessageTTL
+	}
+
 	resp := model.ChatListResponse{
-		ID:              gc.ChatID,
-		Type:            string(chat.TypeGroup),
-		Name:            gc.Name,
-		Description:     gc.Description,
-		IsPublic:        &gc.IsPublic,
-		Avatar:          avatarURL,
-		LastMessage:     lastMessage,
-		UnreadCount:     0,
-		MemberCount:     memberCount,
-		InviteExpiresAt: inviteExpiresAt,
+		ID:               gc.ChatID,
+		Type:             string(chat.TypeGroup),
+		Name:             gc.Name,
+		Description:      gc.Description,
+		IsPublic:         &gc.IsPublic,
+		RequiresApproval: &gc.RequiresApproval,
+		Avatar:           avatarURL,
+		LastMessage:      lastMessage,
+		UnreadCount:      0,
+		MemberCount:      memberCount,
+		InviteExpiresAt:  inviteExpiresAt,
+		PinnedMessages:   helper.MapPinnedMessages(pins, nil),
+		MessageTTL:       messageTTL,
+	}
+
+	if gc.SlowModeSeconds > 0 {
+		resp.SlowModeSeconds = &gc.SlowModeSeconds
 	}
 
 	if role != nil {
@@ -88,6 +107,7 @@ func (s *GroupChatService) getGroupLastMessageResponse(ctx context.Context, chat
 		WithSender().
 		WithAttachments().
 		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
 			q.WithSender()
 		}).
 		First(ctx)
@@ -461,6 +481,32 @@ func (s *GroupChatService) UpdateGroupChat(ctx context.Context, requestorID uuid
 		hasChanges = true
 	}
 
+	if req.SlowModeSeconds != nil && *req.SlowModeSeconds != gc.SlowModeSeconds {
+		update.SetSlowModeSeconds(*req.SlowModeSeconds)
+		systemMessages = append(systemMessages, tx.Message.Create().
+			SetChatID(gc.ChatID).
+			SetSenderID(requestorID).
+			SetType(message.TypeSystemSlowMode).
+			SetActionData(map[string]interface{}{
+				"slow_mode_seconds": *req.SlowModeSeconds,
+				"actor_id":          requestorID,
+			}))
+		hasChanges = true
+	}
+
+	if req.RequiresApproval != nil && *req.RequiresApproval != gc.RequiresApproval {
+		update.SetRequiresApproval(*req.RequiresApproval)
+		systemMessages = append(systemMessages, tx.Message.Create().
+			SetChatID(gc.ChatID).
+			SetSenderID(requestorID).
+			SetType(message.TypeSystemJoinApproval).
+			SetActionData(map[string]interface{}{
+				"requires_approval": *req.RequiresApproval,
+				"actor_id":          requestorID,
+			}))
+		hasChanges = true
+	}
+
 	var avatarMedia *ent.Media
 
 	if req.DeleteAvatar && gc.Edges.Avatar != nil {
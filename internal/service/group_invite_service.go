@@ -18,11 +18,13 @@ import (
 	"github.com/google/uuid"
 )
 
-func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UUID, inviteCode string) (*model.ChatListResponse, error) {
+// JoinGroupByInvite adds userID to the group of inviteCode. When the group
+// requires approval, a pending join request is returned instead.
+func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UUID, inviteCode string) (*model.ChatListResponse, *model.GroupJoinRequestDTO, error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		slog.Error("Failed to start transaction", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 	defer tx.Rollback()
 
@@ -31,20 +33,20 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 			groupchat.InviteCode(inviteCode),
 			groupchat.HasChatWith(chat.DeletedAtIsNil()),
 		).
-		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldInviteExpiresAt, groupchat.FieldName, groupchat.FieldAvatarID, groupchat.FieldIsPublic, groupchat.FieldInviteCode).
+		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldInviteExpiresAt, groupchat.FieldName, groupchat.FieldAvatarID, groupchat.FieldIsPublic, groupchat.FieldInviteCode, groupchat.FieldRequiresApproval).
 		WithAvatar().
 		WithChat().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, helper.NewNotFoundError("Invalid invite code")
+			return nil, nil, helper.NewNotFoundError("Invalid invite code")
 		}
 		slog.Error("Failed to query group chat by invite code", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	if gc.InviteExpiresAt != nil && time.Now().UTC().After(*gc.InviteExpiresAt) {
-		return nil, helper.NewBadRequestError("Invite code has expired")
+		return nil, nil, helper.NewBadRequestError("Invite code has expired")
 	}
 
 	isMember, err := tx.GroupMember.Query().
@@ -55,10 +57,15 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 		Exist(ctx)
 	if err != nil {
 		slog.Error("Failed to check membership", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 	if isMember {
-		return nil, helper.NewConflictError("You are already a member of this group")
+		return nil, nil, helper.NewConflictError("You are already a member of this group")
+	}
+
+	if gc.RequiresApproval {
+		joinRequest, err := s.requestToJoin(ctx, tx, gc, userID)
+		return nil, joinRequest, err
 	}
 
 	_, err = tx.GroupMember.Create().
@@ -68,7 +75,7 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 		Save(ctx)
 	if err != nil {
 		slog.Error("Failed to add member via invite", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	systemMsg, err := tx.Message.Create().
@@ -78,7 +85,7 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 		Save(ctx)
 	if err != nil {
 		slog.Error("Failed to create system message for invite join", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	err = tx.Chat.UpdateOne(gc.Edges.Chat).
@@ -87,12 +94,12 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 		Exec(ctx)
 	if err != nil {
 		slog.Error("Failed to update chat with last message", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	if err := tx.Commit(); err != nil {
 		slog.Error("Failed to commit transaction", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	s.redisAdapter.Del(context.Background(), fmt.Sprintf("chat_members:%s", gc.ChatID))
@@ -127,14 +134,15 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 	}
 
 	chatListResponse := &model.ChatListResponse{
-		ID:          gc.Edges.Chat.ID,
-		Type:        string(chat.TypeGroup),
-		Name:        gc.Name,
-		Avatar:      avatarURL,
-		LastMessage: msgResponse,
-		UnreadCount: 0,
-		MemberCount: memberCount,
-		IsPublic:    &gc.IsPublic,
+		ID:               gc.Edges.Chat.ID,
+		Type:             string(chat.TypeGroup),
+		Name:             gc.Name,
+		Avatar:           avatarURL,
+		LastMessage:      msgResponse,
+		UnreadCount:      0,
+		MemberCount:      memberCount,
+		IsPublic:         &gc.IsPublic,
+		RequiresApproval: &gc.RequiresApproval,
 	}
 	myRole := string(groupmember.RoleMember)
 	chatListResponse.MyRole = &myRole
@@ -169,7 +177,7 @@ func (s *GroupChatService) JoinGroupByInvite(ctx context.Context, userID uuid.UU
 		}()
 	}
 
-	return chatListResponse, nil
+	return chatListResponse, nil, nil
 }
 
 func (s *GroupChatService) GetGroupByInviteCode(ctx context.Context, inviteCode string) (*model.GroupPreviewDTO, error) {
@@ -178,7 +186,7 @@ func (s *GroupChatService) GetGroupByInviteCode(ctx context.Context, inviteCode
 			groupchat.InviteCode(inviteCode),
 			groupchat.HasChatWith(chat.DeletedAtIsNil()),
 		).
-		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldAvatarID, groupchat.FieldInviteExpiresAt, groupchat.FieldIsPublic).
+		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldDescription, groupchat.FieldAvatarID, groupchat.FieldInviteExpiresAt, groupchat.FieldIsPublic, groupchat.FieldRequiresApproval).
 		WithAvatar().
 		Only(ctx)
 	if err != nil {
@@ -213,12 +221,13 @@ func (s *GroupChatService) GetGroupByInviteCode(ctx context.Context, inviteCode
 	}
 
 	return &model.GroupPreviewDTO{
-		ID:          gc.ChatID,
-		Name:        gc.Name,
-		Description: description,
-		Avatar:      avatarURL,
-		MemberCount: memberCount,
-		IsPublic:    gc.IsPublic,
+		ID:               gc.ChatID,
+		Name:             gc.Name,
+		Description:      description,
+		Avatar:           avatarURL,
+		MemberCount:      memberCount,
+		IsPublic:         gc.IsPublic,
+		RequiresApproval: gc.RequiresApproval,
 	}, nil
 }
 
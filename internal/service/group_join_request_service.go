@@ -0,0 +1,432 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GetJoinRequests lists the pending join requests of a group for its admins and
+// owner, oldest first.
+func (s *GroupChatService) GetJoinRequests(ctx context.Context, userID uuid.UUID, req model.GetGroupJoinRequestsRequest) ([]model.GroupJoinRequestDTO, string, bool, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, "", false, helper.NewBadRequestError("")
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	gc, err := s.getAdministeredGroup(ctx, s.client, userID, req.GroupID)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	requests, nextCursor, hasNext, err := s.repo.GroupMember.GetPendingJoinRequests(ctx, gc.ID, req.Cursor, req.Limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid cursor") {
+			slog.Warn("Invalid cursor format in GetJoinRequests", "error", err)
+			return nil, "", false, helper.NewBadRequestError("")
+		}
+		slog.Error("Failed to get join requests", "error", err, "groupID", gc.ID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	dtos := make([]model.GroupJoinRequestDTO, 0, len(requests))
+	for _, r := range requests {
+		dtos = append(dtos, helper.ToGroupJoinRequestDTO(r, gc.ChatID, s.storageAdapter))
+	}
+
+	return dtos, nextCursor, hasNext, nil
+}
+
+// ApproveJoinRequest adds the user behind a pending join request to the group,
+// announcing the join like an invite join.
+func (s *GroupChatService) ApproveJoinRequest(ctx context.Context, requestorID, groupID, targetUserID uuid.UUID) (*model.GroupJoinRequestDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	gc, err := s.getAdministeredGroup(ctx, tx.Client(), requestorID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest, err := s.getPendingJoinRequest(ctx, tx, gc.ID, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetUser, err := tx.User.Query().
+		Where(
+			user.ID(targetUserID),
+			user.DeletedAtIsNil(),
+		).
+		Select(user.FieldID, user.FieldIsBanned, user.FieldBannedUntil).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("User not found")
+		}
+		slog.Error("Failed to query join request user", "error", err, "userID", targetUserID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if targetUser.IsBanned {
+		if targetUser.BannedUntil == nil || time.Now().UTC().Before(*targetUser.BannedUntil) {
+			return nil, helper.NewForbiddenError("Cannot add suspended/banned user to group")
+		}
+	}
+
+	isMember, err := tx.GroupMember.Query().
+		Where(
+			groupmember.GroupChatID(gc.ID),
+			groupmember.UserID(targetUserID),
+		).
+		Exist(ctx)
+	if err != nil {
+		slog.Error("Failed to check membership", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	if isMember {
+		return nil, helper.NewConflictError("User is already a member of this group")
+	}
+
+	_, err = tx.GroupMember.Create().
+		SetGroupChat(gc).
+		SetUserID(targetUserID).
+		SetRole(groupmember.RoleMember).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to add member from join request", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	systemMsg, err := tx.Message.Create().
+		SetChatID(gc.ChatID).
+		SetSenderID(targetUserID).
+		SetType(message.TypeSystemJoin).
+		SetActionData(map[string]interface{}{
+			"actor_id": requestorID,
+		}).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create system message for approved join", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	err = tx.Chat.UpdateOne(gc.Edges.Chat).
+		SetLastMessage(systemMsg).
+		SetLastMessageAt(systemMsg.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to update chat with last message", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := s.reviewJoinRequest(ctx, tx, joinRequest, groupjoinrequest.StatusApproved, requestorID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	s.redisAdapter.Del(context.Background(), fmt.Sprintf("chat_members:%s", gc.ChatID))
+
+	var msgResponse *model.MessageResponse
+	fullMsg, err := s.client.Message.Query().
+		Where(message.ID(systemMsg.ID)).
+		WithSender().
+		Only(ctx)
+	if err == nil {
+		msgResponse = helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, string(groupmember.RoleMember))
+	}
+
+	resp, err := s.loadJoinRequest(ctx, joinRequest.ID, gc.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.wsHub != nil {
+		go func() {
+			memberRole := groupmember.RoleMember
+			chatPayload := s.buildGroupChatListResponse(context.Background(), gc, &memberRole, msgResponse)
+			if msgResponse != nil {
+				msgResponse.MemberCount = &chatPayload.MemberCount
+
+				s.wsHub.BroadcastToChat(gc.ChatID, websocket.Event{
+					Type:    websocket.EventMessageNew,
+					Payload: msgResponse,
+					Meta: &websocket.EventMeta{
+						Timestamp: time.Now().UTC().UnixMilli(),
+						ChatID:    gc.ChatID,
+						SenderID:  targetUserID,
+					},
+				})
+			}
+
+			s.wsHub.BroadcastToUser(targetUserID, websocket.Event{
+				Type:    websocket.EventChatNew,
+				Payload: chatPayload,
+				Meta: &websocket.EventMeta{
+					Timestamp: time.Now().UTC().UnixMilli(),
+					ChatID:    gc.ChatID,
+					SenderID:  requestorID,
+				},
+			})
+		}()
+	}
+
+	s.broadcastJoinRequest(gc, resp, requestorID)
+
+	return resp, nil
+}
+
+// DeclineJoinRequest turns down a pending join request. The user can ask again
+// later.
+func (s *GroupChatService) DeclineJoinRequest(ctx context.Context, requestorID, groupID, targetUserID uuid.UUID) (*model.GroupJoinRequestDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	gc, err := s.getAdministeredGroup(ctx, tx.Client(), requestorID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest, err := s.getPendingJoinRequest(ctx, tx, gc.ID, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewJoinRequest(ctx, tx, joinRequest, groupjoinrequest.StatusDeclined, requestorID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp, err := s.loadJoinRequest(ctx, joinRequest.ID, gc.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastJoinRequest(gc, resp, requestorID)
+
+	return resp, nil
+}
+
+// requestToJoin files a pending join request of userID for gc and commits tx.
+// Asking again while a request is pending returns that request unchanged.
+func (s *GroupChatService) requestToJoin(ctx context.Context, tx *ent.Tx, gc *ent.GroupChat, userID uuid.UUID) (*model.GroupJoinRequestDTO, error) {
+	existing, err := tx.GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.GroupChatID(gc.ID),
+			groupjoinrequest.UserID(userID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		slog.Error("Failed to query join request", "error", err, "groupID", gc.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if existing != nil && existing.Status == groupjoinrequest.StatusPending {
+		return s.loadJoinRequest(ctx, existing.ID, gc.ChatID)
+	}
+
+	var requestID uuid.UUID
+	if existing != nil {
+		err = tx.GroupJoinRequest.UpdateOne(existing).
+			SetStatus(groupjoinrequest.StatusPending).
+			SetRequestedAt(time.Now().UTC()).
+			ClearReviewedBy().
+			ClearReviewedAt().
+			Exec(ctx)
+		requestID = existing.ID
+	} else {
+		var created *ent.GroupJoinRequest
+		created, err = tx.GroupJoinRequest.Create().
+			SetGroupChatID(gc.ID).
+			SetUserID(userID).
+			Save(ctx)
+		if created != nil {
+			requestID = created.ID
+		}
+	}
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, helper.NewConflictError("Join request already pending")
+		}
+		slog.Error("Failed to save join request", "error", err, "groupID", gc.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp, err := s.loadJoinRequest(ctx, requestID, gc.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastJoinRequest(gc, resp, userID)
+
+	return resp, nil
+}
+
+// getAdministeredGroup loads the group of chat groupID with its chat and avatar
+// and checks that userID is one of its admins or its owner.
+func (s *GroupChatService) getAdministeredGroup(ctx context.Context, client *ent.Client, userID, groupID uuid.UUID) (*ent.GroupChat, error) {
+	gc, err := client.GroupChat.Query().
+		Where(
+			groupchat.ChatID(groupID),
+			groupchat.HasChatWith(chat.DeletedAtIsNil()),
+		).
+		WithAvatar().
+		WithChat().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Group chat not found")
+		}
+		slog.Error("Failed to query group chat", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	member, err := client.GroupMember.Query().
+		Where(
+			groupmember.GroupChatID(gc.ID),
+			groupmember.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewForbiddenError("You are not a member of this group")
+		}
+		slog.Error("Failed to query requestor membership", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if member.Role != groupmember.RoleOwner && member.Role != groupmember.RoleAdmin {
+		return nil, helper.NewForbiddenError("Only admins or owners can manage join requests")
+	}
+
+	return gc, nil
+}
+
+func (s *GroupChatService) getPendingJoinRequest(ctx context.Context, tx *ent.Tx, groupChatID, userID uuid.UUID) (*ent.GroupJoinRequest, error) {
+	joinRequest, err := tx.GroupJoinRequest.Query().
+		Where(
+			groupjoinrequest.GroupChatID(groupChatID),
+			groupjoinrequest.UserID(userID),
+			groupjoinrequest.StatusEQ(groupjoinrequest.StatusPending),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Join request not found")
+		}
+		slog.Error("Failed to query join request", "error", err, "groupID", groupChatID)
+		return nil, helper.NewInternalServerError("")
+	}
+	return joinRequest, nil
+}
+
+func (s *GroupChatService) reviewJoinRequest(ctx context.Context, tx *ent.Tx, joinRequest *ent.GroupJoinRequest, status groupjoinrequest.Status, reviewerID uuid.UUID) error {
+	err := tx.GroupJoinRequest.UpdateOne(joinRequest).
+		SetStatus(status).
+		SetReviewedBy(reviewerID).
+		SetReviewedAt(time.Now().UTC()).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to review join request", "error", err, "requestID", joinRequest.ID)
+		return helper.NewInternalServerError("")
+	}
+	return nil
+}
+
+func (s *GroupChatService) loadJoinRequest(ctx context.Context, requestID, chatID uuid.UUID) (*model.GroupJoinRequestDTO, error) {
+	joinRequest, err := s.client.GroupJoinRequest.Query().
+		Where(groupjoinrequest.ID(requestID)).
+		WithUser(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		}).
+		Only(ctx)
+	if err != nil {
+		slog.Error("Failed to load join request", "error", err, "requestID", requestID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp := helper.ToGroupJoinRequestDTO(joinRequest, chatID, s.storageAdapter)
+	return &resp, nil
+}
+
+// broadcastJoinRequest sends a join request to the admins and owner of gc. Once
+// the request is decided, the requester gets the decision as well.
+func (s *GroupChatService) broadcastJoinRequest(gc *ent.GroupChat, joinRequest *model.GroupJoinRequestDTO, senderID uuid.UUID) {
+	if s.wsHub == nil {
+		return
+	}
+
+	go func() {
+		admins, err := s.client.GroupMember.Query().
+			Where(
+				groupmember.GroupChatID(gc.ID),
+				groupmember.RoleIn(groupmember.RoleOwner, groupmember.RoleAdmin),
+			).
+			Select(groupmember.FieldUserID).
+			All(context.Background())
+		if err != nil {
+			slog.Error("Failed to fetch join request recipients", "error", err, "groupID", gc.ID)
+			return
+		}
+
+		recipients := make([]uuid.UUID, 0, len(admins)+1)
+		for _, a := range admins {
+			recipients = append(recipients, a.UserID)
+		}
+		if joinRequest.Status != string(groupjoinrequest.StatusPending) {
+			recipients = append(recipients, joinRequest.UserID)
+		}
+
+		event := websocket.Event{
+			Type:    websocket.EventChatJoinRequest,
+			Payload: joinRequest,
+			Meta: &websocket.EventMeta{
+				Timestamp: time.Now().UTC().UnixMilli(),
+				ChatID:    gc.ChatID,
+				SenderID:  senderID,
+			},
+		}
+		for _, id := range recipients {
+			s.wsHub.BroadcastToUser(id, event)
+		}
+	}()
+}
@@ -97,24 +97,27 @@ func (s *GroupChatService) SearchPublicGroups(ctx context.Context, userID uuid.U
 		}
 
 		groupDTOs = append(groupDTOs, model.PublicGroupDTO{
-			ID:          g.ID,
-			ChatID:      g.ChatID,
-			Name:        g.Name,
-			Description: description,
-			Avatar:      avatarURL,
-			MemberCount: memberCounts[g.ID],
-			IsMember:    joinedGroups[g.ID],
+			ID:               g.ID,
+			ChatID:           g.ChatID,
+			Name:             g.Name,
+			Description:      description,
+			Avatar:           avatarURL,
+			MemberCount:      memberCounts[g.ID],
+			IsMember:         joinedGroups[g.ID],
+			RequiresApproval: g.RequiresApproval,
 		})
 	}
 
 	return groupDTOs, nextCursor, hasNext, nil
 }
 
-func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID, groupID uuid.UUID) (*model.ChatListResponse, error) {
+// JoinPublicGroup adds userID to a public group. When the group requires
+// approval, a pending join request is returned instead.
+func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID, groupID uuid.UUID) (*model.ChatListResponse, *model.GroupJoinRequestDTO, error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		slog.Error("Failed to start transaction", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 	defer tx.Rollback()
 
@@ -123,20 +126,20 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 			groupchat.ChatID(groupID),
 			groupchat.HasChatWith(chat.DeletedAtIsNil()),
 		).
-		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldIsPublic, groupchat.FieldAvatarID, groupchat.FieldInviteCode).
+		Select(groupchat.FieldID, groupchat.FieldChatID, groupchat.FieldName, groupchat.FieldIsPublic, groupchat.FieldAvatarID, groupchat.FieldInviteCode, groupchat.FieldRequiresApproval).
 		WithAvatar().
 		WithChat().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, helper.NewNotFoundError("Group chat not found")
+			return nil, nil, helper.NewNotFoundError("Group chat not found")
 		}
 		slog.Error("Failed to query group chat", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	if !gc.IsPublic {
-		return nil, helper.NewForbiddenError("This group is private. You must be added by an admin.")
+		return nil, nil, helper.NewForbiddenError("This group is private. You must be added by an admin.")
 	}
 
 	isMember, err := tx.GroupMember.Query().
@@ -147,10 +150,15 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 		Exist(ctx)
 	if err != nil {
 		slog.Error("Failed to check membership", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 	if isMember {
-		return nil, helper.NewConflictError("You are already a member of this group")
+		return nil, nil, helper.NewConflictError("You are already a member of this group")
+	}
+
+	if gc.RequiresApproval {
+		joinRequest, err := s.requestToJoin(ctx, tx, gc, userID)
+		return nil, joinRequest, err
 	}
 
 	_, err = tx.GroupMember.Create().
@@ -160,7 +168,7 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 		Save(ctx)
 	if err != nil {
 		slog.Error("Failed to add member", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	systemMsg, err := tx.Message.Create().
@@ -170,7 +178,7 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 		Save(ctx)
 	if err != nil {
 		slog.Error("Failed to create system message for join", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	err = tx.Chat.UpdateOne(gc.Edges.Chat).
@@ -179,12 +187,12 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 		Exec(ctx)
 	if err != nil {
 		slog.Error("Failed to update chat with last message", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	if err := tx.Commit(); err != nil {
 		slog.Error("Failed to commit transaction", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return nil, nil, helper.NewInternalServerError("")
 	}
 
 	s.redisAdapter.Del(context.Background(), fmt.Sprintf("chat_members:%s", groupID))
@@ -219,15 +227,16 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 	}
 
 	chatResponse := &model.ChatListResponse{
-		ID:          gc.Edges.Chat.ID,
-		Type:        string(chat.TypeGroup),
-		Name:        gc.Name,
-		Avatar:      avatarURL,
-		LastMessage: msgResponse,
-		UnreadCount: 0,
-		MemberCount: memberCount,
-		IsPublic:    &gc.IsPublic,
-		InviteCode:  &gc.InviteCode,
+		ID:               gc.Edges.Chat.ID,
+		Type:             string(chat.TypeGroup),
+		Name:             gc.Name,
+		Avatar:           avatarURL,
+		LastMessage:      msgResponse,
+		UnreadCount:      0,
+		MemberCount:      memberCount,
+		IsPublic:         &gc.IsPublic,
+		RequiresApproval: &gc.RequiresApproval,
+		InviteCode:       &gc.InviteCode,
 	}
 	myRole := string(groupmember.RoleMember)
 	chatResponse.MyRole = &myRole
@@ -256,5 +265,5 @@ func (s *GroupChatService) JoinPublicGroup(ctx context.Context, userID uuid.UUID
 		}()
 	}
 
-	return chatResponse, nil
+	return chatResponse, nil, nil
 }
@@ -13,6 +13,8 @@ import (
 	"AtoiTalkAPI/internal/helper"
 	"AtoiTalkAPI/internal/model"
 	"context"
+	"errors"
+	"io"
 	"log/slog"
 	"path/filepath"
 	"strings"
@@ -22,7 +24,10 @@ import (
 	"github.com/google/uuid"
 )
 
-const presignedUploadExpiry = 15 * time.Minute
+const (
+	presignedUploadExpiry = 15 * time.Minute
+	maxVoiceNoteSize      = 10 * 1024 * 1024
+)
 
 var avatarMIMETypes = map[string]bool{
 	"image/jpeg": true,
@@ -30,6 +35,14 @@ var avatarMIMETypes = map[string]bool{
 	"image/webp": true,
 }
 
+var voiceMIMETypes = map[string]bool{
+	"audio/ogg":   true,
+	"audio/opus":  true,
+	"audio/mp4":   true,
+	"audio/m4a":   true,
+	"audio/x-m4a": true,
+}
+
 type MediaService struct {
 	client         *ent.Client
 	cfg            *config.AppConfig
@@ -153,8 +166,17 @@ func (s *MediaService) CompleteUpload(ctx context.Context, userID, mediaID uuid.
 		return nil, helper.NewBadRequestError("Uploaded object content type mismatch")
 	}
 
+	update := s.client.Media.UpdateOneID(mediaID)
+	if m.Category == media.CategoryVoice {
+		info, err := s.readVoiceNote(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		update.SetDurationMs(info.DurationMs).SetWaveform(info.Waveform)
+	}
+
 	now := time.Now().UTC()
-	updated, err := s.client.Media.UpdateOneID(mediaID).
+	updated, err := update.
 		SetUploadStatus(media.UploadStatusCompleted).
 		SetCompletedAt(now).
 		ClearUploadExpiresAt().
@@ -178,6 +200,34 @@ func (s *MediaService) CompleteUpload(ctx context.Context, userID, mediaID uuid.
 	return toMediaDTO(updated, url), nil
 }
 
+func (s *MediaService) readVoiceNote(ctx context.Context, m *ent.Media) (*helper.VoiceNoteInfo, error) {
+	reader, err := s.storageAdapter.Open(ctx, m.FileName, false)
+	if err != nil {
+		slog.Error("Failed to open uploaded voice note", "error", err, "mediaID", m.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxVoiceNoteSize+1))
+	if err != nil {
+		slog.Error("Failed to read uploaded voice note", "error", err, "mediaID", m.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if len(data) > maxVoiceNoteSize {
+		return nil, helper.NewBadRequestError("Uploaded object size mismatch")
+	}
+
+	info, err := helper.ParseVoiceNote(data)
+	if err != nil {
+		if errors.Is(err, helper.ErrInvalidAudio) {
+			slog.Warn("Rejected voice note with unreadable audio", "mediaID", m.ID, "mimeType", m.MimeType)
+			return nil, helper.NewBadRequestError("Voice note must be Ogg/Opus or M4A audio")
+		}
+		return nil, helper.NewInternalServerError("")
+	}
+	return info, nil
+}
+
 func (s *MediaService) GetMediaURL(ctx context.Context, userID, mediaID uuid.UUID) (*model.MediaURLResponse, error) {
 	m, err := s.client.Media.Query().
 		Where(media.ID(mediaID)).
@@ -257,6 +307,8 @@ func isAllowedUpload(category media.Category, mimeType string, fileSize int64) b
 		return fileSize <= 20*1024*1024
 	case media.CategoryUserAvatar, media.CategoryGroupAvatar:
 		return fileSize <= 2*1024*1024 && avatarMIMETypes[mimeType]
+	case media.CategoryVoice:
+		return fileSize <= maxVoiceNoteSize && voiceMIMETypes[mimeType]
 	default:
 		return false
 	}
@@ -278,5 +330,7 @@ func toMediaDTO(m *ent.Media, url string) *model.MediaDTO {
 		Category:     string(m.Category),
 		UploadStatus: string(m.UploadStatus),
 		URL:          url,
+		DurationMs:   m.DurationMs,
+		Waveform:     m.Waveform,
 	}
 }
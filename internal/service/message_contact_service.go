@@ -0,0 +1,100 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/ent/userblock"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SendContact shares the profile of another AtoiTalk user. Users who blocked
+// the sender, or were blocked by them, cannot be shared.
+func (s *MessageService) SendContact(ctx context.Context, userID uuid.UUID, req model.SendContactRequest) (*model.MessageResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	contact, err := s.client.User.Query().
+		Where(
+			user.ID(req.UserID),
+			user.DeletedAtIsNil(),
+		).
+		Select(user.FieldID, user.FieldIsBanned, user.FieldBannedUntil).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("User not found")
+		}
+		slog.Error("Failed to query contact user", "error", err, "contactID", req.UserID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if contact.IsBanned && (contact.BannedUntil == nil || time.Now().UTC().Before(*contact.BannedUntil)) {
+		return nil, helper.NewForbiddenError("User is currently suspended/banned")
+	}
+
+	if contact.ID != userID {
+		isBlocked, err := s.client.UserBlock.Query().
+			Where(
+				userblock.Or(
+					userblock.And(
+						userblock.BlockerID(userID),
+						userblock.BlockedID(contact.ID),
+					),
+					userblock.And(
+						userblock.BlockerID(contact.ID),
+						userblock.BlockedID(userID),
+					),
+				),
+			).
+			Exist(ctx)
+		if err != nil {
+			slog.Error("Failed to check block status", "error", err)
+			return nil, helper.NewInternalServerError("")
+		}
+		if isBlocked {
+			return nil, helper.NewForbiddenError("Cannot share a blocked user")
+		}
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	msg, senderRole, threadRoot, err := s.createMessage(ctx, tx, userID, model.SendMessageRequest{
+		ChatID:    req.ChatID,
+		ReplyToID: req.ReplyToID,
+	}, message.TypeContact)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Message.UpdateOneID(msg.ID).SetContactUserID(contact.ID).Exec(ctx); err != nil {
+		slog.Error("Failed to save contact", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.publishNewMessage(ctx, msg, userID, senderRole, threadRoot)
+}
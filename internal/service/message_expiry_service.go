@@ -0,0 +1,232 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PurgeExpiredMessages hard-deletes the given messages once their expires_at has
+// passed, together with their attachments, and tells chat members through
+// message.delete events. Messages that are not expired yet are skipped.
+func (s *MessageService) PurgeExpiredMessages(ctx context.Context, messageIDs []uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	expired, err := tx.Message.Query().
+		Where(
+			message.IDIn(messageIDs...),
+			message.ExpiresAtLTE(time.Now().UTC()),
+		).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		WithAttachments().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	expiredIDs := make([]uuid.UUID, 0, len(expired))
+	isExpired := make(map[uuid.UUID]bool, len(expired))
+	chatIDs := make([]uuid.UUID, 0)
+	seenChats := make(map[uuid.UUID]bool)
+	rootIDs := make([]uuid.UUID, 0)
+	seenRoots := make(map[uuid.UUID]bool)
+	attachments := make([]*ent.Media, 0)
+
+	for _, m := range expired {
+		expiredIDs = append(expiredIDs, m.ID)
+		isExpired[m.ID] = true
+		if !seenChats[m.ChatID] {
+			chatIDs = append(chatIDs, m.ChatID)
+			seenChats[m.ChatID] = true
+		}
+		if m.ReplyToID != nil && !seenRoots[*m.ReplyToID] {
+			rootIDs = append(rootIDs, *m.ReplyToID)
+			seenRoots[*m.ReplyToID] = true
+		}
+		attachments = append(attachments, m.Edges.Attachments...)
+	}
+
+	// Reported attachments are kept as evidence, they are only unlinked.
+	removedAttachments := make([]*ent.Media, 0, len(attachments))
+	if len(attachments) > 0 {
+		attachmentIDs := make([]uuid.UUID, 0, len(attachments))
+		for _, att := range attachments {
+			attachmentIDs = append(attachmentIDs, att.ID)
+		}
+
+		reportedIDs, err := tx.Media.Query().
+			Where(
+				media.IDIn(attachmentIDs...),
+				media.HasReports(),
+			).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		isReported := make(map[uuid.UUID]bool, len(reportedIDs))
+		for _, id := range reportedIDs {
+			isReported[id] = true
+		}
+
+		for _, att := range attachments {
+			if !isReported[att.ID] {
+				removedAttachments = append(removedAttachments, att)
+			}
+		}
+
+		if _, err := tx.Media.Delete().
+			Where(
+				media.IDIn(attachmentIDs...),
+				media.Not(media.HasReports()),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Like a deleted message, an expired one no longer counts as an unread mention.
+	// The mention rows themselves go with the message.
+	mentionedIDs, err := tx.Message.Query().
+		Where(
+			message.IDIn(expiredIDs...),
+			message.HasMentions(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(mentionedIDs) > 0 {
+		groupChats, err := tx.GroupChat.Query().
+			Where(groupchat.ChatIDIn(chatIDs...)).
+			Select(groupchat.FieldID, groupchat.FieldChatID).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		groupChatIDs := make(map[uuid.UUID]uuid.UUID, len(groupChats))
+		for _, gc := range groupChats {
+			groupChatIDs[gc.ChatID] = gc.ID
+		}
+
+		isMentioned := make(map[uuid.UUID]bool, len(mentionedIDs))
+		for _, id := range mentionedIDs {
+			isMentioned[id] = true
+		}
+
+		for _, m := range expired {
+			gcID, ok := groupChatIDs[m.ChatID]
+			if !ok || !isMentioned[m.ID] {
+				continue
+			}
+			targets, err := mentionTargets(ctx, tx.Client(), gcID, m.ID, m.SenderID)
+			if err != nil {
+				return err
+			}
+			if err := addUnreadMentions(ctx, tx, gcID, m, targets, -1); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := tx.Message.Delete().Where(message.IDIn(expiredIDs...)).Exec(ctx); err != nil {
+		return err
+	}
+
+	// Chats whose last message disappeared fall back to their latest remaining message.
+	orphanedChatIDs, err := tx.Chat.Query().
+		Where(
+			chat.IDIn(chatIDs...),
+			chat.LastMessageIDIsNil(),
+		).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, chatID := range orphanedChatIDs {
+		latest, err := tx.Message.Query().
+			Where(message.ChatID(chatID)).
+			Order(ent.Desc(message.FieldCreatedAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := tx.Chat.UpdateOneID(chatID).SetLastMessageID(latest.ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	threadRoots := make([]*ent.Message, 0, len(rootIDs))
+	for _, rootID := range rootIDs {
+		if isExpired[rootID] {
+			continue
+		}
+		root, err := s.refreshThreadStats(ctx, tx, rootID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		threadRoots = append(threadRoots, root)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	for _, att := range removedAttachments {
+		// Forwarded copies share the storage object with the original.
+		shared, err := s.client.Media.Query().Where(media.FileName(att.FileName)).Exist(ctx)
+		if err != nil {
+			slog.Error("Failed to check shared media references", "mediaID", att.ID, "error", err)
+			continue
+		}
+		if shared {
+			continue
+		}
+		if err := s.storageAdapter.Delete(att.FileName, false); err != nil {
+			slog.Error("Failed to delete expired attachment", "mediaID", att.ID, "key", att.FileName, "error", err)
+		}
+	}
+
+	if s.wsHub != nil {
+		for _, m := range expired {
+			s.wsHub.BroadcastToChat(m.ChatID, websocket.Event{
+				Type: websocket.EventMessageDelete,
+				Payload: map[string]uuid.UUID{
+					"message_id": m.ID,
+				},
+				Meta: &websocket.EventMeta{
+					Timestamp: time.Now().UTC().UnixMilli(),
+					ChatID:    m.ChatID,
+				},
+			})
+		}
+		for _, root := range threadRoots {
+			s.broadcastThreadUpdate(root, uuid.Nil)
+		}
+	}
+
+	slog.Info("Purged expired messages", "count", len(expired), "attachments", len(removedAttachments))
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *MessageService) ForwardMessage(ctx context.Context, userID, messageID uuid.UUID, req model.ForwardMessageRequest) ([]model.MessageResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	src, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if src.Type != message.TypeRegular || src.DeletedAt != nil {
+		return nil, helper.NewBadRequestError("Cannot forward this message")
+	}
+
+	attachments, err := s.client.Media.Query().
+		Where(
+			media.MessageID(src.ID),
+			media.UploadStatusEQ(media.UploadStatusCompleted),
+		).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query message attachments", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	// Forwarding a forwarded message keeps pointing at the original author.
+	originSenderID, originChatID := src.SenderID, &src.ChatID
+	if src.ForwardedFromSenderID != nil || src.ForwardedFromChatID != nil {
+		originSenderID, originChatID = src.ForwardedFromSenderID, src.ForwardedFromChatID
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	createdIDs := make([]uuid.UUID, 0, len(req.ChatIDs))
+	senderRoles := make(map[uuid.UUID]string, len(req.ChatIDs))
+
+	for _, chatID := range req.ChatIDs {
+		chatInfo, senderRole, err := s.checkSendAccess(ctx, tx, userID, chatID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.claimSlowMode(ctx, tx, chatInfo, userID, senderRole); err != nil {
+			return nil, err
+		}
+
+		msg, err := tx.Message.Create().
+			SetChatID(chatID).
+			SetSenderID(userID).
+			SetType(message.TypeRegular).
+			SetNillableContent(src.Content).
+			SetNillableForwardedFromSenderID(originSenderID).
+			SetNillableForwardedFromChatID(originChatID).
+			SetNillableExpiresAt(messageExpiry(chatInfo)).
+			Save(ctx)
+		if err != nil {
+			slog.Error("Failed to save forwarded message", "error", err)
+			return nil, helper.NewInternalServerError("")
+		}
+
+		if len(attachments) > 0 {
+			clones := make([]*ent.MediaCreate, 0, len(attachments))
+			for _, att := range attachments {
+				clones = append(clones, tx.Media.Create().
+					SetFileName(att.FileName).
+					SetOriginalName(att.OriginalName).
+					SetFileSize(att.FileSize).
+					SetMimeType(att.MimeType).
+					SetCategory(att.Category).
+					SetNillableDurationMs(att.DurationMs).
+					SetWaveform(att.Waveform).
+					SetUploadStatus(media.UploadStatusCompleted).
+					SetNillableCompletedAt(att.CompletedAt).
+					SetNillableUploadedByID(att.UploadedByID).
+					SetMessageID(msg.ID))
+			}
+			if err := tx.Media.CreateBulk(clones...).Exec(ctx); err != nil {
+				slog.Error("Failed to clone forwarded attachments", "error", err, "messageID", messageID)
+				return nil, helper.NewInternalServerError("")
+			}
+		}
+
+		if err := s.recordNewMessage(ctx, tx, chatInfo, msg, userID); err != nil {
+			return nil, err
+		}
+
+		createdIDs = append(createdIDs, msg.ID)
+		senderRoles[msg.ID] = senderRole
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	fullMsgs, err := s.client.Message.Query().
+		Where(message.IDIn(createdIDs...)).
+		WithSender(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		}).
+		WithAttachments().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to fetch forwarded messages for response", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	fullMsgByID := make(map[uuid.UUID]*ent.Message, len(fullMsgs))
+	for _, m := range fullMsgs {
+		fullMsgByID[m.ID] = m
+	}
+
+	responses := make([]model.MessageResponse, 0, len(createdIDs))
+	for _, id := range createdIDs {
+		fullMsg, ok := fullMsgByID[id]
+		if !ok {
+			continue
+		}
+
+		resp := helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRoles[id])
+		responses = append(responses, *resp)
+
+		if s.wsHub != nil {
+			go s.wsHub.BroadcastToChat(fullMsg.ChatID, websocket.Event{
+				Type:    websocket.EventMessageNew,
+				Payload: resp,
+				Meta: &websocket.EventMeta{
+					Timestamp: time.Now().UTC().UnixMilli(),
+					ChatID:    fullMsg.ChatID,
+					SenderID:  userID,
+				},
+			})
+		}
+	}
+
+	return responses, nil
+}
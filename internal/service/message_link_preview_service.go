@@ -0,0 +1,165 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/schema"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const linkPreviewJobTimeout = 20 * time.Second
+
+// attachLinkPreview fetches the preview of previewURL and stores it on the
+// message, then broadcasts the updated message. It runs in the background after
+// the message was sent or edited, so the preview is dropped when the message was
+// deleted or its first URL changed in the meantime.
+func (s *MessageService) attachLinkPreview(messageID uuid.UUID, previewURL string, actorID uuid.UUID) {
+	if s.linkPreviewAdapter == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), linkPreviewJobTimeout)
+	defer cancel()
+
+	preview, err := s.linkPreviewAdapter.Preview(ctx, previewURL)
+	if err != nil {
+		slog.Warn("Failed to fetch link preview", "error", err, "messageID", messageID, "url", previewURL)
+		return
+	}
+	if preview == nil {
+		return
+	}
+
+	msg, err := s.client.Message.Query().
+		Where(
+			message.ID(messageID),
+			message.DeletedAtIsNil(),
+		).
+		WithChat(func(q *ent.ChatQuery) {
+			q.WithGroupChat()
+		}).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("Failed to query message for link preview", "error", err, "messageID", messageID)
+		}
+		return
+	}
+	if msg.Content == nil || helper.ExtractFirstURL(*msg.Content) != previewURL {
+		return
+	}
+
+	// Guard against an edit that landed between the read above and this write.
+	update := s.client.Message.Update().
+		Where(
+			message.ID(msg.ID),
+			message.DeletedAtIsNil(),
+		).
+		SetLinkPreview(&schema.LinkPreview{
+			URL:         preview.URL,
+			SiteName:    preview.SiteName,
+			Title:       preview.Title,
+			Description: preview.Description,
+			ImagePath:   preview.ImagePath,
+		})
+	if msg.EditedAt != nil {
+		update.Where(message.EditedAt(*msg.EditedAt))
+	} else {
+		update.Where(message.EditedAtIsNil())
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		slog.Error("Failed to save link preview", "error", err, "messageID", messageID)
+		return
+	}
+	if updated == 0 {
+		return
+	}
+
+	if err := s.broadcastMessageUpdate(ctx, msg, actorID); err != nil {
+		slog.Error("Failed to broadcast link preview", "error", err, "messageID", messageID)
+	}
+}
+
+// startLinkPreview schedules attachLinkPreview when content has a URL.
+func (s *MessageService) startLinkPreview(msg *ent.Message, actorID uuid.UUID) {
+	if s.linkPreviewAdapter == nil || msg.Type != message.TypeRegular || msg.Content == nil {
+		return
+	}
+
+	if previewURL := helper.ExtractFirstURL(*msg.Content); previewURL != "" {
+		go s.attachLinkPreview(msg.ID, previewURL, actorID)
+	}
+}
+
+// broadcastMessageUpdate sends the current state of msg to the chat as a
+// message.update event. msg must have its chat edge loaded with the group chat
+// so sender roles can be resolved.
+func (s *MessageService) broadcastMessageUpdate(ctx context.Context, msg *ent.Message, actorID uuid.UUID) error {
+	if s.wsHub == nil {
+		return nil
+	}
+
+	fullMsg, err := s.client.Message.Query().
+		Where(message.ID(msg.ID)).
+		WithSender(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		}).
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
+			q.WithSender(func(uq *ent.UserQuery) {
+				uq.WithAvatar()
+			})
+			q.WithAttachments(func(aq *ent.MediaQuery) {
+				aq.Limit(1)
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		slog.Error("Failed to fetch full message for response", "error", err)
+		return helper.NewInternalServerError("")
+	}
+
+	var senderRole string
+	if fullMsg.SenderID != nil {
+		senderRole = s.getSenderRoles(ctx, msg.Edges.Chat, []*ent.Message{fullMsg})[*fullMsg.SenderID]
+	}
+
+	eventPayload := helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole)
+	if err := s.applyReactions(ctx, uuid.Nil, eventPayload); err != nil {
+		slog.Error("Failed to load message reactions", "error", err, "messageID", msg.ID)
+		return helper.NewInternalServerError("")
+	}
+	if err := s.applyPolls(ctx, uuid.Nil, eventPayload); err != nil {
+		slog.Error("Failed to load poll", "error", err, "messageID", msg.ID)
+		return helper.NewInternalServerError("")
+	}
+
+	go s.wsHub.BroadcastToChat(msg.ChatID, websocket.Event{
+		Type:    websocket.EventMessageUpdate,
+		Payload: eventPayload,
+		Meta: &websocket.EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			ChatID:    msg.ChatID,
+			SenderID:  actorID,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/schema"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SendLocation shares a position in a chat. With a live period the sender can
+// keep moving it until the period ends or they stop sharing.
+func (s *MessageService) SendLocation(ctx context.Context, userID uuid.UUID, req model.SendLocationRequest) (*model.MessageResponse, error) {
+	req.VenueName = strings.TrimSpace(req.VenueName)
+
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	location := &schema.Location{
+		Latitude:  *req.Latitude,
+		Longitude: *req.Longitude,
+		Accuracy:  *req.Accuracy,
+		VenueName: req.VenueName,
+	}
+	if req.LivePeriod != nil {
+		now := time.Now().UTC()
+		liveUntil := now.Add(time.Duration(*req.LivePeriod) * time.Second)
+		location.LiveUntil = &liveUntil
+		location.UpdatedAt = &now
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	msg, senderRole, threadRoot, err := s.createMessage(ctx, tx, userID, model.SendMessageRequest{
+		ChatID:    req.ChatID,
+		ReplyToID: req.ReplyToID,
+	}, message.TypeLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Message.UpdateOneID(msg.ID).SetLocation(location).Exec(ctx); err != nil {
+		slog.Error("Failed to save location", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.publishNewMessage(ctx, msg, userID, senderRole, threadRoot)
+}
+
+// UpdateLiveLocation moves the position of a live location sent by userID while
+// its live period lasts.
+func (s *MessageService) UpdateLiveLocation(ctx context.Context, userID, messageID uuid.UUID, req model.UpdateLiveLocationRequest) (*model.LocationDTO, error) {
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	return s.changeLiveLocation(ctx, userID, messageID, func(loc *schema.Location, now time.Time) {
+		loc.Latitude = *req.Latitude
+		loc.Longitude = *req.Longitude
+		loc.Accuracy = *req.Accuracy
+		loc.UpdatedAt = &now
+	})
+}
+
+// StopLiveLocation ends the live period of a live location sent by userID, which
+// keeps its last position.
+func (s *MessageService) StopLiveLocation(ctx context.Context, userID, messageID uuid.UUID) (*model.LocationDTO, error) {
+	return s.changeLiveLocation(ctx, userID, messageID, func(loc *schema.Location, now time.Time) {
+		loc.LiveUntil = &now
+	})
+}
+
+// changeLiveLocation applies change to a live location of userID that is still
+// live and broadcasts the new position to the chat.
+func (s *MessageService) changeLiveLocation(ctx context.Context, userID, messageID uuid.UUID, change func(loc *schema.Location, now time.Time)) (*model.LocationDTO, error) {
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Type != message.TypeLocation || msg.DeletedAt != nil {
+		return nil, helper.NewNotFoundError("Location not found")
+	}
+	if msg.SenderID == nil || *msg.SenderID != userID {
+		return nil, helper.NewForbiddenError("")
+	}
+
+	if err := s.checkPrivateChatBlock(ctx, userID, msg.Edges.Chat); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	locked, err := tx.Message.Query().
+		Where(message.ID(msg.ID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Location not found")
+		}
+		slog.Error("Failed to query location for update", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	now := time.Now().UTC()
+	loc := locked.Location
+	if locked.DeletedAt != nil || loc == nil {
+		return nil, helper.NewNotFoundError("Location not found")
+	}
+	if loc.LiveUntil == nil || !now.Before(*loc.LiveUntil) {
+		return nil, helper.NewBadRequestError("Location is not live")
+	}
+
+	change(loc, now)
+	if err := tx.Message.UpdateOneID(msg.ID).SetLocation(loc).Exec(ctx); err != nil {
+		slog.Error("Failed to update live location", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	dto := helper.ToLocationDTO(loc, now)
+	if s.wsHub != nil {
+		go s.wsHub.BroadcastToChat(msg.ChatID, websocket.Event{
+			Type: websocket.EventMessageLocation,
+			Payload: model.LiveLocationEvent{
+				MessageID: msg.ID,
+				ChatID:    msg.ChatID,
+				Location:  *dto,
+			},
+			Meta: &websocket.EventMeta{
+				Timestamp: now.UnixMilli(),
+				ChatID:    msg.ChatID,
+				SenderID:  userID,
+			},
+		})
+	}
+
+	return dto, nil
+}
@@ -0,0 +1,490 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagemention"
+	"AtoiTalkAPI/ent/predicate"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/ent/userblock"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// saveMentions parses the @mentions in the content of msg, a message sent by
+// senderID in the group chat groupChatID, and stores the ones that resolve to
+// another member. @all is only honoured for admins and owners. Members with a
+// block relation to the sender are never mentioned.
+func (s *MessageService) saveMentions(ctx context.Context, tx *ent.Tx, groupChatID uuid.UUID, msg *ent.Message, senderID uuid.UUID, senderRole string) error {
+	if msg.Content == nil {
+		return nil
+	}
+
+	tokens := helper.ParseMentions(*msg.Content)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	canMentionAll := senderRole == string(groupmember.RoleOwner) || senderRole == string(groupmember.RoleAdmin)
+
+	usernames := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if t.Username != helper.MentionAll {
+			usernames = append(usernames, t.Username)
+		}
+	}
+
+	memberIDs := make(map[string]uuid.UUID)
+	if len(usernames) > 0 {
+		blocked, err := blockRelatedUserIDs(ctx, tx.Client(), senderID)
+		if err != nil {
+			return err
+		}
+
+		members, err := tx.GroupMember.Query().
+			Where(
+				groupmember.GroupChatID(groupChatID),
+				groupmember.UserIDNEQ(senderID),
+				groupmember.HasUserWith(
+					user.UsernameIn(usernames...),
+					user.DeletedAtIsNil(),
+				),
+			).
+			WithUser(func(q *ent.UserQuery) {
+				q.Select(user.FieldID, user.FieldUsername)
+			}).
+			All(ctx)
+		if err != nil {
+			slog.Error("Failed to resolve mentioned members", "error", err, "messageID", msg.ID)
+			return helper.NewInternalServerError("")
+		}
+
+		for _, m := range members {
+			if blocked[m.UserID] || m.Edges.User == nil || m.Edges.User.Username == nil {
+				continue
+			}
+			memberIDs[*m.Edges.User.Username] = m.UserID
+		}
+	}
+
+	bulk := make([]*ent.MessageMentionCreate, 0, len(tokens))
+	for _, t := range tokens {
+		create := tx.MessageMention.Create().
+			SetMessageID(msg.ID).
+			SetOffset(t.Offset).
+			SetLength(t.Length)
+
+		if t.Username == helper.MentionAll {
+			if !canMentionAll {
+				continue
+			}
+			create.SetType(messagemention.TypeAll)
+		} else if userID, ok := memberIDs[t.Username]; ok {
+			create.SetType(messagemention.TypeUser).SetUserID(userID)
+		} else {
+			continue
+		}
+		bulk = append(bulk, create)
+	}
+
+	if len(bulk) == 0 {
+		return nil
+	}
+
+	if err := tx.MessageMention.CreateBulk(bulk...).Exec(ctx); err != nil {
+		slog.Error("Failed to save message mentions", "error", err, "messageID", msg.ID)
+		return helper.NewInternalServerError("")
+	}
+
+	return nil
+}
+
+// replaceMentions parses the mentions of an edited group message again and
+// moves the unread-mention counters of members who gained or lost a mention.
+// It returns the newly mentioned members.
+func (s *MessageService) replaceMentions(ctx context.Context, tx *ent.Tx, groupChatID uuid.UUID, msg *ent.Message, senderID uuid.UUID, senderRole string) ([]uuid.UUID, error) {
+	previous, err := clearMentions(ctx, tx, groupChatID, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.saveMentions(ctx, tx, groupChatID, msg, senderID, senderRole); err != nil {
+		return nil, err
+	}
+
+	current, err := mentionTargets(ctx, tx.Client(), groupChatID, msg.ID, msg.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	added := diffUserIDs(current, previous)
+	if err := addUnreadMentions(ctx, tx, groupChatID, msg, added, 1); err != nil {
+		return nil, err
+	}
+	if err := addUnreadMentions(ctx, tx, groupChatID, msg, diffUserIDs(previous, current), -1); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// clearMentions deletes the stored mentions of msg and returns the members they
+// targeted. Unread-mention counters are left untouched.
+func clearMentions(ctx context.Context, tx *ent.Tx, groupChatID uuid.UUID, msg *ent.Message) ([]uuid.UUID, error) {
+	previous, err := mentionTargets(ctx, tx.Client(), groupChatID, msg.ID, msg.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.MessageMention.Delete().Where(messagemention.MessageID(msg.ID)).Exec(ctx); err != nil {
+		slog.Error("Failed to delete message mentions", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return previous, nil
+}
+
+// mentionTargets returns the members of groupChatID notified by the stored
+// mentions of messageID. @all expands to every member the sender has no block
+// relation with.
+func mentionTargets(ctx context.Context, client *ent.Client, groupChatID, messageID uuid.UUID, senderID *uuid.UUID) ([]uuid.UUID, error) {
+	mentions, err := client.MessageMention.Query().
+		Where(messagemention.MessageID(messageID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query message mentions", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if len(mentions) == 0 {
+		return nil, nil
+	}
+
+	mentionsAll := false
+	userIDs := make([]uuid.UUID, 0, len(mentions))
+	for _, m := range mentions {
+		if m.Type == messagemention.TypeAll {
+			mentionsAll = true
+		} else if m.UserID != nil {
+			userIDs = append(userIDs, *m.UserID)
+		}
+	}
+
+	if !mentionsAll {
+		return userIDs, nil
+	}
+
+	query := client.GroupMember.Query().
+		Where(groupmember.GroupChatID(groupChatID))
+
+	var blocked map[uuid.UUID]bool
+	if senderID != nil {
+		query.Where(groupmember.UserIDNEQ(*senderID))
+
+		blocked, err = blockRelatedUserIDs(ctx, client, *senderID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	members, err := query.Select(groupmember.FieldUserID).All(ctx)
+	if err != nil {
+		slog.Error("Failed to query members for @all mention", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	userIDs = userIDs[:0]
+	for _, m := range members {
+		if !blocked[m.UserID] {
+			userIDs = append(userIDs, m.UserID)
+		}
+	}
+
+	return userIDs, nil
+}
+
+// publishMentions notifies the members mentioned by a committed group message.
+func (s *MessageService) publishMentions(ctx context.Context, msg *ent.Message, resp *model.MessageResponse, senderID uuid.UUID) {
+	groupChatID, err := s.client.GroupChat.Query().
+		Where(groupchat.ChatID(msg.ChatID)).
+		OnlyID(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("Failed to query group chat for mentions", "error", err, "chatID", msg.ChatID)
+		}
+		return
+	}
+
+	targets, err := mentionTargets(ctx, s.client, groupChatID, msg.ID, msg.SenderID)
+	if err != nil || len(targets) == 0 {
+		return
+	}
+
+	go s.broadcastMention(resp, senderID, targets)
+}
+
+// blockRelatedUserIDs returns every user that blocked or was blocked by userID.
+func blockRelatedUserIDs(ctx context.Context, client *ent.Client, userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	blocks, err := client.UserBlock.Query().
+		Where(
+			userblock.Or(
+				userblock.BlockerID(userID),
+				userblock.BlockedID(userID),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query block relations", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	related := make(map[uuid.UUID]bool, len(blocks))
+	for _, b := range blocks {
+		if b.BlockerID == userID {
+			related[b.BlockedID] = true
+		} else {
+			related[b.BlockerID] = true
+		}
+	}
+	return related, nil
+}
+
+// messagesBefore matches messages sent before msg, breaking ties by ID.
+func messagesBefore(msg *ent.Message) predicate.Message {
+	return message.Or(
+		message.CreatedAtLT(msg.CreatedAt),
+		message.And(message.CreatedAtEQ(msg.CreatedAt), message.IDLT(msg.ID)),
+	)
+}
+
+// messagesAfter matches messages sent after msg, breaking ties by ID.
+func messagesAfter(msg *ent.Message) predicate.Message {
+	return message.Or(
+		message.CreatedAtGT(msg.CreatedAt),
+		message.And(message.CreatedAtEQ(msg.CreatedAt), message.IDGT(msg.ID)),
+	)
+}
+
+// addUnreadMentions moves the unread-mention counter of every member in userIDs
+// who has not read msg yet by delta. Counters never drop below zero.
+func addUnreadMentions(ctx context.Context, tx *ent.Tx, groupChatID uuid.UUID, msg *ent.Message, userIDs []uuid.UUID, delta int) error {
+	if len(userIDs) == 0 || delta == 0 {
+		return nil
+	}
+
+	update := tx.GroupMember.Update().
+		Where(
+			groupmember.GroupChatID(groupChatID),
+			groupmember.UserIDIn(userIDs...),
+			groupmember.Or(
+				groupmember.LastReadMessageIDIsNil(),
+				groupmember.HasLastReadMessageWith(messagesBefore(msg)),
+			),
+		).
+		AddUnreadMentionCount(delta)
+	if delta < 0 {
+		update.Where(groupmember.UnreadMentionCountGTE(-delta))
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		slog.Error("Failed to update unread mention counters", "error", err, "messageID", msg.ID)
+		return helper.NewInternalServerError("")
+	}
+	return nil
+}
+
+// diffUserIDs returns the IDs in a that are not in b.
+func diffUserIDs(a, b []uuid.UUID) []uuid.UUID {
+	exclude := make(map[uuid.UUID]bool, len(b))
+	for _, id := range b {
+		exclude[id] = true
+	}
+
+	var result []uuid.UUID
+	for _, id := range a {
+		if !exclude[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// broadcastMention sends a message.mention event to every user in userIDs.
+// It is delivered directly to the users, so it also reaches members that do
+// not see the chat in their list.
+func (s *MessageService) broadcastMention(resp *model.MessageResponse, senderID uuid.UUID, userIDs []uuid.UUID) {
+	// A mention stays silent in a muted chat unless the member opted in to
+	// mention alerts.
+	silenced := make(map[uuid.UUID]bool)
+	members, err := s.client.GroupMember.Query().
+		Where(
+			groupmember.HasGroupChatWith(groupchat.ChatID(resp.ChatID)),
+			groupmember.UserIDIn(userIDs...),
+		).
+		All(context.Background())
+	if err != nil {
+		slog.Error("Failed to query mute state of mentioned members", "error", err, "chatID", resp.ChatID)
+	}
+	now := time.Now()
+	for _, m := range members {
+		silenced[m.UserID] = helper.IsChatMuted(m.Muted, m.MutedUntil, now) && !m.NotifyMentions
+	}
+
+	for _, uid := range userIDs {
+		s.wsHub.BroadcastToUser(uid, websocket.Event{
+			Type:    websocket.EventMessageMention,
+			Payload: resp,
+			Meta: &websocket.EventMeta{
+				Timestamp: now.UTC().UnixMilli(),
+				ChatID:    resp.ChatID,
+				SenderID:  senderID,
+				Muted:     silenced[uid],
+			},
+		})
+	}
+}
+
+// GetNextUnreadMention returns the oldest message in a group chat that mentions
+// userID and comes after the user's read position, or after req.AfterMessageID
+// when that message is further ahead.
+func (s *MessageService) GetNextUnreadMention(ctx context.Context, userID, chatID uuid.UUID, req model.GetNextMentionRequest) (*model.MessageResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, helper.NewBadRequestError("")
+	}
+
+	chatInfo, err := s.client.Chat.Query().
+		Where(
+			chat.ID(chatID),
+			chat.DeletedAtIsNil(),
+		).
+		WithGroupChat(func(q *ent.GroupChatQuery) {
+			q.WithMembers(func(mq *ent.GroupMemberQuery) {
+				mq.Where(groupmember.UserID(userID))
+				mq.WithLastReadMessage()
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Chat not found or deleted")
+		}
+		slog.Error("Failed to query chat", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if chatInfo.Type != chat.TypeGroup || chatInfo.Edges.GroupChat == nil {
+		return nil, helper.NewBadRequestError("Mentions are only available in group chats")
+	}
+	if len(chatInfo.Edges.GroupChat.Edges.Members) == 0 {
+		return nil, helper.NewForbiddenError("")
+	}
+	member := chatInfo.Edges.GroupChat.Edges.Members[0]
+
+	position := member.Edges.LastReadMessage
+	if req.AfterMessageID != nil {
+		after, err := s.client.Message.Query().
+			Where(
+				message.ID(*req.AfterMessageID),
+				message.ChatID(chatID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, helper.NewNotFoundError("Message not found")
+			}
+			slog.Error("Failed to query message", "error", err, "messageID", *req.AfterMessageID)
+			return nil, helper.NewInternalServerError("")
+		}
+		if position == nil || messageBefore(position, after) {
+			position = after
+		}
+	}
+
+	blocked, err := blockRelatedUserIDs(ctx, s.client, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.client.Message.Query().
+		Where(
+			message.ChatID(chatID),
+			message.TypeIn(message.TypeRegular, message.TypePoll),
+			message.DeletedAtIsNil(),
+			message.Or(message.SenderIDIsNil(), message.SenderIDNEQ(userID)),
+			message.HasMentionsWith(
+				messagemention.Or(
+					messagemention.UserID(userID),
+					messagemention.TypeEQ(messagemention.TypeAll),
+				),
+			),
+		)
+	if position != nil {
+		query.Where(messagesAfter(position))
+	} else {
+		query.Where(message.CreatedAtGTE(member.JoinedAt))
+	}
+	if len(blocked) > 0 {
+		blockedIDs := make([]uuid.UUID, 0, len(blocked))
+		for id := range blocked {
+			blockedIDs = append(blockedIDs, id)
+		}
+		query.Where(message.Or(message.SenderIDIsNil(), message.SenderIDNotIn(blockedIDs...)))
+	}
+
+	next, err := query.
+		Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID)).
+		WithSender(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		}).
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
+			q.WithSender(func(uq *ent.UserQuery) {
+				uq.WithAvatar()
+			})
+			q.WithAttachments(func(aq *ent.MediaQuery) {
+				aq.Limit(1)
+			})
+		}).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("No unread mentions")
+		}
+		slog.Error("Failed to query next unread mention", "error", err, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	var senderRole string
+	if next.SenderID != nil {
+		senderRole = s.getSenderRoles(ctx, chatInfo, []*ent.Message{next})[*next.SenderID]
+	}
+
+	resp := helper.ToMessageResponse(next, s.storageAdapter, nil, senderRole)
+	if err := s.applyReactions(ctx, userID, resp); err != nil {
+		slog.Error("Failed to load message reactions", "error", err, "messageID", next.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if err := s.applyPolls(ctx, userID, resp); err != nil {
+		slog.Error("Failed to load poll", "error", err, "messageID", next.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,583 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/poll"
+	"AtoiTalkAPI/ent/pollvote"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/base64"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *MessageService) SendPoll(ctx context.Context, userID uuid.UUID, req model.SendPollRequest) (*model.MessageResponse, error) {
+	req.Question = strings.TrimSpace(req.Question)
+	for i, opt := range req.Options {
+		req.Options[i] = strings.TrimSpace(opt)
+	}
+
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	if req.Quiz {
+		if req.MultipleChoice {
+			return nil, helper.NewBadRequestError("Quizzes cannot allow multiple answers")
+		}
+		if req.CorrectOption == nil || *req.CorrectOption >= len(req.Options) {
+			return nil, helper.NewBadRequestError("Quizzes need a valid correct_option")
+		}
+	} else if req.CorrectOption != nil {
+		return nil, helper.NewBadRequestError("correct_option is only allowed for quizzes")
+	}
+
+	var closesAt *time.Time
+	if req.ClosesAt != nil {
+		t := req.ClosesAt.UTC()
+		if !t.After(time.Now().UTC()) {
+			return nil, helper.NewBadRequestError("closes_at must be in the future")
+		}
+		closesAt = &t
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	msg, senderRole, threadRoot, err := s.createMessage(ctx, tx, userID, model.SendMessageRequest{
+		ChatID:    req.ChatID,
+		Content:   req.Question,
+		ReplyToID: req.ReplyToID,
+	}, message.TypePoll)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := tx.Poll.Create().
+		SetMessageID(msg.ID).
+		SetQuestion(req.Question).
+		SetMultipleChoice(req.MultipleChoice).
+		SetAnonymous(req.Anonymous).
+		SetQuiz(req.Quiz).
+		SetNillableClosesAt(closesAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to save poll", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	options := make([]*ent.PollOptionCreate, 0, len(req.Options))
+	for i, text := range req.Options {
+		options = append(options, tx.PollOption.Create().
+			SetPollID(p.ID).
+			SetText(text).
+			SetPosition(i).
+			SetIsCorrect(req.Quiz && *req.CorrectOption == i))
+	}
+	if err := tx.PollOption.CreateBulk(options...).Exec(ctx); err != nil {
+		slog.Error("Failed to save poll options", "error", err, "pollID", p.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp, err := s.publishNewMessage(ctx, msg, userID, senderRole, threadRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// The broadcast payload hides quiz answers, the creator gets to see them.
+	own := *resp
+	own.Poll = nil
+	if err := s.applyPolls(ctx, userID, &own); err != nil {
+		slog.Error("Failed to load poll", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return &own, nil
+}
+
+// VotePoll replaces the user's votes on a poll with req.OptionIDs. Quiz answers
+// are final.
+func (s *MessageService) VotePoll(ctx context.Context, userID, messageID uuid.UUID, req model.PollVoteRequest) (*model.PollDTO, error) {
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	msg, err := s.getPollMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPrivateChatBlock(ctx, userID, msg.Edges.Chat); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	p, err := lockOpenPoll(ctx, tx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.MultipleChoice && len(req.OptionIDs) > 1 {
+		return nil, helper.NewBadRequestError("This poll allows a single answer")
+	}
+
+	validOptions := make(map[uuid.UUID]bool, len(p.Edges.Options))
+	for _, o := range p.Edges.Options {
+		validOptions[o.ID] = true
+	}
+	for _, id := range req.OptionIDs {
+		if !validOptions[id] {
+			return nil, helper.NewBadRequestError("Invalid poll option")
+		}
+	}
+
+	existing, err := tx.PollVote.Query().
+		Where(
+			pollvote.PollID(p.ID),
+			pollvote.UserID(userID),
+		).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query poll votes", "error", err, "pollID", p.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if p.Quiz && len(existing) > 0 {
+		return nil, helper.NewConflictError("You already answered this quiz")
+	}
+
+	previous := make([]uuid.UUID, 0, len(existing))
+	for _, v := range existing {
+		previous = append(previous, v.OptionID)
+	}
+	if len(diffUserIDs(previous, req.OptionIDs)) == 0 && len(diffUserIDs(req.OptionIDs, previous)) == 0 {
+		return s.finishPollChange(ctx, userID, msg, false)
+	}
+
+	if _, err := tx.PollVote.Delete().
+		Where(
+			pollvote.PollID(p.ID),
+			pollvote.UserID(userID),
+		).
+		Exec(ctx); err != nil {
+		slog.Error("Failed to clear poll votes", "error", err, "pollID", p.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	votes := make([]*ent.PollVoteCreate, 0, len(req.OptionIDs))
+	for _, id := range req.OptionIDs {
+		votes = append(votes, tx.PollVote.Create().
+			SetPollID(p.ID).
+			SetOptionID(id).
+			SetUserID(userID))
+	}
+	if err := tx.PollVote.CreateBulk(votes...).Exec(ctx); err != nil {
+		slog.Error("Failed to save poll votes", "error", err, "pollID", p.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.finishPollChange(ctx, userID, msg, true)
+}
+
+func (s *MessageService) RetractPollVote(ctx context.Context, userID, messageID uuid.UUID) (*model.PollDTO, error) {
+	msg, err := s.getPollMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPrivateChatBlock(ctx, userID, msg.Edges.Chat); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	p, err := lockOpenPoll(ctx, tx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Quiz {
+		return nil, helper.NewBadRequestError("Quiz answers cannot be retracted")
+	}
+
+	deleted, err := tx.PollVote.Delete().
+		Where(
+			pollvote.PollID(p.ID),
+			pollvote.UserID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to retract poll votes", "error", err, "pollID", p.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if deleted == 0 {
+		return nil, helper.NewNotFoundError("Vote not found")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.finishPollChange(ctx, userID, msg, true)
+}
+
+// ClosePoll stops a poll from accepting votes. Only the poll creator and, in
+// groups, admins or owners may close it.
+func (s *MessageService) ClosePoll(ctx context.Context, userID, messageID uuid.UUID) (*model.PollDTO, error) {
+	msg, err := s.getPollMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	canClose := msg.SenderID != nil && *msg.SenderID == userID
+	if gc := msg.Edges.Chat.Edges.GroupChat; !canClose && gc != nil && len(gc.Edges.Members) > 0 {
+		role := gc.Edges.Members[0].Role
+		canClose = role == groupmember.RoleOwner || role == groupmember.RoleAdmin
+	}
+	if !canClose {
+		return nil, helper.NewForbiddenError("Only the poll creator or group admins can close this poll")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	p, err := lockOpenPoll(ctx, tx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Poll.UpdateOne(p).SetClosedAt(time.Now().UTC()).Exec(ctx); err != nil {
+		slog.Error("Failed to close poll", "error", err, "pollID", p.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.finishPollChange(ctx, userID, msg, true)
+}
+
+// GetPollVotes lists who voted on a poll, optionally for a single option.
+// Votes of anonymous polls are never listed.
+func (s *MessageService) GetPollVotes(ctx context.Context, userID, messageID uuid.UUID, req model.GetPollVotesRequest) ([]model.PollVoteDTO, string, bool, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, "", false, helper.NewBadRequestError("")
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	var cursorID uuid.UUID
+	if req.Cursor != "" {
+		decodedBytes, err := base64.URLEncoding.DecodeString(req.Cursor)
+		if err != nil {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+		cursorID, err = uuid.Parse(string(decodedBytes))
+		if err != nil {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+	}
+
+	msg, err := s.getPollMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	p, err := s.client.Poll.Query().Where(poll.MessageID(msg.ID)).Only(ctx)
+	if err != nil {
+		slog.Error("Failed to query poll", "error", err, "messageID", msg.ID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	if p.Anonymous {
+		return nil, "", false, helper.NewForbiddenError("Votes of anonymous polls are hidden")
+	}
+
+	votes, err := s.repo.Poll.GetVotes(ctx, p.ID, req.OptionID, cursorID, req.Limit)
+	if err != nil {
+		slog.Error("Failed to get poll votes", "error", err, "pollID", p.ID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	hasNext := false
+	if len(votes) > req.Limit {
+		hasNext = true
+		votes = votes[:req.Limit]
+	}
+
+	var nextCursor string
+	if hasNext && len(votes) > 0 {
+		nextCursor = base64.URLEncoding.EncodeToString([]byte(votes[len(votes)-1].ID.String()))
+	}
+
+	response := make([]model.PollVoteDTO, 0, len(votes))
+	for _, v := range votes {
+		dto := model.PollVoteDTO{
+			UserID:    v.UserID,
+			OptionID:  v.OptionID,
+			CreatedAt: v.CreatedAt.Format(time.RFC3339),
+		}
+		if u := v.Edges.User; u != nil {
+			if u.Username != nil {
+				dto.Username = *u.Username
+			}
+			if u.FullName != nil {
+				dto.FullName = *u.FullName
+			}
+			if u.Edges.Avatar != nil {
+				dto.Avatar = s.storageAdapter.GetPublicURL(u.Edges.Avatar.FileName)
+			}
+		}
+		response = append(response, dto)
+	}
+
+	return response, nextCursor, hasNext, nil
+}
+
+// getPollMessage loads a non-deleted poll message the user can currently see.
+func (s *MessageService) getPollMessage(ctx context.Context, userID, messageID uuid.UUID) (*ent.Message, error) {
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Type != message.TypePoll || msg.DeletedAt != nil {
+		return nil, helper.NewNotFoundError("Poll not found")
+	}
+
+	return msg, nil
+}
+
+// lockOpenPoll locks the poll of a message inside tx together with its options
+// and fails when the poll no longer accepts votes.
+func lockOpenPoll(ctx context.Context, tx *ent.Tx, messageID uuid.UUID) (*ent.Poll, error) {
+	p, err := tx.Poll.Query().
+		Where(poll.MessageID(messageID)).
+		ForUpdate().
+		WithOptions().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Poll not found")
+		}
+		slog.Error("Failed to query poll for update", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if pollClosedAt(p, time.Now().UTC()) != nil {
+		return nil, helper.NewBadRequestError("Poll is closed")
+	}
+
+	return p, nil
+}
+
+// pollClosedAt returns when p stopped accepting votes, either because it was
+// closed by hand or because it reached closes_at, or nil while it is open.
+func pollClosedAt(p *ent.Poll, now time.Time) *time.Time {
+	if p.ClosedAt != nil {
+		return p.ClosedAt
+	}
+	if p.ClosesAt != nil && !now.Before(*p.ClosesAt) {
+		return p.ClosesAt
+	}
+	return nil
+}
+
+// finishPollChange broadcasts the updated poll message to the chat when
+// changed and returns the poll as seen by userID.
+func (s *MessageService) finishPollChange(ctx context.Context, userID uuid.UUID, msg *ent.Message, changed bool) (*model.PollDTO, error) {
+	if changed {
+		if err := s.broadcastMessageUpdate(ctx, msg, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &model.MessageResponse{
+		ID:       msg.ID,
+		SenderID: msg.SenderID,
+		Type:     string(msg.Type),
+	}
+	if err := s.applyPolls(ctx, userID, resp); err != nil {
+		slog.Error("Failed to load poll", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if resp.Poll == nil {
+		return nil, helper.NewNotFoundError("Poll not found")
+	}
+
+	return resp.Poll, nil
+}
+
+// applyPolls fills the poll of every poll message among responses. When viewerID
+// is uuid.Nil the viewer's votes are skipped and quiz answers stay hidden until
+// the quiz closes, which is what shared broadcast payloads need.
+func (s *MessageService) applyPolls(ctx context.Context, viewerID uuid.UUID, responses ...*model.MessageResponse) error {
+	byID := make(map[uuid.UUID]*model.MessageResponse)
+	messageIDs := make([]uuid.UUID, 0)
+	for _, resp := range responses {
+		if resp == nil || resp.DeletedAt != nil || resp.Type != string(message.TypePoll) {
+			continue
+		}
+		byID[resp.ID] = resp
+		messageIDs = append(messageIDs, resp.ID)
+	}
+
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	polls, err := s.repo.Poll.GetPolls(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+
+	pollIDs := make([]uuid.UUID, 0, len(polls))
+	for _, p := range polls {
+		pollIDs = append(pollIDs, p.ID)
+	}
+
+	optionCounts, err := s.repo.Poll.GetOptionCounts(ctx, pollIDs)
+	if err != nil {
+		return err
+	}
+	votesByOption := make(map[uuid.UUID]int, len(optionCounts))
+	for _, c := range optionCounts {
+		votesByOption[c.OptionID] = c.Count
+	}
+
+	voterCounts, err := s.repo.Poll.GetVoterCounts(ctx, pollIDs)
+	if err != nil {
+		return err
+	}
+	votersByPoll := make(map[uuid.UUID]int, len(voterCounts))
+	for _, c := range voterCounts {
+		votersByPoll[c.PollID] = c.Count
+	}
+
+	myVotes := make(map[uuid.UUID][]uuid.UUID)
+	if viewerID != uuid.Nil {
+		mine, err := s.repo.Poll.GetUserVotes(ctx, viewerID, pollIDs)
+		if err != nil {
+			return err
+		}
+		for _, v := range mine {
+			myVotes[v.PollID] = append(myVotes[v.PollID], v.OptionID)
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, p := range polls {
+		resp, ok := byID[p.MessageID]
+		if !ok {
+			continue
+		}
+
+		dto := &model.PollDTO{
+			ID:             p.ID,
+			Question:       p.Question,
+			MultipleChoice: p.MultipleChoice,
+			Anonymous:      p.Anonymous,
+			Quiz:           p.Quiz,
+			TotalVoters:    votersByPoll[p.ID],
+			Options:        make([]model.PollOptionDTO, 0, len(p.Edges.Options)),
+			MyVotes:        myVotes[p.ID],
+		}
+
+		if p.ClosesAt != nil {
+			t := p.ClosesAt.Format(time.RFC3339)
+			dto.ClosesAt = &t
+		}
+		if closedAt := pollClosedAt(p, now); closedAt != nil {
+			t := closedAt.Format(time.RFC3339)
+			dto.ClosedAt = &t
+			dto.IsClosed = true
+		}
+
+		isCreator := viewerID != uuid.Nil && resp.SenderID != nil && *resp.SenderID == viewerID
+		revealAnswer := p.Quiz && (dto.IsClosed || isCreator || len(dto.MyVotes) > 0)
+
+		for _, o := range p.Edges.Options {
+			opt := model.PollOptionDTO{
+				ID:        o.ID,
+				Text:      o.Text,
+				VoteCount: votesByOption[o.ID],
+			}
+			if revealAnswer {
+				isCorrect := o.IsCorrect
+				opt.IsCorrect = &isCorrect
+			}
+			dto.Options = append(dto.Options, opt)
+		}
+
+		resp.Poll = dto
+	}
+
+	return nil
+}
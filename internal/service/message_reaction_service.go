@@ -0,0 +1,353 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/userblock"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/base64"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	reactionActionAdded   = "added"
+	reactionActionRemoved = "removed"
+)
+
+func (s *MessageService) AddReaction(ctx context.Context, userID uuid.UUID, messageID uuid.UUID, req model.MessageReactionRequest) (*model.MessageReactionResponse, error) {
+	req.Emoji = strings.TrimSpace(req.Emoji)
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	msg, err := s.getReactableMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.MessageReaction.Create().
+		SetMessageID(msg.ID).
+		SetUserID(userID).
+		SetEmoji(req.Emoji).
+		Exec(ctx)
+
+	changed := true
+	if err != nil {
+		if !ent.IsConstraintError(err) {
+			slog.Error("Failed to add reaction", "error", err, "messageID", messageID)
+			return nil, helper.NewInternalServerError("")
+		}
+		changed = false
+	}
+
+	return s.finishReactionChange(ctx, userID, msg, req.Emoji, reactionActionAdded, changed)
+}
+
+func (s *MessageService) RemoveReaction(ctx context.Context, userID uuid.UUID, messageID uuid.UUID, req model.MessageReactionRequest) (*model.MessageReactionResponse, error) {
+	req.Emoji = strings.TrimSpace(req.Emoji)
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.client.MessageReaction.Delete().
+		Where(
+			messagereaction.MessageID(msg.ID),
+			messagereaction.UserID(userID),
+			messagereaction.Emoji(req.Emoji),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to remove reaction", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if deleted == 0 {
+		return nil, helper.NewNotFoundError("Reaction not found")
+	}
+
+	return s.finishReactionChange(ctx, userID, msg, req.Emoji, reactionActionRemoved, true)
+}
+
+func (s *MessageService) GetReactions(ctx context.Context, userID uuid.UUID, messageID uuid.UUID, req model.GetMessageReactionsRequest) ([]model.MessageReactionDTO, string, bool, error) {
+	req.Emoji = strings.TrimSpace(req.Emoji)
+	if err := s.validator.Struct(req); err != nil {
+		return nil, "", false, helper.NewBadRequestError("")
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	var cursorID uuid.UUID
+	if req.Cursor != "" {
+		decodedBytes, err := base64.URLEncoding.DecodeString(req.Cursor)
+		if err != nil {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+		cursorID, err = uuid.Parse(string(decodedBytes))
+		if err != nil {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+	}
+
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	reactions, err := s.repo.MessageReaction.GetReactions(ctx, msg.ID, req.Emoji, cursorID, req.Limit)
+	if err != nil {
+		slog.Error("Failed to get reactions", "error", err, "messageID", messageID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	hasNext := false
+	if len(reactions) > req.Limit {
+		hasNext = true
+		reactions = reactions[:req.Limit]
+	}
+
+	var nextCursor string
+	if hasNext && len(reactions) > 0 {
+		nextCursor = base64.URLEncoding.EncodeToString([]byte(reactions[len(reactions)-1].ID.String()))
+	}
+
+	response := make([]model.MessageReactionDTO, 0, len(reactions))
+	for _, r := range reactions {
+		dto := model.MessageReactionDTO{
+			UserID:    r.UserID,
+			Emoji:     r.Emoji,
+			CreatedAt: r.CreatedAt.Format(time.RFC3339),
+		}
+		if u := r.Edges.User; u != nil {
+			if u.Username != nil {
+				dto.Username = *u.Username
+			}
+			if u.FullName != nil {
+				dto.FullName = *u.FullName
+			}
+			if u.Edges.Avatar != nil {
+				dto.Avatar = s.storageAdapter.GetPublicURL(u.Edges.Avatar.FileName)
+			}
+		}
+		response = append(response, dto)
+	}
+
+	return response, nextCursor, hasNext, nil
+}
+
+func (s *MessageService) finishReactionChange(ctx context.Context, userID uuid.UUID, msg *ent.Message, emoji, action string, changed bool) (*model.MessageReactionResponse, error) {
+	resp := &model.MessageReactionResponse{
+		MessageID: msg.ID,
+		ChatID:    msg.ChatID,
+		UserID:    userID,
+		Emoji:     emoji,
+		Action:    action,
+		Reactions: make([]model.ReactionSummaryDTO, 0),
+	}
+
+	summary := &model.MessageResponse{ID: msg.ID}
+	if err := s.applyReactions(ctx, userID, summary); err != nil {
+		slog.Error("Failed to load reaction summary", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if summary.Reactions != nil {
+		resp.Reactions = summary.Reactions
+	}
+
+	if changed && s.wsHub != nil {
+		eventPayload := *resp
+		go s.wsHub.BroadcastToChat(msg.ChatID, websocket.Event{
+			Type:    websocket.EventMessageReaction,
+			Payload: eventPayload,
+			Meta: &websocket.EventMeta{
+				Timestamp: time.Now().UTC().UnixMilli(),
+				ChatID:    msg.ChatID,
+				SenderID:  userID,
+			},
+		})
+	}
+
+	resp.MyReactions = summary.MyReactions
+	return resp, nil
+}
+
+// applyReactions fills reaction counts on the given responses. When viewerID is
+// uuid.Nil only the counts are loaded, which is what shared broadcast payloads need.
+func (s *MessageService) applyReactions(ctx context.Context, viewerID uuid.UUID, responses ...*model.MessageResponse) error {
+	byID := make(map[uuid.UUID]*model.MessageResponse, len(responses))
+	messageIDs := make([]uuid.UUID, 0, len(responses))
+	for _, resp := range responses {
+		if resp == nil || resp.DeletedAt != nil {
+			continue
+		}
+		byID[resp.ID] = resp
+		messageIDs = append(messageIDs, resp.ID)
+	}
+
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	counts, err := s.repo.MessageReaction.GetReactionCounts(ctx, messageIDs)
+	if err != nil {
+		return err
+	}
+	for _, c := range counts {
+		if resp, ok := byID[c.MessageID]; ok {
+			resp.Reactions = append(resp.Reactions, model.ReactionSummaryDTO{Emoji: c.Emoji, Count: c.Count})
+		}
+	}
+
+	if viewerID == uuid.Nil {
+		return nil
+	}
+
+	mine, err := s.repo.MessageReaction.GetUserReactions(ctx, viewerID, messageIDs)
+	if err != nil {
+		return err
+	}
+	for _, r := range mine {
+		if resp, ok := byID[r.MessageID]; ok {
+			resp.MyReactions = append(resp.MyReactions, r.Emoji)
+		}
+	}
+
+	return nil
+}
+
+func (s *MessageService) getReactableMessage(ctx context.Context, userID uuid.UUID, messageID uuid.UUID) (*ent.Message, error) {
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isUserMessage(msg.Type) || msg.DeletedAt != nil {
+		return nil, helper.NewBadRequestError("Cannot react to this message")
+	}
+
+	if err := s.checkPrivateChatBlock(ctx, userID, msg.Edges.Chat); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// checkPrivateChatBlock rejects interactions in a private chat when either
+// participant blocked the other. Group chats always pass.
+func (s *MessageService) checkPrivateChatBlock(ctx context.Context, userID uuid.UUID, c *ent.Chat) error {
+	if c.Type != chat.TypePrivate || c.Edges.PrivateChat == nil {
+		return nil
+	}
+
+	pc := c.Edges.PrivateChat
+	var otherUserID uuid.UUID
+	if pc.User1ID != nil && *pc.User1ID == userID {
+		if pc.User2ID != nil {
+			otherUserID = *pc.User2ID
+		}
+	} else if pc.User1ID != nil {
+		otherUserID = *pc.User1ID
+	}
+
+	if otherUserID == uuid.Nil {
+		return helper.NewForbiddenError("User does not exist")
+	}
+
+	isBlocked, err := s.client.UserBlock.Query().
+		Where(
+			userblock.Or(
+				userblock.And(
+					userblock.BlockerID(userID),
+					userblock.BlockedID(otherUserID),
+				),
+				userblock.And(
+					userblock.BlockerID(otherUserID),
+					userblock.BlockedID(userID),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		slog.Error("Failed to check block status", "error", err)
+		return helper.NewInternalServerError("")
+	}
+	if isBlocked {
+		return helper.NewForbiddenError("")
+	}
+
+	return nil
+}
+
+// getAccessibleMessage loads a message together with its chat and verifies that
+// the user is a participant who can currently see it.
+func (s *MessageService) getAccessibleMessage(ctx context.Context, userID uuid.UUID, messageID uuid.UUID) (*ent.Message, error) {
+	msg, err := s.client.Message.Query().
+		Where(
+			message.ID(messageID),
+			message.HasChatWith(chat.DeletedAtIsNil()),
+		).
+		WithChat(func(q *ent.ChatQuery) {
+			q.WithPrivateChat()
+			q.WithGroupChat(func(gq *ent.GroupChatQuery) {
+				gq.WithMembers(func(mq *ent.GroupMemberQuery) {
+					mq.Where(groupmember.UserID(userID))
+				})
+			})
+		}).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Message not found")
+		}
+		slog.Error("Failed to query message", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	c := msg.Edges.Chat
+	if c == nil {
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil {
+		pc := c.Edges.PrivateChat
+		var hiddenAt *time.Time
+		if pc.User1ID != nil && *pc.User1ID == userID {
+			hiddenAt = pc.User1HiddenAt
+		} else if pc.User2ID != nil && *pc.User2ID == userID {
+			hiddenAt = pc.User2HiddenAt
+		} else {
+			return nil, helper.NewForbiddenError("")
+		}
+
+		if hiddenAt != nil && !msg.CreatedAt.After(*hiddenAt) {
+			return nil, helper.NewNotFoundError("Message not found")
+		}
+	} else if c.Type == chat.TypeGroup && c.Edges.GroupChat != nil {
+		if len(c.Edges.GroupChat.Edges.Members) == 0 {
+			return nil, helper.NewForbiddenError("")
+		}
+	} else {
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// GetMessageReceipts lists which group members have read or received a message,
+// based on each member's read and delivered positions. The sender is left out.
+func (s *MessageService) GetMessageReceipts(ctx context.Context, userID, messageID uuid.UUID) (*model.MessageReceiptsResponse, error) {
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Edges.Chat.Type != chat.TypeGroup {
+		return nil, helper.NewBadRequestError("Receipts are only available in group chats")
+	}
+
+	if !isUserMessage(msg.Type) || msg.DeletedAt != nil {
+		return nil, helper.NewBadRequestError("Receipts are not available for this message")
+	}
+
+	reached := message.Or(
+		message.CreatedAtGT(msg.CreatedAt),
+		message.And(message.CreatedAtEQ(msg.CreatedAt), message.IDGTE(msg.ID)),
+	)
+
+	query := s.client.GroupMember.Query().
+		Where(
+			groupmember.GroupChatID(msg.Edges.Chat.Edges.GroupChat.ID),
+			groupmember.Or(
+				groupmember.HasLastReadMessageWith(reached),
+				groupmember.HasLastDeliveredMessageWith(reached),
+			),
+		)
+	if msg.SenderID != nil {
+		query.Where(groupmember.UserIDNEQ(*msg.SenderID))
+	}
+
+	members, err := query.
+		WithUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		WithLastReadMessage().
+		Order(ent.Asc(groupmember.FieldJoinedAt), ent.Asc(groupmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to query message receipts", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp := &model.MessageReceiptsResponse{
+		MessageID:   msg.ID,
+		ReadBy:      make([]model.MessageReceiptDTO, 0),
+		DeliveredTo: make([]model.MessageReceiptDTO, 0),
+	}
+
+	for _, m := range members {
+		dto := model.MessageReceiptDTO{UserID: m.UserID}
+		if u := m.Edges.User; u != nil {
+			if u.Username != nil {
+				dto.Username = *u.Username
+			}
+			if u.FullName != nil {
+				dto.FullName = *u.FullName
+			}
+			if u.Edges.Avatar != nil {
+				dto.Avatar = s.storageAdapter.GetPublicURL(u.Edges.Avatar.FileName)
+			}
+		}
+
+		if lastRead := m.Edges.LastReadMessage; lastRead != nil && !messageBefore(lastRead, msg) {
+			resp.ReadBy = append(resp.ReadBy, dto)
+		} else {
+			resp.DeliveredTo = append(resp.DeliveredTo, dto)
+		}
+	}
+
+	return resp, nil
+}
+
+// messageBefore reports whether a was sent before b, breaking ties by ID.
+func messageBefore(a, b *ent.Message) bool {
+	if a.CreatedAt.Equal(b.CreatedAt) {
+		return a.ID.String() < b.ID.String()
+	}
+	return a.CreatedAt.Before(b.CreatedAt)
+}
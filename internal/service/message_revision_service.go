@@ -0,0 +1,46 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/messagerevision"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// GetMessageRevisions lists the earlier versions of a message, oldest first.
+// Revisions of deleted messages stay hidden from chat members.
+func (s *MessageService) GetMessageRevisions(ctx context.Context, userID, messageID uuid.UUID) ([]model.MessageRevisionResponse, error) {
+	msg, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.DeletedAt != nil {
+		return nil, helper.NewBadRequestError("Message is deleted")
+	}
+
+	return loadMessageRevisions(ctx, s.client, s.storageAdapter, msg.ID)
+}
+
+func loadMessageRevisions(ctx context.Context, client *ent.Client, urlGen helper.URLGenerator, messageID uuid.UUID) ([]model.MessageRevisionResponse, error) {
+	revisions, err := client.MessageRevision.Query().
+		Where(messagerevision.MessageID(messageID)).
+		WithAttachments().
+		Order(ent.Asc(messagerevision.FieldCreatedAt), ent.Asc(messagerevision.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to get message revisions", "error", err, "messageID", messageID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	response := make([]model.MessageRevisionResponse, 0, len(revisions))
+	for _, rev := range revisions {
+		response = append(response, *helper.ToMessageRevisionResponse(rev, urlGen))
+	}
+
+	return response, nil
+}
@@ -0,0 +1,314 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	maxScheduledMessages = 100
+	maxScheduleAhead     = 365 * 24 * time.Hour
+)
+
+func (s *MessageService) ScheduleMessage(ctx context.Context, userID uuid.UUID, req model.ScheduleMessageRequest) (*model.ScheduledMessageResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	if err := checkSendAt(req.SendAt); err != nil {
+		return nil, err
+	}
+
+	req.Content = strings.TrimSpace(req.Content)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	pending, err := tx.ScheduledMessage.Query().
+		Where(scheduledmessage.SenderID(userID)).
+		Count(ctx)
+	if err != nil {
+		slog.Error("Failed to count scheduled messages", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if pending >= maxScheduledMessages {
+		return nil, helper.NewBadRequestError("Scheduled message limit reached")
+	}
+
+	if _, _, err := s.checkSendRequest(ctx, tx, userID, req.SendMessageRequest); err != nil {
+		return nil, err
+	}
+
+	sm, err := tx.ScheduledMessage.Create().
+		SetChatID(req.ChatID).
+		SetSenderID(userID).
+		SetContent(req.Content).
+		SetNillableReplyToID(req.ReplyToID).
+		SetSendAt(req.SendAt.UTC()).
+		AddAttachmentIDs(req.AttachmentIDs...).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to save scheduled message", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.getScheduledMessage(ctx, sm.ID)
+}
+
+func (s *MessageService) GetScheduledMessages(ctx context.Context, userID uuid.UUID, chatID *uuid.UUID) ([]model.ScheduledMessageResponse, error) {
+	query := s.client.ScheduledMessage.Query().
+		Where(scheduledmessage.SenderID(userID))
+	if chatID != nil {
+		query.Where(scheduledmessage.ChatID(*chatID))
+	}
+
+	items, err := query.
+		WithAttachments().
+		Order(ent.Asc(scheduledmessage.FieldSendAt), ent.Asc(scheduledmessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to get scheduled messages", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	response := make([]model.ScheduledMessageResponse, 0, len(items))
+	for _, sm := range items {
+		response = append(response, *helper.ToScheduledMessageResponse(sm, s.storageAdapter))
+	}
+
+	return response, nil
+}
+
+func (s *MessageService) UpdateScheduledMessage(ctx context.Context, userID, scheduledID uuid.UUID, req model.UpdateScheduledMessageRequest) (*model.ScheduledMessageResponse, error) {
+	if err := s.validator.Struct(req); err != nil {
+		slog.Warn("Validation failed", "error", err, "userID", userID)
+		return nil, helper.NewBadRequestError("")
+	}
+
+	if err := checkSendAt(req.SendAt); err != nil {
+		return nil, err
+	}
+
+	req.Content = strings.TrimSpace(req.Content)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	sm, err := tx.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.ID(scheduledID),
+			scheduledmessage.SenderID(userID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, helper.NewNotFoundError("Scheduled message not found")
+		}
+		slog.Error("Failed to query scheduled message", "error", err, "scheduledID", scheduledID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	// Release the current attachments so they pass the same checks as new ones.
+	if err := tx.Media.Update().
+		Where(media.ScheduledMessageID(sm.ID)).
+		ClearScheduledMessageID().
+		Exec(ctx); err != nil {
+		slog.Error("Failed to release scheduled attachments", "error", err, "scheduledID", scheduledID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	sendReq := model.SendMessageRequest{
+		ChatID:        sm.ChatID,
+		Content:       req.Content,
+		AttachmentIDs: req.AttachmentIDs,
+		ReplyToID:     req.ReplyToID,
+	}
+	if _, _, err := s.checkSendRequest(ctx, tx, userID, sendReq); err != nil {
+		return nil, err
+	}
+
+	update := tx.ScheduledMessage.UpdateOne(sm).
+		SetContent(req.Content).
+		SetSendAt(req.SendAt.UTC()).
+		SetStatus(scheduledmessage.StatusPending).
+		ClearFailureReason().
+		AddAttachmentIDs(req.AttachmentIDs...)
+	if req.ReplyToID != nil {
+		update.SetReplyToID(*req.ReplyToID)
+	} else {
+		update.ClearReplyToID()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		slog.Error("Failed to update scheduled message", "error", err, "scheduledID", scheduledID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.getScheduledMessage(ctx, sm.ID)
+}
+
+func (s *MessageService) CancelScheduledMessage(ctx context.Context, userID, scheduledID uuid.UUID) error {
+	deleted, err := s.client.ScheduledMessage.Delete().
+		Where(
+			scheduledmessage.ID(scheduledID),
+			scheduledmessage.SenderID(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("Failed to delete scheduled message", "error", err, "scheduledID", scheduledID)
+		return helper.NewInternalServerError("")
+	}
+	if deleted == 0 {
+		return helper.NewNotFoundError("Scheduled message not found")
+	}
+
+	return nil
+}
+
+// DeliverScheduledMessage sends a due scheduled message through the same path as
+// SendMessage. When the send is rejected, for example because the author left
+// the chat, the scheduled message is kept and marked as failed. A message held
+// back by slow mode stays pending and is retried on a later run. Messages that
+// are not due or are already being delivered elsewhere are skipped.
+func (s *MessageService) DeliverScheduledMessage(ctx context.Context, scheduledID uuid.UUID) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+		if v := recover(); v != nil {
+			panic(v)
+		}
+	}()
+
+	sm, err := tx.ScheduledMessage.Query().
+		Where(
+			scheduledmessage.ID(scheduledID),
+			scheduledmessage.StatusEQ(scheduledmessage.StatusPending),
+			scheduledmessage.SendAtLTE(time.Now().UTC()),
+		).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		WithSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldDeletedAt, user.FieldIsBanned, user.FieldBannedUntil)
+		}).
+		WithAttachments(func(q *ent.MediaQuery) {
+			q.Select(media.FieldID)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	sender := sm.Edges.Sender
+	if sender == nil || sender.DeletedAt != nil ||
+		(sender.IsBanned && (sender.BannedUntil == nil || time.Now().UTC().Before(*sender.BannedUntil))) {
+		_ = tx.Rollback()
+		return s.failScheduledMessage(ctx, sm.ID, "Sender account is unavailable")
+	}
+
+	// Deleting the schedule releases its attachments to the new message.
+	if err := tx.ScheduledMessage.DeleteOne(sm).Exec(ctx); err != nil {
+		return err
+	}
+
+	req := model.SendMessageRequest{
+		ChatID:    sm.ChatID,
+		ReplyToID: sm.ReplyToID,
+	}
+	if sm.Content != nil {
+		req.Content = *sm.Content
+	}
+	for _, att := range sm.Edges.Attachments {
+		req.AttachmentIDs = append(req.AttachmentIDs, att.ID)
+	}
+
+	msg, senderRole, threadRoot, err := s.createMessage(ctx, tx, sm.SenderID, req, message.TypeRegular)
+	if err != nil {
+		var appErr *helper.AppError
+		if errors.As(err, &appErr) && appErr.Code == http.StatusTooManyRequests {
+			return nil
+		}
+		if errors.As(err, &appErr) && appErr.Code < http.StatusInternalServerError {
+			_ = tx.Rollback()
+			return s.failScheduledMessage(ctx, sm.ID, appErr.Message)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	_, err = s.publishNewMessage(ctx, msg, sm.SenderID, senderRole, threadRoot)
+	return err
+}
+
+func (s *MessageService) failScheduledMessage(ctx context.Context, scheduledID uuid.UUID, reason string) error {
+	return s.client.ScheduledMessage.UpdateOneID(scheduledID).
+		SetStatus(scheduledmessage.StatusFailed).
+		SetFailureReason(reason).
+		Exec(ctx)
+}
+
+func (s *MessageService) getScheduledMessage(ctx context.Context, scheduledID uuid.UUID) (*model.ScheduledMessageResponse, error) {
+	sm, err := s.client.ScheduledMessage.Query().
+		Where(scheduledmessage.ID(scheduledID)).
+		WithAttachments().
+		Only(ctx)
+	if err != nil {
+		slog.Error("Failed to fetch scheduled message for response", "error", err, "scheduledID", scheduledID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return helper.ToScheduledMessageResponse(sm, s.storageAdapter), nil
+}
+
+func checkSendAt(sendAt time.Time) error {
+	now := time.Now()
+	if !sendAt.After(now) {
+		return helper.NewBadRequestError("send_at must be in the future")
+	}
+	if sendAt.After(now.Add(maxScheduleAhead)) {
+		return helper.NewBadRequestError("send_at must be within one year")
+	}
+	return nil
+}
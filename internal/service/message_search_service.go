@@ -0,0 +1,164 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/repository"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *MessageService) SearchMessages(ctx context.Context, userID uuid.UUID, req model.SearchMessagesRequest) ([]model.MessageSearchResultDTO, string, bool, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	if err := s.validator.Struct(req); err != nil {
+		return nil, "", false, helper.NewBadRequestError("")
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	if req.ChatID != nil {
+		chatInfo, err := s.client.Chat.Query().
+			Where(
+				chat.ID(*req.ChatID),
+				chat.DeletedAtIsNil(),
+			).
+			WithPrivateChat().
+			WithGroupChat(func(q *ent.GroupChatQuery) {
+				q.WithMembers(func(mq *ent.GroupMemberQuery) {
+					mq.Where(groupmember.UserID(userID))
+				})
+			}).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, "", false, helper.NewNotFoundError("Chat not found or deleted")
+			}
+			slog.Error("Failed to query chat info", "error", err, "chatID", *req.ChatID)
+			return nil, "", false, helper.NewInternalServerError("")
+		}
+
+		isMember := false
+		if pc := chatInfo.Edges.PrivateChat; chatInfo.Type == chat.TypePrivate && pc != nil {
+			isMember = (pc.User1ID != nil && *pc.User1ID == userID) || (pc.User2ID != nil && *pc.User2ID == userID)
+		} else if gc := chatInfo.Edges.GroupChat; chatInfo.Type == chat.TypeGroup && gc != nil {
+			isMember = len(gc.Edges.Members) > 0
+		}
+
+		if !isMember {
+			return nil, "", false, helper.NewForbiddenError("")
+		}
+	}
+
+	hits, nextCursor, hasNext, err := s.repo.Message.SearchMessages(ctx, userID, req.ChatID, req.Query, req.Cursor, req.Limit)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+		slog.Error("Failed to search messages", "error", err, "userID", userID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	results := make([]model.MessageSearchResultDTO, 0, len(hits))
+	if len(hits) == 0 {
+		return results, nextCursor, hasNext, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+
+	messages, err := s.client.Message.Query().
+		Where(message.IDIn(ids...)).
+		WithSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithChat(func(q *ent.ChatQuery) {
+			q.WithPrivateChat(func(pq *ent.PrivateChatQuery) {
+				pq.WithUser1(func(uq *ent.UserQuery) {
+					uq.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+				})
+				pq.WithUser2(func(uq *ent.UserQuery) {
+					uq.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+				})
+			})
+			q.WithGroupChat()
+		}).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to load search results", "error", err, "userID", userID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	messageMap := make(map[uuid.UUID]*ent.Message, len(messages))
+	for _, m := range messages {
+		messageMap[m.ID] = m
+	}
+
+	for _, h := range hits {
+		msg, ok := messageMap[h.ID]
+		if !ok || msg.Edges.Chat == nil {
+			continue
+		}
+
+		result := model.MessageSearchResultDTO{
+			MessageID: msg.ID,
+			ChatID:    msg.ChatID,
+			ChatType:  string(msg.Edges.Chat.Type),
+			ChatName:  searchResultChatName(userID, msg.Edges.Chat),
+			SenderID:  msg.SenderID,
+			Snippet:   helper.FormatSearchSnippet(h.Snippet),
+			Rank:      h.Rank,
+			CreatedAt: msg.CreatedAt.Format(time.RFC3339),
+		}
+
+		if sender := msg.Edges.Sender; sender != nil {
+			if sender.DeletedAt != nil {
+				result.SenderID = nil
+				result.SenderName = "Deleted User"
+			} else if sender.FullName != nil {
+				result.SenderName = *sender.FullName
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nextCursor, hasNext, nil
+}
+
+func searchResultChatName(userID uuid.UUID, c *ent.Chat) string {
+	if gc := c.Edges.GroupChat; gc != nil {
+		return gc.Name
+	}
+
+	pc := c.Edges.PrivateChat
+	if pc == nil {
+		return ""
+	}
+
+	other := pc.Edges.User1
+	if pc.User1ID != nil && *pc.User1ID == userID {
+		other = pc.Edges.User2
+	}
+
+	if other == nil || other.DeletedAt != nil {
+		return "Deleted User"
+	}
+	if other.FullName != nil {
+		return *other.FullName
+	}
+	return ""
+}
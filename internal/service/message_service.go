@@ -7,6 +7,8 @@ import (
 	"AtoiTalkAPI/ent/groupmember"
 	"AtoiTalkAPI/ent/media"
 	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/ent/pinnedmessage"
 	"AtoiTalkAPI/ent/user"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/internal/adapter"
@@ -18,7 +20,10 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +31,10 @@ import (
 	"github.com/google/uuid"
 )
 
+// errDuplicateClientMessage is returned by createMessage when the sender already
+// stored a message with the same client message ID in the chat.
+var errDuplicateClientMessage = errors.New("duplicate client message id")
+
 type MessageService struct {
 	client         *ent.Client
 	repo           *repository.Repository
@@ -33,16 +42,19 @@ type MessageService struct {
 	validator      *validator.Validate
 	storageAdapter *adapter.StorageAdapter
 	wsHub          *websocket.Hub
+
+	linkPreviewAdapter *adapter.LinkPreviewAdapter
 }
 
-func NewMessageService(client *ent.Client, repo *repository.Repository, cfg *config.AppConfig, validator *validator.Validate, storageAdapter *adapter.StorageAdapter, wsHub *websocket.Hub) *MessageService {
+func NewMessageService(client *ent.Client, repo *repository.Repository, cfg *config.AppConfig, validator *validator.Validate, storageAdapter *adapter.StorageAdapter, wsHub *websocket.Hub, linkPreviewAdapter *adapter.LinkPreviewAdapter) *MessageService {
 	return &MessageService{
-		client:         client,
-		repo:           repo,
-		cfg:            cfg,
-		validator:      validator,
-		storageAdapter: storageAdapter,
-		wsHub:          wsHub,
+		client:             client,
+		repo:               repo,
+		cfg:                cfg,
+		validator:          validator,
+		storageAdapter:     storageAdapter,
+		wsHub:              wsHub,
+		linkPreviewAdapter: linkPreviewAdapter,
 	}
 }
 
@@ -54,8 +66,6 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 
 	req.Content = strings.TrimSpace(req.Content)
 
-	var msg *ent.Message
-
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
 		slog.Error("Failed to start transaction", "error", err)
@@ -69,9 +79,370 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 		}
 	}()
 
+	if req.ClientMessageID != "" {
+		// A retry only gets the stored message back while the sender can still
+		// send to the chat, not after being removed or blocked.
+		if _, _, err := s.checkSendAccess(ctx, tx, userID, req.ChatID); err != nil {
+			return nil, err
+		}
+		resp, err := s.getClientMessage(ctx, userID, req.ChatID, req.ClientMessageID)
+		if err != nil || resp != nil {
+			return resp, err
+		}
+	}
+
+	msg, senderRole, threadRoot, err := s.createMessage(ctx, tx, userID, req, message.TypeRegular)
+	if errors.Is(err, errDuplicateClientMessage) {
+		// A concurrent retry stored the message first.
+		_ = tx.Rollback()
+		resp, err := s.getClientMessage(ctx, userID, req.ChatID, req.ClientMessageID)
+		if err == nil && resp == nil {
+			return nil, helper.NewInternalServerError("")
+		}
+		return resp, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return s.publishNewMessage(ctx, msg, userID, senderRole, threadRoot)
+}
+
+// createMessage stores a message of msgType sent by userID inside tx after
+// running every send rule against req. It returns the sender's group role and,
+// for replies, the updated thread root.
+func (s *MessageService) createMessage(ctx context.Context, tx *ent.Tx, userID uuid.UUID, req model.SendMessageRequest, msgType message.Type) (*ent.Message, string, *ent.Message, error) {
+	chatInfo, senderRole, err := s.checkSendRequest(ctx, tx, userID, req)
+	if err != nil {
+		return nil, "", nil, err
+	}
+
+	if err := s.claimSlowMode(ctx, tx, chatInfo, userID, senderRole); err != nil {
+		return nil, "", nil, err
+	}
+
+	msgCreate := tx.Message.Create().
+		SetChatID(req.ChatID).
+		SetSenderID(userID).
+		SetType(msgType).
+		SetContent(req.Content).
+		SetNillableExpiresAt(messageExpiry(chatInfo))
+
+	if req.ReplyToID != nil {
+		msgCreate.SetReplyToID(*req.ReplyToID)
+	}
+
+	if len(req.AttachmentIDs) > 0 {
+		msgCreate.AddAttachmentIDs(req.AttachmentIDs...)
+	}
+
+	if req.ClientMessageID != "" {
+		msgCreate.SetClientMessageID(req.ClientMessageID)
+	}
+
+	msg, err := msgCreate.Save(ctx)
+	if err != nil {
+		if req.ClientMessageID != "" && ent.IsConstraintError(err) {
+			return nil, "", nil, errDuplicateClientMessage
+		}
+		slog.Error("Failed to save message", "error", err)
+		return nil, "", nil, helper.NewInternalServerError("")
+	}
+
+	var threadRoot *ent.Message
+	if req.ReplyToID != nil {
+		threadRoot, err = tx.Message.UpdateOneID(*req.ReplyToID).
+			AddReplyCount(1).
+			SetLastReplyAt(msg.CreatedAt).
+			Save(ctx)
+		if err != nil {
+			slog.Error("Failed to update thread stats", "error", err, "messageID", *req.ReplyToID)
+			return nil, "", nil, helper.NewInternalServerError("")
+		}
+	}
+
+	if err := s.recordNewMessage(ctx, tx, chatInfo, msg, userID); err != nil {
+		return nil, "", nil, err
+	}
+
+	if chatInfo.Type == chat.TypeGroup && chatInfo.Edges.GroupChat != nil {
+		gcID := chatInfo.Edges.GroupChat.ID
+		if err := s.saveMentions(ctx, tx, gcID, msg, userID, senderRole); err != nil {
+			return nil, "", nil, err
+		}
+
+		targets, err := mentionTargets(ctx, tx.Client(), gcID, msg.ID, &userID)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		if err := addUnreadMentions(ctx, tx, gcID, msg, targets, 1); err != nil {
+			return nil, "", nil, err
+		}
+	}
+
+	return msg, senderRole, threadRoot, nil
+}
+
+// getClientMessage returns the message userID already sent to chatID with
+// clientMessageID, as seen by the sender, or nil when there is none.
+func (s *MessageService) getClientMessage(ctx context.Context, userID, chatID uuid.UUID, clientMessageID string) (*model.MessageResponse, error) {
+	msg, err := s.client.Message.Query().
+		Where(
+			message.SenderID(userID),
+			message.ChatID(chatID),
+			message.ClientMessageID(clientMessageID),
+		).
+		WithChat(func(q *ent.ChatQuery) {
+			q.WithGroupChat()
+		}).
+		WithSender(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		}).
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
+			q.WithSender(func(uq *ent.UserQuery) {
+				uq.WithAvatar()
+			})
+			q.WithAttachments(func(aq *ent.MediaQuery) {
+				aq.Limit(1)
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		slog.Error("Failed to query message by client ID", "error", err, "userID", userID, "chatID", chatID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	senderRole := s.getSenderRoles(ctx, msg.Edges.Chat, []*ent.Message{msg})[userID]
+	resp := helper.ToMessageResponse(msg, s.storageAdapter, nil, senderRole)
+	if err := s.applyReactions(ctx, userID, resp); err != nil {
+		slog.Error("Failed to load message reactions", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+	if err := s.applyPolls(ctx, userID, resp); err != nil {
+		slog.Error("Failed to load poll", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	return resp, nil
+}
+
+// messageExpiry returns when a message sent to c right now disappears, or nil
+// when the chat has no message TTL.
+func messageExpiry(c *ent.Chat) *time.Time {
+	if c.MessageTTL == nil {
+		return nil
+	}
+	expiresAt := time.Now().UTC().Add(time.Duration(*c.MessageTTL) * time.Second)
+	return &expiresAt
+}
+
+// claimSlowMode starts the slow mode cooldown of userID in a group chat, or
+// returns a 429 with the seconds left when the previous one has not ended.
+// Admins and owners are exempt. The cooldown is given back when tx does not
+// commit, so a failed send does not use up the window.
+func (s *MessageService) claimSlowMode(ctx context.Context, tx *ent.Tx, c *ent.Chat, userID uuid.UUID, senderRole string) error {
+	gc := c.Edges.GroupChat
+	if gc == nil || gc.SlowModeSeconds <= 0 {
+		return nil
+	}
+	if senderRole == string(groupmember.RoleOwner) || senderRole == string(groupmember.RoleAdmin) {
+		return nil
+	}
+
+	key := fmt.Sprintf("ratelimit:slowmode:%s:%s", c.ID, userID)
+	allowed, ttl, err := s.repo.RateLimit.Allow(ctx, key, 1, time.Duration(gc.SlowModeSeconds)*time.Second)
+	if err != nil {
+		slog.Error("Failed to check slow mode", "error", err, "chatID", c.ID)
+		return helper.NewInternalServerError("")
+	}
+
+	if !allowed {
+		seconds := int(math.Ceil(ttl.Seconds()))
+		return helper.NewTooManyRequestsError(fmt.Sprintf("Slow mode is on. Please try again in %d seconds", seconds))
+	}
+
+	release := func() {
+		if err := s.repo.RateLimit.Release(context.WithoutCancel(ctx), key); err != nil {
+			slog.Error("Failed to release slow mode", "error", err, "chatID", c.ID)
+		}
+	}
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Commit(ctx, tx)
+			if err != nil {
+				release()
+			}
+			return err
+		})
+	})
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			// Rolling back a committed transaction fails and keeps the claim.
+			err := next.Rollback(ctx, tx)
+			if err == nil {
+				release()
+			}
+			return err
+		})
+	})
+
+	return nil
+}
+
+// userMessageTypes are the message types written by users, as opposed to
+// system messages.
+var userMessageTypes = []message.Type{
+	message.TypeRegular,
+	message.TypePoll,
+	message.TypeLocation,
+	message.TypeContact,
+}
+
+// isUserMessage reports whether messages of type t are written by users.
+func isUserMessage(t message.Type) bool {
+	return slices.Contains(userMessageTypes, t)
+}
+
+// checkSendRequest applies checkSendAccess and validates the reply target and
+// attachments of req without storing anything.
+func (s *MessageService) checkSendRequest(ctx context.Context, tx *ent.Tx, userID uuid.UUID, req model.SendMessageRequest) (*ent.Chat, string, error) {
+	chatInfo, senderRole, err := s.checkSendAccess(ctx, tx, userID, req.ChatID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if req.ReplyToID != nil {
+		replyMsgExists, err := tx.Message.Query().
+			Where(
+				message.ID(*req.ReplyToID),
+				message.ChatID(req.ChatID),
+				message.DeletedAtIsNil(),
+				message.TypeIn(userMessageTypes...),
+			).
+			Exist(ctx)
+
+		if err != nil {
+			slog.Error("Failed to check reply message existence", "error", err)
+			return nil, "", helper.NewInternalServerError("")
+		}
+		if !replyMsgExists {
+			return nil, "", helper.NewBadRequestError("Cannot reply to this message")
+		}
+	}
+
+	if len(req.AttachmentIDs) > 0 {
+		count, err := tx.Media.Query().
+			Where(
+				media.IDIn(req.AttachmentIDs...),
+				media.MessageIDIsNil(),
+				media.ScheduledMessageIDIsNil(),
+				media.CategoryIn(media.CategoryMessageAttachment, media.CategoryVoice),
+				media.UploadStatusEQ(media.UploadStatusCompleted),
+				media.HasUploaderWith(user.ID(userID)),
+			).
+			Count(ctx)
+
+		if err != nil {
+			slog.Error("Failed to count valid media", "error", err)
+			return nil, "", helper.NewInternalServerError("")
+		}
+
+		if count != len(req.AttachmentIDs) {
+			return nil, "", helper.NewBadRequestError("")
+		}
+	}
+
+	return chatInfo, senderRole, nil
+}
+
+// publishNewMessage loads a committed message for the response and broadcasts
+// it, along with the thread update of its root when it is a reply.
+func (s *MessageService) publishNewMessage(ctx context.Context, msg *ent.Message, userID uuid.UUID, senderRole string, threadRoot *ent.Message) (*model.MessageResponse, error) {
+	fullMsg, err := s.client.Message.Query().
+		Where(message.ID(msg.ID)).
+		WithSender(func(uq *ent.UserQuery) {
+			uq.WithAvatar()
+		}).
+		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
+		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
+			q.WithSender(func(uq *ent.UserQuery) {
+				uq.WithAvatar()
+			})
+			q.WithAttachments(func(aq *ent.MediaQuery) {
+				aq.Limit(1)
+			})
+		}).
+		Only(ctx)
+
+	if err != nil {
+		slog.Error("Failed to fetch full message for response", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	resp := helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole)
+	if err := s.applyPolls(ctx, uuid.Nil, resp); err != nil {
+		slog.Error("Failed to load poll", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil && resp != nil {
+		go s.wsHub.BroadcastToChat(msg.ChatID, websocket.Event{
+			Type:    websocket.EventMessageNew,
+			Payload: resp,
+			Meta: &websocket.EventMeta{
+				Timestamp: time.Now().UTC().UnixMilli(),
+				ChatID:    msg.ChatID,
+				SenderID:  userID,
+			},
+		})
+	}
+
+	if s.wsHub != nil && threadRoot != nil {
+		go s.broadcastThreadUpdate(threadRoot, userID)
+	}
+
+	if s.wsHub != nil && resp != nil && len(fullMsg.Edges.Mentions) > 0 {
+		s.publishMentions(ctx, fullMsg, resp, userID)
+	}
+
+	s.startLinkPreview(fullMsg, userID)
+
+	return resp, nil
+}
+
+// checkSendAccess loads a chat inside tx and applies the membership, block and
+// ban rules for posting a message to it. It also returns the sender's group role.
+func (s *MessageService) checkSendAccess(ctx context.Context, tx *ent.Tx, userID, chatID uuid.UUID) (*ent.Chat, string, error) {
+	var senderRole string
+
 	chatInfo, err := tx.Chat.Query().
 		Where(
-			chat.ID(req.ChatID),
+			chat.ID(chatID),
 			chat.DeletedAtIsNil(),
 		).
 		WithPrivateChat(func(q *ent.PrivateChatQuery) {
@@ -91,15 +462,17 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, helper.NewNotFoundError("Chat not found or deleted")
+			return nil, "", helper.NewNotFoundError("Chat not found or deleted")
 		}
-		slog.Error("Failed to query chat info", "error", err, "chatID", req.ChatID)
-		return nil, helper.NewInternalServerError("")
+		slog.Error("Failed to query chat info", "error", err, "chatID", chatID)
+		return nil, "", helper.NewInternalServerError("")
 	}
 
-	var senderRole string
-
-	if chatInfo.Type == chat.TypePrivate && chatInfo.Edges.PrivateChat != nil {
+	if chatInfo.Type == chat.TypePrivate && chatInfo.Edges.PrivateChat != nil && helper.IsSavedMessages(chatInfo.Edges.PrivateChat) {
+		if *chatInfo.Edges.PrivateChat.User1ID != userID {
+			return nil, "", helper.NewForbiddenError("")
+		}
+	} else if chatInfo.Type == chat.TypePrivate && chatInfo.Edges.PrivateChat != nil {
 		pc := chatInfo.Edges.PrivateChat
 		var otherUserID uuid.UUID
 		var otherUser *ent.User
@@ -115,20 +488,20 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 				otherUser = pc.Edges.User1
 			}
 		} else {
-			return nil, helper.NewForbiddenError("")
+			return nil, "", helper.NewForbiddenError("")
 		}
 
 		if otherUserID == uuid.Nil {
-			return nil, helper.NewForbiddenError("User does not exist")
+			return nil, "", helper.NewForbiddenError("User does not exist")
 		}
 
 		if otherUser != nil && otherUser.DeletedAt != nil {
-			return nil, helper.NewForbiddenError("User is deleted")
+			return nil, "", helper.NewForbiddenError("User is deleted")
 		}
 
 		if otherUser != nil && otherUser.IsBanned {
 			if otherUser.BannedUntil == nil || time.Now().UTC().Before(*otherUser.BannedUntil) {
-				return nil, helper.NewForbiddenError("User is currently suspended/banned")
+				return nil, "", helper.NewForbiddenError("User is currently suspended/banned")
 			}
 		}
 
@@ -148,88 +521,34 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 			Exist(ctx)
 		if err != nil {
 			slog.Error("Failed to check block status", "error", err)
-			return nil, helper.NewInternalServerError("")
+			return nil, "", helper.NewInternalServerError("")
 		}
 		if isBlocked {
-			return nil, helper.NewForbiddenError("")
+			return nil, "", helper.NewForbiddenError("")
 		}
 
 	} else if chatInfo.Type == chat.TypeGroup && chatInfo.Edges.GroupChat != nil {
 		if len(chatInfo.Edges.GroupChat.Edges.Members) == 0 {
-			return nil, helper.NewForbiddenError("")
+			return nil, "", helper.NewForbiddenError("")
 		}
 		senderRole = string(chatInfo.Edges.GroupChat.Edges.Members[0].Role)
 	} else {
-		return nil, helper.NewInternalServerError("")
-	}
-
-	if req.ReplyToID != nil {
-		replyMsgExists, err := tx.Message.Query().
-			Where(
-				message.ID(*req.ReplyToID),
-				message.ChatID(req.ChatID),
-				message.DeletedAtIsNil(),
-				message.TypeEQ(message.TypeRegular),
-			).
-			Exist(ctx)
-
-		if err != nil {
-			slog.Error("Failed to check reply message existence", "error", err)
-			return nil, helper.NewInternalServerError("")
-		}
-		if !replyMsgExists {
-			return nil, helper.NewBadRequestError("Cannot reply to this message")
-		}
-	}
-
-	if len(req.AttachmentIDs) > 0 {
-		count, err := tx.Media.Query().
-			Where(
-				media.IDIn(req.AttachmentIDs...),
-				media.MessageIDIsNil(),
-				media.CategoryEQ(media.CategoryMessageAttachment),
-				media.UploadStatusEQ(media.UploadStatusCompleted),
-				media.HasUploaderWith(user.ID(userID)),
-			).
-			Count(ctx)
-
-		if err != nil {
-			slog.Error("Failed to count valid media", "error", err)
-			return nil, helper.NewInternalServerError("")
-		}
-
-		if count != len(req.AttachmentIDs) {
-			return nil, helper.NewBadRequestError("")
-		}
+		return nil, "", helper.NewInternalServerError("")
 	}
 
-	msgCreate := tx.Message.Create().
-		SetChatID(req.ChatID).
-		SetSenderID(userID).
-		SetType(message.TypeRegular).
-		SetContent(req.Content)
-
-	if req.ReplyToID != nil {
-		msgCreate.SetReplyToID(*req.ReplyToID)
-	}
-
-	if len(req.AttachmentIDs) > 0 {
-		msgCreate.AddAttachmentIDs(req.AttachmentIDs...)
-	}
-
-	msg, err = msgCreate.Save(ctx)
-	if err != nil {
-		slog.Error("Failed to save message", "error", err)
-		return nil, helper.NewInternalServerError("")
-	}
+	return chatInfo, senderRole, nil
+}
 
-	err = tx.Chat.UpdateOne(chatInfo).
+// recordNewMessage moves the chat's last message pointer to msg and updates the
+// unread counters of every participant except the sender.
+func (s *MessageService) recordNewMessage(ctx context.Context, tx *ent.Tx, chatInfo *ent.Chat, msg *ent.Message, userID uuid.UUID) error {
+	err := tx.Chat.UpdateOne(chatInfo).
 		SetLastMessageID(msg.ID).
 		SetLastMessageAt(msg.CreatedAt).
 		Exec(ctx)
 	if err != nil {
 		slog.Error("Failed to update chat last message", "error", err)
-		return nil, helper.NewInternalServerError("")
+		return helper.NewInternalServerError("")
 	}
 
 	if chatInfo.Type == chat.TypePrivate && chatInfo.Edges.PrivateChat != nil {
@@ -238,17 +557,20 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 
 		if pc.User1ID != nil && *pc.User1ID == userID {
 			update.SetUser1LastReadAt(time.Now().UTC())
-			update.SetUser1UnreadCount(0)
-			update.AddUser2UnreadCount(1)
+			update.SetUser1UnreadCount(0).SetUser1MarkedUnread(false)
+			// Saved Messages has no other participant to count unread for.
+			if !helper.IsSavedMessages(pc) {
+				update.AddUser2UnreadCount(1)
+			}
 		} else {
 			update.SetUser2LastReadAt(time.Now().UTC())
-			update.SetUser2UnreadCount(0)
+			update.SetUser2UnreadCount(0).SetUser2MarkedUnread(false)
 			update.AddUser1UnreadCount(1)
 		}
 
 		if err := update.Exec(ctx); err != nil {
 			slog.Error("Failed to update private chat counters", "error", err)
-			return nil, helper.NewInternalServerError("")
+			return helper.NewInternalServerError("")
 		}
 	}
 
@@ -262,7 +584,7 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 			AddUnreadCount(1).
 			Exec(ctx); err != nil {
 			slog.Error("Failed to update group member counters", "error", err)
-			return nil, helper.NewInternalServerError("")
+			return helper.NewInternalServerError("")
 		}
 
 		if err := tx.GroupMember.Update().
@@ -271,54 +593,16 @@ func (s *MessageService) SendMessage(ctx context.Context, userID uuid.UUID, req
 				groupmember.UserID(userID),
 			).
 			SetUnreadCount(0).
+			SetMarkedUnread(false).
 			SetLastReadAt(time.Now().UTC()).
+			SetLastReadMessageID(msg.ID).
 			Exec(ctx); err != nil {
 			slog.Error("Failed to reset sender group member counter", "error", err)
-			return nil, helper.NewInternalServerError("")
+			return helper.NewInternalServerError("")
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		slog.Error("Failed to commit transaction", "error", err)
-		return nil, helper.NewInternalServerError("")
-	}
-
-	fullMsg, err := s.client.Message.Query().
-		Where(message.ID(msg.ID)).
-		WithSender(func(uq *ent.UserQuery) {
-			uq.WithAvatar()
-		}).
-		WithAttachments().
-		WithReplyTo(func(q *ent.MessageQuery) {
-			q.WithSender(func(uq *ent.UserQuery) {
-				uq.WithAvatar()
-			})
-			q.WithAttachments(func(aq *ent.MediaQuery) {
-				aq.Limit(1)
-			})
-		}).
-		Only(ctx)
-
-	if err != nil {
-		slog.Error("Failed to fetch full message for response", "error", err)
-		return nil, helper.NewInternalServerError("")
-	}
-
-	resp := helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole)
-
-	if s.wsHub != nil && resp != nil {
-		go s.wsHub.BroadcastToChat(req.ChatID, websocket.Event{
-			Type:    websocket.EventMessageNew,
-			Payload: resp,
-			Meta: &websocket.EventMeta{
-				Timestamp: time.Now().UTC().UnixMilli(),
-				ChatID:    req.ChatID,
-				SenderID:  userID,
-			},
-		})
-	}
-
-	return resp, nil
+	return nil
 }
 
 func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, messageID uuid.UUID, req model.EditMessageRequest) (*model.MessageResponse, error) {
@@ -348,6 +632,7 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 			q.WithGroupChat()
 		}).
 		WithAttachments().
+		WithMentions().
 		Only(ctx)
 
 	if err != nil {
@@ -374,15 +659,21 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 		return nil, helper.NewBadRequestError("Cannot edit a deleted message")
 	}
 
+	if msg.ForwardedFromSenderID != nil || msg.ForwardedFromChatID != nil {
+		return nil, helper.NewBadRequestError("Cannot edit a forwarded message")
+	}
+
 	if time.Since(msg.CreatedAt) > 15*time.Minute {
 		return nil, helper.NewBadRequestError("Message is too old to edit")
 	}
 
 	var senderRole string
+	var groupChat *ent.GroupChat
 	if msg.Edges.Chat.Type == chat.TypeGroup && msg.Edges.Chat.Edges.GroupChat != nil {
+		groupChat = msg.Edges.Chat.Edges.GroupChat
 		member, err := tx.GroupMember.Query().
 			Where(
-				groupmember.GroupChatID(msg.Edges.Chat.Edges.GroupChat.ID),
+				groupmember.GroupChatID(groupChat.ID),
 				groupmember.UserID(userID),
 			).
 			Only(ctx)
@@ -427,7 +718,15 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 				uq.WithAvatar()
 			}).
 			WithAttachments().
+			WithMentions().
+			WithForwardedFromSender(func(q *ent.UserQuery) {
+				q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+			}).
+			WithContactUser(func(q *ent.UserQuery) {
+				q.WithAvatar()
+			}).
 			WithReplyTo(func(q *ent.MessageQuery) {
+				q.WithContactUser()
 				q.WithSender(func(uq *ent.UserQuery) {
 					uq.WithAvatar()
 				})
@@ -442,7 +741,13 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 			return nil, helper.NewInternalServerError("")
 		}
 
-		return helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole), nil
+		resp := helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole)
+		if err := s.applyReactions(ctx, userID, resp); err != nil {
+			slog.Error("Failed to load message reactions", "error", err, "messageID", msg.ID)
+			return nil, helper.NewInternalServerError("")
+		}
+
+		return resp, nil
 	}
 
 	if len(toLink) > 0 {
@@ -450,6 +755,7 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 			Where(
 				media.IDIn(toLink...),
 				media.MessageIDIsNil(),
+				media.ScheduledMessageIDIsNil(),
 				media.Not(media.HasUserAvatar()),
 				media.Not(media.HasGroupAvatar()),
 				media.HasUploaderWith(user.ID(userID)),
@@ -466,10 +772,34 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 		}
 	}
 
+	// The replaced version is kept so moderators can judge what was originally sent.
+	previousAttachmentIDs := make([]uuid.UUID, 0, len(msg.Edges.Attachments))
+	for _, att := range msg.Edges.Attachments {
+		previousAttachmentIDs = append(previousAttachmentIDs, att.ID)
+	}
+	if err := tx.MessageRevision.Create().
+		SetMessageID(msg.ID).
+		SetNillableContent(msg.Content).
+		AddAttachmentIDs(previousAttachmentIDs...).
+		Exec(ctx); err != nil {
+		slog.Error("Failed to save message revision", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
+
 	update := tx.Message.UpdateOne(msg).
 		SetContent(req.Content).
 		SetEditedAt(time.Now().UTC())
 
+	// A preview for a URL that is still the first one is kept as is.
+	refreshPreview := false
+	if contentChanged {
+		previewURL := helper.ExtractFirstURL(req.Content)
+		if msg.LinkPreview == nil || msg.LinkPreview.URL != previewURL {
+			update.ClearLinkPreview()
+			refreshPreview = previewURL != ""
+		}
+	}
+
 	if len(toUnlink) > 0 {
 		update.RemoveAttachmentIDs(toUnlink...)
 	}
@@ -483,6 +813,14 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 		return nil, helper.NewInternalServerError("")
 	}
 
+	var newlyMentioned []uuid.UUID
+	if contentChanged && groupChat != nil {
+		newlyMentioned, err = s.replaceMentions(ctx, tx, groupChat.ID, msg, userID, senderRole)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		slog.Error("Failed to commit transaction", "error", err)
 		return nil, helper.NewInternalServerError("")
@@ -494,7 +832,15 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 			uq.WithAvatar()
 		}).
 		WithAttachments().
+		WithMentions().
+		WithForwardedFromSender(func(q *ent.UserQuery) {
+			q.Select(user.FieldID, user.FieldFullName, user.FieldDeletedAt)
+		}).
+		WithContactUser(func(q *ent.UserQuery) {
+			q.WithAvatar()
+		}).
 		WithReplyTo(func(q *ent.MessageQuery) {
+			q.WithContactUser()
 			q.WithSender(func(uq *ent.UserQuery) {
 				uq.WithAvatar()
 			})
@@ -510,17 +856,32 @@ func (s *MessageService) EditMessage(ctx context.Context, userID uuid.UUID, mess
 	}
 
 	resp := helper.ToMessageResponse(fullMsg, s.storageAdapter, nil, senderRole)
+	if err := s.applyReactions(ctx, userID, resp); err != nil {
+		slog.Error("Failed to load message reactions", "error", err, "messageID", msg.ID)
+		return nil, helper.NewInternalServerError("")
+	}
 
 	if s.wsHub != nil && resp != nil {
+		// The editor's own reactions are not shared with the other members.
+		eventPayload := *resp
+		eventPayload.MyReactions = nil
 		go s.wsHub.BroadcastToChat(msg.ChatID, websocket.Event{
 			Type:    websocket.EventMessageUpdate,
-			Payload: resp,
+			Payload: &eventPayload,
 			Meta: &websocket.EventMeta{
 				Timestamp: time.Now().UTC().UnixMilli(),
 				ChatID:    msg.ChatID,
 				SenderID:  userID,
 			},
 		})
+
+		if len(newlyMentioned) > 0 {
+			go s.broadcastMention(&eventPayload, userID, newlyMentioned)
+		}
+	}
+
+	if refreshPreview {
+		s.startLinkPreview(fullMsg, userID)
 	}
 
 	return resp, nil
@@ -652,36 +1013,7 @@ func (s *MessageService) GetMessages(ctx context.Context, userID uuid.UUID, req
 		}
 	}
 
-	senderRoleMap := make(map[uuid.UUID]string)
-	if chatInfo.Type == chat.TypeGroup && chatInfo.Edges.GroupChat != nil {
-		senderIDs := make([]uuid.UUID, 0)
-		seenSenders := make(map[uuid.UUID]bool)
-		for _, m := range messages {
-			if m.SenderID != nil && !seenSenders[*m.SenderID] {
-				senderIDs = append(senderIDs, *m.SenderID)
-				seenSenders[*m.SenderID] = true
-			}
-		}
-
-		if len(senderIDs) > 0 {
-			members, err := s.client.GroupMember.Query().
-				Where(
-					groupmember.GroupChatID(chatInfo.Edges.GroupChat.ID),
-					groupmember.UserIDIn(senderIDs...),
-					groupmember.HasUserWith(user.DeletedAtIsNil()),
-				).
-				Select(groupmember.FieldUserID, groupmember.FieldRole).
-				All(ctx)
-
-			if err == nil {
-				for _, m := range members {
-					senderRoleMap[m.UserID] = string(m.Role)
-				}
-			} else {
-				slog.Error("Failed to batch fetch sender roles", "error", err)
-			}
-		}
-	}
+	senderRoleMap := s.getSenderRoles(ctx, chatInfo, messages)
 
 	hasNext := false
 	var nextCursor string
@@ -783,13 +1115,28 @@ func (s *MessageService) GetMessages(ctx context.Context, userID uuid.UUID, req
 		}
 	}
 
+	responsePtrs := make([]*model.MessageResponse, len(response))
+	for i := range response {
+		responsePtrs[i] = &response[i]
+	}
+	if err := s.applyReactions(ctx, userID, responsePtrs...); err != nil {
+		slog.Error("Failed to load message reactions", "error", err, "chatID", req.ChatID)
+		return nil, "", false, "", false, helper.NewInternalServerError("")
+	}
+	if err := s.applyPolls(ctx, userID, responsePtrs...); err != nil {
+		slog.Error("Failed to load polls", "error", err, "chatID", req.ChatID)
+		return nil, "", false, "", false, helper.NewInternalServerError("")
+	}
+
 	return response, nextCursor, hasNext, prevCursor, hasPrev, nil
 }
 
 func (s *MessageService) DeleteMessage(ctx context.Context, userID uuid.UUID, messageID uuid.UUID) error {
 	msg, err := s.client.Message.Query().
 		Where(message.ID(messageID)).
-		WithChat().
+		WithChat(func(q *ent.ChatQuery) {
+			q.WithGroupChat()
+		}).
 		Only(ctx)
 
 	if err != nil {
@@ -825,7 +1172,7 @@ func (s *MessageService) DeleteMessage(ctx context.Context, userID uuid.UUID, me
 		return helper.NewForbiddenError("")
 	}
 
-	if msg.Type != message.TypeRegular {
+	if !isUserMessage(msg.Type) {
 		return helper.NewBadRequestError("Cannot delete this type of message")
 	}
 
@@ -833,7 +1180,14 @@ func (s *MessageService) DeleteMessage(ctx context.Context, userID uuid.UUID, me
 		return helper.NewBadRequestError("Message already deleted")
 	}
 
-	err = s.client.Message.UpdateOne(msg).
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	err = tx.Message.UpdateOne(msg).
 		SetDeletedAt(time.Now().UTC()).
 		ClearContent().
 		ClearAttachments().
@@ -844,6 +1198,40 @@ func (s *MessageService) DeleteMessage(ctx context.Context, userID uuid.UUID, me
 		return helper.NewInternalServerError("")
 	}
 
+	if _, err := tx.MessageReaction.Delete().Where(messagereaction.MessageID(messageID)).Exec(ctx); err != nil {
+		slog.Error("Failed to delete message reactions", "error", err, "messageID", messageID)
+		return helper.NewInternalServerError("")
+	}
+
+	if _, err := tx.PinnedMessage.Delete().Where(pinnedmessage.MessageID(messageID)).Exec(ctx); err != nil {
+		slog.Error("Failed to delete message pins", "error", err, "messageID", messageID)
+		return helper.NewInternalServerError("")
+	}
+
+	if gc := msg.Edges.Chat.Edges.GroupChat; gc != nil {
+		mentioned, err := clearMentions(ctx, tx, gc.ID, msg)
+		if err != nil {
+			return err
+		}
+		if err := addUnreadMentions(ctx, tx, gc.ID, msg, mentioned, -1); err != nil {
+			return err
+		}
+	}
+
+	var threadRoot *ent.Message
+	if msg.ReplyToID != nil {
+		threadRoot, err = s.refreshThreadStats(ctx, tx, *msg.ReplyToID)
+		if err != nil {
+			slog.Error("Failed to update thread stats", "error", err, "messageID", *msg.ReplyToID)
+			return helper.NewInternalServerError("")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return helper.NewInternalServerError("")
+	}
+
 	if s.wsHub != nil {
 		go s.wsHub.BroadcastToChat(msg.ChatID, websocket.Event{
 			Type: websocket.EventMessageDelete,
@@ -858,5 +1246,9 @@ func (s *MessageService) DeleteMessage(ctx context.Context, userID uuid.UUID, me
 		})
 	}
 
+	if s.wsHub != nil && threadRoot != nil {
+		go s.broadcastThreadUpdate(threadRoot, userID)
+	}
+
 	return nil
 }
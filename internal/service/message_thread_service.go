@@ -0,0 +1,186 @@
+package service
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/base64"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (s *MessageService) GetThread(ctx context.Context, userID uuid.UUID, messageID uuid.UUID, req model.GetThreadRequest) ([]model.MessageResponse, string, bool, error) {
+	if err := s.validator.Struct(req); err != nil {
+		return nil, "", false, helper.NewBadRequestError("")
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+
+	var cursorID uuid.UUID
+	if req.Cursor != "" {
+		decodedBytes, err := base64.URLEncoding.DecodeString(req.Cursor)
+		if err != nil {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+		cursorID, err = uuid.Parse(string(decodedBytes))
+		if err != nil {
+			return nil, "", false, helper.NewBadRequestError("Invalid cursor format")
+		}
+	}
+
+	root, err := s.getAccessibleMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	replies, err := s.repo.Message.GetThreadReplies(ctx, root.ID, cursorID, req.Limit)
+	if err != nil {
+		slog.Error("Failed to get thread replies", "error", err, "messageID", messageID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	hasNext := false
+	if len(replies) > req.Limit {
+		hasNext = true
+		replies = replies[:req.Limit]
+	}
+
+	var nextCursor string
+	if hasNext && len(replies) > 0 {
+		nextCursor = base64.URLEncoding.EncodeToString([]byte(replies[len(replies)-1].ID.String()))
+	}
+
+	senderRoleMap := s.getSenderRoles(ctx, root.Edges.Chat, replies)
+
+	response := make([]model.MessageResponse, 0, len(replies))
+	for _, reply := range replies {
+		var role string
+		if reply.SenderID != nil {
+			role = senderRoleMap[*reply.SenderID]
+		}
+		if resp := helper.ToMessageResponse(reply, s.storageAdapter, nil, role); resp != nil {
+			response = append(response, *resp)
+		}
+	}
+
+	responsePtrs := make([]*model.MessageResponse, len(response))
+	for i := range response {
+		responsePtrs[i] = &response[i]
+	}
+	if err := s.applyReactions(ctx, userID, responsePtrs...); err != nil {
+		slog.Error("Failed to load message reactions", "error", err, "messageID", messageID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+	if err := s.applyPolls(ctx, userID, responsePtrs...); err != nil {
+		slog.Error("Failed to load polls", "error", err, "messageID", messageID)
+		return nil, "", false, helper.NewInternalServerError("")
+	}
+
+	return response, nextCursor, hasNext, nil
+}
+
+// getSenderRoles maps the senders of messages in a group chat to their current role.
+func (s *MessageService) getSenderRoles(ctx context.Context, c *ent.Chat, messages []*ent.Message) map[uuid.UUID]string {
+	senderRoleMap := make(map[uuid.UUID]string)
+	if c == nil || c.Type != chat.TypeGroup || c.Edges.GroupChat == nil {
+		return senderRoleMap
+	}
+
+	senderIDs := make([]uuid.UUID, 0)
+	seenSenders := make(map[uuid.UUID]bool)
+	for _, m := range messages {
+		if m.SenderID != nil && !seenSenders[*m.SenderID] {
+			senderIDs = append(senderIDs, *m.SenderID)
+			seenSenders[*m.SenderID] = true
+		}
+	}
+
+	if len(senderIDs) == 0 {
+		return senderRoleMap
+	}
+
+	members, err := s.client.GroupMember.Query().
+		Where(
+			groupmember.GroupChatID(c.Edges.GroupChat.ID),
+			groupmember.UserIDIn(senderIDs...),
+			groupmember.HasUserWith(user.DeletedAtIsNil()),
+		).
+		Select(groupmember.FieldUserID, groupmember.FieldRole).
+		All(ctx)
+	if err != nil {
+		slog.Error("Failed to batch fetch sender roles", "error", err)
+		return senderRoleMap
+	}
+
+	for _, m := range members {
+		senderRoleMap[m.UserID] = string(m.Role)
+	}
+
+	return senderRoleMap
+}
+
+// refreshThreadStats recomputes reply_count and last_reply_at of a thread root
+// from its remaining non-deleted replies.
+func (s *MessageService) refreshThreadStats(ctx context.Context, tx *ent.Tx, rootID uuid.UUID) (*ent.Message, error) {
+	count, err := tx.Message.Query().
+		Where(
+			message.ReplyToID(rootID),
+			message.DeletedAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := tx.Message.UpdateOneID(rootID).SetReplyCount(count)
+
+	latest, err := tx.Message.Query().
+		Where(
+			message.ReplyToID(rootID),
+			message.DeletedAtIsNil(),
+		).
+		Order(ent.Desc(message.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if latest != nil {
+		update.SetLastReplyAt(latest.CreatedAt)
+	} else {
+		update.ClearLastReplyAt()
+	}
+
+	return update.Save(ctx)
+}
+
+func (s *MessageService) broadcastThreadUpdate(root *ent.Message, actorID uuid.UUID) {
+	payload := model.MessageThreadUpdate{
+		MessageID:  root.ID,
+		ChatID:     root.ChatID,
+		ReplyCount: root.ReplyCount,
+	}
+	if root.LastReplyAt != nil {
+		t := root.LastReplyAt.Format(time.RFC3339)
+		payload.LastReplyAt = &t
+	}
+
+	s.wsHub.BroadcastToChat(root.ChatID, websocket.Event{
+		Type:    websocket.EventMessageThreadUpdate,
+		Payload: payload,
+		Meta: &websocket.EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			ChatID:    root.ChatID,
+			SenderID:  actorID,
+		},
+	})
+}
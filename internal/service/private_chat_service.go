@@ -77,7 +77,7 @@ func (s *PrivateChatService) CreatePrivateChat(ctx context.Context, userID uuid.
 	}
 
 	if userID == req.TargetUserID {
-		return nil, helper.NewBadRequestError("Cannot chat with yourself")
+		return s.getOrCreateSavedMessages(ctx, userID)
 	}
 
 	users, err := s.client.User.Query().
@@ -264,3 +264,72 @@ func (s *PrivateChatService) CreatePrivateChat(ctx context.Context, userID uuid.
 		CreatedAt: newChat.CreatedAt.Format(time.RFC3339),
 	}, nil
 }
+
+// getOrCreateSavedMessages returns the self-chat of userID, creating it the first
+// time the user opens it. The chat starts with a last message time so it is
+// listed before anything is saved to it.
+func (s *PrivateChatService) getOrCreateSavedMessages(ctx context.Context, userID uuid.UUID) (*model.ChatResponse, error) {
+	existingChat, err := s.findExistingPrivateChat(ctx, userID, userID)
+	if err == nil {
+		return privateChatToResponse(existingChat), nil
+	} else if !ent.IsNotFound(err) {
+		slog.Error("Failed to check existing saved messages chat", "error", err, "userID", userID)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		slog.Error("Failed to start transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+	defer tx.Rollback()
+
+	newChat, err := tx.Chat.Create().
+		SetType(chat.TypePrivate).
+		SetLastMessageAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to create chat", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	_, err = tx.PrivateChat.Create().
+		SetChat(newChat).
+		SetUser1ID(userID).
+		SetUser2ID(userID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			if existing, findErr := s.findExistingPrivateChat(ctx, userID, userID); findErr == nil {
+				return privateChatToResponse(existing), nil
+			}
+			return nil, helper.NewConflictError("Saved messages chat already exists")
+		}
+		slog.Error("Failed to create saved messages chat", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.Error("Failed to commit transaction", "error", err)
+		return nil, helper.NewInternalServerError("")
+	}
+
+	if s.wsHub != nil {
+		go s.wsHub.BroadcastToUser(userID, websocket.Event{
+			Type: websocket.EventChatNew,
+			Payload: model.ChatListResponse{
+				ID:              newChat.ID,
+				Type:            string(newChat.Type),
+				Name:            helper.SavedMessagesName,
+				IsSavedMessages: true,
+			},
+			Meta: &websocket.EventMeta{Timestamp: time.Now().UTC().UnixMilli(), ChatID: newChat.ID, SenderID: userID},
+		})
+	}
+
+	return &model.ChatResponse{
+		ID:        newChat.ID,
+		Type:      string(newChat.Type),
+		CreatedAt: newChat.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
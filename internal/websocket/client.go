@@ -14,6 +14,7 @@ const (
 	pongWait       = 60 * time.Second
 	pingPeriod     = (pongWait * 9) / 10
 	maxMessageSize = 512
+	maxCommandSize = 32 * 1024 // room for a command carrying a full message
 )
 
 type Client struct {
@@ -21,6 +22,10 @@ type Client struct {
 	Conn   *websocket.Conn
 	Send   chan []byte
 	UserID uuid.UUID
+	// Token is the session token the connection was opened with. Commands
+	// re-verify it, so a revoked session cannot keep acting over the socket.
+	Token    string
+	Commands CommandHandler
 }
 
 func (c *Client) ReadPump() {
@@ -28,7 +33,9 @@ func (c *Client) ReadPump() {
 		c.Hub.Unregister <- c
 		c.Conn.Close()
 	}()
-	c.Conn.SetReadLimit(maxMessageSize)
+	// The connection accepts frames up to the command size; any other frame
+	// above maxMessageSize closes it.
+	c.Conn.SetReadLimit(maxCommandSize)
 	c.Conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.Conn.SetPongHandler(func(string) error {
 		c.Conn.SetReadDeadline(time.Now().Add(pongWait))
@@ -47,6 +54,9 @@ func (c *Client) ReadPump() {
 
 		var event Event
 		if err := json.Unmarshal(message, &event); err == nil {
+			if len(message) > maxMessageSize && event.Type != EventCommand {
+				break
+			}
 
 			if event.Type == EventTyping {
 
@@ -55,7 +65,13 @@ func (c *Client) ReadPump() {
 					event.Meta.Timestamp = time.Now().UTC().UnixMilli()
 					c.Hub.BroadcastToChat(event.Meta.ChatID, event)
 				}
+			} else if event.Type == EventMessageDelivered {
+				c.Hub.MarkDelivered(c.UserID, event)
+			} else if event.Type == EventCommand {
+				c.handleCommand(message)
 			}
+		} else if len(message) > maxMessageSize {
+			break
 		}
 	}
 }
@@ -0,0 +1,120 @@
+package websocket
+
+import (
+	"AtoiTalkAPI/internal/helper"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	commandTimeout         = 15 * time.Second
+	maxCommandRequestIDLen = 64
+)
+
+// Commands a client can send in a command frame.
+const (
+	CommandMessageSend   = "message.send"
+	CommandMessageEdit   = "message.edit"
+	CommandMessageDelete = "message.delete"
+	CommandChatRead      = "chat.read"
+	CommandChatHide      = "chat.hide"
+)
+
+// CommandFrame is a request sent by a client over the socket. The server
+// answers every command frame with exactly one command.ack or command.error
+// event carrying the same request_id.
+type CommandFrame struct {
+	Type      EventType       `json:"type"`
+	RequestID string          `json:"request_id"`
+	Command   string          `json:"command"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// CommandError is the payload of a command.error event. Code mirrors the HTTP
+// status the matching REST route would have returned.
+type CommandError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// CommandHandler runs a command on behalf of userID, who connected with the
+// session token, and returns the payload of the command.ack event. A 401 error
+// means the session is no longer valid.
+type CommandHandler interface {
+	HandleCommand(ctx context.Context, userID uuid.UUID, token string, command string, payload json.RawMessage) (interface{}, error)
+}
+
+// handleCommand runs a command frame synchronously, so commands from one
+// connection are applied in the order they were sent.
+func (c *Client) handleCommand(data []byte) {
+	var frame CommandFrame
+	if err := json.Unmarshal(data, &frame); err != nil {
+		return
+	}
+
+	if frame.RequestID == "" || len(frame.RequestID) > maxCommandRequestIDLen {
+		c.replyCommandError(frame.RequestID, helper.NewBadRequestError("Invalid request_id"))
+		return
+	}
+
+	if c.Commands == nil {
+		c.replyCommandError(frame.RequestID, helper.NewBadRequestError("Unknown command"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	result, err := c.Commands.HandleCommand(ctx, c.UserID, c.Token, frame.Command, frame.Payload)
+	if err != nil {
+		c.replyCommandError(frame.RequestID, err)
+
+		// The session behind the connection was revoked. Unregistering closes
+		// the connection once the error above has been written.
+		var appErr *helper.AppError
+		if errors.As(err, &appErr) && appErr.Code == http.StatusUnauthorized {
+			c.Hub.Unregister <- c
+		}
+		return
+	}
+
+	c.Hub.SendToClient(c, Event{
+		Type:      EventCommandAck,
+		RequestID: frame.RequestID,
+		Payload:   result,
+		Meta: &EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+		},
+	})
+}
+
+func (c *Client) replyCommandError(requestID string, err error) {
+	var appErr *helper.AppError
+	if !errors.As(err, &appErr) {
+		slog.Error("WebSocket command failed", "error", err, "userID", c.UserID)
+		appErr = helper.NewInternalServerError("")
+	}
+
+	// An oversized ID is not echoed back.
+	if len(requestID) > maxCommandRequestIDLen {
+		requestID = ""
+	}
+
+	c.Hub.SendToClient(c, Event{
+		Type:      EventCommandError,
+		RequestID: requestID,
+		Payload: CommandError{
+			Code:    appErr.Code,
+			Message: appErr.Message,
+		},
+		Meta: &EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+		},
+	})
+}
@@ -9,12 +9,24 @@ const (
 	EventMessageUpdate EventType = "message.update"
 	EventMessageDelete EventType = "message.delete"
 
-	EventChatNew    EventType = "chat.new"
-	EventChatRead   EventType = "chat.read"
-	EventChatHide   EventType = "chat.hide"
-	EventChatDelete EventType = "chat.delete"
-	EventChatUpdate EventType = "chat.update"
-	EventTyping     EventType = "chat.typing"
+	EventMessageReaction     EventType = "message.reaction"
+	EventMessageThreadUpdate EventType = "message.thread_update"
+	EventMessageDelivered    EventType = "message.delivered"
+	EventMessageMention      EventType = "message.mention"
+	EventMessageLocation     EventType = "message.location"
+
+	EventChatNew         EventType = "chat.new"
+	EventChatRead        EventType = "chat.read"
+	EventChatHide        EventType = "chat.hide"
+	EventChatArchive     EventType = "chat.archive"
+	EventChatPin         EventType = "chat.pin"
+	EventChatMute        EventType = "chat.mute"
+	EventChatFolder      EventType = "chat.folder"
+	EventChatJoinRequest EventType = "chat.join_request"
+	EventChatDelete      EventType = "chat.delete"
+	EventChatUpdate      EventType = "chat.update"
+	EventChatExport      EventType = "chat.export"
+	EventTyping          EventType = "chat.typing"
 
 	EventUserOnline   EventType = "user.online"
 	EventUserOffline  EventType = "user.offline"
@@ -24,12 +36,19 @@ const (
 	EventUserBanned   EventType = "user.banned"
 	EventUserUnbanned EventType = "user.unbanned"
 	EventUserDeleted  EventType = "user.deleted"
+
+	EventCommand      EventType = "command"
+	EventCommandAck   EventType = "command.ack"
+	EventCommandError EventType = "command.error"
 )
 
 type Event struct {
 	Type    EventType   `json:"type"`
 	Payload interface{} `json:"payload"`
 	Meta    *EventMeta  `json:"meta,omitempty"`
+
+	// Echoes the request_id of a command frame, only set on command.ack and command.error
+	RequestID string `json:"request_id,omitempty"`
 }
 
 type EventMeta struct {
@@ -37,4 +56,7 @@ type EventMeta struct {
 	ChatID      uuid.UUID `json:"chat_id,omitempty"`
 	SenderID    uuid.UUID `json:"sender_id,omitempty"`
 	UnreadCount int       `json:"unread_count"`
+
+	// Set when the recipient muted the chat, so clients can skip the alert
+	Muted bool `json:"muted,omitempty"`
 }
@@ -8,6 +8,7 @@ import (
 	"AtoiTalkAPI/ent/privatechat"
 	"AtoiTalkAPI/ent/userblock"
 	"AtoiTalkAPI/internal/adapter"
+	"AtoiTalkAPI/internal/helper"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -43,7 +44,17 @@ type redisPayload struct {
 }
 
 func NewHub(db *ent.Client, redis *adapter.RedisAdapter) *Hub {
-	hub := &Hub{
+	hub := NewPublisherHub(db, redis)
+
+	go hub.listenToRedis()
+	return hub
+}
+
+// NewPublisherHub returns a hub for processes without client connections, such
+// as the scheduler. Events are only published to Redis for the API instances to
+// deliver; the hub does not subscribe to them itself and Run is not needed.
+func NewPublisherHub(db *ent.Client, redis *adapter.RedisAdapter) *Hub {
+	return &Hub{
 		clients:     make(map[*Client]bool),
 		userClients: make(map[uuid.UUID]map[*Client]bool),
 		Register:    make(chan *Client, 256),
@@ -51,9 +62,6 @@ func NewHub(db *ent.Client, redis *adapter.RedisAdapter) *Hub {
 		db:          db,
 		redis:       redis,
 	}
-
-	go hub.listenToRedis()
-	return hub
 }
 
 func (h *Hub) listenToRedis() {
@@ -92,6 +100,32 @@ func (h *Hub) deliverToLocalClients(userID uuid.UUID, data []byte) {
 	}
 }
 
+// SendToClient delivers event to a single connection of this instance, such as
+// the reply to a command. It is dropped when the connection is already gone.
+func (h *Hub) SendToClient(client *Client, event Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		slog.Error("Failed to marshal event for client", "error", err)
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if _, ok := h.clients[client]; !ok {
+		return
+	}
+
+	select {
+	case client.Send <- data:
+	default:
+		select {
+		case h.Unregister <- client:
+		default:
+		}
+	}
+}
+
 func (h *Hub) Run() {
 	for {
 		select {
@@ -254,7 +288,7 @@ func (h *Hub) fetchAndCacheMembers(chatID uuid.UUID) []uuid.UUID {
 			if pc.User1ID != nil {
 				userIDs = append(userIDs, *pc.User1ID)
 			}
-			if pc.User2ID != nil {
+			if pc.User2ID != nil && !helper.IsSavedMessages(pc) {
 				userIDs = append(userIDs, *pc.User2ID)
 			}
 		}
@@ -317,18 +351,23 @@ func (h *Hub) broadcastHeavy(chatID uuid.UUID, event Event) {
 	}
 
 	memberUnreadMap := make(map[uuid.UUID]int)
+	memberMutedMap := make(map[uuid.UUID]bool)
+	now := time.Now()
 
 	if c.Type == chat.TypePrivate && c.Edges.PrivateChat != nil {
 		pc := c.Edges.PrivateChat
 		if pc.User1ID != nil {
 			memberUnreadMap[*pc.User1ID] = pc.User1UnreadCount
+			memberMutedMap[*pc.User1ID] = helper.IsChatMuted(pc.User1Muted, pc.User1MutedUntil, now)
 		}
-		if pc.User2ID != nil {
+		if pc.User2ID != nil && !helper.IsSavedMessages(pc) {
 			memberUnreadMap[*pc.User2ID] = pc.User2UnreadCount
+			memberMutedMap[*pc.User2ID] = helper.IsChatMuted(pc.User2Muted, pc.User2MutedUntil, now)
 		}
 	} else if c.Type == chat.TypeGroup && c.Edges.GroupChat != nil {
 		for _, m := range c.Edges.GroupChat.Edges.Members {
 			memberUnreadMap[m.UserID] = m.UnreadCount
+			memberMutedMap[m.UserID] = helper.IsChatMuted(m.Muted, m.MutedUntil, now)
 		}
 	}
 
@@ -368,10 +407,12 @@ func (h *Hub) broadcastHeavy(chatID uuid.UUID, event Event) {
 		if event.Meta != nil {
 			newMeta := *event.Meta
 			newMeta.UnreadCount = unreadCount
+			newMeta.Muted = memberMutedMap[uid]
 			personalEvent.Meta = &newMeta
 		} else {
 			personalEvent.Meta = &EventMeta{
 				UnreadCount: unreadCount,
+				Muted:       memberMutedMap[uid],
 			}
 		}
 
@@ -415,6 +456,9 @@ func (h *Hub) fetchAndCacheContacts(userID uuid.UUID) []uuid.UUID {
 
 	targetUserIDs := make([]uuid.UUID, 0, len(chats))
 	for _, pc := range chats {
+		if helper.IsSavedMessages(pc) {
+			continue
+		}
 		if pc.User1ID != nil && *pc.User1ID == userID {
 			if pc.User2ID != nil {
 				targetUserIDs = append(targetUserIDs, *pc.User2ID)
@@ -0,0 +1,91 @@
+package websocket
+
+import (
+	"AtoiTalkAPI/ent"
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupchat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MarkDelivered handles a client acknowledging a message.new event. The payload
+// carries the acknowledged message_id. The member's delivered position only
+// moves forward, and the message sender is told about it with a
+// message.delivered event. Acks for private chats are ignored.
+func (h *Hub) MarkDelivered(userID uuid.UUID, event Event) {
+	payload, ok := event.Payload.(map[string]interface{})
+	if !ok {
+		return
+	}
+	rawID, _ := payload["message_id"].(string)
+	messageID, err := uuid.Parse(rawID)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	msg, err := h.db.Message.Query().
+		Where(
+			message.ID(messageID),
+			message.DeletedAtIsNil(),
+			message.HasChatWith(
+				chat.TypeEQ(chat.TypeGroup),
+				chat.DeletedAtIsNil(),
+			),
+		).
+		Select(message.FieldID, message.FieldChatID, message.FieldSenderID, message.FieldCreatedAt).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("Failed to query delivered message", "error", err, "messageID", messageID)
+		}
+		return
+	}
+
+	if msg.SenderID == nil || *msg.SenderID == userID {
+		return
+	}
+
+	updated, err := h.db.GroupMember.Update().
+		Where(
+			groupmember.UserID(userID),
+			groupmember.HasGroupChatWith(groupchat.ChatID(msg.ChatID)),
+			groupmember.Or(
+				groupmember.LastDeliveredMessageIDIsNil(),
+				groupmember.HasLastDeliveredMessageWith(message.Or(
+					message.CreatedAtLT(msg.CreatedAt),
+					message.And(message.CreatedAtEQ(msg.CreatedAt), message.IDLT(msg.ID)),
+				)),
+			),
+		).
+		SetLastDeliveredMessageID(msg.ID).
+		Save(ctx)
+	if err != nil {
+		slog.Error("Failed to record message delivery", "error", err, "messageID", messageID, "userID", userID)
+		return
+	}
+	if updated == 0 {
+		return
+	}
+
+	h.BroadcastToUser(*msg.SenderID, Event{
+		Type: EventMessageDelivered,
+		Payload: map[string]interface{}{
+			"chat_id":    msg.ChatID,
+			"message_id": msg.ID,
+			"user_id":    userID,
+		},
+		Meta: &EventMeta{
+			Timestamp: time.Now().UTC().UnixMilli(),
+			ChatID:    msg.ChatID,
+			SenderID:  userID,
+		},
+	})
+}
@@ -0,0 +1,169 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func listTestChatIDs(t *testing.T, token string, archived bool) []string {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats?archived=%t", archived), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseWithPagination
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	var ids []string
+	for _, c := range resp.Data.([]interface{}) {
+		ids = append(ids, c.(map[string]interface{})["id"].(string))
+	}
+	return ids
+}
+
+func getTestArchiveSummary(t *testing.T, token string) map[string]interface{} {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", "/api/chats/archive", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})
+}
+
+func TestChatArchive(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "archive1")
+	u2 := createTestUser(t, "archive2")
+	outsider := createTestUser(t, "archiveout")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	outsiderToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, outsider.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Archive Group").SetInviteCode("archinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	sendTestMessage(t, token2, privateChat.ID, "Hello private")
+	sendTestMessage(t, token2, groupChat.ID, "Hello group")
+
+	t.Run("Fail - Archive Unknown Or Foreign Chat", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/archive", uuid.New()), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusNotFound, executeRequest(req).Code)
+
+		req, _ = http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/archive", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+outsiderToken)
+		assert.Equal(t, http.StatusForbidden, executeRequest(req).Code)
+
+		req, _ = http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/archive", privateChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+outsiderToken)
+		assert.Equal(t, http.StatusForbidden, executeRequest(req).Code)
+	})
+
+	t.Run("Success - Archive Moves Chat Out Of Inbox", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/archive", privateChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, true, data["is_archived"])
+		assert.Equal(t, false, data["keep_archived"])
+		assert.Equal(t, float64(1), data["unread_count"])
+
+		assert.NotContains(t, listTestChatIDs(t, token1, false), privateChat.ID.String())
+		assert.Contains(t, listTestChatIDs(t, token1, true), privateChat.ID.String())
+		assert.Contains(t, listTestChatIDs(t, token2, false), privateChat.ID.String(), "Archiving is per participant")
+	})
+
+	t.Run("Success - Keep Archived Group And Summary", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", fmt.Sprintf("/api/chats/%s/archive", groupChat.ID), token1, model.ArchiveChatRequest{
+			KeepArchived: true,
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		sendTestMessage(t, token2, groupChat.ID, "Still archived?")
+
+		ids := listTestChatIDs(t, token1, true)
+		assert.Contains(t, ids, groupChat.ID.String())
+		assert.Contains(t, ids, privateChat.ID.String())
+
+		summary := getTestArchiveSummary(t, token1)
+		if summary != nil {
+			assert.Equal(t, float64(2), summary["chat_count"])
+			assert.Equal(t, float64(2), summary["unread_chat_count"])
+			assert.Equal(t, float64(3), summary["unread_count"])
+		}
+	})
+
+	t.Run("Success - New Message Unarchives Chat", func(t *testing.T) {
+		sendTestMessage(t, token2, privateChat.ID, "Come back")
+
+		assert.Contains(t, listTestChatIDs(t, token1, false), privateChat.ID.String())
+		assert.NotContains(t, listTestChatIDs(t, token1, true), privateChat.ID.String())
+
+		summary := getTestArchiveSummary(t, token1)
+		if summary != nil {
+			assert.Equal(t, float64(1), summary["chat_count"])
+		}
+	})
+
+	t.Run("Success - Unarchive", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/unarchive", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, false, resp.Data.(map[string]interface{})["is_archived"])
+
+		assert.Contains(t, listTestChatIDs(t, token1, false), groupChat.ID.String())
+		assert.Empty(t, listTestChatIDs(t, token1, true))
+
+		summary := getTestArchiveSummary(t, token1)
+		if summary != nil {
+			assert.Equal(t, float64(0), summary["chat_count"])
+			assert.Equal(t, float64(0), summary["unread_count"])
+		}
+	})
+}
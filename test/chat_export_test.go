@@ -0,0 +1,241 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/chatexport"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/websocket"
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func requestChatExport(t *testing.T, token string, chatID uuid.UUID) (int, map[string]interface{}) {
+	t.Helper()
+
+	req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/exports", chatID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func getChatExport(t *testing.T, token string, exportID string) (int, map[string]interface{}) {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", "/api/chats/exports/"+exportID, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func TestChatExport(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "export1")
+	u2 := createTestUser(t, "export2")
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	now := time.Now().UTC()
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().
+		SetChat(privateChat).
+		SetUser1(u1).
+		SetUser2(u2).
+		SetUser1HiddenAt(now.Add(-time.Hour)).
+		SaveX(ctx)
+
+	testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetContent("before hide").SetCreatedAt(now.Add(-2 * time.Hour)).SaveX(ctx)
+	testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetContent("deleted one").SetDeletedAt(now).SaveX(ctx)
+	testClient.Message.Create().SetChat(privateChat).SetSender(u1).SetContent("hello <b>there</b>").SaveX(ctx)
+
+	att := testClient.Media.Create().
+		SetFileName("export_attachment.txt").
+		SetOriginalName("notes.txt").
+		SetFileSize(13).
+		SetMimeType("text/plain").
+		SetUploaderID(u2.ID).
+		SaveX(ctx)
+	s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(testConfig.S3BucketPrivate),
+		Key:    aws.String(att.FileName),
+		Body:   bytes.NewReader([]byte("exported file")),
+	})
+	testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetContent("see attached").AddAttachments(att).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Export Group").SetInviteCode("exportgroup").SaveX(ctx)
+	owner := testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	var exportID string
+
+	t.Run("Fail - Group Member Is Not Owner", func(t *testing.T) {
+		code, _ := requestChatExport(t, token2, groupChat.ID)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Success - Request Export", func(t *testing.T) {
+		code, data := requestChatExport(t, token1, privateChat.ID)
+		if !assert.Equal(t, http.StatusOK, code) || data == nil {
+			return
+		}
+		exportID = data["id"].(string)
+		assert.Equal(t, "pending", data["status"])
+		assert.Nil(t, data["download_url"])
+	})
+
+	t.Run("Fail - Export Already In Progress", func(t *testing.T) {
+		code, _ := requestChatExport(t, token1, privateChat.ID)
+		assert.Equal(t, http.StatusConflict, code)
+	})
+
+	t.Run("Success - Process And Download", func(t *testing.T) {
+		if exportID == "" {
+			t.Skip("export was not created")
+		}
+
+		server := httptest.NewServer(testRouter)
+		defer server.Close()
+
+		conn, _, err := ws.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws?token="+token1, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		time.Sleep(200 * time.Millisecond)
+
+		assert.NoError(t, testChatService.ProcessChatExport(ctx, uuid.MustParse(exportID)))
+
+		event := waitForEvent(t, conn, websocket.EventChatExport, 3*time.Second)
+		if assert.NotNil(t, event) {
+			payload := event.Payload.(map[string]interface{})
+			assert.Equal(t, "completed", payload["status"])
+			assert.NotEmpty(t, payload["download_url"])
+		}
+
+		code, data := getChatExport(t, token1, exportID)
+		if !assert.Equal(t, http.StatusOK, code) || data == nil {
+			return
+		}
+		assert.Equal(t, "completed", data["status"])
+		assert.Equal(t, float64(2), data["message_count"])
+		assert.NotEmpty(t, data["download_url"])
+		assert.NotNil(t, data["expires_at"])
+
+		exp := testClient.ChatExport.GetX(ctx, uuid.MustParse(exportID))
+		reader, err := testStorageAdapter.Open(ctx, *exp.FileName, false)
+		if !assert.NoError(t, err) {
+			return
+		}
+		archive, _ := io.ReadAll(reader)
+		reader.Close()
+
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		files := make(map[string]string)
+		for _, f := range zr.File {
+			rc, _ := f.Open()
+			content, _ := io.ReadAll(rc)
+			rc.Close()
+			files[f.Name] = string(content)
+		}
+
+		var doc struct {
+			Chat     map[string]interface{}   `json:"chat"`
+			Messages []map[string]interface{} `json:"messages"`
+		}
+		if assert.NoError(t, json.Unmarshal([]byte(files["messages.json"]), &doc)) && assert.Len(t, doc.Messages, 2) {
+			assert.Equal(t, "hello <b>there</b>", doc.Messages[0]["content"])
+			assert.Equal(t, "see attached", doc.Messages[1]["content"])
+			assert.Equal(t, *u2.FullName, doc.Chat["name"])
+		}
+
+		transcript := files["transcript.html"]
+		assert.Contains(t, transcript, "hello &lt;b&gt;there&lt;/b&gt;")
+		assert.NotContains(t, transcript, "before hide")
+		assert.NotContains(t, transcript, "deleted one")
+
+		attachmentPath := fmt.Sprintf("attachments/%s-notes.txt", att.ID)
+		assert.Equal(t, "exported file", files[attachmentPath])
+		assert.Contains(t, transcript, attachmentPath)
+	})
+
+	t.Run("Fail - Other User Cannot See Export", func(t *testing.T) {
+		if exportID == "" {
+			t.Skip("export was not created")
+		}
+		code, _ := getChatExport(t, token2, exportID)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("Fail - Access Lost Before Processing", func(t *testing.T) {
+		code, data := requestChatExport(t, token1, groupChat.ID)
+		if !assert.Equal(t, http.StatusOK, code) || data == nil {
+			return
+		}
+		id := uuid.MustParse(data["id"].(string))
+
+		testClient.GroupMember.UpdateOne(owner).SetRole(groupmember.RoleAdmin).ExecX(ctx)
+		defer testClient.GroupMember.UpdateOne(owner).SetRole(groupmember.RoleOwner).ExecX(ctx)
+
+		assert.NoError(t, testChatService.ProcessChatExport(ctx, id))
+
+		exp := testClient.ChatExport.GetX(ctx, id)
+		assert.Equal(t, chatexport.StatusFailed, exp.Status)
+		assert.NotNil(t, exp.FailureReason)
+		assert.Nil(t, exp.FileName)
+	})
+
+	t.Run("Success - Expired Exports Are Purged", func(t *testing.T) {
+		if exportID == "" {
+			t.Skip("export was not created")
+		}
+		id := uuid.MustParse(exportID)
+		exp := testClient.ChatExport.UpdateOneID(id).SetExpiresAt(time.Now().UTC().Add(-time.Minute)).SaveX(ctx)
+
+		code, data := getChatExport(t, token1, exportID)
+		assert.Equal(t, http.StatusOK, code)
+		if data != nil {
+			assert.Nil(t, data["download_url"])
+		}
+
+		assert.NoError(t, testChatService.PurgeExpiredChatExports(ctx))
+
+		exists := testClient.ChatExport.Query().Where(chatexport.ID(id)).ExistX(ctx)
+		assert.False(t, exists)
+
+		_, err := s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(testConfig.S3BucketPrivate),
+			Key:    aws.String(*exp.FileName),
+		})
+		assert.Error(t, err)
+	})
+}
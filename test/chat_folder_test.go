@@ -0,0 +1,184 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func saveTestChatFolder(t *testing.T, token, method, path string, req model.ChatFolderRequest) map[string]interface{} {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest(method, path, token, req))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})
+}
+
+func listTestFolderChatIDs(t *testing.T, token, folderID string) []string {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", "/api/chats?folder_id="+folderID, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseWithPagination
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	var ids []string
+	for _, c := range resp.Data.([]interface{}) {
+		ids = append(ids, c.(map[string]interface{})["id"].(string))
+	}
+	return ids
+}
+
+func TestChatFolders(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "folder1")
+	u2 := createTestUser(t, "folder2")
+	u3 := createTestUser(t, "folder3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	foreignChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(foreignChat).SetUser1(u2).SetUser2(u3).SaveX(ctx)
+
+	var groups []uuid.UUID
+	for i := range 2 {
+		c := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+		gc := testClient.GroupChat.Create().SetChat(c).SetCreator(u1).SetName(fmt.Sprintf("Folder Group %d", i)).SetInviteCode(fmt.Sprintf("folderinv%d", i)).SaveX(ctx)
+		testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+		testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+		groups = append(groups, c.ID)
+	}
+
+	sendTestMessage(t, token2, privateChat.ID, "Hello private")
+	sendTestMessage(t, token2, groups[0], "Hello unread group")
+	sendTestMessage(t, token2, groups[0], "Still unread")
+	sendTestMessage(t, token1, groups[1], "Hello read group")
+	muteTestChat(t, token1, groups[1], model.MuteChatRequest{})
+
+	groupType := "group"
+	muted := true
+
+	t.Run("Fail - Invalid Folders", func(t *testing.T) {
+		cases := []model.ChatFolderRequest{
+			{Name: "Empty"},
+			{Name: "   ", UnreadOnly: true},
+			{Name: "Foreign", ChatIDs: []uuid.UUID{foreignChat.ID}},
+			{Name: "Duplicate", ChatIDs: []uuid.UUID{privateChat.ID, privateChat.ID}},
+		}
+		for _, c := range cases {
+			rr := executeRequest(newGroupJSONRequest("POST", "/api/chats/folders", token1, c))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+
+		req, _ := http.NewRequest("GET", "/api/chats?folder_id="+uuid.New().String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusNotFound, executeRequest(req).Code)
+	})
+
+	var unreadGroupsID, explicitID string
+
+	t.Run("Success - Rule Folder With Badge", func(t *testing.T) {
+		data := saveTestChatFolder(t, token1, "POST", "/api/chats/folders", model.ChatFolderRequest{
+			Name:       " Unread groups ",
+			ChatType:   &groupType,
+			UnreadOnly: true,
+		})
+		if data == nil {
+			return
+		}
+		unreadGroupsID = data["id"].(string)
+		assert.Equal(t, "Unread groups", data["name"])
+		assert.Equal(t, "group", data["chat_type"])
+		assert.Equal(t, float64(1), data["unread_chat_count"])
+		assert.Equal(t, float64(2), data["unread_count"])
+
+		assert.Equal(t, []string{groups[0].String()}, listTestFolderChatIDs(t, token1, unreadGroupsID))
+	})
+
+	t.Run("Success - Explicit Chats Or Rules", func(t *testing.T) {
+		data := saveTestChatFolder(t, token1, "POST", "/api/chats/folders", model.ChatFolderRequest{
+			Name:    "Quiet",
+			Muted:   &muted,
+			ChatIDs: []uuid.UUID{privateChat.ID},
+		})
+		if data == nil {
+			return
+		}
+		explicitID = data["id"].(string)
+		assert.Equal(t, float64(1), data["unread_chat_count"])
+
+		ids := listTestFolderChatIDs(t, token1, explicitID)
+		assert.ElementsMatch(t, []string{privateChat.ID.String(), groups[1].String()}, ids)
+
+		req, _ := http.NewRequest("GET", "/api/chats?folder_id="+explicitID, nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		assert.Equal(t, http.StatusNotFound, executeRequest(req).Code, "Folders are private to their owner")
+	})
+
+	t.Run("Success - Update And List", func(t *testing.T) {
+		if explicitID == "" {
+			return
+		}
+		data := saveTestChatFolder(t, token1, "PUT", "/api/chats/folders/"+explicitID, model.ChatFolderRequest{
+			Name:     "Groups",
+			ChatType: &groupType,
+		})
+		if data == nil {
+			return
+		}
+		assert.Nil(t, data["muted"])
+		assert.Empty(t, data["chat_ids"])
+		assert.ElementsMatch(t, []string{groups[0].String(), groups[1].String()}, listTestFolderChatIDs(t, token1, explicitID))
+
+		req, _ := http.NewRequest("GET", "/api/chats/folders", nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		if assert.Equal(t, http.StatusOK, rr.Code) {
+			var resp helper.ResponseSuccess
+			json.Unmarshal(rr.Body.Bytes(), &resp)
+			folders := resp.Data.([]interface{})
+			if assert.Len(t, folders, 2) {
+				assert.Equal(t, unreadGroupsID, folders[0].(map[string]interface{})["id"])
+			}
+		}
+	})
+
+	t.Run("Success - Delete", func(t *testing.T) {
+		if unreadGroupsID == "" {
+			return
+		}
+		req, _ := http.NewRequest("DELETE", "/api/chats/folders/"+unreadGroupsID, nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusOK, executeRequest(req).Code)
+
+		req, _ = http.NewRequest("DELETE", "/api/chats/folders/"+unreadGroupsID, nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusNotFound, executeRequest(req).Code)
+	})
+}
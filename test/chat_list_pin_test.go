@@ -0,0 +1,169 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func changeTestChatPin(t *testing.T, token string, chatID uuid.UUID, action string) (int, []string) {
+	t.Helper()
+
+	req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/%s", chatID, action), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	var ids []string
+	if list, ok := data["chat_ids"].([]interface{}); ok {
+		for _, id := range list {
+			ids = append(ids, id.(string))
+		}
+	}
+	return rr.Code, ids
+}
+
+func getTestChatPage(t *testing.T, token, cursor string, limit int) ([]map[string]interface{}, string) {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats?limit=%d&cursor=%s", limit, url.QueryEscape(cursor)), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil, ""
+	}
+
+	var resp helper.ResponseWithPagination
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	var chats []map[string]interface{}
+	for _, c := range resp.Data.([]interface{}) {
+		chats = append(chats, c.(map[string]interface{}))
+	}
+	return chats, resp.Meta.NextCursor
+}
+
+func TestPinnedChats(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	owner := createTestUser(t, "pinchatowner")
+	outsider := createTestUser(t, "pinchatout")
+	ownerToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, owner.ID)
+	outsiderToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, outsider.ID)
+
+	var chatIDs []uuid.UUID
+	for i := range 7 {
+		c := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+		gc := testClient.GroupChat.Create().SetChat(c).SetCreator(owner).SetName(fmt.Sprintf("Pin Group %d", i)).SetInviteCode(fmt.Sprintf("pinchat%d", i)).SaveX(ctx)
+		testClient.GroupMember.Create().SetGroupChat(gc).SetUser(owner).SetRole(groupmember.RoleOwner).SaveX(ctx)
+		sendTestMessage(t, ownerToken, c.ID, fmt.Sprintf("Hello %d", i))
+		chatIDs = append(chatIDs, c.ID)
+	}
+
+	t.Run("Fail - Pin Foreign Or Unknown Chat", func(t *testing.T) {
+		code, _ := changeTestChatPin(t, outsiderToken, chatIDs[0], "pin")
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = changeTestChatPin(t, ownerToken, uuid.New(), "pin")
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = changeTestChatPin(t, ownerToken, chatIDs[0], "unpin")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("Success - Newest Pin Comes First", func(t *testing.T) {
+		code, ids := changeTestChatPin(t, ownerToken, chatIDs[0], "pin")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{chatIDs[0].String()}, ids)
+
+		code, ids = changeTestChatPin(t, ownerToken, chatIDs[1], "pin")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []string{chatIDs[1].String(), chatIDs[0].String()}, ids)
+
+		code, _ = changeTestChatPin(t, ownerToken, chatIDs[1], "pin")
+		assert.Equal(t, http.StatusConflict, code)
+	})
+
+	t.Run("Success - Pinned Chats Lead The First Page Only", func(t *testing.T) {
+		page, cursor := getTestChatPage(t, ownerToken, "", 3)
+		if assert.Len(t, page, 5) {
+			assert.Equal(t, chatIDs[1].String(), page[0]["id"])
+			assert.Equal(t, chatIDs[0].String(), page[1]["id"])
+			assert.Equal(t, true, page[0]["is_pinned"])
+			assert.Equal(t, chatIDs[6].String(), page[2]["id"])
+			assert.Equal(t, false, page[2]["is_pinned"])
+		}
+
+		seen := map[string]bool{}
+		for _, c := range page {
+			seen[c["id"].(string)] = true
+		}
+		for cursor != "" {
+			var next []map[string]interface{}
+			next, cursor = getTestChatPage(t, ownerToken, cursor, 3)
+			for _, c := range next {
+				assert.False(t, seen[c["id"].(string)], "Chat listed twice")
+				seen[c["id"].(string)] = true
+			}
+		}
+		assert.Len(t, seen, len(chatIDs))
+	})
+
+	t.Run("Success - Reorder", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/chats/pinned", ownerToken, model.ReorderPinnedChatsRequest{
+			ChatIDs: []uuid.UUID{chatIDs[1]},
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = executeRequest(newGroupJSONRequest("PUT", "/api/chats/pinned", ownerToken, model.ReorderPinnedChatsRequest{
+			ChatIDs: []uuid.UUID{chatIDs[0], chatIDs[2]},
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = executeRequest(newGroupJSONRequest("PUT", "/api/chats/pinned", ownerToken, model.ReorderPinnedChatsRequest{
+			ChatIDs: []uuid.UUID{chatIDs[0], chatIDs[1]},
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		page, _ := getTestChatPage(t, ownerToken, "", 3)
+		if assert.NotEmpty(t, page) {
+			assert.Equal(t, chatIDs[0].String(), page[0]["id"])
+		}
+	})
+
+	t.Run("Fail - Maximum Pinned Chats", func(t *testing.T) {
+		for _, id := range chatIDs[2:5] {
+			code, _ := changeTestChatPin(t, ownerToken, id, "pin")
+			assert.Equal(t, http.StatusOK, code)
+		}
+
+		code, _ := changeTestChatPin(t, ownerToken, chatIDs[5], "pin")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("Success - Unpin", func(t *testing.T) {
+		code, ids := changeTestChatPin(t, ownerToken, chatIDs[0], "unpin")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, ids, 4)
+		assert.NotContains(t, ids, chatIDs[0].String())
+
+		code, _ = changeTestChatPin(t, ownerToken, chatIDs[5], "pin")
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
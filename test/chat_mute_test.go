@@ -0,0 +1,142 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func muteTestChat(t *testing.T, token string, chatID uuid.UUID, req model.MuteChatRequest) map[string]interface{} {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/chats/%s/mute", chatID), token, req))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})
+}
+
+func TestChatMute(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "mute1")
+	u2 := createTestUser(t, "mute2")
+	outsider := createTestUser(t, "muteout")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	outsiderToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, outsider.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Mute Group").SetInviteCode("muteinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	t.Run("Fail - Invalid Mute", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		rr := executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/chats/%s/mute", privateChat.ID), token1, model.MuteChatRequest{
+			MutedUntil: &past,
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/chats/%s/mute", groupChat.ID), outsiderToken, model.MuteChatRequest{}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/chats/%s/mute", uuid.New()), token1, model.MuteChatRequest{}))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Success - Mute Until Unmuted Keeps Counting Unread", func(t *testing.T) {
+		data := muteTestChat(t, token1, privateChat.ID, model.MuteChatRequest{NotifyMentions: true})
+		if data == nil {
+			return
+		}
+		assert.Equal(t, true, data["is_muted"])
+		assert.Nil(t, data["muted_until"])
+		assert.Equal(t, false, data["notify_mentions"], "Mention alerts only apply to groups")
+
+		sendTestMessage(t, token2, privateChat.ID, "Are you there?")
+
+		page, _ := getTestChatPage(t, token1, "", 10)
+		for _, c := range page {
+			if c["id"] == privateChat.ID.String() {
+				assert.Equal(t, true, c["is_muted"])
+				assert.Equal(t, float64(1), c["unread_count"])
+			}
+		}
+
+		page, _ = getTestChatPage(t, token2, "", 10)
+		for _, c := range page {
+			if c["id"] == privateChat.ID.String() {
+				assert.Equal(t, false, c["is_muted"], "Muting is per participant")
+			}
+		}
+	})
+
+	t.Run("Success - Timed Mute With Mention Alerts", func(t *testing.T) {
+		until := time.Now().Add(8 * time.Hour)
+		data := muteTestChat(t, token1, groupChat.ID, model.MuteChatRequest{
+			MutedUntil:     &until,
+			NotifyMentions: true,
+		})
+		if data == nil {
+			return
+		}
+		assert.Equal(t, true, data["is_muted"])
+		assert.Equal(t, true, data["notify_mentions"])
+		if assert.NotNil(t, data["muted_until"]) {
+			parsed, err := time.Parse(time.RFC3339, data["muted_until"].(string))
+			assert.NoError(t, err)
+			assert.WithinDuration(t, until, parsed, time.Second)
+		}
+	})
+
+	t.Run("Success - Expired Mute Is Not Muted", func(t *testing.T) {
+		testClient.GroupMember.Update().
+			Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u1.ID)).
+			SetMutedUntil(time.Now().Add(-time.Minute)).
+			ExecX(ctx)
+
+		page, _ := getTestChatPage(t, token1, "", 10)
+		for _, c := range page {
+			if c["id"] == groupChat.ID.String() {
+				assert.Equal(t, false, c["is_muted"])
+				assert.Nil(t, c["muted_until"])
+			}
+		}
+	})
+
+	t.Run("Success - Unmute", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/chats/%s/mute", privateChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, false, data["is_muted"])
+		assert.Equal(t, float64(1), data["unread_count"])
+	})
+}
@@ -0,0 +1,189 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/pinnedmessage"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPinRequest(chatID, messageID uuid.UUID, token string) *http.Request {
+	body, _ := json.Marshal(model.PinMessageRequest{MessageID: messageID})
+	req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/pins", chatID), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func newUnpinRequest(chatID, messageID uuid.UUID, token string) *http.Request {
+	req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/chats/%s/pins/%s", chatID, messageID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestPinMessages(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "pin1")
+	u2 := createTestUser(t, "pin2")
+	u3 := createTestUser(t, "pin3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+	privateMsg := testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetType("regular").
+		SetContent("Remember this").SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Pin Group").SetInviteCode("pininv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+	groupMsg := testClient.Message.Create().SetChat(groupChat).SetSender(u2).SetType("regular").
+		SetContent("Group rules").SaveX(ctx)
+
+	t.Run("Success - Pin In Private Chat", func(t *testing.T) {
+		rr := executeRequest(newPinRequest(privateChat.ID, privateMsg.ID, token1))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+
+		pins := data["pinned_messages"].([]interface{})
+		if assert.Len(t, pins, 1) {
+			pin := pins[0].(map[string]interface{})
+			assert.Equal(t, u1.ID.String(), pin["pinned_by_id"])
+			preview := pin["message"].(map[string]interface{})
+			assert.Equal(t, privateMsg.ID.String(), preview["id"])
+			assert.Equal(t, "Remember this", preview["content"])
+		}
+
+		lastMsg := data["last_message"].(map[string]interface{})
+		assert.Equal(t, "system_pin", lastMsg["type"])
+		assert.Equal(t, privateMsg.ID.String(), lastMsg["action_data"].(map[string]interface{})["message_id"])
+	})
+
+	t.Run("Success - Visible In Chat Detail And List", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/chats/"+privateChat.ID.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Len(t, resp.Data.(map[string]interface{})["pinned_messages"], 1)
+
+		req, _ = http.NewRequest("GET", "/api/chats", nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var listResp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &listResp)
+		for _, item := range listResp.Data.([]interface{}) {
+			c := item.(map[string]interface{})
+			if c["id"] == privateChat.ID.String() {
+				assert.Len(t, c["pinned_messages"], 1)
+			}
+		}
+	})
+
+	t.Run("Fail - Already Pinned", func(t *testing.T) {
+		rr := executeRequest(newPinRequest(privateChat.ID, privateMsg.ID, token2))
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Fail - Not A Participant", func(t *testing.T) {
+		rr := executeRequest(newPinRequest(privateChat.ID, privateMsg.ID, token3))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - System Message Cannot Be Pinned", func(t *testing.T) {
+		sysMsg := testClient.Message.Query().
+			Where(message.ChatID(privateChat.ID), message.TypeEQ(message.TypeSystemPin)).
+			FirstX(ctx)
+		rr := executeRequest(newPinRequest(privateChat.ID, sysMsg.ID, token1))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Success - Unpin", func(t *testing.T) {
+		rr := executeRequest(newUnpinRequest(privateChat.ID, privateMsg.ID, token2))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Nil(t, data["pinned_messages"])
+		assert.Equal(t, "system_unpin", data["last_message"].(map[string]interface{})["type"])
+	})
+
+	t.Run("Fail - Unpin Not Pinned", func(t *testing.T) {
+		rr := executeRequest(newUnpinRequest(privateChat.ID, privateMsg.ID, token1))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Fail - Group Member Cannot Pin", func(t *testing.T) {
+		rr := executeRequest(newPinRequest(groupChat.ID, groupMsg.ID, token2))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Non Member Cannot Pin", func(t *testing.T) {
+		rr := executeRequest(newPinRequest(groupChat.ID, groupMsg.ID, token3))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Group Owner Pins", func(t *testing.T) {
+		rr := executeRequest(newPinRequest(groupChat.ID, groupMsg.ID, token1))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Len(t, data["pinned_messages"], 1)
+		assert.Equal(t, "owner", data["last_message"].(map[string]interface{})["sender_role"])
+	})
+
+	t.Run("Success - Deleting Message Removes Pin", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/api/messages/"+groupMsg.ID.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		exists, _ := testClient.PinnedMessage.Query().Where(pinnedmessage.MessageID(groupMsg.ID)).Exist(ctx)
+		assert.False(t, exists)
+	})
+
+	t.Run("Fail - Pin Limit", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			m := testClient.Message.Create().SetChat(groupChat).SetSender(u1).SetType("regular").
+				SetContent(fmt.Sprintf("Pin %d", i)).SaveX(ctx)
+			testClient.PinnedMessage.Create().SetChatID(groupChat.ID).SetMessageID(m.ID).SetPinnedByID(u1.ID).SaveX(ctx)
+		}
+		extra := testClient.Message.Create().SetChat(groupChat).SetSender(u1).SetType("regular").
+			SetContent("One too many").SaveX(ctx)
+
+		rr := executeRequest(newPinRequest(groupChat.ID, extra.ID, token1))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,159 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/internal/helper"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkAsUnread(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "unread1")
+	u2 := createTestUser(t, "unread2")
+	outsider := createTestUser(t, "unreadout")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	outsiderToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, outsider.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Unread Group").SetInviteCode("unreadinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+
+	sendTestMessage(t, token2, privateChat.ID, "Hello")
+	sendTestMessage(t, token1, groupChat.ID, "Note to self")
+
+	t.Run("Fail - Unknown Or Foreign Chat", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/unread", uuid.New()), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusNotFound, executeRequest(req).Code)
+
+		req, _ = http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/unread", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+outsiderToken)
+		assert.Equal(t, http.StatusForbidden, executeRequest(req).Code)
+	})
+
+	t.Run("Success - Mark Read Chat As Unread", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/unread", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, true, data["is_marked_unread"])
+		assert.Equal(t, float64(0), data["unread_count"])
+	})
+
+	t.Run("Success - Reading Clears The Mark", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/read", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusOK, executeRequest(req).Code)
+
+		gm := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u1.ID)).OnlyX(ctx)
+		assert.False(t, gm.MarkedUnread)
+	})
+
+	t.Run("Success - Sending Clears The Mark", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/unread", privateChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		assert.Equal(t, http.StatusOK, executeRequest(req).Code)
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(privateChat.ID)).OnlyX(ctx)
+		assert.True(t, pc.User2MarkedUnread)
+		assert.False(t, pc.User1MarkedUnread, "Marking is per participant")
+
+		sendTestMessage(t, token2, privateChat.ID, "Replying")
+
+		pc = testClient.PrivateChat.Query().Where(privatechat.ChatID(privateChat.ID)).OnlyX(ctx)
+		assert.False(t, pc.User2MarkedUnread)
+	})
+}
+
+func TestMarkAllAsRead(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "readall1")
+	u2 := createTestUser(t, "readall2")
+	blocker := createTestUser(t, "readallblock")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u2).SetUser2(u1).SaveX(ctx)
+
+	blockedChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(blockedChat).SetUser1(u1).SetUser2(blocker).SetUser1UnreadCount(3).SaveX(ctx)
+	testClient.UserBlock.Create().SetBlockerID(blocker.ID).SetBlockedID(u1.ID).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Read All Group").SetInviteCode("readallinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	sendTestMessage(t, token2, privateChat.ID, "Hello")
+	lastGroupMsg := sendTestMessage(t, token2, groupChat.ID, "Hello group")
+
+	quietChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	quiet := testClient.GroupChat.Create().SetChat(quietChat).SetCreator(u1).SetName("Quiet Group").SetInviteCode("readallquiet").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(quiet).SetUser(u1).SetRole(groupmember.RoleOwner).SetMarkedUnread(true).SaveX(ctx)
+
+	t.Run("Success - Everything Read", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/chats/read", nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(privateChat.ID)).OnlyX(ctx)
+		assert.Equal(t, 0, pc.User2UnreadCount)
+		assert.NotNil(t, pc.User2LastReadAt)
+
+		blocked := testClient.PrivateChat.Query().Where(privatechat.ChatID(blockedChat.ID)).OnlyX(ctx)
+		assert.Equal(t, 0, blocked.User1UnreadCount)
+		assert.Nil(t, blocked.User1LastReadAt, "Read receipts stay hidden while blocked")
+
+		gm := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u1.ID)).OnlyX(ctx)
+		assert.Equal(t, 0, gm.UnreadCount)
+		assert.NotNil(t, gm.LastReadAt)
+		if assert.NotNil(t, gm.LastReadMessageID) {
+			assert.Equal(t, lastGroupMsg, *gm.LastReadMessageID)
+		}
+
+		qm := testClient.GroupMember.Query().Where(groupmember.GroupChatID(quiet.ID), groupmember.UserID(u1.ID)).OnlyX(ctx)
+		assert.False(t, qm.MarkedUnread)
+	})
+
+	t.Run("Success - Nothing Left To Read", func(t *testing.T) {
+		before := time.Now()
+		req, _ := http.NewRequest("POST", "/api/chats/read", nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusOK, executeRequest(req).Code)
+
+		gm := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u1.ID)).OnlyX(ctx)
+		assert.True(t, gm.LastReadAt.Before(before), "Read chats are left untouched")
+	})
+}
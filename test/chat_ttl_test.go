@@ -0,0 +1,228 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/scheduler/job"
+	"AtoiTalkAPI/internal/websocket"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMessageTTLRequest(chatID uuid.UUID, token string, ttl int) *http.Request {
+	body, _ := json.Marshal(model.UpdateMessageTTLRequest{MessageTTL: ttl})
+	req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/chats/%s/ttl", chatID), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestMessageTTL(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "ttl1")
+	u2 := createTestUser(t, "ttl2")
+	u3 := createTestUser(t, "ttl3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("TTL Group").SetInviteCode("ttlinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	t.Run("Success - Enable In Private Chat", func(t *testing.T) {
+		rr := executeRequest(newMessageTTLRequest(privateChat.ID, token2, 60))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, float64(60), data["message_ttl"])
+
+		lastMsg := data["last_message"].(map[string]interface{})
+		assert.Equal(t, "system_ttl", lastMsg["type"])
+		assert.Equal(t, float64(60), lastMsg["action_data"].(map[string]interface{})["message_ttl"])
+	})
+
+	t.Run("Success - New Messages Are Stamped", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+			ChatID:  privateChat.ID,
+			Content: "This will vanish",
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		expiresAt, err := time.Parse(time.RFC3339, resp.Data.(map[string]interface{})["expires_at"].(string))
+		if assert.NoError(t, err) {
+			assert.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, 5*time.Second)
+		}
+	})
+
+	t.Run("Fail - Invalid TTL", func(t *testing.T) {
+		rr := executeRequest(newMessageTTLRequest(privateChat.ID, token1, 3))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Fail - Not A Participant", func(t *testing.T) {
+		rr := executeRequest(newMessageTTLRequest(privateChat.ID, token3, 60))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Group Member Cannot Change", func(t *testing.T) {
+		rr := executeRequest(newMessageTTLRequest(groupChat.ID, token2, 60))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Group Owner Changes", func(t *testing.T) {
+		rr := executeRequest(newMessageTTLRequest(groupChat.ID, token1, 3600))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, float64(3600), resp.Data.(map[string]interface{})["message_ttl"])
+	})
+
+	t.Run("Success - Expired Messages Are Purged", func(t *testing.T) {
+		attachment := testClient.Media.Create().
+			SetFileName("ttl_file.jpg").
+			SetOriginalName("ttl.jpg").
+			SetFileSize(1024).
+			SetMimeType("image/jpeg").
+			SetUploaderID(u1.ID).
+			SaveX(ctx)
+
+		expired := testClient.Message.Create().SetChat(privateChat).SetSender(u1).SetType("regular").
+			SetContent("Gone").AddAttachments(attachment).SetExpiresAt(time.Now().Add(-time.Second)).SaveX(ctx)
+		testClient.Chat.UpdateOne(privateChat).SetLastMessage(expired).ExecX(ctx)
+
+		kept := testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetType("regular").
+			SetContent("Still here").SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+
+		err := job.RunExpiredMessageCleanup(ctx, testClient, testMessageService)
+		assert.NoError(t, err)
+
+		exists, _ := testClient.Message.Query().Where(message.ID(expired.ID)).Exist(ctx)
+		assert.False(t, exists)
+		exists, _ = testClient.Message.Query().Where(message.ID(kept.ID)).Exist(ctx)
+		assert.True(t, exists)
+		exists, _ = testClient.Media.Query().Where(media.ID(attachment.ID)).Exist(ctx)
+		assert.False(t, exists)
+
+		updated := testClient.Chat.GetX(ctx, privateChat.ID)
+		if assert.NotNil(t, updated.LastMessageID) {
+			assert.Equal(t, kept.ID, *updated.LastMessageID)
+		}
+	})
+
+	t.Run("Success - Expired Mention Is No Longer Unread", func(t *testing.T) {
+		msgID := sendTestMessage(t, token1, groupChat.ID, fmt.Sprintf("hello @%s", *u2.Username))
+		gm := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u2.ID)).OnlyX(ctx)
+		assert.Equal(t, 1, gm.UnreadMentionCount)
+
+		testClient.Message.UpdateOneID(msgID).SetExpiresAt(time.Now().Add(-time.Second)).ExecX(ctx)
+		err := job.RunExpiredMessageCleanup(ctx, testClient, testMessageService)
+		assert.NoError(t, err)
+
+		gm = testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u2.ID)).OnlyX(ctx)
+		assert.Equal(t, 0, gm.UnreadMentionCount)
+
+		code, _ := getNextMention(t, groupChat.ID, token2, nil)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("Success - Disable", func(t *testing.T) {
+		rr := executeRequest(newMessageTTLRequest(privateChat.ID, token1, 0))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Nil(t, resp.Data.(map[string]interface{})["message_ttl"])
+
+		rr = executeRequest(newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+			ChatID:  privateChat.ID,
+			Content: "This stays",
+		}))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var msgResp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &msgResp)
+		assert.Nil(t, msgResp.Data.(map[string]interface{})["expires_at"])
+	})
+}
+
+func TestMessageTTLWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createWSUser(t, "ttlws1", "ttlws1@example.com")
+	u2 := createWSUser(t, "ttlws2", "ttlws2@example.com")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	groupID := createWSGroupChat(t, token1, "TTL WS Group", []uuid.UUID{u2.ID}, false)
+
+	rr := executeRequest(newMessageTTLRequest(groupID, token1, 5))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = executeRequest(newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+		ChatID:  groupID,
+		Content: "Short lived",
+	}))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var msgResp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &msgResp)
+	msgID, _ := uuid.Parse(msgResp.Data.(map[string]interface{})["id"].(string))
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn2, _, err := ws.DefaultDialer.Dial(wsURL+token2, nil)
+	assert.NoError(t, err)
+	defer conn2.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	testClient.Message.UpdateOneID(msgID).SetExpiresAt(time.Now().Add(-time.Second)).ExecX(ctx)
+	assert.NoError(t, job.RunExpiredMessageCleanup(ctx, testClient, testMessageService))
+
+	event := waitForEvent(t, conn2, websocket.EventMessageDelete, 2*time.Second)
+	if assert.NotNil(t, event) {
+		payload := event.Payload.(map[string]interface{})
+		assert.Equal(t, msgID.String(), payload["message_id"])
+		assert.Equal(t, groupID, event.Meta.ChatID)
+	}
+}
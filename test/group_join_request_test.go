@@ -0,0 +1,166 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupjoinrequest"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func reviewTestJoinRequest(token string, chatID, userID uuid.UUID, action string) int {
+	req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/group/%s/requests/%s/%s", chatID, userID, action), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return executeRequest(req).Code
+}
+
+func TestGroupJoinRequests(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	owner := createTestUser(t, "joinreq1")
+	member := createTestUser(t, "joinreq2")
+	applicant := createTestUser(t, "joinreq3")
+	other := createTestUser(t, "joinreq4")
+
+	ownerToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, owner.ID)
+	memberToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, member.ID)
+	applicantToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, applicant.ID)
+	otherToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, other.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(owner).SetName("Approval Group").SetInviteCode("joinreqinv").SetIsPublic(true).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(owner).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(member).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	t.Run("Fail - Member Cannot Require Approval", func(t *testing.T) {
+		requiresApproval := true
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/chats/group/"+groupChat.ID.String(), memberToken, model.UpdateGroupChatRequest{
+			RequiresApproval: &requiresApproval,
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Owner Requires Approval", func(t *testing.T) {
+		requiresApproval := true
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/chats/group/"+groupChat.ID.String(), ownerToken, model.UpdateGroupChatRequest{
+			RequiresApproval: &requiresApproval,
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Equal(t, true, resp.Data.(map[string]interface{})["requires_approval"])
+
+		exists := testClient.Message.Query().Where(message.ChatID(groupChat.ID), message.TypeEQ(message.TypeSystemJoinApproval)).ExistX(ctx)
+		assert.True(t, exists)
+	})
+
+	t.Run("Success - Join Creates Pending Request", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/group/%s/join", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+applicantToken)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusAccepted, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, "pending", data["status"])
+		assert.Equal(t, applicant.ID.String(), data["user_id"])
+
+		rr = executeRequest(newGroupJSONRequest("POST", "/api/chats/group/join/invite", otherToken, model.JoinGroupByInviteRequest{
+			InviteCode: "joinreqinv",
+		}))
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+
+		isMember := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(applicant.ID)).ExistX(ctx)
+		assert.False(t, isMember)
+	})
+
+	t.Run("Success - Admins List Pending Requests", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats/group/%s/requests?limit=1", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.([]interface{})
+		if assert.Len(t, data, 1) {
+			assert.Equal(t, applicant.ID.String(), data[0].(map[string]interface{})["user_id"])
+		}
+		assert.True(t, resp.Meta.HasNext)
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/chats/group/%s/requests?cursor=%s", groupChat.ID, resp.Meta.NextCursor), nil)
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		rr = executeRequest(req)
+		if assert.Equal(t, http.StatusOK, rr.Code) {
+			json.Unmarshal(rr.Body.Bytes(), &resp)
+			data = resp.Data.([]interface{})
+			if assert.Len(t, data, 1) {
+				assert.Equal(t, other.ID.String(), data[0].(map[string]interface{})["user_id"])
+			}
+		}
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/chats/group/%s/requests", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+memberToken)
+		assert.Equal(t, http.StatusForbidden, executeRequest(req).Code)
+	})
+
+	t.Run("Fail - Member Cannot Review", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, reviewTestJoinRequest(memberToken, groupChat.ID, applicant.ID, "approve"))
+		assert.Equal(t, http.StatusNotFound, reviewTestJoinRequest(ownerToken, groupChat.ID, member.ID, "approve"))
+	})
+
+	t.Run("Success - Approve Adds Member", func(t *testing.T) {
+		if !assert.Equal(t, http.StatusOK, reviewTestJoinRequest(ownerToken, groupChat.ID, applicant.ID, "approve")) {
+			return
+		}
+
+		isMember := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(applicant.ID)).ExistX(ctx)
+		assert.True(t, isMember)
+
+		jr := testClient.GroupJoinRequest.Query().Where(groupjoinrequest.GroupChatID(gc.ID), groupjoinrequest.UserID(applicant.ID)).OnlyX(ctx)
+		assert.Equal(t, groupjoinrequest.StatusApproved, jr.Status)
+		if assert.NotNil(t, jr.ReviewedBy) {
+			assert.Equal(t, owner.ID, *jr.ReviewedBy)
+		}
+
+		assert.Equal(t, http.StatusNotFound, reviewTestJoinRequest(ownerToken, groupChat.ID, applicant.ID, "approve"), "Already reviewed")
+	})
+
+	t.Run("Success - Decline And Request Again", func(t *testing.T) {
+		if !assert.Equal(t, http.StatusOK, reviewTestJoinRequest(ownerToken, groupChat.ID, other.ID, "decline")) {
+			return
+		}
+
+		isMember := testClient.GroupMember.Query().Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(other.ID)).ExistX(ctx)
+		assert.False(t, isMember)
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/group/%s/join", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+otherToken)
+		assert.Equal(t, http.StatusAccepted, executeRequest(req).Code)
+
+		jr := testClient.GroupJoinRequest.Query().Where(groupjoinrequest.GroupChatID(gc.ID), groupjoinrequest.UserID(other.ID)).OnlyX(ctx)
+		assert.Equal(t, groupjoinrequest.StatusPending, jr.Status)
+		assert.Nil(t, jr.ReviewedBy)
+	})
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/scheduler/job"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setSlowMode(token string, chatID uuid.UUID, seconds int) (int, map[string]interface{}) {
+	rr := executeRequest(newGroupJSONRequest("PUT", "/api/chats/group/"+chatID.String(), token, model.UpdateGroupChatRequest{
+		SlowModeSeconds: &seconds,
+	}))
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func TestGroupSlowMode(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "slow1")
+	u2 := createTestUser(t, "slow2")
+	u3 := createTestUser(t, "slow3")
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Slow Group").SetInviteCode("slowinv").SetIsPublic(true).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u3).SetRole(groupmember.RoleAdmin).SaveX(ctx)
+
+	send := func(token, content string) *helper.ResponseError {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token, model.SendMessageRequest{
+			ChatID:  groupChat.ID,
+			Content: content,
+		}))
+		if rr.Code == http.StatusOK {
+			return nil
+		}
+		var resp helper.ResponseError
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		if resp.Error == "" {
+			resp.Error = http.StatusText(rr.Code)
+		}
+		if rr.Code != http.StatusTooManyRequests {
+			t.Errorf("unexpected status %d: %s", rr.Code, resp.Error)
+		}
+		return &resp
+	}
+
+	t.Run("Fail - Member Cannot Enable", func(t *testing.T) {
+		code, _ := setSlowMode(token2, groupChat.ID, 30)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Fail - Interval Too Long", func(t *testing.T) {
+		code, _ := setSlowMode(token1, groupChat.ID, 7200)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("Success - Admin Enables Slow Mode", func(t *testing.T) {
+		code, data := setSlowMode(token1, groupChat.ID, 30)
+		if !assert.Equal(t, http.StatusOK, code) || data == nil {
+			return
+		}
+		assert.Equal(t, float64(30), data["slow_mode_seconds"])
+
+		sysMsg := testClient.Message.Query().
+			Where(message.ChatID(groupChat.ID), message.TypeEQ(message.TypeSystemSlowMode)).
+			OnlyX(ctx)
+		assert.Equal(t, float64(30), sysMsg.ActionData["slow_mode_seconds"])
+		assert.Equal(t, u1.ID.String(), sysMsg.ActionData["actor_id"])
+	})
+
+	t.Run("Fail - Member Sends Too Often", func(t *testing.T) {
+		assert.Nil(t, send(token2, "first"))
+
+		errResp := send(token2, "second")
+		if assert.NotNil(t, errResp) {
+			assert.Contains(t, errResp.Error, "seconds")
+		}
+
+		count := testClient.Message.Query().
+			Where(message.ChatID(groupChat.ID), message.SenderID(u2.ID)).
+			CountX(ctx)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Success - Admins And Owners Are Exempt", func(t *testing.T) {
+		assert.Nil(t, send(token1, "owner one"))
+		assert.Nil(t, send(token1, "owner two"))
+		assert.Nil(t, send(token3, "admin one"))
+		assert.Nil(t, send(token3, "admin two"))
+	})
+
+	cooldownKey := fmt.Sprintf("ratelimit:slowmode:%s:%s", groupChat.ID, u2.ID)
+
+	t.Run("Success - Failed Forward Does Not Start Cooldown", func(t *testing.T) {
+		redisAdapter.Del(ctx, cooldownKey)
+
+		otherChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+		testClient.PrivateChat.Create().SetChat(otherChat).SetUser1(u1).SetUser2(u3).SaveX(ctx)
+		src := testClient.Message.Query().
+			Where(message.ChatID(groupChat.ID), message.SenderID(u2.ID)).
+			FirstX(ctx)
+
+		rr := executeRequest(newForwardRequest(src.ID, token2, groupChat.ID, otherChat.ID))
+		assert.True(t, rr.Code == http.StatusForbidden || rr.Code == http.StatusNotFound, "unexpected code %d", rr.Code)
+
+		assert.Nil(t, send(token2, "after failed forward"))
+	})
+
+	t.Run("Success - Scheduled Message Waits For Cooldown", func(t *testing.T) {
+		id := scheduleTestMessage(t, token2, model.ScheduleMessageRequest{
+			SendMessageRequest: model.SendMessageRequest{ChatID: groupChat.ID, Content: "scheduled"},
+			SendAt:             time.Now().UTC().Add(time.Hour),
+		})
+		makeScheduledMessageDue(ctx, id)
+
+		err := job.RunScheduledMessageDispatch(ctx, testClient, testMessageService)
+		assert.NoError(t, err)
+
+		sm := testClient.ScheduledMessage.GetX(ctx, id)
+		assert.Equal(t, scheduledmessage.StatusPending, sm.Status)
+
+		redisAdapter.Del(ctx, cooldownKey)
+		err = job.RunScheduledMessageDispatch(ctx, testClient, testMessageService)
+		assert.NoError(t, err)
+
+		exists := testClient.ScheduledMessage.Query().Where(scheduledmessage.ID(id)).ExistX(ctx)
+		assert.False(t, exists)
+	})
+
+	t.Run("Success - Disable Slow Mode", func(t *testing.T) {
+		code, data := setSlowMode(token1, groupChat.ID, 0)
+		if assert.Equal(t, http.StatusOK, code) && data != nil {
+			assert.Nil(t, data["slow_mode_seconds"])
+		}
+
+		assert.Nil(t, send(token2, "free again"))
+
+		count := testClient.Message.Query().
+			Where(message.ChatID(groupChat.ID), message.TypeEQ(message.TypeSystemSlowMode)).
+			CountX(ctx)
+		assert.Equal(t, 2, count)
+	})
+}
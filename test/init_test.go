@@ -38,6 +38,8 @@ var (
 	redisAdapter       *adapter.RedisAdapter
 	s3Client           *s3.Client
 	testStorageAdapter *adapter.StorageAdapter
+	testMessageService *service.MessageService
+	testChatService    *service.ChatService
 )
 
 const (
@@ -142,16 +144,16 @@ func TestMain(m *testing.M) {
 	accountService := service.NewAccountService(testClient, testConfig, validator, testHub, otpService, redisAdapter, repo)
 	accountController := controller.NewAccountController(accountService)
 
-	chatService := service.NewChatService(testClient, repo, testConfig, validator, testHub, testStorageAdapter, redisAdapter)
+	testChatService = service.NewChatService(testClient, repo, testConfig, validator, testHub, testStorageAdapter, redisAdapter)
 	privateChatService := service.NewPrivateChatService(testClient, testConfig, validator, testHub, redisAdapter, testStorageAdapter)
 	groupChatService := service.NewGroupChatService(testClient, repo, testConfig, validator, testHub, testStorageAdapter, redisAdapter)
 
-	chatController := controller.NewChatController(chatService)
+	chatController := controller.NewChatController(testChatService)
 	privateChatController := controller.NewPrivateChatController(privateChatService)
 	groupChatController := controller.NewGroupChatController(groupChatService)
 
-	messageService := service.NewMessageService(testClient, repo, testConfig, validator, testStorageAdapter, testHub)
-	messageController := controller.NewMessageController(messageService)
+	testMessageService = service.NewMessageService(testClient, repo, testConfig, validator, testStorageAdapter, testHub, adapter.NewLinkPreviewAdapter(httpClient, redisAdapter, testStorageAdapter))
+	messageController := controller.NewMessageController(testMessageService)
 
 	mediaService := service.NewMediaService(testClient, testConfig, validator, testStorageAdapter, captchaAdapter)
 	mediaController := controller.NewMediaController(mediaService)
@@ -162,7 +164,7 @@ func TestMain(m *testing.M) {
 	adminService := service.NewAdminService(testClient, testConfig, validator, testHub, repo, testStorageAdapter)
 	adminController := controller.NewAdminController(adminService, groupChatService, validator)
 
-	wsController := controller.NewWebSocketController(testHub, testConfig)
+	wsController := controller.NewWebSocketController(testHub, testConfig, authService, testMessageService, testChatService, repo.RateLimit, repo.Session)
 
 	authMiddleware := middleware.NewAuthMiddleware(authService, repo.Session)
 	rateLimitMiddleware := middleware.NewRateLimitMiddleware(repo.RateLimit, testConfig)
@@ -204,8 +206,21 @@ func executeRequest(req *http.Request) *httptest.ResponseRecorder {
 func clearDatabase(ctx context.Context) {
 
 	testClient.Report.Delete().Exec(ctx)
+	testClient.ChatExport.Delete().Exec(ctx)
+	testClient.MessageReaction.Delete().Exec(ctx)
+	testClient.PinnedMessage.Delete().Exec(ctx)
+	testClient.PinnedChat.Delete().Exec(ctx)
+	testClient.ChatFolderChat.Delete().Exec(ctx)
+	testClient.ChatFolder.Delete().Exec(ctx)
+	testClient.ScheduledMessage.Delete().Exec(ctx)
+	testClient.MessageRevision.Delete().Exec(ctx)
+	testClient.MessageMention.Delete().Exec(ctx)
+	testClient.PollVote.Delete().Exec(ctx)
+	testClient.PollOption.Delete().Exec(ctx)
+	testClient.Poll.Delete().Exec(ctx)
 	testClient.Message.Delete().Exec(ctx)
 	testClient.PrivateChat.Delete().Exec(ctx)
+	testClient.GroupJoinRequest.Delete().Exec(ctx)
 	testClient.GroupMember.Delete().Exec(ctx)
 	testClient.GroupChat.Delete().Exec(ctx)
 	testClient.Chat.Delete().Exec(ctx)
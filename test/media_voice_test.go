@@ -0,0 +1,154 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestOggPage(headerType byte, granule uint64, packets ...[]byte) []byte {
+	page := make([]byte, 27)
+	copy(page, "OggS")
+	page[5] = headerType
+	binary.LittleEndian.PutUint64(page[6:], granule)
+	binary.LittleEndian.PutUint32(page[14:], 1)
+	page[26] = byte(len(packets))
+
+	var body []byte
+	for _, p := range packets {
+		page = append(page, byte(len(p)))
+		body = append(body, p...)
+	}
+	return append(page, body...)
+}
+
+// createTestVoiceNote builds a minimal Ogg/Opus stream lasting one and a half
+// seconds with packets growing in size.
+func createTestVoiceNote() []byte {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1
+	head[9] = 1
+
+	var audio [][]byte
+	for i := range 75 {
+		audio = append(audio, make([]byte, 20+i))
+	}
+
+	data := createTestOggPage(0x02, 0, head)
+	data = append(data, createTestOggPage(0, 0, []byte("OpusTags"))...)
+	return append(data, createTestOggPage(0x04, 72000, audio...)...)
+}
+
+func uploadTestVoiceNote(t *testing.T, token, mimeType string, content []byte) (string, int, map[string]interface{}) {
+	t.Helper()
+
+	req := newUploadMediaRequest("voice", "voice.ogg", len(content), mimeType, "dummy-token")
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return "", rr.Code, nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	mediaMap := resp.Data.(map[string]interface{})["media"].(map[string]interface{})
+	assert.Equal(t, "voice", mediaMap["category"])
+
+	_, err := s3Client.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket:      aws.String(testConfig.S3BucketPrivate),
+		Key:         aws.String(mediaMap["file_name"].(string)),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String(mimeType),
+	})
+	assert.NoError(t, err)
+
+	mediaID := mediaMap["id"].(string)
+	completeReq, _ := http.NewRequest("POST", fmt.Sprintf("/api/media/%s/complete", mediaID), nil)
+	completeReq.Header.Set("Authorization", "Bearer "+token)
+	completeRR := executeRequest(completeReq)
+
+	var completeResp helper.ResponseSuccess
+	json.Unmarshal(completeRR.Body.Bytes(), &completeResp)
+	data, _ := completeResp.Data.(map[string]interface{})
+	return mediaID, completeRR.Code, data
+}
+
+func TestVoiceNotes(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "voice1")
+	u2 := createTestUser(t, "voice2")
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	t.Run("Fail - Voice Requires Audio MIME", func(t *testing.T) {
+		req := newUploadMediaRequest("voice", "voice.mp3", 1000, "audio/mpeg", "dummy-token")
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		req = newUploadMediaRequest("voice", "voice.ogg", 11*1024*1024, "audio/ogg", "dummy-token")
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Fail - Unreadable Audio Is Rejected", func(t *testing.T) {
+		mediaID, code, _ := uploadTestVoiceNote(t, token1, "audio/ogg", []byte("this is not an ogg stream"))
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		if mediaID != "" {
+			m := testClient.Media.GetX(ctx, uuid.MustParse(mediaID))
+			assert.Equal(t, "pending", string(m.UploadStatus))
+		}
+	})
+
+	t.Run("Success - Duration And Waveform Are Extracted", func(t *testing.T) {
+		mediaID, code, data := uploadTestVoiceNote(t, token1, "audio/ogg", createTestVoiceNote())
+		if !assert.Equal(t, http.StatusOK, code) || data == nil {
+			return
+		}
+		assert.Equal(t, float64(1500), data["duration_ms"])
+		waveform, _ := data["waveform"].([]interface{})
+		if assert.Len(t, waveform, helper.WaveformBars) {
+			assert.Equal(t, float64(0), waveform[0])
+			assert.Equal(t, float64(255), waveform[len(waveform)-1])
+		}
+
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+			ChatID:        privateChat.ID,
+			AttachmentIDs: []uuid.UUID{uuid.MustParse(mediaID)},
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		attachments := resp.Data.(map[string]interface{})["attachments"].([]interface{})
+		if assert.Len(t, attachments, 1) {
+			att := attachments[0].(map[string]interface{})
+			assert.Equal(t, "voice", att["category"])
+			assert.Equal(t, float64(1500), att["duration_ms"])
+			assert.Len(t, att["waveform"], helper.WaveformBars)
+		}
+	})
+}
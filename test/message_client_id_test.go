@@ -0,0 +1,160 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendClientMessage(t *testing.T, token string, chatID uuid.UUID, content, clientMessageID string) (int, map[string]interface{}) {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token, model.SendMessageRequest{
+		ChatID:          chatID,
+		Content:         content,
+		ClientMessageID: clientMessageID,
+	}))
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func TestMessageClientID(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "clientid1")
+	u2 := createTestUser(t, "clientid2")
+	u3 := createTestUser(t, "clientid3")
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	chat1 := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(chat1).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+	chat2 := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(chat2).SetUser1(u1).SetUser2(u3).SaveX(ctx)
+
+	var firstID string
+
+	t.Run("Success - Client ID Is Echoed", func(t *testing.T) {
+		code, data := sendClientMessage(t, token1, chat1.ID, "hello", "local-1")
+		assert.Equal(t, http.StatusOK, code)
+		if data == nil {
+			return
+		}
+		firstID = data["id"].(string)
+		assert.Equal(t, "local-1", data["client_message_id"])
+	})
+
+	t.Run("Success - Retry Returns Existing Message", func(t *testing.T) {
+		code, data := sendClientMessage(t, token1, chat1.ID, "hello", "local-1")
+		assert.Equal(t, http.StatusOK, code)
+		if data != nil {
+			assert.Equal(t, firstID, data["id"])
+			assert.Equal(t, "hello", data["content"])
+		}
+
+		count := testClient.Message.Query().Where(message.ChatID(chat1.ID)).CountX(ctx)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Success - Same Client ID In Other Chat Or By Other Sender", func(t *testing.T) {
+		code, data := sendClientMessage(t, token1, chat2.ID, "hello", "local-1")
+		assert.Equal(t, http.StatusOK, code)
+		if data != nil {
+			assert.NotEqual(t, firstID, data["id"])
+		}
+
+		code, data = sendClientMessage(t, token2, chat1.ID, "hi", "local-1")
+		assert.Equal(t, http.StatusOK, code)
+		if data != nil {
+			assert.NotEqual(t, firstID, data["id"])
+		}
+	})
+
+	t.Run("Success - Concurrent Retries Create One Message", func(t *testing.T) {
+		var wg sync.WaitGroup
+		ids := make([]string, 5)
+		for i := range ids {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, data := sendClientMessage(t, token1, chat1.ID, "burst", "local-burst")
+				if data != nil {
+					ids[i], _ = data["id"].(string)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for _, id := range ids {
+			assert.Equal(t, ids[0], id)
+		}
+		count := testClient.Message.Query().Where(message.ClientMessageID("local-burst")).CountX(ctx)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Fail - Retry After Block", func(t *testing.T) {
+		code, _ := sendClientMessage(t, token1, chat2.ID, "before block", "local-block")
+		assert.Equal(t, http.StatusOK, code)
+
+		testClient.UserBlock.Create().SetBlockerID(u3.ID).SetBlockedID(u1.ID).SaveX(ctx)
+
+		code, _ = sendClientMessage(t, token1, chat2.ID, "before block", "local-block")
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Fail - Client ID Too Long", func(t *testing.T) {
+		code, _ := sendClientMessage(t, token1, chat1.ID, "hello", strings.Repeat("a", 65))
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestMessageClientIDWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "clientidws1")
+	u2 := createTestUser(t, "clientidws2")
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+
+	chatEntity := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(chatEntity).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token=" + token1
+	conn, _, err := ws.DefaultDialer.Dial(wsURL, nil)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	sendClientMessage(t, token1, chatEntity.ID, "optimistic", "local-ws")
+
+	event := waitForEvent(t, conn, websocket.EventMessageNew, 2*time.Second)
+	if assert.NotNil(t, event) {
+		payload := event.Payload.(map[string]interface{})
+		assert.Equal(t, "local-ws", payload["client_message_id"])
+	}
+
+	sendClientMessage(t, token1, chatEntity.ID, "optimistic", "local-ws")
+	assert.Nil(t, waitForEvent(t, conn, websocket.EventMessageNew, 500*time.Millisecond))
+}
@@ -0,0 +1,173 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newForwardRequest(messageID uuid.UUID, token string, chatIDs ...uuid.UUID) *http.Request {
+	body, _ := json.Marshal(model.ForwardMessageRequest{ChatIDs: chatIDs})
+	req, _ := http.NewRequest("POST", fmt.Sprintf("/api/messages/%s/forward", messageID), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestForwardMessage(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "fwd1")
+	u2 := createTestUser(t, "fwd2")
+	u3 := createTestUser(t, "fwd3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	sourceChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(sourceChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	targetPrivate := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(targetPrivate).SetUser1(u1).SetUser2(u3).SaveX(ctx)
+
+	targetGroup := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(targetGroup).SetCreator(u1).SetName("Forward Group").SetInviteCode("fwdinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u3).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	attachment := testClient.Media.Create().
+		SetFileName("forward_file.jpg").
+		SetOriginalName("forward.jpg").
+		SetFileSize(1024).
+		SetMimeType("image/jpeg").
+		SetUploaderID(u2.ID).
+		SaveX(ctx)
+
+	source := testClient.Message.Create().
+		SetChat(sourceChat).
+		SetSender(u2).
+		SetType("regular").
+		SetContent("Look at this").
+		AddAttachments(attachment).
+		SaveX(ctx)
+
+	t.Run("Success - Forward To Multiple Chats", func(t *testing.T) {
+		rr := executeRequest(newForwardRequest(source.ID, token1, targetPrivate.ID, targetGroup.ID))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.([]interface{})
+		if !assert.Len(t, data, 2) {
+			return
+		}
+
+		for _, item := range data {
+			msg := item.(map[string]interface{})
+			assert.Equal(t, "Look at this", msg["content"])
+			assert.Equal(t, u1.ID.String(), msg["sender_id"])
+
+			forwardedFrom := msg["forwarded_from"].(map[string]interface{})
+			assert.Equal(t, u2.ID.String(), forwardedFrom["sender_id"])
+			assert.Equal(t, u2.FullName, stringPtr(forwardedFrom["sender_name"]))
+			assert.Equal(t, sourceChat.ID.String(), forwardedFrom["chat_id"])
+
+			attachments := msg["attachments"].([]interface{})
+			if assert.Len(t, attachments, 1) {
+				att := attachments[0].(map[string]interface{})
+				assert.NotEqual(t, attachment.ID.String(), att["id"])
+				assert.Equal(t, attachment.FileName, att["file_name"])
+			}
+		}
+
+		clones, _ := testClient.Media.Query().Where(media.FileName(attachment.FileName)).Count(ctx)
+		assert.Equal(t, 3, clones)
+
+		original, _ := testClient.Media.Get(ctx, attachment.ID)
+		assert.Equal(t, source.ID, *original.MessageID)
+
+		pc, _ := testClient.PrivateChat.Query().Where(privatechat.ChatID(targetPrivate.ID)).Only(ctx)
+		assert.Equal(t, 1, pc.User2UnreadCount)
+	})
+
+	t.Run("Success - Forwarding A Forward Keeps Origin", func(t *testing.T) {
+		forwarded := testClient.Message.Query().
+			Where(message.ChatID(targetGroup.ID), message.ForwardedFromSenderIDNotNil()).
+			FirstX(ctx)
+
+		rr := executeRequest(newForwardRequest(forwarded.ID, token1, targetPrivate.ID))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		msg := resp.Data.([]interface{})[0].(map[string]interface{})
+		forwardedFrom := msg["forwarded_from"].(map[string]interface{})
+		assert.Equal(t, u2.ID.String(), forwardedFrom["sender_id"])
+		assert.Equal(t, sourceChat.ID.String(), forwardedFrom["chat_id"])
+	})
+
+	t.Run("Fail - Cannot Edit Forwarded Message", func(t *testing.T) {
+		forwarded := testClient.Message.Query().
+			Where(message.ChatID(targetGroup.ID), message.ForwardedFromSenderIDNotNil()).
+			FirstX(ctx)
+
+		body, _ := json.Marshal(model.EditMessageRequest{Content: "changed"})
+		req, _ := http.NewRequest("PUT", "/api/messages/"+forwarded.ID.String(), bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Fail - Not Member Of Target", func(t *testing.T) {
+		before, _ := testClient.Message.Query().Where(message.ChatID(sourceChat.ID)).Count(ctx)
+
+		rr := executeRequest(newForwardRequest(source.ID, token2, sourceChat.ID, targetGroup.ID))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		after, _ := testClient.Message.Query().Where(message.ChatID(sourceChat.ID)).Count(ctx)
+		assert.Equal(t, before, after, "forward must be all-or-nothing")
+	})
+
+	t.Run("Fail - Blocked Target", func(t *testing.T) {
+		testClient.UserBlock.Create().SetBlockerID(u3.ID).SetBlockedID(u1.ID).SaveX(ctx)
+		defer testClient.UserBlock.Delete().ExecX(ctx)
+
+		rr := executeRequest(newForwardRequest(source.ID, token1, targetPrivate.ID))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Source Not Accessible", func(t *testing.T) {
+		token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+		rr := executeRequest(newForwardRequest(source.ID, token3, targetGroup.ID))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - No Targets", func(t *testing.T) {
+		rr := executeRequest(newForwardRequest(source.ID, token1))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Fail - Duplicate Targets", func(t *testing.T) {
+		rr := executeRequest(newForwardRequest(source.ID, token1, targetGroup.ID, targetGroup.ID))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
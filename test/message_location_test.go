@@ -0,0 +1,230 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendTestLocation(t *testing.T, token string, req model.SendLocationRequest) map[string]interface{} {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/locations", token, req))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})
+}
+
+func TestMessageLocations(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "loc1")
+	u2 := createTestUser(t, "loc2")
+	outsider := createTestUser(t, "locout")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	outsiderToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, outsider.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Location Group").SetInviteCode("locinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	lat, long, accuracy := -6.2, 106.816666, 15.0
+
+	t.Run("Fail - Invalid Locations", func(t *testing.T) {
+		badLat, shortPeriod := 91.0, 30
+		cases := []model.SendLocationRequest{
+			{ChatID: groupChat.ID, Longitude: &long, Accuracy: &accuracy},
+			{ChatID: groupChat.ID, Latitude: &badLat, Longitude: &long, Accuracy: &accuracy},
+			{ChatID: groupChat.ID, Latitude: &lat, Longitude: &long, Accuracy: &accuracy, LivePeriod: &shortPeriod},
+		}
+		for _, c := range cases {
+			rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/locations", token1, c))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/locations", outsiderToken, model.SendLocationRequest{
+			ChatID: groupChat.ID, Latitude: &lat, Longitude: &long, Accuracy: &accuracy,
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Static Location", func(t *testing.T) {
+		data := sendTestLocation(t, token1, model.SendLocationRequest{
+			ChatID:    groupChat.ID,
+			Latitude:  &lat,
+			Longitude: &long,
+			Accuracy:  &accuracy,
+			VenueName: "  Monas  ",
+		})
+		if data == nil {
+			return
+		}
+		assert.Equal(t, "location", data["type"])
+
+		loc := data["location"].(map[string]interface{})
+		assert.Equal(t, lat, loc["latitude"])
+		assert.Equal(t, long, loc["longitude"])
+		assert.Equal(t, "Monas", loc["venue_name"])
+		assert.Equal(t, false, loc["is_live"])
+		assert.Nil(t, loc["live_until"])
+
+		msgID, _ := uuid.Parse(data["id"].(string))
+		rr := executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/messages/%s/location", msgID), token1, model.UpdateLiveLocationRequest{
+			Latitude: &lat, Longitude: &long, Accuracy: &accuracy,
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	period := 600
+	data := sendTestLocation(t, token1, model.SendLocationRequest{
+		ChatID:     groupChat.ID,
+		Latitude:   &lat,
+		Longitude:  &long,
+		Accuracy:   &accuracy,
+		LivePeriod: &period,
+	})
+	if data == nil {
+		return
+	}
+	liveID, _ := uuid.Parse(data["id"].(string))
+
+	t.Run("Success - Live Location Is Live", func(t *testing.T) {
+		loc := data["location"].(map[string]interface{})
+		assert.Equal(t, true, loc["is_live"])
+		assert.NotNil(t, loc["live_until"])
+	})
+
+	t.Run("Fail - Only Sender Can Move Live Location", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/messages/%s/location", liveID), token2, model.UpdateLiveLocationRequest{
+			Latitude: &lat, Longitude: &long, Accuracy: &accuracy,
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Update And Stop Live Location", func(t *testing.T) {
+		newLat := -6.175
+		rr := executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/messages/%s/location", liveID), token1, model.UpdateLiveLocationRequest{
+			Latitude: &newLat, Longitude: &long, Accuracy: &accuracy,
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		loc := resp.Data.(map[string]interface{})
+		assert.Equal(t, newLat, loc["latitude"])
+		assert.Equal(t, true, loc["is_live"])
+		assert.NotNil(t, loc["updated_at"])
+
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/messages/%s/location/stop", liveID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr = executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		loc = resp.Data.(map[string]interface{})
+		assert.Equal(t, newLat, loc["latitude"])
+		assert.Equal(t, false, loc["is_live"])
+
+		rr = executeRequest(newGroupJSONRequest("PUT", fmt.Sprintf("/api/messages/%s/location", liveID), token1, model.UpdateLiveLocationRequest{
+			Latitude: &lat, Longitude: &long, Accuracy: &accuracy,
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Success - Reply Preview Includes Location", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token2, model.SendMessageRequest{
+			ChatID:    groupChat.ID,
+			Content:   "On my way",
+			ReplyToID: &liveID,
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		replyTo := resp.Data.(map[string]interface{})["reply_to"].(map[string]interface{})
+		loc, ok := replyTo["location"].(map[string]interface{})
+		if assert.True(t, ok) {
+			assert.Equal(t, false, loc["is_live"])
+		}
+	})
+}
+
+func TestMessageContacts(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "contact1")
+	u2 := createTestUser(t, "contact2")
+	shared := createTestUser(t, "contactshared")
+	blocker := createTestUser(t, "contactblocker")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	testClient.UserBlock.Create().SetBlockerID(blocker.ID).SetBlockedID(u1.ID).SaveX(ctx)
+
+	t.Run("Fail - Unknown User", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/contacts", token1, model.SendContactRequest{
+			ChatID: privateChat.ID,
+			UserID: uuid.New(),
+		}))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Fail - Blocked User Cannot Be Shared", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/contacts", token1, model.SendContactRequest{
+			ChatID: privateChat.ID,
+			UserID: blocker.ID,
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Share Contact", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/contacts", token1, model.SendContactRequest{
+			ChatID: privateChat.ID,
+			UserID: shared.ID,
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+			return
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, "contact", data["type"])
+
+		contact := data["contact"].(map[string]interface{})
+		assert.Equal(t, shared.ID.String(), contact["user_id"])
+		assert.Equal(t, *shared.Username, contact["username"])
+		assert.Equal(t, *shared.FullName, contact["full_name"])
+	})
+}
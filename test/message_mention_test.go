@@ -0,0 +1,242 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func getUnreadMentionCount(t *testing.T, chatID uuid.UUID, token string) int {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats/%s", chatID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return -1
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return int(resp.Data.(map[string]interface{})["unread_mention_count"].(float64))
+}
+
+func getNextMention(t *testing.T, chatID uuid.UUID, token string, after *uuid.UUID) (int, map[string]interface{}) {
+	t.Helper()
+
+	url := fmt.Sprintf("/api/chats/%s/mentions/next", chatID)
+	if after != nil {
+		url += "?after_message_id=" + after.String()
+	}
+	req, _ := http.NewRequest("GET", url, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func sendMentionMessage(t *testing.T, token string, chatID uuid.UUID, content string) map[string]interface{} {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token, model.SendMessageRequest{
+		ChatID:  chatID,
+		Content: content,
+	}))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})
+}
+
+func TestMessageMentions(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	owner := createTestUser(t, "mentionowner")
+	u2 := createTestUser(t, "mention2")
+	u3 := createTestUser(t, "mention3")
+	outsider := createTestUser(t, "mentionout")
+
+	ownerToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, owner.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(owner).SetName("Mention Group").SetInviteCode("mentioninv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(owner).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u3).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	var firstID, secondID uuid.UUID
+
+	t.Run("Success - Username Mention Is Stored", func(t *testing.T) {
+		data := sendMentionMessage(t, ownerToken, groupChat.ID, fmt.Sprintf("héllo @%s and @%s and @nobody", *u2.Username, *outsider.Username))
+		if data == nil {
+			return
+		}
+		firstID, _ = uuid.Parse(data["id"].(string))
+
+		mentions, _ := data["mentions"].([]interface{})
+		if assert.Len(t, mentions, 1) {
+			m := mentions[0].(map[string]interface{})
+			assert.Equal(t, "user", m["type"])
+			assert.Equal(t, u2.ID.String(), m["user_id"])
+			assert.Equal(t, float64(6), m["offset"])
+			assert.Equal(t, float64(len(*u2.Username)+1), m["length"])
+		}
+
+		assert.Equal(t, 1, getUnreadMentionCount(t, groupChat.ID, token2))
+		assert.Equal(t, 0, getUnreadMentionCount(t, groupChat.ID, token3))
+	})
+
+	t.Run("Success - Member Cannot Mention All", func(t *testing.T) {
+		data := sendMentionMessage(t, token2, groupChat.ID, "hey @all")
+		if data == nil {
+			return
+		}
+		assert.Nil(t, data["mentions"])
+		assert.Equal(t, 0, getUnreadMentionCount(t, groupChat.ID, token3))
+	})
+
+	t.Run("Success - Owner Mentions All", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		data := sendMentionMessage(t, ownerToken, groupChat.ID, "@all meeting now")
+		if data == nil {
+			return
+		}
+		secondID, _ = uuid.Parse(data["id"].(string))
+
+		mentions, _ := data["mentions"].([]interface{})
+		if assert.Len(t, mentions, 1) {
+			m := mentions[0].(map[string]interface{})
+			assert.Equal(t, "all", m["type"])
+			assert.Nil(t, m["user_id"])
+		}
+
+		assert.Equal(t, 2, getUnreadMentionCount(t, groupChat.ID, token2))
+		assert.Equal(t, 1, getUnreadMentionCount(t, groupChat.ID, token3))
+	})
+
+	t.Run("Success - Jump Through Unread Mentions", func(t *testing.T) {
+		code, data := getNextMention(t, groupChat.ID, token2, nil)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, firstID.String(), data["id"])
+
+		code, data = getNextMention(t, groupChat.ID, token2, &firstID)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, secondID.String(), data["id"])
+
+		code, _ = getNextMention(t, groupChat.ID, token2, &secondID)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, data = getNextMention(t, groupChat.ID, token3, nil)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, secondID.String(), data["id"])
+	})
+
+	t.Run("Success - Edit Updates Counters", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/messages/"+firstID.String(), ownerToken, model.EditMessageRequest{
+			Content: fmt.Sprintf("hello @%s", *u3.Username),
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		assert.Equal(t, 1, getUnreadMentionCount(t, groupChat.ID, token2))
+		assert.Equal(t, 2, getUnreadMentionCount(t, groupChat.ID, token3))
+	})
+
+	t.Run("Success - Delete Updates Counters", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/api/messages/"+secondID.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		assert.Equal(t, 0, getUnreadMentionCount(t, groupChat.ID, token2))
+		assert.Equal(t, 1, getUnreadMentionCount(t, groupChat.ID, token3))
+	})
+
+	t.Run("Success - Mark As Read Clears Mentions", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/read", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token3)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		assert.Equal(t, 0, getUnreadMentionCount(t, groupChat.ID, token3))
+		code, _ := getNextMention(t, groupChat.ID, token3, nil)
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	t.Run("Fail - Private Chat", func(t *testing.T) {
+		privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+		testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(owner).SetUser2(u2).SaveX(ctx)
+
+		data := sendMentionMessage(t, ownerToken, privateChat.ID, "@"+*u2.Username)
+		if data != nil {
+			assert.Nil(t, data["mentions"])
+		}
+
+		code, _ := getNextMention(t, privateChat.ID, ownerToken, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestMessageMentionWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+
+	u1 := createTestUser(t, "mentionws1")
+	u2 := createTestUser(t, "mentionws2")
+	u3 := createTestUser(t, "mentionws3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	groupID := createWSGroupChat(t, token1, "Mention WS Group", []uuid.UUID{u2.ID, u3.ID}, false)
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn2, _, err := ws.DefaultDialer.Dial(wsURL+token2, nil)
+	assert.NoError(t, err)
+	defer conn2.Close()
+	conn3, _, err := ws.DefaultDialer.Dial(wsURL+token3, nil)
+	assert.NoError(t, err)
+	defer conn3.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	data := sendMentionMessage(t, token1, groupID, "ping @"+*u2.Username)
+
+	event := waitForEvent(t, conn2, websocket.EventMessageMention, 2*time.Second)
+	if assert.NotNil(t, event) && data != nil {
+		payload := event.Payload.(map[string]interface{})
+		assert.Equal(t, data["id"], payload["id"])
+		assert.Equal(t, groupID.String(), event.Meta.ChatID.String())
+	}
+
+	assert.Nil(t, waitForEvent(t, conn3, websocket.EventMessageMention, 500*time.Millisecond))
+}
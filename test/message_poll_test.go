@@ -0,0 +1,348 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendTestPoll(t *testing.T, token string, req model.SendPollRequest) map[string]interface{} {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/polls", token, req))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})
+}
+
+func pollOptionIDs(msg map[string]interface{}) []uuid.UUID {
+	poll := msg["poll"].(map[string]interface{})
+	var ids []uuid.UUID
+	for _, o := range poll["options"].([]interface{}) {
+		id, _ := uuid.Parse(o.(map[string]interface{})["id"].(string))
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func votePoll(t *testing.T, token string, messageID uuid.UUID, optionIDs ...uuid.UUID) (int, map[string]interface{}) {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", fmt.Sprintf("/api/messages/%s/poll/votes", messageID), token, model.PollVoteRequest{
+		OptionIDs: optionIDs,
+	}))
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func pollVoteCounts(poll map[string]interface{}) []int {
+	var counts []int
+	for _, o := range poll["options"].([]interface{}) {
+		counts = append(counts, int(o.(map[string]interface{})["vote_count"].(float64)))
+	}
+	return counts
+}
+
+func TestMessagePolls(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	owner := createTestUser(t, "pollowner")
+	u2 := createTestUser(t, "poll2")
+	u3 := createTestUser(t, "poll3")
+	outsider := createTestUser(t, "pollout")
+
+	ownerToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, owner.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+	outsiderToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, outsider.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(owner).SetName("Poll Group").SetInviteCode("pollinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(owner).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u3).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	t.Run("Fail - Invalid Polls", func(t *testing.T) {
+		correct := 5
+		cases := []model.SendPollRequest{
+			{ChatID: groupChat.ID, Question: "One option?", Options: []string{"Yes"}},
+			{ChatID: groupChat.ID, Question: "Duplicates?", Options: []string{"Yes", "Yes"}},
+			{ChatID: groupChat.ID, Question: "Quiz?", Options: []string{"A", "B"}, Quiz: true},
+			{ChatID: groupChat.ID, Question: "Quiz?", Options: []string{"A", "B"}, Quiz: true, CorrectOption: &correct},
+		}
+		for _, c := range cases {
+			rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/polls", ownerToken, c))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		}
+
+		past := time.Now().Add(-time.Minute)
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/polls", ownerToken, model.SendPollRequest{
+			ChatID: groupChat.ID, Question: "Late?", Options: []string{"A", "B"}, ClosesAt: &past,
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		rr = executeRequest(newGroupJSONRequest("POST", "/api/messages/polls", outsiderToken, model.SendPollRequest{
+			ChatID: groupChat.ID, Question: "Outsider?", Options: []string{"A", "B"},
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	data := sendTestPoll(t, ownerToken, model.SendPollRequest{
+		ChatID:   groupChat.ID,
+		Question: "  Lunch?  ",
+		Options:  []string{"Pizza", " Sushi ", "Salad"},
+	})
+	if data == nil {
+		return
+	}
+	pollMsgID, _ := uuid.Parse(data["id"].(string))
+	options := pollOptionIDs(data)
+
+	t.Run("Success - Poll Message Created", func(t *testing.T) {
+		assert.Equal(t, "poll", data["type"])
+		assert.Equal(t, "Lunch?", data["content"])
+
+		poll := data["poll"].(map[string]interface{})
+		assert.Equal(t, "Lunch?", poll["question"])
+		assert.Equal(t, false, poll["is_closed"])
+		assert.Equal(t, float64(0), poll["total_voters"])
+		assert.Len(t, options, 3)
+		assert.Equal(t, "Sushi", poll["options"].([]interface{})[1].(map[string]interface{})["text"])
+	})
+
+	t.Run("Success - Vote And Change Vote", func(t *testing.T) {
+		code, poll := votePoll(t, token2, pollMsgID, options[0])
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []int{1, 0, 0}, pollVoteCounts(poll))
+		assert.Equal(t, []interface{}{options[0].String()}, poll["my_votes"])
+
+		code, poll = votePoll(t, token2, pollMsgID, options[1])
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []int{0, 1, 0}, pollVoteCounts(poll))
+
+		code, poll = votePoll(t, token3, pollMsgID, options[1])
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []int{0, 2, 0}, pollVoteCounts(poll))
+		assert.Equal(t, float64(2), poll["total_voters"])
+	})
+
+	t.Run("Fail - Invalid Votes", func(t *testing.T) {
+		code, _ := votePoll(t, token2, pollMsgID, options[0], options[1])
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = votePoll(t, token2, pollMsgID, uuid.New())
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = votePoll(t, outsiderToken, pollMsgID, options[0])
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Success - Tallies In Message List", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats/%s/messages", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token3)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		var found map[string]interface{}
+		for _, m := range resp.Data.([]interface{}) {
+			if m.(map[string]interface{})["id"] == pollMsgID.String() {
+				found = m.(map[string]interface{})
+			}
+		}
+		if assert.NotNil(t, found) {
+			poll := found["poll"].(map[string]interface{})
+			assert.Equal(t, []int{0, 2, 0}, pollVoteCounts(poll))
+			assert.Equal(t, []interface{}{options[1].String()}, poll["my_votes"])
+		}
+	})
+
+	t.Run("Success - Vote List", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/poll/votes?option_id=%s", pollMsgID, options[1]), nil)
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Len(t, resp.Data.([]interface{}), 2)
+	})
+
+	t.Run("Success - Retract Vote", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/messages/%s/poll/votes", pollMsgID), nil)
+		req.Header.Set("Authorization", "Bearer "+token3)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Fail - Member Cannot Close Others Poll", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/messages/%s/poll/close", pollMsgID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Success - Close Poll", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/messages/%s/poll/close", pollMsgID), nil)
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		poll := resp.Data.(map[string]interface{})
+		assert.Equal(t, true, poll["is_closed"])
+		assert.NotNil(t, poll["closed_at"])
+
+		code, _ := votePoll(t, token3, pollMsgID, options[0])
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("Success - Anonymous Quiz", func(t *testing.T) {
+		correct := 1
+		quiz := sendTestPoll(t, ownerToken, model.SendPollRequest{
+			ChatID:        groupChat.ID,
+			Question:      "2 + 2?",
+			Options:       []string{"3", "4"},
+			Quiz:          true,
+			Anonymous:     true,
+			CorrectOption: &correct,
+		})
+		if quiz == nil {
+			return
+		}
+		quizID, _ := uuid.Parse(quiz["id"].(string))
+		quizOptions := pollOptionIDs(quiz)
+
+		creatorView := quiz["poll"].(map[string]interface{})["options"].([]interface{})
+		assert.Equal(t, true, creatorView[1].(map[string]interface{})["is_correct"])
+
+		code, poll := votePoll(t, token2, quizID, quizOptions[0])
+		assert.Equal(t, http.StatusOK, code)
+		answers := poll["options"].([]interface{})
+		assert.Equal(t, false, answers[0].(map[string]interface{})["is_correct"])
+		assert.Equal(t, true, answers[1].(map[string]interface{})["is_correct"])
+
+		code, _ = votePoll(t, token2, quizID, quizOptions[1])
+		assert.Equal(t, http.StatusConflict, code)
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/messages/%s/poll/votes", quizID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/poll/votes", quizID), nil)
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestMessagePollBlocked(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "pollblock1")
+	u2 := createTestUser(t, "pollblock2")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	data := sendTestPoll(t, token1, model.SendPollRequest{
+		ChatID:   privateChat.ID,
+		Question: "Lunch?",
+		Options:  []string{"Yes", "No"},
+	})
+	if data == nil {
+		return
+	}
+	pollMsgID, _ := uuid.Parse(data["id"].(string))
+	options := pollOptionIDs(data)
+
+	code, _ := votePoll(t, token2, pollMsgID, options[0])
+	assert.Equal(t, http.StatusOK, code)
+
+	testClient.UserBlock.Create().SetBlockerID(u1.ID).SetBlockedID(u2.ID).SaveX(ctx)
+
+	code, _ = votePoll(t, token2, pollMsgID, options[1])
+	assert.Equal(t, http.StatusForbidden, code)
+
+	req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/messages/%s/poll/votes", pollMsgID), nil)
+	req.Header.Set("Authorization", "Bearer "+token2)
+	assert.Equal(t, http.StatusForbidden, executeRequest(req).Code)
+}
+
+func TestMessagePollWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+
+	u1 := createTestUser(t, "pollws1")
+	u2 := createTestUser(t, "pollws2")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	groupID := createWSGroupChat(t, token1, "Poll WS Group", []uuid.UUID{u2.ID}, false)
+
+	data := sendTestPoll(t, token1, model.SendPollRequest{
+		ChatID:   groupID,
+		Question: "Ship it?",
+		Options:  []string{"Yes", "No"},
+	})
+	if data == nil {
+		return
+	}
+	pollMsgID, _ := uuid.Parse(data["id"].(string))
+	options := pollOptionIDs(data)
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token=" + token1
+	conn, _, err := ws.DefaultDialer.Dial(wsURL, nil)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	code, _ := votePoll(t, token2, pollMsgID, options[0])
+	assert.Equal(t, http.StatusOK, code)
+
+	event := waitForEvent(t, conn, websocket.EventMessageUpdate, 2*time.Second)
+	if assert.NotNil(t, event) {
+		payload := event.Payload.(map[string]interface{})
+		assert.Equal(t, pollMsgID.String(), payload["id"])
+		poll := payload["poll"].(map[string]interface{})
+		assert.Equal(t, []int{1, 0}, pollVoteCounts(poll))
+		assert.Nil(t, poll["my_votes"])
+	}
+}
@@ -0,0 +1,258 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/messagereaction"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func newReactionRequest(method string, messageID uuid.UUID, token, emoji string) *http.Request {
+	body, _ := json.Marshal(model.MessageReactionRequest{Emoji: emoji})
+	req, _ := http.NewRequest(method, fmt.Sprintf("/api/messages/%s/reactions", messageID), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestMessageReactions(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "react1")
+	u2 := createTestUser(t, "react2")
+	u3 := createTestUser(t, "react3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	chatEntity := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(chatEntity).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	msg := testClient.Message.Create().
+		SetChatID(chatEntity.ID).
+		SetSenderID(u1.ID).
+		SetType("regular").
+		SetContent("React to me").
+		SaveX(ctx)
+
+	t.Run("Success - Add Reaction", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("POST", msg.ID, token2, "👍"))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, "added", data["action"])
+		assert.Equal(t, "👍", data["emoji"])
+
+		reactions := data["reactions"].([]interface{})
+		assert.Len(t, reactions, 1)
+		assert.Equal(t, float64(1), reactions[0].(map[string]interface{})["count"])
+		assert.Equal(t, []interface{}{"👍"}, data["my_reactions"])
+	})
+
+	t.Run("Success - Add Same Reaction Is Idempotent", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("POST", msg.ID, token2, "👍"))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		count, _ := testClient.MessageReaction.Query().Where(messagereaction.MessageID(msg.ID)).Count(ctx)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Success - Aggregated In Messages", func(t *testing.T) {
+		executeRequest(newReactionRequest("POST", msg.ID, token1, "👍"))
+		executeRequest(newReactionRequest("POST", msg.ID, token1, "❤️"))
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats/%s/messages", chatEntity.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		msgs := resp.Data.([]interface{})
+		assert.Len(t, msgs, 1)
+
+		m := msgs[0].(map[string]interface{})
+		reactions := m["reactions"].([]interface{})
+		assert.Len(t, reactions, 2)
+		first := reactions[0].(map[string]interface{})
+		assert.Equal(t, "👍", first["emoji"])
+		assert.Equal(t, float64(2), first["count"])
+		assert.Equal(t, []interface{}{"👍"}, m["my_reactions"])
+	})
+
+	t.Run("Success - List Reactions", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/reactions?limit=1", msg.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.Len(t, resp.Data.([]interface{}), 1)
+		assert.True(t, resp.Meta.HasNext)
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/reactions?emoji=%s", msg.ID, "%E2%9D%A4%EF%B8%8F"), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.([]interface{})
+		assert.Len(t, data, 1)
+		assert.Equal(t, u1.ID.String(), data[0].(map[string]interface{})["user_id"])
+	})
+
+	t.Run("Success - Remove Reaction", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("DELETE", msg.ID, token2, "👍"))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, "removed", data["action"])
+		assert.Nil(t, data["my_reactions"])
+
+		exists, _ := testClient.MessageReaction.Query().
+			Where(messagereaction.MessageID(msg.ID), messagereaction.UserID(u2.ID)).
+			Exist(ctx)
+		assert.False(t, exists)
+	})
+
+	t.Run("Fail - Remove Missing Reaction", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("DELETE", msg.ID, token2, "👍"))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Fail - Invalid Emoji", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("POST", msg.ID, token2, "hello"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Fail - Not A Member", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("POST", msg.ID, token3, "👍"))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/reactions", msg.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token3)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Blocked User", func(t *testing.T) {
+		testClient.UserBlock.Create().SetBlockerID(u1.ID).SetBlockedID(u2.ID).ExecX(ctx)
+		defer testClient.UserBlock.Delete().ExecX(ctx)
+
+		rr := executeRequest(newReactionRequest("POST", msg.ID, token2, "😂"))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - React To Deleted Message", func(t *testing.T) {
+		deletedMsg := testClient.Message.Create().
+			SetChatID(chatEntity.ID).
+			SetSenderID(u1.ID).
+			SetType("regular").
+			SetContent("gone").
+			SaveX(ctx)
+
+		executeRequest(newReactionRequest("POST", deletedMsg.ID, token2, "👍"))
+
+		req, _ := http.NewRequest("DELETE", fmt.Sprintf("/api/messages/%s", deletedMsg.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		count, _ := testClient.MessageReaction.Query().Where(messagereaction.MessageID(deletedMsg.ID)).Count(ctx)
+		assert.Equal(t, 0, count, "Reactions should be removed with the message")
+
+		rr = executeRequest(newReactionRequest("POST", deletedMsg.ID, token2, "👍"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestMessageReactionWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+
+	u1 := createWSUser(t, "reactws1", "reactws1@example.com")
+	u2 := createWSUser(t, "reactws2", "reactws2@example.com")
+	u3 := createWSUser(t, "reactws3", "reactws3@example.com")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	groupID := createWSGroupChat(t, token1, "Reaction Group", []uuid.UUID{u2.ID, u3.ID}, false)
+
+	req := newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+		ChatID:  groupID,
+		Content: "Group message",
+	})
+	rr := executeRequest(req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var msgResp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &msgResp)
+	msgID, _ := uuid.Parse(msgResp.Data.(map[string]interface{})["id"].(string))
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn1, _, err := ws.DefaultDialer.Dial(wsURL+token1, nil)
+	assert.NoError(t, err)
+	defer conn1.Close()
+	conn3, _, err := ws.DefaultDialer.Dial(wsURL+token3, nil)
+	assert.NoError(t, err)
+	defer conn3.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	t.Run("Broadcast To Chat Members", func(t *testing.T) {
+		rr := executeRequest(newReactionRequest("POST", msgID, token2, "🔥"))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		event := waitForEvent(t, conn1, websocket.EventMessageReaction, 2*time.Second)
+		if assert.NotNil(t, event) {
+			payload := event.Payload.(map[string]interface{})
+			assert.Equal(t, msgID.String(), payload["message_id"])
+			assert.Equal(t, u2.ID.String(), payload["user_id"])
+			assert.Equal(t, "added", payload["action"])
+			assert.Nil(t, payload["my_reactions"])
+			assert.Equal(t, u2.ID.String(), event.Meta.SenderID.String())
+		}
+
+		assert.NotNil(t, waitForEvent(t, conn3, websocket.EventMessageReaction, 2*time.Second))
+	})
+
+	t.Run("Skip Members Who Blocked Reactor", func(t *testing.T) {
+		testClient.UserBlock.Create().SetBlockerID(u3.ID).SetBlockedID(u2.ID).ExecX(context.Background())
+		defer testClient.UserBlock.Delete().ExecX(context.Background())
+
+		rr := executeRequest(newReactionRequest("DELETE", msgID, token2, "🔥"))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		assert.NotNil(t, waitForEvent(t, conn1, websocket.EventMessageReaction, 2*time.Second))
+		assert.Nil(t, waitForEvent(t, conn3, websocket.EventMessageReaction, 1*time.Second))
+	})
+}
@@ -0,0 +1,204 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendTestMessage(t *testing.T, token string, chatID uuid.UUID, content string) uuid.UUID {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token, model.SendMessageRequest{
+		ChatID:  chatID,
+		Content: content,
+	}))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	id, _ := uuid.Parse(resp.Data.(map[string]interface{})["id"].(string))
+	return id
+}
+
+func getMessageReceipts(t *testing.T, messageID uuid.UUID, token string) (int, map[string]interface{}) {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/receipts", messageID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.(map[string]interface{})
+	return rr.Code, data
+}
+
+func receiptUserIDs(list interface{}) []string {
+	ids := make([]string, 0)
+	items, _ := list.([]interface{})
+	for _, item := range items {
+		ids = append(ids, item.(map[string]interface{})["user_id"].(string))
+	}
+	return ids
+}
+
+func TestMessageReceipts(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "rcpt1")
+	u2 := createTestUser(t, "rcpt2")
+	u3 := createTestUser(t, "rcpt3")
+	u4 := createTestUser(t, "rcpt4")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+	token4, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u4.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Receipt Group").SetInviteCode("rcptinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u3).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	first := sendTestMessage(t, token1, groupChat.ID, "first")
+	time.Sleep(10 * time.Millisecond)
+	second := sendTestMessage(t, token1, groupChat.ID, "second")
+
+	t.Run("Success - Nobody Has Read Yet", func(t *testing.T) {
+		code, data := getMessageReceipts(t, first, token1)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, data["read_by"], 0)
+		assert.Len(t, data["delivered_to"], 0)
+	})
+
+	t.Run("Success - Reading Covers Earlier Messages", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/read", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		member := testClient.GroupMember.Query().
+			Where(groupmember.GroupChatID(gc.ID), groupmember.UserID(u2.ID)).
+			OnlyX(ctx)
+		if assert.NotNil(t, member.LastReadMessageID) {
+			assert.Equal(t, second, *member.LastReadMessageID)
+		}
+
+		for _, id := range []uuid.UUID{first, second} {
+			code, data := getMessageReceipts(t, id, token3)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, []string{u2.ID.String()}, receiptUserIDs(data["read_by"]))
+		}
+	})
+
+	t.Run("Success - Read Position Does Not Cover Later Messages", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		third := sendTestMessage(t, token1, groupChat.ID, "third")
+
+		code, data := getMessageReceipts(t, third, token1)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, data["read_by"], 0)
+	})
+
+	t.Run("Fail - Not A Member", func(t *testing.T) {
+		code, _ := getMessageReceipts(t, first, token4)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Fail - Private Chat", func(t *testing.T) {
+		privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+		testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+		msg := testClient.Message.Create().SetChat(privateChat).SetSender(u1).SetType("regular").
+			SetContent("private").SaveX(ctx)
+
+		code, _ := getMessageReceipts(t, msg.ID, token1)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestMessageReceiptsWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+
+	u1 := createTestUser(t, "rcptws1")
+	u2 := createTestUser(t, "rcptws2")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	groupID := createWSGroupChat(t, token1, "Receipt WS Group", []uuid.UUID{u2.ID}, false)
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn1, _, err := ws.DefaultDialer.Dial(wsURL+token1, nil)
+	assert.NoError(t, err)
+	defer conn1.Close()
+	conn2, _, err := ws.DefaultDialer.Dial(wsURL+token2, nil)
+	assert.NoError(t, err)
+	defer conn2.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	msgID := sendTestMessage(t, token1, groupID, "hello")
+
+	t.Run("Success - Ack Records Delivery", func(t *testing.T) {
+		event := waitForEvent(t, conn2, websocket.EventMessageNew, 2*time.Second)
+		assert.NotNil(t, event)
+
+		err := conn2.WriteJSON(websocket.Event{
+			Type:    websocket.EventMessageDelivered,
+			Payload: map[string]interface{}{"message_id": msgID.String()},
+		})
+		assert.NoError(t, err)
+
+		delivered := waitForEvent(t, conn1, websocket.EventMessageDelivered, 2*time.Second)
+		if assert.NotNil(t, delivered) {
+			payload := delivered.Payload.(map[string]interface{})
+			assert.Equal(t, msgID.String(), payload["message_id"])
+			assert.Equal(t, u2.ID.String(), payload["user_id"])
+		}
+
+		code, data := getMessageReceipts(t, msgID, token1)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, data["read_by"], 0)
+		assert.Equal(t, []string{u2.ID.String()}, receiptUserIDs(data["delivered_to"]))
+	})
+
+	t.Run("Success - Read Broadcast Carries Message Position", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/read", groupID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		event := waitForEvent(t, conn1, websocket.EventChatRead, 2*time.Second)
+		if assert.NotNil(t, event) {
+			payload := event.Payload.(map[string]interface{})
+			assert.Equal(t, u2.ID.String(), payload["user_id"])
+			assert.Equal(t, msgID.String(), payload["last_read_message_id"])
+		}
+
+		_, data := getMessageReceipts(t, msgID, token1)
+		assert.Equal(t, []string{u2.ID.String()}, receiptUserIDs(data["read_by"]))
+		assert.Len(t, data["delivered_to"], 0)
+	})
+}
@@ -0,0 +1,153 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/report"
+	"AtoiTalkAPI/ent/user"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func getMessageRevisions(t *testing.T, messageID uuid.UUID, token string) (int, []interface{}) {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/revisions", messageID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	data, _ := resp.Data.([]interface{})
+	return rr.Code, data
+}
+
+func TestMessageRevisions(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "rev1")
+	u2 := createTestUser(t, "rev2")
+	u3 := createTestUser(t, "rev3")
+	admin := createTestUser(t, "revadmin")
+	testClient.User.UpdateOne(admin).SetRole(user.RoleAdmin).ExecX(ctx)
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+	adminToken, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, admin.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	original := testClient.Media.Create().
+		SetFileName("rev_original.jpg").
+		SetOriginalName("original.jpg").
+		SetFileSize(1024).
+		SetMimeType("image/jpeg").
+		SetUploaderID(u1.ID).
+		SaveX(ctx)
+
+	msg := testClient.Message.Create().
+		SetChatID(privateChat.ID).
+		SetSenderID(u1.ID).
+		SetType(message.TypeRegular).
+		SetContent("Something rude").
+		AddAttachmentIDs(original.ID).
+		SaveX(ctx)
+
+	t.Run("Success - No Revisions Before Edit", func(t *testing.T) {
+		code, data := getMessageRevisions(t, msg.ID, token2)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, data, 0)
+	})
+
+	t.Run("Success - Edits Keep Every Version", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/messages/"+msg.ID.String(), token1, model.EditMessageRequest{
+			Content:       "Something nice",
+			AttachmentIDs: []uuid.UUID{},
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		rr = executeRequest(newGroupJSONRequest("PUT", "/api/messages/"+msg.ID.String(), token1, model.EditMessageRequest{
+			Content: "Something nicer",
+		}))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		code, data := getMessageRevisions(t, msg.ID, token2)
+		assert.Equal(t, http.StatusOK, code)
+		if assert.Len(t, data, 2) {
+			first := data[0].(map[string]interface{})
+			assert.Equal(t, "Something rude", first["content"])
+			if attachments := first["attachments"].([]interface{}); assert.Len(t, attachments, 1) {
+				assert.Equal(t, original.ID.String(), attachments[0].(map[string]interface{})["id"])
+			}
+
+			second := data[1].(map[string]interface{})
+			assert.Equal(t, "Something nice", second["content"])
+			assert.Len(t, second["attachments"], 0)
+		}
+
+		att := testClient.Media.Query().Where(media.ID(original.ID)).OnlyX(ctx)
+		assert.Nil(t, att.MessageID)
+	})
+
+	t.Run("Success - Unchanged Edit Adds No Revision", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/messages/"+msg.ID.String(), token1, model.EditMessageRequest{
+			Content: "Something nicer",
+		}))
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		_, data := getMessageRevisions(t, msg.ID, token1)
+		assert.Len(t, data, 2)
+	})
+
+	t.Run("Fail - Not A Participant", func(t *testing.T) {
+		code, _ := getMessageRevisions(t, msg.ID, token3)
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Success - Admin Report Detail Includes Revisions", func(t *testing.T) {
+		rpt := testClient.Report.Create().
+			SetReporter(u2).
+			SetTargetType(report.TargetTypeMessage).
+			SetMessageID(msg.ID).
+			SetReason("harassment").
+			SaveX(ctx)
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/admin/reports/%s", rpt.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		rr := executeRequest(req)
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		revisions := resp.Data.(map[string]interface{})["message_revisions"].([]interface{})
+		if assert.Len(t, revisions, 2) {
+			assert.Equal(t, "Something rude", revisions[0].(map[string]interface{})["content"])
+		}
+	})
+
+	t.Run("Fail - Deleted Message Hides Revisions", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/api/messages/"+msg.ID.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		code, _ := getMessageRevisions(t, msg.ID, token2)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
@@ -0,0 +1,272 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/media"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/ent/scheduledmessage"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/scheduler/job"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func scheduleTestMessage(t *testing.T, token string, req model.ScheduleMessageRequest) uuid.UUID {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/scheduled", token, req))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return uuid.Nil
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	id, _ := uuid.Parse(resp.Data.(map[string]interface{})["id"].(string))
+	return id
+}
+
+func makeScheduledMessageDue(ctx context.Context, id uuid.UUID) {
+	testClient.ScheduledMessage.UpdateOneID(id).SetSendAt(time.Now().UTC().Add(-time.Second)).ExecX(ctx)
+}
+
+func TestScheduledMessages(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "sched1")
+	u2 := createTestUser(t, "sched2")
+	u3 := createTestUser(t, "sched3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	attachment := testClient.Media.Create().
+		SetFileName("scheduled_file.jpg").
+		SetOriginalName("scheduled.jpg").
+		SetFileSize(1024).
+		SetMimeType("image/jpeg").
+		SetUploaderID(u1.ID).
+		SaveX(ctx)
+
+	var scheduledID uuid.UUID
+
+	t.Run("Success - Schedule With Attachment", func(t *testing.T) {
+		sendAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+		scheduledID = scheduleTestMessage(t, token1, model.ScheduleMessageRequest{
+			SendMessageRequest: model.SendMessageRequest{
+				ChatID:        privateChat.ID,
+				Content:       "  See you tomorrow  ",
+				AttachmentIDs: []uuid.UUID{attachment.ID},
+			},
+			SendAt: sendAt,
+		})
+
+		sm := testClient.ScheduledMessage.GetX(ctx, scheduledID)
+		assert.Equal(t, "See you tomorrow", *sm.Content)
+		assert.True(t, sm.SendAt.Equal(sendAt))
+		assert.Equal(t, scheduledmessage.StatusPending, sm.Status)
+
+		att := testClient.Media.GetX(ctx, attachment.ID)
+		if assert.NotNil(t, att.ScheduledMessageID) {
+			assert.Equal(t, scheduledID, *att.ScheduledMessageID)
+		}
+	})
+
+	t.Run("Fail - Attachment Already Scheduled", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+			ChatID:        privateChat.ID,
+			AttachmentIDs: []uuid.UUID{attachment.ID},
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Success - List Own Scheduled Messages", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/scheduled?chat_id=%s", privateChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.([]interface{})
+		if assert.Len(t, data, 1) {
+			item := data[0].(map[string]interface{})
+			assert.Equal(t, scheduledID.String(), item["id"])
+			assert.Equal(t, "pending", item["status"])
+			assert.Len(t, item["attachments"], 1)
+		}
+
+		req, _ = http.NewRequest("GET", "/api/messages/scheduled", nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var other helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &other)
+		assert.Len(t, other.Data, 0)
+	})
+
+	t.Run("Fail - Send At In The Past", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/scheduled", token1, model.ScheduleMessageRequest{
+			SendMessageRequest: model.SendMessageRequest{ChatID: privateChat.ID, Content: "Too late"},
+			SendAt:             time.Now().UTC().Add(-time.Minute),
+		}))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Fail - Not A Participant", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages/scheduled", token3, model.ScheduleMessageRequest{
+			SendMessageRequest: model.SendMessageRequest{ChatID: privateChat.ID, Content: "Hello"},
+			SendAt:             time.Now().UTC().Add(time.Hour),
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Update By Another User", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/messages/scheduled/"+scheduledID.String(), token2, model.UpdateScheduledMessageRequest{
+			Content: "Hijacked",
+			SendAt:  time.Now().UTC().Add(time.Hour),
+		}))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Success - Update Keeps Attachment", func(t *testing.T) {
+		rr := executeRequest(newGroupJSONRequest("PUT", "/api/messages/scheduled/"+scheduledID.String(), token1, model.UpdateScheduledMessageRequest{
+			Content:       "See you later",
+			AttachmentIDs: []uuid.UUID{attachment.ID},
+			SendAt:        time.Now().UTC().Add(2 * time.Hour),
+		}))
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
+			printBody(t, rr)
+		}
+
+		var resp helper.ResponseSuccess
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.(map[string]interface{})
+		assert.Equal(t, "See you later", data["content"])
+		assert.Len(t, data["attachments"], 1)
+	})
+
+	t.Run("Success - Dispatch Due Message", func(t *testing.T) {
+		makeScheduledMessageDue(ctx, scheduledID)
+
+		err := job.RunScheduledMessageDispatch(ctx, testClient, testMessageService)
+		assert.NoError(t, err)
+
+		exists, _ := testClient.ScheduledMessage.Query().Where(scheduledmessage.ID(scheduledID)).Exist(ctx)
+		assert.False(t, exists)
+
+		msg, err := testClient.Message.Query().
+			Where(message.ChatID(privateChat.ID)).
+			WithAttachments().
+			Only(ctx)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "See you later", *msg.Content)
+			assert.Equal(t, u1.ID, *msg.SenderID)
+			if assert.Len(t, msg.Edges.Attachments, 1) {
+				assert.Equal(t, attachment.ID, msg.Edges.Attachments[0].ID)
+			}
+		}
+
+		att := testClient.Media.Query().Where(media.ID(attachment.ID)).OnlyX(ctx)
+		assert.Nil(t, att.ScheduledMessageID)
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(privateChat.ID)).OnlyX(ctx)
+		assert.Equal(t, 1, pc.User2UnreadCount)
+	})
+
+	t.Run("Success - Rejected Send Is Marked Failed", func(t *testing.T) {
+		id := scheduleTestMessage(t, token1, model.ScheduleMessageRequest{
+			SendMessageRequest: model.SendMessageRequest{ChatID: privateChat.ID, Content: "Blocked soon"},
+			SendAt:             time.Now().UTC().Add(time.Hour),
+		})
+
+		testClient.UserBlock.Create().SetBlockerID(u2.ID).SetBlockedID(u1.ID).SaveX(ctx)
+		defer testClient.UserBlock.Delete().ExecX(ctx)
+
+		makeScheduledMessageDue(ctx, id)
+		err := job.RunScheduledMessageDispatch(ctx, testClient, testMessageService)
+		assert.NoError(t, err)
+
+		sm := testClient.ScheduledMessage.GetX(ctx, id)
+		assert.Equal(t, scheduledmessage.StatusFailed, sm.Status)
+		assert.NotNil(t, sm.FailureReason)
+
+		count, _ := testClient.Message.Query().Where(message.ChatID(privateChat.ID)).Count(ctx)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("Success - Cancel", func(t *testing.T) {
+		id := scheduleTestMessage(t, token1, model.ScheduleMessageRequest{
+			SendMessageRequest: model.SendMessageRequest{ChatID: privateChat.ID, Content: "Never mind"},
+			SendAt:             time.Now().UTC().Add(time.Hour),
+		})
+
+		req, _ := http.NewRequest("DELETE", "/api/messages/scheduled/"+id.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		req, _ = http.NewRequest("DELETE", "/api/messages/scheduled/"+id.String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestScheduledMessageWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createWSUser(t, "schedws1", "schedws1@example.com")
+	u2 := createWSUser(t, "schedws2", "schedws2@example.com")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	groupID := createWSGroupChat(t, token1, "Scheduled WS Group", []uuid.UUID{u2.ID}, false)
+
+	id := scheduleTestMessage(t, token1, model.ScheduleMessageRequest{
+		SendMessageRequest: model.SendMessageRequest{ChatID: groupID, Content: "Good morning"},
+		SendAt:             time.Now().UTC().Add(time.Hour),
+	})
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn2, _, err := ws.DefaultDialer.Dial(wsURL+token2, nil)
+	assert.NoError(t, err)
+	defer conn2.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	makeScheduledMessageDue(ctx, id)
+	assert.NoError(t, job.RunScheduledMessageDispatch(ctx, testClient, testMessageService))
+
+	event := waitForEvent(t, conn2, websocket.EventMessageNew, 2*time.Second)
+	if assert.NotNil(t, event) {
+		payload := event.Payload.(map[string]interface{})
+		assert.Equal(t, "Good morning", payload["content"])
+		assert.Equal(t, u1.ID.String(), payload["sender_id"])
+		assert.Equal(t, "owner", payload["sender_role"])
+	}
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func executeSearch(t *testing.T, path, token, query string, extra string) (int, helper.ResponseWithPagination) {
+	t.Helper()
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("%s?query=%s%s", path, url.QueryEscape(query), extra), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rr := executeRequest(req)
+
+	var resp helper.ResponseWithPagination
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	if rr.Code != http.StatusOK {
+		printBody(t, rr)
+	}
+	return rr.Code, resp
+}
+
+func TestSearchMessages(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "search1")
+	u2 := createTestUser(t, "search2")
+	u3 := createTestUser(t, "search3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	privateChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	pc := testClient.PrivateChat.Create().SetChat(privateChat).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Search Group").SetInviteCode("searchinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	otherGroup := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	ogc := testClient.GroupChat.Create().SetChat(otherGroup).SetCreator(u3).SetName("Other Group").SetInviteCode("otherinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(ogc).SetUser(u3).SetRole(groupmember.RoleOwner).SaveX(ctx)
+
+	hiddenMsg := testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetType("regular").
+		SetContent("pineapple before hide").SetCreatedAt(time.Now().Add(-2 * time.Hour)).SaveX(ctx)
+	testClient.PrivateChat.UpdateOne(pc).SetUser1HiddenAt(time.Now().Add(-1 * time.Hour)).ExecX(ctx)
+
+	privateMsg := testClient.Message.Create().SetChat(privateChat).SetSender(u2).SetType("regular").
+		SetContent("pineapple pizza tonight <b>yes</b>").SaveX(ctx)
+	groupMsg := testClient.Message.Create().SetChat(groupChat).SetSender(u1).SetType("regular").
+		SetContent("who wants pineapple").SaveX(ctx)
+	testClient.Message.Create().SetChat(groupChat).SetSender(u2).SetType("regular").
+		SetContent("deleted pineapple").SetDeletedAt(time.Now()).SaveX(ctx)
+	testClient.Message.Create().SetChat(otherGroup).SetSender(u3).SetType("regular").
+		SetContent("secret pineapple").SaveX(ctx)
+
+	t.Run("Success - Global Search", func(t *testing.T) {
+		code, resp := executeSearch(t, "/api/messages/search", token1, "pineapple", "")
+		assert.Equal(t, http.StatusOK, code)
+
+		data := resp.Data.([]interface{})
+		assert.Len(t, data, 2)
+
+		ids := make([]string, 0)
+		for _, item := range data {
+			ids = append(ids, item.(map[string]interface{})["message_id"].(string))
+		}
+		assert.ElementsMatch(t, []string{privateMsg.ID.String(), groupMsg.ID.String()}, ids)
+		assert.NotContains(t, ids, hiddenMsg.ID.String())
+	})
+
+	t.Run("Success - Snippet Highlight And Escaping", func(t *testing.T) {
+		code, resp := executeSearch(t, "/api/chats/"+privateChat.ID.String()+"/messages/search", token1, "pizza", "")
+		assert.Equal(t, http.StatusOK, code)
+
+		data := resp.Data.([]interface{})
+		if assert.Len(t, data, 1) {
+			item := data[0].(map[string]interface{})
+			assert.Equal(t, privateMsg.ID.String(), item["message_id"])
+			assert.Equal(t, "private", item["chat_type"])
+			assert.Equal(t, u2.FullName, stringPtr(item["chat_name"]))
+			snippet := item["snippet"].(string)
+			assert.Contains(t, snippet, "<mark>pizza</mark>")
+			assert.Contains(t, snippet, "&lt;b&gt;")
+		}
+	})
+
+	t.Run("Success - Pagination", func(t *testing.T) {
+		code, resp := executeSearch(t, "/api/messages/search", token1, "pineapple", "&limit=1")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, resp.Data.([]interface{}), 1)
+		assert.True(t, resp.Meta.HasNext)
+
+		code, next := executeSearch(t, "/api/messages/search", token1, "pineapple", "&limit=1&cursor="+resp.Meta.NextCursor)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Len(t, next.Data.([]interface{}), 1)
+		assert.False(t, next.Meta.HasNext)
+		assert.NotEqual(t, resp.Data.([]interface{})[0], next.Data.([]interface{})[0])
+	})
+
+	t.Run("Success - Hit Works With Around Message", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats/%s/messages?around_message_id=%s", groupChat.ID, groupMsg.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Fail - Chat Scope Not Member", func(t *testing.T) {
+		code, _ := executeSearch(t, "/api/chats/"+groupChat.ID.String()+"/messages/search", token3, "pineapple", "")
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("Success - Global Search Only Own Chats", func(t *testing.T) {
+		code, resp := executeSearch(t, "/api/messages/search", token3, "pineapple", "")
+		assert.Equal(t, http.StatusOK, code)
+		data := resp.Data.([]interface{})
+		if assert.Len(t, data, 1) {
+			assert.Equal(t, otherGroup.ID.String(), data[0].(map[string]interface{})["chat_id"])
+		}
+	})
+
+	t.Run("Fail - Query Too Short", func(t *testing.T) {
+		code, _ := executeSearch(t, "/api/messages/search", token1, "p", "")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("Fail - Invalid Cursor", func(t *testing.T) {
+		code, _ := executeSearch(t, "/api/messages/search", token1, "pineapple", "&cursor=invalid")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func stringPtr(v interface{}) *string {
+	s, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return &s
+}
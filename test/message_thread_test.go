@@ -0,0 +1,186 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/groupmember"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendTestReply(t *testing.T, token string, chatID, replyToID uuid.UUID, content string) uuid.UUID {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token, model.SendMessageRequest{
+		ChatID:    chatID,
+		Content:   content,
+		ReplyToID: &replyToID,
+	}))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	id, _ := uuid.Parse(resp.Data.(map[string]interface{})["id"].(string))
+	return id
+}
+
+func TestMessageThreads(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "thread1")
+	u2 := createTestUser(t, "thread2")
+	u3 := createTestUser(t, "thread3")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+	token3, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u3.ID)
+
+	groupChat := testClient.Chat.Create().SetType(chat.TypeGroup).SaveX(ctx)
+	gc := testClient.GroupChat.Create().SetChat(groupChat).SetCreator(u1).SetName("Thread Group").SetInviteCode("threadinv").SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u1).SetRole(groupmember.RoleOwner).SaveX(ctx)
+	testClient.GroupMember.Create().SetGroupChat(gc).SetUser(u2).SetRole(groupmember.RoleMember).SaveX(ctx)
+
+	root := testClient.Message.Create().SetChat(groupChat).SetSender(u1).SetType("regular").
+		SetContent("Thread root").SaveX(ctx)
+
+	replyIDs := []uuid.UUID{
+		sendTestReply(t, token2, groupChat.ID, root.ID, "first"),
+		sendTestReply(t, token1, groupChat.ID, root.ID, "second"),
+		sendTestReply(t, token2, groupChat.ID, root.ID, "third"),
+	}
+
+	t.Run("Success - Root Exposes Reply Stats", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/chats/%s/messages", groupChat.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		for _, item := range resp.Data.([]interface{}) {
+			msg := item.(map[string]interface{})
+			if msg["id"] == root.ID.String() {
+				assert.Equal(t, float64(3), msg["reply_count"])
+				assert.NotNil(t, msg["last_reply_at"])
+			} else {
+				assert.Nil(t, msg["reply_count"])
+			}
+		}
+	})
+
+	t.Run("Success - Page Through Thread", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/thread?limit=2", root.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &resp)
+		data := resp.Data.([]interface{})
+		if assert.Len(t, data, 2) {
+			assert.Equal(t, replyIDs[0].String(), data[0].(map[string]interface{})["id"])
+			assert.Equal(t, replyIDs[1].String(), data[1].(map[string]interface{})["id"])
+			assert.Equal(t, "owner", data[1].(map[string]interface{})["sender_role"])
+		}
+		assert.True(t, resp.Meta.HasNext)
+
+		req, _ = http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/thread?limit=2&cursor=%s", root.ID, resp.Meta.NextCursor), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr = executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var next helper.ResponseWithPagination
+		json.Unmarshal(rr.Body.Bytes(), &next)
+		data = next.Data.([]interface{})
+		if assert.Len(t, data, 1) {
+			assert.Equal(t, replyIDs[2].String(), data[0].(map[string]interface{})["id"])
+		}
+		assert.False(t, next.Meta.HasNext)
+	})
+
+	t.Run("Success - Deleting Reply Updates Stats", func(t *testing.T) {
+		req, _ := http.NewRequest("DELETE", "/api/messages/"+replyIDs[2].String(), nil)
+		req.Header.Set("Authorization", "Bearer "+token2)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		updated := testClient.Message.GetX(ctx, root.ID)
+		assert.Equal(t, 2, updated.ReplyCount)
+		second := testClient.Message.GetX(ctx, replyIDs[1])
+		if assert.NotNil(t, updated.LastReplyAt) {
+			assert.True(t, updated.LastReplyAt.Equal(second.CreatedAt))
+		}
+	})
+
+	t.Run("Fail - Not A Member", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/thread", root.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token3)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Invalid Cursor", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/messages/%s/thread?cursor=invalid", root.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		rr := executeRequest(req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestMessageThreadWebSocket(t *testing.T) {
+	clearDatabase(context.Background())
+
+	u1 := createWSUser(t, "threadws1", "threadws1@example.com")
+	u2 := createWSUser(t, "threadws2", "threadws2@example.com")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	groupID := createWSGroupChat(t, token1, "Thread WS Group", []uuid.UUID{u2.ID}, false)
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token1, model.SendMessageRequest{
+		ChatID:  groupID,
+		Content: "Root",
+	}))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var msgResp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &msgResp)
+	rootID, _ := uuid.Parse(msgResp.Data.(map[string]interface{})["id"].(string))
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn1, _, err := ws.DefaultDialer.Dial(wsURL+token1, nil)
+	assert.NoError(t, err)
+	defer conn1.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	sendTestReply(t, token2, groupID, rootID, "reply")
+
+	event := waitForEvent(t, conn1, websocket.EventMessageThreadUpdate, 2*time.Second)
+	if assert.NotNil(t, event) {
+		payload := event.Payload.(map[string]interface{})
+		assert.Equal(t, rootID.String(), payload["message_id"])
+		assert.Equal(t, groupID.String(), payload["chat_id"])
+		assert.Equal(t, float64(1), payload["reply_count"])
+		assert.NotNil(t, payload["last_reply_at"])
+	}
+}
@@ -110,7 +110,7 @@ func TestCreatePrivateChat(t *testing.T) {
 		}
 	})
 
-	t.Run("Chat With Self", func(t *testing.T) {
+	t.Run("Chat With Self Opens Saved Messages", func(t *testing.T) {
 		reqBody := model.CreatePrivateChatRequest{
 			TargetUserID: u1.ID,
 		}
@@ -121,7 +121,7 @@ func TestCreatePrivateChat(t *testing.T) {
 
 		rr := executeRequest(req)
 
-		if !assert.Equal(t, http.StatusBadRequest, rr.Code) {
+		if !assert.Equal(t, http.StatusOK, rr.Code) {
 			printBody(t, rr)
 		}
 	})
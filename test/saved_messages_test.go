@@ -0,0 +1,94 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestSavedMessages(t *testing.T, token string, userID uuid.UUID) string {
+	t.Helper()
+
+	rr := executeRequest(newGroupJSONRequest("POST", "/api/chats/private", token, model.CreatePrivateChatRequest{
+		TargetUserID: userID,
+	}))
+	if !assert.Equal(t, http.StatusOK, rr.Code) {
+		printBody(t, rr)
+		return ""
+	}
+
+	var resp helper.ResponseSuccess
+	json.Unmarshal(rr.Body.Bytes(), &resp)
+	return resp.Data.(map[string]interface{})["id"].(string)
+}
+
+func TestSavedMessages(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "saved1")
+	u2 := createTestUser(t, "saved2")
+
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	var chatID string
+
+	t.Run("Success - Created Once", func(t *testing.T) {
+		chatID = openTestSavedMessages(t, token1, u1.ID)
+		if chatID == "" {
+			return
+		}
+		assert.Equal(t, chatID, openTestSavedMessages(t, token1, u1.ID))
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(uuid.MustParse(chatID))).OnlyX(ctx)
+		assert.Equal(t, u1.ID, *pc.User1ID)
+		assert.Equal(t, u1.ID, *pc.User2ID)
+	})
+
+	t.Run("Success - Listed Before Any Message", func(t *testing.T) {
+		page, _ := getTestChatPage(t, token1, "", 10)
+		if assert.Len(t, page, 1) {
+			assert.Equal(t, chatID, page[0]["id"])
+			assert.Equal(t, "Saved Messages", page[0]["name"])
+			assert.Equal(t, true, page[0]["is_saved_messages"])
+			assert.Nil(t, page[0]["other_user_id"])
+		}
+
+		page, _ = getTestChatPage(t, token2, "", 10)
+		assert.Len(t, page, 0, "Saved Messages belong to their owner only")
+	})
+
+	t.Run("Success - Saving Keeps Nothing Unread", func(t *testing.T) {
+		if chatID == "" {
+			return
+		}
+		testClient.UserBlock.Create().SetBlockerID(u2.ID).SetBlockedID(u1.ID).SaveX(ctx)
+
+		sendTestMessage(t, token1, uuid.MustParse(chatID), "Note to self")
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(uuid.MustParse(chatID))).OnlyX(ctx)
+		assert.Equal(t, 0, pc.User1UnreadCount)
+		assert.Equal(t, 0, pc.User2UnreadCount)
+
+		rr := executeRequest(newGroupJSONRequest("POST", "/api/messages", token2, model.SendMessageRequest{
+			ChatID:  uuid.MustParse(chatID),
+			Content: "Intruder",
+		}))
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("Fail - Cannot Hide", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/api/chats/%s/hide", chatID), nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		assert.Equal(t, http.StatusBadRequest, executeRequest(req).Code)
+	})
+}
@@ -145,6 +145,53 @@ func TestSchedulerJobs(t *testing.T) {
 		assert.True(t, exists, "Attached media should NOT be deleted")
 	})
 
+	t.Run("Media Cleanup - Shared Object Kept For Forwarded Copy", func(t *testing.T) {
+		originalMediaRetention := testConfig.MediaRetentionDays
+		testConfig.MediaRetentionDays = 0
+		defer func() {
+			testConfig.MediaRetentionDays = originalMediaRetention
+		}()
+
+		ctx := context.Background()
+		u := createTestUser(t, "media_shared_uploader")
+		chatEntity := testClient.Chat.Create().SetType("private").SaveX(ctx)
+
+		orphan := testClient.Media.Create().
+			SetFileName("shared.jpg").
+			SetOriginalName("shared.jpg").
+			SetFileSize(100).
+			SetMimeType("image/jpeg").
+			SetUploader(u).
+			SetCreatedAt(time.Now().Add(-24 * time.Hour)).
+			SaveX(ctx)
+
+		msg := testClient.Message.Create().
+			SetChat(chatEntity).
+			SetSender(u).
+			SetType("regular").
+			SetContent("forwarded").
+			SaveX(ctx)
+
+		clone := testClient.Media.Create().
+			SetFileName("shared.jpg").
+			SetOriginalName("shared.jpg").
+			SetFileSize(100).
+			SetMimeType("image/jpeg").
+			SetUploader(u).
+			SetMessage(msg).
+			SetCreatedAt(time.Now().Add(-24 * time.Hour)).
+			SaveX(ctx)
+
+		err := job.RunMediaCleanup(ctx, testClient, testStorageAdapter, testConfig)
+		assert.NoError(t, err)
+
+		exists, _ := testClient.Media.Query().Where(media.ID(orphan.ID)).Exist(ctx)
+		assert.False(t, exists, "Orphaned row should be deleted")
+
+		exists, _ = testClient.Media.Query().Where(media.ID(clone.ID)).Exist(ctx)
+		assert.True(t, exists, "Clone sharing the storage object should remain")
+	})
+
 	t.Run("Private Chat Cleanup - Safety Check (Active User)", func(t *testing.T) {
 		ctx := context.Background()
 		u5 := createTestUser(t, "user_sched_5")
@@ -0,0 +1,234 @@
+package test
+
+import (
+	"AtoiTalkAPI/ent/chat"
+	"AtoiTalkAPI/ent/message"
+	"AtoiTalkAPI/ent/privatechat"
+	"AtoiTalkAPI/internal/helper"
+	"AtoiTalkAPI/internal/websocket"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+// sendCommand writes a command frame and waits for the ack or error that
+// echoes requestID, skipping other events.
+func sendCommand(t *testing.T, conn *ws.Conn, requestID, command string, payload interface{}) *websocket.Event {
+	t.Helper()
+
+	err := conn.WriteJSON(map[string]interface{}{
+		"type":       websocket.EventCommand,
+		"request_id": requestID,
+		"command":    command,
+		"payload":    payload,
+	})
+	if !assert.NoError(t, err) {
+		return nil
+	}
+
+	deadline := time.Now().Add(3 * time.Second)
+	conn.SetReadDeadline(deadline)
+	for time.Now().Before(deadline) {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return nil
+		}
+
+		var event websocket.Event
+		if err := json.Unmarshal(data, &event); err != nil {
+			continue
+		}
+		if (event.Type == websocket.EventCommandAck || event.Type == websocket.EventCommandError) && event.RequestID == requestID {
+			return &event
+		}
+	}
+	return nil
+}
+
+func commandErrorCode(event *websocket.Event) int {
+	if event == nil || event.Type != websocket.EventCommandError {
+		return 0
+	}
+	payload, _ := event.Payload.(map[string]interface{})
+	code, _ := payload["code"].(float64)
+	return int(code)
+}
+
+func TestWebSocketCommands(t *testing.T) {
+	clearDatabase(context.Background())
+	ctx := context.Background()
+
+	u1 := createTestUser(t, "wscmd1")
+	u2 := createTestUser(t, "wscmd2")
+	u3 := createTestUser(t, "wscmd3")
+	token1, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u1.ID)
+	token2, _ := helper.GenerateJWT(testConfig.JWTSecret, testConfig.JWTExp, u2.ID)
+
+	chatEntity := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(chatEntity).SetUser1(u1).SetUser2(u2).SaveX(ctx)
+	otherChat := testClient.Chat.Create().SetType(chat.TypePrivate).SaveX(ctx)
+	testClient.PrivateChat.Create().SetChat(otherChat).SetUser1(u2).SetUser2(u3).SaveX(ctx)
+
+	server := httptest.NewServer(testRouter)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?token="
+	conn1, _, err := ws.DefaultDialer.Dial(wsURL+token1, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn1.Close()
+	conn2, _, err := ws.DefaultDialer.Dial(wsURL+token2, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn2.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	var messageID string
+
+	t.Run("Success - Send Message", func(t *testing.T) {
+		event := sendCommand(t, conn1, "send-1", websocket.CommandMessageSend, map[string]interface{}{
+			"chat_id":           chatEntity.ID,
+			"content":           "hello over ws",
+			"client_message_id": "local-1",
+		})
+		if !assert.NotNil(t, event) || !assert.Equal(t, websocket.EventCommandAck, event.Type) {
+			return
+		}
+		payload := event.Payload.(map[string]interface{})
+		messageID = payload["id"].(string)
+		assert.Equal(t, "hello over ws", payload["content"])
+		assert.Equal(t, "local-1", payload["client_message_id"])
+
+		newEvent := waitForEvent(t, conn2, websocket.EventMessageNew, 2*time.Second)
+		if assert.NotNil(t, newEvent) {
+			assert.Equal(t, messageID, newEvent.Payload.(map[string]interface{})["id"])
+		}
+	})
+
+	t.Run("Success - Edit Message", func(t *testing.T) {
+		event := sendCommand(t, conn1, "edit-1", websocket.CommandMessageEdit, map[string]interface{}{
+			"message_id": messageID,
+			"content":    "edited over ws",
+		})
+		if assert.NotNil(t, event) && assert.Equal(t, websocket.EventCommandAck, event.Type) {
+			assert.Equal(t, "edited over ws", event.Payload.(map[string]interface{})["content"])
+		}
+	})
+
+	t.Run("Success - Mark As Read", func(t *testing.T) {
+		event := sendCommand(t, conn2, "read-1", websocket.CommandChatRead, map[string]interface{}{
+			"chat_id": chatEntity.ID,
+		})
+		if assert.NotNil(t, event) {
+			assert.Equal(t, websocket.EventCommandAck, event.Type)
+		}
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(chatEntity.ID)).OnlyX(ctx)
+		assert.Equal(t, 0, pc.User2UnreadCount)
+	})
+
+	t.Run("Fail - Edit Someone Else's Message", func(t *testing.T) {
+		event := sendCommand(t, conn2, "edit-2", websocket.CommandMessageEdit, map[string]interface{}{
+			"message_id": messageID,
+			"content":    "not mine",
+		})
+		assert.Equal(t, http.StatusForbidden, commandErrorCode(event))
+	})
+
+	t.Run("Fail - Send To Chat Without Access", func(t *testing.T) {
+		event := sendCommand(t, conn1, "send-2", websocket.CommandMessageSend, map[string]interface{}{
+			"chat_id": otherChat.ID,
+			"content": "intruder",
+		})
+		code := commandErrorCode(event)
+		assert.True(t, code == http.StatusForbidden || code == http.StatusNotFound, "unexpected code %d", code)
+	})
+
+	t.Run("Fail - Validation", func(t *testing.T) {
+		event := sendCommand(t, conn1, "send-3", websocket.CommandMessageSend, map[string]interface{}{
+			"chat_id": chatEntity.ID,
+		})
+		assert.Equal(t, http.StatusBadRequest, commandErrorCode(event))
+	})
+
+	t.Run("Fail - Unknown Command", func(t *testing.T) {
+		event := sendCommand(t, conn1, "unknown-1", "message.explode", map[string]interface{}{})
+		assert.Equal(t, http.StatusBadRequest, commandErrorCode(event))
+	})
+
+	t.Run("Success - Delete Message", func(t *testing.T) {
+		event := sendCommand(t, conn1, "delete-1", websocket.CommandMessageDelete, map[string]interface{}{
+			"message_id": messageID,
+		})
+		if assert.NotNil(t, event) {
+			assert.Equal(t, websocket.EventCommandAck, event.Type)
+		}
+
+		count := testClient.Message.Query().Where(message.ChatID(chatEntity.ID), message.DeletedAtIsNil()).CountX(ctx)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("Success - Hide Chat", func(t *testing.T) {
+		event := sendCommand(t, conn1, "hide-1", websocket.CommandChatHide, map[string]interface{}{
+			"chat_id": chatEntity.ID,
+		})
+		if assert.NotNil(t, event) {
+			assert.Equal(t, websocket.EventCommandAck, event.Type)
+		}
+
+		pc := testClient.PrivateChat.Query().Where(privatechat.ChatID(chatEntity.ID)).OnlyX(ctx)
+		assert.NotNil(t, pc.User1HiddenAt)
+	})
+
+	t.Run("Fail - Rate Limited", func(t *testing.T) {
+		redisAdapter.Set(ctx, "ratelimit:user:general_read:"+u1.ID.String(), 1000, time.Minute)
+		defer redisAdapter.Del(ctx, "ratelimit:user:general_read:"+u1.ID.String())
+
+		event := sendCommand(t, conn1, "read-2", websocket.CommandChatRead, map[string]interface{}{
+			"chat_id": chatEntity.ID,
+		})
+		assert.Equal(t, http.StatusTooManyRequests, commandErrorCode(event))
+	})
+
+	t.Run("Fail - Auth Verify Rate Limited", func(t *testing.T) {
+		redisAdapter.Set(ctx, "ratelimit:user:auth_verify:"+u1.ID.String(), 1000, time.Minute)
+		defer redisAdapter.Del(ctx, "ratelimit:user:auth_verify:"+u1.ID.String())
+
+		event := sendCommand(t, conn1, "read-3", websocket.CommandChatRead, map[string]interface{}{
+			"chat_id": chatEntity.ID,
+		})
+		assert.Equal(t, http.StatusTooManyRequests, commandErrorCode(event))
+	})
+
+	t.Run("Fail - Revoked Token Closes Socket", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/api/auth/logout", nil)
+		req.Header.Set("Authorization", "Bearer "+token1)
+		if !assert.Equal(t, http.StatusOK, executeRequest(req).Code) {
+			return
+		}
+
+		event := sendCommand(t, conn1, "read-4", websocket.CommandChatRead, map[string]interface{}{
+			"chat_id": chatEntity.ID,
+		})
+		assert.Equal(t, http.StatusUnauthorized, commandErrorCode(event))
+
+		conn1.SetReadDeadline(time.Now().Add(3 * time.Second))
+		for {
+			if _, _, err := conn1.ReadMessage(); err != nil {
+				_, closed := err.(*ws.CloseError)
+				assert.True(t, closed, "expected a close frame, got %v", err)
+				break
+			}
+		}
+	})
+}